@@ -22,6 +22,9 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
     event LogManagementContractCreated(address messageBusAddress);
     // Event to log changes to important contract addresses
     event ImportantContractAddressUpdated(string key, address newAddress);
+    // Event to log changes to the network parameters, e.g. the min gas price. The parameters which are not listed keep
+    // their values. The enclaves apply them from the first batch built on this block
+    event NetworkParametersUpdated(string[] keys, uint256[] values);
 
     mapping(address => string) private attestationRequests;
     mapping(address => bool) private attested;
@@ -167,4 +170,10 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
     function GetImportantContractKeys() public view returns(string[] memory) {
         return importantContractKeys;
     }
+
+    // The addresses are passed as their uint256 value
+    function SetNetworkParameters(string[] calldata keys, uint256[] calldata values) public onlyOwner {
+        require(keys.length == values.length, "keys and values must have the same length");
+        emit NetworkParametersUpdated(keys, values);
+    }
 }
@@ -8,8 +8,12 @@ type (
 	ScheduledFunc func()
 )
 
-// Schedule runs the function after the delay
+// Schedule runs the function after the delay, or straight away if the delay is not positive
 func Schedule(delay time.Duration, fun ScheduledFunc) {
+	if delay <= 0 {
+		go fun()
+		return
+	}
 	ticker := time.NewTicker(delay)
 	go func() {
 		<-ticker.C
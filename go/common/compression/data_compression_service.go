@@ -13,6 +13,8 @@ type DataCompressionService interface {
 	// CompressBatch - uses the default compression level, because the compression is for the efficiency of the p2p transfer
 	CompressBatch(blob []byte) ([]byte, error)
 	Decompress(blob []byte) ([]byte, error)
+	// DecompressReader - decompresses the data as it is read from r, so the decompressed data is never held in memory at once
+	DecompressReader(r io.Reader) io.Reader
 }
 
 func NewBrotliDataCompressionService() DataCompressionService {
@@ -34,6 +36,10 @@ func (cs *brotliDataCompressionService) Decompress(in []byte) ([]byte, error) {
 	return io.ReadAll(r)
 }
 
+func (cs *brotliDataCompressionService) DecompressReader(r io.Reader) io.Reader {
+	return brotli.NewReader(r)
+}
+
 func (cs *brotliDataCompressionService) compress(in []byte, level int) ([]byte, error) {
 	var buf bytes.Buffer
 	writer := brotli.NewWriterLevel(&buf, level)
@@ -1,13 +1,15 @@
 package common
 
 import (
+	"context"
 	"encoding/json"
 	"math/big"
+	"time"
 
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
-	"github.com/ten-protocol/go-ten/go/common/tracers"
 	"github.com/ten-protocol/go-ten/go/responses"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -16,19 +18,185 @@ import (
 type StatusCode int
 
 // Status represents the enclave's current state - whether the enclave is healthy and ready to process requests, as well
-// as its latest known heads for the L1 and L2 chains
+// as its latest known heads for the L1 and L2 chains, and what the enclave is.
+// New fields are only appended, so the hosts that predate them can still decode the status.
 type Status struct {
 	StatusCode StatusCode
 	L1Head     gethcommon.Hash
 	L2Head     *big.Int
+	TxPool     TxPoolStatus
+	Version    string      // the enclave software version
+	NodeType   NodeType    // Unknown for the enclaves which predate it
+	EnclaveID  EnclaveID   // derived from the enclave key
+	HasSecret  bool        // whether the enclave holds the shared secret
+	WillAttest bool        // whether the enclave produces real attestation reports
+	L2HeadHash L2BatchHash // the hash of the batch with the L2Head seq number, empty if there is no head batch yet
+	// L2ExecutedHead - the seq number of the last executed batch. It trails L2Head while the enclave catches up
+	L2ExecutedHead *big.Int
+	// LatestRollup - the canonical rollup which published the most recent batches, nil if no rollup was processed yet
+	LatestRollup *PublicRollupMetadata
+	// BatchGasLimit - the gas the sequencer currently packs in a batch, zero for the validators
+	BatchGasLimit uint64
+	// BatchProductionPaused - whether the sequencer stopped creating batches for maintenance
+	BatchProductionPaused bool
+	// Ready - whether the enclave has a head batch. Until then, the rollups cannot be created
+	Ready bool
+	// Replay - the progress of the replay of the batches whose state was lost, nil if the enclave did not replay batches
+	// since it started
+	Replay *ReplayStatus
+	// StateRetention - which batches the enclave keeps the state of, see StateRetentionMode
+	StateRetention StateRetentionMode
+	// OldestStateSeqNo - the seq number of the oldest batch whose state can be queried, zero if there is no state yet
+	OldestStateSeqNo uint64
+}
+
+// StateRetentionMode - the policy of the enclave for the state of the past batches, which the historical queries (e.g.
+// an eth_call at an old batch) depend on
+type StateRetentionMode string
+
+const (
+	// ArchiveStateRetention - the state of every batch is kept
+	ArchiveStateRetention StateRetentionMode = "archive"
+	// FullStateRetention - only the state of the latest batches is kept, the older ones are garbage collected
+	FullStateRetention StateRetentionMode = "full"
+)
+
+// ReplayStatus - the replay of the batches whose state was not persisted before the enclave stopped uncleanly. The
+// enclave reports the Recovering status code while it is in progress.
+type ReplayStatus struct {
+	InProgress   bool
+	CurrentSeqNo uint64 // the last replayed batch
+	TargetSeqNo  uint64 // the head batch, where the replay stops
+	Replayed     uint64 // the number of batches replayed so far
+	Total        uint64 // the number of batches to replay
+	Error        string // why the replay failed, empty otherwise
+}
+
+// TxPoolStatus - the occupancy of the enclave mempool
+type TxPoolStatus struct {
+	Pending  uint64 // executable transactions
+	Queued   uint64 // transactions waiting for a nonce gap to be filled
+	Capacity uint64 // the maximum number of transactions the pool accepts
+}
+
+// HealthStatus - the health of each enclave component checked. OverallHealth is true only when all of them are healthy.
+type HealthStatus struct {
+	OverallHealth bool
+	Components    []ComponentHealth
+}
+
+// ComponentHealth - the result of the health check of one enclave component
+type ComponentHealth struct {
+	Name    string
+	Healthy bool
+	Error   string            // why the component is not healthy, empty otherwise
+	Latency time.Duration     // how long the check took
+	Detail  map[string]string // e.g. the head of the component, to tell how far behind it is
+}
+
+// StateDivergenceReport - a batch whose re-execution by a validator does not match the header signed by the sequencer.
+// The validator stops executing the batches from it on, as long as the batch is canonical.
+type StateDivergenceReport struct {
+	BatchHash            L2BatchHash
+	SeqNo                uint64
+	Height               uint64
+	ComputedBatchHash    L2BatchHash
+	ExpectedStateRoot    gethcommon.Hash
+	ComputedStateRoot    gethcommon.Hash
+	ExpectedReceiptsRoot gethcommon.Hash
+	ComputedReceiptsRoot gethcommon.Hash
+	ExpectedGasUsed      uint64
+	ComputedGasUsed      uint64
+	DetectedAt           time.Time
+	Canonical            bool               // whether the divergent batch is still canonical, i.e. the validator is halted by it
+	Snapshot             *StateSnapshotInfo // the snapshot the validator started from, nil if it executed every batch
+}
+
+// BatchTimeViolation - a batch whose timestamp a validator rejected, because it is not after the timestamp of its parent
+// or drifts too far from the timestamp of its L1 proof block. The offending header is kept for diagnostics.
+type BatchTimeViolation struct {
+	BatchHash  L2BatchHash
+	SeqNo      uint64
+	Header     *BatchHeader
+	ParentTime uint64
+	L1Time     uint64
+	Reason     string
+	DetectedAt time.Time
+}
+
+// StateSnapshotInfo - identifies the state in a snapshot: the state after the last batch of a canonical rollup, as
+// executed by the exporting enclave. A validator which imported the snapshot keeps it, so a divergence can be traced
+// back to the snapshot rather than to its own execution.
+type StateSnapshotInfo struct {
+	RollupHash L2RollupHash
+	BatchHash  L2BatchHash // the last batch of the rollup
+	SeqNo      uint64
+	Height     uint64
+	StateRoot  StateRoot
+	ExporterID EnclaveID
+}
+
+// EncryptedStateSnapshot - a state snapshot encrypted with the attested key of the enclave which requested it, and
+// signed by the enclave which exported it, as anyone can encrypt with the attested key. The info is repeated in clear so
+// the operators know what they are shipping, and the enclave checks it against the snapshot.
+type EncryptedStateSnapshot struct {
+	Info      StateSnapshotInfo
+	Snapshot  []byte
+	Signature []byte // the signature of the StateSnapshotDigest by the key of the exporting enclave
+}
+
+// ExecutedBatchSummary - the outcome of the execution of a batch, recorded when the enclave executes it. The summaries
+// hold no private data, so they are returned unencrypted, and the operators of independent validators can compare them
+// to detect a divergence.
+type ExecutedBatchSummary struct {
+	SeqNo        uint64
+	Height       uint64
+	BatchHash    L2BatchHash
+	StateRoot    StateRoot
+	ReceiptsRoot gethcommon.Hash // computed from the receipts of the execution
+	GasUsed      uint64          // the sum of the gas used by the receipts
+	TxCount      uint64
+	Orphaned     bool // whether the batch was built on an L1 block which a fork moved to a dead branch
+}
+
+// ProfilerStatus - the state of the enclave profiler after it was started or stopped at runtime
+type ProfilerStatus struct {
+	Running bool
+	Address string // the address the profiler listens on, empty when it is not running
+	Message string // what the call did, e.g. that the profiler was already running
+}
+
+// EnclaveConfiguration - the effective configuration of the enclave, sanitized, with the values derived from it. Its
+// JSON encoding is stable, so the configurations of two nodes can be diffed
+type EnclaveConfiguration struct {
+	Config              json.RawMessage  `json:"config"`              // the sanitized config.EnclaveConfig
+	ChainConfig         json.RawMessage  `json:"chainConfig"`         // the params.ChainConfig of the L2 chain
+	GenesisBatchHash    *L2BatchHash     `json:"genesisBatchHash"`    // nil until the genesis batch is stored
+	L1GenesisConfigHash *gethcommon.Hash `json:"l1GenesisConfigHash"` // the hash of the L1 genesis JSON, nil when not set
+}
+
+// NetworkInfo - the static info of the network, which identifies the chain to the wallets. It is not encrypted, and is
+// available before the enclave receives the shared secret
+type NetworkInfo struct {
+	ChainID          int64       `json:"chainId"`
+	NetworkVersion   string      `json:"networkVersion"`   // the net_version of the network
+	ClientVersion    string      `json:"clientVersion"`    // the web3_clientVersion of the enclave
+	GenesisBatchHash L2BatchHash `json:"genesisBatchHash"` // the zero hash until the genesis batch is stored
 }
 
 const (
 	Running        StatusCode = iota // the enclave is running, accepting L1 blocks
 	AwaitingSecret                   // the enclave has not received the network secret and cannot process L1 blocks
 	Unavailable                      // the enclave is unavailable (no guarantee it will self-recover)
+	Recovering                       // the enclave is replaying the batches whose state was lost, and cannot process L1 blocks yet
 )
 
+// MaxSecretDisclosuresPerPage - the maximum number of records returned by GetSecretDisclosures
+const MaxSecretDisclosuresPerPage = 100
+
+// MaxBatchesPerRange - the maximum number of batches returned by GetBatchesBySeqRange, so the responses stay bounded
+const MaxBatchesPerRange = 100
+
 // Enclave represents the API of the service that runs inside the TEE.
 type Enclave interface {
 	EnclaveScan
@@ -36,6 +204,10 @@ type Enclave interface {
 	// Status checks whether the enclave is ready to process requests - only implemented by the RPC layer
 	Status() (Status, SystemError)
 
+	// GetEnclaveAPIVersion - the revision of the enclave interface and the optional features it supports, which the
+	// host queries when it connects to the enclave
+	GetEnclaveAPIVersion() (*EnclaveAPIVersion, SystemError)
+
 	// Attestation - Produces an attestation report which will be used to request the shared secret from another enclave.
 	Attestation() (*AttestationReport, SystemError)
 
@@ -48,23 +220,42 @@ type Enclave interface {
 	// EnclaveID - returns the enclave's ID
 	EnclaveID() (EnclaveID, SystemError)
 
+	// EnclaveIDProof - returns the enclave's ID with the signature by the enclave key of the nonce, so the registration
+	// tooling and the peers can check the enclave controls the key of its ID, see EnclaveIDProof.Verify. An empty or
+	// oversized nonce is a user error.
+	EnclaveIDProof(nonce []byte) (*EnclaveIDProof, SystemError)
+
 	// SubmitL1Block - Used for the host to submit L1 blocks to the enclave, these may be:
 	//  a. historic block - if the enclave is behind and in the process of catching up with the L1 state
 	//  b. the latest block published by the L1, to which the enclave should respond with a rollup
 	// It is the responsibility of the host to gossip the returned rollup
 	// For good functioning the caller should always submit blocks ordered by height
 	// submitting a block before receiving ancestors of it, will result in it being ignored
-	SubmitL1Block(block L1Block, receipts L1Receipts, isLatest bool) (*BlockSubmissionResponse, SystemError)
+	// The host submits either the receipts of the block, or proofs of the receipts of the relevant transactions (see
+	// L1ReceiptsToProve) against the receipts root, in which case the receipts are nil.
+	// The trace context of ctx, if any, is the parent of the spans recorded by the enclave.
+	SubmitL1Block(ctx context.Context, block L1Block, receipts L1Receipts, receiptProofs L1ReceiptProofs, isLatest bool) (*BlockSubmissionResponse, SystemError)
+
+	// SubmitL1Headers - a header only pre-submission of a chain of L1 blocks ordered by height, which the host uses to find
+	// the latest block of its chain the enclave already has, without shipping the full blocks and receipts.
+	// If the enclave has none of the blocks or their parent, the host should submit older headers.
+	SubmitL1Headers(headers []*types.Header) (*L1ForkPoint, SystemError)
 
 	// SubmitTx - user transactions
-	SubmitTx(tx EncryptedTx) (*responses.RawTx, SystemError)
+	// Only the sequencer adds them to its mempool. Validators return the validated transaction, which the host must
+	// relay to the sequencer (see SubmitTxResponse.NeedsForwarding).
+	SubmitTx(tx EncryptedTx) (*SubmitTxResponse, SystemError)
 
-	// SubmitBatch submits a batch received from the sequencer for processing.
-	SubmitBatch(batch *ExtBatch) SystemError
+	// SubmitForwardedTx - transactions validated and forwarded by the validators. Only accepted by the sequencer.
+	SubmitForwardedTx(tx EncryptedForwardedTx) SystemError
+
+	// SubmitBatch submits a batch received from the sequencer for processing, and returns what the enclave executed of
+	// the stored batches. The summary is also returned when the execution failed, and is nil for the older enclaves.
+	SubmitBatch(ctx context.Context, batch *ExtBatch) (*BatchExecutionSummary, SystemError)
 
 	// ObsCall - Execute a smart contract to retrieve data. The equivalent of "Eth_call"
 	// Todo - return the result with a block delay. To prevent frontrunning.
-	ObsCall(encryptedParams EncryptedParamsCall) (*responses.Call, SystemError)
+	ObsCall(ctx context.Context, encryptedParams EncryptedParamsCall) (*responses.Call, SystemError)
 
 	// GetTransactionCount returns the nonce of the wallet with the given address (encrypted with the acc viewing key)
 	GetTransactionCount(encryptedParams EncryptedParamsGetTxCount) (*responses.TxCount, SystemError)
@@ -76,7 +267,16 @@ type Enclave interface {
 	GetTransaction(encryptedParams EncryptedParamsGetTxByHash) (*responses.TxByHash, SystemError)
 
 	// GetTransactionReceipt returns a transaction receipt given its signed hash, or nil if the transaction is unknown
-	GetTransactionReceipt(encryptedParams EncryptedParamsGetTxReceipt) (*responses.TxReceipt, SystemError)
+	// The context of the request ends the wait for the transactions of the mempool.
+	GetTransactionReceipt(ctx context.Context, encryptedParams EncryptedParamsGetTxReceipt) (*responses.TxReceipt, SystemError)
+
+	// GetCrossChainMessageProof returns the merkle proof of an outbound cross chain message, encrypted with the viewing
+	// key of the message sender, or a pending status if the batch that emitted it was not yet published to the L1
+	GetCrossChainMessageProof(encryptedParams EncryptedParamsGetMessageProof) (*responses.MessageProof, SystemError)
+
+	// GetCrossChainMessageStatus returns the lifecycle state of a cross chain message. The state of L2->L1 messages is
+	// only revealed to the sender of the message.
+	GetCrossChainMessageStatus(encryptedParams EncryptedParamsGetMessageStatus) (*responses.MessageStatus, SystemError)
 
 	// GetBalance returns the balance of the address on the Obscuro network, encrypted with the viewing key for the
 	// address.
@@ -85,13 +285,21 @@ type Enclave interface {
 	// GetCode returns the code stored at the given address in the state for the given rollup hash.
 	GetCode(address gethcommon.Address, rollupHash *gethcommon.Hash) ([]byte, SystemError)
 
+	// ConvertBatchHash accepts either the hash of a batch or the hash of the Ethereum header the batch is converted to,
+	// which is the block hash seen by the standard tooling, and returns both. Nil is returned when neither is known.
+	ConvertBatchHash(hash gethcommon.Hash) (*BatchHashes, SystemError)
+
 	// Subscribe adds a log subscription to the enclave under the given ID, provided the request is authenticated
 	// correctly. The events will be populated in the BlockSubmissionResponse. If there is an existing subscription
 	// with the given ID, it is overwritten.
 	Subscribe(id rpc.ID, encryptedParams EncryptedParamsLogSubscription) SystemError
 
-	// Unsubscribe removes the log subscription with the given ID from the enclave. If there is no subscription with
-	// the given ID, nothing is deleted.
+	// SubscribeNewHeads adds a newHeads subscription to the enclave under the given ID. The converted header of each new
+	// head batch is streamed for it. The headers are public, so no viewing key is needed.
+	SubscribeNewHeads(id rpc.ID) SystemError
+
+	// Unsubscribe removes the log or newHeads subscription with the given ID from the enclave. If there is no
+	// subscription with the given ID, nothing is deleted.
 	Unsubscribe(id rpc.ID) SystemError
 
 	// StopClient stops the enclave client if one exists - only implemented by the RPC layer
@@ -100,11 +308,42 @@ type Enclave interface {
 	// EstimateGas tries to estimate the gas needed to execute a specific transaction based on the pending state.
 	EstimateGas(encryptedParams EncryptedParamsEstimateGas) (*responses.Gas, SystemError)
 
+	// EstimateL1Fee returns the part of the fee of a transaction which pays for publishing it to the L1. It is included
+	// in the result of EstimateGas.
+	EstimateL1Fee(encryptedParams EncryptedParamsEstimateL1Fee) (*responses.L1Fee, SystemError)
+
 	// GetLogs returns all the logs matching the filter.
 	GetLogs(encryptedParams EncryptedParamsGetLogs) (*responses.Logs, SystemError)
 
-	// HealthCheck returns whether the enclave is in a healthy state
-	HealthCheck() (bool, SystemError)
+	// HealthCheck returns whether the enclave is in a healthy state, with the health of each of its components
+	HealthCheck() (*HealthStatus, SystemError)
+
+	// GetStateDivergenceReports returns the batches whose re-execution did not match the header signed by the sequencer,
+	// ordered by seq number
+	GetStateDivergenceReports() ([]*StateDivergenceReport, SystemError)
+
+	// ExportStateSnapshot - the state after the last batch of the canonical rollup, with the batch and the data needed to
+	// execute the following batches, encrypted with the key of the requesting enclave once its attestation is verified
+	ExportStateSnapshot(rollupHash L2RollupHash, requester *AttestationReport) (*EncryptedStateSnapshot, SystemError)
+
+	// ImportStateSnapshot - initialises the empty database of a new validator with a snapshot exported for it, so it
+	// executes the batches following the snapshot instead of replaying them from genesis. The enclave has not processed
+	// the L1 blocks yet, so it is given the attestations published on L1, which it verifies and learns the attested keys
+	// of the exporter and of the sequencer from.
+	ImportStateSnapshot(snapshot *EncryptedStateSnapshot, l1Attestations []*AttestationReport) (*StateSnapshotInfo, SystemError)
+
+	// GetSecretDisclosures returns a page of the audit log of the secret requests, from the record with the ID on, in the
+	// order they were processed. At most maxCount records are returned, capped at MaxSecretDisclosuresPerPage, which is
+	// also the default for 0.
+	GetSecretDisclosures(fromID uint64, maxCount uint64) ([]*SecretDisclosureRecord, SystemError)
+
+	// GetRollupCompressionStats returns the compression stats of the most recent rollups created or consumed by the
+	// enclave, in the order they were recorded
+	GetRollupCompressionStats() ([]*RollupCompressionStats, SystemError)
+
+	// GetMetrics returns the metrics of the enclave components in the Prometheus text format. Only aggregates are
+	// exported, never per-user data. The response is empty when the metrics are disabled.
+	GetMetrics() ([]byte, SystemError)
 
 	// GetBatch - retrieve a batch if existing within the enclave db.
 	GetBatch(hash L2BatchHash) (*ExtBatch, SystemError)
@@ -112,22 +351,85 @@ type Enclave interface {
 	// GetBatchBySeqNo - retrieve batch by sequencer number if it's in the db
 	GetBatchBySeqNo(seqNo uint64) (*ExtBatch, SystemError)
 
+	// GetBatchByHeight - retrieve the canonical batch at the height, if it's in the db
+	GetBatchByHeight(height uint64) (*ExtBatch, SystemError)
+
+	// GetBatchesBySeqRange - retrieve the batches with the sequencer numbers between from and to (inclusive), ordered by
+	// sequencer number. At most maxCount batches are returned, capped at MaxBatchesPerRange, which is also the default for 0.
+	GetBatchesBySeqRange(fromSeqNo uint64, toSeqNo uint64, maxCount uint64) ([]*ExtBatch, SystemError)
+
+	// GetBatchExecutionSummary - retrieve the summary of the execution of the batch with the sequencer number, once it was
+	// executed by the enclave
+	GetBatchExecutionSummary(seqNo uint64) (*ExecutedBatchSummary, SystemError)
+
+	// GetBatchExecutionSummaries - retrieve the summaries of the executed batches with the sequencer numbers between from
+	// and to (inclusive), ordered by sequencer number. At most maxCount summaries are returned, capped at
+	// MaxBatchesPerRange, which is also the default for 0.
+	GetBatchExecutionSummaries(fromSeqNo uint64, toSeqNo uint64, maxCount uint64) ([]*ExecutedBatchSummary, SystemError)
+
+	// GetRollupData - retrieve the metadata of a published rollup, canonical or not
+	GetRollupData(hash L2RollupHash) (*PublicRollupMetadata, SystemError)
+
+	// GetRollupByBatchSeqNo - retrieve the metadata of the rollup which published the batch
+	GetRollupByBatchSeqNo(seqNo uint64) (*PublicRollupMetadata, SystemError)
+
 	// CreateBatch - creates a new head batch extending the previous one for the latest known L1 head if the node is
-	// a sequencer. Will panic otherwise.
-	CreateBatch(skipIfEmpty bool) SystemError
+	// a sequencer. Will panic otherwise. No batch is created while the batch production is paused, or when the enclave
+	// produces the batches on its own timer.
+	// Returns errutil.ErrNotReady if the enclave has no L1 head to create the genesis batch on yet.
+	CreateBatch(skipIfEmpty bool) (*CreateBatchResult, SystemError)
+
+	// PauseBatchProduction - stops the sequencer from creating batches, e.g. to drain it before an upgrade. It returns
+	// once the batch being created, if any, is stored. The transactions are still accepted and the rollups still
+	// created. The production stays paused until resumed or the enclave restarts.
+	// Returns errutil.ErrNotSequencer if the node is not a sequencer.
+	PauseBatchProduction() SystemError
+
+	// ResumeBatchProduction - the sequencer creates batches again after PauseBatchProduction.
+	// Returns errutil.ErrNotSequencer if the node is not a sequencer.
+	ResumeBatchProduction() SystemError
+
+	// ReprocessRollup - retries the processing of a rollup which failed, after the rollups published before it, rather
+	// than waiting for the next L1 block. A failure is recorded in the returned processing.
+	// Returns an ErrCodeInvalidParams error if the rollup was not published in an ingested block.
+	ReprocessRollup(rollupHash L2RollupHash) (*RollupProcessing, SystemError)
 
 	// CreateRollup - will create a new rollup by going through the sequencer if the node is a sequencer
 	// or panic otherwise.
-	CreateRollup(fromSeqNo uint64) (*ExtRollup, SystemError)
+	// The metadata describes the batches included in the rollup.
+	// Returns errutil.ErrNotReady if the enclave has no head batch yet.
+	CreateRollup(fromSeqNo uint64) (*ExtRollup, *ExtRollupMetadata, SystemError)
+
+	// DebugTraceTransaction returns the trace of a transaction. Only the sender of the transaction, or the owner of the
+	// contract it calls, can request it, unless the enclave allows the unrestricted debug access.
+	DebugTraceTransaction(encryptedParams EncryptedParamsDebugTraceTx) (*responses.DebugTrace, SystemError)
 
-	// DebugTraceTransaction returns the trace of a transaction
-	DebugTraceTransaction(hash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, SystemError)
+	// DebugStorageRangeAt returns a page of the storage of a contract, in the state in which a transaction of a batch is
+	// executed. Only the owner of the contract can request it, unless the enclave allows the unrestricted debug access.
+	DebugStorageRangeAt(encryptedParams EncryptedParamsStorageRangeAt) (*responses.StorageRange, SystemError)
 
 	// StreamL2Updates - will stream any new batches as they are created/detected
 	// All will be queued in the channel that has been returned.
 	StreamL2Updates() (chan StreamL2UpdatesResponse, func())
-	// DebugEventLogRelevancy returns the logs of a transaction
-	DebugEventLogRelevancy(hash gethcommon.Hash) (json.RawMessage, SystemError)
+	// DebugEventLogRelevancy returns the logs of a transaction, with the same authorisation as DebugTraceTransaction
+	DebugEventLogRelevancy(encryptedParams EncryptedParamsDebugLogs) (*responses.DebugLogs, SystemError)
+	// DebugBatchLogRelevancy returns a page of the relevancy decisions for the logs of a batch: the accounts which see
+	// each log, and why. Only the logs of the contracts owned by the caller are included, unless the enclave allows the
+	// unrestricted debug access.
+	DebugBatchLogRelevancy(encryptedParams EncryptedParamsBatchLogs) (*responses.BatchLogRelevancy, SystemError)
+
+	// SetProfilerEnabled starts or stops the profiler at runtime. Only available when the debug namespace is enabled, and
+	// never on an attested enclave.
+	SetProfilerEnabled(enabled bool) (*ProfilerStatus, SystemError)
+	// SetLogLevel changes the level at which the records of the enclave component are logged, and returns the levels of
+	// the components which are not logged at the default level. Only available when the debug namespace is enabled.
+	SetLogLevel(component string, level int) (map[string]int, SystemError)
+	// GetEnclaveConfiguration returns the sanitized configuration of the enclave, with the values derived from it. Only
+	// available when the debug namespace or the config introspection is enabled.
+	GetEnclaveConfiguration() (*EnclaveConfiguration, SystemError)
+	// GetNetworkInfo returns the static info of the network. It is not encrypted, and is available in every state of the
+	// enclave, so the wallets can detect the chain before the node is provisioned.
+	GetNetworkInfo() (*NetworkInfo, SystemError)
 }
 
 // EnclaveScan represents the methods that are used for data scanning in the enclave
@@ -151,6 +453,85 @@ type BlockSubmissionResponse struct {
 	RejectError             *errutil.BlockRejectError // If block was rejected, contains information about what block to submit next.
 }
 
+// L1ForkPoint - the latest block of a submitted L1 chain the enclave already has, and the number of blocks of the chain
+// after it which the enclave is missing
+type L1ForkPoint struct {
+	Ancestor       L1BlockHash
+	AncestorHeight uint64
+	MissingBlocks  uint64
+}
+
+// BatchHashes - the hash of a batch, and the hash of the Ethereum header it is converted to
+type BatchHashes struct {
+	BatchHash     L2BatchHash
+	ConvertedHash gethcommon.Hash
+}
+
+// CreateBatchResult - the outcome of a CreateBatch request that did not fail
+type CreateBatchResult struct {
+	Produced   bool
+	BatchHash  L2BatchHash // the hash of the produced batch
+	SeqNo      uint64      // the sequence number of the produced batch
+	TxCount    int         // the number of transactions in the produced batch
+	SkipReason BatchSkipReason
+}
+
+// BatchSkipReason - why a CreateBatch request did not produce a batch
+type BatchSkipReason string
+
+const (
+	BatchNotSkipped       BatchSkipReason = ""
+	BatchSkippedEmpty     BatchSkipReason = "empty"   // there were no transactions, and the empty batch could be skipped
+	BatchSkippedPaused    BatchSkipReason = "paused"  // the batch production is paused
+	BatchSkippedInEnclave BatchSkipReason = "enclave" // the enclave produces the batches on its own timer, so the request was ignored
+)
+
+// SubmitTxResponse - the outcome of submitting a user transaction
+type SubmitTxResponse struct {
+	Response  *responses.RawTx     // the tx hash or the error, encrypted with the viewing key of the user
+	ForwardTx EncryptedForwardedTx // the validated transaction, set when the enclave is not the sequencer
+}
+
+// NeedsForwarding - whether the transaction must be relayed to the sequencer in order to be included in a batch
+func (r *SubmitTxResponse) NeedsForwarding() bool {
+	return len(r.ForwardTx) > 0
+}
+
+// BatchExecutionStop - why the enclave stopped executing the stored batches
+type BatchExecutionStop string
+
+const (
+	BatchExecutionUpToDate       BatchExecutionStop = "up to date"       // every stored batch was executed
+	BatchExecutionMissingParent  BatchExecutionStop = "missing parent"   // the next batch waits for its parent
+	BatchExecutionMissingL1Block BatchExecutionStop = "missing L1 block" // the next batch waits for its L1 block
+	BatchExecutionFailed         BatchExecutionStop = "execution error"
+)
+
+// BatchExecutionSummary - what the enclave executed of the stored batches, and why it stopped
+type BatchExecutionSummary struct {
+	Executed  uint64 // the number of batches executed by the call
+	HeadSeqNo uint64 // the head batch after the execution
+	HeadHash  L2BatchHash
+	// StoppedAtSeqNo - the first stored batch which was not executed, zero when the enclave is up to date or when the
+	// batches could not be read
+	StoppedAtSeqNo uint64
+	StopReason     BatchExecutionStop
+}
+
+// SecretDisclosureRecord - the audit record of a secret request processed by the enclave, approved or denied. It holds
+// no secret material.
+type SecretDisclosureRecord struct {
+	ID              uint64             // the position in the audit log
+	RequesterID     gethcommon.Address // the enclave ID claimed by the attestation, zero when it could not be decoded
+	AttestationHash gethcommon.Hash    // the keccak256 of the encoded attestation report
+	L1BlockHash     L1BlockHash
+	L1BlockHeight   uint64
+	L1TxHash        TxHash
+	Timestamp       time.Time // when the enclave processed the request
+	Approved        bool
+	Reason          string // why the request was denied, empty when it was approved
+}
+
 // ProducedSecretResponse contains the data to publish to L1 in response to a secret request discovered while processing an L1 block
 type ProducedSecretResponse struct {
 	Secret      []byte
@@ -158,6 +539,17 @@ type ProducedSecretResponse struct {
 	HostAddress string
 }
 
+// EnclavePublicConfig - the network data known to the enclave that clients need to configure themselves. The values
+// which are not known yet (e.g. a contract that is not deployed) are zero.
 type EnclavePublicConfig struct {
 	L2MessageBusAddress gethcommon.Address
+	L1MessageBusAddress gethcommon.Address
+	BridgeAddress       gethcommon.Address            // the L2 bridge contract
+	SystemContracts     map[string]gethcommon.Address // the contracts deployed by the enclave, by name
+	L2ChainID           int64
+	// The network parameters in force at the L1 head, see NetworkParameters
+	MinGasPrice       *big.Int
+	BaseFee           *big.Int
+	GasPaymentAddress gethcommon.Address
+	Version           string // the enclave software version
 }
@@ -0,0 +1,126 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnsupportedEnclaveFeature - the enclave is too old for the call
+var ErrUnsupportedEnclaveFeature = errors.New("the enclave API version does not support the feature")
+
+// EnclaveAPIVersion - the revision of the enclave interface. The host negotiates it when it connects to the enclave, so
+// the host and the enclave do not have to be upgraded in lockstep.
+type EnclaveAPIVersion struct {
+	Major    uint32 // incremented by the changes which break the older hosts
+	Minor    uint32 // incremented when a feature is added
+	Patch    uint32
+	Features EnclaveFeatures
+}
+
+// EnclaveFeatures - a bitmap of the optional parts of the enclave interface
+type EnclaveFeatures uint64
+
+const (
+	// FeatureL1HeaderPreSubmission - SubmitL1Headers, to find the fork point before submitting L1 blocks in bulk
+	FeatureL1HeaderPreSubmission EnclaveFeatures = 1 << iota
+	// FeatureStructuredHealth - HealthCheck reports the health of each component
+	FeatureStructuredHealth
+	// FeatureBatchRanges - GetBatchesBySeqRange
+	FeatureBatchRanges
+	// FeatureNewHeads - SubscribeNewHeads
+	FeatureNewHeads
+	// FeatureBatchHashConversion - ConvertBatchHash
+	FeatureBatchHashConversion
+	// FeatureBatchProductionPause - PauseBatchProduction and ResumeBatchProduction
+	FeatureBatchProductionPause
+	// FeatureStateDivergenceReports - GetStateDivergenceReports
+	FeatureStateDivergenceReports
+	// FeatureSecretDisclosureAudit - GetSecretDisclosures
+	FeatureSecretDisclosureAudit
+	// FeatureRollupCompressionStats - GetRollupCompressionStats
+	FeatureRollupCompressionStats
+	// FeatureCompressedL2Updates - StreamL2Updates compresses the batches and their logs when the host asks for it
+	FeatureCompressedL2Updates
+	// FeatureEnclaveIDProof - EnclaveIDProof
+	FeatureEnclaveIDProof
+	// FeatureConfigIntrospection - GetEnclaveConfiguration
+	FeatureConfigIntrospection
+	// FeatureBatchExecutionSummaries - GetBatchExecutionSummary and GetBatchExecutionSummaries
+	FeatureBatchExecutionSummaries
+	// FeatureStateSnapshots - ExportStateSnapshot and ImportStateSnapshot
+	FeatureStateSnapshots
+	// FeatureNetworkInfo - GetNetworkInfo
+	FeatureNetworkInfo
+	// FeatureRollupReprocessing - ReprocessRollup
+	FeatureRollupReprocessing
+	// FeatureComponentLogLevels - SetLogLevel
+	FeatureComponentLogLevels
+)
+
+var featureNames = map[EnclaveFeatures]string{
+	FeatureL1HeaderPreSubmission:   "L1 header pre-submission",
+	FeatureStructuredHealth:        "structured health",
+	FeatureBatchRanges:             "batch ranges",
+	FeatureNewHeads:                "newHeads subscriptions",
+	FeatureBatchHashConversion:     "batch hash conversion",
+	FeatureBatchProductionPause:    "batch production pause",
+	FeatureStateDivergenceReports:  "state divergence reports",
+	FeatureSecretDisclosureAudit:   "secret disclosure audit",
+	FeatureRollupCompressionStats:  "rollup compression stats",
+	FeatureCompressedL2Updates:     "compressed L2 updates",
+	FeatureEnclaveIDProof:          "enclave ID proof",
+	FeatureConfigIntrospection:     "config introspection",
+	FeatureBatchExecutionSummaries: "batch execution summaries",
+	FeatureStateSnapshots:          "state snapshots",
+	FeatureNetworkInfo:             "network info",
+	FeatureRollupReprocessing:      "rollup reprocessing",
+	FeatureComponentLogLevels:      "component log levels",
+}
+
+// CurrentEnclaveAPIVersion - the version implemented by this enclave, and expected by this host
+var CurrentEnclaveAPIVersion = EnclaveAPIVersion{
+	Major: 1,
+	Minor: 11,
+	Patch: 0,
+	Features: FeatureL1HeaderPreSubmission | FeatureStructuredHealth | FeatureBatchRanges | FeatureNewHeads |
+		FeatureBatchHashConversion | FeatureBatchProductionPause | FeatureStateDivergenceReports |
+		FeatureSecretDisclosureAudit | FeatureRollupCompressionStats | FeatureCompressedL2Updates | FeatureEnclaveIDProof |
+		FeatureConfigIntrospection | FeatureBatchExecutionSummaries | FeatureStateSnapshots | FeatureNetworkInfo |
+		FeatureRollupReprocessing | FeatureComponentLogLevels,
+}
+
+// LegacyEnclaveAPIVersion - the version assumed for the enclaves which predate the negotiation
+var LegacyEnclaveAPIVersion = EnclaveAPIVersion{Major: 1}
+
+// CompatibleWith - whether the two versions can talk to each other, i.e. share the major version
+func (v EnclaveAPIVersion) CompatibleWith(other EnclaveAPIVersion) bool {
+	return v.Major == other.Major
+}
+
+// Supports - whether all the given features are available
+func (v EnclaveAPIVersion) Supports(features EnclaveFeatures) bool {
+	return v.Features&features == features
+}
+
+func (v EnclaveAPIVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d (%s)", v.Major, v.Minor, v.Patch, v.Features)
+}
+
+func (f EnclaveFeatures) String() string {
+	var names []string
+	for feature := EnclaveFeatures(1); feature != 0 && feature <= f; feature <<= 1 {
+		if f&feature == 0 {
+			continue
+		}
+		name, found := featureNames[feature]
+		if !found {
+			name = fmt.Sprintf("feature %#x", uint64(feature))
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return "no features"
+	}
+	return strings.Join(names, ", ")
+}
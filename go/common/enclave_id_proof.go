@@ -0,0 +1,74 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// EnclaveIDProofDomain - the domain tag of the digest signed by the enclave key for the proofs of possession
+	EnclaveIDProofDomain = "TEN enclave ID proof"
+	// MaxEnclaveIDProofNonceSize - the largest nonce the enclave signs, in bytes
+	MaxEnclaveIDProofNonceSize = 256
+)
+
+// ErrInvalidEnclaveIDProof - the proof does not show that the enclave controls the key of its ID
+var ErrInvalidEnclaveIDProof = errors.New("invalid enclave ID proof")
+
+// EnclaveIDProof - the proof that the enclave controls the key its ID is derived from: the signature by the enclave key
+// of a nonce chosen by the verifier, who can check it without an attestation round-trip
+type EnclaveIDProof struct {
+	EnclaveID EnclaveID     `json:"enclaveID"`
+	PublicKey hexutil.Bytes `json:"publicKey"` // the compressed public key of the enclave key
+	Signature hexutil.Bytes `json:"signature"` // the [R || S || V] signature of the EnclaveIDProofDigest of the nonce
+}
+
+// EnclaveIDFromPublicKey - the ID of the enclave with the given compressed public key
+func EnclaveIDFromPublicKey(compressedPubKey []byte) EnclaveID {
+	return EnclaveID(compressedPubKey[gethcommon.HashLength-gethcommon.AddressLength:])
+}
+
+// ValidateEnclaveIDProofNonce - the nonce must not be empty, so the signature is fresh, and is capped in size, as the
+// callers of the enclave are not trusted
+func ValidateEnclaveIDProofNonce(nonce []byte) error {
+	if len(nonce) == 0 {
+		return errors.New("the nonce of the enclave ID proof is empty")
+	}
+	if len(nonce) > MaxEnclaveIDProofNonceSize {
+		return fmt.Errorf("the nonce of the enclave ID proof has %d bytes, more than the limit of %d", len(nonce), MaxEnclaveIDProofNonceSize)
+	}
+	return nil
+}
+
+// EnclaveIDProofDigest - the digest the enclave signs for the nonce. The domain tag keeps the callers from getting the
+// enclave to sign the digest of a batch or of a rollup.
+func EnclaveIDProofDigest(nonce []byte) gethcommon.Hash {
+	return crypto.Keccak256Hash([]byte(EnclaveIDProofDomain), nonce)
+}
+
+// Verify - checks that the ID is derived from the public key, and that the key signed the nonce
+func (p *EnclaveIDProof) Verify(nonce []byte) error {
+	if err := ValidateEnclaveIDProofNonce(nonce); err != nil {
+		return err
+	}
+	if len(p.PublicKey) != 33 {
+		return fmt.Errorf("%w - the public key has %d bytes, a compressed key has 33", ErrInvalidEnclaveIDProof, len(p.PublicKey))
+	}
+	if EnclaveIDFromPublicKey(p.PublicKey) != p.EnclaveID {
+		return fmt.Errorf("%w - the enclave ID %s is not derived from the public key", ErrInvalidEnclaveIDProof, p.EnclaveID)
+	}
+	if len(p.Signature) != crypto.SignatureLength {
+		return fmt.Errorf("%w - the signature has %d bytes, expected %d", ErrInvalidEnclaveIDProof, len(p.Signature), crypto.SignatureLength)
+	}
+	digest := EnclaveIDProofDigest(nonce)
+	// the recovery ID is left out, the public key is known
+	if !crypto.VerifySignature(p.PublicKey, digest.Bytes(), p.Signature[:crypto.RecoveryIDOffset]) {
+		return fmt.Errorf("%w - the nonce was not signed by the enclave key", ErrInvalidEnclaveIDProof)
+	}
+	return nil
+}
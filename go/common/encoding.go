@@ -45,3 +45,13 @@ func DecodeAttestation(encoded EncodedAttestationReport) (*AttestationReport, er
 	err := rlp.DecodeBytes(encoded, att)
 	return att, err
 }
+
+func EncodeStateSnapshot(snapshot *EncryptedStateSnapshot) ([]byte, error) {
+	return rlp.EncodeToBytes(snapshot)
+}
+
+func DecodeStateSnapshot(encoded []byte) (*EncryptedStateSnapshot, error) {
+	snapshot := new(EncryptedStateSnapshot)
+	err := rlp.DecodeBytes(encoded, snapshot)
+	return snapshot, err
+}
@@ -3,6 +3,7 @@ package errutil
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/ethereum/go-ethereum"
@@ -16,20 +17,43 @@ var (
 	ErrNotFound      = ethereum.NotFound
 	ErrAlreadyExists = errors.New("already exists")
 	ErrNoImpl        = errors.New("not implemented")
+	ErrShuttingDown  = errors.New("enclave is shutting down") // returned to the users whose request was aborted by a stop
+	ErrNotSequencer  = errors.New("the enclave is not a sequencer")
+	// ErrStateDivergence - the validator computed a different state than the one signed by the sequencer
+	ErrStateDivergence = errors.New("state divergence")
+	// ErrInvalidBatchTime - the timestamp of the batch signed by the sequencer breaks the time rules of the network
+	ErrInvalidBatchTime = errors.New("invalid batch timestamp")
+	// ErrNotReady - the enclave has no head batch yet. The callers should wait for the readiness rather than retry
+	ErrNotReady = errors.New("enclave not ready, there is no head batch yet")
+	// ErrStateUnavailable - the state of the batch is older than the states kept by a full node
+	ErrStateUnavailable = errors.New("state unavailable, archive node required")
+	// ErrExecutionTimeout - a call requested by a user ran for longer than the execution timeout of the enclave
+	ErrExecutionTimeout = errors.New("execution aborted: timeout")
+	// ErrExecutionMemoryCapExceeded - a call requested by a user used more EVM memory than the enclave allows
+	ErrExecutionMemoryCapExceeded = errors.New("execution aborted: memory limit exceeded")
 
 	// Standard errors that can be returned from block submission
 
 	ErrBlockAlreadyProcessed = errors.New("block already processed")
 	ErrBlockAncestorNotFound = errors.New("block ancestor not found")
+	ErrInvalidL1Block        = errors.New("invalid l1 block")
+	ErrForkBeyondHistory     = errors.New("l1 fork is deeper than the stored history")
+	ErrMissingL1Receipts     = errors.New("relevant l1 receipts missing")
 	ErrBlockForBatchNotFound = errors.New("block for batch not found")
 	ErrAncestorBatchNotFound = errors.New("parent for batch not found")
+	ErrGenesisMismatch       = errors.New("genesis mismatch")
 )
 
 // BlockRejectError is used as a standard format for error response from enclave for block submission errors
 // The L1 Head hash tells the host what the enclave knows as the canonical chain head, so it can feed it the appropriate block.
+// When the block was rejected because of a missing ancestor, the latest ancestor the enclave has and the number of blocks
+// missing after it are set if they are known, so the host can submit exactly the missing range.
 type BlockRejectError struct {
-	L1Head  gethcommon.Hash
-	Wrapped error
+	L1Head               gethcommon.Hash
+	LatestAncestor       gethcommon.Hash
+	LatestAncestorHeight uint64
+	MissingBlocks        uint64
+	Wrapped              error
 }
 
 func (r BlockRejectError) Error() string {
@@ -37,7 +61,11 @@ func (r BlockRejectError) Error() string {
 	if r.L1Head != (gethcommon.Hash{}) {
 		head = r.L1Head.String()
 	}
-	return fmt.Sprintf("%s l1Head=%s", r.Wrapped.Error(), head)
+	if r.LatestAncestor == (gethcommon.Hash{}) {
+		return fmt.Sprintf("%s l1Head=%s", r.Wrapped.Error(), head)
+	}
+	return fmt.Sprintf("%s l1Head=%s latestAncestor=%s latestAncestorHeight=%d missingBlocks=%d",
+		r.Wrapped.Error(), head, r.LatestAncestor, r.LatestAncestorHeight, r.MissingBlocks)
 }
 
 func (r BlockRejectError) Unwrap() error {
@@ -49,3 +77,22 @@ func (r BlockRejectError) Unwrap() error {
 func (r BlockRejectError) Is(err error) bool {
 	return strings.Contains(r.Error(), err.Error()) || errors.Is(err, r.Wrapped)
 }
+
+// StopError lists the components which failed to stop, or did not stop within the deadline
+type StopError struct {
+	Failures map[string]error
+}
+
+func (e *StopError) Error() string {
+	components := make([]string, 0, len(e.Failures))
+	for component := range e.Failures {
+		components = append(components, component)
+	}
+	sort.Strings(components)
+
+	failures := make([]string, len(components))
+	for i, component := range components {
+		failures[i] = fmt.Sprintf("%s: %s", component, e.Failures[component])
+	}
+	return fmt.Sprintf("failed to stop [%s]", strings.Join(failures, ", "))
+}
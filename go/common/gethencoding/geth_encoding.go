@@ -2,6 +2,7 @@ package gethencoding
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
@@ -9,9 +10,7 @@ import (
 	"time"
 	"unsafe"
 
-	"github.com/dgraph-io/ristretto"
-	"github.com/eko/gocache/lib/v4/cache"
-	ristretto_store "github.com/eko/gocache/store/ristretto/v4"
+	lru "github.com/hashicorp/golang-lru/v2"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common/log"
@@ -42,36 +41,46 @@ const (
 	callFieldGasPrice             = "gasprice"
 	callFieldMaxFeePerGas         = "maxfeepergas"
 	callFieldMaxPriorityFeePerGas = "maxpriorityfeepergas"
+
+	// backfillChunkSize - the number of batches read at a time when the converted hashes missing from the databases
+	// of older versions are backfilled
+	backfillChunkSize = 1000
+
+	// convertedHeaderCacheSize - the number of converted headers cached. It covers the batches executed, queried and
+	// compressed into a rollup around the head
+	convertedHeaderCacheSize = 1000
 )
 
+// ErrConvertedAncestryMismatch - the converted hash recorded for the parent of a batch is not the hash of the converted
+// header of the parent, so the converted chain would be broken
+var ErrConvertedAncestryMismatch = errors.New("the converted parent hash does not match the converted header of the parent")
+
 // EncodingService handles conversion to Geth data structures
 type EncodingService interface {
 	CreateEthHeaderForBatch(h *common.BatchHeader) (*types.Header, error)
 	CreateEthBlockFromBatch(b *core.Batch) (*types.Block, error)
+	// ConvertBatchHash accepts either the hash of a batch or the hash of the Ethereum header it is converted to, and
+	// returns both. errutil.ErrNotFound is returned when neither is known.
+	ConvertBatchHash(hash gethcommon.Hash) (common.L2BatchHash, gethcommon.Hash, error)
 }
 
 type gethEncodingServiceImpl struct {
-	// conversion is expensive. Cache the converted headers. The key is the hash of the batch.
-	gethHeaderCache *cache.Cache[*types.Header]
+	// conversion is expensive. Cache the converted headers. The key is the hash of the batch, so the batches of the
+	// forks never share an entry. The cached headers must not be modified.
+	gethHeaderCache *lru.Cache[common.L2BatchHash, *types.Header]
 
 	storage storage.Storage
 	logger  gethlog.Logger
 }
 
 func NewGethEncodingService(storage storage.Storage, logger gethlog.Logger) EncodingService {
-	// todo (tudor) figure out the best values
-	ristrettoCache, err := ristretto.NewCache(&ristretto.Config{
-		NumCounters: 1000,    // number of keys to track frequency of.
-		MaxCost:     1 << 28, // maximum cost of cache (256MB).
-		BufferItems: 64,      // number of keys per Get buffer. Todo - what is this
-	})
+	headerCache, err := lru.New[common.L2BatchHash, *types.Header](convertedHeaderCacheSize)
 	if err != nil {
 		panic(err)
 	}
-	ristrettoStore := ristretto_store.NewRistretto(ristrettoCache)
 
 	return &gethEncodingServiceImpl{
-		gethHeaderCache: cache.New[*types.Header](ristrettoStore),
+		gethHeaderCache: headerCache,
 		storage:         storage,
 		logger:          logger,
 	}
@@ -274,55 +283,155 @@ func ExtractEthCall(param interface{}) (*gethapi.TransactionArgs, error) {
 // We convert the Batch headers to Ethereum headers to be able to use the Geth EVM.
 // Special care must be taken to maintain a valid chain of these converted headers.
 func (enc *gethEncodingServiceImpl) CreateEthHeaderForBatch(h *common.BatchHeader) (*types.Header, error) {
-	// wrap in a caching layer
-	return common.GetCachedValue(enc.gethHeaderCache, enc.logger, h.Hash(), func(a any) (*types.Header, error) {
-		// deterministically calculate the private randomness that will be exposed to the EVM
-		secret, err := enc.storage.FetchSecret()
+	return enc.createEthHeaderForBatch(h, true)
+}
+
+// createEthHeaderForBatch - when backfill is set, the converted hashes missing from the databases of older versions
+// are backfilled if the one of the parent is needed
+func (enc *gethEncodingServiceImpl) createEthHeaderForBatch(h *common.BatchHeader, backfill bool) (*types.Header, error) {
+	batchHash := h.Hash()
+	if cached, found := enc.gethHeaderCache.Get(batchHash); found {
+		return cached, nil
+	}
+
+	// deterministically calculate the private randomness that will be exposed to the EVM
+	secret, err := enc.storage.FetchSecret()
+	if err != nil {
+		enc.logger.Crit("Could not fetch shared secret. Exiting.", log.ErrKey, err)
+	}
+	perBatchRandomness := crypto.CalculateRootBatchEntropy(secret[:], h.Number)
+
+	// calculate the converted hash of the parent, for a correct converted chain
+	// default to the genesis
+	convertedParentHash := common.GethGenesisParentHash
+
+	if h.SequencerOrderNo.Uint64() > common.L2GenesisSeqNo {
+		convertedParentHash, err = enc.storage.FetchConvertedHash(h.ParentHash)
+		if errors.Is(err, errutil.ErrNotFound) && backfill {
+			if err = enc.backfillConvertedHashes(); err != nil {
+				return nil, err
+			}
+			convertedParentHash, err = enc.storage.FetchConvertedHash(h.ParentHash)
+		}
 		if err != nil {
-			enc.logger.Crit("Could not fetch shared secret. Exiting.", log.ErrKey, err)
+			enc.logger.Error("Cannot find the converted value for the parent of", log.BatchSeqNoKey, h.SequencerOrderNo)
+			return nil, err
 		}
-		perBatchRandomness := crypto.CalculateRootBatchEntropy(secret[:], h.Number)
+		if err = enc.verifyConvertedParent(h, convertedParentHash); err != nil {
+			enc.logger.Error("Refusing to convert the batch", log.BatchSeqNoKey, h.SequencerOrderNo, log.ErrKey, err)
+			return nil, err
+		}
+	}
 
-		// calculate the converted hash of the parent, for a correct converted chain
-		// default to the genesis
-		convertedParentHash := common.GethGenesisParentHash
+	baseFee := uint64(0)
+	if h.BaseFee != nil {
+		baseFee = h.BaseFee.Uint64()
+	}
 
-		if h.SequencerOrderNo.Uint64() > common.L2GenesisSeqNo {
-			convertedParentHash, err = enc.storage.FetchConvertedHash(h.ParentHash)
+	gethHeader := &types.Header{
+		ParentHash:      convertedParentHash,
+		UncleHash:       gethcommon.Hash{},
+		Root:            h.Root,
+		TxHash:          h.TxHash,
+		ReceiptHash:     h.ReceiptHash,
+		Difficulty:      big.NewInt(0),
+		Number:          h.Number,
+		GasLimit:        h.GasLimit,
+		GasUsed:         h.GasUsed,
+		BaseFee:         big.NewInt(0).SetUint64(baseFee),
+		Coinbase:        h.Coinbase,
+		Time:            h.Time,
+		MixDigest:       perBatchRandomness,
+		Nonce:           types.BlockNonce{},
+		Extra:           h.SequencerOrderNo.Bytes(),
+		WithdrawalsHash: nil,
+		BlobGasUsed:     nil,
+		ExcessBlobGas:   nil,
+		Bloom:           types.Bloom{},
+	}
+	enc.gethHeaderCache.Add(batchHash, gethHeader)
+	return gethHeader, nil
+}
+
+// verifyConvertedParent - the converted parent hash recorded in the database must be the hash of the converted header
+// of the parent, when it is cached, and that header must be the one of the previous height. A mismatch is a bug of the
+// conversion, which would otherwise only surface when the converted chain is walked, e.g. by an explorer.
+func (enc *gethEncodingServiceImpl) verifyConvertedParent(h *common.BatchHeader, convertedParentHash gethcommon.Hash) error {
+	parent, found := enc.gethHeaderCache.Peek(h.ParentHash)
+	if !found {
+		return nil
+	}
+	if parent.Hash() != convertedParentHash {
+		return fmt.Errorf("batch %d records the converted parent %s, but the parent %s converts to %s - %w",
+			h.SequencerOrderNo, convertedParentHash, h.ParentHash, parent.Hash(), ErrConvertedAncestryMismatch)
+	}
+	if h.Number == nil || parent.Number.Uint64()+1 != h.Number.Uint64() {
+		return fmt.Errorf("batch %d at height %s has its parent at height %s - %w",
+			h.SequencerOrderNo, h.Number, parent.Number, ErrConvertedAncestryMismatch)
+	}
+	return nil
+}
+
+func (enc *gethEncodingServiceImpl) ConvertBatchHash(hash gethcommon.Hash) (common.L2BatchHash, gethcommon.Hash, error) {
+	batchHash, err := enc.storage.FetchBatchHashByConvertedHash(hash)
+	if err == nil {
+		return batchHash, hash, nil
+	}
+	if !errors.Is(err, errutil.ErrNotFound) {
+		return gethcommon.Hash{}, gethcommon.Hash{}, err
+	}
+
+	// the hash of a batch
+	header, err := enc.storage.FetchBatchHeader(hash)
+	if err == nil {
+		convertedHash, err := enc.storage.FetchConvertedHash(hash)
+		if errors.Is(err, errutil.ErrNotFound) {
+			ethHeader, err := enc.CreateEthHeaderForBatch(header)
 			if err != nil {
-				enc.logger.Error("Cannot find the converted value for the parent of", log.BatchSeqNoKey, h.SequencerOrderNo)
-				return nil, err
+				return gethcommon.Hash{}, gethcommon.Hash{}, err
 			}
+			convertedHash = ethHeader.Hash()
+			return hash, convertedHash, enc.storage.StoreConvertedHash(hash, convertedHash)
 		}
+		return hash, convertedHash, err
+	}
+	if !errors.Is(err, errutil.ErrNotFound) {
+		return gethcommon.Hash{}, gethcommon.Hash{}, err
+	}
 
-		baseFee := uint64(0)
-		if h.BaseFee != nil {
-			baseFee = h.BaseFee.Uint64()
-		}
+	// the converted hash of a batch stored by an older version, before the reverse lookup was possible
+	if err = enc.backfillConvertedHashes(); err != nil {
+		return gethcommon.Hash{}, gethcommon.Hash{}, err
+	}
+	batchHash, err = enc.storage.FetchBatchHashByConvertedHash(hash)
+	if err != nil {
+		return gethcommon.Hash{}, gethcommon.Hash{}, err
+	}
+	return batchHash, hash, nil
+}
 
-		gethHeader := types.Header{
-			ParentHash:      convertedParentHash,
-			UncleHash:       gethcommon.Hash{},
-			Root:            h.Root,
-			TxHash:          h.TxHash,
-			ReceiptHash:     h.ReceiptHash,
-			Difficulty:      big.NewInt(0),
-			Number:          h.Number,
-			GasLimit:        h.GasLimit,
-			GasUsed:         h.GasUsed,
-			BaseFee:         big.NewInt(0).SetUint64(baseFee),
-			Coinbase:        h.Coinbase,
-			Time:            h.Time,
-			MixDigest:       perBatchRandomness,
-			Nonce:           types.BlockNonce{},
-			Extra:           h.SequencerOrderNo.Bytes(),
-			WithdrawalsHash: nil,
-			BlobGasUsed:     nil,
-			ExcessBlobGas:   nil,
-			Bloom:           types.Bloom{},
+// backfillConvertedHashes - records the converted hashes of the batches stored by older versions. The batches are
+// converted in order, so that the converted hash of the parent is always known.
+func (enc *gethEncodingServiceImpl) backfillConvertedHashes() error {
+	for {
+		batches, err := enc.storage.FetchBatchesWithoutConvertedHash(backfillChunkSize)
+		if err != nil {
+			return fmt.Errorf("could not fetch the batches without converted hash - %w", err)
+		}
+		if len(batches) == 0 {
+			return nil
 		}
-		return &gethHeader, nil
-	})
+		for _, batch := range batches {
+			ethHeader, err := enc.createEthHeaderForBatch(batch.Header, false)
+			if err != nil {
+				return fmt.Errorf("could not convert batch %s - %w", batch.Hash(), err)
+			}
+			if err = enc.storage.StoreConvertedHash(batch.Hash(), ethHeader.Hash()); err != nil {
+				return fmt.Errorf("could not store the converted hash of batch %s - %w", batch.Hash(), err)
+			}
+		}
+		enc.logger.Info("Backfilled the converted hashes of the batches", log.BatchSeqNoKey, batches[len(batches)-1].SeqNo())
+	}
 }
 
 // The Geth "Block" type doesn't expose the header directly.
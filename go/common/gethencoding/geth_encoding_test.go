@@ -0,0 +1,217 @@
+package gethencoding
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+)
+
+func TestConvertBatchHash(t *testing.T) {
+	enc, storageDB := newTestEncodingService(t)
+	batches := testBatches(3)
+	convertedHashes := storeConverted(t, enc, storageDB, batches)
+
+	for i, batch := range batches {
+		batchHash, convertedHash, err := enc.ConvertBatchHash(batch.Hash())
+		require.NoError(t, err)
+		require.Equal(t, batch.Hash(), batchHash)
+		require.Equal(t, convertedHashes[i], convertedHash)
+
+		batchHash, convertedHash, err = enc.ConvertBatchHash(convertedHashes[i])
+		require.NoError(t, err)
+		require.Equal(t, batch.Hash(), batchHash)
+		require.Equal(t, convertedHashes[i], convertedHash)
+	}
+
+	_, _, err := enc.ConvertBatchHash(gethcommon.HexToHash("0x1234"))
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+}
+
+func TestConvertedHashesAreBackfilled(t *testing.T) {
+	enc, storageDB := newTestEncodingService(t)
+	batches := testBatches(3)
+	convertedHashes := storeConverted(t, enc, storageDB, batches)
+
+	// the batches stored by the older versions miss their converted hash
+	oldEnc, oldStorageDB := newTestEncodingService(t)
+	for _, batch := range batches {
+		require.NoError(t, oldStorageDB.StoreBatch(batch, gethcommon.Hash{}))
+	}
+
+	batchHash, _, err := oldEnc.ConvertBatchHash(convertedHashes[2])
+	require.NoError(t, err)
+	require.Equal(t, batches[2].Hash(), batchHash)
+	for i, batch := range batches {
+		convertedHash, err := oldStorageDB.FetchConvertedHash(batch.Hash())
+		require.NoError(t, err)
+		require.Equal(t, convertedHashes[i], convertedHash)
+	}
+}
+
+func newTestEncodingService(t *testing.T) (EncodingService, storage.Storage) {
+	logger := gethlog.New()
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	require.NoError(t, err)
+	storageDB := storage.NewStorage(backingDB, params.TestChainConfig, logger)
+	require.NoError(t, storageDB.StoreSecret(crypto.SharedEnclaveSecret{}))
+	return NewGethEncodingService(storageDB, logger), storageDB
+}
+
+// testBatches - a chain of empty batches, starting with the genesis
+func testBatches(count int) []*core.Batch {
+	batches := make([]*core.Batch, count)
+	parentHash := gethcommon.Hash{}
+	for i := range batches {
+		batches[i] = &core.Batch{Header: &common.BatchHeader{
+			ParentHash:       parentHash,
+			Number:           big.NewInt(int64(i)),
+			SequencerOrderNo: big.NewInt(int64(common.L2GenesisSeqNo) + int64(i)),
+		}}
+		parentHash = batches[i].Hash()
+	}
+	return batches
+}
+
+// storeConverted - stores the batches with their converted hash, as the current version does, and returns the hashes
+func storeConverted(t *testing.T, enc EncodingService, storageDB storage.Storage, batches []*core.Batch) []gethcommon.Hash {
+	convertedHashes := make([]gethcommon.Hash, len(batches))
+	for i, batch := range batches {
+		ethHeader, err := enc.CreateEthHeaderForBatch(batch.Header)
+		require.NoError(t, err)
+		convertedHashes[i] = ethHeader.Hash()
+		require.NoError(t, storageDB.StoreBatch(batch, convertedHashes[i]))
+	}
+	return convertedHashes
+}
+
+func TestConvertedHeadersAreCachedAcrossForks(t *testing.T) {
+	enc, storageDB := newTestEncodingService(t)
+	counting := &countingStorage{Storage: storageDB}
+	enc.(*gethEncodingServiceImpl).storage = counting
+	batches := testBatches(3)
+	storeConverted(t, enc, storageDB, batches)
+
+	// a sibling of the last batch, on a fork, and its child
+	fork := forkBatches(batches[1], 2)
+	forkHashes := storeConverted(t, enc, storageDB, fork)
+	canonical, err := enc.CreateEthHeaderForBatch(batches[2].Header)
+	require.NoError(t, err)
+	require.NotEqual(t, canonical.Hash(), forkHashes[0])
+	require.Equal(t, canonical.ParentHash, mustConvert(t, enc, fork[0]).ParentHash)
+	forkChild, err := enc.CreateEthHeaderForBatch(fork[1].Header)
+	require.NoError(t, err)
+	require.Equal(t, forkHashes[0], forkChild.ParentHash)
+
+	// the converted headers are served from the cache, without reading the database
+	reads := counting.convertedHashReads
+	for _, batch := range append(batches, fork...) {
+		_, err = enc.CreateEthHeaderForBatch(batch.Header)
+		require.NoError(t, err)
+	}
+	require.Equal(t, reads, counting.convertedHashReads)
+}
+
+func TestConvertedAncestryMismatchIsDetected(t *testing.T) {
+	enc, storageDB := newTestEncodingService(t)
+	batches := testBatches(3)
+	storeConverted(t, enc, storageDB, batches[:2])
+	require.NoError(t, storageDB.StoreBatch(batches[2], gethcommon.Hash{}))
+
+	// the database records a wrong converted hash for the parent
+	require.NoError(t, storageDB.StoreConvertedHash(batches[1].Hash(), gethcommon.HexToHash("0xbad")))
+	_, err := enc.CreateEthHeaderForBatch(batches[2].Header)
+	require.ErrorIs(t, err, ErrConvertedAncestryMismatch)
+
+	// the parent linkage skips a height
+	require.NoError(t, storageDB.StoreConvertedHash(batches[1].Hash(), mustConvert(t, enc, batches[1]).Hash()))
+	skipping := *batches[2].Header
+	skipping.Number = big.NewInt(5)
+	_, err = enc.CreateEthHeaderForBatch(&skipping)
+	require.ErrorIs(t, err, ErrConvertedAncestryMismatch)
+
+	_, err = enc.CreateEthHeaderForBatch(batches[2].Header)
+	require.NoError(t, err)
+}
+
+// BenchmarkCreateEthHeaderForBatch - the conversion of the same batch header, as done when a batch is submitted, executed
+// and streamed. Without the cache, every conversion reads the secret and the converted hash of the parent.
+func BenchmarkCreateEthHeaderForBatch(b *testing.B) {
+	logger := gethlog.New()
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	if err != nil {
+		b.Fatal(err)
+	}
+	storageDB := storage.NewStorage(backingDB, params.TestChainConfig, logger)
+	if err = storageDB.StoreSecret(crypto.SharedEnclaveSecret{}); err != nil {
+		b.Fatal(err)
+	}
+	enc := NewGethEncodingService(storageDB, logger).(*gethEncodingServiceImpl)
+	batches := testBatches(2)
+	for _, batch := range batches {
+		ethHeader, err := enc.CreateEthHeaderForBatch(batch.Header)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err = storageDB.StoreBatch(batch, ethHeader.Hash()); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	for name, purge := range map[string]bool{"cached": false, "uncached": true} {
+		b.Run(name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				if purge {
+					enc.gethHeaderCache.Remove(batches[1].Hash())
+				}
+				if _, err := enc.CreateEthHeaderForBatch(batches[1].Header); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// countingStorage - counts the reads of the converted hashes
+type countingStorage struct {
+	storage.Storage
+	convertedHashReads int
+}
+
+func (s *countingStorage) FetchConvertedHash(hash common.L2BatchHash) (gethcommon.Hash, error) {
+	s.convertedHashReads++
+	return s.Storage.FetchConvertedHash(hash)
+}
+
+// forkBatches - a chain of empty batches following the parent, which differ from the ones of testBatches
+func forkBatches(parent *core.Batch, count int) []*core.Batch {
+	batches := make([]*core.Batch, count)
+	parentHash := parent.Hash()
+	for i := range batches {
+		height := parent.Number().Int64() + int64(i) + 1
+		batches[i] = &core.Batch{Header: &common.BatchHeader{
+			ParentHash:       parentHash,
+			Number:           big.NewInt(height),
+			SequencerOrderNo: big.NewInt(int64(common.L2GenesisSeqNo) + 100 + int64(i)),
+			Time:             1,
+		}}
+		parentHash = batches[i].Hash()
+	}
+	return batches
+}
+
+func mustConvert(t *testing.T, enc EncodingService, batch *core.Batch) *types.Header {
+	ethHeader, err := enc.CreateEthHeaderForBatch(batch.Header)
+	require.NoError(t, err)
+	return ethHeader
+}
@@ -44,6 +44,9 @@ type BatchHeader struct {
 	LatestInboundCrossChainHash   common.Hash                           `json:"inboundCrossChainHash"`   // The block hash of the latest block that has been scanned for cross chain messages.
 	LatestInboundCrossChainHeight *big.Int                              `json:"inboundCrossChainHeight"` // The block height of the latest block that has been scanned for cross chain messages.
 	TransfersTree                 common.Hash                           `json:"transfersTree"`           // This is a merkle tree of all of the outbound value transfers for the MainNet
+	// SyntheticTxCount - the number of synthetic transactions executed for the inbound cross chain messages and value
+	// transfers. The ones which did not fit in the synthetic gas budget of the batch are executed by the next batches.
+	SyntheticTxCount uint64 `json:"syntheticTxCount" rlp:"optional"`
 }
 
 type batchHeaderEncoding struct {
@@ -68,6 +71,7 @@ type batchHeaderEncoding struct {
 	LatestInboundCrossChainHash   common.Hash                           `json:"inboundCrossChainHash"`   // The block hash of the latest block that has been scanned for cross chain messages.
 	LatestInboundCrossChainHeight *hexutil.Big                          `json:"inboundCrossChainHeight"` // The block height of the latest block that has been scanned for cross chain messages.
 	TransfersTree                 common.Hash
+	SyntheticTxCount              hexutil.Uint64 `json:"syntheticTxCount"`
 }
 
 // MarshalJSON custom marshals the BatchHeader into a json
@@ -93,6 +97,7 @@ func (b *BatchHeader) MarshalJSON() ([]byte, error) {
 		b.LatestInboundCrossChainHash,
 		(*hexutil.Big)(b.LatestInboundCrossChainHeight),
 		b.TransfersTree,
+		hexutil.Uint64(b.SyntheticTxCount),
 	})
 }
 
@@ -122,6 +127,7 @@ func (b *BatchHeader) UnmarshalJSON(data []byte) error {
 	b.LatestInboundCrossChainHash = dec.LatestInboundCrossChainHash
 	b.LatestInboundCrossChainHeight = (*big.Int)(dec.LatestInboundCrossChainHeight)
 	b.TransfersTree = dec.TransfersTree
+	b.SyntheticTxCount = uint64(dec.SyntheticTxCount)
 	return nil
 }
 
@@ -160,6 +166,12 @@ type CalldataRollupHeader struct {
 	// BatchHeaders []*BatchHeader
 
 	ReOrgs [][]byte `rlp:"optional"` // sparse list of reorged headers - non null only for reorgs.
+
+	L1GasPrices []*big.Int `rlp:"optional"` // the l1 gas price used to charge the publishing cost of the transactions of each batch
+
+	// GenesisHash - the hash of the genesis batch, when the rollup starts with it, so the validators can check the genesis
+	// they recreate. Empty in the rollups published before it was added.
+	GenesisHash L2BatchHash `rlp:"optional"`
 }
 
 // MarshalJSON custom marshals the RollupHeader into a json
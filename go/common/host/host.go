@@ -1,6 +1,7 @@
 package host
 
 import (
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ten-protocol/go-ten/go/common"
@@ -21,7 +22,9 @@ type Host interface {
 	SubmitAndBroadcastTx(encryptedParams common.EncryptedParamsSendRawTx) (*responses.RawTx, error)
 	// Subscribe feeds logs matching the encrypted log subscription to the matchedLogs channel.
 	Subscribe(id rpc.ID, encryptedLogSubscription common.EncryptedParamsLogSubscription, matchedLogs chan []byte) error
-	// Unsubscribe terminates a log subscription between the host and the enclave.
+	// SubscribeNewHeads feeds the JSON-encoded converted header of each new head batch to the heads channel.
+	SubscribeNewHeads(id rpc.ID, heads chan []byte) error
+	// Unsubscribe terminates a log or newHeads subscription between the host and the enclave.
 	Unsubscribe(id rpc.ID)
 	// Stop gracefully stops the host execution.
 	Stop() error
@@ -31,6 +34,12 @@ type Host interface {
 
 	// ObscuroConfig returns the info of the Obscuro network
 	ObscuroConfig() (*common.ObscuroNetworkInfo, error)
+	// NetworkInfo returns the static info of the network served by the enclave, or the one of the host config when the
+	// enclave cannot serve it
+	NetworkInfo() *common.NetworkInfo
+	// ExportStateSnapshot writes the state snapshot of the rollup, exported for the enclave of the validator which
+	// requested the secret on L1, to the file
+	ExportStateSnapshot(rollupHash common.L2RollupHash, requesterID gethcommon.Address, path string) (*common.StateSnapshotInfo, error)
 }
 
 type BlockStream struct {
@@ -1,5 +1,7 @@
 package host
 
+import "github.com/ten-protocol/go-ten/go/common"
+
 // HealthStatus is an interface supported by all Services on the host
 type HealthStatus interface {
 	OK() bool
@@ -10,6 +12,9 @@ type HealthStatus interface {
 type HealthCheck struct {
 	OverallHealth bool
 	Errors        []string
+	Enclave       *common.HealthStatus // the health of each enclave component, nil if the enclave could not be reached
+	TxPool        *common.TxPoolStatus // the occupancy of the enclave mempool, nil if the enclave could not be reached
+	Replay        *common.ReplayStatus // the replay of the batches whose state was lost, nil if the enclave did not replay batches
 }
 
 // BasicErrHealthStatus is a simple health status implementation, if the ErrMsg is non-empty then OK() returns false
@@ -24,3 +29,11 @@ func (l *BasicErrHealthStatus) OK() bool {
 func (l *BasicErrHealthStatus) Message() string {
 	return l.ErrMsg
 }
+
+// EnclaveHealthStatus is the health status of the enclave service, with the health reported by the enclave itself
+type EnclaveHealthStatus struct {
+	BasicErrHealthStatus
+	Enclave *common.HealthStatus
+	TxPool  *common.TxPoolStatus
+	Replay  *common.ReplayStatus
+}
@@ -39,8 +39,8 @@ type Service interface {
 type P2P interface {
 	// BroadcastBatches sends live batch(es) to every other node on the network
 	BroadcastBatches(batches []*common.ExtBatch) error
-	// SendTxToSequencer sends the encrypted transaction to the sequencer.
-	SendTxToSequencer(tx common.EncryptedTx) error
+	// SendTxToSequencer sends a transaction validated by our enclave, encrypted for the enclaves only, to the sequencer.
+	SendTxToSequencer(tx common.EncryptedForwardedTx) error
 
 	// RequestBatchesFromSequencer asynchronously requests batches from the sequencer, from the given sequence number
 	RequestBatchesFromSequencer(fromSeqNo *big.Int) error
@@ -68,7 +68,7 @@ type P2PBatchHandler interface {
 // P2PTxHandler is an interface for receiving new transactions from the P2P network as they arrive
 type P2PTxHandler interface {
 	// HandleTransaction will be called in a new goroutine for each new tx as it arrives
-	HandleTransaction(tx common.EncryptedTx)
+	HandleTransaction(tx common.EncryptedForwardedTx)
 }
 
 type P2PBatchRequestHandler interface {
@@ -82,11 +82,15 @@ type L1BlockRepository interface {
 	Subscribe(handler L1BlockHandler) func()
 
 	FetchBlockByHeight(height *big.Int) (*types.Block, error)
+	// FetchBlock returns the block with the given hash
+	FetchBlock(hash gethcommon.Hash) (*types.Block, error)
 	// FetchNextBlock returns the next canonical block after a given block hash
 	// It returns the new block, a bool which is true if the block is the current L1 head and a bool if the block is on a different fork to prevBlock
 	FetchNextBlock(prevBlock gethcommon.Hash) (*types.Block, bool, error)
 	// FetchObscuroReceipts returns the receipts for a given L1 block
 	FetchObscuroReceipts(block *common.L1Block) (types.Receipts, error)
+	// FetchObscuroReceiptProofs returns the proofs of the relevant receipts for a given L1 block
+	FetchObscuroReceiptProofs(block *common.L1Block) (common.L1ReceiptProofs, error)
 }
 
 // L1BlockHandler is an interface for receiving new blocks from the repository as they arrive
@@ -103,6 +107,9 @@ type L1Publisher interface {
 	RequestSecret(report *common.AttestationReport) (gethcommon.Hash, error)
 	// ExtractObscuroRelevantTransactions will return all Obscuro relevant tx from an L1 block
 	ExtractObscuroRelevantTransactions(block *types.Block) ([]*ethadapter.L1RespondSecretTx, []*ethadapter.L1RollupTx, []*ethadapter.L1SetImportantContractsTx)
+	// FindAttestations returns the attestations published on L1 from the given block to the head, with which an enclave
+	// which did not process these blocks learns the attested keys
+	FindAttestations(fromBlock gethcommon.Hash) ([]*common.AttestationReport, error)
 	// PublishRollup will create and publish a rollup tx to the management contract - fire and forget we don't wait for receipt
 	// todo (#1624) - With a single sequencer, it is problematic if rollup publication fails; handle this case better
 	PublishRollup(producedRollup *common.ExtRollup)
@@ -151,12 +158,15 @@ type EnclaveService interface {
 	SubmitAndBroadcastTx(encryptedParams common.EncryptedParamsSendRawTx) (*responses.RawTx, error)
 
 	Subscribe(id rpc.ID, encryptedLogSubscription common.EncryptedParamsLogSubscription) error
+	SubscribeNewHeads(id rpc.ID) error
 	Unsubscribe(id rpc.ID) error
 }
 
 // LogSubscriptionManager provides an interface for the host to manage log subscriptions
 type LogSubscriptionManager interface {
 	Subscribe(id rpc.ID, encryptedLogSubscription common.EncryptedParamsLogSubscription, matchedLogsCh chan []byte) error
+	// SubscribeNewHeads feeds the JSON-encoded converted header of each new head batch to the heads channel
+	SubscribeNewHeads(id rpc.ID, headsCh chan []byte) error
 	Unsubscribe(id rpc.ID)
 	SendLogsToSubscribers(result *common.EncryptedSubscriptionLogs)
 }
@@ -0,0 +1,66 @@
+package log
+
+import (
+	"sync"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+// ComponentLevels - the levels at which the records of the components of a logger are logged. The records of the
+// components without a level of their own are logged at the default level. The levels can be changed at runtime.
+type ComponentLevels struct {
+	defaultLevel gethlog.Lvl
+	mutex        sync.RWMutex
+	levels       map[string]gethlog.Lvl
+}
+
+func NewComponentLevels(defaultLevel int) *ComponentLevels {
+	return &ComponentLevels{
+		defaultLevel: gethlog.Lvl(defaultLevel),
+		levels:       make(map[string]gethlog.Lvl),
+	}
+}
+
+// SetLevel - logs the records of the component at the given level
+func (c *ComponentLevels) SetLevel(component string, level int) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.levels[component] = gethlog.Lvl(level)
+}
+
+// Levels - the level of each component which has a level of its own
+func (c *ComponentLevels) Levels() map[string]int {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	levels := make(map[string]int, len(c.levels))
+	for component, level := range c.levels {
+		levels[component] = int(level)
+	}
+	return levels
+}
+
+// Handler - passes the records to the handler when they are at the level of their component or more severe. The records
+// must reach it unfiltered, so the handler of the root logger is expected to let every level through.
+func (c *ComponentLevels) Handler(h gethlog.Handler) gethlog.Handler {
+	return gethlog.FilterHandler(func(r *gethlog.Record) bool {
+		return r.Lvl <= c.levelOf(r.Ctx)
+	}, h)
+}
+
+// levelOf - the level of the component of the record. The child loggers append their component to the context of their
+// parent, so the last one is the most specific.
+func (c *ComponentLevels) levelOf(ctx []interface{}) gethlog.Lvl {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	for i := len(ctx) - 2; i >= 0; i -= 2 {
+		if key, ok := ctx[i].(string); !ok || key != CmpKey {
+			continue
+		}
+		component, _ := ctx[i+1].(string)
+		if level, found := c.levels[component]; found {
+			return level
+		}
+		return c.defaultLevel
+	}
+	return c.defaultLevel
+}
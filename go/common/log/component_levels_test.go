@@ -0,0 +1,51 @@
+package log
+
+import (
+	"testing"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+// countingHandler - counts the records it is passed
+type countingHandler struct {
+	count int
+}
+
+func (h *countingHandler) Log(*gethlog.Record) error {
+	h.count++
+	return nil
+}
+
+func TestComponentLevels(t *testing.T) {
+	sink := &countingHandler{}
+	levels := NewComponentLevels(int(gethlog.LvlInfo))
+	levels.SetLevel(TxPoolCmp, int(gethlog.LvlDebug))
+
+	root := gethlog.New(CmpKey, EnclaveCmp)
+	root.SetHandler(levels.Handler(sink))
+	txPool := root.New(CmpKey, TxPoolCmp)
+	registry := root.New(CmpKey, BatchRegistryCmp)
+
+	root.Debug("filtered at the default level")
+	registry.Debug("filtered at the default level, without a level of its own")
+	txPool.Trace("filtered at the level of the component")
+	if sink.count != 0 {
+		t.Fatalf("expected the records to be filtered, %d were logged", sink.count)
+	}
+
+	root.Info("logged")
+	txPool.Debug("logged at the level of the component")
+	if sink.count != 2 {
+		t.Fatalf("expected 2 records to be logged, got %d", sink.count)
+	}
+
+	// the level is changed at runtime
+	levels.SetLevel(TxPoolCmp, int(gethlog.LvlWarn))
+	txPool.Info("filtered at the new level of the component")
+	if sink.count != 2 {
+		t.Fatalf("expected the record to be filtered at the new level, got %d records", sink.count)
+	}
+	if actual := levels.Levels(); len(actual) != 1 || actual[TxPoolCmp] != int(gethlog.LvlWarn) {
+		t.Fatalf("unexpected levels %v", actual)
+	}
+}
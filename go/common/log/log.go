@@ -26,6 +26,7 @@ const (
 	BlockHeightKey   = "block_height"
 	BlockHashKey     = "block_hash"
 	PackageKey       = "package"
+	RequestIDKey     = "request_id"
 )
 
 // Logging is grouped by the component where it was initialised
@@ -46,6 +47,28 @@ const (
 	CrossChainCmp   = "cross_chain"
 )
 
+// The components of the enclave, which can be logged at a level of their own
+const (
+	BlockProcessorCmp      = "block_processor"
+	BatchExecutorCmp       = "batch_executor"
+	BatchRegistryCmp       = "batch_registry"
+	RollupConsumerCmp      = "rollup_consumer"
+	TxPoolCmp              = "txpool"
+	SubscriptionManagerCmp = "subscription_manager"
+	EnclaveRPCCmp          = "enclave_rpc"
+)
+
+// EnclaveComponents - the components of the enclave which can be logged at a level of their own
+var EnclaveComponents = []string{
+	BlockProcessorCmp,
+	BatchExecutorCmp,
+	BatchRegistryCmp,
+	RollupConsumerCmp,
+	TxPoolCmp,
+	SubscriptionManagerCmp,
+	EnclaveRPCCmp,
+}
+
 // Used when the logger has to write to Sys.out
 const (
 	SysOut = "sys_out"
@@ -0,0 +1,37 @@
+package common
+
+import (
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// NetworkParameters - the economic parameters of the network. They start from the enclave config, and are updated by
+// the management contract.
+type NetworkParameters struct {
+	MinGasPrice       *big.Int           // the minimum gas price of the transactions accepted in the mempool
+	BaseFee           *big.Int           // the base fee of the batches
+	GasPaymentAddress gethcommon.Address // the coinbase of the batches, which receives the fees
+}
+
+// NetworkParametersUpdate - the parameters set by the management contract in an L1 block. The nil fields keep their
+// previous values.
+type NetworkParametersUpdate struct {
+	MinGasPrice       *big.Int
+	BaseFee           *big.Int
+	GasPaymentAddress *gethcommon.Address
+}
+
+// Apply - the parameters after the update
+func (p NetworkParameters) Apply(update *NetworkParametersUpdate) NetworkParameters {
+	if update.MinGasPrice != nil {
+		p.MinGasPrice = update.MinGasPrice
+	}
+	if update.BaseFee != nil {
+		p.BaseFee = update.BaseFee
+	}
+	if update.GasPaymentAddress != nil {
+		p.GasPaymentAddress = *update.GasPaymentAddress
+	}
+	return p
+}
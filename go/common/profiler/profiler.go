@@ -1,8 +1,11 @@
 package profiler
 
 import (
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"sync"
 	"time"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
@@ -16,8 +19,11 @@ const (
 )
 
 // Profiler stores the data relevant to the profiler instance
+// It can be started again after it was stopped.
 type Profiler struct {
 	port   int
+	server *http.Server // nil when the profiler is not running
+	mutex  sync.Mutex
 	logger gethlog.Logger
 }
 
@@ -26,23 +32,57 @@ func NewProfiler(port int, logger gethlog.Logger) *Profiler {
 	return &Profiler{port: port, logger: logger}
 }
 
-// Start starts the profiler
+// Start starts the profiler. It is a no-op if the profiler is already running.
 func (p *Profiler) Start() error {
-	go func() {
-		address := fmt.Sprintf("0.0.0.0:%d", p.port)
-		server := &http.Server{
-			Addr:              address,
-			ReadHeaderTimeout: 3 * time.Second,
-		}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.server != nil {
+		return nil
+	}
+
+	address := fmt.Sprintf("0.0.0.0:%d", p.port)
+	// listen before returning, so the caller knows if the port is available
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("could not start the profiler @%s. Cause: %w", address, err)
+	}
+	server := &http.Server{
+		Addr:              address,
+		ReadHeaderTimeout: 3 * time.Second,
+	}
+	p.server = server
 
+	go func() {
 		p.logger.Info(fmt.Sprintf("Profiler started @%s ", address))
-		p.logger.Info(fmt.Sprintf("%v", server.ListenAndServe()))
+		if err := server.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+			p.logger.Info(fmt.Sprintf("%v", err))
+		}
 	}()
 	return nil
 }
 
-// Stop stops the profiler
+// Stop stops the profiler. It is a no-op if the profiler is not running.
 func (p *Profiler) Stop() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.server == nil {
+		return nil
+	}
+
 	// todo graceful shutdown
-	return nil
+	err := p.server.Close()
+	p.server = nil
+	return err
+}
+
+// Running returns whether the profiler is serving
+func (p *Profiler) Running() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.server != nil
+}
+
+// Address returns the address the profiler listens on
+func (p *Profiler) Address() string {
+	return fmt.Sprintf("0.0.0.0:%d", p.port)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type PrivateQueryResponse struct {
@@ -54,6 +55,116 @@ const (
 	BatchFinal     FinalityType = "Final"
 )
 
+type CrossChainProofStatus string
+
+const (
+	CrossChainProofPending   CrossChainProofStatus = "Pending"   // the batch was not yet published to the L1 in a rollup
+	CrossChainProofPublished CrossChainProofStatus = "Published" // the batch was published in a canonical rollup
+)
+
+// CrossChainMessageProof - proves that an outbound message is part of the message tree of the batch that emitted it
+type CrossChainMessageProof struct {
+	Status      CrossChainProofStatus `json:"status"`
+	MessageHash common.Hash           `json:"messageHash"`
+	Proof       []common.Hash         `json:"proof"`
+	Root        common.Hash           `json:"root"`
+	BatchHash   common.Hash           `json:"batchHash"`
+	BatchHeight *big.Int              `json:"batchHeight"`
+	RollupHash  *common.Hash          `json:"rollupHash,omitempty"` // only set once the batch was published
+}
+
+// CrossChainMessageState - the stage reached by a cross chain message
+type CrossChainMessageState string
+
+const (
+	MessageUnknown           CrossChainMessageState = "Unknown"           // the enclave has never seen the message
+	MessagePending           CrossChainMessageState = "Pending"           // an L1->L2 message published on the L1, but not yet included in a batch
+	MessageIncludedInBatch   CrossChainMessageState = "IncludedInBatch"   // the message was included in a canonical batch
+	MessagePublishedInRollup CrossChainMessageState = "PublishedInRollup" // an L2->L1 message whose batch was published in a canonical rollup
+	MessageConsumedOnL1      CrossChainMessageState = "ConsumedOnL1"      // an L2->L1 message relayed on the L1
+)
+
+// CrossChainMessageStatus - where a cross chain message is in its lifecycle
+type CrossChainMessageStatus struct {
+	MessageHash common.Hash            `json:"messageHash"`
+	Inbound     bool                   `json:"inbound"` // true for L1->L2 messages
+	State       CrossChainMessageState `json:"state"`
+	BatchHash   *common.Hash           `json:"batchHash,omitempty"`
+	BatchHeight *big.Int               `json:"batchHeight,omitempty"`
+	RollupHash  *common.Hash           `json:"rollupHash,omitempty"`
+	L1BlockHash *common.Hash           `json:"l1BlockHash,omitempty"` // the block where an L1->L2 message was published, or an L2->L1 message consumed
+	// ConfirmationsRemaining - the number of L1 blocks still to be processed before a pending L1->L2 message can be
+	// included in a batch
+	ConfirmationsRemaining *uint64 `json:"confirmationsRemaining,omitempty"`
+}
+
+// L1FeeEstimate - the cost of publishing a transaction to the L1
+type L1FeeEstimate struct {
+	L1GasPrice    *hexutil.Big   `json:"l1GasPrice"`
+	L1Fee         *hexutil.Big   `json:"l1Fee"`         // the fee, in wei, charged on top of the execution
+	PublishingGas hexutil.Uint64 `json:"publishingGas"` // the L2 gas which pays the fee, part of the gas estimate
+}
+
+// StorageRange - a page of the storage of a contract, as returned by debug_storageRangeAt
+type StorageRange struct {
+	Storage map[common.Hash]StorageEntry `json:"storage"` // by the hash of the key
+	NextKey *common.Hash                 `json:"nextKey"` // the hash of the first key of the next page, nil on the last page
+}
+
+// StorageEntry - a slot of the storage. The key is only known when its preimage was recorded.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
+// LogRelevancyPage - a page of the relevancy decisions for the logs of a batch, as returned by debug_batchLogRelevancy
+type LogRelevancyPage struct {
+	Logs       []LogRelevancy `json:"logs"`
+	NextOffset *uint64        `json:"nextOffset"` // the offset of the first log of the next page, nil on the last page
+}
+
+// LogRelevancy - the accounts to which a log is delivered, and why. Only the addresses of the accounts are reported.
+type LogRelevancy struct {
+	TxHash    common.Hash      `json:"transactionHash"`
+	Offset    uint64           `json:"offset"` // the position of the log among the logs of the batch
+	Address   common.Address   `json:"address"`
+	Topics    []TopicRelevancy `json:"topics"`
+	Rule      RelevancyRule    `json:"rule"`
+	Public    bool             `json:"public"`    // whether every account sees the log
+	VisibleTo []common.Address `json:"visibleTo"` // the accounts which see the log, empty when it is public
+}
+
+// TopicRelevancy - whether the topic makes the log relevant to an account
+type TopicRelevancy struct {
+	Topic   common.Hash  `json:"topic"`
+	Outcome TopicOutcome `json:"outcome"`
+}
+
+type RelevancyRule string
+
+const (
+	// LifecycleEventRule - none of the topics is a user account, so the log is visible to every account
+	LifecycleEventRule RelevancyRule = "lifecycleEvent"
+	// TopicAddressRule - the log is only visible to the user accounts among its topics
+	TopicAddressRule RelevancyRule = "topicAddress"
+	// ConfiguredPublicRule - the contract configured the event as visible to every account
+	ConfiguredPublicRule RelevancyRule = "configuredPublic"
+	// ConfiguredTopic1OwnerRule - the contract configured the event as only visible to the account of its first topic
+	ConfiguredTopic1OwnerRule RelevancyRule = "configuredTopic1Owner"
+	// ConfiguredSenderRule - the contract configured the event as only visible to the sender of the transaction
+	ConfiguredSenderRule RelevancyRule = "configuredSender"
+)
+
+type TopicOutcome string
+
+const (
+	EventSignatureTopic TopicOutcome = "eventSignature" // the first topic, never an account
+	NotAnAddressTopic   TopicOutcome = "notAnAddress"   // the topic does not have the leading zeros of an address
+	NoNonceTopic        TopicOutcome = "noNonce"        // the address never sent a transaction
+	ContractTopic       TopicOutcome = "contract"       // the address has code
+	UserAccountTopic    TopicOutcome = "userAccount"    // the log is relevant to the account
+)
+
 type QueryPagination struct {
 	Offset uint64
 	Size   uint
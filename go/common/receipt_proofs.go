@@ -0,0 +1,166 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+// L1ReceiptProof - the receipt of an L1 transaction, proven by the trie nodes on the path from the receipts root of the
+// block to the consensus encoding of the receipt
+type L1ReceiptProof struct {
+	TxIndex uint64
+	Proof   [][]byte
+}
+
+type L1ReceiptProofs = []*L1ReceiptProof
+
+// L1ReceiptsToProve - the indexes of the receipts the host must prove. These are the receipts of the transactions sent
+// to the tracked addresses, unless the bloom of the header may contain events from a tracked address. A proof only shows
+// that a receipt exists, so the enclave could not tell whether a receipt with such events was withheld, and all the
+// receipts of the block must be proven instead.
+func L1ReceiptsToProve(block *L1Block, trackedAddresses []gethcommon.Address) []int {
+	txs := block.Transactions()
+	for _, address := range trackedAddresses {
+		if types.BloomLookup(block.Bloom(), address) {
+			indexes := make([]int, len(txs))
+			for idx := range txs {
+				indexes[idx] = idx
+			}
+			return indexes
+		}
+	}
+
+	indexes := make([]int, 0)
+	for idx, tx := range txs {
+		if isSentToTrackedAddress(tx, trackedAddresses) {
+			indexes = append(indexes, idx)
+		}
+	}
+	return indexes
+}
+
+func isSentToTrackedAddress(tx *types.Transaction, trackedAddresses []gethcommon.Address) bool {
+	for _, address := range trackedAddresses {
+		if tx.To() != nil && *tx.To() == address {
+			return true
+		}
+	}
+	return false
+}
+
+// ProveL1Receipts - creates the proofs of the receipts at the given indexes. The receipts must be all the receipts of
+// the block.
+func ProveL1Receipts(receipts types.Receipts, indexes []int) (L1ReceiptProofs, error) {
+	receiptsTrie := trie.NewEmpty(trie.NewDatabase(rawdb.NewMemoryDatabase(), nil))
+	var buffer bytes.Buffer
+	for i := range receipts {
+		buffer.Reset()
+		receipts.EncodeIndex(i, &buffer)
+		if err := receiptsTrie.Update(rlp.AppendUint64(nil, uint64(i)), gethcommon.CopyBytes(buffer.Bytes())); err != nil {
+			return nil, fmt.Errorf("could not build the receipts trie. Cause: %w", err)
+		}
+	}
+
+	proofs := make(L1ReceiptProofs, 0, len(indexes))
+	for _, idx := range indexes {
+		var nodes proofNodes
+		if err := receiptsTrie.Prove(rlp.AppendUint64(nil, uint64(idx)), &nodes); err != nil {
+			return nil, fmt.Errorf("could not prove receipt %d. Cause: %w", idx, err)
+		}
+		proofs = append(proofs, &L1ReceiptProof{TxIndex: uint64(idx), Proof: nodes})
+	}
+	return proofs, nil
+}
+
+// parseReceiptProofs - verifies the proofs against the receipts root of the block, and that every receipt the host had
+// to prove (see L1ReceiptsToProve) was proven
+func parseReceiptProofs(block *L1Block, proofs L1ReceiptProofs, trackedAddresses []gethcommon.Address) (*BlockAndReceipts, error) {
+	txs := block.Transactions()
+	receipts := make(L1Receipts, len(txs))
+	br := BlockAndReceipts{
+		Block:          block,
+		Receipts:       &receipts,
+		ReceiptsMap:    make(map[int]*types.Receipt, len(proofs)),
+		ProvenReceipts: true,
+	}
+
+	for _, proof := range proofs {
+		if proof.TxIndex >= uint64(len(txs)) {
+			return nil, fmt.Errorf("receipt proof for transaction %d, but the block has %d transactions", proof.TxIndex, len(txs))
+		}
+		if receipts[proof.TxIndex] != nil {
+			return nil, fmt.Errorf("duplicated receipt proof for transaction %d", proof.TxIndex)
+		}
+		receipt, err := verifyReceiptProof(block, proof)
+		if err != nil {
+			return nil, err
+		}
+		br.ReceiptsMap[int(proof.TxIndex)] = receipt
+		receipts[proof.TxIndex] = receipt
+	}
+
+	for _, idx := range L1ReceiptsToProve(block, trackedAddresses) {
+		if receipts[idx] == nil {
+			return nil, fmt.Errorf("%w. Cause: no receipt for transaction %s", errutil.ErrMissingL1Receipts, txs[idx].Hash())
+		}
+	}
+	for idx := range receipts {
+		if receipts[idx] == nil {
+			// put in a dummy receipt so that the index matches the transaction index
+			receipts[idx] = &types.Receipt{Status: types.ReceiptStatusFailed}
+		}
+	}
+	return &br, nil
+}
+
+func verifyReceiptProof(block *L1Block, proof *L1ReceiptProof) (*types.Receipt, error) {
+	nodes := memorydb.New()
+	for _, node := range proof.Proof {
+		if err := nodes.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, err
+		}
+	}
+	encoded, err := trie.VerifyProof(block.ReceiptHash(), rlp.AppendUint64(nil, proof.TxIndex), nodes)
+	if err != nil || encoded == nil {
+		return nil, fmt.Errorf("%w. Cause: invalid proof for the receipt of transaction %d", errutil.ErrInvalidL1Block, proof.TxIndex)
+	}
+
+	receipt := new(types.Receipt)
+	if err = receipt.UnmarshalBinary(encoded); err != nil {
+		return nil, fmt.Errorf("could not decode the receipt of transaction %d. Cause: %w", proof.TxIndex, err)
+	}
+	// the consensus encoding only contains the outcome of the transaction
+	tx := block.Transactions()[proof.TxIndex]
+	receipt.TxHash = tx.Hash()
+	receipt.BlockHash = block.Hash()
+	receipt.BlockNumber = block.Number()
+	receipt.TransactionIndex = uint(proof.TxIndex)
+	for _, l := range receipt.Logs {
+		l.TxHash = receipt.TxHash
+		l.TxIndex = receipt.TransactionIndex
+		l.BlockHash = receipt.BlockHash
+		l.BlockNumber = block.NumberU64()
+	}
+	return receipt, nil
+}
+
+// proofNodes - collects the trie nodes of a proof
+type proofNodes [][]byte
+
+func (n *proofNodes) Put(_ []byte, value []byte) error {
+	*n = append(*n, value)
+	return nil
+}
+
+func (n *proofNodes) Delete([]byte) error {
+	panic("not supported")
+}
@@ -0,0 +1,114 @@
+package common
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+var (
+	testBusAddress   = gethcommon.HexToAddress("0xdeadbeef")
+	testOtherAddress = gethcommon.HexToAddress("0x1234")
+)
+
+func newTestReceipt(logAddresses ...gethcommon.Address) *types.Receipt {
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: 21_000, Logs: []*types.Log{}}
+	for _, address := range logAddresses {
+		receipt.Logs = append(receipt.Logs, &types.Log{Address: address, Topics: []gethcommon.Hash{{1}}, Data: []byte{2}})
+	}
+	receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+	return receipt
+}
+
+// newTestBlock - a block with a transaction sent to the bus, and two unrelated transactions, with the given receipts
+func newTestBlock(receipts types.Receipts) *types.Block {
+	txs := types.Transactions{
+		types.NewTransaction(0, testBusAddress, big.NewInt(0), 21_000, big.NewInt(1), nil),
+		types.NewTransaction(1, testOtherAddress, big.NewInt(0), 21_000, big.NewInt(1), nil),
+		types.NewTransaction(2, testOtherAddress, big.NewInt(0), 21_000, big.NewInt(1), nil),
+	}
+	header := &types.Header{Number: big.NewInt(1), Bloom: types.CreateBloom(receipts)}
+	return types.NewBlock(header, txs, nil, receipts, trie.NewStackTrie(nil))
+}
+
+// newTestBlockWithReceipts - the transaction sent to the bus and the last one, through another contract, emit bus events
+func newTestBlockWithReceipts() (*types.Block, types.Receipts) {
+	receipts := types.Receipts{newTestReceipt(testBusAddress), newTestReceipt(testOtherAddress), newTestReceipt(testBusAddress)}
+	return newTestBlock(receipts), receipts
+}
+
+func TestReceiptProofs(t *testing.T) {
+	// no bus event, so only the receipt of the transaction sent to the bus is proven
+	receipts := types.Receipts{newTestReceipt(), newTestReceipt(testOtherAddress), newTestReceipt(testOtherAddress)}
+	block := newTestBlock(receipts)
+	tracked := []gethcommon.Address{testBusAddress}
+	require.Equal(t, []int{0}, L1ReceiptsToProve(block, tracked))
+
+	proofs, err := ProveL1Receipts(receipts, L1ReceiptsToProve(block, tracked))
+	require.NoError(t, err)
+	br, err := ParseBlockAndReceipts(block, nil, proofs, tracked)
+	require.NoError(t, err)
+	require.True(t, br.ProvenReceipts)
+	require.Len(t, *br.Receipts, 3)
+	require.Len(t, br.ReceiptsMap, 1)
+	require.Equal(t, block.Transactions()[0].Hash(), br.ReceiptsMap[0].TxHash)
+	require.Equal(t, types.ReceiptStatusFailed, (*br.Receipts)[1].Status)
+	require.Len(t, *br.SuccessfulTransactions(), 1)
+
+	// with bus events, all the receipts are proven
+	block, receipts = newTestBlockWithReceipts()
+	require.Equal(t, []int{0, 1, 2}, L1ReceiptsToProve(block, tracked))
+	proofs, err = ProveL1Receipts(receipts, L1ReceiptsToProve(block, tracked))
+	require.NoError(t, err)
+	br, err = ParseBlockAndReceipts(block, nil, proofs, tracked)
+	require.NoError(t, err)
+	require.Len(t, br.ReceiptsMap, 3)
+	require.Equal(t, block.Transactions()[2].Hash(), br.ReceiptsMap[2].TxHash)
+	require.Equal(t, testBusAddress, br.ReceiptsMap[2].Logs[0].Address)
+
+	// the full receipts are still accepted
+	_, err = ParseBlockAndReceipts(block, &receipts, nil, tracked)
+	require.NoError(t, err)
+}
+
+func TestWithheldReceiptsAreDetected(t *testing.T) {
+	receipts := types.Receipts{newTestReceipt(), newTestReceipt(testOtherAddress), newTestReceipt(testOtherAddress)}
+	block := newTestBlock(receipts)
+	tracked := []gethcommon.Address{testBusAddress}
+
+	// the transaction sent to the bus is known from the block
+	proofs, err := ProveL1Receipts(receipts, []int{1, 2})
+	require.NoError(t, err)
+	_, err = ParseBlockAndReceipts(block, nil, proofs, tracked)
+	require.True(t, errors.Is(err, errutil.ErrMissingL1Receipts), err)
+
+	// the bus event of the last receipt sets no bit of the bloom which the first receipt does not set already
+	block, receipts = newTestBlockWithReceipts()
+	proofs, err = ProveL1Receipts(receipts, []int{0, 1})
+	require.NoError(t, err)
+	_, err = ParseBlockAndReceipts(block, nil, proofs, tracked)
+	require.True(t, errors.Is(err, errutil.ErrMissingL1Receipts), err)
+
+	// a receipt proven twice does not stand for the withheld one
+	proofs, err = ProveL1Receipts(receipts, []int{0, 1, 1})
+	require.NoError(t, err)
+	_, err = ParseBlockAndReceipts(block, nil, proofs, tracked)
+	require.Error(t, err)
+}
+
+func TestTamperedReceiptProofIsRejected(t *testing.T) {
+	block, receipts := newTestBlockWithReceipts()
+	proofs, err := ProveL1Receipts(receipts, []int{0, 2})
+	require.NoError(t, err)
+
+	// the proof of a receipt presented as the proof of another one
+	proofs[1].TxIndex = 1
+	_, err = ParseBlockAndReceipts(block, nil, proofs, nil)
+	require.True(t, errors.Is(err, errutil.ErrInvalidL1Block), err)
+}
@@ -91,6 +91,53 @@ func (b *backoffStrategy) Reset() {
 	b.startTime = time.Now()
 }
 
+// NewTimeoutWithBackoffStrategy retries until the timeout duration has elapsed, doubling the initialInterval wait period
+// after each retry up to the maxInterval, so slow changes are still polled without hammering the fast ones
+func NewTimeoutWithBackoffStrategy(timeout time.Duration, initialInterval time.Duration, maxInterval time.Duration) Strategy {
+	return &timeoutWithBackoffStrategy{
+		timeout:         timeout,
+		initialInterval: initialInterval,
+		maxInterval:     maxInterval,
+	}
+}
+
+type timeoutWithBackoffStrategy struct {
+	timeout         time.Duration
+	initialInterval time.Duration
+	maxInterval     time.Duration
+
+	startTime time.Time
+	interval  time.Duration
+	attempts  uint64
+}
+
+func (t *timeoutWithBackoffStrategy) NextRetryInterval() time.Duration {
+	t.attempts++
+	interval := t.interval
+	t.interval *= 2
+	if t.interval > t.maxInterval {
+		t.interval = t.maxInterval
+	}
+	return interval
+}
+
+func (t *timeoutWithBackoffStrategy) Done() bool {
+	return time.Now().After(t.startTime.Add(t.timeout))
+}
+
+func (t *timeoutWithBackoffStrategy) Summary() string {
+	if t.Done() {
+		return fmt.Sprintf("timed out after %s (%d attempts)", t.timeout, t.attempts)
+	}
+	return fmt.Sprintf("retrying after %d attempts", t.attempts)
+}
+
+func (t *timeoutWithBackoffStrategy) Reset() {
+	t.attempts = 0
+	t.interval = t.initialInterval
+	t.startTime = time.Now()
+}
+
 // NewBackoffAndRetryForeverStrategy will keep retrying until there is a success. For the first retries it will wait the
 // durations specified by the `backoffIntervals` slice, after which it will use the `retryInterval` indefinitely
 // Note: caller can still use retry.FailFast(err) to wrap an error if it wants to break out of the retry
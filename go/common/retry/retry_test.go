@@ -80,6 +80,27 @@ func TestDoublingBackoffStrategy_DoublingIntervalsAndRespectMaxRetries(t *testin
 	assert.Equal(t, 5, count, "expected function to be called exactly 5 times before failing")
 }
 
+func TestTimeoutWithBackoffStrategy_CappedDoublingIntervalsUntilTimeout(t *testing.T) {
+	strategy := NewTimeoutWithBackoffStrategy(time.Hour, 10*time.Millisecond, 50*time.Millisecond)
+	strategy.Reset()
+
+	expectedIntervals := []time.Duration{10, 20, 40, 50, 50}
+	for _, expectedInterval := range expectedIntervals {
+		assert.Equal(t, expectedInterval*time.Millisecond, strategy.NextRetryInterval())
+	}
+	assert.False(t, strategy.Done())
+
+	var count int
+	err := Do(func() error {
+		count++
+		return fmt.Errorf("attempt number %d", count)
+	}, NewTimeoutWithBackoffStrategy(100*time.Millisecond, 10*time.Millisecond, 20*time.Millisecond))
+	if err == nil {
+		assert.Fail(t, "expected failure from hitting the timeout but no err found")
+	}
+	assert.Greater(t, count, 2, "expected the function to be retried until the timeout")
+}
+
 func TestRetryForeverWithBackoffs(t *testing.T) {
 	var count int
 	prevAttempt := time.Now()
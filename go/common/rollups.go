@@ -1,9 +1,14 @@
 package common
 
 import (
+	"math/big"
 	"sync/atomic"
+	"time"
 )
 
+// RollupCompressionStatsRetained - the number of the most recent rollup compression stats kept by the enclave
+const RollupCompressionStatsRetained = 100
+
 // ExtRollup is an encrypted form of rollup used when passing the rollup around outside an enclave.
 type ExtRollup struct {
 	Header               *RollupHeader // the fields required by the management contract
@@ -12,6 +17,82 @@ type ExtRollup struct {
 	hash                 atomic.Value
 }
 
+// PublicRollupMetadata - the public data of a rollup published on the L1, which tells which batches it covers
+type PublicRollupMetadata struct {
+	Hash              L2RollupHash
+	L1Block           L1BlockHash // the block which published the rollup
+	CompressionL1Head L1BlockHash // the l1 block the rollup was compressed against
+	CompressedSize    uint64      // the size of the compressed and encrypted batches
+	FirstBatchSeqNo   uint64
+	LastBatchSeqNo    uint64
+	R, S              *big.Int // the signature of the sequencer
+	IsCanonical       bool     // false when the block which published the rollup was reorged
+}
+
+// ExtRollupMetadata - describes the batches included in a rollup created by the sequencer, so the host can tell whether
+// another rollup is needed straight away
+type ExtRollupMetadata struct {
+	FirstBatchSeqNo  uint64
+	LastBatchSeqNo   uint64
+	CompressedSize   uint64 // see ExtRollup.CompressedSize
+	RemainingBatches uint64 // the batches after LastBatchSeqNo, which were left for the next rollup
+	SizeLimitReached bool   // whether the rollup was cut short by the maximum rollup size
+}
+
+// RollupCompressionStats - how well a rollup compressed. They are recorded by the sequencer when it creates the rollup,
+// and by the validators when they consume it, so both sides can be compared. Only sizes and durations are recorded.
+type RollupCompressionStats struct {
+	RollupHash      L2RollupHash
+	Created         bool // recorded by the sequencer which created the rollup, rather than by a validator consuming it
+	FirstBatchSeqNo uint64
+	LastBatchSeqNo  uint64
+	Batches         uint64
+	RawBytes        uint64        // the serialised calldata header and transactions
+	CompressedBytes uint64        // the compressed calldata header and transactions, before encryption
+	Duration        time.Duration // spent compressing, or decompressing
+	RecordedAt      time.Time
+}
+
+// RollupProcessingStatus - how far a validator got with the batches of a rollup published on the L1
+type RollupProcessingStatus string
+
+const (
+	RollupProcessingPending  RollupProcessingStatus = "pending"  // recorded, the batches are being stored and executed
+	RollupProcessingComplete RollupProcessingStatus = "complete" // the batches and the rollup are stored
+	RollupProcessingFailed   RollupProcessingStatus = "failed"   // retried with the next L1 block, or on request
+	RollupProcessingSkipped  RollupProcessingStatus = "skipped"  // its L1 blocks were reorged, so it is never processed
+)
+
+// RollupProcessing - the processing of a rollup by a validator. A rollup which is not complete or skipped is kept, with
+// the reason of its last failure, until a retry processes it.
+type RollupProcessing struct {
+	RollupHash L2RollupHash
+	L1Block    L1BlockHash // the block which published the rollup
+	L1Height   uint64
+	Status     RollupProcessingStatus
+	Reason     string // why the last attempt failed, or why the rollup was skipped
+	Attempts   uint64 // the failed attempts
+	UpdatedAt  time.Time
+}
+
+// Processed - whether the rollup needs no further processing
+func (p *RollupProcessing) Processed() bool {
+	return p.Status == RollupProcessingComplete || p.Status == RollupProcessingSkipped
+}
+
+// Ratio - the raw size over the compressed size
+func (s *RollupCompressionStats) Ratio() float64 {
+	if s.CompressedBytes == 0 {
+		return 0
+	}
+	return float64(s.RawBytes) / float64(s.CompressedBytes)
+}
+
+// CompressedSize - the size of the compressed and encrypted payloads, which must not exceed the maximum rollup size
+func (r *ExtRollup) CompressedSize() uint64 {
+	return uint64(len(r.CalldataRollupHeader) + len(r.BatchPayloads))
+}
+
 // Hash returns the keccak256 hash of the rollup's header.
 // The hash is computed on the first call and cached thereafter.
 func (r *ExtRollup) Hash() L2RollupHash {
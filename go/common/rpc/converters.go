@@ -1,11 +1,14 @@
 package rpc
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ten-protocol/go-ten/contracts/generated/MessageBus"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/rpc/generated"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -68,12 +71,315 @@ func FromSecretRespMsg(secretResponses []*generated.SecretResponseMsg) []*common
 	return respList
 }
 
+// FromBlockSubmissionResponseMsg - a rejected block is returned as a *errutil.BlockRejectError, the same as the enclave does
 func FromBlockSubmissionResponseMsg(msg *generated.BlockSubmissionResponseMsg) (*common.BlockSubmissionResponse, error) {
+	if msg.Error != nil {
+		return nil, FromBlockSubmissionErrorMsg(msg.Error)
+	}
 	return &common.BlockSubmissionResponse{
 		ProducedSecretResponses: FromSecretRespMsg(msg.ProducedSecretResponses),
 	}, nil
 }
 
+func ToBlockSubmissionErrorMsg(rejErr *errutil.BlockRejectError) *generated.BlockSubmissionErrorMsg {
+	return &generated.BlockSubmissionErrorMsg{
+		Cause:                rejErr.Wrapped.Error(),
+		L1Head:               rejErr.L1Head.Bytes(),
+		LatestAncestor:       rejErr.LatestAncestor.Bytes(),
+		LatestAncestorHeight: rejErr.LatestAncestorHeight,
+		MissingBlocks:        rejErr.MissingBlocks,
+	}
+}
+
+func FromBlockSubmissionErrorMsg(msg *generated.BlockSubmissionErrorMsg) *errutil.BlockRejectError {
+	return &errutil.BlockRejectError{
+		L1Head:               gethcommon.BytesToHash(msg.L1Head),
+		LatestAncestor:       gethcommon.BytesToHash(msg.LatestAncestor),
+		LatestAncestorHeight: msg.LatestAncestorHeight,
+		MissingBlocks:        msg.MissingBlocks,
+		Wrapped:              errors.New(msg.Cause),
+	}
+}
+
+func ToL1ForkPointMsg(forkPoint *common.L1ForkPoint) *generated.L1ForkPointMsg {
+	return &generated.L1ForkPointMsg{
+		Ancestor:       forkPoint.Ancestor.Bytes(),
+		AncestorHeight: forkPoint.AncestorHeight,
+		MissingBlocks:  forkPoint.MissingBlocks,
+	}
+}
+
+func FromL1ForkPointMsg(msg *generated.L1ForkPointMsg) *common.L1ForkPoint {
+	return &common.L1ForkPoint{
+		Ancestor:       gethcommon.BytesToHash(msg.Ancestor),
+		AncestorHeight: msg.AncestorHeight,
+		MissingBlocks:  msg.MissingBlocks,
+	}
+}
+
+func ToComponentHealthMsgs(components []common.ComponentHealth) []*generated.ComponentHealth {
+	msgs := make([]*generated.ComponentHealth, len(components))
+	for i, component := range components {
+		msgs[i] = &generated.ComponentHealth{
+			Name:         component.Name,
+			Healthy:      component.Healthy,
+			Error:        component.Error,
+			LatencyNanos: component.Latency.Nanoseconds(),
+			Detail:       component.Detail,
+		}
+	}
+	return msgs
+}
+
+func FromComponentHealthMsgs(msgs []*generated.ComponentHealth) []common.ComponentHealth {
+	components := make([]common.ComponentHealth, len(msgs))
+	for i, msg := range msgs {
+		components[i] = common.ComponentHealth{
+			Name:    msg.Name,
+			Healthy: msg.Healthy,
+			Error:   msg.Error,
+			Latency: time.Duration(msg.LatencyNanos),
+			Detail:  msg.Detail,
+		}
+	}
+	return components
+}
+
+func ToStateDivergenceReportMsgs(reports []*common.StateDivergenceReport) []*generated.StateDivergenceReportMsg {
+	msgs := make([]*generated.StateDivergenceReportMsg, len(reports))
+	for i, report := range reports {
+		msgs[i] = &generated.StateDivergenceReportMsg{
+			BatchHash:            report.BatchHash.Bytes(),
+			SeqNo:                report.SeqNo,
+			Height:               report.Height,
+			ComputedBatchHash:    report.ComputedBatchHash.Bytes(),
+			ExpectedStateRoot:    report.ExpectedStateRoot.Bytes(),
+			ComputedStateRoot:    report.ComputedStateRoot.Bytes(),
+			ExpectedReceiptsRoot: report.ExpectedReceiptsRoot.Bytes(),
+			ComputedReceiptsRoot: report.ComputedReceiptsRoot.Bytes(),
+			ExpectedGasUsed:      report.ExpectedGasUsed,
+			ComputedGasUsed:      report.ComputedGasUsed,
+			DetectedAt:           report.DetectedAt.UnixNano(),
+			Canonical:            report.Canonical,
+		}
+		if report.Snapshot != nil {
+			msgs[i].Snapshot = ToStateSnapshotInfoMsg(report.Snapshot)
+		}
+	}
+	return msgs
+}
+
+func FromStateDivergenceReportMsgs(msgs []*generated.StateDivergenceReportMsg) []*common.StateDivergenceReport {
+	reports := make([]*common.StateDivergenceReport, len(msgs))
+	for i, msg := range msgs {
+		reports[i] = &common.StateDivergenceReport{
+			BatchHash:            gethcommon.BytesToHash(msg.BatchHash),
+			SeqNo:                msg.SeqNo,
+			Height:               msg.Height,
+			ComputedBatchHash:    gethcommon.BytesToHash(msg.ComputedBatchHash),
+			ExpectedStateRoot:    gethcommon.BytesToHash(msg.ExpectedStateRoot),
+			ComputedStateRoot:    gethcommon.BytesToHash(msg.ComputedStateRoot),
+			ExpectedReceiptsRoot: gethcommon.BytesToHash(msg.ExpectedReceiptsRoot),
+			ComputedReceiptsRoot: gethcommon.BytesToHash(msg.ComputedReceiptsRoot),
+			ExpectedGasUsed:      msg.ExpectedGasUsed,
+			ComputedGasUsed:      msg.ComputedGasUsed,
+			DetectedAt:           time.Unix(0, msg.DetectedAt),
+			Canonical:            msg.Canonical,
+		}
+		if msg.Snapshot != nil {
+			reports[i].Snapshot = FromStateSnapshotInfoMsg(msg.Snapshot)
+		}
+	}
+	return reports
+}
+
+func ToStateSnapshotInfoMsg(info *common.StateSnapshotInfo) *generated.StateSnapshotInfoMsg {
+	return &generated.StateSnapshotInfoMsg{
+		RollupHash: info.RollupHash.Bytes(),
+		BatchHash:  info.BatchHash.Bytes(),
+		SeqNo:      info.SeqNo,
+		Height:     info.Height,
+		StateRoot:  info.StateRoot.Bytes(),
+		ExporterID: info.ExporterID.Bytes(),
+	}
+}
+
+func FromStateSnapshotInfoMsg(msg *generated.StateSnapshotInfoMsg) *common.StateSnapshotInfo {
+	if msg == nil {
+		return nil
+	}
+	return &common.StateSnapshotInfo{
+		RollupHash: gethcommon.BytesToHash(msg.RollupHash),
+		BatchHash:  gethcommon.BytesToHash(msg.BatchHash),
+		SeqNo:      msg.SeqNo,
+		Height:     msg.Height,
+		StateRoot:  gethcommon.BytesToHash(msg.StateRoot),
+		ExporterID: gethcommon.BytesToAddress(msg.ExporterID),
+	}
+}
+
+func ToNetworkInfoMsg(info *common.NetworkInfo) *generated.NetworkInfoMsg {
+	return &generated.NetworkInfoMsg{
+		ChainID:          info.ChainID,
+		NetworkVersion:   info.NetworkVersion,
+		ClientVersion:    info.ClientVersion,
+		GenesisBatchHash: info.GenesisBatchHash.Bytes(),
+	}
+}
+
+func FromNetworkInfoMsg(msg *generated.NetworkInfoMsg) *common.NetworkInfo {
+	if msg == nil {
+		return nil
+	}
+	return &common.NetworkInfo{
+		ChainID:          msg.ChainID,
+		NetworkVersion:   msg.NetworkVersion,
+		ClientVersion:    msg.ClientVersion,
+		GenesisBatchHash: gethcommon.BytesToHash(msg.GenesisBatchHash),
+	}
+}
+
+func ToSecretDisclosureRecordMsgs(records []*common.SecretDisclosureRecord) []*generated.SecretDisclosureRecordMsg {
+	msgs := make([]*generated.SecretDisclosureRecordMsg, len(records))
+	for i, record := range records {
+		msgs[i] = &generated.SecretDisclosureRecordMsg{
+			Id:              record.ID,
+			RequesterID:     record.RequesterID.Bytes(),
+			AttestationHash: record.AttestationHash.Bytes(),
+			L1BlockHash:     record.L1BlockHash.Bytes(),
+			L1BlockHeight:   record.L1BlockHeight,
+			L1TxHash:        record.L1TxHash.Bytes(),
+			Timestamp:       record.Timestamp.UnixNano(),
+			Approved:        record.Approved,
+			Reason:          record.Reason,
+		}
+	}
+	return msgs
+}
+
+func FromSecretDisclosureRecordMsgs(msgs []*generated.SecretDisclosureRecordMsg) []*common.SecretDisclosureRecord {
+	records := make([]*common.SecretDisclosureRecord, len(msgs))
+	for i, msg := range msgs {
+		records[i] = &common.SecretDisclosureRecord{
+			ID:              msg.Id,
+			RequesterID:     gethcommon.BytesToAddress(msg.RequesterID),
+			AttestationHash: gethcommon.BytesToHash(msg.AttestationHash),
+			L1BlockHash:     gethcommon.BytesToHash(msg.L1BlockHash),
+			L1BlockHeight:   msg.L1BlockHeight,
+			L1TxHash:        gethcommon.BytesToHash(msg.L1TxHash),
+			Timestamp:       time.Unix(0, msg.Timestamp),
+			Approved:        msg.Approved,
+			Reason:          msg.Reason,
+		}
+	}
+	return records
+}
+
+func ToRollupCompressionStatsMsgs(all []*common.RollupCompressionStats) []*generated.RollupCompressionStatsMsg {
+	msgs := make([]*generated.RollupCompressionStatsMsg, len(all))
+	for i, stats := range all {
+		msgs[i] = &generated.RollupCompressionStatsMsg{
+			RollupHash:      stats.RollupHash.Bytes(),
+			Created:         stats.Created,
+			FirstBatchSeqNo: stats.FirstBatchSeqNo,
+			LastBatchSeqNo:  stats.LastBatchSeqNo,
+			Batches:         stats.Batches,
+			RawBytes:        stats.RawBytes,
+			CompressedBytes: stats.CompressedBytes,
+			Duration:        int64(stats.Duration),
+			RecordedAt:      stats.RecordedAt.UnixNano(),
+		}
+	}
+	return msgs
+}
+
+func FromRollupCompressionStatsMsgs(msgs []*generated.RollupCompressionStatsMsg) []*common.RollupCompressionStats {
+	all := make([]*common.RollupCompressionStats, len(msgs))
+	for i, msg := range msgs {
+		all[i] = &common.RollupCompressionStats{
+			RollupHash:      gethcommon.BytesToHash(msg.RollupHash),
+			Created:         msg.Created,
+			FirstBatchSeqNo: msg.FirstBatchSeqNo,
+			LastBatchSeqNo:  msg.LastBatchSeqNo,
+			Batches:         msg.Batches,
+			RawBytes:        msg.RawBytes,
+			CompressedBytes: msg.CompressedBytes,
+			Duration:        time.Duration(msg.Duration),
+			RecordedAt:      time.Unix(0, msg.RecordedAt),
+		}
+	}
+	return all
+}
+
+func ToRollupProcessingMsg(processing *common.RollupProcessing) *generated.RollupProcessingMsg {
+	return &generated.RollupProcessingMsg{
+		RollupHash: processing.RollupHash.Bytes(),
+		L1Block:    processing.L1Block.Bytes(),
+		L1Height:   processing.L1Height,
+		Status:     string(processing.Status),
+		Reason:     processing.Reason,
+		Attempts:   processing.Attempts,
+		UpdatedAt:  processing.UpdatedAt.UnixNano(),
+	}
+}
+
+func FromRollupProcessingMsg(msg *generated.RollupProcessingMsg) *common.RollupProcessing {
+	if msg == nil {
+		return nil
+	}
+	return &common.RollupProcessing{
+		RollupHash: gethcommon.BytesToHash(msg.RollupHash),
+		L1Block:    gethcommon.BytesToHash(msg.L1Block),
+		L1Height:   msg.L1Height,
+		Status:     common.RollupProcessingStatus(msg.Status),
+		Reason:     msg.Reason,
+		Attempts:   msg.Attempts,
+		UpdatedAt:  time.Unix(0, msg.UpdatedAt),
+	}
+}
+
+func ToExecutedBatchSummaryMsg(summary *common.ExecutedBatchSummary) *generated.ExecutedBatchSummaryMsg {
+	return &generated.ExecutedBatchSummaryMsg{
+		SeqNo:        summary.SeqNo,
+		Height:       summary.Height,
+		BatchHash:    summary.BatchHash.Bytes(),
+		StateRoot:    summary.StateRoot.Bytes(),
+		ReceiptsRoot: summary.ReceiptsRoot.Bytes(),
+		GasUsed:      summary.GasUsed,
+		TxCount:      summary.TxCount,
+		Orphaned:     summary.Orphaned,
+	}
+}
+
+func FromExecutedBatchSummaryMsg(msg *generated.ExecutedBatchSummaryMsg) *common.ExecutedBatchSummary {
+	return &common.ExecutedBatchSummary{
+		SeqNo:        msg.SeqNo,
+		Height:       msg.Height,
+		BatchHash:    gethcommon.BytesToHash(msg.BatchHash),
+		StateRoot:    gethcommon.BytesToHash(msg.StateRoot),
+		ReceiptsRoot: gethcommon.BytesToHash(msg.ReceiptsRoot),
+		GasUsed:      msg.GasUsed,
+		TxCount:      msg.TxCount,
+		Orphaned:     msg.Orphaned,
+	}
+}
+
+func ToExecutedBatchSummaryMsgs(summaries []*common.ExecutedBatchSummary) []*generated.ExecutedBatchSummaryMsg {
+	msgs := make([]*generated.ExecutedBatchSummaryMsg, len(summaries))
+	for i, summary := range summaries {
+		msgs[i] = ToExecutedBatchSummaryMsg(summary)
+	}
+	return msgs
+}
+
+func FromExecutedBatchSummaryMsgs(msgs []*generated.ExecutedBatchSummaryMsg) []*common.ExecutedBatchSummary {
+	summaries := make([]*common.ExecutedBatchSummary, len(msgs))
+	for i, msg := range msgs {
+		summaries[i] = FromExecutedBatchSummaryMsg(msg)
+	}
+	return summaries
+}
+
 func ToCrossChainMsgs(messages []MessageBus.StructsCrossChainMessage) []*generated.CrossChainMsg {
 	generatedMessages := make([]*generated.CrossChainMsg, 0)
 
@@ -148,6 +454,7 @@ func ToBatchHeaderMsg(header *common.BatchHeader) *generated.BatchHeaderMsg {
 		Coinbase:                    header.Coinbase.Bytes(),
 		CrossChainMessages:          ToCrossChainMsgs(header.CrossChainMessages),
 		LatestInboundCrossChainHash: header.LatestInboundCrossChainHash.Bytes(),
+		SyntheticTxCount:            header.SyntheticTxCount,
 	}
 
 	if header.LatestInboundCrossChainHeight != nil {
@@ -204,6 +511,7 @@ func FromBatchHeaderMsg(header *generated.BatchHeaderMsg) *common.BatchHeader {
 		CrossChainMessages:            FromCrossChainMsgs(header.CrossChainMessages),
 		LatestInboundCrossChainHash:   gethcommon.BytesToHash(header.LatestInboundCrossChainHash),
 		LatestInboundCrossChainHeight: big.NewInt(0).SetBytes(header.LatestInboundCrossChainHeight),
+		SyntheticTxCount:              header.SyntheticTxCount,
 	}
 }
 
@@ -261,3 +569,130 @@ func FromRollupHeaderMsg(header *generated.RollupHeaderMsg) *common.RollupHeader
 		LastBatchSeqNo:     header.LastBatchSeqNo,
 	}
 }
+
+func ToRollupMetadataMsg(rollup *common.PublicRollupMetadata) *generated.RollupMetadataMsg {
+	if rollup == nil {
+		return nil
+	}
+
+	var r, s []byte
+	if rollup.R != nil && rollup.S != nil {
+		r, s = rollup.R.Bytes(), rollup.S.Bytes()
+	}
+	return &generated.RollupMetadataMsg{
+		Hash:              rollup.Hash.Bytes(),
+		L1Block:           rollup.L1Block.Bytes(),
+		CompressionL1Head: rollup.CompressionL1Head.Bytes(),
+		CompressedSize:    rollup.CompressedSize,
+		FirstBatchSeqNo:   rollup.FirstBatchSeqNo,
+		LastBatchSeqNo:    rollup.LastBatchSeqNo,
+		R:                 r,
+		S:                 s,
+		IsCanonical:       rollup.IsCanonical,
+	}
+}
+
+func FromRollupMetadataMsg(msg *generated.RollupMetadataMsg) *common.PublicRollupMetadata {
+	if msg == nil {
+		return nil
+	}
+
+	return &common.PublicRollupMetadata{
+		Hash:              gethcommon.BytesToHash(msg.Hash),
+		L1Block:           gethcommon.BytesToHash(msg.L1Block),
+		CompressionL1Head: gethcommon.BytesToHash(msg.CompressionL1Head),
+		CompressedSize:    msg.CompressedSize,
+		FirstBatchSeqNo:   msg.FirstBatchSeqNo,
+		LastBatchSeqNo:    msg.LastBatchSeqNo,
+		R:                 new(big.Int).SetBytes(msg.R),
+		S:                 new(big.Int).SetBytes(msg.S),
+		IsCanonical:       msg.IsCanonical,
+	}
+}
+
+func ToReplayStatusMsg(status *common.ReplayStatus) *generated.ReplayStatusMsg {
+	if status == nil {
+		return nil
+	}
+
+	return &generated.ReplayStatusMsg{
+		InProgress:   status.InProgress,
+		CurrentSeqNo: status.CurrentSeqNo,
+		TargetSeqNo:  status.TargetSeqNo,
+		Replayed:     status.Replayed,
+		Total:        status.Total,
+		Error:        status.Error,
+	}
+}
+
+func FromReplayStatusMsg(msg *generated.ReplayStatusMsg) *common.ReplayStatus {
+	if msg == nil {
+		return nil
+	}
+
+	return &common.ReplayStatus{
+		InProgress:   msg.InProgress,
+		CurrentSeqNo: msg.CurrentSeqNo,
+		TargetSeqNo:  msg.TargetSeqNo,
+		Replayed:     msg.Replayed,
+		Total:        msg.Total,
+		Error:        msg.Error,
+	}
+}
+
+func ToBatchExecutionSummaryMsg(summary *common.BatchExecutionSummary) *generated.BatchExecutionSummaryMsg {
+	if summary == nil {
+		return nil
+	}
+
+	return &generated.BatchExecutionSummaryMsg{
+		Executed:       summary.Executed,
+		HeadSeqNo:      summary.HeadSeqNo,
+		HeadHash:       summary.HeadHash.Bytes(),
+		StoppedAtSeqNo: summary.StoppedAtSeqNo,
+		StopReason:     string(summary.StopReason),
+	}
+}
+
+func FromBatchExecutionSummaryMsg(msg *generated.BatchExecutionSummaryMsg) *common.BatchExecutionSummary {
+	if msg == nil {
+		return nil
+	}
+
+	return &common.BatchExecutionSummary{
+		Executed:       msg.Executed,
+		HeadSeqNo:      msg.HeadSeqNo,
+		HeadHash:       gethcommon.BytesToHash(msg.HeadHash),
+		StoppedAtSeqNo: msg.StoppedAtSeqNo,
+		StopReason:     common.BatchExecutionStop(msg.StopReason),
+	}
+}
+
+func ToExtRollupMetadataMsg(metadata *common.ExtRollupMetadata) *generated.ExtRollupMetadataMsg {
+	if metadata == nil {
+		return nil
+	}
+
+	return &generated.ExtRollupMetadataMsg{
+		FirstBatchSeqNo:  metadata.FirstBatchSeqNo,
+		LastBatchSeqNo:   metadata.LastBatchSeqNo,
+		CompressedSize:   metadata.CompressedSize,
+		RemainingBatches: metadata.RemainingBatches,
+		SizeLimitReached: metadata.SizeLimitReached,
+	}
+}
+
+// FromExtRollupMetadataMsg - the enclaves which predate the metadata don't send it, in which case it is empty
+func FromExtRollupMetadataMsg(msg *generated.ExtRollupMetadataMsg) *common.ExtRollupMetadata {
+	if msg == nil {
+		return &common.ExtRollupMetadata{}
+	}
+
+	return &common.ExtRollupMetadata{
+		FirstBatchSeqNo:  msg.FirstBatchSeqNo,
+		LastBatchSeqNo:   msg.LastBatchSeqNo,
+		CompressedSize:   msg.CompressedSize,
+		RemainingBatches: msg.RemainingBatches,
+		SizeLimitReached: msg.SizeLimitReached,
+	}
+}
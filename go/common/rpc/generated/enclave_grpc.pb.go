@@ -30,8 +30,10 @@ type EnclaveProtoClient interface {
 	GenerateSecret(ctx context.Context, in *GenerateSecretRequest, opts ...grpc.CallOption) (*GenerateSecretResponse, error)
 	// Init - initialise an enclave with a seed received by another enclave
 	InitEnclave(ctx context.Context, in *InitEnclaveRequest, opts ...grpc.CallOption) (*InitEnclaveResponse, error)
-	// Init - initialise an enclave with a seed received by another enclave
+	// EnclaveID - request the EnclaveID from the enclave
 	EnclaveID(ctx context.Context, in *EnclaveIDRequest, opts ...grpc.CallOption) (*EnclaveIDResponse, error)
+	// EnclaveIDProof - the EnclaveID with the signature by the enclave key of the nonce
+	EnclaveIDProof(ctx context.Context, in *EnclaveIDProofRequest, opts ...grpc.CallOption) (*EnclaveIDProofResponse, error)
 	// SubmitL1Block - Used for the host to submit blocks to the enclave, these may be:
 	//  a. historic block - if the enclave is behind and in the process of catching up with the L1 state
 	//  b. the latest block published by the L1, to which the enclave should respond with a rollup
@@ -39,8 +41,12 @@ type EnclaveProtoClient interface {
 	// For good functioning the caller should always submit blocks ordered by height
 	// submitting a block before receiving ancestors of it, will result in it being ignored
 	SubmitL1Block(ctx context.Context, in *SubmitBlockRequest, opts ...grpc.CallOption) (*SubmitBlockResponse, error)
+	// SubmitL1Headers - header only pre-submission, used by the host to find the latest block of its chain the enclave has
+	SubmitL1Headers(ctx context.Context, in *SubmitL1HeadersRequest, opts ...grpc.CallOption) (*SubmitL1HeadersResponse, error)
 	// SubmitTx - user transactions
 	SubmitTx(ctx context.Context, in *SubmitTxRequest, opts ...grpc.CallOption) (*SubmitTxResponse, error)
+	// SubmitForwardedTx - transactions validated and forwarded by the validators
+	SubmitForwardedTx(ctx context.Context, in *SubmitForwardedTxRequest, opts ...grpc.CallOption) (*SubmitForwardedTxResponse, error)
 	// SubmitBatch submits a batch received from the sequencer for processing.
 	SubmitBatch(ctx context.Context, in *SubmitBatchRequest, opts ...grpc.CallOption) (*SubmitBatchResponse, error)
 	// ObsCall - returns the result of executing the smart contract as a user, encrypted with the
@@ -55,27 +61,81 @@ type EnclaveProtoClient interface {
 	// GetTransaction returns a transaction receipt given the transaction's signed hash, encrypted with the viewing key
 	// corresponding to the original transaction submitter
 	GetTransactionReceipt(ctx context.Context, in *GetTransactionReceiptRequest, opts ...grpc.CallOption) (*GetTransactionReceiptResponse, error)
+	// GetCrossChainMessageProof returns the merkle proof of an outbound cross chain message, encrypted with the viewing
+	// key corresponding to the message sender
+	GetCrossChainMessageProof(ctx context.Context, in *GetCrossChainMessageProofRequest, opts ...grpc.CallOption) (*GetCrossChainMessageProofResponse, error)
+	// GetCrossChainMessageStatus returns the lifecycle state of a cross chain message, encrypted with the viewing key
+	GetCrossChainMessageStatus(ctx context.Context, in *GetCrossChainMessageStatusRequest, opts ...grpc.CallOption) (*GetCrossChainMessageStatusResponse, error)
 	// GetBalance returns the address's balance on the Obscuro network, encrypted with the viewing key corresponding to
 	// the address
 	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
 	// GetCode returns the code stored at the given address in the state for the given rollup height or rollup hash
 	GetCode(ctx context.Context, in *GetCodeRequest, opts ...grpc.CallOption) (*GetCodeResponse, error)
+	// ConvertBatchHash returns the hash of a batch and the hash of its converted Ethereum header, given either of them
+	ConvertBatchHash(ctx context.Context, in *ConvertBatchHashRequest, opts ...grpc.CallOption) (*ConvertBatchHashResponse, error)
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error)
+	// SubscribeNewHeads adds a subscription to the converted headers of the new head batches
+	SubscribeNewHeads(ctx context.Context, in *SubscribeNewHeadsRequest, opts ...grpc.CallOption) (*SubscribeNewHeadsResponse, error)
 	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error)
 	// EstimateGas returns the estimation of gas used for the given transactions
 	EstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// EstimateL1Fee returns the cost of publishing the given transaction to the L1
+	EstimateL1Fee(ctx context.Context, in *EstimateL1FeeRequest, opts ...grpc.CallOption) (*EstimateL1FeeResponse, error)
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
 	// HealthCheck returns the health status of enclave + db
 	HealthCheck(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+	// GetMetrics returns the metrics of the enclave components in the Prometheus text format
+	GetMetrics(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*GetMetricsResponse, error)
+	// GetStateDivergenceReports returns the batches whose re-execution did not match the header signed by the sequencer
+	GetStateDivergenceReports(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*GetStateDivergenceReportsResponse, error)
+	// ExportStateSnapshot streams the state after the last batch of a rollup, encrypted for the requesting enclave and
+	// signed by the exporting one
+	ExportStateSnapshot(ctx context.Context, in *ExportStateSnapshotRequest, opts ...grpc.CallOption) (EnclaveProto_ExportStateSnapshotClient, error)
+	// ImportStateSnapshot initialises the empty database of a new validator with a state snapshot streamed to it
+	ImportStateSnapshot(ctx context.Context, opts ...grpc.CallOption) (EnclaveProto_ImportStateSnapshotClient, error)
+	// GetSecretDisclosures returns a page of the audit log of the secret requests
+	GetSecretDisclosures(ctx context.Context, in *GetSecretDisclosuresRequest, opts ...grpc.CallOption) (*GetSecretDisclosuresResponse, error)
+	// GetRollupCompressionStats returns the compression stats of the most recent rollups
+	GetRollupCompressionStats(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*GetRollupCompressionStatsResponse, error)
+	// GetEnclaveAPIVersion returns the revision of the enclave interface, and the features it supports
+	GetEnclaveAPIVersion(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*GetEnclaveAPIVersionResponse, error)
 	// GetBatch returns the encrypted batch based on a hash
 	GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (*GetBatchResponse, error)
 	// GetBatch returns the encrypted batch based on a hash
 	GetBatchBySeqNo(ctx context.Context, in *GetBatchBySeqNoRequest, opts ...grpc.CallOption) (*GetBatchResponse, error)
+	// GetBatchByHeight returns the encrypted canonical batch at a height
+	GetBatchByHeight(ctx context.Context, in *GetBatchByHeightRequest, opts ...grpc.CallOption) (*GetBatchResponse, error)
+	// GetBatchesBySeqRange returns the encrypted batches between two sequence numbers
+	GetBatchesBySeqRange(ctx context.Context, in *GetBatchesBySeqRangeRequest, opts ...grpc.CallOption) (*GetBatchesResponse, error)
+	// GetBatchExecutionSummary returns the summary of the execution of a batch
+	GetBatchExecutionSummary(ctx context.Context, in *GetBatchExecutionSummaryRequest, opts ...grpc.CallOption) (*GetBatchExecutionSummaryResponse, error)
+	// GetBatchExecutionSummaries returns the summaries of the executed batches between two sequence numbers
+	GetBatchExecutionSummaries(ctx context.Context, in *GetBatchesBySeqRangeRequest, opts ...grpc.CallOption) (*GetBatchExecutionSummariesResponse, error)
+	// GetRollupData returns the metadata of a published rollup
+	GetRollupData(ctx context.Context, in *GetRollupDataRequest, opts ...grpc.CallOption) (*GetRollupDataResponse, error)
+	// GetRollupByBatchSeqNo returns the metadata of the rollup which published a batch
+	GetRollupByBatchSeqNo(ctx context.Context, in *GetRollupByBatchSeqNoRequest, opts ...grpc.CallOption) (*GetRollupDataResponse, error)
 	CreateBatch(ctx context.Context, in *CreateBatchRequest, opts ...grpc.CallOption) (*CreateBatchResponse, error)
 	CreateRollup(ctx context.Context, in *CreateRollupRequest, opts ...grpc.CallOption) (*CreateRollupResponse, error)
+	// SetBatchProductionPaused pauses or resumes the batch production of the sequencer
+	SetBatchProductionPaused(ctx context.Context, in *SetBatchProductionPausedRequest, opts ...grpc.CallOption) (*SetBatchProductionPausedResponse, error)
+	// ReprocessRollup retries the processing of a rollup which failed, after the ones published before it
+	ReprocessRollup(ctx context.Context, in *ReprocessRollupRequest, opts ...grpc.CallOption) (*ReprocessRollupResponse, error)
 	DebugTraceTransaction(ctx context.Context, in *DebugTraceTransactionRequest, opts ...grpc.CallOption) (*DebugTraceTransactionResponse, error)
+	// DebugStorageRangeAt returns a page of the storage of a contract
+	DebugStorageRangeAt(ctx context.Context, in *DebugStorageRangeAtRequest, opts ...grpc.CallOption) (*DebugStorageRangeAtResponse, error)
 	StreamL2Updates(ctx context.Context, in *StreamL2UpdatesRequest, opts ...grpc.CallOption) (EnclaveProto_StreamL2UpdatesClient, error)
 	DebugEventLogRelevancy(ctx context.Context, in *DebugEventLogRelevancyRequest, opts ...grpc.CallOption) (*DebugEventLogRelevancyResponse, error)
+	// DebugBatchLogRelevancy returns the relevancy decisions for the logs of a batch
+	DebugBatchLogRelevancy(ctx context.Context, in *DebugBatchLogRelevancyRequest, opts ...grpc.CallOption) (*DebugBatchLogRelevancyResponse, error)
+	// SetProfilerEnabled starts or stops the enclave profiler at runtime
+	SetProfilerEnabled(ctx context.Context, in *SetProfilerEnabledRequest, opts ...grpc.CallOption) (*SetProfilerEnabledResponse, error)
+	// SetLogLevel changes the log level of an enclave component at runtime
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// GetEnclaveConfiguration returns the sanitized configuration of the enclave
+	GetEnclaveConfiguration(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*GetEnclaveConfigurationResponse, error)
+	// GetNetworkInfo returns the static info of the network, in plaintext and in every state of the enclave
+	GetNetworkInfo(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*GetNetworkInfoResponse, error)
 	GetTotalContractCount(ctx context.Context, in *GetTotalContractCountRequest, opts ...grpc.CallOption) (*GetTotalContractCountResponse, error)
 	GetReceiptsByAddress(ctx context.Context, in *GetReceiptsByAddressRequest, opts ...grpc.CallOption) (*GetReceiptsByAddressResponse, error)
 	GetPublicTransactionData(ctx context.Context, in *GetPublicTransactionDataRequest, opts ...grpc.CallOption) (*GetPublicTransactionDataResponse, error)
@@ -136,6 +196,15 @@ func (c *enclaveProtoClient) EnclaveID(ctx context.Context, in *EnclaveIDRequest
 	return out, nil
 }
 
+func (c *enclaveProtoClient) EnclaveIDProof(ctx context.Context, in *EnclaveIDProofRequest, opts ...grpc.CallOption) (*EnclaveIDProofResponse, error) {
+	out := new(EnclaveIDProofResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/EnclaveIDProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) SubmitL1Block(ctx context.Context, in *SubmitBlockRequest, opts ...grpc.CallOption) (*SubmitBlockResponse, error) {
 	out := new(SubmitBlockResponse)
 	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/SubmitL1Block", in, out, opts...)
@@ -145,6 +214,15 @@ func (c *enclaveProtoClient) SubmitL1Block(ctx context.Context, in *SubmitBlockR
 	return out, nil
 }
 
+func (c *enclaveProtoClient) SubmitL1Headers(ctx context.Context, in *SubmitL1HeadersRequest, opts ...grpc.CallOption) (*SubmitL1HeadersResponse, error) {
+	out := new(SubmitL1HeadersResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/SubmitL1Headers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) SubmitTx(ctx context.Context, in *SubmitTxRequest, opts ...grpc.CallOption) (*SubmitTxResponse, error) {
 	out := new(SubmitTxResponse)
 	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/SubmitTx", in, out, opts...)
@@ -154,6 +232,15 @@ func (c *enclaveProtoClient) SubmitTx(ctx context.Context, in *SubmitTxRequest,
 	return out, nil
 }
 
+func (c *enclaveProtoClient) SubmitForwardedTx(ctx context.Context, in *SubmitForwardedTxRequest, opts ...grpc.CallOption) (*SubmitForwardedTxResponse, error) {
+	out := new(SubmitForwardedTxResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/SubmitForwardedTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) SubmitBatch(ctx context.Context, in *SubmitBatchRequest, opts ...grpc.CallOption) (*SubmitBatchResponse, error) {
 	out := new(SubmitBatchResponse)
 	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/SubmitBatch", in, out, opts...)
@@ -208,6 +295,24 @@ func (c *enclaveProtoClient) GetTransactionReceipt(ctx context.Context, in *GetT
 	return out, nil
 }
 
+func (c *enclaveProtoClient) GetCrossChainMessageProof(ctx context.Context, in *GetCrossChainMessageProofRequest, opts ...grpc.CallOption) (*GetCrossChainMessageProofResponse, error) {
+	out := new(GetCrossChainMessageProofResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetCrossChainMessageProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetCrossChainMessageStatus(ctx context.Context, in *GetCrossChainMessageStatusRequest, opts ...grpc.CallOption) (*GetCrossChainMessageStatusResponse, error) {
+	out := new(GetCrossChainMessageStatusResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetCrossChainMessageStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error) {
 	out := new(GetBalanceResponse)
 	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetBalance", in, out, opts...)
@@ -226,6 +331,15 @@ func (c *enclaveProtoClient) GetCode(ctx context.Context, in *GetCodeRequest, op
 	return out, nil
 }
 
+func (c *enclaveProtoClient) ConvertBatchHash(ctx context.Context, in *ConvertBatchHashRequest, opts ...grpc.CallOption) (*ConvertBatchHashResponse, error) {
+	out := new(ConvertBatchHashResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/ConvertBatchHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error) {
 	out := new(SubscribeResponse)
 	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/Subscribe", in, out, opts...)
@@ -235,6 +349,15 @@ func (c *enclaveProtoClient) Subscribe(ctx context.Context, in *SubscribeRequest
 	return out, nil
 }
 
+func (c *enclaveProtoClient) SubscribeNewHeads(ctx context.Context, in *SubscribeNewHeadsRequest, opts ...grpc.CallOption) (*SubscribeNewHeadsResponse, error) {
+	out := new(SubscribeNewHeadsResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/SubscribeNewHeads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error) {
 	out := new(UnsubscribeResponse)
 	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/Unsubscribe", in, out, opts...)
@@ -253,6 +376,15 @@ func (c *enclaveProtoClient) EstimateGas(ctx context.Context, in *EstimateGasReq
 	return out, nil
 }
 
+func (c *enclaveProtoClient) EstimateL1Fee(ctx context.Context, in *EstimateL1FeeRequest, opts ...grpc.CallOption) (*EstimateL1FeeResponse, error) {
+	out := new(EstimateL1FeeResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/EstimateL1Fee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error) {
 	out := new(GetLogsResponse)
 	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetLogs", in, out, opts...)
@@ -271,6 +403,117 @@ func (c *enclaveProtoClient) HealthCheck(ctx context.Context, in *EmptyArgs, opt
 	return out, nil
 }
 
+func (c *enclaveProtoClient) GetMetrics(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*GetMetricsResponse, error) {
+	out := new(GetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetStateDivergenceReports(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*GetStateDivergenceReportsResponse, error) {
+	out := new(GetStateDivergenceReportsResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetStateDivergenceReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) ExportStateSnapshot(ctx context.Context, in *ExportStateSnapshotRequest, opts ...grpc.CallOption) (EnclaveProto_ExportStateSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EnclaveProto_ServiceDesc.Streams[0], "/generated.EnclaveProto/ExportStateSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &enclaveProtoExportStateSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EnclaveProto_ExportStateSnapshotClient interface {
+	Recv() (*StateSnapshotChunkMsg, error)
+	grpc.ClientStream
+}
+
+type enclaveProtoExportStateSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *enclaveProtoExportStateSnapshotClient) Recv() (*StateSnapshotChunkMsg, error) {
+	m := new(StateSnapshotChunkMsg)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *enclaveProtoClient) ImportStateSnapshot(ctx context.Context, opts ...grpc.CallOption) (EnclaveProto_ImportStateSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EnclaveProto_ServiceDesc.Streams[1], "/generated.EnclaveProto/ImportStateSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &enclaveProtoImportStateSnapshotClient{stream}
+	return x, nil
+}
+
+type EnclaveProto_ImportStateSnapshotClient interface {
+	Send(*StateSnapshotChunkMsg) error
+	CloseAndRecv() (*ImportStateSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type enclaveProtoImportStateSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *enclaveProtoImportStateSnapshotClient) Send(m *StateSnapshotChunkMsg) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *enclaveProtoImportStateSnapshotClient) CloseAndRecv() (*ImportStateSnapshotResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportStateSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *enclaveProtoClient) GetSecretDisclosures(ctx context.Context, in *GetSecretDisclosuresRequest, opts ...grpc.CallOption) (*GetSecretDisclosuresResponse, error) {
+	out := new(GetSecretDisclosuresResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetSecretDisclosures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetRollupCompressionStats(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*GetRollupCompressionStatsResponse, error) {
+	out := new(GetRollupCompressionStatsResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetRollupCompressionStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetEnclaveAPIVersion(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*GetEnclaveAPIVersionResponse, error) {
+	out := new(GetEnclaveAPIVersionResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetEnclaveAPIVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (*GetBatchResponse, error) {
 	out := new(GetBatchResponse)
 	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetBatch", in, out, opts...)
@@ -289,6 +532,60 @@ func (c *enclaveProtoClient) GetBatchBySeqNo(ctx context.Context, in *GetBatchBy
 	return out, nil
 }
 
+func (c *enclaveProtoClient) GetBatchByHeight(ctx context.Context, in *GetBatchByHeightRequest, opts ...grpc.CallOption) (*GetBatchResponse, error) {
+	out := new(GetBatchResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetBatchByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetBatchesBySeqRange(ctx context.Context, in *GetBatchesBySeqRangeRequest, opts ...grpc.CallOption) (*GetBatchesResponse, error) {
+	out := new(GetBatchesResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetBatchesBySeqRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetBatchExecutionSummary(ctx context.Context, in *GetBatchExecutionSummaryRequest, opts ...grpc.CallOption) (*GetBatchExecutionSummaryResponse, error) {
+	out := new(GetBatchExecutionSummaryResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetBatchExecutionSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetBatchExecutionSummaries(ctx context.Context, in *GetBatchesBySeqRangeRequest, opts ...grpc.CallOption) (*GetBatchExecutionSummariesResponse, error) {
+	out := new(GetBatchExecutionSummariesResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetBatchExecutionSummaries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetRollupData(ctx context.Context, in *GetRollupDataRequest, opts ...grpc.CallOption) (*GetRollupDataResponse, error) {
+	out := new(GetRollupDataResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetRollupData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetRollupByBatchSeqNo(ctx context.Context, in *GetRollupByBatchSeqNoRequest, opts ...grpc.CallOption) (*GetRollupDataResponse, error) {
+	out := new(GetRollupDataResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetRollupByBatchSeqNo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) CreateBatch(ctx context.Context, in *CreateBatchRequest, opts ...grpc.CallOption) (*CreateBatchResponse, error) {
 	out := new(CreateBatchResponse)
 	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/CreateBatch", in, out, opts...)
@@ -307,6 +604,24 @@ func (c *enclaveProtoClient) CreateRollup(ctx context.Context, in *CreateRollupR
 	return out, nil
 }
 
+func (c *enclaveProtoClient) SetBatchProductionPaused(ctx context.Context, in *SetBatchProductionPausedRequest, opts ...grpc.CallOption) (*SetBatchProductionPausedResponse, error) {
+	out := new(SetBatchProductionPausedResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/SetBatchProductionPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) ReprocessRollup(ctx context.Context, in *ReprocessRollupRequest, opts ...grpc.CallOption) (*ReprocessRollupResponse, error) {
+	out := new(ReprocessRollupResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/ReprocessRollup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) DebugTraceTransaction(ctx context.Context, in *DebugTraceTransactionRequest, opts ...grpc.CallOption) (*DebugTraceTransactionResponse, error) {
 	out := new(DebugTraceTransactionResponse)
 	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/DebugTraceTransaction", in, out, opts...)
@@ -316,8 +631,17 @@ func (c *enclaveProtoClient) DebugTraceTransaction(ctx context.Context, in *Debu
 	return out, nil
 }
 
+func (c *enclaveProtoClient) DebugStorageRangeAt(ctx context.Context, in *DebugStorageRangeAtRequest, opts ...grpc.CallOption) (*DebugStorageRangeAtResponse, error) {
+	out := new(DebugStorageRangeAtResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/DebugStorageRangeAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) StreamL2Updates(ctx context.Context, in *StreamL2UpdatesRequest, opts ...grpc.CallOption) (EnclaveProto_StreamL2UpdatesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &EnclaveProto_ServiceDesc.Streams[0], "/generated.EnclaveProto/StreamL2Updates", opts...)
+	stream, err := c.cc.NewStream(ctx, &EnclaveProto_ServiceDesc.Streams[2], "/generated.EnclaveProto/StreamL2Updates", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -357,6 +681,51 @@ func (c *enclaveProtoClient) DebugEventLogRelevancy(ctx context.Context, in *Deb
 	return out, nil
 }
 
+func (c *enclaveProtoClient) DebugBatchLogRelevancy(ctx context.Context, in *DebugBatchLogRelevancyRequest, opts ...grpc.CallOption) (*DebugBatchLogRelevancyResponse, error) {
+	out := new(DebugBatchLogRelevancyResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/DebugBatchLogRelevancy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) SetProfilerEnabled(ctx context.Context, in *SetProfilerEnabledRequest, opts ...grpc.CallOption) (*SetProfilerEnabledResponse, error) {
+	out := new(SetProfilerEnabledResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/SetProfilerEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetEnclaveConfiguration(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*GetEnclaveConfigurationResponse, error) {
+	out := new(GetEnclaveConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetEnclaveConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetNetworkInfo(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*GetNetworkInfoResponse, error) {
+	out := new(GetNetworkInfoResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetNetworkInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) GetTotalContractCount(ctx context.Context, in *GetTotalContractCountRequest, opts ...grpc.CallOption) (*GetTotalContractCountResponse, error) {
 	out := new(GetTotalContractCountResponse)
 	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetTotalContractCount", in, out, opts...)
@@ -405,8 +774,10 @@ type EnclaveProtoServer interface {
 	GenerateSecret(context.Context, *GenerateSecretRequest) (*GenerateSecretResponse, error)
 	// Init - initialise an enclave with a seed received by another enclave
 	InitEnclave(context.Context, *InitEnclaveRequest) (*InitEnclaveResponse, error)
-	// Init - initialise an enclave with a seed received by another enclave
+	// EnclaveID - request the EnclaveID from the enclave
 	EnclaveID(context.Context, *EnclaveIDRequest) (*EnclaveIDResponse, error)
+	// EnclaveIDProof - the EnclaveID with the signature by the enclave key of the nonce
+	EnclaveIDProof(context.Context, *EnclaveIDProofRequest) (*EnclaveIDProofResponse, error)
 	// SubmitL1Block - Used for the host to submit blocks to the enclave, these may be:
 	//  a. historic block - if the enclave is behind and in the process of catching up with the L1 state
 	//  b. the latest block published by the L1, to which the enclave should respond with a rollup
@@ -414,8 +785,12 @@ type EnclaveProtoServer interface {
 	// For good functioning the caller should always submit blocks ordered by height
 	// submitting a block before receiving ancestors of it, will result in it being ignored
 	SubmitL1Block(context.Context, *SubmitBlockRequest) (*SubmitBlockResponse, error)
+	// SubmitL1Headers - header only pre-submission, used by the host to find the latest block of its chain the enclave has
+	SubmitL1Headers(context.Context, *SubmitL1HeadersRequest) (*SubmitL1HeadersResponse, error)
 	// SubmitTx - user transactions
 	SubmitTx(context.Context, *SubmitTxRequest) (*SubmitTxResponse, error)
+	// SubmitForwardedTx - transactions validated and forwarded by the validators
+	SubmitForwardedTx(context.Context, *SubmitForwardedTxRequest) (*SubmitForwardedTxResponse, error)
 	// SubmitBatch submits a batch received from the sequencer for processing.
 	SubmitBatch(context.Context, *SubmitBatchRequest) (*SubmitBatchResponse, error)
 	// ObsCall - returns the result of executing the smart contract as a user, encrypted with the
@@ -430,27 +805,81 @@ type EnclaveProtoServer interface {
 	// GetTransaction returns a transaction receipt given the transaction's signed hash, encrypted with the viewing key
 	// corresponding to the original transaction submitter
 	GetTransactionReceipt(context.Context, *GetTransactionReceiptRequest) (*GetTransactionReceiptResponse, error)
+	// GetCrossChainMessageProof returns the merkle proof of an outbound cross chain message, encrypted with the viewing
+	// key corresponding to the message sender
+	GetCrossChainMessageProof(context.Context, *GetCrossChainMessageProofRequest) (*GetCrossChainMessageProofResponse, error)
+	// GetCrossChainMessageStatus returns the lifecycle state of a cross chain message, encrypted with the viewing key
+	GetCrossChainMessageStatus(context.Context, *GetCrossChainMessageStatusRequest) (*GetCrossChainMessageStatusResponse, error)
 	// GetBalance returns the address's balance on the Obscuro network, encrypted with the viewing key corresponding to
 	// the address
 	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
 	// GetCode returns the code stored at the given address in the state for the given rollup height or rollup hash
 	GetCode(context.Context, *GetCodeRequest) (*GetCodeResponse, error)
+	// ConvertBatchHash returns the hash of a batch and the hash of its converted Ethereum header, given either of them
+	ConvertBatchHash(context.Context, *ConvertBatchHashRequest) (*ConvertBatchHashResponse, error)
 	Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error)
+	// SubscribeNewHeads adds a subscription to the converted headers of the new head batches
+	SubscribeNewHeads(context.Context, *SubscribeNewHeadsRequest) (*SubscribeNewHeadsResponse, error)
 	Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error)
 	// EstimateGas returns the estimation of gas used for the given transactions
 	EstimateGas(context.Context, *EstimateGasRequest) (*EstimateGasResponse, error)
+	// EstimateL1Fee returns the cost of publishing the given transaction to the L1
+	EstimateL1Fee(context.Context, *EstimateL1FeeRequest) (*EstimateL1FeeResponse, error)
 	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
 	// HealthCheck returns the health status of enclave + db
 	HealthCheck(context.Context, *EmptyArgs) (*HealthCheckResponse, error)
+	// GetMetrics returns the metrics of the enclave components in the Prometheus text format
+	GetMetrics(context.Context, *EmptyArgs) (*GetMetricsResponse, error)
+	// GetStateDivergenceReports returns the batches whose re-execution did not match the header signed by the sequencer
+	GetStateDivergenceReports(context.Context, *EmptyArgs) (*GetStateDivergenceReportsResponse, error)
+	// ExportStateSnapshot streams the state after the last batch of a rollup, encrypted for the requesting enclave and
+	// signed by the exporting one
+	ExportStateSnapshot(*ExportStateSnapshotRequest, EnclaveProto_ExportStateSnapshotServer) error
+	// ImportStateSnapshot initialises the empty database of a new validator with a state snapshot streamed to it
+	ImportStateSnapshot(EnclaveProto_ImportStateSnapshotServer) error
+	// GetSecretDisclosures returns a page of the audit log of the secret requests
+	GetSecretDisclosures(context.Context, *GetSecretDisclosuresRequest) (*GetSecretDisclosuresResponse, error)
+	// GetRollupCompressionStats returns the compression stats of the most recent rollups
+	GetRollupCompressionStats(context.Context, *EmptyArgs) (*GetRollupCompressionStatsResponse, error)
+	// GetEnclaveAPIVersion returns the revision of the enclave interface, and the features it supports
+	GetEnclaveAPIVersion(context.Context, *EmptyArgs) (*GetEnclaveAPIVersionResponse, error)
 	// GetBatch returns the encrypted batch based on a hash
 	GetBatch(context.Context, *GetBatchRequest) (*GetBatchResponse, error)
 	// GetBatch returns the encrypted batch based on a hash
 	GetBatchBySeqNo(context.Context, *GetBatchBySeqNoRequest) (*GetBatchResponse, error)
+	// GetBatchByHeight returns the encrypted canonical batch at a height
+	GetBatchByHeight(context.Context, *GetBatchByHeightRequest) (*GetBatchResponse, error)
+	// GetBatchesBySeqRange returns the encrypted batches between two sequence numbers
+	GetBatchesBySeqRange(context.Context, *GetBatchesBySeqRangeRequest) (*GetBatchesResponse, error)
+	// GetBatchExecutionSummary returns the summary of the execution of a batch
+	GetBatchExecutionSummary(context.Context, *GetBatchExecutionSummaryRequest) (*GetBatchExecutionSummaryResponse, error)
+	// GetBatchExecutionSummaries returns the summaries of the executed batches between two sequence numbers
+	GetBatchExecutionSummaries(context.Context, *GetBatchesBySeqRangeRequest) (*GetBatchExecutionSummariesResponse, error)
+	// GetRollupData returns the metadata of a published rollup
+	GetRollupData(context.Context, *GetRollupDataRequest) (*GetRollupDataResponse, error)
+	// GetRollupByBatchSeqNo returns the metadata of the rollup which published a batch
+	GetRollupByBatchSeqNo(context.Context, *GetRollupByBatchSeqNoRequest) (*GetRollupDataResponse, error)
 	CreateBatch(context.Context, *CreateBatchRequest) (*CreateBatchResponse, error)
 	CreateRollup(context.Context, *CreateRollupRequest) (*CreateRollupResponse, error)
+	// SetBatchProductionPaused pauses or resumes the batch production of the sequencer
+	SetBatchProductionPaused(context.Context, *SetBatchProductionPausedRequest) (*SetBatchProductionPausedResponse, error)
+	// ReprocessRollup retries the processing of a rollup which failed, after the ones published before it
+	ReprocessRollup(context.Context, *ReprocessRollupRequest) (*ReprocessRollupResponse, error)
 	DebugTraceTransaction(context.Context, *DebugTraceTransactionRequest) (*DebugTraceTransactionResponse, error)
+	// DebugStorageRangeAt returns a page of the storage of a contract
+	DebugStorageRangeAt(context.Context, *DebugStorageRangeAtRequest) (*DebugStorageRangeAtResponse, error)
 	StreamL2Updates(*StreamL2UpdatesRequest, EnclaveProto_StreamL2UpdatesServer) error
 	DebugEventLogRelevancy(context.Context, *DebugEventLogRelevancyRequest) (*DebugEventLogRelevancyResponse, error)
+	// DebugBatchLogRelevancy returns the relevancy decisions for the logs of a batch
+	DebugBatchLogRelevancy(context.Context, *DebugBatchLogRelevancyRequest) (*DebugBatchLogRelevancyResponse, error)
+	// SetProfilerEnabled starts or stops the enclave profiler at runtime
+	SetProfilerEnabled(context.Context, *SetProfilerEnabledRequest) (*SetProfilerEnabledResponse, error)
+	// SetLogLevel changes the log level of an enclave component at runtime
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	// GetEnclaveConfiguration returns the sanitized configuration of the enclave
+	GetEnclaveConfiguration(context.Context, *EmptyArgs) (*GetEnclaveConfigurationResponse, error)
+	// GetNetworkInfo returns the static info of the network, in plaintext and in every state of the enclave
+	GetNetworkInfo(context.Context, *EmptyArgs) (*GetNetworkInfoResponse, error)
 	GetTotalContractCount(context.Context, *GetTotalContractCountRequest) (*GetTotalContractCountResponse, error)
 	GetReceiptsByAddress(context.Context, *GetReceiptsByAddressRequest) (*GetReceiptsByAddressResponse, error)
 	GetPublicTransactionData(context.Context, *GetPublicTransactionDataRequest) (*GetPublicTransactionDataResponse, error)
@@ -478,12 +907,21 @@ func (UnimplementedEnclaveProtoServer) InitEnclave(context.Context, *InitEnclave
 func (UnimplementedEnclaveProtoServer) EnclaveID(context.Context, *EnclaveIDRequest) (*EnclaveIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnclaveID not implemented")
 }
+func (UnimplementedEnclaveProtoServer) EnclaveIDProof(context.Context, *EnclaveIDProofRequest) (*EnclaveIDProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnclaveIDProof not implemented")
+}
 func (UnimplementedEnclaveProtoServer) SubmitL1Block(context.Context, *SubmitBlockRequest) (*SubmitBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitL1Block not implemented")
 }
+func (UnimplementedEnclaveProtoServer) SubmitL1Headers(context.Context, *SubmitL1HeadersRequest) (*SubmitL1HeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitL1Headers not implemented")
+}
 func (UnimplementedEnclaveProtoServer) SubmitTx(context.Context, *SubmitTxRequest) (*SubmitTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitTx not implemented")
 }
+func (UnimplementedEnclaveProtoServer) SubmitForwardedTx(context.Context, *SubmitForwardedTxRequest) (*SubmitForwardedTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitForwardedTx not implemented")
+}
 func (UnimplementedEnclaveProtoServer) SubmitBatch(context.Context, *SubmitBatchRequest) (*SubmitBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitBatch not implemented")
 }
@@ -502,48 +940,126 @@ func (UnimplementedEnclaveProtoServer) GetTransaction(context.Context, *GetTrans
 func (UnimplementedEnclaveProtoServer) GetTransactionReceipt(context.Context, *GetTransactionReceiptRequest) (*GetTransactionReceiptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionReceipt not implemented")
 }
+func (UnimplementedEnclaveProtoServer) GetCrossChainMessageProof(context.Context, *GetCrossChainMessageProofRequest) (*GetCrossChainMessageProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCrossChainMessageProof not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetCrossChainMessageStatus(context.Context, *GetCrossChainMessageStatusRequest) (*GetCrossChainMessageStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCrossChainMessageStatus not implemented")
+}
 func (UnimplementedEnclaveProtoServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
 }
 func (UnimplementedEnclaveProtoServer) GetCode(context.Context, *GetCodeRequest) (*GetCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCode not implemented")
 }
+func (UnimplementedEnclaveProtoServer) ConvertBatchHash(context.Context, *ConvertBatchHashRequest) (*ConvertBatchHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertBatchHash not implemented")
+}
 func (UnimplementedEnclaveProtoServer) Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
+func (UnimplementedEnclaveProtoServer) SubscribeNewHeads(context.Context, *SubscribeNewHeadsRequest) (*SubscribeNewHeadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeNewHeads not implemented")
+}
 func (UnimplementedEnclaveProtoServer) Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
 }
 func (UnimplementedEnclaveProtoServer) EstimateGas(context.Context, *EstimateGasRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (UnimplementedEnclaveProtoServer) EstimateL1Fee(context.Context, *EstimateL1FeeRequest) (*EstimateL1FeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateL1Fee not implemented")
+}
 func (UnimplementedEnclaveProtoServer) GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
 }
 func (UnimplementedEnclaveProtoServer) HealthCheck(context.Context, *EmptyArgs) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
+func (UnimplementedEnclaveProtoServer) GetMetrics(context.Context, *EmptyArgs) (*GetMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetStateDivergenceReports(context.Context, *EmptyArgs) (*GetStateDivergenceReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateDivergenceReports not implemented")
+}
+func (UnimplementedEnclaveProtoServer) ExportStateSnapshot(*ExportStateSnapshotRequest, EnclaveProto_ExportStateSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportStateSnapshot not implemented")
+}
+func (UnimplementedEnclaveProtoServer) ImportStateSnapshot(EnclaveProto_ImportStateSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportStateSnapshot not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetSecretDisclosures(context.Context, *GetSecretDisclosuresRequest) (*GetSecretDisclosuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecretDisclosures not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetRollupCompressionStats(context.Context, *EmptyArgs) (*GetRollupCompressionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRollupCompressionStats not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetEnclaveAPIVersion(context.Context, *EmptyArgs) (*GetEnclaveAPIVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaveAPIVersion not implemented")
+}
 func (UnimplementedEnclaveProtoServer) GetBatch(context.Context, *GetBatchRequest) (*GetBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBatch not implemented")
 }
 func (UnimplementedEnclaveProtoServer) GetBatchBySeqNo(context.Context, *GetBatchBySeqNoRequest) (*GetBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBatchBySeqNo not implemented")
 }
+func (UnimplementedEnclaveProtoServer) GetBatchByHeight(context.Context, *GetBatchByHeightRequest) (*GetBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchByHeight not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetBatchesBySeqRange(context.Context, *GetBatchesBySeqRangeRequest) (*GetBatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchesBySeqRange not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetBatchExecutionSummary(context.Context, *GetBatchExecutionSummaryRequest) (*GetBatchExecutionSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchExecutionSummary not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetBatchExecutionSummaries(context.Context, *GetBatchesBySeqRangeRequest) (*GetBatchExecutionSummariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchExecutionSummaries not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetRollupData(context.Context, *GetRollupDataRequest) (*GetRollupDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRollupData not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetRollupByBatchSeqNo(context.Context, *GetRollupByBatchSeqNoRequest) (*GetRollupDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRollupByBatchSeqNo not implemented")
+}
 func (UnimplementedEnclaveProtoServer) CreateBatch(context.Context, *CreateBatchRequest) (*CreateBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBatch not implemented")
 }
 func (UnimplementedEnclaveProtoServer) CreateRollup(context.Context, *CreateRollupRequest) (*CreateRollupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRollup not implemented")
 }
+func (UnimplementedEnclaveProtoServer) SetBatchProductionPaused(context.Context, *SetBatchProductionPausedRequest) (*SetBatchProductionPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBatchProductionPaused not implemented")
+}
+func (UnimplementedEnclaveProtoServer) ReprocessRollup(context.Context, *ReprocessRollupRequest) (*ReprocessRollupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReprocessRollup not implemented")
+}
 func (UnimplementedEnclaveProtoServer) DebugTraceTransaction(context.Context, *DebugTraceTransactionRequest) (*DebugTraceTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DebugTraceTransaction not implemented")
 }
+func (UnimplementedEnclaveProtoServer) DebugStorageRangeAt(context.Context, *DebugStorageRangeAtRequest) (*DebugStorageRangeAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugStorageRangeAt not implemented")
+}
 func (UnimplementedEnclaveProtoServer) StreamL2Updates(*StreamL2UpdatesRequest, EnclaveProto_StreamL2UpdatesServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamL2Updates not implemented")
 }
 func (UnimplementedEnclaveProtoServer) DebugEventLogRelevancy(context.Context, *DebugEventLogRelevancyRequest) (*DebugEventLogRelevancyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DebugEventLogRelevancy not implemented")
 }
+func (UnimplementedEnclaveProtoServer) DebugBatchLogRelevancy(context.Context, *DebugBatchLogRelevancyRequest) (*DebugBatchLogRelevancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugBatchLogRelevancy not implemented")
+}
+func (UnimplementedEnclaveProtoServer) SetProfilerEnabled(context.Context, *SetProfilerEnabledRequest) (*SetProfilerEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProfilerEnabled not implemented")
+}
+func (UnimplementedEnclaveProtoServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetEnclaveConfiguration(context.Context, *EmptyArgs) (*GetEnclaveConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaveConfiguration not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetNetworkInfo(context.Context, *EmptyArgs) (*GetNetworkInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkInfo not implemented")
+}
 func (UnimplementedEnclaveProtoServer) GetTotalContractCount(context.Context, *GetTotalContractCountRequest) (*GetTotalContractCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTotalContractCount not implemented")
 }
@@ -659,38 +1175,92 @@ func _EnclaveProto_EnclaveID_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EnclaveProto_SubmitL1Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitBlockRequest)
+func _EnclaveProto_EnclaveIDProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveIDProofRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EnclaveProtoServer).SubmitL1Block(ctx, in)
+		return srv.(EnclaveProtoServer).EnclaveIDProof(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/generated.EnclaveProto/SubmitL1Block",
+		FullMethod: "/generated.EnclaveProto/EnclaveIDProof",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EnclaveProtoServer).SubmitL1Block(ctx, req.(*SubmitBlockRequest))
+		return srv.(EnclaveProtoServer).EnclaveIDProof(ctx, req.(*EnclaveIDProofRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EnclaveProto_SubmitTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitTxRequest)
+func _EnclaveProto_SubmitL1Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EnclaveProtoServer).SubmitTx(ctx, in)
+		return srv.(EnclaveProtoServer).SubmitL1Block(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/generated.EnclaveProto/SubmitTx",
+		FullMethod: "/generated.EnclaveProto/SubmitL1Block",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EnclaveProtoServer).SubmitTx(ctx, req.(*SubmitTxRequest))
+		return srv.(EnclaveProtoServer).SubmitL1Block(ctx, req.(*SubmitBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_SubmitL1Headers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitL1HeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).SubmitL1Headers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/SubmitL1Headers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).SubmitL1Headers(ctx, req.(*SubmitL1HeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_SubmitTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).SubmitTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/SubmitTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).SubmitTx(ctx, req.(*SubmitTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_SubmitForwardedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitForwardedTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).SubmitForwardedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/SubmitForwardedTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).SubmitForwardedTx(ctx, req.(*SubmitForwardedTxRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -803,6 +1373,42 @@ func _EnclaveProto_GetTransactionReceipt_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_GetCrossChainMessageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCrossChainMessageProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetCrossChainMessageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetCrossChainMessageProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetCrossChainMessageProof(ctx, req.(*GetCrossChainMessageProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetCrossChainMessageStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCrossChainMessageStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetCrossChainMessageStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetCrossChainMessageStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetCrossChainMessageStatus(ctx, req.(*GetCrossChainMessageStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBalanceRequest)
 	if err := dec(in); err != nil {
@@ -839,6 +1445,24 @@ func _EnclaveProto_GetCode_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_ConvertBatchHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertBatchHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).ConvertBatchHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/ConvertBatchHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).ConvertBatchHash(ctx, req.(*ConvertBatchHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_Subscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubscribeRequest)
 	if err := dec(in); err != nil {
@@ -857,6 +1481,24 @@ func _EnclaveProto_Subscribe_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_SubscribeNewHeads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeNewHeadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).SubscribeNewHeads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/SubscribeNewHeads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).SubscribeNewHeads(ctx, req.(*SubscribeNewHeadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UnsubscribeRequest)
 	if err := dec(in); err != nil {
@@ -893,6 +1535,24 @@ func _EnclaveProto_EstimateGas_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_EstimateL1Fee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateL1FeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).EstimateL1Fee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/EstimateL1Fee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).EstimateL1Fee(ctx, req.(*EstimateL1FeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLogsRequest)
 	if err := dec(in); err != nil {
@@ -929,6 +1589,143 @@ func _EnclaveProto_HealthCheck_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetMetrics(ctx, req.(*EmptyArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetStateDivergenceReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetStateDivergenceReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetStateDivergenceReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetStateDivergenceReports(ctx, req.(*EmptyArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_ExportStateSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportStateSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EnclaveProtoServer).ExportStateSnapshot(m, &enclaveProtoExportStateSnapshotServer{stream})
+}
+
+type EnclaveProto_ExportStateSnapshotServer interface {
+	Send(*StateSnapshotChunkMsg) error
+	grpc.ServerStream
+}
+
+type enclaveProtoExportStateSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *enclaveProtoExportStateSnapshotServer) Send(m *StateSnapshotChunkMsg) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EnclaveProto_ImportStateSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EnclaveProtoServer).ImportStateSnapshot(&enclaveProtoImportStateSnapshotServer{stream})
+}
+
+type EnclaveProto_ImportStateSnapshotServer interface {
+	SendAndClose(*ImportStateSnapshotResponse) error
+	Recv() (*StateSnapshotChunkMsg, error)
+	grpc.ServerStream
+}
+
+type enclaveProtoImportStateSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *enclaveProtoImportStateSnapshotServer) SendAndClose(m *ImportStateSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *enclaveProtoImportStateSnapshotServer) Recv() (*StateSnapshotChunkMsg, error) {
+	m := new(StateSnapshotChunkMsg)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _EnclaveProto_GetSecretDisclosures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretDisclosuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetSecretDisclosures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetSecretDisclosures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetSecretDisclosures(ctx, req.(*GetSecretDisclosuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetRollupCompressionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetRollupCompressionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetRollupCompressionStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetRollupCompressionStats(ctx, req.(*EmptyArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetEnclaveAPIVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetEnclaveAPIVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetEnclaveAPIVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetEnclaveAPIVersion(ctx, req.(*EmptyArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_GetBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBatchRequest)
 	if err := dec(in); err != nil {
@@ -965,6 +1762,114 @@ func _EnclaveProto_GetBatchBySeqNo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_GetBatchByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetBatchByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetBatchByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetBatchByHeight(ctx, req.(*GetBatchByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetBatchesBySeqRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchesBySeqRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetBatchesBySeqRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetBatchesBySeqRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetBatchesBySeqRange(ctx, req.(*GetBatchesBySeqRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetBatchExecutionSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchExecutionSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetBatchExecutionSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetBatchExecutionSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetBatchExecutionSummary(ctx, req.(*GetBatchExecutionSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetBatchExecutionSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchesBySeqRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetBatchExecutionSummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetBatchExecutionSummaries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetBatchExecutionSummaries(ctx, req.(*GetBatchesBySeqRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetRollupData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRollupDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetRollupData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetRollupData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetRollupData(ctx, req.(*GetRollupDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetRollupByBatchSeqNo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRollupByBatchSeqNoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetRollupByBatchSeqNo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetRollupByBatchSeqNo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetRollupByBatchSeqNo(ctx, req.(*GetRollupByBatchSeqNoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_CreateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateBatchRequest)
 	if err := dec(in); err != nil {
@@ -1001,6 +1906,42 @@ func _EnclaveProto_CreateRollup_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_SetBatchProductionPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBatchProductionPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).SetBatchProductionPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/SetBatchProductionPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).SetBatchProductionPaused(ctx, req.(*SetBatchProductionPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_ReprocessRollup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReprocessRollupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).ReprocessRollup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/ReprocessRollup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).ReprocessRollup(ctx, req.(*ReprocessRollupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_DebugTraceTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DebugTraceTransactionRequest)
 	if err := dec(in); err != nil {
@@ -1019,6 +1960,24 @@ func _EnclaveProto_DebugTraceTransaction_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_DebugStorageRangeAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugStorageRangeAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).DebugStorageRangeAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/DebugStorageRangeAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).DebugStorageRangeAt(ctx, req.(*DebugStorageRangeAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_StreamL2Updates_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamL2UpdatesRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1058,6 +2017,96 @@ func _EnclaveProto_DebugEventLogRelevancy_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_DebugBatchLogRelevancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugBatchLogRelevancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).DebugBatchLogRelevancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/DebugBatchLogRelevancy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).DebugBatchLogRelevancy(ctx, req.(*DebugBatchLogRelevancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_SetProfilerEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProfilerEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).SetProfilerEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/SetProfilerEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).SetProfilerEnabled(ctx, req.(*SetProfilerEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetEnclaveConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetEnclaveConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetEnclaveConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetEnclaveConfiguration(ctx, req.(*EmptyArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetNetworkInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetNetworkInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetNetworkInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetNetworkInfo(ctx, req.(*EmptyArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_GetTotalContractCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTotalContractCountRequest)
 	if err := dec(in); err != nil {
@@ -1157,14 +2206,26 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EnclaveID",
 			Handler:    _EnclaveProto_EnclaveID_Handler,
 		},
+		{
+			MethodName: "EnclaveIDProof",
+			Handler:    _EnclaveProto_EnclaveIDProof_Handler,
+		},
 		{
 			MethodName: "SubmitL1Block",
 			Handler:    _EnclaveProto_SubmitL1Block_Handler,
 		},
+		{
+			MethodName: "SubmitL1Headers",
+			Handler:    _EnclaveProto_SubmitL1Headers_Handler,
+		},
 		{
 			MethodName: "SubmitTx",
 			Handler:    _EnclaveProto_SubmitTx_Handler,
 		},
+		{
+			MethodName: "SubmitForwardedTx",
+			Handler:    _EnclaveProto_SubmitForwardedTx_Handler,
+		},
 		{
 			MethodName: "SubmitBatch",
 			Handler:    _EnclaveProto_SubmitBatch_Handler,
@@ -1189,6 +2250,14 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactionReceipt",
 			Handler:    _EnclaveProto_GetTransactionReceipt_Handler,
 		},
+		{
+			MethodName: "GetCrossChainMessageProof",
+			Handler:    _EnclaveProto_GetCrossChainMessageProof_Handler,
+		},
+		{
+			MethodName: "GetCrossChainMessageStatus",
+			Handler:    _EnclaveProto_GetCrossChainMessageStatus_Handler,
+		},
 		{
 			MethodName: "GetBalance",
 			Handler:    _EnclaveProto_GetBalance_Handler,
@@ -1197,10 +2266,18 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCode",
 			Handler:    _EnclaveProto_GetCode_Handler,
 		},
+		{
+			MethodName: "ConvertBatchHash",
+			Handler:    _EnclaveProto_ConvertBatchHash_Handler,
+		},
 		{
 			MethodName: "Subscribe",
 			Handler:    _EnclaveProto_Subscribe_Handler,
 		},
+		{
+			MethodName: "SubscribeNewHeads",
+			Handler:    _EnclaveProto_SubscribeNewHeads_Handler,
+		},
 		{
 			MethodName: "Unsubscribe",
 			Handler:    _EnclaveProto_Unsubscribe_Handler,
@@ -1209,6 +2286,10 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _EnclaveProto_EstimateGas_Handler,
 		},
+		{
+			MethodName: "EstimateL1Fee",
+			Handler:    _EnclaveProto_EstimateL1Fee_Handler,
+		},
 		{
 			MethodName: "GetLogs",
 			Handler:    _EnclaveProto_GetLogs_Handler,
@@ -1217,6 +2298,26 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HealthCheck",
 			Handler:    _EnclaveProto_HealthCheck_Handler,
 		},
+		{
+			MethodName: "GetMetrics",
+			Handler:    _EnclaveProto_GetMetrics_Handler,
+		},
+		{
+			MethodName: "GetStateDivergenceReports",
+			Handler:    _EnclaveProto_GetStateDivergenceReports_Handler,
+		},
+		{
+			MethodName: "GetSecretDisclosures",
+			Handler:    _EnclaveProto_GetSecretDisclosures_Handler,
+		},
+		{
+			MethodName: "GetRollupCompressionStats",
+			Handler:    _EnclaveProto_GetRollupCompressionStats_Handler,
+		},
+		{
+			MethodName: "GetEnclaveAPIVersion",
+			Handler:    _EnclaveProto_GetEnclaveAPIVersion_Handler,
+		},
 		{
 			MethodName: "GetBatch",
 			Handler:    _EnclaveProto_GetBatch_Handler,
@@ -1225,6 +2326,30 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBatchBySeqNo",
 			Handler:    _EnclaveProto_GetBatchBySeqNo_Handler,
 		},
+		{
+			MethodName: "GetBatchByHeight",
+			Handler:    _EnclaveProto_GetBatchByHeight_Handler,
+		},
+		{
+			MethodName: "GetBatchesBySeqRange",
+			Handler:    _EnclaveProto_GetBatchesBySeqRange_Handler,
+		},
+		{
+			MethodName: "GetBatchExecutionSummary",
+			Handler:    _EnclaveProto_GetBatchExecutionSummary_Handler,
+		},
+		{
+			MethodName: "GetBatchExecutionSummaries",
+			Handler:    _EnclaveProto_GetBatchExecutionSummaries_Handler,
+		},
+		{
+			MethodName: "GetRollupData",
+			Handler:    _EnclaveProto_GetRollupData_Handler,
+		},
+		{
+			MethodName: "GetRollupByBatchSeqNo",
+			Handler:    _EnclaveProto_GetRollupByBatchSeqNo_Handler,
+		},
 		{
 			MethodName: "CreateBatch",
 			Handler:    _EnclaveProto_CreateBatch_Handler,
@@ -1233,14 +2358,46 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateRollup",
 			Handler:    _EnclaveProto_CreateRollup_Handler,
 		},
+		{
+			MethodName: "SetBatchProductionPaused",
+			Handler:    _EnclaveProto_SetBatchProductionPaused_Handler,
+		},
+		{
+			MethodName: "ReprocessRollup",
+			Handler:    _EnclaveProto_ReprocessRollup_Handler,
+		},
 		{
 			MethodName: "DebugTraceTransaction",
 			Handler:    _EnclaveProto_DebugTraceTransaction_Handler,
 		},
+		{
+			MethodName: "DebugStorageRangeAt",
+			Handler:    _EnclaveProto_DebugStorageRangeAt_Handler,
+		},
 		{
 			MethodName: "DebugEventLogRelevancy",
 			Handler:    _EnclaveProto_DebugEventLogRelevancy_Handler,
 		},
+		{
+			MethodName: "DebugBatchLogRelevancy",
+			Handler:    _EnclaveProto_DebugBatchLogRelevancy_Handler,
+		},
+		{
+			MethodName: "SetProfilerEnabled",
+			Handler:    _EnclaveProto_SetProfilerEnabled_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _EnclaveProto_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "GetEnclaveConfiguration",
+			Handler:    _EnclaveProto_GetEnclaveConfiguration_Handler,
+		},
+		{
+			MethodName: "GetNetworkInfo",
+			Handler:    _EnclaveProto_GetNetworkInfo_Handler,
+		},
 		{
 			MethodName: "GetTotalContractCount",
 			Handler:    _EnclaveProto_GetTotalContractCount_Handler,
@@ -1259,6 +2416,16 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportStateSnapshot",
+			Handler:       _EnclaveProto_ExportStateSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportStateSnapshot",
+			Handler:       _EnclaveProto_ImportStateSnapshot_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "StreamL2Updates",
 			Handler:       _EnclaveProto_StreamL2Updates_Handler,
@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/rpc/generated"
+)
+
+// StateSnapshotChunkSize - the size of the pieces the encrypted state snapshots are streamed in, well below the 4MB
+// limit of the gRPC messages
+const StateSnapshotChunkSize = 1 << 20
+
+// ToStateSnapshotChunkMsgs - splits the snapshot in chunks. The first one carries the info, the signature and the
+// attestations, so an empty snapshot is still one chunk.
+func ToStateSnapshotChunkMsgs(snapshot *common.EncryptedStateSnapshot, l1Attestations []*common.AttestationReport) []*generated.StateSnapshotChunkMsg {
+	first := &generated.StateSnapshotChunkMsg{Info: ToStateSnapshotInfoMsg(&snapshot.Info), Signature: snapshot.Signature}
+	for _, attestation := range l1Attestations {
+		msg := ToAttestationReportMsg(attestation)
+		first.L1Attestations = append(first.L1Attestations, &msg)
+	}
+	chunks := []*generated.StateSnapshotChunkMsg{first}
+	for offset := 0; offset < len(snapshot.Snapshot); offset += StateSnapshotChunkSize {
+		end := offset + StateSnapshotChunkSize
+		if end > len(snapshot.Snapshot) {
+			end = len(snapshot.Snapshot)
+		}
+		if offset == 0 {
+			first.Data = snapshot.Snapshot[:end]
+			continue
+		}
+		chunks = append(chunks, &generated.StateSnapshotChunkMsg{Data: snapshot.Snapshot[offset:end]})
+	}
+	return chunks
+}
+
+// FromStateSnapshotChunkMsgs - joins the chunks received until recv returns io.EOF
+func FromStateSnapshotChunkMsgs(recv func() (*generated.StateSnapshotChunkMsg, error)) (*common.EncryptedStateSnapshot, []*common.AttestationReport, error) {
+	first, err := recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, errors.New("the state snapshot has no chunk")
+		}
+		return nil, nil, err
+	}
+	if first.Info == nil {
+		return nil, nil, errors.New("the first chunk of the state snapshot has no info")
+	}
+	snapshot := &common.EncryptedStateSnapshot{Info: *FromStateSnapshotInfoMsg(first.Info), Signature: first.Signature, Snapshot: first.Data}
+	l1Attestations := make([]*common.AttestationReport, len(first.L1Attestations))
+	for i, msg := range first.L1Attestations {
+		l1Attestations[i] = FromAttestationReportMsg(msg)
+	}
+	for {
+		chunk, err := recv()
+		if errors.Is(err, io.EOF) {
+			return snapshot, l1Attestations, nil
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not receive a chunk of the state snapshot. Cause: %w", err)
+		}
+		snapshot.Snapshot = append(snapshot.Snapshot, chunk.Data...)
+	}
+}
@@ -0,0 +1,48 @@
+package rpc
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/rpc/generated"
+)
+
+func TestStateSnapshotChunksRoundTrip(t *testing.T) {
+	for _, size := range []int{0, 1, StateSnapshotChunkSize, 2*StateSnapshotChunkSize + 3} {
+		snapshot := &common.EncryptedStateSnapshot{
+			Info:      common.StateSnapshotInfo{SeqNo: 7, Height: 5},
+			Snapshot:  bytes.Repeat([]byte{0xab}, size),
+			Signature: []byte{1, 2, 3},
+		}
+		attestations := []*common.AttestationReport{{Owner: gethcommon.Address{1}, PubKey: []byte{4}}}
+
+		chunks := ToStateSnapshotChunkMsgs(snapshot, attestations)
+		for _, chunk := range chunks {
+			require.LessOrEqual(t, len(chunk.Data), StateSnapshotChunkSize)
+		}
+		received, receivedAttestations, err := FromStateSnapshotChunkMsgs(func() (*generated.StateSnapshotChunkMsg, error) {
+			if len(chunks) == 0 {
+				return nil, io.EOF
+			}
+			chunk := chunks[0]
+			chunks = chunks[1:]
+			return chunk, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, snapshot.Info, received.Info)
+		require.Equal(t, snapshot.Signature, received.Signature)
+		require.Equal(t, len(snapshot.Snapshot), len(received.Snapshot))
+		require.True(t, bytes.Equal(snapshot.Snapshot, received.Snapshot))
+		require.Len(t, receivedAttestations, 1)
+		require.Equal(t, attestations[0].Owner, receivedAttestations[0].Owner)
+	}
+}
+
+func TestStateSnapshotWithoutChunks(t *testing.T) {
+	_, _, err := FromStateSnapshotChunkMsgs(func() (*generated.StateSnapshotChunkMsg, error) { return nil, io.EOF })
+	require.ErrorContains(t, err, "no chunk")
+}
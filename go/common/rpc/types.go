@@ -1,9 +1,34 @@
 package rpc
 
-import "github.com/ten-protocol/go-ten/go/common/viewingkey"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ten-protocol/go-ten/go/common/viewingkey"
+)
+
+// MaxRequestIDLength - the longest request ID the enclave accepts
+const MaxRequestIDLength = 64
 
 // RequestWithVk - wraps the eth parameters with a viewing key
 type RequestWithVk struct {
 	VK     *viewingkey.RPCSignedViewingKey
 	Params []any
+	// RequestID - optional, chosen by the caller to find its request in the logs of the enclave. It is echoed in the
+	// encrypted response, and it is the only part of the request which the enclave logs.
+	RequestID string `json:",omitempty"`
+}
+
+// ValidateRequestID - the ID is logged, so it is capped and restricted to the characters which can't forge log lines
+func ValidateRequestID(id string) error {
+	if len(id) > MaxRequestIDLength {
+		return fmt.Errorf("the request ID is longer than %d characters", MaxRequestIDLength)
+	}
+	for _, c := range id {
+		isAlphanumeric := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+		if !isAlphanumeric && c != '-' && c != '_' && c != '.' && c != ':' {
+			return errors.New("the request ID can only have letters, digits and the characters - _ . :")
+		}
+	}
+	return nil
 }
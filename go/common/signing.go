@@ -0,0 +1,49 @@
+package common
+
+import (
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The domain tags of the digests signed by the sequencer
+const (
+	BatchSignatureDomain  = "TEN batch"
+	RollupSignatureDomain = "TEN rollup"
+)
+
+// SignatureScheme - what the sequencer signs for a batch or a rollup. From the activation seq no on, the header hash is
+// signed in an EIP-712 style digest separated by the type of message and the chain ID, so a signature cannot be replayed
+// as another type of message, or on another network sharing the key. Before it, the bare header hash is signed, so the
+// older batches still verify when they are replayed.
+type SignatureScheme struct {
+	ChainID int64
+	// ActivationSeqNo - the first batch seq no signed with the domain separated digest. A rollup follows the scheme of
+	// its last batch. Must be the same for all the enclaves of the network
+	ActivationSeqNo uint64
+}
+
+// BatchDigest - the digest the sequencer signs for the batch header
+func (s SignatureScheme) BatchDigest(header *BatchHeader) gethcommon.Hash {
+	return s.digest(BatchSignatureDomain, header.Hash(), header.SequencerOrderNo.Uint64())
+}
+
+// RollupDigest - the digest the sequencer signs for the rollup header
+func (s SignatureScheme) RollupDigest(header *RollupHeader) gethcommon.Hash {
+	return s.digest(RollupSignatureDomain, header.Hash(), header.LastBatchSeqNo)
+}
+
+func (s SignatureScheme) digest(domain string, hash gethcommon.Hash, seqNo uint64) gethcommon.Hash {
+	if seqNo < s.ActivationSeqNo {
+		return hash
+	}
+	return DomainSeparatedDigest(domain, s.ChainID, hash)
+}
+
+// DomainSeparatedDigest - keccak256(0x19 0x01 ‖ domainSeparator ‖ hash), with the domain separator being
+// keccak256(keccak256(domain) ‖ chainID)
+func DomainSeparatedDigest(domain string, chainID int64, hash gethcommon.Hash) gethcommon.Hash {
+	domainSeparator := crypto.Keccak256(crypto.Keccak256([]byte(domain)), gethcommon.BigToHash(big.NewInt(chainID)).Bytes())
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator, hash.Bytes())
+}
@@ -0,0 +1,20 @@
+package common
+
+import (
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// StateSnapshotDomain - the domain tag of the digest signed by the enclave key for the exported state snapshots
+const StateSnapshotDomain = "TEN state snapshot"
+
+// StateSnapshotDigest - the digest the exporting enclave signs, which covers the info and the encrypted snapshot
+func StateSnapshotDigest(info StateSnapshotInfo, encrypted []byte) (gethcommon.Hash, error) {
+	encodedInfo, err := rlp.EncodeToBytes(info)
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	return crypto.Keccak256Hash([]byte(StateSnapshotDomain), encodedInfo, encrypted), nil
+}
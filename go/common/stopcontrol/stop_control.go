@@ -1,15 +1,22 @@
 package stopcontrol
 
 import (
+	"context"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 // StopControl allows for any instance to thread-safely check if the status is stopping or not
+// It also tracks the operations in progress, so the instance can wait for them to finish before releasing its resources.
 type StopControl struct {
 	stop     *int32
 	stopChan chan interface{}
 	closer   sync.Once
+
+	// the write lock is taken when stopping, so no operation is registered after the instance started stopping
+	operationsLock sync.RWMutex
+	operations     sync.WaitGroup
 }
 
 func New() *StopControl {
@@ -21,7 +28,9 @@ func New() *StopControl {
 
 func (s *StopControl) Stop() {
 	s.closer.Do(func() {
+		s.operationsLock.Lock()
 		atomic.StoreInt32(s.stop, 1)
+		s.operationsLock.Unlock()
 		close(s.stopChan)
 	})
 }
@@ -33,3 +42,51 @@ func (s *StopControl) IsStopping() bool {
 func (s *StopControl) Done() chan interface{} {
 	return s.stopChan
 }
+
+// Enter registers an operation in progress. It returns false if the instance is stopping, in which case the operation
+// must not start. Otherwise, Exit must be called when the operation is done.
+func (s *StopControl) Enter() bool {
+	s.operationsLock.RLock()
+	defer s.operationsLock.RUnlock()
+	if s.IsStopping() {
+		return false
+	}
+	s.operations.Add(1)
+	return true
+}
+
+// WithContext returns a context cancelled when ctx is done or when the instance starts stopping, so the long operations
+// can abort instead of running against resources being released. cancel must be called once the operation is done.
+func (s *StopControl) WithContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-s.stopChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// Exit marks an operation registered with Enter as done
+func (s *StopControl) Exit() {
+	s.operations.Done()
+}
+
+// WaitForOperations waits for the operations in progress to finish, once Stop was called.
+// It returns false if they did not finish within the timeout.
+func (s *StopControl) WaitForOperations(timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		s.operations.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
@@ -1,6 +1,8 @@
 package stopcontrol
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 )
@@ -58,3 +60,46 @@ func TestStopControl_Done(t *testing.T) {
 		t.Error("Expected Done channel to be closed immediately after Stop")
 	}
 }
+
+func TestStopControl_WaitForOperations(t *testing.T) {
+	sc := New()
+	if !sc.Enter() {
+		t.Fatal("Expected the operation to be registered before Stop")
+	}
+	sc.Stop()
+
+	if sc.Enter() {
+		t.Error("Expected no operation to be registered after Stop")
+	}
+	if sc.WaitForOperations(50 * time.Millisecond) {
+		t.Error("Expected WaitForOperations to time out while an operation is in progress")
+	}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		sc.Exit()
+	}()
+	if !sc.WaitForOperations(time.Second) {
+		t.Error("Expected WaitForOperations to return once the operation exited")
+	}
+}
+
+func TestStopControl_WithContext(t *testing.T) {
+	sc := New()
+	ctx, cancel := sc.WithContext(context.Background())
+	defer cancel()
+
+	if ctx.Err() != nil {
+		t.Fatal("Expected the context to be active before Stop")
+	}
+	sc.Stop()
+
+	select {
+	case <-ctx.Done():
+		if !errors.Is(ctx.Err(), context.Canceled) {
+			t.Errorf("Expected the context to be cancelled, got %s", ctx.Err())
+		}
+	case <-time.After(time.Second):
+		t.Error("Expected the context to be cancelled by Stop")
+	}
+}
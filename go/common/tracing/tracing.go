@@ -0,0 +1,125 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+const _instrumentationName = "github.com/ten-protocol/go-ten"
+
+// The span attributes. They must only describe public data (e.g. the L1 blocks or the batch headers), never anything
+// sent by the users or any key.
+const (
+	BlockHashKey   = attribute.Key("block.hash")
+	BlockHeightKey = attribute.Key("block.height")
+	BatchHashKey   = attribute.Key("batch.hash")
+	BatchSeqNoKey  = attribute.Key("batch.seqNo")
+	CountKey       = attribute.Key("count")
+)
+
+var _propagator = propagation.TraceContext{}
+
+// Provider - records the spans and exports them to a file, in the OpenTelemetry JSON format.
+// The spans are batched in-process and written in the background.
+type Provider struct {
+	*sdktrace.TracerProvider
+	file *os.File
+}
+
+func NewProvider(serviceName string, path string) (*Provider, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("could not open the traces file. Cause: %w", err)
+	}
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+	if err != nil {
+		return nil, fmt.Errorf("could not create the traces exporter. Cause: %w", err)
+	}
+	return &Provider{
+		TracerProvider: sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exporter),
+			sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+		),
+		file: file,
+	}, nil
+}
+
+// Shutdown - exports the remaining spans and closes the file
+func (p *Provider) Shutdown(ctx context.Context) error {
+	if err := p.TracerProvider.Shutdown(ctx); err != nil {
+		return err
+	}
+	return p.file.Close()
+}
+
+// Tracer - the tracer of the provider, or a tracer that records nothing if the provider is nil
+func Tracer(provider trace.TracerProvider) trace.Tracer {
+	if provider == nil {
+		provider = trace.NewNoopTracerProvider()
+	}
+	return provider.Tracer(_instrumentationName)
+}
+
+// StartSpan - starts a child of the span in the context, recorded by the same provider. So the components don't need a
+// tracer, and record nothing when the context was not traced.
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer(trace.SpanFromContext(ctx).TracerProvider()).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// EndSpan - records the error, if any, and ends the span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// ToOutgoingGRPC - passes the trace context of ctx to the remote side of a gRPC call
+func ToOutgoingGRPC(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	_propagator.Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// FromIncomingGRPC - the context of a gRPC call, with the trace context passed by the caller
+func FromIncomingGRPC(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return _propagator.Extract(ctx, metadataCarrier(md))
+}
+
+// metadataCarrier - adapts the gRPC metadata to the propagation API
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key string, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
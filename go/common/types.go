@@ -24,6 +24,12 @@ type (
 	StreamL2UpdatesResponse struct {
 		Batch *ExtBatch
 		Logs  EncryptedSubscriptionLogs
+		// NewHeads - the JSON-encoded converted header of the new head, for each newHeads subscription. The headers
+		// are public, so they are not encrypted.
+		NewHeads map[rpc.ID][]byte
+		// Fork - set when an L1 fork moved batches which were already streamed to a dead branch. It is followed by the
+		// batches of the new canonical chain above the common ancestor, streamed again
+		Fork *L2ForkNotification
 	}
 
 	// MainNet aliases
@@ -60,17 +66,25 @@ type (
 	ValueTransferEvents   = []ValueTransferEvent
 	EncryptedTx           []byte // A single transaction, encoded as a JSON list of transaction binary hexes and encrypted using the enclave's public key
 	EncryptedTransactions []byte // A blob of encrypted transactions, as they're stored in the rollup, with the nonce prepended.
+	EncryptedForwardedTx  []byte // A transaction validated by a validator enclave, encrypted so that only the enclaves of the network can read it
 
-	EncryptedParamsGetBalance      []byte // The params for an RPC getBalance request, as a JSON object encrypted with the public key of the enclave.
-	EncryptedParamsCall            []byte // As above, but for an RPC call request.
-	EncryptedParamsGetTxByHash     []byte // As above, but for an RPC getTransactionByHash request.
-	EncryptedParamsGetTxReceipt    []byte // As above, but for an RPC getTransactionReceipt request.
-	EncryptedParamsLogSubscription []byte // As above, but for an RPC logs subscription request.
-	EncryptedParamsSendRawTx       []byte // As above, but for an RPC sendRawTransaction request.
-	EncryptedParamsGetTxCount      []byte // As above, but for an RPC getTransactionCount request.
-	EncryptedParamsEstimateGas     []byte // As above, but for an RPC estimateGas request.
-	EncryptedParamsGetLogs         []byte // As above, but for an RPC getLogs request.
-	EncryptedParamsGetStorageAt    []byte
+	EncryptedParamsGetBalance       []byte // The params for an RPC getBalance request, as a JSON object encrypted with the public key of the enclave.
+	EncryptedParamsCall             []byte // As above, but for an RPC call request.
+	EncryptedParamsGetTxByHash      []byte // As above, but for an RPC getTransactionByHash request.
+	EncryptedParamsGetTxReceipt     []byte // As above, but for an RPC getTransactionReceipt request.
+	EncryptedParamsLogSubscription  []byte // As above, but for an RPC logs subscription request.
+	EncryptedParamsSendRawTx        []byte // As above, but for an RPC sendRawTransaction request.
+	EncryptedParamsGetTxCount       []byte // As above, but for an RPC getTransactionCount request.
+	EncryptedParamsEstimateGas      []byte // As above, but for an RPC estimateGas request.
+	EncryptedParamsGetLogs          []byte // As above, but for an RPC getLogs request.
+	EncryptedParamsGetStorageAt     []byte
+	EncryptedParamsGetMessageProof  []byte // As above, but for an RPC getCrossChainMessageProof request.
+	EncryptedParamsGetMessageStatus []byte // As above, but for an RPC getCrossChainMessageStatus request.
+	EncryptedParamsEstimateL1Fee    []byte // As above, but for an RPC estimateL1Fee request.
+	EncryptedParamsStorageRangeAt   []byte // As above, but for an RPC debug_storageRangeAt request.
+	EncryptedParamsDebugTraceTx     []byte // As above, but for an RPC debug_traceTransaction request.
+	EncryptedParamsDebugLogs        []byte // As above, but for an RPC debug_eventLogRelevancy request.
+	EncryptedParamsBatchLogs        []byte // As above, but for an RPC debug_batchLogRelevancy request.
 
 	Nonce               = uint64
 	EncodedRollup       []byte
@@ -94,6 +108,15 @@ const (
 	L2GenesisSeqNo  = uint64(1)
 	// HeightCommittedBlocks is the number of blocks deep a transaction must be to be considered safe from reorganisations.
 	HeightCommittedBlocks = 15
+	// SyntheticTxGasLimit - the gas limit of the synthetic transaction created for each inbound cross chain message and
+	// value transfer
+	SyntheticTxGasLimit = uint64(5_000_000)
+	// SyntheticTxGasBudget - the gas the synthetic transactions can use in a batch, within its gas limit. It is a
+	// network parameter, as the validators must include the same synthetic transactions as the sequencer
+	SyntheticTxGasBudget = uint64(500_000_000)
+	// BatchTimeL1Drift - the most seconds the timestamp of a batch can differ from the timestamp of its L1 proof block.
+	// It is a network parameter, as the validators reject the batches which break it
+	BatchTimeL1Drift = uint64(120)
 )
 
 var GethGenesisParentHash = common.Hash{}
@@ -125,12 +148,19 @@ type BlockAndReceipts struct {
 	Block                  *types.Block
 	ReceiptsMap            map[int]*types.Receipt // sparse map with obscuro-relevant receipts in it
 	Receipts               *types.Receipts
+	ProvenReceipts         bool // the receipts were verified with proofs against the receipts root of the block
 	successfulTransactions *types.Transactions
 }
 
 // ParseBlockAndReceipts - will create a container struct that has preprocessed the receipts
 // and verified if they indeed match the receipt root hash in the block.
-func ParseBlockAndReceipts(block *L1Block, receipts *L1Receipts) (*BlockAndReceipts, error) {
+// When the proofs are not nil, only the relevant receipts are submitted, each with a proof against the receipts root,
+// and the receipts list is ignored.
+func ParseBlockAndReceipts(block *L1Block, receipts *L1Receipts, proofs L1ReceiptProofs, trackedAddresses []common.Address) (*BlockAndReceipts, error) {
+	if proofs != nil {
+		return parseReceiptProofs(block, proofs, trackedAddresses)
+	}
+
 	if len(block.Transactions()) != len(*receipts) {
 		// the receipts list is currently a *sparse* list of relevant receipts, it needs to have the same length as the
 		// transactions list even though some of the entries may be nil
@@ -171,6 +201,32 @@ func (br *BlockAndReceipts) SuccessfulTransactions() *types.Transactions {
 	return br.successfulTransactions
 }
 
+// HasTransactionsTo - whether any transaction of the block was sent to the address. Unlike the events, the calls which
+// emit none (e.g. the rollups) can't be ruled out with the bloom of the header.
+func (br *BlockAndReceipts) HasTransactionsTo(address common.Address) bool {
+	for _, tx := range br.Block.Transactions() {
+		if tx.To() != nil && *tx.To() == address {
+			return true
+		}
+	}
+	return false
+}
+
+// MayContainL1Log - false when the bloom of the header proves that no log of the block was emitted by the address with
+// all the topics. The bloom gives false positives, so true only means that the receipts must be searched.
+func MayContainL1Log(block *L1Block, address common.Address, topics ...common.Hash) bool {
+	bloom := block.Bloom()
+	if !types.BloomLookup(bloom, address) {
+		return false
+	}
+	for _, topic := range topics {
+		if !types.BloomLookup(bloom, topic) {
+			return false
+		}
+	}
+	return true
+}
+
 // ChainFork - represents the result of walking the chain when processing a fork
 type ChainFork struct {
 	NewCanonical *types.Block
@@ -181,6 +237,16 @@ type ChainFork struct {
 	NonCanonicalPath []L1BlockHash
 }
 
+// L2ForkNotification - the batches above the common ancestor streamed before the notification are on a dead branch, and
+// the canonical chain ends at the new head. The new head is the common ancestor when the canonical batches above it are
+// not known yet, e.g. on a validator, until it receives the batches the sequencer duplicated.
+type L2ForkNotification struct {
+	CommonAncestorSeqNo uint64
+	CommonAncestorHash  L2BatchHash
+	NewHeadSeqNo        uint64
+	NewHeadHash         L2BatchHash
+}
+
 func (cf *ChainFork) IsFork() bool {
 	return len(cf.NonCanonicalPath) > 0
 }
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"math"
+
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/flag"
@@ -16,24 +18,63 @@ const (
 	ObscuroChainIDFlag            = "obscuroChainID"
 	WillAttestFlag                = "willAttest"
 	ValidateL1BlocksFlag          = "validateL1Blocks"
+	L1MaxForkDepthFlag            = "l1MaxForkDepth"
 	ManagementContractAddressFlag = "managementContractAddress"
 	LogLevelFlag                  = "logLevel"
 	LogPathFlag                   = "logPath"
+	ComponentLogLevelsFlag        = "componentLogLevels"
 	UseInMemoryDBFlag             = "useInMemoryDB"
 	EdgelessDBHostFlag            = "edgelessDBHost"
 	SQLiteDBPathFlag              = "sqliteDBPath"
 	ProfilerEnabledFlag           = "profilerEnabled"
 	MinGasPriceFlag               = "minGasPrice"
 	MessageBusAddressFlag         = "messageBusAddress"
+	MessengerAddressFlag          = "messengerAddress"
 	SequencerIDFlag               = "sequencerID"
 	ObscuroGenesisFlag            = "obscuroGenesis"
 	DebugNamespaceEnabledFlag     = "debugNamespaceEnabled"
+	DebugJSTracersEnabledFlag     = "debugJSTracersEnabled"
+	DebugAccessUnrestrictedFlag   = "debugAccessUnrestricted"
+	ConfigIntrospectionFlag       = "configIntrospectionEnabled"
 	MaxBatchSizeFlag              = "maxBatchSize"
 	MaxRollupSizeFlag             = "maxRollupSize"
 	L2BaseFeeFlag                 = "l2BaseFee"
 	L2CoinbaseFlag                = "l2Coinbase"
 	GasBatchExecutionLimit        = "gasBatchExecutionLimit"
+	BatchExecutionTargetFlag      = "batchExecutionTarget"
+	EnclaveBatchProductionFlag    = "enclaveBatchProduction"
+	BatchIntervalFlag             = "batchInterval"
+	MaxBatchIntervalFlag          = "maxBatchInterval"
 	GasLocalExecutionCapFlag      = "gasLocalExecutionCap"
+	TxPoolPriceBumpFlag           = "txPoolPriceBump"
+	TxPoolGlobalCapFlag           = "txPoolGlobalCap"
+	TxPoolAccountCapFlag          = "txPoolAccountCap"
+	TxPoolTTLFlag                 = "txPoolTTL"
+	TxPoolHealthOccupancyFlag     = "txPoolHealthOccupancy"
+	TxPoolHealthAdmissionFlag     = "txPoolHealthAdmissionTimeout"
+	SubscriptionsGlobalCapFlag    = "subscriptionsGlobalCap"
+	SubscriptionsVKCapFlag        = "subscriptionsViewingKeyCap"
+	SubscriptionKeepAliveFlag     = "subscriptionKeepAlive"
+	SubscriptionReorgDepthFlag    = "subscriptionReorgDepth"
+	SubscriptionHealthBacklogFlag = "subscriptionHealthBacklog"
+	SecretResponseLimitFlag       = "secretResponseLimit"
+	SecretResponseWindowFlag      = "secretResponseWindow"
+	L1ConfirmationDepthFlag       = "l1ConfirmationDepth"
+	SignatureDomainActivationFlag = "signatureDomainActivationSeqNo"
+	InboundBudgetActivationFlag   = "inboundBudgetActivationSeqNo"
+	BatchTimeRulesActivationFlag  = "batchTimeRulesActivationSeqNo"
+	StopTimeoutFlag               = "stopTimeout"
+	MetricsEnabledFlag            = "metricsEnabled"
+	TracesPathFlag                = "tracesPath"
+	StateRetentionFlag            = "stateRetention"
+	StateRetentionBatchesFlag     = "stateRetentionBatches"
+	RequestTimeoutFlag            = "requestTimeout"
+	CallExecutionTimeoutFlag      = "callExecutionTimeout"
+	CallMemoryCapFlag             = "callMemoryCap"
+	RPCMaxConcurrencyFlag         = "rpcMaxConcurrency"
+	RPCQueueSizeFlag              = "rpcQueueSize"
+	ResponsePaddingMinBucketFlag  = "responsePaddingMinBucket"
+	ResponsePaddingMaxBucketFlag  = "responsePaddingMaxBucket"
 )
 
 // EnclaveFlags are the flags that the enclave can receive
@@ -43,27 +84,66 @@ var EnclaveFlags = map[string]*flag.TenFlag{
 	AddressFlag:                   flag.NewStringFlag(AddressFlag, "127.0.0.1:11000", "The address on which to serve the Obscuro enclave service"),
 	NodeTypeFlag:                  flag.NewStringFlag(NodeTypeFlag, common.Sequencer.String(), "The node's type (e.g. sequencer, validator)"),
 	WillAttestFlag:                flag.NewBoolFlag(WillAttestFlag, false, "Whether the enclave will produce a verified attestation report"),
-	ValidateL1BlocksFlag:          flag.NewBoolFlag(ValidateL1BlocksFlag, false, "Whether to validate incoming blocks using the hardcoded L1 genesis.json config"),
+	ValidateL1BlocksFlag:          flag.NewBoolFlag(ValidateL1BlocksFlag, false, "Whether to validate incoming blocks using the hardcoded L1 genesis.json config. The host must submit the complete receipts of the blocks"),
+	L1MaxForkDepthFlag:            flag.NewUint64Flag(L1MaxForkDepthFlag, 64, "When validating incoming blocks, the maximum number of L1 blocks a fork can revert"),
 	ManagementContractAddressFlag: flag.NewStringFlag(ManagementContractAddressFlag, "", "The management contract address on the L1"),
 	LogLevelFlag:                  flag.NewIntFlag(LogLevelFlag, 3, "The verbosity level of logs. (Defaults to Info)"),
 	LogPathFlag:                   flag.NewStringFlag(LogPathFlag, "stdout", "The path to use for the enclave service's log file"),
+	ComponentLogLevelsFlag:        flag.NewStringFlag(ComponentLogLevelsFlag, "", "The verbosity levels of the logs of the enclave components which are not logged at logLevel, e.g. subscription_manager=4,txpool=2"),
 	EdgelessDBHostFlag:            flag.NewStringFlag(EdgelessDBHostFlag, "", "Host address for the edgeless DB instance (can be empty if useInMemoryDB is true or if not using attestation"),
 	SQLiteDBPathFlag:              flag.NewStringFlag(SQLiteDBPathFlag, "", "Filepath for the sqlite DB persistence file (can be empty if a throwaway file in /tmp/ is acceptable or if using InMemory DB or if using attestation/EdgelessDB)"),
 	MinGasPriceFlag:               flag.NewInt64Flag(MinGasPriceFlag, 1, "The minimum gas price for mining a transaction"),
 	MessageBusAddressFlag:         flag.NewStringFlag(MessageBusAddressFlag, "", "The address of the L1 message bus contract owned by the management contract."),
+	MessengerAddressFlag:          flag.NewStringFlag(MessengerAddressFlag, "", "The address of the L1 cross chain messenger whose relayed messages are recorded as consumed (none are recorded if empty)."),
 	SequencerIDFlag:               flag.NewStringFlag(SequencerIDFlag, "", "The 20 bytes of the address of the sequencer for this network"),
 	MaxBatchSizeFlag:              flag.NewUint64Flag(MaxBatchSizeFlag, 1024*32, "The maximum size a batch is allowed to reach uncompressed"),
 	MaxRollupSizeFlag:             flag.NewUint64Flag(MaxRollupSizeFlag, 1024*64, "The maximum size a rollup is allowed to reach"),
 	L2BaseFeeFlag:                 flag.NewUint64Flag(L2BaseFeeFlag, params.InitialBaseFee, ""),
 	L2CoinbaseFlag:                flag.NewStringFlag(L2CoinbaseFlag, "0xd6C9230053f45F873Cb66D8A02439380a37A4fbF", ""),
 	GasBatchExecutionLimit:        flag.NewUint64Flag(GasBatchExecutionLimit, 3_000_000_000, "Max gas that can be executed in a single batch"),
+	BatchExecutionTargetFlag:      flag.NewUint64Flag(BatchExecutionTargetFlag, 0, "The number of milliseconds within which the sequencer aims to execute a batch. The gas packed in the batches is scaled down while they take longer. Zero disables the scaling"),
+	EnclaveBatchProductionFlag:    flag.NewBoolFlag(EnclaveBatchProductionFlag, false, "Whether the sequencer enclave produces the batches on its own timer, instead of when the host requests them"),
+	BatchIntervalFlag:             flag.NewUint64Flag(BatchIntervalFlag, 1000, "The number of milliseconds between the batches produced by the enclave, when enclaveBatchProduction is enabled"),
+	MaxBatchIntervalFlag:          flag.NewUint64Flag(MaxBatchIntervalFlag, 1000, "The number of milliseconds for which the enclave skips the empty batches, when it is longer than batchInterval"),
 	ObscuroGenesisFlag:            flag.NewStringFlag(ObscuroGenesisFlag, "", "The json string with the obscuro genesis"),
 	L1ChainIDFlag:                 flag.NewInt64Flag(L1ChainIDFlag, 1337, "An integer representing the unique chain id of the Ethereum chain used as an L1 (default 1337)"),
 	ObscuroChainIDFlag:            flag.NewInt64Flag(ObscuroChainIDFlag, 443, "An integer representing the unique chain id of the Obscuro chain (default 443)"),
 	UseInMemoryDBFlag:             flag.NewBoolFlag(UseInMemoryDBFlag, true, "Whether the enclave will use an in-memory DB rather than persist data"),
 	ProfilerEnabledFlag:           flag.NewBoolFlag(ProfilerEnabledFlag, false, "Runs a profiler instance (Defaults to false)"),
 	DebugNamespaceEnabledFlag:     flag.NewBoolFlag(DebugNamespaceEnabledFlag, false, "Whether the debug namespace is enabled"),
+	DebugJSTracersEnabledFlag:     flag.NewBoolFlag(DebugJSTracersEnabledFlag, false, "Whether debug_traceTransaction runs JavaScript tracers. The code of the tracer is supplied by the caller and runs inside the enclave"),
+	DebugAccessUnrestrictedFlag:   flag.NewBoolFlag(DebugAccessUnrestrictedFlag, false, "Whether the debug calls which reveal transactions and contract state are available to any viewing key, instead of only to the sender or the contract owner. Only for local test networks"),
+	ConfigIntrospectionFlag:       flag.NewBoolFlag(ConfigIntrospectionFlag, false, "Whether the host can read the sanitized configuration of the enclave when the debug namespace is disabled"),
 	GasLocalExecutionCapFlag:      flag.NewUint64Flag(GasLocalExecutionCapFlag, 4_000_000_000, "Max gas usage when executing local transactions"),
+	TxPoolPriceBumpFlag:           flag.NewUint64Flag(TxPoolPriceBumpFlag, 10, "The minimum price bump (%) required to replace a pending transaction with the same nonce"),
+	TxPoolGlobalCapFlag:           flag.NewUint64Flag(TxPoolGlobalCapFlag, 6144, "The maximum number of transactions in the mempool"),
+	TxPoolAccountCapFlag:          flag.NewUint64Flag(TxPoolAccountCapFlag, 64, "The maximum number of transactions a sender can have in the mempool"),
+	TxPoolTTLFlag:                 flag.NewUint64Flag(TxPoolTTLFlag, 3*60*60, "The number of seconds after which a transaction that was not included is dropped from the mempool"),
+	TxPoolHealthOccupancyFlag:     flag.NewUint64Flag(TxPoolHealthOccupancyFlag, 95, "The percentage of txPoolGlobalCap above which the mempool is reported unhealthy"),
+	TxPoolHealthAdmissionFlag:     flag.NewUint64Flag(TxPoolHealthAdmissionFlag, 0, "The number of seconds without an admitted transaction after which the mempool of the sequencer is reported unhealthy (0 disables the check)"),
+	SubscriptionsGlobalCapFlag:    flag.NewUint64Flag(SubscriptionsGlobalCapFlag, 10_000, "The maximum number of log subscriptions the enclave serves"),
+	SubscriptionsVKCapFlag:        flag.NewUint64Flag(SubscriptionsVKCapFlag, 100, "The maximum number of log subscriptions a single viewing key can register"),
+	SubscriptionKeepAliveFlag:     flag.NewUint64Flag(SubscriptionKeepAliveFlag, 0, "The number of seconds after which a log subscription that was not renewed by the client is dropped (0 disables the expiry)"),
+	SubscriptionReorgDepthFlag:    flag.NewUint64Flag(SubscriptionReorgDepthFlag, 64, "The number of batches for which the delivered logs are remembered, so they can be flagged as removed on reorgs"),
+	SubscriptionHealthBacklogFlag: flag.NewUint64Flag(SubscriptionHealthBacklogFlag, 10_000, "The number of logs waiting to be delivered to the subscribers above which the subscriptions are reported unhealthy"),
+	SecretResponseLimitFlag:       flag.NewUint64Flag(SecretResponseLimitFlag, 3, "The maximum number of secret responses produced for the same enclave within secretResponseWindow (0 disables the limit)"),
+	SecretResponseWindowFlag:      flag.NewUint64Flag(SecretResponseWindowFlag, 60*60, "The number of seconds of the window within which the secret responses to an enclave are limited"),
+	L1ConfirmationDepthFlag:       flag.NewUint64Flag(L1ConfirmationDepthFlag, 0, "The number of L1 blocks that must be built on top of a cross chain message before it is included in a batch"),
+	SignatureDomainActivationFlag: flag.NewUint64Flag(SignatureDomainActivationFlag, math.MaxUint64, "The first batch seq no for which the sequencer signs a digest separated by the type of message and the chain ID, instead of the bare header hash. Never activated by default, so the existing networks verify the signatures of their batches"),
+	InboundBudgetActivationFlag:   flag.NewUint64Flag(InboundBudgetActivationFlag, math.MaxUint64, "The first batch seq no which includes the synthetic transactions of the inbound cross chain messages and deposits oldest first, within the synthetic gas budget. Never activated by default, so the existing networks replay their batches"),
+	BatchTimeRulesActivationFlag:  flag.NewUint64Flag(BatchTimeRulesActivationFlag, math.MaxUint64, "The first batch seq no whose timestamp must not be before the one of its parent, nor too far from the one of its L1 block. Never activated by default, so the existing networks replay their batches"),
+	StopTimeoutFlag:               flag.NewUint64Flag(StopTimeoutFlag, 10, "The number of seconds the enclave waits for the requests in progress to finish when stopping"),
+	MetricsEnabledFlag:            flag.NewBoolFlag(MetricsEnabledFlag, false, "Whether the enclave collects metrics, served to the host in the Prometheus format (Defaults to false)"),
+	TracesPathFlag:                flag.NewStringFlag(TracesPathFlag, "", "The file to which the enclave exports its traces in the OpenTelemetry JSON format. Tracing is disabled when empty"),
+	StateRetentionFlag:            flag.NewStringFlag(StateRetentionFlag, string(common.ArchiveStateRetention), "Which batches the enclave keeps the state of: archive (all the batches) or full (the latest stateRetentionBatches batches)"),
+	StateRetentionBatchesFlag:     flag.NewUint64Flag(StateRetentionBatchesFlag, 128, "The number of the latest batches whose state a full node keeps. The historical queries at older batches are refused"),
+	RequestTimeoutFlag:            flag.NewUint64Flag(RequestTimeoutFlag, 0, "The number of seconds after which the enclave aborts a user request (e.g. an eth_call). Zero means no timeout"),
+	CallExecutionTimeoutFlag:      flag.NewUint64Flag(CallExecutionTimeoutFlag, 5000, "The number of milliseconds after which the enclave aborts the execution of a call, a gas estimation or a trace requested by a user"),
+	CallMemoryCapFlag:             flag.NewUint64Flag(CallMemoryCapFlag, 64<<20, "The most EVM memory in bytes the execution of a call, a gas estimation or a trace requested by a user can use"),
+	RPCMaxConcurrencyFlag:         flag.NewUint64Flag(RPCMaxConcurrencyFlag, 32, "The total weight of the user requests the enclave executes at the same time. The calls and the gas estimations weigh more than the other reads. Zero means unbounded"),
+	RPCQueueSizeFlag:              flag.NewUint64Flag(RPCQueueSizeFlag, 512, "The number of user requests waiting for the enclave to execute them. The others are refused as the server is busy. Ignored when the concurrency is unbounded"),
+	ResponsePaddingMinBucketFlag:  flag.NewUint64Flag(ResponsePaddingMinBucketFlag, 256, "The size in bytes to which the smallest encrypted user responses are padded, doubled for the larger ones. Zero disables the padding"),
+	ResponsePaddingMaxBucketFlag:  flag.NewUint64Flag(ResponsePaddingMaxBucketFlag, 64*1024, "The largest padding bucket in bytes. The larger encrypted user responses are padded to a multiple of it"),
 }
 
 // enclaveRestrictedFlags are the flags that the enclave can receive ONLY over the Ego signed enclave.json
@@ -74,4 +154,6 @@ var enclaveRestrictedFlags = []string{
 	UseInMemoryDBFlag,
 	ProfilerEnabledFlag,
 	DebugNamespaceEnabledFlag,
+	DebugJSTracersEnabledFlag,
+	DebugAccessUnrestrictedFlag,
 }
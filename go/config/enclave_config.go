@@ -6,12 +6,16 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/flag"
 )
 
+// RedactedValue - the value of the sensitive fields of the sanitized configuration
+const RedactedValue = "<redacted>"
+
 // EnclaveConfig contains the full configuration for an Obscuro enclave service.
 type EnclaveConfig struct {
 	// The identity of the host the enclave service is tied to
@@ -32,12 +36,17 @@ type EnclaveConfig struct {
 	ValidateL1Blocks bool
 	// When validating incoming blocks, the genesis config for the L1 chain
 	GenesisJSON []byte
+	// When validating incoming blocks, the maximum number of L1 blocks a fork can revert
+	L1MaxForkDepth uint64
 	// The management contract address on the L1 network
 	ManagementContractAddress gethcommon.Address
 	// LogLevel determines the verbosity of output logs
 	LogLevel int
 	// The path that the enclave's logs are written to
 	LogPath string
+	// ComponentLogLevels - the verbosity of the logs of the enclave components, by component name, for the components
+	// which are not logged at LogLevel. See log.EnclaveComponents
+	ComponentLogLevels map[string]int
 	// Whether the enclave should use in-memory or persistent storage
 	UseInMemoryDB bool
 	// host address for the edgeless DB instance (can be empty if using InMemory DB or if attestation is disabled)
@@ -51,12 +60,24 @@ type EnclaveConfig struct {
 	MinGasPrice *big.Int
 	// MessageBus L1 Address
 	MessageBusAddress gethcommon.Address
+	// MessengerAddress - the L1 cross chain messenger. Only the messages relayed through it are recorded as consumed on the L1
+	MessengerAddress gethcommon.Address
 	// The identity of the sequencer for the network
 	SequencerID gethcommon.Address
 	// A json string that specifies the prefunded addresses at the genesis of the Obscuro network
 	ObscuroGenesis string
 	// Whether debug calls are available
 	DebugNamespaceEnabled bool
+	// DebugJSTracersEnabled - whether the transactions can be traced with JavaScript tracers, whose code is supplied by
+	// the caller. Only the native tracers are available otherwise
+	DebugJSTracersEnabled bool
+	// DebugAccessUnrestricted - whether any viewing key can trace any transaction or inspect the state of any contract
+	// with the debug calls. Only meant for local test networks. Otherwise, only the sender of a transaction or the owner
+	// of the contract it calls can debug it, and only the owner of a contract can inspect its state
+	DebugAccessUnrestricted bool
+	// ConfigIntrospectionEnabled - whether the host can read the sanitized configuration of the enclave, see
+	// EnclaveConfig.Sanitized. It is always available when the debug namespace is enabled
+	ConfigIntrospectionEnabled bool
 	// Maximum bytes a batch can be uncompressed.
 	MaxBatchSize uint64
 	// MaxRollupSize - configured to be close to what the ethereum clients
@@ -69,6 +90,87 @@ type EnclaveConfig struct {
 	BaseFee                  *big.Int
 	GasBatchExecutionLimit   uint64
 	GasLocalExecutionCapFlag uint64
+	// BatchExecutionTarget - the sequencer packs less gas in the batches while they take longer than this to execute.
+	// The gas limit of the batch headers is not affected. Zero disables the scaling
+	BatchExecutionTarget time.Duration
+	// EnclaveBatchProduction - the sequencer enclave produces a batch every BatchInterval on its own, so the chain does
+	// not depend on the host to keep its cadence. The CreateBatch requests of the host are ignored
+	EnclaveBatchProduction bool
+	BatchInterval          time.Duration
+	// MaxBatchInterval - when longer than BatchInterval, the empty batches are skipped until it elapsed since the last batch
+	MaxBatchInterval time.Duration
+	// TxPoolPriceBump - minimum price bump percentage to replace an already pending transaction (nonce)
+	TxPoolPriceBump uint64
+	// TxPoolGlobalCap - maximum number of transactions in the mempool. When reached, the lowest paying ones are evicted
+	TxPoolGlobalCap uint64
+	// TxPoolAccountCap - maximum number of transactions a sender can have in the mempool
+	TxPoolAccountCap uint64
+	// TxPoolTTL - transactions that were not included within this duration are dropped from the mempool
+	TxPoolTTL time.Duration
+	// TxPoolHealthOccupancy - the mempool is reported unhealthy when it holds more than this percentage of TxPoolGlobalCap
+	TxPoolHealthOccupancy uint64
+	// TxPoolHealthAdmissionTimeout - the mempool of the sequencer is reported unhealthy when it did not admit a
+	// transaction within this duration. Zero disables the check
+	TxPoolHealthAdmissionTimeout time.Duration
+	// SubscriptionsGlobalCap - maximum number of log subscriptions across all clients
+	SubscriptionsGlobalCap uint64
+	// SubscriptionsViewingKeyCap - maximum number of log subscriptions registered with the same viewing key
+	SubscriptionsViewingKeyCap uint64
+	// SubscriptionKeepAlive - subscriptions not renewed within this window are dropped. Zero disables the expiry
+	SubscriptionKeepAlive time.Duration
+	// SubscriptionReorgDepth - the number of batches for which the logs delivered to subscribers are remembered, so
+	// they can be sent again flagged as removed when the batches are reorged
+	SubscriptionReorgDepth uint64
+	// SubscriptionHealthBacklog - the subscriptions are reported unhealthy when more logs than this wait to be delivered
+	SubscriptionHealthBacklog uint64
+	// SecretResponseLimit - the maximum number of secret responses produced for the same enclave within the
+	// SecretResponseWindow, on top of the requests with an already answered attestation being skipped. Zero disables it
+	SecretResponseLimit uint64
+	// SecretResponseWindow - the window within which the secret responses to an enclave are limited
+	SecretResponseWindow time.Duration
+	// L1ConfirmationDepth - the number of L1 blocks that must be built on top of the block of a cross chain message
+	// before the message can be included in a batch. Must be the same for all the enclaves of the network
+	L1ConfirmationDepth uint64
+	// SignatureDomainActivationSeqNo - the first batch seq no for which the sequencer signs a digest separated by the type
+	// of message and the chain ID, instead of the bare header hash. Must be the same for all the enclaves of the network
+	SignatureDomainActivationSeqNo uint64
+	// InboundBudgetActivationSeqNo - the first batch seq no whose synthetic transactions are created for the inbound
+	// items oldest first, within the common.SyntheticTxGasBudget, deferring the ones which do not fit. The earlier
+	// batches include them all. Must be the same for all the enclaves of the network
+	InboundBudgetActivationSeqNo uint64
+	// BatchTimeRulesActivationSeqNo - the first batch seq no whose timestamp must follow the components.BatchTimeRules.
+	// Must be the same for all the enclaves of the network
+	BatchTimeRulesActivationSeqNo uint64
+	// StopTimeout - how long the enclave waits for the requests in progress to finish when stopping
+	StopTimeout time.Duration
+	// MetricsEnabled - whether the enclave components collect metrics. Only aggregates are collected, never per-user data
+	MetricsEnabled bool
+	// TracesPath - the file to which the spans of the enclave operations are exported. Tracing is disabled when empty
+	TracesPath string
+	// StateRetention - whether the enclave keeps the state of every batch (archive), or only of the latest
+	// StateRetentionBatches batches (full), in which case the queries at older batches return errutil.ErrStateUnavailable
+	StateRetention common.StateRetentionMode
+	// StateRetentionBatches - the number of the latest batches whose state is kept in the full mode
+	StateRetentionBatches uint64
+	// RequestTimeout - the user requests (e.g. eth_call) still running after this duration are aborted. Zero means no timeout
+	RequestTimeout time.Duration
+	// CallExecutionTimeout - the wall-clock time after which an execution requested by a user (a call, a gas estimation
+	// or a trace) is aborted. Unlike RequestTimeout, it applies to each execution, or to all the executions of a gas
+	// estimation together, and is always enforced
+	CallExecutionTimeout time.Duration
+	// CallMemoryCap - the most EVM memory, in bytes, an execution requested by a user can use across its call frames
+	CallMemoryCap uint64
+	// RPCMaxConcurrency - the total weight of the user requests executed at the same time, so a spike of calls does not
+	// slow down the production of the batches. Zero means unbounded
+	RPCMaxConcurrency uint64
+	// RPCQueueSize - the number of user requests waiting for RPCMaxConcurrency. The others are refused as the server is busy.
+	// Ignored when RPCMaxConcurrency is unbounded, as the requests never wait
+	RPCQueueSize uint64
+	// ResponsePaddingMinBucket - the size to which the encrypted user responses are padded, so their size does not leak
+	// their content. It is doubled for the larger responses, up to ResponsePaddingMaxBucket. Zero disables the padding
+	ResponsePaddingMinBucket uint64
+	// ResponsePaddingMaxBucket - the largest padding bucket. The larger responses are padded to a multiple of it
+	ResponsePaddingMaxBucket uint64
 }
 
 func NewConfigFromFlags(cliFlags map[string]*flag.TenFlag) (*EnclaveConfig, error) {
@@ -169,24 +271,126 @@ func newConfig(flags map[string]*flag.TenFlag) (*EnclaveConfig, error) {
 	cfg.ObscuroChainID = flags[ObscuroChainIDFlag].Int64()
 	cfg.WillAttest = flags[WillAttestFlag].Bool()
 	cfg.ValidateL1Blocks = flags[ValidateL1BlocksFlag].Bool()
+	cfg.L1MaxForkDepth = flags[L1MaxForkDepthFlag].Uint64()
 	cfg.ManagementContractAddress = gethcommon.HexToAddress(flags[ManagementContractAddressFlag].String())
 	cfg.LogLevel = flags[LogLevelFlag].Int()
 	cfg.LogPath = flags[LogPathFlag].String()
+	cfg.ComponentLogLevels, err = parseComponentLogLevels(flags[ComponentLogLevelsFlag].String())
+	if err != nil {
+		return nil, err
+	}
 	cfg.UseInMemoryDB = flags[UseInMemoryDBFlag].Bool()
 	cfg.EdgelessDBHost = flags[EdgelessDBHostFlag].String()
 	cfg.SqliteDBPath = flags[SQLiteDBPathFlag].String()
 	cfg.ProfilerEnabled = flags[ProfilerEnabledFlag].Bool()
 	cfg.MinGasPrice = big.NewInt(flags[MinGasPriceFlag].Int64())
 	cfg.MessageBusAddress = gethcommon.HexToAddress(flags[MessageBusAddressFlag].String())
+	cfg.MessengerAddress = gethcommon.HexToAddress(flags[MessengerAddressFlag].String())
 	cfg.SequencerID = gethcommon.HexToAddress(flags[SequencerIDFlag].String())
 	cfg.ObscuroGenesis = flags[ObscuroGenesisFlag].String()
 	cfg.DebugNamespaceEnabled = flags[DebugNamespaceEnabledFlag].Bool()
+	cfg.DebugJSTracersEnabled = flags[DebugJSTracersEnabledFlag].Bool()
+	cfg.DebugAccessUnrestricted = flags[DebugAccessUnrestrictedFlag].Bool()
+	cfg.ConfigIntrospectionEnabled = flags[ConfigIntrospectionFlag].Bool()
 	cfg.MaxBatchSize = flags[MaxBatchSizeFlag].Uint64()
 	cfg.MaxRollupSize = flags[MaxRollupSizeFlag].Uint64()
 	cfg.BaseFee = big.NewInt(0).SetUint64(flags[L2BaseFeeFlag].Uint64())
 	cfg.GasPaymentAddress = gethcommon.HexToAddress(flags[L2CoinbaseFlag].String())
 	cfg.GasBatchExecutionLimit = flags[GasBatchExecutionLimit].Uint64()
 	cfg.GasLocalExecutionCapFlag = flags[GasLocalExecutionCapFlag].Uint64()
+	cfg.BatchExecutionTarget = time.Duration(flags[BatchExecutionTargetFlag].Uint64()) * time.Millisecond
+	cfg.EnclaveBatchProduction = flags[EnclaveBatchProductionFlag].Bool()
+	cfg.BatchInterval = time.Duration(flags[BatchIntervalFlag].Uint64()) * time.Millisecond
+	cfg.MaxBatchInterval = time.Duration(flags[MaxBatchIntervalFlag].Uint64()) * time.Millisecond
+	cfg.TxPoolPriceBump = flags[TxPoolPriceBumpFlag].Uint64()
+	cfg.TxPoolGlobalCap = flags[TxPoolGlobalCapFlag].Uint64()
+	cfg.TxPoolAccountCap = flags[TxPoolAccountCapFlag].Uint64()
+	cfg.TxPoolTTL = time.Duration(flags[TxPoolTTLFlag].Uint64()) * time.Second
+	cfg.TxPoolHealthOccupancy = flags[TxPoolHealthOccupancyFlag].Uint64()
+	cfg.TxPoolHealthAdmissionTimeout = time.Duration(flags[TxPoolHealthAdmissionFlag].Uint64()) * time.Second
+	cfg.SubscriptionsGlobalCap = flags[SubscriptionsGlobalCapFlag].Uint64()
+	cfg.SubscriptionsViewingKeyCap = flags[SubscriptionsVKCapFlag].Uint64()
+	cfg.SubscriptionKeepAlive = time.Duration(flags[SubscriptionKeepAliveFlag].Uint64()) * time.Second
+	cfg.SubscriptionReorgDepth = flags[SubscriptionReorgDepthFlag].Uint64()
+	cfg.SubscriptionHealthBacklog = flags[SubscriptionHealthBacklogFlag].Uint64()
+	cfg.SecretResponseLimit = flags[SecretResponseLimitFlag].Uint64()
+	cfg.SecretResponseWindow = time.Duration(flags[SecretResponseWindowFlag].Uint64()) * time.Second
+	cfg.L1ConfirmationDepth = flags[L1ConfirmationDepthFlag].Uint64()
+	cfg.SignatureDomainActivationSeqNo = flags[SignatureDomainActivationFlag].Uint64()
+	cfg.InboundBudgetActivationSeqNo = flags[InboundBudgetActivationFlag].Uint64()
+	cfg.BatchTimeRulesActivationSeqNo = flags[BatchTimeRulesActivationFlag].Uint64()
+	cfg.StopTimeout = time.Duration(flags[StopTimeoutFlag].Uint64()) * time.Second
+	cfg.MetricsEnabled = flags[MetricsEnabledFlag].Bool()
+	cfg.TracesPath = flags[TracesPathFlag].String()
+	cfg.StateRetention = common.StateRetentionMode(flags[StateRetentionFlag].String())
+	cfg.StateRetentionBatches = flags[StateRetentionBatchesFlag].Uint64()
+	cfg.RequestTimeout = time.Duration(flags[RequestTimeoutFlag].Uint64()) * time.Second
+	cfg.CallExecutionTimeout = time.Duration(flags[CallExecutionTimeoutFlag].Uint64()) * time.Millisecond
+	cfg.CallMemoryCap = flags[CallMemoryCapFlag].Uint64()
+	cfg.RPCMaxConcurrency = flags[RPCMaxConcurrencyFlag].Uint64()
+	cfg.RPCQueueSize = flags[RPCQueueSizeFlag].Uint64()
+	cfg.ResponsePaddingMinBucket = flags[ResponsePaddingMinBucketFlag].Uint64()
+	cfg.ResponsePaddingMaxBucket = flags[ResponsePaddingMaxBucketFlag].Uint64()
 
 	return cfg, nil
 }
+
+// Sanitized - a copy of the configuration which can be shown outside the enclave: the paths of the files and the address
+// of the database are redacted, and the L1 genesis is left out, as it is large and only its hash is of interest
+func (c *EnclaveConfig) Sanitized() *EnclaveConfig {
+	sanitized := *c
+	redact := func(value string) string {
+		if value == "" {
+			return ""
+		}
+		return RedactedValue
+	}
+	sanitized.LogPath = redact(c.LogPath)
+	sanitized.EdgelessDBHost = redact(c.EdgelessDBHost)
+	sanitized.SqliteDBPath = redact(c.SqliteDBPath)
+	sanitized.TracesPath = redact(c.TracesPath)
+	sanitized.GenesisJSON = nil
+	sanitized.ComponentLogLevels = make(map[string]int, len(c.ComponentLogLevels))
+	for component, level := range c.ComponentLogLevels {
+		sanitized.ComponentLogLevels[component] = level
+	}
+	if c.MinGasPrice != nil {
+		sanitized.MinGasPrice = new(big.Int).Set(c.MinGasPrice)
+	}
+	if c.BaseFee != nil {
+		sanitized.BaseFee = new(big.Int).Set(c.BaseFee)
+	}
+	return &sanitized
+}
+
+// MostVerboseLogLevel - the most verbose of the levels at which the enclave and its components are logged. The records
+// which are more verbose are not logged, even when a component is set to a more verbose level at runtime.
+func (c *EnclaveConfig) MostVerboseLogLevel() int {
+	level := c.LogLevel
+	for _, componentLevel := range c.ComponentLogLevels {
+		if componentLevel > level {
+			level = componentLevel
+		}
+	}
+	return level
+}
+
+// parseComponentLogLevels - parses the comma-separated component=level pairs of the log levels of the components
+func parseComponentLogLevels(value string) (map[string]int, error) {
+	levels := make(map[string]int)
+	if value == "" {
+		return levels, nil
+	}
+	for _, entry := range strings.Split(value, ",") {
+		component, level, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found || component == "" {
+			return nil, fmt.Errorf("invalid component log level %q, expected component=level", entry)
+		}
+		parsedLevel, err := strconv.Atoi(level)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log level of component %s - %w", component, err)
+		}
+		levels[component] = parsedLevel
+	}
+	return levels, nil
+}
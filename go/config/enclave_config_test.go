@@ -1,12 +1,17 @@
 package config
 
 import (
+	"errors"
 	"flag"
+	"math"
 	"math/big"
 	"strings"
 	"testing"
+	"time"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
 	tenflag "github.com/ten-protocol/go-ten/go/common/flag"
 )
 
@@ -60,6 +65,60 @@ func TestCLIFlagTypes(t *testing.T) {
 	require.Equal(t, 123, enclaveConfig.LogLevel)
 	require.Equal(t, big.NewInt(3333), enclaveConfig.MinGasPrice)
 	require.Equal(t, uint64(222222), enclaveConfig.GasBatchExecutionLimit)
+	// the existing networks replay their batches with the rules they were produced with
+	require.Equal(t, uint64(math.MaxUint64), enclaveConfig.SignatureDomainActivationSeqNo)
+	require.Equal(t, uint64(math.MaxUint64), enclaveConfig.InboundBudgetActivationSeqNo)
+	require.Equal(t, uint64(math.MaxUint64), enclaveConfig.BatchTimeRulesActivationSeqNo)
+}
+
+func TestComponentLogLevelsFlag(t *testing.T) {
+	levels, err := parseComponentLogLevels("subscription_manager=4, txpool=2")
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"subscription_manager": 4, "txpool": 2}, levels)
+
+	levels, err = parseComponentLogLevels("")
+	require.NoError(t, err)
+	require.Empty(t, levels)
+
+	for _, invalid := range []string{"txpool", "=3", "txpool=debug"} {
+		_, err = parseComponentLogLevels(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestMostVerboseLogLevel(t *testing.T) {
+	cfg := &EnclaveConfig{LogLevel: 3}
+	require.Equal(t, 3, cfg.MostVerboseLogLevel())
+	cfg.ComponentLogLevels = map[string]int{"txpool": 2, "subscription_manager": 5}
+	require.Equal(t, 5, cfg.MostVerboseLogLevel())
+}
+
+func TestSanitizedConfigRedactsSensitiveFields(t *testing.T) {
+	cfg := &EnclaveConfig{
+		LogPath:            "/var/log/enclave.log",
+		SqliteDBPath:       "/data/enclave.db",
+		EdgelessDBHost:     "edgelessdb.internal",
+		GenesisJSON:        []byte(`{"config":{}}`),
+		ComponentLogLevels: map[string]int{"txpool": 2},
+		MinGasPrice:        big.NewInt(1),
+		ObscuroChainID:     443,
+	}
+
+	sanitized := cfg.Sanitized()
+	require.Equal(t, RedactedValue, sanitized.LogPath)
+	require.Equal(t, RedactedValue, sanitized.SqliteDBPath)
+	require.Equal(t, RedactedValue, sanitized.EdgelessDBHost)
+	require.Empty(t, sanitized.TracesPath) // unset fields are not marked as redacted
+	require.Nil(t, sanitized.GenesisJSON)
+	require.Equal(t, int64(443), sanitized.ObscuroChainID)
+
+	// the original is left untouched
+	sanitized.ComponentLogLevels["txpool"] = 5
+	sanitized.MinGasPrice.SetInt64(2)
+	require.Equal(t, "/var/log/enclave.log", cfg.LogPath)
+	require.NotNil(t, cfg.GenesisJSON)
+	require.Equal(t, 2, cfg.ComponentLogLevels["txpool"])
+	require.Equal(t, int64(1), cfg.MinGasPrice.Int64())
 }
 
 func TestRestrictedMode(t *testing.T) {
@@ -80,6 +139,8 @@ func TestRestrictedMode(t *testing.T) {
 	t.Setenv("EDG_"+strings.ToUpper(UseInMemoryDBFlag), "true")
 	t.Setenv("EDG_"+strings.ToUpper(ProfilerEnabledFlag), "true")
 	t.Setenv("EDG_"+strings.ToUpper(DebugNamespaceEnabledFlag), "true")
+	t.Setenv("EDG_"+strings.ToUpper(DebugJSTracersEnabledFlag), "false")
+	t.Setenv("EDG_"+strings.ToUpper(DebugAccessUnrestrictedFlag), "false")
 
 	flags := EnclaveFlags
 	err := tenflag.CreateCLIFlags(flags)
@@ -99,6 +160,8 @@ func TestRestrictedMode(t *testing.T) {
 	require.Equal(t, true, enclaveConfig.UseInMemoryDB)
 	require.Equal(t, true, enclaveConfig.ProfilerEnabled)
 	require.Equal(t, true, enclaveConfig.DebugNamespaceEnabled)
+	require.Equal(t, false, enclaveConfig.DebugJSTracersEnabled)
+	require.Equal(t, false, enclaveConfig.DebugAccessUnrestricted)
 }
 
 func TestRestrictedModeNoCLIDuplication(t *testing.T) {
@@ -119,6 +182,8 @@ func TestRestrictedModeNoCLIDuplication(t *testing.T) {
 	t.Setenv("EDG_"+strings.ToUpper(UseInMemoryDBFlag), "true")
 	t.Setenv("EDG_"+strings.ToUpper(ProfilerEnabledFlag), "true")
 	t.Setenv("EDG_"+strings.ToUpper(DebugNamespaceEnabledFlag), "true")
+	t.Setenv("EDG_"+strings.ToUpper(DebugJSTracersEnabledFlag), "false")
+	t.Setenv("EDG_"+strings.ToUpper(DebugAccessUnrestrictedFlag), "false")
 
 	flags := EnclaveFlags
 	err := tenflag.CreateCLIFlags(flags)
@@ -135,3 +200,115 @@ func TestRestrictedModeNoCLIDuplication(t *testing.T) {
 	_, err = NewConfigFromFlags(flags)
 	require.Errorf(t, err, "restricted flag was set: l1ChainID")
 }
+
+func validConfig() *EnclaveConfig {
+	return &EnclaveConfig{
+		NodeType:             common.Validator,
+		SequencerID:          gethcommon.HexToAddress("0x1"),
+		MaxBatchSize:         1024 * 32,
+		MaxRollupSize:        1024 * 64,
+		StateRetention:       common.ArchiveStateRetention,
+		CallExecutionTimeout: 5 * time.Second,
+		CallMemoryCap:        64 << 20,
+	}
+}
+
+func TestValidConfig(t *testing.T) {
+	require.NoError(t, validConfig().Validate())
+
+	// the default queue size is ignored when the concurrency is unbounded
+	cfg := validConfig()
+	cfg.RPCMaxConcurrency = 0
+	cfg.RPCQueueSize = 512
+	require.NoError(t, cfg.Validate())
+}
+
+func TestInvalidConfig(t *testing.T) {
+	tests := map[string]struct {
+		field  string
+		modify func(cfg *EnclaveConfig)
+	}{
+		"unknown node type": {"NodeType", func(cfg *EnclaveConfig) {
+			cfg.NodeType = common.Unknown
+		}},
+		"validator without sequencer ID": {"SequencerID", func(cfg *EnclaveConfig) {
+			cfg.SequencerID = gethcommon.Address{}
+		}},
+		"block validation without genesis": {"GenesisJSON", func(cfg *EnclaveConfig) {
+			cfg.ValidateL1Blocks = true
+		}},
+		"zero max batch size": {"MaxBatchSize", func(cfg *EnclaveConfig) {
+			cfg.MaxBatchSize = 0
+			cfg.MaxRollupSize = 0
+		}},
+		"rollup smaller than a batch": {"MaxRollupSize", func(cfg *EnclaveConfig) {
+			cfg.MaxRollupSize = cfg.MaxBatchSize - 1
+		}},
+		"batch production on a validator": {"EnclaveBatchProduction", func(cfg *EnclaveConfig) {
+			cfg.EnclaveBatchProduction = true
+			cfg.BatchInterval = time.Second
+		}},
+		"mempool occupancy over the capacity": {"TxPoolHealthOccupancy", func(cfg *EnclaveConfig) {
+			cfg.TxPoolHealthOccupancy = 101
+		}},
+		"secret response limit without a window": {"SecretResponseWindow", func(cfg *EnclaveConfig) {
+			cfg.SecretResponseLimit = 3
+			cfg.SecretResponseWindow = 0
+		}},
+		"admission timeout on a validator": {"TxPoolHealthAdmissionTimeout", func(cfg *EnclaveConfig) {
+			cfg.TxPoolHealthAdmissionTimeout = time.Minute
+		}},
+		"batch production without interval": {"BatchInterval", func(cfg *EnclaveConfig) {
+			cfg.NodeType = common.Sequencer
+			cfg.EnclaveBatchProduction = true
+		}},
+		"js tracers without the debug namespace": {"DebugJSTracersEnabled", func(cfg *EnclaveConfig) {
+			cfg.DebugJSTracersEnabled = true
+		}},
+		"unrestricted debug access without the debug namespace": {"DebugAccessUnrestricted", func(cfg *EnclaveConfig) {
+			cfg.DebugAccessUnrestricted = true
+		}},
+		"unknown state retention mode": {"StateRetention", func(cfg *EnclaveConfig) {
+			cfg.StateRetention = "pruned"
+		}},
+		"full state retention without a window": {"StateRetentionBatches", func(cfg *EnclaveConfig) {
+			cfg.StateRetention = common.FullStateRetention
+		}},
+		"unbounded call execution time": {"CallExecutionTimeout", func(cfg *EnclaveConfig) {
+			cfg.CallExecutionTimeout = 0
+		}},
+		"unbounded call memory": {"CallMemoryCap", func(cfg *EnclaveConfig) {
+			cfg.CallMemoryCap = 0
+		}},
+		"padding max bucket smaller than the min bucket": {"ResponsePaddingMaxBucket", func(cfg *EnclaveConfig) {
+			cfg.ResponsePaddingMinBucket = 256
+			cfg.ResponsePaddingMaxBucket = 128
+		}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := validConfig()
+			test.modify(cfg)
+
+			err := cfg.Validate()
+			require.Error(t, err)
+			var fieldErr *InvalidFieldError
+			require.True(t, errors.As(err, &fieldErr))
+			require.Equal(t, test.field, fieldErr.Field)
+			require.Contains(t, err.Error(), test.field)
+		})
+	}
+}
+
+func TestInvalidConfigListsAllFields(t *testing.T) {
+	cfg := validConfig()
+	cfg.SequencerID = gethcommon.Address{}
+	cfg.ValidateL1Blocks = true
+	cfg.MaxRollupSize = 1
+
+	err := cfg.Validate()
+	require.Error(t, err)
+	for _, field := range []string{"SequencerID", "GenesisJSON", "MaxRollupSize"} {
+		require.Contains(t, err.Error(), field)
+	}
+}
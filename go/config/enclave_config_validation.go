@@ -0,0 +1,83 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+// InvalidFieldError - a field of the configuration which is invalid, or inconsistent with another field
+type InvalidFieldError struct {
+	Field  string
+	Reason string
+}
+
+func (e *InvalidFieldError) Error() string {
+	return fmt.Sprintf("invalid enclave config field %s: %s", e.Field, e.Reason)
+}
+
+// Validate returns an error listing every invalid field of the configuration, or nil if it is valid.
+// Each entry of the joined error is an *InvalidFieldError.
+func (c *EnclaveConfig) Validate() error {
+	var errs []error
+	invalid := func(field string, reason string, args ...any) {
+		errs = append(errs, &InvalidFieldError{Field: field, Reason: fmt.Sprintf(reason, args...)})
+	}
+
+	if c.NodeType != common.Sequencer && c.NodeType != common.Validator {
+		invalid("NodeType", "must be %s or %s, got %s", common.Sequencer, common.Validator, c.NodeType)
+	}
+	if c.NodeType == common.Validator && c.SequencerID == (gethcommon.Address{}) {
+		invalid("SequencerID", "must be set on a validator, to verify the batches it receives")
+	}
+	if c.ValidateL1Blocks && c.GenesisJSON == nil {
+		invalid("GenesisJSON", "must be set when ValidateL1Blocks is enabled")
+	}
+	if c.MaxBatchSize == 0 {
+		invalid("MaxBatchSize", "must be greater than zero")
+	}
+	if c.MaxRollupSize < c.MaxBatchSize {
+		invalid("MaxRollupSize", "must be at least MaxBatchSize (%d) so a rollup can hold a batch, got %d", c.MaxBatchSize, c.MaxRollupSize)
+	}
+	if c.DebugJSTracersEnabled && !c.DebugNamespaceEnabled {
+		invalid("DebugJSTracersEnabled", "requires DebugNamespaceEnabled")
+	}
+	if c.DebugAccessUnrestricted && !c.DebugNamespaceEnabled {
+		invalid("DebugAccessUnrestricted", "requires DebugNamespaceEnabled")
+	}
+	if c.EnclaveBatchProduction && c.NodeType != common.Sequencer {
+		invalid("EnclaveBatchProduction", "can only be enabled on a sequencer")
+	}
+	if c.EnclaveBatchProduction && c.BatchInterval == 0 {
+		invalid("BatchInterval", "must be greater than zero when EnclaveBatchProduction is enabled")
+	}
+	if c.StateRetention != common.ArchiveStateRetention && c.StateRetention != common.FullStateRetention {
+		invalid("StateRetention", "must be %s or %s, got %q", common.ArchiveStateRetention, common.FullStateRetention, c.StateRetention)
+	}
+	if c.StateRetention == common.FullStateRetention && c.StateRetentionBatches == 0 {
+		invalid("StateRetentionBatches", "must be greater than zero in the %s mode", common.FullStateRetention)
+	}
+	if c.TxPoolHealthOccupancy > 100 {
+		invalid("TxPoolHealthOccupancy", "must be a percentage of TxPoolGlobalCap, got %d", c.TxPoolHealthOccupancy)
+	}
+	if c.TxPoolHealthAdmissionTimeout > 0 && c.NodeType != common.Sequencer {
+		invalid("TxPoolHealthAdmissionTimeout", "can only be set on a sequencer, the validators forward the transactions they receive")
+	}
+	if c.SecretResponseLimit > 0 && c.SecretResponseWindow <= 0 {
+		invalid("SecretResponseWindow", "must be greater than zero when SecretResponseLimit is set")
+	}
+	if c.CallExecutionTimeout <= 0 {
+		invalid("CallExecutionTimeout", "must be greater than zero, the executions requested by the users must be bounded")
+	}
+	if c.CallMemoryCap == 0 {
+		invalid("CallMemoryCap", "must be greater than zero, the executions requested by the users must be bounded")
+	}
+
+	if c.ResponsePaddingMinBucket > 0 && c.ResponsePaddingMaxBucket < c.ResponsePaddingMinBucket {
+		invalid("ResponsePaddingMaxBucket", "must be at least ResponsePaddingMinBucket (%d) when the padding is enabled, got %d", c.ResponsePaddingMinBucket, c.ResponsePaddingMaxBucket)
+	}
+
+	return errors.Join(errs...)
+}
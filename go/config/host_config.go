@@ -43,6 +43,15 @@ type HostInputConfig struct {
 	EnclaveRPCTimeout time.Duration
 	// Timeout duration for connecting to, and communicating with, the L1 node
 	L1RPCTimeout time.Duration
+	// Whether to submit the receipts of all the transactions of the L1 blocks, which enclaves that validate the blocks need
+	L1FullReceipts bool
+	// Whether to submit only the relevant receipts of the L1 blocks, with proofs against the receipts roots
+	L1ReceiptProofs bool
+	// Whether to ask the enclave to compress the streamed batches, if it supports it
+	CompressL2Updates bool
+	// The state snapshot exported for the enclave of this validator, imported before it processes the L1 blocks, so it
+	// does not replay the batches from genesis. It is only imported while the database of the enclave is empty.
+	StateSnapshotPath string
 	// Timeout duration for messaging between hosts.
 	P2PConnectionTimeout time.Duration
 	// The rollup contract address on the L1 network
@@ -117,6 +126,10 @@ func (p HostInputConfig) ToHostConfig() *HostConfig {
 		L1WebsocketURL:            p.L1WebsocketURL,
 		EnclaveRPCTimeout:         p.EnclaveRPCTimeout,
 		L1RPCTimeout:              p.L1RPCTimeout,
+		L1FullReceipts:            p.L1FullReceipts,
+		L1ReceiptProofs:           p.L1ReceiptProofs,
+		CompressL2Updates:         p.CompressL2Updates,
+		StateSnapshotPath:         p.StateSnapshotPath,
 		P2PConnectionTimeout:      p.P2PConnectionTimeout,
 		ManagementContractAddress: p.ManagementContractAddress,
 		MessageBusAddress:         p.MessageBusAddress,
@@ -220,6 +233,15 @@ type HostConfig struct {
 	EnclaveRPCTimeout time.Duration
 	// Timeout duration for connecting to, and communicating with, the L1 node
 	L1RPCTimeout time.Duration
+	// Whether to submit the receipts of all the transactions of the L1 blocks, which enclaves that validate the blocks need
+	L1FullReceipts bool
+	// Whether to submit only the relevant receipts of the L1 blocks, with proofs against the receipts roots
+	L1ReceiptProofs bool
+	// Whether to ask the enclave to compress the streamed batches, if it supports it
+	CompressL2Updates bool
+	// The state snapshot exported for the enclave of this validator, imported before it processes the L1 blocks, so it
+	// does not replay the batches from genesis. It is only imported while the database of the enclave is empty.
+	StateSnapshotPath string
 	// Timeout duration for messaging between hosts.
 	P2PConnectionTimeout time.Duration
 	// ProfilerEnabled starts a profiler instance
@@ -270,5 +292,6 @@ func DefaultHostParsedConfig() *HostInputConfig {
 		L1BlockTime:          15 * time.Second,
 		IsInboundP2PDisabled: false,
 		MaxRollupSize:        1024 * 64,
+		CompressL2Updates:    true,
 	}
 }
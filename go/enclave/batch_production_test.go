@@ -0,0 +1,233 @@
+package enclave
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/json"
+	"math"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/compression"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/events"
+	"github.com/ten-protocol/go-ten/go/enclave/nodetype"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+const testBatchInterval = 5 * time.Millisecond
+
+// tickingSequencer - every batch extends the head, and is stored and reported as executed
+type tickingSequencer struct {
+	nodetype.Sequencer
+	storage  storage.Storage
+	registry components.BatchRegistry
+	l1Proof  common.L1BlockHash
+	produced atomic.Uint64
+}
+
+func (s *tickingSequencer) CreateBatch(bool) (*core.Batch, error) {
+	seqNo := s.produced.Load() + 1
+	batch := &core.Batch{Header: &common.BatchHeader{
+		Number:           big.NewInt(int64(seqNo)),
+		SequencerOrderNo: big.NewInt(int64(seqNo)),
+		L1Proof:          s.l1Proof,
+	}}
+	if err := s.storage.StoreBatch(batch, batch.Hash()); err != nil {
+		return nil, err
+	}
+	s.registry.OnBatchExecuted(batch, nil)
+	s.produced.Store(seqNo)
+	return batch, nil
+}
+
+func (s *tickingSequencer) BatchGasLimit() uint64 {
+	return 0
+}
+
+func TestEnclaveBatchProduction(t *testing.T) {
+	enclave, seq := newBatchProductionTestEnclave(t)
+	go enclave.produceBatches()
+	require.Eventually(t, func() bool { return seq.produced.Load() >= 3 }, lockTestTimeout, testBatchInterval)
+
+	// the requests of the host are ignored
+	result, err := enclave.CreateBatch(false)
+	require.Nil(t, err)
+	require.Equal(t, &common.CreateBatchResult{SkipReason: common.BatchSkippedInEnclave}, result)
+
+	// no batch is produced while paused
+	require.Nil(t, enclave.PauseBatchProduction())
+	produced := seq.produced.Load()
+	time.Sleep(10 * testBatchInterval)
+	require.Equal(t, produced, seq.produced.Load())
+	require.Nil(t, enclave.ResumeBatchProduction())
+	require.Eventually(t, func() bool { return seq.produced.Load() > produced }, lockTestTimeout, testBatchInterval)
+
+	// the production stops with the enclave
+	enclave.stopControl.Stop()
+	require.True(t, enclave.stopControl.WaitForOperations(lockTestTimeout))
+	produced = seq.produced.Load()
+	time.Sleep(10 * testBatchInterval)
+	require.Equal(t, produced, seq.produced.Load())
+}
+
+func TestEnclaveBatchesAreReceivedOnceAfterHostReconnection(t *testing.T) {
+	enclave, seq := newBatchProductionTestEnclave(t)
+	go enclave.produceBatches()
+	defer enclave.stopControl.Stop()
+
+	updates, stop := enclave.StreamL2Updates()
+	received := streamedSeqNos(t, updates, 3)
+
+	// the host crashes mid-stream, and the enclave keeps producing batches
+	stop()
+	lastReceived := received[len(received)-1]
+	require.Eventually(t, func() bool { return seq.produced.Load() >= lastReceived+3 }, lockTestTimeout, testBatchInterval)
+
+	// once reconnected, the host fetches the batches it missed, and skips those it receives again from the new stream
+	updates, stop = enclave.StreamL2Updates()
+	defer stop()
+	missed, err := enclave.GetBatchesBySeqRange(lastReceived+1, math.MaxInt64, 0)
+	require.Nil(t, err)
+	require.GreaterOrEqual(t, len(missed), 3)
+	for _, batch := range missed {
+		received = append(received, batch.Header.SequencerOrderNo.Uint64())
+	}
+	for _, seqNo := range streamedSeqNos(t, updates, 3) {
+		if seqNo > received[len(received)-1] {
+			received = append(received, seqNo)
+		}
+	}
+
+	for i, seqNo := range received {
+		require.Equal(t, uint64(i+1), seqNo)
+	}
+}
+
+func newBatchProductionTestEnclave(t *testing.T) (*enclaveImpl, *tickingSequencer) {
+	logger := gethlog.New()
+	enclave, _ := newLockTestEnclave(t)
+	genesis := lockTestBlock(gethcommon.Hash{}, 0, "")
+	submitL1Block(t, enclave, genesis)
+
+	registry := components.NewBatchRegistry(enclave.storage, logger)
+	seq := &tickingSequencer{storage: enclave.storage, registry: registry, l1Proof: genesis.Hash()}
+	enclave.config = &config.EnclaveConfig{EnclaveBatchProduction: true, BatchInterval: testBatchInterval}
+	enclave.service = seq
+	enclave.registry = registry
+	enclave.dataEncryptionService = crypto.NewDataEncryptionService(logger)
+	enclave.dataCompressionService = compression.NewBrotliDataCompressionService()
+	enclave.subscriptionManager = events.NewSubscriptionManager(enclave.storage, enclave.gethEncodingService, 443, events.SubscriptionLimits{}, logger)
+	return enclave, seq
+}
+
+// streamedSeqNos - the seq numbers of the next n batches of the stream
+func streamedSeqNos(t *testing.T, updates chan common.StreamL2UpdatesResponse, n int) []uint64 {
+	var seqNos []uint64
+	for len(seqNos) < n {
+		select {
+		case update := <-updates:
+			if update.Batch != nil {
+				seqNos = append(seqNos, update.Batch.Header.SequencerOrderNo.Uint64())
+			}
+		case <-time.After(lockTestTimeout):
+			t.Fatalf("received %d batches, expected %d", len(seqNos), n)
+		}
+	}
+	return seqNos
+}
+
+func TestCompressedUpdatesAreSmaller(t *testing.T) {
+	logger := gethlog.New()
+	dataCompression := compression.NewBrotliDataCompressionService()
+	batch := &core.Batch{
+		Header: &common.BatchHeader{
+			ParentHash:       gethcommon.BytesToHash(randomBytes(t, 32)),
+			Root:             gethcommon.BytesToHash(randomBytes(t, 32)),
+			TxHash:           gethcommon.BytesToHash(randomBytes(t, 32)),
+			ReceiptHash:      gethcommon.BytesToHash(randomBytes(t, 32)),
+			Number:           big.NewInt(1000),
+			SequencerOrderNo: big.NewInt(1001),
+			GasLimit:         1_000_000_000,
+			Time:             uint64(time.Now().Unix()),
+			BaseFee:          big.NewInt(1_000_000_000),
+			L1Proof:          gethcommon.BytesToHash(randomBytes(t, 32)),
+		},
+		Transactions: erc20Transfers(t, 1000),
+	}
+	extBatch, err := batch.ToExtBatch(crypto.NewDataEncryptionService(logger), dataCompression)
+	require.NoError(t, err)
+	// the logs of a few subscriptions, encrypted for each of them
+	logs := common.EncryptedSubscriptionLogs{}
+	for i := 0; i < 20; i++ {
+		logs[gethrpc.NewID()] = randomBytes(t, 2_000)
+	}
+	update := common.StreamL2UpdatesResponse{Batch: extBatch, Logs: logs}
+
+	encoded, err := encodeL2Update(update, false, dataCompression)
+	require.NoError(t, err)
+	compressed, err := encodeL2Update(update, true, dataCompression)
+	require.NoError(t, err)
+	require.True(t, compressed.Compressed)
+	reduction := 100 * (1 - float64(len(compressed.Batch))/float64(len(encoded.Batch)))
+	t.Logf("a batch of %d transactions is streamed in %d bytes instead of %d, %.1f%% less", len(batch.Transactions),
+		len(compressed.Batch), len(encoded.Batch), reduction)
+	// the transactions are already compressed and encrypted, so the gain comes from the JSON encoding of the binary data
+	require.Greater(t, reduction, 20.0)
+
+	decompressed, err := dataCompression.Decompress(compressed.Batch)
+	require.NoError(t, err)
+	require.Equal(t, encoded.Batch, decompressed)
+	var decoded common.StreamL2UpdatesResponse
+	require.NoError(t, json.Unmarshal(decompressed, &decoded))
+	require.Equal(t, extBatch.Hash(), decoded.Batch.Hash())
+	require.Equal(t, logs, decoded.Logs)
+}
+
+// erc20Transfers - signed token transfers from a few accounts to distinct recipients
+func erc20Transfers(t *testing.T, n int) common.L2Transactions {
+	signer := types.LatestSignerForChainID(big.NewInt(443))
+	token := gethcommon.BytesToAddress(randomBytes(t, 20))
+	senders := make([]*ecdsa.PrivateKey, 50)
+	for i := range senders {
+		key, err := gethcrypto.GenerateKey()
+		require.NoError(t, err)
+		senders[i] = key
+	}
+
+	txs := make(common.L2Transactions, n)
+	for i := range txs {
+		// transfer(address,uint256)
+		data := append(gethcommon.FromHex("0xa9059cbb"), gethcommon.LeftPadBytes(randomBytes(t, 20), 32)...)
+		data = append(data, gethcommon.LeftPadBytes(big.NewInt(int64(i+1)*1_000_000).Bytes(), 32)...)
+		tx, err := types.SignNewTx(senders[i%len(senders)], signer, &types.LegacyTx{
+			Nonce:    uint64(i / len(senders)),
+			GasPrice: big.NewInt(1_000_000_000),
+			Gas:      60_000,
+			To:       &token,
+			Data:     data,
+		})
+		require.NoError(t, err)
+		txs[i] = tx
+	}
+	return txs
+}
+
+func randomBytes(t *testing.T, n int) []byte {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	return b
+}
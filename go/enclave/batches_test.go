@@ -0,0 +1,199 @@
+package enclave
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/compression"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+	"github.com/ten-protocol/go-ten/go/responses"
+)
+
+func TestGetBatchByHeightReturnsCanonicalBatch(t *testing.T) {
+	enclave, storageDB := newStorageTestEnclave(t)
+	blockA := testBlock("")
+	require.NoError(t, storageDB.StoreBlock(blockA, nil))
+	for seqNo := int64(1); seqNo <= 3; seqNo++ {
+		storeTestBatch(t, storageDB, seqNo, seqNo, blockA.Hash())
+	}
+	// a batch at the same height, created on a fork
+	blockB := testBlock("fork")
+	storeTestBatch(t, storageDB, 4, 3, blockB.Hash())
+
+	batch, err := enclave.GetBatchByHeight(3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), batch.Header.SequencerOrderNo.Uint64())
+
+	// the fork becomes canonical
+	chainFork := &common.ChainFork{CanonicalPath: []common.L1BlockHash{blockB.Hash()}, NonCanonicalPath: []common.L1BlockHash{blockA.Hash()}}
+	require.NoError(t, storageDB.StoreBlock(blockB, chainFork))
+	batch, err = enclave.GetBatchByHeight(3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), batch.Header.SequencerOrderNo.Uint64())
+
+	_, err = enclave.GetBatchByHeight(5)
+	require.Error(t, err)
+}
+
+func TestGetBatchesBySeqRange(t *testing.T) {
+	enclave, storageDB := newStorageTestEnclave(t)
+	block := testBlock("")
+	require.NoError(t, storageDB.StoreBlock(block, nil))
+	for seqNo := int64(1); seqNo <= common.MaxBatchesPerRange+10; seqNo++ {
+		storeTestBatch(t, storageDB, seqNo, seqNo, block.Hash())
+	}
+
+	tests := map[string]struct {
+		from, to, maxCount uint64
+		expectedSeqNos     []uint64
+	}{
+		"range":                {from: 2, to: 4, maxCount: 10, expectedSeqNos: []uint64{2, 3, 4}},
+		"limited by max count": {from: 2, to: 4, maxCount: 2, expectedSeqNos: []uint64{2, 3}},
+		"past the head":        {from: common.MaxBatchesPerRange + 9, to: common.MaxBatchesPerRange + 20, maxCount: 10, expectedSeqNos: []uint64{common.MaxBatchesPerRange + 9, common.MaxBatchesPerRange + 10}},
+		"no batches":           {from: 1000, to: 1010, maxCount: 10, expectedSeqNos: []uint64{}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			batches, err := enclave.GetBatchesBySeqRange(test.from, test.to, test.maxCount)
+			require.NoError(t, err)
+			seqNos := make([]uint64, len(batches))
+			for i, batch := range batches {
+				seqNos[i] = batch.Header.SequencerOrderNo.Uint64()
+			}
+			require.Equal(t, test.expectedSeqNos, seqNos)
+		})
+	}
+
+	for _, maxCount := range []uint64{0, common.MaxBatchesPerRange + 1} {
+		batches, err := enclave.GetBatchesBySeqRange(1, 1000, maxCount)
+		require.NoError(t, err)
+		require.Len(t, batches, common.MaxBatchesPerRange)
+	}
+
+	_, err := enclave.GetBatchesBySeqRange(4, 2, 10)
+	require.Error(t, err)
+}
+
+func TestBatchExecutionSummaries(t *testing.T) {
+	enclave, storageDB := newStorageTestEnclave(t)
+	blockA := testBlock("")
+	require.NoError(t, storageDB.StoreBlock(blockA, nil))
+	contract := gethcommon.HexToAddress("0xc0de")
+	var receipts []types.Receipts
+	for seqNo := int64(1); seqNo <= 3; seqNo++ {
+		batch := storeTestBatch(t, storageDB, seqNo, 1, blockA.Hash())
+		batchReceipts := types.Receipts{{
+			Status:    types.ReceiptStatusSuccessful,
+			TxHash:    gethcommon.HexToHash("0x1"),
+			BlockHash: batch.Hash(),
+			GasUsed:   21_000,
+			Logs:      []*types.Log{{Address: contract}},
+		}}
+		require.NoError(t, storageDB.StoreExecutedBatch(batch, batchReceipts))
+		receipts = append(receipts, batchReceipts)
+	}
+	// the batch was stored, but not executed
+	storeTestBatch(t, storageDB, 4, 1, blockA.Hash())
+
+	summary, err := enclave.GetBatchExecutionSummary(2)
+	require.NoError(t, err)
+	batch, err := storageDB.FetchBatchBySeqNo(2)
+	require.NoError(t, err)
+	require.Equal(t, batch.Hash(), summary.BatchHash)
+	require.Equal(t, types.DeriveSha(receipts[1], trie.NewStackTrie(nil)), summary.ReceiptsRoot)
+	require.Equal(t, uint64(21_000), summary.GasUsed)
+	require.False(t, summary.Orphaned)
+
+	// the batches which were not executed, or were executed before the summaries were recorded, have none
+	_, err = enclave.GetBatchExecutionSummary(4)
+	require.Equal(t, responses.ErrCodeNotFound, responses.ErrorCodeOf(err))
+
+	summaries, err := enclave.GetBatchExecutionSummaries(2, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+	require.Equal(t, uint64(2), summaries[0].SeqNo)
+	require.Equal(t, uint64(3), summaries[1].SeqNo)
+	summaries, err = enclave.GetBatchExecutionSummaries(1, 3, 1)
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	_, err = enclave.GetBatchExecutionSummaries(3, 1, 0)
+	require.Error(t, err)
+
+	// the block of the batches is reorged, then becomes canonical again
+	blockB := testBlock("fork")
+	chainFork := &common.ChainFork{CanonicalPath: []common.L1BlockHash{blockB.Hash()}, NonCanonicalPath: []common.L1BlockHash{blockA.Hash()}}
+	require.NoError(t, storageDB.StoreBlock(blockB, chainFork))
+	summaries, err = enclave.GetBatchExecutionSummaries(1, 3, 0)
+	require.NoError(t, err)
+	require.Len(t, summaries, 3)
+	for _, summary := range summaries {
+		require.True(t, summary.Orphaned)
+	}
+
+	chainFork = &common.ChainFork{CanonicalPath: []common.L1BlockHash{blockA.Hash()}, NonCanonicalPath: []common.L1BlockHash{blockB.Hash()}}
+	require.NoError(t, storageDB.StoreBlock(testBlock("after fork"), chainFork))
+	summary, err = enclave.GetBatchExecutionSummary(1)
+	require.NoError(t, err)
+	require.False(t, summary.Orphaned)
+}
+
+func TestNetworkInfoIsServedWithoutTheSharedSecret(t *testing.T) {
+	enclave, storageDB := newStorageTestEnclave(t)
+	enclave.config = &config.EnclaveConfig{ObscuroChainID: 443}
+
+	// the enclave has neither the shared secret nor the genesis batch
+	info, err := enclave.GetNetworkInfo()
+	require.NoError(t, err)
+	require.Equal(t, int64(443), info.ChainID)
+	require.Equal(t, "443", info.NetworkVersion)
+	require.True(t, strings.HasPrefix(info.ClientVersion, "Ten/"+Version+"/"))
+	require.Equal(t, common.L2BatchHash{}, info.GenesisBatchHash)
+
+	block := testBlock("")
+	require.NoError(t, storageDB.StoreBlock(block, nil))
+	genesis := storeTestBatch(t, storageDB, int64(common.L2GenesisSeqNo), 0, block.Hash())
+	info, err = enclave.GetNetworkInfo()
+	require.NoError(t, err)
+	require.Equal(t, genesis.Hash(), info.GenesisBatchHash)
+}
+
+func newStorageTestEnclave(t *testing.T) (*enclaveImpl, storage.Storage) {
+	logger := gethlog.New()
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	require.NoError(t, err)
+	storageDB := storage.NewStorage(backingDB, nil, logger)
+	return &enclaveImpl{
+		storage:                storageDB,
+		dataEncryptionService:  crypto.NewDataEncryptionService(logger),
+		dataCompressionService: compression.NewBrotliDataCompressionService(),
+		stopControl:            stopcontrol.New(),
+		logger:                 logger,
+	}, storageDB
+}
+
+func testBlock(extra string) *types.Block {
+	return types.NewBlock(&types.Header{Number: big.NewInt(1), Extra: []byte(extra)}, nil, nil, nil, trie.NewStackTrie(nil))
+}
+
+func storeTestBatch(t *testing.T, storageDB storage.Storage, seqNo int64, height int64, l1Proof common.L1BlockHash) *core.Batch {
+	batch := &core.Batch{Header: &common.BatchHeader{
+		Number:           big.NewInt(height),
+		SequencerOrderNo: big.NewInt(seqNo),
+		ParentHash:       gethcommon.BigToHash(big.NewInt(height - 1)),
+		L1Proof:          l1Proof,
+	}}
+	require.NoError(t, storageDB.StoreBatch(batch, batch.Hash()))
+	return batch
+}
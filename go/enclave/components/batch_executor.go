@@ -2,15 +2,18 @@ package components
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/ten-protocol/go-ten/go/common/gethencoding"
 
 	"github.com/ten-protocol/go-ten/go/enclave/gas"
+	"github.com/ten-protocol/go-ten/go/enclave/metrics"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -19,20 +22,32 @@ import (
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/trie"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/measure"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
 	"github.com/ten-protocol/go-ten/go/enclave/evm"
 	"github.com/ten-protocol/go-ten/go/enclave/genesis"
+	"github.com/ten-protocol/go-ten/go/enclave/visibility"
 )
 
 var ErrNoTransactionsToProcess = fmt.Errorf("no transactions to process")
 
+// StateDivergenceError - the re-execution of a batch does not match the header signed by the sequencer
+type StateDivergenceError struct {
+	Report *common.StateDivergenceReport
+}
+
+func (e *StateDivergenceError) Error() string {
+	return fmt.Sprintf("batch is in invalid state. Incoming hash: %s  Computed hash: %s", e.Report.BatchHash, e.Report.ComputedBatchHash)
+}
+
 // batchExecutor - the component responsible for executing batches
 type batchExecutor struct {
 	storage              storage.Storage
@@ -47,6 +62,15 @@ type batchExecutor struct {
 	stateDBMutex sync.Mutex
 
 	batchGasLimit uint64 // max execution gas allowed in a batch
+	// the first batch whose synthetic transactions are budgeted, and created for the inbound items oldest first
+	inboundBudgetActivationSeqNo uint64
+
+	computeTimer   gethmetrics.Timer
+	executeTimer   gethmetrics.Timer
+	batchTxs       gethmetrics.Histogram
+	batchGasUsed   gethmetrics.Histogram
+	excludedTxs    gethmetrics.Counter
+	invalidBatches gethmetrics.Counter
 }
 
 func NewBatchExecutor(
@@ -57,18 +81,27 @@ func NewBatchExecutor(
 	gasOracle gas.Oracle,
 	chainConfig *params.ChainConfig,
 	batchGasLimit uint64,
+	inboundBudgetActivationSeqNo uint64,
+	registry *metrics.Registry,
 	logger gethlog.Logger,
 ) BatchExecutor {
 	return &batchExecutor{
-		storage:              storage,
-		gethEncodingService:  gethEncodingService,
-		crossChainProcessors: cc,
-		genesis:              genesis,
-		chainConfig:          chainConfig,
-		logger:               logger,
-		gasOracle:            gasOracle,
-		stateDBMutex:         sync.Mutex{},
-		batchGasLimit:        batchGasLimit,
+		storage:                      storage,
+		gethEncodingService:          gethEncodingService,
+		crossChainProcessors:         cc,
+		genesis:                      genesis,
+		chainConfig:                  chainConfig,
+		logger:                       logger,
+		gasOracle:                    gasOracle,
+		stateDBMutex:                 sync.Mutex{},
+		batchGasLimit:                batchGasLimit,
+		inboundBudgetActivationSeqNo: inboundBudgetActivationSeqNo,
+		computeTimer:                 registry.Timer("enclave/batch/compute"),
+		executeTimer:                 registry.Timer("enclave/batch/execute"),
+		batchTxs:                     registry.Histogram("enclave/batch/transactions"),
+		batchGasUsed:                 registry.Histogram("enclave/batch/gasused"),
+		excludedTxs:                  registry.Counter("enclave/batch/excluded"),
+		invalidBatches:               registry.Counter("enclave/batch/invalid"),
 	}
 }
 
@@ -123,6 +156,8 @@ func (executor *batchExecutor) filterTransactionsWithSufficientFunds(stateDB *st
 
 func (executor *batchExecutor) ComputeBatch(context *BatchExecutionContext, failForEmptyBatch bool) (*ComputedBatch, error) { //nolint:gocognit
 	defer core.LogMethodDuration(executor.logger, measure.NewStopwatch(), "Batch context processed")
+	start := time.Now()
+	defer executor.computeTimer.UpdateSince(start)
 
 	// sanity check that the l1 block exists. We don't have to execute batches of forks.
 	block, err := executor.storage.FetchBlock(context.BlockPtr)
@@ -132,6 +167,11 @@ func (executor *batchExecutor) ComputeBatch(context *BatchExecutionContext, fail
 		return nil, fmt.Errorf("failed to retrieve block %s for batch. Cause: %w", context.BlockPtr, err)
 	}
 
+	// the publishing cost is charged at the gas price of the l1 block, so the price recorded for the batch must match it
+	if context.L1GasPrice != nil && context.L1GasPrice.Cmp(gas.L1GasPriceAt(block)) != 0 {
+		return nil, fmt.Errorf("l1 gas price %d of the batch does not match the price %d of block %s", context.L1GasPrice, gas.L1GasPriceAt(block), context.BlockPtr)
+	}
+
 	// These variables will be used to create the new batch
 	parent, err := executor.storage.FetchBatch(context.ParentPtr)
 	if errors.Is(err, errutil.ErrNotFound) {
@@ -161,17 +201,37 @@ func (executor *batchExecutor) ComputeBatch(context *BatchExecutionContext, fail
 	}
 	snap := stateDB.Snapshot()
 
+	// the earlier batches include all the synthetic transactions, in the order of the newest L1 block first, so the
+	// existing chains replay them
+	budgeted := context.SequencerNo.Uint64() >= executor.inboundBudgetActivationSeqNo
 	var messages common.CrossChainMessages
 	var transfers common.ValueTransferEvents
 	if context.SequencerNo.Int64() > int64(common.L2GenesisSeqNo+1) {
-		messages, transfers = executor.crossChainProcessors.Local.RetrieveInboundMessages(parentBlock, block, stateDB)
+		messages, transfers = executor.crossChainProcessors.Local.RetrieveInboundMessages(parentBlock, block, stateDB, budgeted)
 	}
 
-	crossChainTransactions := executor.crossChainProcessors.Local.CreateSyntheticTransactions(messages, stateDB)
+	// the value of the new deposits is locked in the bus when they are received, even if their release is deferred
 	executor.crossChainProcessors.Local.ExecuteValueTransfers(transfers, stateDB)
+	inbound, err := executor.pendingInbound(parent.Hash(), messages, transfers)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the inbound messages deferred by the parent batch. Cause: %w", err)
+	}
 
 	transactionsToProcess, freeTransactions := executor.filterTransactionsWithSufficientFunds(stateDB, context)
 
+	// the synthetic transactions are derived only from the canonical L1 blocks and the parent state, so every enclave
+	// creates and verifies the same ones. The ones which do not fit in the synthetic gas budget are deferred to the next
+	// batches, so a burst of deposits neither crowds out the transactions of the users nor exceeds the batch gas limit.
+	crossChainTransactions := executor.crossChainProcessors.Local.CreateSyntheticTransactions(inbound.messages, inbound.transfers, stateDB)
+	if budgeted {
+		crossChainTransactions = crossChainTransactions[:syntheticTxsWithinBudget(crossChainTransactions, syntheticGasBudget(batch.Header.GasLimit, freeTransactions))]
+	}
+	included, deferred := inbound.split(len(crossChainTransactions))
+	if deferred.size() > 0 {
+		executor.logger.Info("Deferring the inbound cross chain messages which do not fit in the synthetic gas budget",
+			"included", len(crossChainTransactions), "deferred", deferred.size(), log.CmpKey, log.CrossChainCmp)
+	}
+
 	xchainTxs := make(common.L2PricedTransactions, 0)
 	for _, xTx := range crossChainTransactions {
 		xchainTxs = append(xchainTxs, common.L2PricedTransaction{
@@ -213,19 +273,41 @@ func (executor *batchExecutor) ComputeBatch(context *BatchExecutionContext, fail
 		return nil, ErrNoTransactionsToProcess
 	}
 
+	// the value sent to the L1 is burned before the state root is computed
+	outboundTransfers, err := executor.crossChainProcessors.Local.ExtractOutboundTransfers(txReceipts)
+	if err != nil {
+		executor.logger.Error("Failed extracting L2->L1 messages value transfers", log.ErrKey, err, log.CmpKey, log.CrossChainCmp)
+		return nil, fmt.Errorf("could not extract cross chain value transfers. Cause: %w", err)
+	}
+	executor.crossChainProcessors.Local.BurnOutboundTransfers(outboundTransfers, stateDB)
+
+	// the event visibility rules configured by the contracts are part of the state, and apply from the next batch
+	allReceipts := append(txReceipts, ccReceipts...)
+	visibility.Record(allReceipts, stateDB, executor.logger)
+
 	// we need to copy the batch to reset the internal hash cache
 	copyBatch := *batch
 	copyBatch.Header.Root = stateDB.IntermediateRoot(false)
 	copyBatch.Transactions = append(successfulTxs, freeTransactions.ToTransactions()...)
+	if budgeted {
+		copyBatch.Header.SyntheticTxCount = uint64(len(crossChainTransactions))
+	}
 	copyBatch.ResetHash()
 
-	if err = executor.populateOutboundCrossChainData(&copyBatch, block, txReceipts); err != nil {
+	if err = executor.populateOutboundCrossChainData(&copyBatch, block, txReceipts, outboundTransfers); err != nil {
 		return nil, fmt.Errorf("failed adding cross chain data to batch. Cause: %w", err)
 	}
 
-	allReceipts := append(txReceipts, ccReceipts...)
 	executor.populateHeader(&copyBatch, allReceipts)
 
+	gasUsed := uint64(0)
+	for _, receipt := range allReceipts {
+		gasUsed += receipt.GasUsed
+	}
+	executor.batchTxs.Update(int64(len(copyBatch.Transactions)))
+	executor.batchGasUsed.Update(int64(gasUsed))
+	executor.excludedTxs.Inc(int64(len(excludedTxs)))
+
 	// the logs and receipts produced by the EVM have the wrong hash which must be adjusted
 	for _, receipt := range allReceipts {
 		receipt.BlockHash = copyBatch.Hash()
@@ -235,8 +317,10 @@ func (executor *batchExecutor) ComputeBatch(context *BatchExecutionContext, fail
 	}
 
 	return &ComputedBatch{
-		Batch:    &copyBatch,
-		Receipts: allReceipts,
+		Batch:         &copyBatch,
+		Receipts:      allReceipts,
+		ExecutionTime: time.Since(start),
+		GasUsed:       gasUsed,
 		Commit: func(deleteEmptyObjects bool) (gethcommon.Hash, error) {
 			executor.stateDBMutex.Lock()
 			defer executor.stateDBMutex.Unlock()
@@ -244,21 +328,32 @@ func (executor *batchExecutor) ComputeBatch(context *BatchExecutionContext, fail
 			if err != nil {
 				return gethcommon.Hash{}, fmt.Errorf("commit failure for batch %d. Cause: %w", batch.SeqNo(), err)
 			}
-			trieDB := executor.storage.TrieDB()
-			err = trieDB.Commit(h, false)
-			return h, err
+			if err = executor.storage.CommitState(copyBatch.SeqNo().Uint64(), h); err != nil {
+				return h, err
+			}
+			if deferred.size() > 0 {
+				if err = executor.storage.StoreDeferredInbound(copyBatch.Hash(), deferred.messages, deferred.transfers); err != nil {
+					return h, fmt.Errorf("could not store the deferred inbound messages of batch %d. Cause: %w", batch.SeqNo(), err)
+				}
+			}
+			return h, executor.crossChainProcessors.OnBatchCommitted(&copyBatch, included.messages)
 		},
 	}, nil
 }
 
-func (executor *batchExecutor) ExecuteBatch(batch *core.Batch) (types.Receipts, error) {
+func (executor *batchExecutor) ExecuteBatch(ctx context.Context, batch *core.Batch) (_ types.Receipts, err error) {
 	defer core.LogMethodDuration(executor.logger, measure.NewStopwatch(), "Executed batch", log.BatchHashKey, batch.Hash())
+	defer executor.executeTimer.UpdateSince(time.Now())
+	ctx, span := tracing.StartSpan(ctx, "batchExecutor.ExecuteBatch",
+		tracing.BatchHashKey.String(batch.Hash().Hex()), tracing.BatchSeqNoKey.Int64(batch.SeqNo().Int64()), tracing.CountKey.Int(len(batch.Transactions)))
+	defer func() { tracing.EndSpan(span, err) }()
 
 	// Validators recompute the entire batch using the same batch context
 	// if they have all necessary prerequisites like having the l1 block processed
 	// and the parent hash. This recomputed batch is then checked against the incoming batch.
 	// If the sequencer has tampered with something the hash will not add up and validation will
 	// produce an error.
+	_, computeSpan := tracing.StartSpan(ctx, "batchExecutor.ComputeBatch")
 	cb, err := executor.ComputeBatch(&BatchExecutionContext{
 		BlockPtr:     batch.Header.L1Proof,
 		ParentPtr:    batch.Header.ParentHash,
@@ -269,17 +364,34 @@ func (executor *batchExecutor) ExecuteBatch(batch *core.Batch) (types.Receipts,
 		Creator:      batch.Header.Coinbase,
 		BaseFee:      batch.Header.BaseFee,
 	}, false) // this execution is not used when first producing a batch, we never want to fail for empty batches
+	tracing.EndSpan(computeSpan, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed computing batch %s. Cause: %w", batch.Hash(), err)
 	}
 
 	if cb.Batch.Hash() != batch.Hash() {
 		// todo @stefan - generate a validator challenge here and return it
+		executor.invalidBatches.Inc(1)
 		executor.logger.Error(fmt.Sprintf("Error validating batch. Calculated: %+v    Incoming: %+v\n", cb.Batch.Header, batch.Header))
-		return nil, fmt.Errorf("batch is in invalid state. Incoming hash: %s  Computed hash: %s", batch.Hash(), cb.Batch.Hash())
+		return nil, &StateDivergenceError{Report: &common.StateDivergenceReport{
+			BatchHash:            batch.Hash(),
+			SeqNo:                batch.SeqNo().Uint64(),
+			Height:               batch.NumberU64(),
+			ComputedBatchHash:    cb.Batch.Hash(),
+			ExpectedStateRoot:    batch.Header.Root,
+			ComputedStateRoot:    cb.Batch.Header.Root,
+			ExpectedReceiptsRoot: batch.Header.ReceiptHash,
+			ComputedReceiptsRoot: cb.Batch.Header.ReceiptHash,
+			ExpectedGasUsed:      batch.Header.GasUsed,
+			ComputedGasUsed:      cb.GasUsed,
+			DetectedAt:           time.Now(),
+		}}
 	}
 
-	if _, err := cb.Commit(true); err != nil {
+	_, commitSpan := tracing.StartSpan(ctx, "stateDB.Commit")
+	_, err = cb.Commit(true)
+	tracing.EndSpan(commitSpan, err)
+	if err != nil {
 		return nil, fmt.Errorf("cannot commit stateDB for incoming valid batch %s. Cause: %w", batch.Hash(), err)
 	}
 
@@ -324,6 +436,7 @@ func (executor *batchExecutor) CreateGenesisState(
 			Coinbase:         coinbase,
 			BaseFee:          baseFee,
 			GasLimit:         executor.batchGasLimit,
+			Extra:            executor.genesis.Hash().Bytes(), // so the validators can tell if their allocations differ
 		},
 		Transactions: []*common.L2Tx{},
 	}
@@ -342,18 +455,18 @@ func (executor *batchExecutor) CreateGenesisState(
 	return genesisBatch, deployTx, nil
 }
 
-func (executor *batchExecutor) populateOutboundCrossChainData(batch *core.Batch, block *types.Block, receipts types.Receipts) error {
+func (executor *batchExecutor) populateOutboundCrossChainData(batch *core.Batch, block *types.Block, receipts types.Receipts, valueTransferMessages common.ValueTransferEvents) error {
 	crossChainMessages, err := executor.crossChainProcessors.Local.ExtractOutboundMessages(receipts)
 	if err != nil {
 		executor.logger.Error("Failed extracting L2->L1 messages", log.ErrKey, err, log.CmpKey, log.CrossChainCmp)
 		return fmt.Errorf("could not extract cross chain messages. Cause: %w", err)
 	}
 
-	valueTransferMessages, err := executor.crossChainProcessors.Local.ExtractOutboundTransfers(receipts)
+	withdrawals, err := executor.crossChainProcessors.Local.CreateWithdrawalMessages(valueTransferMessages, batch.SeqNo().Uint64())
 	if err != nil {
-		executor.logger.Error("Failed extracting L2->L1 messages value transfers", log.ErrKey, err, log.CmpKey, log.CrossChainCmp)
-		return fmt.Errorf("could not extract cross chain value transfers. Cause: %w", err)
+		return fmt.Errorf("could not create withdrawal messages. Cause: %w", err)
 	}
+	crossChainMessages = append(crossChainMessages, withdrawals...)
 
 	transfersHash := types.DeriveSha(ValueTransfers(valueTransferMessages), &trie.StackTrie{})
 
@@ -7,12 +7,14 @@ import (
 	"sync"
 	"time"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 
 	"github.com/ethereum/go-ethereum/core/state"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/async"
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/log"
@@ -30,6 +32,12 @@ type batchRegistry struct {
 	callbackMutex     sync.RWMutex
 	healthTimeout     time.Duration
 	lastExecutedBatch *async.Timestamp
+
+	txWaiters      map[gethcommon.Hash][]chan struct{} // the requests waiting for the inclusion of a transaction
+	txWaitersMutex sync.Mutex
+
+	ready     chan struct{} // closed when the first head batch is set
+	readyOnce sync.Once
 }
 
 func NewBatchRegistry(storage storage.Storage, logger gethlog.Logger) BatchRegistry {
@@ -45,19 +53,78 @@ func NewBatchRegistry(storage storage.Storage, logger gethlog.Logger) BatchRegis
 	} else {
 		headBatchSeq = headBatch.SeqNo()
 	}
-	return &batchRegistry{
+	registry := &batchRegistry{
 		storage:           storage,
 		headBatchSeq:      headBatchSeq,
 		logger:            logger,
 		healthTimeout:     time.Minute,
 		lastExecutedBatch: async.NewAsyncTimestamp(time.Now().Add(-time.Minute)),
+		txWaiters:         map[gethcommon.Hash][]chan struct{}{},
+		ready:             make(chan struct{}),
+	}
+	if headBatchSeq != nil {
+		registry.markReady()
 	}
+	return registry
 }
 
 func (br *batchRegistry) HeadBatchSeq() *big.Int {
 	return br.headBatchSeq
 }
 
+func (br *batchRegistry) OrphanedBatches(l1Blocks []common.L1BlockHash) ([]*core.Batch, error) {
+	headBatchSeq := br.HeadBatchSeq()
+	if headBatchSeq == nil {
+		return nil, nil
+	}
+	reorged := make(map[common.L1BlockHash]bool, len(l1Blocks))
+	for _, l1BlockHash := range l1Blocks {
+		reorged[l1BlockHash] = true
+	}
+
+	batch, err := br.storage.FetchBatchBySeqNo(headBatchSeq.Uint64())
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the head batch. Cause: %w", err)
+	}
+	// the L1 proof of a batch descends from the one of its parent, so the orphaned batches are the last ones of the chain
+	var orphans []*core.Batch
+	for reorged[batch.Header.L1Proof] {
+		orphans = append(orphans, batch)
+		if batch.Header.ParentHash == (gethcommon.Hash{}) {
+			break
+		}
+		parent, err := br.storage.FetchBatch(batch.Header.ParentHash)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch the parent of the batch %s. Cause: %w", batch.Hash(), err)
+		}
+		batch = parent
+	}
+
+	for i, j := 0, len(orphans)-1; i < j; i, j = i+1, j-1 {
+		orphans[i], orphans[j] = orphans[j], orphans[i]
+	}
+	return orphans, nil
+}
+
+func (br *batchRegistry) Ready() <-chan struct{} {
+	return br.ready
+}
+
+func (br *batchRegistry) IsReady() bool {
+	select {
+	case <-br.ready:
+		return true
+	default:
+		return false
+	}
+}
+
+func (br *batchRegistry) markReady() {
+	br.readyOnce.Do(func() {
+		close(br.ready)
+	})
+}
+
 func (br *batchRegistry) SubscribeForExecutedBatches(callback func(*core.Batch, types.Receipts)) {
 	br.callbackMutex.Lock()
 	defer br.callbackMutex.Unlock()
@@ -78,6 +145,8 @@ func (br *batchRegistry) OnBatchExecuted(batch *core.Batch, receipts types.Recei
 	defer core.LogMethodDuration(br.logger, measure.NewStopwatch(), "Sending batch and events", log.BatchHashKey, batch.Hash())
 
 	br.headBatchSeq = batch.SeqNo()
+	br.markReady()
+	br.notifyTxWaiters(batch)
 	if br.batchesCallback != nil {
 		br.batchesCallback(batch, receipts)
 	}
@@ -85,19 +154,54 @@ func (br *batchRegistry) OnBatchExecuted(batch *core.Batch, receipts types.Recei
 	br.lastExecutedBatch.Mark()
 }
 
+func (br *batchRegistry) NotifyOnTransaction(txHash gethcommon.Hash) (<-chan struct{}, func()) {
+	br.txWaitersMutex.Lock()
+	defer br.txWaitersMutex.Unlock()
+
+	included := make(chan struct{})
+	br.txWaiters[txHash] = append(br.txWaiters[txHash], included)
+	release := func() {
+		br.txWaitersMutex.Lock()
+		defer br.txWaitersMutex.Unlock()
+		waiters := br.txWaiters[txHash]
+		for i, waiter := range waiters {
+			if waiter == included {
+				waiters = append(waiters[:i], waiters[i+1:]...)
+				break
+			}
+		}
+		if len(waiters) == 0 {
+			delete(br.txWaiters, txHash)
+		} else {
+			br.txWaiters[txHash] = waiters
+		}
+	}
+	return included, release
+}
+
+// notifyTxWaiters - wakes up the requests waiting for the transactions of the batch
+func (br *batchRegistry) notifyTxWaiters(batch *core.Batch) {
+	br.txWaitersMutex.Lock()
+	defer br.txWaitersMutex.Unlock()
+	if len(br.txWaiters) == 0 {
+		return
+	}
+	for _, tx := range batch.Transactions {
+		for _, waiter := range br.txWaiters[tx.Hash()] {
+			close(waiter)
+		}
+		delete(br.txWaiters, tx.Hash())
+	}
+}
+
 func (br *batchRegistry) HasGenesisBatch() (bool, error) {
 	return br.headBatchSeq != nil, nil
 }
 
-func (br *batchRegistry) BatchesAfter(batchSeqNo uint64, upToL1Height uint64, rollupLimiter limiters.RollupLimiter) ([]*core.Batch, []*types.Block, error) {
+func (br *batchRegistry) BatchesAfter(batchSeqNo uint64, upToSeqNo uint64, upToL1Height uint64, rollupLimiter limiters.RollupLimiter) ([]*core.Batch, []*types.Block, error) {
 	// sanity check
-	headBatch, err := br.storage.FetchBatchBySeqNo(br.headBatchSeq.Uint64())
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if headBatch.SeqNo().Uint64() < batchSeqNo {
-		return nil, nil, fmt.Errorf("head batch height %d is in the past compared to requested batch %d", headBatch.SeqNo().Uint64(), batchSeqNo)
+	if upToSeqNo < batchSeqNo {
+		return nil, nil, fmt.Errorf("head batch height %d is in the past compared to requested batch %d", upToSeqNo, batchSeqNo)
 	}
 
 	resultBatches := make([]*core.Batch, 0)
@@ -105,7 +209,7 @@ func (br *batchRegistry) BatchesAfter(batchSeqNo uint64, upToL1Height uint64, ro
 
 	currentBatchSeq := batchSeqNo
 	var currentBlock *types.Block
-	for currentBatchSeq <= headBatch.SeqNo().Uint64() {
+	for currentBatchSeq <= upToSeqNo {
 		batch, err := br.storage.FetchBatchBySeqNo(currentBatchSeq)
 		if err != nil {
 			return nil, nil, fmt.Errorf("could not retrieve batch by sequence number %d. Cause: %w", currentBatchSeq, err)
@@ -159,6 +263,10 @@ func (br *batchRegistry) GetBatchStateAtHeight(blockNumber *gethrpc.BlockNumber)
 	if err != nil {
 		return nil, err
 	}
+	// a full node refuses the queries at the batches it does not keep the state of, even if the state happens to exist
+	if oldest := br.storage.OldestStateSeqNo(br.headBatchSeq.Uint64()); batch.SeqNo().Uint64() < oldest {
+		return nil, fmt.Errorf("%w - the state of batch %d is not kept, the oldest state is at batch %d", errutil.ErrStateUnavailable, batch.SeqNo(), oldest)
+	}
 
 	// We get that of the chain at that height
 	blockchainState, err := br.storage.CreateStateDB(batch.Hash())
@@ -175,7 +283,7 @@ func (br *batchRegistry) GetBatchStateAtHeight(blockNumber *gethrpc.BlockNumber)
 
 func (br *batchRegistry) GetBatchAtHeight(height gethrpc.BlockNumber) (*core.Batch, error) {
 	if br.headBatchSeq == nil {
-		return nil, fmt.Errorf("chain not initialised")
+		return nil, errutil.ErrNotReady
 	}
 	var batch *core.Batch
 	switch height {
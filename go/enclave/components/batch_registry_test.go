@@ -0,0 +1,114 @@
+package components
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/async"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+// chainStorage - stores the batches in memory
+type chainStorage struct {
+	storage.Storage
+	batches []*core.Batch
+}
+
+func (s *chainStorage) FetchBatch(hash common.L2BatchHash) (*core.Batch, error) {
+	for _, batch := range s.batches {
+		if batch.Hash() == hash {
+			return batch, nil
+		}
+	}
+	return nil, errutil.ErrNotFound
+}
+
+func (s *chainStorage) FetchBatchBySeqNo(seqNum uint64) (*core.Batch, error) {
+	for _, batch := range s.batches {
+		if batch.SeqNo().Uint64() == seqNum {
+			return batch, nil
+		}
+	}
+	return nil, errutil.ErrNotFound
+}
+
+func TestTransactionWaitersAreNotifiedOnInclusion(t *testing.T) {
+	br := &batchRegistry{
+		logger:            gethlog.New(),
+		lastExecutedBatch: async.NewAsyncTimestamp(time.Now()),
+		txWaiters:         map[gethcommon.Hash][]chan struct{}{},
+		ready:             make(chan struct{}),
+	}
+	included := types.NewTx(&types.LegacyTx{Nonce: 0})
+	pending := types.NewTx(&types.LegacyTx{Nonce: 1})
+
+	first, releaseFirst := br.NotifyOnTransaction(included.Hash())
+	second, releaseSecond := br.NotifyOnTransaction(included.Hash())
+	other, releaseOther := br.NotifyOnTransaction(pending.Hash())
+	abandoned, releaseAbandoned := br.NotifyOnTransaction(pending.Hash())
+	releaseAbandoned()
+
+	br.OnBatchExecuted(&core.Batch{
+		Header:       &common.BatchHeader{SequencerOrderNo: big.NewInt(1)},
+		Transactions: common.L2Transactions{included},
+	}, nil)
+
+	for _, ch := range []<-chan struct{}{first, second} {
+		select {
+		case <-ch:
+		default:
+			t.Fatal("expected the waiters of the included transaction to be notified")
+		}
+	}
+	for _, ch := range []<-chan struct{}{other, abandoned} {
+		select {
+		case <-ch:
+			t.Fatal("expected the waiters of the pending transaction not to be notified")
+		default:
+		}
+	}
+
+	// releasing after the notification is harmless, and the released waiters are forgotten
+	releaseFirst()
+	releaseSecond()
+	releaseOther()
+	require.Empty(t, br.txWaiters)
+}
+
+func TestOnlyTheBatchesOfTheHeadChainAreOrphaned(t *testing.T) {
+	ancestorBlock, blockA, blockB, nextBlockA := gethcommon.Hash{0x1}, gethcommon.Hash{0xa}, gethcommon.Hash{0xb}, gethcommon.Hash{0xa, 0x1}
+	db := &chainStorage{}
+	chain := func(parent *core.Batch, l1Proof common.L1BlockHash) *core.Batch {
+		header := &common.BatchHeader{SequencerOrderNo: big.NewInt(int64(len(db.batches) + 1)), Number: big.NewInt(0), L1Proof: l1Proof}
+		if parent != nil {
+			header.ParentHash = parent.Hash()
+			header.Number = new(big.Int).Add(parent.Number(), big.NewInt(1))
+		}
+		batch := &core.Batch{Header: header}
+		db.batches = append(db.batches, batch)
+		return batch
+	}
+	ancestor := chain(nil, ancestorBlock)
+	// the batches of the block A were duplicated on the common ancestor when the L1 forked to B, then the L1 returned to
+	// A and a batch was produced on its next block
+	chain(chain(ancestor, blockA), blockA)
+	duplicate := chain(chain(ancestor, ancestorBlock), ancestorBlock)
+	head := chain(duplicate, nextBlockA)
+	br := &batchRegistry{storage: db, headBatchSeq: head.SeqNo()}
+
+	orphans, err := br.OrphanedBatches([]common.L1BlockHash{nextBlockA, blockA})
+	require.NoError(t, err)
+	require.Equal(t, []*core.Batch{head}, orphans)
+
+	orphans, err = br.OrphanedBatches([]common.L1BlockHash{blockB})
+	require.NoError(t, err)
+	require.Empty(t, orphans)
+}
@@ -0,0 +1,58 @@
+package components
+
+import (
+	"fmt"
+	"time"
+)
+
+// BatchTimeRules - the rules the timestamp of a batch must follow, so a skewed or malicious sequencer can't distort the
+// time seen by the contracts. The timestamp must not be before the one of the parent batch, as several batches can be
+// produced within a second, and when L1Drift is set, must be within L1Drift of the timestamp of the L1 proof block of
+// the batch. The batches before ActivationSeqNo are not checked, so the existing chains replay their batches.
+type BatchTimeRules struct {
+	L1Drift         time.Duration
+	ActivationSeqNo uint64
+}
+
+// Check - returns why the time of the batch with the seq number breaks the rules, or nil
+func (r BatchTimeRules) Check(seqNo uint64, batchTime uint64, parentTime uint64, l1Time uint64) error {
+	if seqNo < r.ActivationSeqNo {
+		return nil
+	}
+	if batchTime < parentTime {
+		return fmt.Errorf("batch time %d is before the time %d of the parent batch", batchTime, parentTime)
+	}
+	if r.L1Drift == 0 {
+		return nil
+	}
+	drift := uint64(r.L1Drift / time.Second)
+	if batchTime+drift < l1Time {
+		return fmt.Errorf("batch time %d is more than %s before the time %d of the L1 block", batchTime, r.L1Drift, l1Time)
+	}
+	if batchTime > l1Time+drift {
+		return fmt.Errorf("batch time %d is more than %s after the time %d of the L1 block", batchTime, r.L1Drift, l1Time)
+	}
+	return nil
+}
+
+// Clamp - the time closest to the local clock which follows the rules. It fails when there is none, i.e. the parent
+// batch is already after the latest time allowed by the L1 block, and the batch must wait for a newer block.
+func (r BatchTimeRules) Clamp(seqNo uint64, now uint64, parentTime uint64, l1Time uint64) (uint64, error) {
+	if seqNo < r.ActivationSeqNo {
+		return now, nil
+	}
+	batchTime := now
+	if batchTime < parentTime {
+		batchTime = parentTime
+	}
+	if r.L1Drift > 0 {
+		drift := uint64(r.L1Drift / time.Second)
+		if batchTime+drift < l1Time {
+			batchTime = l1Time - drift
+		}
+		if batchTime > l1Time+drift {
+			batchTime = l1Time + drift
+		}
+	}
+	return batchTime, r.Check(seqNo, batchTime, parentTime, l1Time)
+}
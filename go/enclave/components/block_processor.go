@@ -1,23 +1,30 @@
 package components
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/ten-protocol/go-ten/go/common/async"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 	"github.com/ten-protocol/go-ten/go/enclave/gas"
+	"github.com/ten-protocol/go-ten/go/enclave/metrics"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/gethutil"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/measure"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
 	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
+	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
 )
 
 type l1BlockProcessor struct {
@@ -25,15 +32,20 @@ type l1BlockProcessor struct {
 	gasOracle            gas.Oracle
 	logger               gethlog.Logger
 	crossChainProcessors *crosschain.Processors
+	mgmtContractLib      mgmtcontractlib.MgmtContractLib
 
 	// we store the l1 head to avoid expensive db access
 	// the host is responsible to always submitting the head l1 block
 	currentL1Head     *common.L1BlockHash
 	healthTimeout     time.Duration
 	lastIngestedBlock *async.Timestamp
+
+	processTimer   gethmetrics.Timer
+	rejectedBlocks gethmetrics.Counter
+	headHeight     gethmetrics.Gauge
 }
 
-func NewBlockProcessor(storage storage.Storage, cc *crosschain.Processors, gasOracle gas.Oracle, logger gethlog.Logger) L1BlockProcessor {
+func NewBlockProcessor(storage storage.Storage, cc *crosschain.Processors, mgmtContractLib mgmtcontractlib.MgmtContractLib, gasOracle gas.Oracle, registry *metrics.Registry, logger gethlog.Logger) L1BlockProcessor {
 	var l1BlockHash *common.L1BlockHash
 	head, err := storage.FetchHeadBlock()
 	if err != nil {
@@ -50,17 +62,28 @@ func NewBlockProcessor(storage storage.Storage, cc *crosschain.Processors, gasOr
 		logger:               logger,
 		gasOracle:            gasOracle,
 		crossChainProcessors: cc,
+		mgmtContractLib:      mgmtContractLib,
 		currentL1Head:        l1BlockHash,
 		healthTimeout:        time.Minute,
 		lastIngestedBlock:    async.NewAsyncTimestamp(time.Now().Add(-time.Minute)),
+		processTimer:         registry.Timer("enclave/l1/block/process"),
+		rejectedBlocks:       registry.Counter("enclave/l1/block/rejected"),
+		headHeight:           registry.Gauge("enclave/l1/head/height"),
 	}
 }
 
-func (bp *l1BlockProcessor) Process(br *common.BlockAndReceipts) (*BlockIngestionType, error) {
+func (bp *l1BlockProcessor) Process(ctx context.Context, br *common.BlockAndReceipts) (_ *BlockIngestionType, err error) {
 	defer core.LogMethodDuration(bp.logger, measure.NewStopwatch(), "L1 block processed", log.BlockHashKey, br.Block.Hash())
+	defer bp.processTimer.UpdateSince(time.Now())
+	ctx, span := tracing.StartSpan(ctx, "l1BlockProcessor.Process",
+		tracing.BlockHashKey.String(br.Block.Hash().Hex()), tracing.BlockHeightKey.Int64(br.Block.Number().Int64()))
+	defer func() { tracing.EndSpan(span, err) }()
 
-	ingestion, err := bp.tryAndInsertBlock(br)
+	ingestion, err := bp.tryAndInsertBlock(ctx, br)
 	if err != nil {
+		if !errors.Is(err, errutil.ErrBlockAlreadyProcessed) {
+			bp.rejectedBlocks.Inc(1)
+		}
 		return nil, err
 	}
 
@@ -75,6 +98,16 @@ func (bp *l1BlockProcessor) Process(br *common.BlockAndReceipts) (*BlockIngestio
 		if err != nil {
 			return nil, fmt.Errorf("failed to process cross chain transfers. Cause: %w", err)
 		}
+
+		err = bp.crossChainProcessors.Remote.StoreConsumedMessages(br.Block, *br.Receipts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to process relayed cross chain messages. Cause: %w", err)
+		}
+
+		ingestion.NetworkParametersUpdate, err = bp.storeNetworkParametersUpdate(br)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// todo @siliev - not sure if this is the best way to update the price, will pick up random stale blocks from forks?
@@ -83,9 +116,30 @@ func (bp *l1BlockProcessor) Process(br *common.BlockAndReceipts) (*BlockIngestio
 	h := br.Block.Hash()
 	bp.currentL1Head = &h
 	bp.lastIngestedBlock.Mark()
+	bp.headHeight.Update(br.Block.Number().Int64())
 	return ingestion, nil
 }
 
+// storeNetworkParametersUpdate - records the network parameters set by the management contract in the block. They are
+// in force from the first batch built on it.
+func (bp *l1BlockProcessor) storeNetworkParametersUpdate(br *common.BlockAndReceipts) (*common.NetworkParametersUpdate, error) {
+	if !common.MayContainL1Log(br.Block, *bp.mgmtContractLib.GetContractAddr()) {
+		return nil, nil //nolint:nilnil
+	}
+	update, err := bp.mgmtContractLib.DecodeNetworkParametersUpdate(*br.Receipts)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode the network parameters update. Cause: %w", err)
+	}
+	if update == nil {
+		return nil, nil //nolint:nilnil
+	}
+	if err := bp.storage.StoreNetworkParametersUpdate(br.Block, update); err != nil {
+		return nil, fmt.Errorf("could not store the network parameters update. Cause: %w", err)
+	}
+	bp.logger.Info("Network parameters updated", log.BlockHashKey, br.Block.Hash(), log.BlockHeightKey, br.Block.NumberU64())
+	return update, nil
+}
+
 // HealthCheck checks if the last ingested block was more than healthTimeout ago
 func (bp *l1BlockProcessor) HealthCheck() (bool, error) {
 	lastIngestedBlockTime := bp.lastIngestedBlock.LastTimestamp()
@@ -96,7 +150,7 @@ func (bp *l1BlockProcessor) HealthCheck() (bool, error) {
 	return true, nil
 }
 
-func (bp *l1BlockProcessor) tryAndInsertBlock(br *common.BlockAndReceipts) (*BlockIngestionType, error) {
+func (bp *l1BlockProcessor) tryAndInsertBlock(ctx context.Context, br *common.BlockAndReceipts) (*BlockIngestionType, error) {
 	block := br.Block
 
 	_, err := bp.storage.FetchBlock(block.Hash())
@@ -117,7 +171,9 @@ func (bp *l1BlockProcessor) tryAndInsertBlock(br *common.BlockAndReceipts) (*Blo
 	bp.logger.Trace("Block inserted successfully",
 		log.BlockHeightKey, block.NumberU64(), log.BlockHashKey, block.Hash(), "ingestionType", ingestionType)
 
+	_, span := tracing.StartSpan(ctx, "storage.StoreBlock")
 	err = bp.storage.StoreBlock(block, ingestionType.ChainFork)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, fmt.Errorf("1. could not store block. Cause: %w", err)
 	}
@@ -158,11 +214,74 @@ func (bp *l1BlockProcessor) ingestBlock(block *common.L1Block) (*BlockIngestionT
 
 func (bp *l1BlockProcessor) GetHead() (*common.L1Block, error) {
 	if bp.currentL1Head == nil {
-		return nil, errutil.ErrNotFound
+		// the head is stored without being processed when the enclave imports a state snapshot
+		head, err := bp.storage.FetchHeadBlock()
+		if err != nil {
+			return nil, err
+		}
+		h := head.Hash()
+		bp.currentL1Head = &h
+		return head, nil
 	}
 	return bp.storage.FetchBlock(*bp.currentL1Head)
 }
 
+// MissingAncestors - when the block is more than one block above the head, the head is assumed to be its ancestor. If it
+// is not, the host will find out when submitting the missing range, and fall back to FindForkPoint.
+func (bp *l1BlockProcessor) MissingAncestors(block *common.L1Block) *common.L1ForkPoint {
+	head, err := bp.GetHead()
+	if err != nil || head.NumberU64()+1 >= block.NumberU64() {
+		return nil
+	}
+	return &common.L1ForkPoint{
+		Ancestor:       head.Hash(),
+		AncestorHeight: head.NumberU64(),
+		MissingBlocks:  block.NumberU64() - head.NumberU64() - 1,
+	}
+}
+
+func (bp *l1BlockProcessor) FindForkPoint(headers []*types.Header) (*common.L1ForkPoint, error) {
+	if len(headers) == 0 {
+		return nil, fmt.Errorf("no headers submitted")
+	}
+	for i := 1; i < len(headers); i++ {
+		if headers[i].ParentHash != headers[i-1].Hash() {
+			return nil, fmt.Errorf("%w. Cause: header %d is not a child of the previous one", errutil.ErrInvalidL1Block, i)
+		}
+	}
+
+	tip := headers[len(headers)-1].Number.Uint64()
+	for i := len(headers) - 1; i >= 0; i-- {
+		if _, err := bp.storage.FetchBlock(headers[i].Hash()); err == nil {
+			return &common.L1ForkPoint{Ancestor: headers[i].Hash(), AncestorHeight: headers[i].Number.Uint64(), MissingBlocks: tip - headers[i].Number.Uint64()}, nil
+		} else if !errors.Is(err, errutil.ErrNotFound) {
+			return nil, fmt.Errorf("could not retrieve block. Cause: %w", err)
+		}
+	}
+
+	oldest := headers[0]
+	parent, err := bp.storage.FetchBlock(oldest.ParentHash)
+	if err == nil {
+		return &common.L1ForkPoint{Ancestor: parent.Hash(), AncestorHeight: parent.NumberU64(), MissingBlocks: tip - parent.NumberU64()}, nil
+	}
+	if !errors.Is(err, errutil.ErrNotFound) {
+		return nil, fmt.Errorf("could not retrieve block. Cause: %w", err)
+	}
+
+	// the history of the enclave starts after the parent height, so the chain can't have a common ancestor with it
+	if oldest.Number.Sign() == 0 {
+		return nil, errutil.ErrForkBeyondHistory
+	}
+	parentHeight := new(big.Int).Sub(oldest.Number, big.NewInt(1))
+	_, err = bp.storage.FetchCanonicaBlockByHeight(parentHeight)
+	if errors.Is(err, errutil.ErrNotFound) {
+		if head, err := bp.GetHead(); err == nil && head.Number().Cmp(parentHeight) >= 0 {
+			return nil, errutil.ErrForkBeyondHistory
+		}
+	}
+	return nil, errutil.ErrBlockAncestorNotFound
+}
+
 func (bp *l1BlockProcessor) GetCrossChainContractAddress() *gethcommon.Address {
 	return bp.crossChainProcessors.Remote.GetBusAddress()
 }
@@ -0,0 +1,107 @@
+package components
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+// newTestChain - creates a chain of blocks on top of the parent, the extra byte distinguishes the forks
+func newTestChain(parent *types.Block, startHeight uint64, length int, extra byte) []*types.Block {
+	chain := make([]*types.Block, 0, length)
+	for i := 0; i < length; i++ {
+		header := &types.Header{Number: new(big.Int).SetUint64(startHeight + uint64(i)), Extra: []byte{extra}}
+		if parent != nil {
+			header.ParentHash = parent.Hash()
+		}
+		parent = types.NewBlockWithHeader(header)
+		chain = append(chain, parent)
+	}
+	return chain
+}
+
+func headersOf(blocks []*types.Block) []*types.Header {
+	headers := make([]*types.Header, len(blocks))
+	for i, b := range blocks {
+		headers[i] = b.Header()
+	}
+	return headers
+}
+
+func newTestBlockProcessor(s *validatorStorage, stored []*types.Block) *l1BlockProcessor {
+	for _, b := range stored {
+		s.ingest(b)
+	}
+	head := s.head.Hash()
+	return &l1BlockProcessor{storage: s, currentL1Head: &head, logger: gethlog.New()}
+}
+
+func TestMissingL1BlocksGap(t *testing.T) {
+	s := &validatorStorage{blocks: map[common.L1BlockHash]*types.Block{}}
+	chain := newTestChain(nil, 0, 62, 0)
+	bp := newTestBlockProcessor(s, chain[:11])
+
+	// the host skips 50 blocks
+	if _, err := bp.ingestBlock(chain[61]); !errors.Is(err, errutil.ErrBlockAncestorNotFound) {
+		t.Fatalf("expected the block to be rejected for a missing ancestor, got %v", err)
+	}
+	forkPoint := bp.MissingAncestors(chain[61])
+	if forkPoint == nil || forkPoint.Ancestor != chain[10].Hash() || forkPoint.AncestorHeight != 10 || forkPoint.MissingBlocks != 50 {
+		t.Fatalf("expected 50 blocks missing after block 10, got %+v", forkPoint)
+	}
+
+	forkPoint, err := bp.FindForkPoint(headersOf(chain[11:61]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forkPoint.Ancestor != chain[10].Hash() || forkPoint.MissingBlocks != 50 {
+		t.Errorf("expected the headers to start right after block 10, got %+v", forkPoint)
+	}
+
+	// the range starting with blocks the enclave has is resolved to the latest of them
+	forkPoint, err = bp.FindForkPoint(headersOf(chain[5:20]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forkPoint.Ancestor != chain[10].Hash() || forkPoint.MissingBlocks != 9 {
+		t.Errorf("expected block 10 to be the latest known block, got %+v", forkPoint)
+	}
+
+	if _, err := bp.FindForkPoint([]*types.Header{chain[30].Header(), chain[32].Header()}); !errors.Is(err, errutil.ErrInvalidL1Block) {
+		t.Errorf("expected headers which are not a chain to be rejected, got %v", err)
+	}
+}
+
+func TestL1ForkBeyondStoredHistory(t *testing.T) {
+	s := &validatorStorage{blocks: map[common.L1BlockHash]*types.Block{}}
+	// the history of the enclave starts at block 100
+	canonical := newTestChain(nil, 90, 21, 0)
+	bp := newTestBlockProcessor(s, canonical[10:])
+
+	// a fork from block 102, the host has to submit older headers to reach it
+	shallowFork := newTestChain(canonical[12], 103, 10, 1)
+	if bp.MissingAncestors(shallowFork[7]) != nil {
+		t.Errorf("expected the fork point to not be inferred from the heights")
+	}
+	if _, err := bp.FindForkPoint(headersOf(shallowFork[3:])); !errors.Is(err, errutil.ErrBlockAncestorNotFound) {
+		t.Fatalf("expected older headers to be requested, got %v", err)
+	}
+	forkPoint, err := bp.FindForkPoint(headersOf(shallowFork[:3]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forkPoint.Ancestor != canonical[12].Hash() || forkPoint.AncestorHeight != 102 {
+		t.Errorf("expected the fork point to be block 102, got %+v", forkPoint)
+	}
+
+	// a fork from block 95, before the first block the enclave has
+	deepFork := newTestChain(canonical[5], 96, 16, 2)
+	if _, err := bp.FindForkPoint(headersOf(deepFork)); !errors.Is(err, errutil.ErrForkBeyondHistory) {
+		t.Errorf("expected the fork to be deeper than the stored history, got %v", err)
+	}
+}
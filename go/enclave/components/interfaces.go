@@ -1,8 +1,10 @@
 package components
 
 import (
+	"context"
 	"errors"
 	"math/big"
+	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/state"
@@ -24,6 +26,9 @@ type BlockIngestionType struct {
 
 	// ChainFork contains information about the status of the new block in the chain
 	ChainFork *common.ChainFork
+
+	// NetworkParametersUpdate - the network parameters set by the management contract in the block, if any
+	NetworkParametersUpdate *common.NetworkParametersUpdate
 }
 
 func (bit *BlockIngestionType) IsFork() bool {
@@ -34,8 +39,13 @@ func (bit *BlockIngestionType) IsFork() bool {
 }
 
 type L1BlockProcessor interface {
-	Process(br *common.BlockAndReceipts) (*BlockIngestionType, error)
+	Process(ctx context.Context, br *common.BlockAndReceipts) (*BlockIngestionType, error)
 	GetHead() (*common.L1Block, error)
+	// MissingAncestors - returns the range of blocks the enclave is missing before a block with an unknown parent, when
+	// it can be inferred from the heights. Otherwise, it returns nil.
+	MissingAncestors(block *common.L1Block) *common.L1ForkPoint
+	// FindForkPoint - returns the latest of the headers, or their parent, which the enclave has
+	FindForkPoint(headers []*types.Header) (*common.L1ForkPoint, error)
 	GetCrossChainContractAddress() *gethcommon.Address
 	HealthCheck() (bool, error)
 }
@@ -50,6 +60,7 @@ type BatchExecutionContext struct {
 	ChainConfig  *params.ChainConfig
 	SequencerNo  *big.Int
 	BaseFee      *big.Int
+	L1GasPrice   *big.Int // when set, it must match the gas price of the block. Set when the batch is recreated from a rollup
 }
 
 // ComputedBatch - a structure representing the result of a batch
@@ -61,7 +72,10 @@ type BatchExecutionContext struct {
 type ComputedBatch struct {
 	Batch    *core.Batch
 	Receipts types.Receipts
-	Commit   func(bool) (gethcommon.Hash, error)
+	// ExecutionTime - the wall time it took to compute the batch, excluding the commit of the state
+	ExecutionTime time.Duration
+	GasUsed       uint64
+	Commit        func(bool) (gethcommon.Hash, error)
 }
 
 type BatchExecutor interface {
@@ -72,7 +86,7 @@ type BatchExecutor interface {
 	ComputeBatch(batchContext *BatchExecutionContext, failForEmptyBatch bool) (*ComputedBatch, error)
 
 	// ExecuteBatch - executes the transactions and xchain messages, returns the receipts, and updates the stateDB
-	ExecuteBatch(*core.Batch) (types.Receipts, error)
+	ExecuteBatch(context.Context, *core.Batch) (types.Receipts, error)
 
 	// CreateGenesisState - will create and commit the genesis state in the stateDB for the given block hash,
 	// and uint64 timestamp representing the time now. In this genesis state is where one can
@@ -81,11 +95,12 @@ type BatchExecutor interface {
 }
 
 type BatchRegistry interface {
-	// BatchesAfter - Given a hash, will return batches following it until the head batch and the l1 blocks referenced by those batches
-	BatchesAfter(batchSeqNo uint64, upToL1Height uint64, rollupLimiter limiters.RollupLimiter) ([]*core.Batch, []*types.Block, error)
+	// BatchesAfter - Given a seq number, will return batches following it until the upToSeqNo batch and the l1 blocks referenced by those batches
+	BatchesAfter(batchSeqNo uint64, upToSeqNo uint64, upToL1Height uint64, rollupLimiter limiters.RollupLimiter) ([]*core.Batch, []*types.Block, error)
 
 	// GetBatchStateAtHeight - creates a stateDB that represents the state committed when
 	// the batch with height matching the blockNumber was created and stored.
+	// Returns errutil.ErrStateUnavailable if the storage does not keep the state of the batch anymore.
 	GetBatchStateAtHeight(blockNumber *gethrpc.BlockNumber) (*state.StateDB, error)
 
 	// GetBatchAtHeight - same as `GetBatchStateAtHeight`, but instead returns the full batch
@@ -98,23 +113,43 @@ type BatchRegistry interface {
 
 	OnBatchExecuted(batch *core.Batch, receipts types.Receipts)
 
+	// NotifyOnTransaction - the returned channel is closed when a batch including the transaction is executed. The
+	// release function must be called when the caller stops waiting.
+	NotifyOnTransaction(txHash gethcommon.Hash) (<-chan struct{}, func())
+
 	// HasGenesisBatch - returns if genesis batch is available yet or not, or error in case
 	// the function is unable to determine.
 	HasGenesisBatch() (bool, error)
 
 	HeadBatchSeq() *big.Int
 
+	// OrphanedBatches - the batches of the chain of the head batch which are built on the given L1 blocks, lowest first.
+	// The other batches built on these blocks were replaced already, when the L1 forked away from them before.
+	OrphanedBatches(l1Blocks []common.L1BlockHash) ([]*core.Batch, error)
+
+	// Ready - the returned channel is closed when the first head batch is set. Until then, the operations which need
+	// the head batch fail with errutil.ErrNotReady
+	Ready() <-chan struct{}
+	// IsReady - whether the head batch is set
+	IsReady() bool
+
 	HealthCheck() (bool, error)
 }
 
 type RollupProducer interface {
 	// CreateInternalRollup - creates a rollup starting from the end of the last rollup that has been stored on the L1
-	CreateInternalRollup(fromBatchNo uint64, upToL1Height uint64, limiter limiters.RollupLimiter) (*core.Rollup, error)
+	// The batches are at most upToBatchNo, so the rollup only includes the batches which were sealed when it was requested
+	CreateInternalRollup(fromBatchNo uint64, upToBatchNo uint64, upToL1Height uint64, limiter limiters.RollupLimiter) (*core.Rollup, error)
 }
 
 type RollupConsumer interface {
 	// ProcessRollupsInBlock - extracts the rollup from the block's transactions
 	// and verifies its integrity, saving and processing any batches that have
 	// not been seen previously.
-	ProcessRollupsInBlock(b *common.BlockAndReceipts) error
+	ProcessRollupsInBlock(ctx context.Context, b *common.BlockAndReceipts) error
+	// ProcessPendingRollups - processes the rollups recorded by the previous blocks which are not processed yet, e.g.
+	// because a storage error failed them, in the order in which they were published. It stops at the first failure.
+	ProcessPendingRollups(ctx context.Context) error
+	// HealthCheck - unhealthy when a rollup failed to be processed RollupProcessingFailuresUnhealthy times
+	HealthCheck() (bool, error)
 }
@@ -0,0 +1,154 @@
+package components
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/gethutil"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+// L1BlockValidator - verifies the L1 blocks submitted by the host against the consensus rules of the L1 chain, so a
+// compromised host can't feed the enclave fabricated blocks
+type L1BlockValidator struct {
+	engine       consensus.Engine
+	chainConfig  *params.ChainConfig
+	storage      storage.Storage
+	maxForkDepth uint64
+	logger       gethlog.Logger
+}
+
+func NewL1BlockValidator(engine consensus.Engine, chainConfig *params.ChainConfig, storage storage.Storage, maxForkDepth uint64, logger gethlog.Logger) *L1BlockValidator {
+	return &L1BlockValidator{
+		engine:       engine,
+		chainConfig:  chainConfig,
+		storage:      storage,
+		maxForkDepth: maxForkDepth,
+		logger:       logger,
+	}
+}
+
+// Validate - checks that the block body and the receipts match the header, that the block extends a known block
+// without reorging more than the allowed depth, and that the header follows the consensus rules.
+// Unless they were proven, the receipts must be the complete list, as they are checked against the receipts root.
+func (v *L1BlockValidator) Validate(br *common.BlockAndReceipts) error {
+	block := br.Block
+	if err := verifyBlockBody(br); err != nil {
+		return fmt.Errorf("%w. Cause: %w", errutil.ErrInvalidL1Block, err)
+	}
+
+	head, err := v.storage.FetchHeadBlock()
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			// todo (@matt) - the first block should be a configured hash (e.g. the L1 management contract deployment block)
+			v.logger.Info("Trusting the first L1 block", log.BlockHashKey, block.Hash())
+			return nil
+		}
+		return fmt.Errorf("could not retrieve head block. Cause: %w", err)
+	}
+
+	if _, err := v.storage.FetchBlock(block.ParentHash()); err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return errutil.ErrBlockAncestorNotFound
+		}
+		return fmt.Errorf("could not retrieve parent block. Cause: %w", err)
+	}
+
+	if block.ParentHash() != head.Hash() {
+		fork, err := gethutil.LCA(block, head, v.storage)
+		if err != nil {
+			return fmt.Errorf("could not find the common ancestor with the head. Cause: %w", err)
+		}
+		if depth := head.NumberU64() - fork.CommonAncestor.NumberU64(); depth > v.maxForkDepth {
+			return fmt.Errorf("%w. Cause: the block reorgs %d blocks, more than the allowed %d", errutil.ErrInvalidL1Block, depth, v.maxForkDepth)
+		}
+	}
+
+	reader := &l1ChainReader{config: v.chainConfig, storage: v.storage, head: head}
+	if err := v.engine.VerifyHeader(reader, block.Header()); err != nil {
+		return fmt.Errorf("%w. Cause: %w", errutil.ErrInvalidL1Block, err)
+	}
+	return nil
+}
+
+// verifyBlockBody - the header is what the consensus rules protect, so everything else must be bound to it
+func verifyBlockBody(br *common.BlockAndReceipts) error {
+	block := br.Block
+	header := block.Header()
+	if hash := types.DeriveSha(block.Transactions(), trie.NewStackTrie(nil)); hash != header.TxHash {
+		return fmt.Errorf("transactions root mismatch. Have %s, want %s", hash, header.TxHash)
+	}
+	if hash := types.CalcUncleHash(block.Uncles()); hash != header.UncleHash {
+		return fmt.Errorf("uncles root mismatch. Have %s, want %s", hash, header.UncleHash)
+	}
+	if header.WithdrawalsHash != nil {
+		if hash := types.DeriveSha(block.Withdrawals(), trie.NewStackTrie(nil)); hash != *header.WithdrawalsHash {
+			return fmt.Errorf("withdrawals root mismatch. Have %s, want %s", hash, *header.WithdrawalsHash)
+		}
+	}
+	if br.ProvenReceipts {
+		return nil
+	}
+	if hash := types.DeriveSha(*br.Receipts, trie.NewStackTrie(nil)); hash != header.ReceiptHash {
+		return fmt.Errorf("receipts root mismatch. Have %s, want %s", hash, header.ReceiptHash)
+	}
+	return nil
+}
+
+// l1ChainReader - exposes the L1 blocks ingested by the enclave to the consensus engine
+type l1ChainReader struct {
+	config  *params.ChainConfig
+	storage storage.Storage
+	head    *common.L1Block
+}
+
+func (r *l1ChainReader) Config() *params.ChainConfig {
+	return r.config
+}
+
+func (r *l1ChainReader) CurrentHeader() *types.Header {
+	return r.head.Header()
+}
+
+func (r *l1ChainReader) GetHeader(hash gethcommon.Hash, number uint64) *types.Header {
+	header := r.GetHeaderByHash(hash)
+	if header == nil || header.Number.Uint64() != number {
+		return nil
+	}
+	return header
+}
+
+func (r *l1ChainReader) GetHeaderByNumber(number uint64) *types.Header {
+	block, err := r.storage.FetchCanonicaBlockByHeight(new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil
+	}
+	return block.Header()
+}
+
+func (r *l1ChainReader) GetHeaderByHash(hash gethcommon.Hash) *types.Header {
+	block, err := r.storage.FetchBlock(hash)
+	if err != nil {
+		return nil
+	}
+	return block.Header()
+}
+
+// GetTd - the total difficulty is not tracked. The engine is only created for networks that are already merged, so
+// every known block is past the terminal total difficulty.
+func (r *l1ChainReader) GetTd(hash gethcommon.Hash, number uint64) *big.Int {
+	if r.config.TerminalTotalDifficulty == nil || r.GetHeader(hash, number) == nil {
+		return nil
+	}
+	return new(big.Int).Set(r.config.TerminalTotalDifficulty)
+}
@@ -0,0 +1,140 @@
+package components
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/beacon"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+// validatorStorage - serves the L1 blocks already ingested by the enclave from memory
+type validatorStorage struct {
+	storage.Storage
+	blocks map[common.L1BlockHash]*types.Block
+	head   *types.Block
+}
+
+func (s *validatorStorage) FetchBlock(hash common.L1BlockHash) (*types.Block, error) {
+	if b, found := s.blocks[hash]; found {
+		return b, nil
+	}
+	return nil, errutil.ErrNotFound
+}
+
+func (s *validatorStorage) FetchHeadBlock() (*types.Block, error) {
+	if s.head == nil {
+		return nil, errutil.ErrNotFound
+	}
+	return s.head, nil
+}
+
+func (s *validatorStorage) FetchCanonicaBlockByHeight(height *big.Int) (*types.Block, error) {
+	for b := s.head; b != nil; b = s.blocks[b.ParentHash()] {
+		if b.Number().Cmp(height) == 0 {
+			return b, nil
+		}
+	}
+	return nil, errutil.ErrNotFound
+}
+
+func (s *validatorStorage) ingest(b *types.Block) *types.Block {
+	s.blocks[b.Hash()] = b
+	s.head = b
+	return b
+}
+
+func testL1ChainConfig() *params.ChainConfig {
+	config := *params.AllEthashProtocolChanges
+	config.TerminalTotalDifficulty = big.NewInt(0)
+	config.TerminalTotalDifficultyPassed = true
+	config.ShanghaiTime = nil
+	config.CancunTime = nil
+	return &config
+}
+
+// newTestL1Block - creates a post-merge block with a single transaction, and returns it with its receipts
+func newTestL1Block(config *params.ChainConfig, parent *types.Block, extra byte) (*types.Block, types.Receipts) {
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     new(big.Int).Add(parent.Number(), big.NewInt(1)),
+		Time:       parent.Time() + 12,
+		GasLimit:   parent.GasLimit(),
+		Difficulty: big.NewInt(0),
+		BaseFee:    eip1559.CalcBaseFee(config, parent.Header()),
+		Extra:      []byte{extra},
+	}
+	tx := types.NewTransaction(0, gethcommon.HexToAddress("0x1"), big.NewInt(1), params.TxGas, big.NewInt(params.InitialBaseFee), nil)
+	receipts := types.Receipts{{Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: params.TxGas, Logs: []*types.Log{}}}
+	return types.NewBlock(header, types.Transactions{tx}, nil, receipts, trie.NewStackTrie(nil)), receipts
+}
+
+func blockAndReceipts(block *types.Block, receipts types.Receipts) *common.BlockAndReceipts {
+	return &common.BlockAndReceipts{Block: block, Receipts: &receipts}
+}
+
+func TestL1BlockValidation(t *testing.T) {
+	config := testL1ChainConfig()
+	s := &validatorStorage{blocks: map[common.L1BlockHash]*types.Block{}}
+	genesis := types.NewBlock(&types.Header{
+		Number:     big.NewInt(0),
+		GasLimit:   30_000_000,
+		Difficulty: big.NewInt(0),
+		BaseFee:    big.NewInt(params.InitialBaseFee),
+	}, nil, nil, nil, trie.NewStackTrie(nil))
+	validator := NewL1BlockValidator(beacon.New(ethash.NewFaker()), config, s, 1, gethlog.New())
+
+	if err := validator.Validate(blockAndReceipts(genesis, types.Receipts{})); err != nil {
+		t.Fatalf("expected the first block to be trusted, got %s", err)
+	}
+	s.ingest(genesis)
+
+	block, receipts := newTestL1Block(config, genesis, 0)
+	if err := validator.Validate(blockAndReceipts(block, receipts)); err != nil {
+		t.Fatalf("expected a valid block to be accepted, got %s", err)
+	}
+
+	tampered := types.Receipts{{Status: types.ReceiptStatusFailed, CumulativeGasUsed: params.TxGas, Logs: []*types.Log{}}}
+	if err := validator.Validate(blockAndReceipts(block, tampered)); !errors.Is(err, errutil.ErrInvalidL1Block) {
+		t.Errorf("expected tampered receipts to be rejected, got %v", err)
+	}
+
+	header := block.Header()
+	header.Time = genesis.Time()
+	badTime := block.WithSeal(header)
+	if err := validator.Validate(blockAndReceipts(badTime, receipts)); !errors.Is(err, errutil.ErrInvalidL1Block) {
+		t.Errorf("expected a block with an invalid header to be rejected, got %v", err)
+	}
+
+	orphan, orphanReceipts := newTestL1Block(config, types.NewBlockWithHeader(&types.Header{Number: big.NewInt(5), GasLimit: genesis.GasLimit(), BaseFee: genesis.BaseFee()}), 0)
+	if err := validator.Validate(blockAndReceipts(orphan, orphanReceipts)); !errors.Is(err, errutil.ErrBlockAncestorNotFound) {
+		t.Errorf("expected a block with an unknown parent to be rejected, got %v", err)
+	}
+
+	// the head moves two blocks ahead, so a sibling of the first block reorgs more than the allowed depth
+	s.ingest(block)
+	next, nextReceipts := newTestL1Block(config, block, 0)
+	if err := validator.Validate(blockAndReceipts(next, nextReceipts)); err != nil {
+		t.Fatalf("expected a valid block to be accepted, got %s", err)
+	}
+	s.ingest(next)
+
+	fork, forkReceipts := newTestL1Block(config, genesis, 1)
+	if err := validator.Validate(blockAndReceipts(fork, forkReceipts)); !errors.Is(err, errutil.ErrInvalidL1Block) {
+		t.Errorf("expected a deep fork to be rejected, got %v", err)
+	}
+	shallowFork, shallowForkReceipts := newTestL1Block(config, block, 1)
+	if err := validator.Validate(blockAndReceipts(shallowFork, shallowForkReceipts)); err != nil {
+		t.Errorf("expected a shallow fork to be accepted, got %s", err)
+	}
+}
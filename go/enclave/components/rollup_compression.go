@@ -1,9 +1,12 @@
 package components
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
+	"time"
 
 	"github.com/ten-protocol/go-ten/go/common/gethencoding"
 
@@ -17,13 +20,16 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rlp"
-	"github.com/ethereum/go-ethereum/trie"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/compression"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/gas"
+	"github.com/ten-protocol/go-ten/go/enclave/metrics"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
 )
 
 /*
@@ -55,6 +61,26 @@ type RollupCompression struct {
 	storage                storage.Storage
 	chainConfig            *params.ChainConfig
 	logger                 gethlog.Logger
+
+	createdMetrics  compressionMetrics
+	consumedMetrics compressionMetrics
+}
+
+// compressionMetrics - the aggregates of the compression stats of the rollups created, or consumed
+type compressionMetrics struct {
+	duration        gethmetrics.Timer
+	rawBytes        gethmetrics.Histogram
+	compressedBytes gethmetrics.Histogram
+	ratio           gethmetrics.Histogram // in hundredths
+}
+
+func newCompressionMetrics(registry *metrics.Registry, side string) compressionMetrics {
+	return compressionMetrics{
+		duration:        registry.Timer("enclave/rollup/compression/" + side + "/duration"),
+		rawBytes:        registry.Histogram("enclave/rollup/compression/" + side + "/raw"),
+		compressedBytes: registry.Histogram("enclave/rollup/compression/" + side + "/compressed"),
+		ratio:           registry.Histogram("enclave/rollup/compression/" + side + "/ratio"),
+	}
 }
 
 func NewRollupCompression(
@@ -65,6 +91,7 @@ func NewRollupCompression(
 	storage storage.Storage,
 	gethEncodingService gethencoding.EncodingService,
 	chainConfig *params.ChainConfig,
+	registry *metrics.Registry,
 	logger gethlog.Logger,
 ) *RollupCompression {
 	return &RollupCompression{
@@ -76,61 +103,102 @@ func NewRollupCompression(
 		gethEncodingService:    gethEncodingService,
 		chainConfig:            chainConfig,
 		logger:                 logger,
+		createdMetrics:         newCompressionMetrics(registry, "created"),
+		consumedMetrics:        newCompressionMetrics(registry, "consumed"),
 	}
 }
 
 // temporary data structure to help build a batch from the information found in the rollup
 type batchFromRollup struct {
-	transactions []*common.L2Tx
+	transactions []*common.L2Tx // decoded from the rollup payloads only while the batch is executed
 	seqNo        *big.Int
 	height       *big.Int
-	txHash       gethcommon.Hash
 	time         uint64
 	l1Proof      common.L1BlockHash
 	coinbase     gethcommon.Address
 	baseFee      *big.Int
+	l1GasPrice   *big.Int
 	gasLimit     uint64
 
 	header *common.BatchHeader // for reorgs
 }
 
-// CreateExtRollup - creates a compressed and encrypted External rollup from the internal data structure
-func (rc *RollupCompression) CreateExtRollup(r *core.Rollup) (*common.ExtRollup, error) {
+// CreateExtRollup - creates a compressed and encrypted External rollup from the internal data structure. The compression
+// stats are returned to be recorded once the rollup is final, since the sequencer compresses several candidates.
+func (rc *RollupCompression) CreateExtRollup(r *core.Rollup) (*common.ExtRollup, *common.RollupCompressionStats, error) {
+	stats := &common.RollupCompressionStats{
+		Created:        true,
+		LastBatchSeqNo: r.Header.LastBatchSeqNo,
+		Batches:        uint64(len(r.Batches)),
+	}
+	if len(r.Batches) > 0 {
+		stats.FirstBatchSeqNo = r.Batches[0].SeqNo().Uint64()
+	}
+
 	header, err := rc.createRollupHeader(r)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	encryptedHeader, err := rc.serialiseCompressAndEncrypt(header)
+	// the header carries no transactions
+	encryptedHeader, err := rc.serialiseCompressAndEncrypt(header, 0, stats)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	transactions := make([][]*common.L2Tx, len(r.Batches))
+	txCount := 0
 	for i, batch := range r.Batches {
 		transactions[i] = batch.Transactions
+		txCount += len(batch.Transactions)
 	}
-	encryptedTransactions, err := rc.serialiseCompressAndEncrypt(transactions)
+	encryptedTransactions, err := rc.serialiseCompressAndEncrypt(transactions, uint64(txCount), stats)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &common.ExtRollup{
+	extRollup := &common.ExtRollup{
 		Header:               r.Header,
 		BatchPayloads:        encryptedTransactions,
 		CalldataRollupHeader: encryptedHeader,
-	}, nil
+	}
+	// the signature is not part of the hash, so the hash is final
+	stats.RollupHash = extRollup.Hash()
+	return extRollup, stats, nil
+}
+
+// RecordStats - persists the compression stats of a rollup, and adds them to the metrics
+func (rc *RollupCompression) RecordStats(stats *common.RollupCompressionStats) {
+	stats.RecordedAt = time.Now()
+	m := rc.consumedMetrics
+	if stats.Created {
+		m = rc.createdMetrics
+	}
+	m.duration.Update(stats.Duration)
+	m.rawBytes.Update(int64(stats.RawBytes))
+	m.compressedBytes.Update(int64(stats.CompressedBytes))
+	m.ratio.Update(int64(stats.Ratio() * 100))
+
+	rc.logger.Info("Rollup compression", log.RollupHashKey, stats.RollupHash, "created", stats.Created, "batches", stats.Batches,
+		"raw_bytes", stats.RawBytes, "compressed_bytes", stats.CompressedBytes, "ratio", fmt.Sprintf("%.2f", stats.Ratio()), log.DurationKey, stats.Duration)
+	// the stats are only for monitoring, so they do not fail the rollup
+	if err := rc.storage.StoreRollupCompressionStats(stats); err != nil {
+		rc.logger.Error("Could not store the rollup compression stats", log.RollupHashKey, stats.RollupHash, log.ErrKey, err)
+	}
 }
 
 // ProcessExtRollup - given an External rollup, responsible with checking and saving all batches found inside
+// The transactions are decompressed and decoded one batch at a time, while the batches are recreated and stored, so only
+// the compressed payload and a single batch are held in memory.
+// Processing the same rollup again after a failure skips the batches which were already stored and executed.
 func (rc *RollupCompression) ProcessExtRollup(rollup *common.ExtRollup) (*common.CalldataRollupHeader, error) {
-	transactionsPerBatch := make([][]*common.L2Tx, 0)
-	err := rc.decryptDecompressAndDeserialise(rollup.BatchPayloads, &transactionsPerBatch)
+	stats := &common.RollupCompressionStats{RollupHash: rollup.Hash(), LastBatchSeqNo: rollup.Header.LastBatchSeqNo}
+	calldataRollupHeader := new(common.CalldataRollupHeader)
+	err := rc.decryptDecompressAndDeserialise(rollup.CalldataRollupHeader, calldataRollupHeader, stats)
 	if err != nil {
 		return nil, err
 	}
 
-	calldataRollupHeader := new(common.CalldataRollupHeader)
-	err = rc.decryptDecompressAndDeserialise(rollup.CalldataRollupHeader, calldataRollupHeader)
+	payloads, err := rc.openBatchPayloads(rollup.BatchPayloads, stats)
 	if err != nil {
 		return nil, err
 	}
@@ -138,17 +206,20 @@ func (rc *RollupCompression) ProcessExtRollup(rollup *common.ExtRollup) (*common
 	// The recreation of batches is a 2-step process:
 
 	// 1. calculate fields like: sequence, height, time, l1Proof, from the implicit and explicit information from the metadata
-	incompleteBatches, err := rc.createIncompleteBatches(calldataRollupHeader, transactionsPerBatch, rollup.Header.CompressionL1Head)
+	incompleteBatches, err := rc.createIncompleteBatches(calldataRollupHeader, rollup.Header.CompressionL1Head)
 	if err != nil {
 		return nil, err
 	}
 
 	// 2. execute each batch to be able to calculate the hash which is necessary for the next batch as it is the parent.
-	err = rc.executeAndSaveIncompleteBatches(calldataRollupHeader, incompleteBatches)
+	err = rc.executeAndSaveIncompleteBatches(calldataRollupHeader, incompleteBatches, payloads)
 	if err != nil {
 		return nil, err
 	}
 
+	stats.FirstBatchSeqNo = calldataRollupHeader.FirstBatchSequence.Uint64()
+	stats.Batches = uint64(len(incompleteBatches))
+	rc.RecordStats(stats)
 	return calldataRollupHeader, nil
 }
 
@@ -164,6 +235,8 @@ func (rc *RollupCompression) createRollupHeader(rollup *core.Rollup) (*common.Ca
 	l1HeightDeltas := make([]*big.Int, len(batches))
 	var prevL1Height *big.Int
 
+	l1GasPrices := make([]*big.Int, len(batches))
+
 	batchHashes := make([]common.L2BatchHash, len(batches))
 	batchHeaders := make([]*common.BatchHeader, len(batches))
 
@@ -176,6 +249,7 @@ func (rc *RollupCompression) createRollupHeader(rollup *core.Rollup) (*common.Ca
 	for _, batch := range reorgedBatches {
 		reorgMap[batch.SeqNo().Uint64()] = true
 	}
+	markReplacedBatches(batches, reorgMap)
 
 	for i, batch := range batches {
 		rc.logger.Info("Compressing batch to rollup", log.BatchSeqNoKey, batch.SeqNo(), log.BatchHeightKey, batch.Number(), log.BatchHashKey, batch.Hash())
@@ -204,6 +278,8 @@ func (rc *RollupCompression) createRollupHeader(rollup *core.Rollup) (*common.Ca
 			l1HeightDeltas[i] = big.NewInt(block.Number().Int64() - prevL1Height.Int64())
 		}
 		prevL1Height = block.Number()
+
+		l1GasPrices[i] = gas.L1GasPriceAt(block)
 	}
 
 	l1DeltasBA := make([][]byte, len(l1HeightDeltas))
@@ -229,7 +305,7 @@ func (rc *RollupCompression) createRollupHeader(rollup *core.Rollup) (*common.Ca
 		return nil, err
 	}
 	// optimisation in case there is no reorg header
-	if len(reorgedBatches) == 0 {
+	if len(reorgMap) == 0 {
 		reorgsBA = nil
 	}
 
@@ -253,19 +329,51 @@ func (rc *RollupCompression) createRollupHeader(rollup *core.Rollup) (*common.Ca
 		BatchTimeDeltas:       timeDeltasBA,
 		ReOrgs:                reorgsBA,
 		L1HeightDeltas:        l1DeltasBA,
+		L1GasPrices:           l1GasPrices,
 		//	BatchHashes:           batchHashes,
 		//	BatchHeaders:          batchHeaders,
 		Coinbase: batches[0].Header.Coinbase,
 		BaseFee:  batches[0].Header.BaseFee,
 		GasLimit: batches[0].Header.GasLimit,
 	}
+	if batches[0].SeqNo().Uint64() == common.L2GenesisSeqNo {
+		calldataRollupHeader.GenesisHash = batches[0].Hash()
+	}
 
 	return calldataRollupHeader, nil
 }
 
+// markReplacedBatches - adds to the reorged batches the ones which are not ancestors of the most recent canonical batch.
+// When the L1 returns to a fork, the batches which were duplicated on the other fork are canonical again, next to their
+// duplicates, which are built on the common L1 ancestor. The duplicates have the higher sequence numbers.
+func markReplacedBatches(batches []*core.Batch, reorgMap map[uint64]bool) {
+	byHash := make(map[common.L2BatchHash]*core.Batch, len(batches))
+	var head *core.Batch
+	for _, batch := range batches {
+		byHash[batch.Hash()] = batch
+		if !reorgMap[batch.SeqNo().Uint64()] {
+			head = batch
+		}
+	}
+	if head == nil {
+		return
+	}
+
+	canonical := make(map[uint64]bool, len(batches))
+	for batch := head; batch != nil; batch = byHash[batch.Header.ParentHash] {
+		canonical[batch.SeqNo().Uint64()] = true
+	}
+	for _, batch := range batches {
+		if !canonical[batch.SeqNo().Uint64()] {
+			reorgMap[batch.SeqNo().Uint64()] = true
+		}
+	}
+}
+
 // the main logic to recreate the batches from the header. The logical pair of: `createRollupHeader`
-func (rc *RollupCompression) createIncompleteBatches(calldataRollupHeader *common.CalldataRollupHeader, transactionsPerBatch [][]*common.L2Tx, compressionL1Head common.L1BlockHash) ([]*batchFromRollup, error) {
-	incompleteBatches := make([]*batchFromRollup, len(transactionsPerBatch))
+// the transactions are not set, because they are decoded from the payloads while the batches are executed
+func (rc *RollupCompression) createIncompleteBatches(calldataRollupHeader *common.CalldataRollupHeader, compressionL1Head common.L1BlockHash) ([]*batchFromRollup, error) {
+	incompleteBatches := make([]*batchFromRollup, len(calldataRollupHeader.L1HeightDeltas))
 
 	startAtSeq := calldataRollupHeader.FirstBatchSequence.Int64()
 	currentHeight := calldataRollupHeader.FirstCanonBatchHeight.Int64() - 1
@@ -276,7 +384,7 @@ func (rc *RollupCompression) createIncompleteBatches(calldataRollupHeader *commo
 		return nil, fmt.Errorf("can't find the block used for compression. Cause: %w", err)
 	}
 
-	l1Heights, err := rc.calculateL1HeightsFromDeltas(calldataRollupHeader, transactionsPerBatch)
+	l1Heights, err := rc.calculateL1HeightsFromDeltas(calldataRollupHeader)
 	if err != nil {
 		return nil, err
 	}
@@ -288,7 +396,7 @@ func (rc *RollupCompression) createIncompleteBatches(calldataRollupHeader *commo
 		return nil, err
 	}
 
-	for currentBatchIdx, batchTransactions := range transactionsPerBatch {
+	for currentBatchIdx := range incompleteBatches {
 		// the l1 proofs are stored as deltas, which compress well as it should be a series of 1s and 0s
 		// get the block with the currentL1Height, relative to the rollupL1Block
 		block, f := l1BlocksAtHeight[l1Heights[currentBatchIdx]]
@@ -331,32 +439,29 @@ func (rc *RollupCompression) createIncompleteBatches(calldataRollupHeader *commo
 			currentHeight = currentHeight + 1
 		}
 
-		// calculate the hash of the txs
-		var txHash gethcommon.Hash
-		if len(batchTransactions) == 0 {
-			txHash = types.EmptyRootHash
-		} else {
-			txHash = types.DeriveSha(types.Transactions(batchTransactions), trie.NewStackTrie(nil))
+		// rollups created before the gas prices were recorded are charged at the price of the block
+		var l1GasPrice *big.Int
+		if len(calldataRollupHeader.L1GasPrices) > 0 {
+			l1GasPrice = calldataRollupHeader.L1GasPrices[currentBatchIdx]
 		}
 
 		incompleteBatches[currentBatchIdx] = &batchFromRollup{
-			transactions: batchTransactions,
-			seqNo:        currentSeqNo,
-			height:       big.NewInt(currentHeight),
-			txHash:       txHash,
-			time:         uint64(currentTime),
-			l1Proof:      block.Hash(),
-			header:       fullReorgedHeader,
-			coinbase:     calldataRollupHeader.Coinbase,
-			baseFee:      calldataRollupHeader.BaseFee,
-			gasLimit:     calldataRollupHeader.GasLimit,
+			seqNo:      currentSeqNo,
+			height:     big.NewInt(currentHeight),
+			time:       uint64(currentTime),
+			l1Proof:    block.Hash(),
+			header:     fullReorgedHeader,
+			coinbase:   calldataRollupHeader.Coinbase,
+			baseFee:    calldataRollupHeader.BaseFee,
+			l1GasPrice: l1GasPrice,
+			gasLimit:   calldataRollupHeader.GasLimit,
 		}
 		rc.logger.Info("Rollup decompressed batch", log.BatchSeqNoKey, currentSeqNo, log.BatchHeightKey, currentHeight, "rollup_idx", currentBatchIdx, "l1_height", block.Number(), "l1_hash", block.Hash())
 	}
 	return incompleteBatches, nil
 }
 
-func (rc *RollupCompression) calculateL1HeightsFromDeltas(calldataRollupHeader *common.CalldataRollupHeader, transactionsPerBatch [][]*common.L2Tx) ([]uint64, error) {
+func (rc *RollupCompression) calculateL1HeightsFromDeltas(calldataRollupHeader *common.CalldataRollupHeader) ([]uint64, error) {
 	referenceHeight := big.NewInt(0)
 	// the first element in the deltas is the actual height
 	err := referenceHeight.GobDecode(calldataRollupHeader.L1HeightDeltas[0])
@@ -367,7 +472,7 @@ func (rc *RollupCompression) calculateL1HeightsFromDeltas(calldataRollupHeader *
 	l1Heights := make([]uint64, 0)
 	l1Heights = append(l1Heights, referenceHeight.Uint64())
 	prevHeight := l1Heights[0]
-	for currentBatchIdx := range transactionsPerBatch {
+	for currentBatchIdx := range calldataRollupHeader.L1HeightDeltas {
 		// the l1 proofs are stored as deltas, which compress well as it should be a series of 1s and 0s
 		if currentBatchIdx > 0 {
 			l1Delta := big.NewInt(0)
@@ -398,7 +503,7 @@ func (rc *RollupCompression) calcL1AncestorsOfHeight(fromHeight *big.Int, toBloc
 	return rc.calcL1AncestorsOfHeight(fromHeight, p, path)
 }
 
-func (rc *RollupCompression) executeAndSaveIncompleteBatches(calldataRollupHeader *common.CalldataRollupHeader, incompleteBatches []*batchFromRollup) error { //nolint:gocognit
+func (rc *RollupCompression) executeAndSaveIncompleteBatches(calldataRollupHeader *common.CalldataRollupHeader, incompleteBatches []*batchFromRollup, payloads *batchPayloads) error { //nolint:gocognit
 	parentHash := calldataRollupHeader.FirstCanonParentHash
 
 	if calldataRollupHeader.FirstBatchSequence.Uint64() != common.L2GenesisSeqNo {
@@ -410,18 +515,24 @@ func (rc *RollupCompression) executeAndSaveIncompleteBatches(calldataRollupHeade
 	}
 
 	for _, incompleteBatch := range incompleteBatches {
-		// check whether the batch is already stored in the database
-		b, err := rc.storage.FetchBatchBySeqNo(incompleteBatch.seqNo.Uint64())
-		if err == nil {
+		transactions, err := payloads.next()
+		if err != nil {
+			return fmt.Errorf("could not decode the transactions of batch %d. Cause: %w", incompleteBatch.seqNo, err)
+		}
+		incompleteBatch.transactions = transactions
+
+		stored, err := rc.batchAlreadyProcessed(incompleteBatch)
+		if err != nil {
+			return err
+		}
+		if stored != nil {
 			// chain to a parent only if the batch is not a reorg
 			if incompleteBatch.header == nil {
-				parentHash = b.Hash()
+				parentHash = stored.Hash()
 			}
+			incompleteBatch.transactions = nil
 			continue
 		}
-		if !errors.Is(err, errutil.ErrNotFound) {
-			return err
-		}
 
 		switch {
 		// this batch was re-orged
@@ -451,11 +562,12 @@ func (rc *RollupCompression) executeAndSaveIncompleteBatches(calldataRollupHeade
 			if err != nil {
 				return err
 			}
-			// Sanity check - uncomment when debugging
-			/*if genBatch.Hash() != calldataRollupHeader.BatchHashes[i] {
-				rc.logger.Info(fmt.Sprintf("Good %+v \n Calc %+v", calldataRollupHeader.BatchHeaders[i], genBatch.Header))
-				rc.logger.Crit("Rollup decompression failure. The check hashes don't match")
-			}*/
+			// the following batches are chained to the recreated genesis, so it must be the one of the sequencer
+			expectedGenesis := calldataRollupHeader.GenesisHash
+			if expectedGenesis != (common.L2BatchHash{}) && genBatch.Hash() != expectedGenesis {
+				return fmt.Errorf("%w - the genesis batch recreated from the rollup is %s, the sequencer's is %s. The enclaves must be configured with the same genesis",
+					errutil.ErrGenesisMismatch, genBatch.Hash(), expectedGenesis)
+			}
 
 			convertedHeader, err := rc.gethEncodingService.CreateEthHeaderForBatch(genBatch.Header)
 			if err != nil {
@@ -485,6 +597,7 @@ func (rc *RollupCompression) executeAndSaveIncompleteBatches(calldataRollupHeade
 				incompleteBatch.seqNo,
 				incompleteBatch.coinbase,
 				incompleteBatch.baseFee,
+				incompleteBatch.l1GasPrice,
 			)
 			if err != nil {
 				return err
@@ -516,35 +629,73 @@ func (rc *RollupCompression) executeAndSaveIncompleteBatches(calldataRollupHeade
 
 			parentHash = computedBatch.Batch.Hash()
 		}
+		// the transactions were stored, so they are released before the next batch is decoded
+		incompleteBatch.transactions = nil
 	}
-	return nil
+	return payloads.end()
 }
 
-func (rc *RollupCompression) serialiseCompressAndEncrypt(obj any) ([]byte, error) {
+// batchAlreadyProcessed - returns the stored batch with the same sequence number, if it does not need to be processed
+// again. A canonical batch which was stored but not executed, e.g. because the processing of the rollup failed, is
+// executed again.
+func (rc *RollupCompression) batchAlreadyProcessed(incompleteBatch *batchFromRollup) (*core.Batch, error) {
+	b, err := rc.storage.FetchBatchBySeqNo(incompleteBatch.seqNo.Uint64())
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if incompleteBatch.header != nil {
+		return b, nil
+	}
+	executed, err := rc.storage.BatchWasExecuted(b.Hash())
+	if err != nil {
+		return nil, err
+	}
+	if !executed {
+		return nil, nil
+	}
+	return b, nil
+}
+
+func (rc *RollupCompression) serialiseCompressAndEncrypt(obj any, txCount uint64, stats *common.RollupCompressionStats) ([]byte, error) {
 	serialised, err := rlp.EncodeToBytes(obj)
 	if err != nil {
 		return nil, err
 	}
+	start := time.Now()
 	compressed, err := rc.dataCompressionService.CompressRollup(serialised)
 	if err != nil {
 		return nil, err
 	}
-	encrypted, err := rc.dataEncryptionService.Encrypt(compressed)
+	stats.Duration += time.Since(start)
+	stats.RawBytes += uint64(len(serialised))
+	stats.CompressedBytes += uint64(len(compressed))
+	encrypted, err := rc.dataEncryptionService.Encrypt(core.EncodePayload(compressed, txCount))
 	if err != nil {
 		return nil, err
 	}
 	return encrypted, nil
 }
 
-func (rc *RollupCompression) decryptDecompressAndDeserialise(blob []byte, obj any) error {
+func (rc *RollupCompression) decryptDecompressAndDeserialise(blob []byte, obj any, stats *common.RollupCompressionStats) error {
 	plaintextBlob, err := rc.dataEncryptionService.Decrypt(blob)
 	if err != nil {
 		return err
 	}
-	serialisedBlob, err := rc.dataCompressionService.Decompress(plaintextBlob)
+	payload, err := core.DecodePayload(plaintextBlob)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	serialisedBlob, err := rc.dataCompressionService.Decompress(payload.Compressed)
 	if err != nil {
 		return err
 	}
+	stats.Duration += time.Since(start)
+	stats.RawBytes += uint64(len(serialisedBlob))
+	stats.CompressedBytes += uint64(len(payload.Compressed))
 	err = rlp.DecodeBytes(serialisedBlob, obj)
 	if err != nil {
 		return err
@@ -552,6 +703,68 @@ func (rc *RollupCompression) decryptDecompressAndDeserialise(blob []byte, obj an
 	return nil
 }
 
+// batchPayloads - decodes the transactions of the batches in a rollup one batch at a time, as the decrypted payload
+// is decompressed
+type batchPayloads struct {
+	stream  *rlp.Stream
+	payload *core.Payload
+	txCount int // the number of transactions decoded so far
+}
+
+func (rc *RollupCompression) openBatchPayloads(blob []byte, stats *common.RollupCompressionStats) (*batchPayloads, error) {
+	plaintextBlob, err := rc.dataEncryptionService.Decrypt(blob)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := core.DecodePayload(plaintextBlob)
+	if err != nil {
+		return nil, err
+	}
+	stats.CompressedBytes += uint64(len(payload.Compressed))
+	decompressed := &measuredReader{reader: rc.dataCompressionService.DecompressReader(bytes.NewReader(payload.Compressed)), stats: stats}
+	stream := rlp.NewStream(decompressed, 0)
+	// the payloads are a list with the transactions of each batch
+	if _, err := stream.List(); err != nil {
+		return nil, err
+	}
+	return &batchPayloads{stream: stream, payload: payload}, nil
+}
+
+// next - the transactions of the next batch
+func (p *batchPayloads) next() ([]*common.L2Tx, error) {
+	var transactions []*common.L2Tx
+	if err := p.stream.Decode(&transactions); err != nil {
+		if errors.Is(err, rlp.EOL) {
+			return nil, fmt.Errorf("the rollup has fewer batch payloads than batches")
+		}
+		return nil, err
+	}
+	p.txCount += len(transactions)
+	return transactions, nil
+}
+
+// end - checks that all the payloads were read
+// measuredReader - adds the bytes read, and the time spent reading them, to the stats
+type measuredReader struct {
+	reader io.Reader
+	stats  *common.RollupCompressionStats
+}
+
+func (r *measuredReader) Read(p []byte) (int, error) {
+	start := time.Now()
+	n, err := r.reader.Read(p)
+	r.stats.Duration += time.Since(start)
+	r.stats.RawBytes += uint64(n)
+	return n, err
+}
+
+func (p *batchPayloads) end() error {
+	if err := p.stream.ListEnd(); err != nil {
+		return fmt.Errorf("the rollup has more batch payloads than batches. Cause: %w", err)
+	}
+	return p.payload.CheckTxCount(p.txCount)
+}
+
 func (rc *RollupCompression) computeBatch(
 	BlockPtr common.L1BlockHash,
 	ParentPtr common.L2BatchHash,
@@ -560,6 +773,7 @@ func (rc *RollupCompression) computeBatch(
 	SequencerNo *big.Int,
 	Coinbase gethcommon.Address,
 	BaseFee *big.Int,
+	L1GasPrice *big.Int,
 ) (*ComputedBatch, error) {
 	return rc.batchExecutor.ComputeBatch(&BatchExecutionContext{
 		BlockPtr:     BlockPtr,
@@ -570,6 +784,7 @@ func (rc *RollupCompression) computeBatch(
 		ChainConfig:  rc.chainConfig,
 		SequencerNo:  SequencerNo,
 		BaseFee:      big.NewInt(0).Set(BaseFee),
+		L1GasPrice:   L1GasPrice,
 	}, false)
 }
 
@@ -0,0 +1,205 @@
+package components
+
+import (
+	"crypto/rand"
+	"math/big"
+	"runtime"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/compression"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+const (
+	payloadBatches       = 64
+	payloadRandomBytes   = 1000    // the random bytes make the compressed payload close to the max rollup size
+	payloadZeroBytes     = 1 << 20 // the zeros compress to almost nothing, but take 1MiB when decompressed
+	payloadMemoryCeiling = 16 << 20
+)
+
+func TestBatchPayloadsAreDecodedOneBatchAtATime(t *testing.T) {
+	rc := newTestRollupCompression()
+	blob := encryptedPayloads(t, rc, payloadBatches)
+	require.Less(t, len(blob), 128*1024)
+
+	// the buffers pooled while the payloads were created survive the first collection
+	runtime.GC()
+	runtime.GC()
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	baseline := memStats.HeapAlloc
+
+	stats := &common.RollupCompressionStats{}
+	payloads, err := rc.openBatchPayloads(blob, stats)
+	require.NoError(t, err)
+	var peak uint64
+	for i := 0; i < payloadBatches; i++ {
+		transactions, err := payloads.next()
+		require.NoError(t, err)
+		require.Len(t, transactions, 1)
+		require.Len(t, transactions[0].Data(), payloadRandomBytes+payloadZeroBytes)
+
+		// only the memory still in use counts, so the garbage is collected before measuring
+		runtime.GC()
+		runtime.ReadMemStats(&memStats)
+		if memStats.HeapAlloc > peak {
+			peak = memStats.HeapAlloc
+		}
+	}
+	require.NoError(t, payloads.end())
+
+	// the stats cover the whole payload, although it was never held in memory at once
+	require.Greater(t, stats.RawBytes, uint64(payloadBatches*(payloadRandomBytes+payloadZeroBytes)))
+	require.Less(t, stats.CompressedBytes, uint64(len(blob)))
+	require.Greater(t, stats.Ratio(), float64(100))
+	require.Positive(t, stats.Duration)
+
+	// the decompressed payloads take 64MiB
+	require.Less(t, peak-baseline, uint64(payloadMemoryCeiling), "peak memory: %d bytes above the baseline", peak-baseline)
+}
+
+func TestBatchPayloadsMustMatchTheBatches(t *testing.T) {
+	rc := newTestRollupCompression()
+
+	payloads, err := rc.openBatchPayloads(encryptedPayloads(t, rc, 2), &common.RollupCompressionStats{})
+	require.NoError(t, err)
+	_, err = payloads.next()
+	require.NoError(t, err)
+	require.ErrorContains(t, payloads.end(), "more batch payloads than batches")
+
+	payloads, err = rc.openBatchPayloads(encryptedPayloads(t, rc, 1), &common.RollupCompressionStats{})
+	require.NoError(t, err)
+	_, err = payloads.next()
+	require.NoError(t, err)
+	_, err = payloads.next()
+	require.ErrorContains(t, err, "fewer batch payloads than batches")
+}
+
+func TestLegacyBatchPayloadsOfARollupAreDecoded(t *testing.T) {
+	rc := newTestRollupCompression()
+	transactionsPerBatch := [][]*common.L2Tx{
+		{types.NewTx(&types.LegacyTx{Nonce: 0})},
+		{types.NewTx(&types.LegacyTx{Nonce: 1}), types.NewTx(&types.LegacyTx{Nonce: 2})},
+	}
+	// the payloads as encoded before the versions: the encrypted compressed RLP encoding
+	serialised, err := rlp.EncodeToBytes(transactionsPerBatch)
+	require.NoError(t, err)
+	compressed, err := rc.dataCompressionService.CompressRollup(serialised)
+	require.NoError(t, err)
+	blob, err := rc.dataEncryptionService.Encrypt(compressed)
+	require.NoError(t, err)
+
+	payloads, err := rc.openBatchPayloads(blob, &common.RollupCompressionStats{})
+	require.NoError(t, err)
+	for _, expected := range transactionsPerBatch {
+		transactions, err := payloads.next()
+		require.NoError(t, err)
+		require.Len(t, transactions, len(expected))
+		require.Equal(t, expected[0].Hash(), transactions[0].Hash())
+	}
+	require.NoError(t, payloads.end())
+}
+
+func TestDuplicatedBatchesCanonicalAgainAreReorged(t *testing.T) {
+	// 95-96 were duplicated as 97-98 when the L1 forked, and again as 99-100 when the L1 returned to the first fork
+	// and forked again. 97-98 are built on the common L1 ancestor, so they are still flagged canonical.
+	batches := []*core.Batch{testRollupBatch(94, 84, nil)}
+	replaced := testRollupBatch(95, 85, batches[0])
+	batches = append(batches, replaced, testRollupBatch(96, 86, replaced))
+	duplicate := testRollupBatch(97, 85, batches[0])
+	batches = append(batches, duplicate, testRollupBatch(98, 86, duplicate))
+	canonical := testRollupBatch(99, 85, batches[0])
+	batches = append(batches, canonical, testRollupBatch(100, 86, canonical))
+	batches = append(batches, testRollupBatch(101, 87, batches[6]))
+
+	reorgMap := map[uint64]bool{95: true, 96: true}
+	markReplacedBatches(batches, reorgMap)
+	require.Equal(t, map[uint64]bool{95: true, 96: true, 97: true, 98: true}, reorgMap)
+
+	// without a reorg, all the batches are canonical
+	reorgMap = map[uint64]bool{}
+	markReplacedBatches([]*core.Batch{batches[0], canonical, batches[6], batches[7]}, reorgMap)
+	require.Empty(t, reorgMap)
+}
+
+// noBatchStorage - a validator which has not received any batch
+type noBatchStorage struct {
+	storage.Storage
+}
+
+func (s *noBatchStorage) FetchBatchBySeqNo(uint64) (*core.Batch, error) {
+	return nil, errutil.ErrNotFound
+}
+
+// genesisExecutor - recreates the given genesis batch
+type genesisExecutor struct {
+	BatchExecutor
+	genesis *core.Batch
+}
+
+func (e *genesisExecutor) CreateGenesisState(common.L1BlockHash, uint64, gethcommon.Address, *big.Int) (*core.Batch, *types.Transaction, error) {
+	return e.genesis, nil, nil
+}
+
+func TestRecreatedGenesisMustMatchTheSequencers(t *testing.T) {
+	logger := gethlog.New()
+	localGenesis := testRollupBatch(int64(common.L2GenesisSeqNo), 0, nil)
+	localGenesis.Header.Extra = []byte{1}
+	rc := NewRollupCompression(nil, &genesisExecutor{genesis: localGenesis}, crypto.NewDataEncryptionService(logger), compression.NewBrotliDataCompressionService(), &noBatchStorage{}, nil, nil, nil, logger)
+
+	serialised, err := rlp.EncodeToBytes([][]*common.L2Tx{{}})
+	require.NoError(t, err)
+	compressed, err := rc.dataCompressionService.CompressBatch(serialised)
+	require.NoError(t, err)
+	blob, err := rc.dataEncryptionService.Encrypt(core.EncodePayload(compressed, 0))
+	require.NoError(t, err)
+	payloads, err := rc.openBatchPayloads(blob, &common.RollupCompressionStats{})
+	require.NoError(t, err)
+
+	sequencerGenesis := testRollupBatch(int64(common.L2GenesisSeqNo), 0, nil)
+	header := &common.CalldataRollupHeader{FirstBatchSequence: sequencerGenesis.SeqNo(), GenesisHash: sequencerGenesis.Hash()}
+	incomplete := []*batchFromRollup{{seqNo: sequencerGenesis.SeqNo(), height: big.NewInt(0)}}
+	err = rc.executeAndSaveIncompleteBatches(header, incomplete, payloads)
+	require.ErrorIs(t, err, errutil.ErrGenesisMismatch)
+}
+
+func testRollupBatch(seqNo int64, height int64, parent *core.Batch) *core.Batch {
+	header := &common.BatchHeader{SequencerOrderNo: big.NewInt(seqNo), Number: big.NewInt(height)}
+	if parent != nil {
+		header.ParentHash = parent.Hash()
+	}
+	return &core.Batch{Header: header}
+}
+
+func newTestRollupCompression() *RollupCompression {
+	logger := gethlog.New()
+	return NewRollupCompression(nil, nil, crypto.NewDataEncryptionService(logger), compression.NewBrotliDataCompressionService(), nil, nil, nil, nil, logger)
+}
+
+// encryptedPayloads - the payloads of a rollup with the given number of batches, each with a single large transaction
+func encryptedPayloads(t *testing.T, rc *RollupCompression, batches int) []byte {
+	transactionsPerBatch := make([][]*common.L2Tx, batches)
+	for i := range transactionsPerBatch {
+		data := make([]byte, payloadRandomBytes+payloadZeroBytes)
+		_, err := rand.Read(data[:payloadRandomBytes])
+		require.NoError(t, err)
+		transactionsPerBatch[i] = []*common.L2Tx{types.NewTx(&types.LegacyTx{Nonce: uint64(i), Data: data})}
+	}
+	serialised, err := rlp.EncodeToBytes(transactionsPerBatch)
+	require.NoError(t, err)
+	// the decompression is the same for any compression level, and the default level is much faster for 64MiB
+	compressed, err := rc.dataCompressionService.CompressBatch(serialised)
+	require.NoError(t, err)
+	encrypted, err := rc.dataEncryptionService.Encrypt(core.EncodePayload(compressed, uint64(batches)))
+	require.NoError(t, err)
+	return encrypted
+}
@@ -1,21 +1,32 @@
 package components
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 
+	"github.com/ten-protocol/go-ten/go/enclave/metrics"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 
 	"github.com/ten-protocol/go-ten/go/common/measure"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
 )
 
+// RollupProcessingFailuresUnhealthy - the failed attempts to process a rollup after which the consumer is unhealthy
+const RollupProcessingFailuresUnhealthy = 3
+
 type rollupConsumerImpl struct {
 	MgmtContractLib mgmtcontractlib.MgmtContractLib
 
@@ -26,6 +37,10 @@ type rollupConsumerImpl struct {
 
 	storage      storage.Storage
 	sigValidator *SignatureValidator
+
+	processTimer gethmetrics.Timer
+	rollupSize   gethmetrics.Histogram
+	rollups      gethmetrics.Counter
 }
 
 func NewRollupConsumer(
@@ -35,6 +50,7 @@ func NewRollupConsumer(
 	storage storage.Storage,
 	logger gethlog.Logger,
 	verifier *SignatureValidator,
+	registry *metrics.Registry,
 ) RollupConsumer {
 	return &rollupConsumerImpl{
 		MgmtContractLib:   mgmtContractLib,
@@ -43,49 +59,170 @@ func NewRollupConsumer(
 		logger:            logger,
 		storage:           storage,
 		sigValidator:      verifier,
+		processTimer:      registry.Timer("enclave/rollup/process"),
+		rollupSize:        registry.Histogram("enclave/rollup/size"),
+		rollups:           registry.Counter("enclave/rollup/processed"),
 	}
 }
 
-func (rc *rollupConsumerImpl) ProcessRollupsInBlock(b *common.BlockAndReceipts) error {
+func (rc *rollupConsumerImpl) ProcessRollupsInBlock(ctx context.Context, b *common.BlockAndReceipts) (err error) {
 	defer core.LogMethodDuration(rc.logger, measure.NewStopwatch(), "Rollup consumer processed block", log.BlockHashKey, b.Block.Hash())
+	ctx, span := tracing.StartSpan(ctx, "rollupConsumer.ProcessRollupsInBlock", tracing.BlockHashKey.String(b.Block.Hash().Hex()))
+	defer func() { tracing.EndSpan(span, err) }()
 
 	rollups := rc.extractRollups(b)
-	if len(rollups) == 0 {
-		return nil
+	span.SetAttributes(tracing.CountKey.Int(len(rollups)))
+	if len(rollups) > 0 {
+		rollups, err = rc.getSignedRollup(rollups)
+		if err != nil {
+			return err
+		}
+		// the rollups are recorded before they are processed, so the ones which fail are retried with the next blocks
+		for _, rollup := range rollups {
+			if err = rc.recordRollup(rollup, b.Block); err != nil {
+				return err
+			}
+		}
 	}
+	return rc.ProcessPendingRollups(ctx)
+}
 
-	rollups, err := rc.getSignedRollup(rollups)
+func (rc *rollupConsumerImpl) ProcessPendingRollups(ctx context.Context) error {
+	processings, err := rc.storage.FetchUnprocessedRollups()
 	if err != nil {
 		return err
 	}
+	if len(processings) == 0 {
+		return nil
+	}
+	defer rc.processTimer.UpdateSince(time.Now())
 
-	for _, rollup := range rollups {
-		l1CompressionBlock, err := rc.storage.FetchBlock(rollup.Header.CompressionL1Head)
-		if err != nil {
-			rc.logger.Warn("Can't process rollup because the l1 block used for compression is not available", "block_hash", rollup.Header.CompressionL1Head, log.RollupHashKey, rollup.Hash(), log.ErrKey, err)
-			continue
-		}
-		canonicalBlockByHeight, err := rc.storage.FetchCanonicaBlockByHeight(l1CompressionBlock.Number())
-		if err != nil {
+	// the batches of a rollup follow the ones of the previous rollups, so the processing stops at the first failure
+	for _, processing := range processings {
+		if err := rc.processRollup(ctx, processing); err != nil {
 			return err
 		}
-		if canonicalBlockByHeight.Hash() != l1CompressionBlock.Hash() {
-			rc.logger.Warn("Skipping rollup because it was compressed on top of a non-canonical rollup", "block_hash", rollup.Header.CompressionL1Head, log.RollupHashKey, rollup.Hash(), log.ErrKey, err)
-			continue
-		}
-		// read batch data from rollup, verify and store it
-		internalHeader, err := rc.rollupCompression.ProcessExtRollup(rollup)
-		if err != nil {
-			rc.logger.Error("Failed processing rollup", log.RollupHashKey, rollup.Hash(), log.ErrKey, err)
-			// todo - issue challenge as a validator
-			return err
-		}
-		if err := rc.storage.StoreRollup(rollup, internalHeader); err != nil {
-			rc.logger.Error("Failed storing rollup", log.RollupHashKey, rollup.Hash(), log.ErrKey, err)
-			return err
+	}
+	return nil
+}
+
+func (rc *rollupConsumerImpl) HealthCheck() (bool, error) {
+	processings, err := rc.storage.FetchUnprocessedRollups()
+	if err != nil {
+		return false, err
+	}
+	for _, processing := range processings {
+		if processing.Attempts >= RollupProcessingFailuresUnhealthy {
+			return false, fmt.Errorf("rollup %s failed to be processed %d times. Cause: %s", processing.RollupHash, processing.Attempts, processing.Reason)
 		}
 	}
+	return true, nil
+}
 
+// recordRollup - records the rollup as pending, unless it was already published, e.g. by a block submitted again
+func (rc *rollupConsumerImpl) recordRollup(rollup *common.ExtRollup, block *types.Block) error {
+	processing, err := rc.storage.FetchRollupProcessing(rollup.Hash())
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return err
+	}
+	// a rollup skipped because its block was reorged is processed again when it is published by another block
+	if processing != nil && (processing.Status != common.RollupProcessingSkipped || processing.L1Block == block.Hash()) {
+		return nil
+	}
+	return rc.storage.StoreRollupProcessing(&common.RollupProcessing{
+		RollupHash: rollup.Hash(),
+		L1Block:    block.Hash(),
+		L1Height:   block.NumberU64(),
+		Status:     common.RollupProcessingPending,
+		UpdatedAt:  time.Now(),
+	}, rollup)
+}
+
+// processRollup - stores and executes the batches of the rollup, then records the outcome. The batches stored by a
+// failed attempt are not stored again, so the rollup can be retried.
+func (rc *rollupConsumerImpl) processRollup(ctx context.Context, processing *common.RollupProcessing) error {
+	rollup, err := rc.storage.FetchUnprocessedRollup(processing.RollupHash)
+	if err != nil {
+		return fmt.Errorf("could not fetch the rollup %s to process. Cause: %w", processing.RollupHash, err)
+	}
+
+	skipReason, err := rc.skipReason(processing, rollup)
+	if err == nil && skipReason == "" {
+		err = rc.storeRollup(ctx, processing, rollup)
+	}
+	switch {
+	case err != nil:
+		rc.logger.Error("Failed processing rollup. It will be retried", log.RollupHashKey, rollup.Hash(), "attempts", processing.Attempts+1, log.ErrKey, err)
+		processing.Status = common.RollupProcessingFailed
+		processing.Reason = err.Error()
+		processing.Attempts++
+	case skipReason != "":
+		rc.logger.Warn("Skipping rollup", log.RollupHashKey, rollup.Hash(), "reason", skipReason)
+		processing.Status = common.RollupProcessingSkipped
+		processing.Reason = skipReason
+	default:
+		processing.Status = common.RollupProcessingComplete
+		processing.Reason = ""
+		rc.rollups.Inc(1)
+	}
+	processing.UpdatedAt = time.Now()
+
+	if storeErr := rc.storage.StoreRollupProcessing(processing, rollup); storeErr != nil {
+		return fmt.Errorf("could not record the processing of rollup %s. Cause: %w", rollup.Hash(), storeErr)
+	}
+	return err
+}
+
+// skipReason - why the rollup must not be processed, if it was published or compressed on blocks which were reorged
+func (rc *rollupConsumerImpl) skipReason(processing *common.RollupProcessing, rollup *common.ExtRollup) (string, error) {
+	canonical, err := rc.isCanonical(processing.L1Block)
+	if err != nil {
+		return "", err
+	}
+	if !canonical {
+		return "the l1 block which published the rollup is not canonical", nil
+	}
+
+	canonical, err = rc.isCanonical(rollup.Header.CompressionL1Head)
+	if errors.Is(err, errutil.ErrNotFound) {
+		return fmt.Sprintf("the l1 block used for compression %s is not available", rollup.Header.CompressionL1Head), nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if !canonical {
+		return fmt.Sprintf("the rollup was compressed on top of the non-canonical block %s", rollup.Header.CompressionL1Head), nil
+	}
+	return "", nil
+}
+
+func (rc *rollupConsumerImpl) isCanonical(blockHash common.L1BlockHash) (bool, error) {
+	block, err := rc.storage.FetchBlock(blockHash)
+	if err != nil {
+		return false, err
+	}
+	canonicalBlockByHeight, err := rc.storage.FetchCanonicaBlockByHeight(block.Number())
+	if err != nil {
+		return false, err
+	}
+	return canonicalBlockByHeight.Hash() == block.Hash(), nil
+}
+
+// storeRollup - reads the batch data from the rollup, verifies and stores it
+func (rc *rollupConsumerImpl) storeRollup(ctx context.Context, processing *common.RollupProcessing, rollup *common.ExtRollup) error {
+	_, decompressSpan := tracing.StartSpan(ctx, "rollupCompression.ProcessExtRollup")
+	internalHeader, err := rc.rollupCompression.ProcessExtRollup(rollup)
+	tracing.EndSpan(decompressSpan, err)
+	if err != nil {
+		// todo - issue challenge as a validator
+		return err
+	}
+	_, storeSpan := tracing.StartSpan(ctx, "storage.StoreRollup")
+	err = rc.storage.StoreRollup(rollup, internalHeader, processing.L1Block)
+	tracing.EndSpan(storeSpan, err)
+	if err != nil {
+		return fmt.Errorf("failed storing rollup. Cause: %w", err)
+	}
 	return nil
 }
 
@@ -94,7 +231,7 @@ func (rc *rollupConsumerImpl) getSignedRollup(rollups []*common.ExtRollup) ([]*c
 
 	// loop through the rollups, find the one that is signed, verify the signature, make sure it's the only one
 	for _, rollup := range rollups {
-		if err := rc.sigValidator.CheckSequencerSignature(rollup.Hash(), rollup.Header.R, rollup.Header.S); err != nil {
+		if err := rc.sigValidator.CheckRollupSignature(rollup.Header); err != nil {
 			return nil, fmt.Errorf("rollup signature was invalid. Cause: %w", err)
 		}
 
@@ -108,6 +245,10 @@ func (rc *rollupConsumerImpl) getSignedRollup(rollups []*common.ExtRollup) ([]*c
 func (rc *rollupConsumerImpl) extractRollups(br *common.BlockAndReceipts) []*common.ExtRollup {
 	rollups := make([]*common.ExtRollup, 0)
 	b := br.Block
+	// the rollups are published with calls to the management contract
+	if !br.HasTransactionsTo(*rc.MgmtContractLib.GetContractAddr()) {
+		return rollups
+	}
 
 	for _, tx := range *br.SuccessfulTransactions() {
 		// go through all rollup transactions
@@ -127,6 +268,7 @@ func (rc *rollupConsumerImpl) extractRollups(br *common.BlockAndReceipts) []*com
 			return nil
 		}
 
+		rc.rollupSize.Update(int64(len(rolTx.Rollup)))
 		rollups = append(rollups, r)
 		rc.logger.Info("Extracted rollup from block", log.RollupHashKey, r.Hash(), log.BlockHashKey, b.Hash())
 	}
@@ -3,12 +3,15 @@ package components
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/core/types"
 
+	"github.com/ten-protocol/go-ten/go/enclave/metrics"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
 
 	"github.com/ten-protocol/go-ten/contracts/generated/MessageBus"
 
@@ -24,19 +27,25 @@ type rollupProducerImpl struct {
 	storage       storage.Storage
 	batchRegistry BatchRegistry
 	logger        gethlog.Logger
+
+	createTimer   gethmetrics.Timer
+	rollupBatches gethmetrics.Histogram
 }
 
-func NewRollupProducer(sequencerID gethcommon.Address, storage storage.Storage, batchRegistry BatchRegistry, logger gethlog.Logger) RollupProducer {
+func NewRollupProducer(sequencerID gethcommon.Address, storage storage.Storage, batchRegistry BatchRegistry, registry *metrics.Registry, logger gethlog.Logger) RollupProducer {
 	return &rollupProducerImpl{
 		sequencerID:   sequencerID,
 		logger:        logger,
 		batchRegistry: batchRegistry,
 		storage:       storage,
+		createTimer:   registry.Timer("enclave/rollup/create"),
+		rollupBatches: registry.Histogram("enclave/rollup/batches"),
 	}
 }
 
-func (re *rollupProducerImpl) CreateInternalRollup(fromBatchNo uint64, upToL1Height uint64, limiter limiters.RollupLimiter) (*core.Rollup, error) {
-	batches, blocks, err := re.batchRegistry.BatchesAfter(fromBatchNo, upToL1Height, limiter)
+func (re *rollupProducerImpl) CreateInternalRollup(fromBatchNo uint64, upToBatchNo uint64, upToL1Height uint64, limiter limiters.RollupLimiter) (*core.Rollup, error) {
+	defer re.createTimer.UpdateSince(time.Now())
+	batches, blocks, err := re.batchRegistry.BatchesAfter(fromBatchNo, upToBatchNo, upToL1Height, limiter)
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch 'from' batch (seqNo=%d) for rollup: %w", fromBatchNo, err)
 	}
@@ -75,6 +84,7 @@ func (re *rollupProducerImpl) CreateInternalRollup(fromBatchNo uint64, upToL1Hei
 		Batches: batches,
 	}
 
+	re.rollupBatches.Update(int64(len(batches)))
 	re.logger.Info(fmt.Sprintf("Created new rollup %s with %d batches. From %d to %d", newRollup.Hash(), len(newRollup.Batches), batches[0].SeqNo(), rh.LastBatchSeqNo))
 
 	return newRollup, nil
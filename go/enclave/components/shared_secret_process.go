@@ -1,8 +1,11 @@
 package components
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethcrypto "github.com/ethereum/go-ethereum/crypto"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common"
@@ -13,18 +16,29 @@ import (
 	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
 )
 
+var (
+	// errSecretAlreadyDisclosed - a request re-broadcast with the same attestation is not answered again, the requester
+	// can decrypt the response that was already published
+	errSecretAlreadyDisclosed = errors.New("the secret was already disclosed in response to this attestation")
+	errSecretRateLimited      = errors.New("too many secret responses to the requester within the window")
+)
+
 type SharedSecretProcessor struct {
 	mgmtContractLib     mgmtcontractlib.MgmtContractLib
 	attestationProvider AttestationProvider // interface for producing attestation reports and verifying them
 	storage             storage.Storage
+	limiter             *secretResponseLimiter
 	logger              gethlog.Logger
 }
 
-func NewSharedSecretProcessor(mgmtcontractlib mgmtcontractlib.MgmtContractLib, attestationProvider AttestationProvider, storage storage.Storage, logger gethlog.Logger) *SharedSecretProcessor {
+// NewSharedSecretProcessor - at most responseLimit secret responses are produced for the same requester within the
+// responseWindow. A zero limit disables the rate limiting.
+func NewSharedSecretProcessor(mgmtcontractlib mgmtcontractlib.MgmtContractLib, attestationProvider AttestationProvider, storage storage.Storage, responseLimit uint64, responseWindow time.Duration, logger gethlog.Logger) *SharedSecretProcessor {
 	return &SharedSecretProcessor{
 		mgmtContractLib:     mgmtcontractlib,
 		attestationProvider: attestationProvider,
 		storage:             storage,
+		limiter:             newSecretResponseLimiter(responseLimit, responseWindow),
 		logger:              logger,
 	}
 }
@@ -40,12 +54,32 @@ func (ssp *SharedSecretProcessor) ProcessNetworkSecretMsgs(br *common.BlockAndRe
 		// this transaction is for a node that has joined the network and needs to be sent the network secret
 		if scrtReqTx, ok := t.(*ethadapter.L1RequestSecretTx); ok {
 			ssp.logger.Info("Process shared secret request.", log.BlockHeightKey, block.Number(), log.BlockHashKey, block.Hash(), log.TxKey, tx.Hash())
-			resp, err := ssp.processSecretRequest(scrtReqTx)
-			if err != nil {
+			record := &common.SecretDisclosureRecord{
+				AttestationHash: gethcrypto.Keccak256Hash(scrtReqTx.Attestation),
+				L1BlockHash:     block.Hash(),
+				L1BlockHeight:   block.NumberU64(),
+				L1TxHash:        tx.Hash(),
+				Timestamp:       time.Now(),
+			}
+			resp, err := ssp.processSecretRequest(scrtReqTx, record)
+			switch {
+			case errors.Is(err, errSecretAlreadyDisclosed) || errors.Is(err, errSecretRateLimited):
+				ssp.logger.Warn("Skipped shared secret request.", "requester", record.RequesterID, log.ErrKey, err)
+				record.Reason = err.Error()
+			case err != nil:
 				ssp.logger.Error("Failed to process shared secret request.", log.ErrKey, err)
+				record.Reason = err.Error()
+			}
+			record.Approved = resp != nil
+			// the secret is only disclosed once the disclosure is on record
+			if err := ssp.storage.StoreSecretDisclosure(record); err != nil {
+				ssp.logger.Error("Could not record the secret disclosure, no response will be published.", log.ErrKey, err)
 				continue
 			}
-			responses = append(responses, resp)
+			if resp != nil {
+				ssp.limiter.record(resp.RequesterID, record.Timestamp)
+				responses = append(responses, resp)
+			}
 		}
 
 		// this transaction was created by the genesis node, we need to store their attested key to decrypt their rollup
@@ -67,11 +101,24 @@ func (ssp *SharedSecretProcessor) ProcessNetworkSecretMsgs(br *common.BlockAndRe
 	return responses
 }
 
-func (ssp *SharedSecretProcessor) processSecretRequest(req *ethadapter.L1RequestSecretTx) (*common.ProducedSecretResponse, error) {
+// processSecretRequest - the record is filled in with the requester, once it is known
+func (ssp *SharedSecretProcessor) processSecretRequest(req *ethadapter.L1RequestSecretTx, record *common.SecretDisclosureRecord) (*common.ProducedSecretResponse, error) {
 	att, err := common.DecodeAttestation(req.Attestation)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode attestation - %w", err)
 	}
+	record.RequesterID = att.Owner
+
+	answered, err := ssp.storage.SecretRequestAnswered(att.Owner, record.AttestationHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not check whether the request was already answered. Cause: %w", err)
+	}
+	if answered {
+		return nil, errSecretAlreadyDisclosed
+	}
+	if !ssp.limiter.allow(att.Owner, record.Timestamp) {
+		return nil, errSecretRateLimited
+	}
 
 	ssp.logger.Info("received attestation", "attestation", att)
 	secret, err := ssp.verifyAttestationAndEncryptSecret(att)
@@ -127,3 +174,48 @@ func (ssp *SharedSecretProcessor) storeAttestation(att *common.AttestationReport
 	}
 	return nil
 }
+
+// secretResponseLimiter - the times of the secret responses produced for each requester within the window. They are
+// kept in memory, so a restarted enclave starts with a fresh window.
+type secretResponseLimiter struct {
+	limit     uint64
+	window    time.Duration
+	responses map[gethcommon.Address][]time.Time
+}
+
+func newSecretResponseLimiter(limit uint64, window time.Duration) *secretResponseLimiter {
+	return &secretResponseLimiter{
+		limit:     limit,
+		window:    window,
+		responses: make(map[gethcommon.Address][]time.Time),
+	}
+}
+
+// allow - whether another response can be produced for the requester at the time
+func (l *secretResponseLimiter) allow(requester gethcommon.Address, now time.Time) bool {
+	if l.limit == 0 {
+		return true
+	}
+	l.prune(requester, now)
+	return uint64(len(l.responses[requester])) < l.limit
+}
+
+func (l *secretResponseLimiter) record(requester gethcommon.Address, now time.Time) {
+	if l.limit == 0 {
+		return
+	}
+	l.responses[requester] = append(l.responses[requester], now)
+}
+
+// prune - forgets the responses to the requester which are out of the window
+func (l *secretResponseLimiter) prune(requester gethcommon.Address, now time.Time) {
+	times := l.responses[requester]
+	for len(times) > 0 && !times[0].After(now.Add(-l.window)) {
+		times = times[1:]
+	}
+	if len(times) == 0 {
+		delete(l.responses, requester)
+		return
+	}
+	l.responses[requester] = times
+}
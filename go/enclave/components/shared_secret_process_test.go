@@ -0,0 +1,184 @@
+package components
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
+)
+
+// requestSecretContractLib - decodes the data of every transaction as an attestation requesting the secret
+type requestSecretContractLib struct {
+	mgmtcontractlib.MgmtContractLib
+}
+
+func (l *requestSecretContractLib) DecodeTx(tx *types.Transaction) ethadapter.L1Transaction {
+	return &ethadapter.L1RequestSecretTx{Attestation: tx.Data()}
+}
+
+// disclosureStorage - holds the secret, and the audit log in memory
+type disclosureStorage struct {
+	storage.Storage
+	records []*common.SecretDisclosureRecord
+}
+
+func (s *disclosureStorage) FetchSecret() (*crypto.SharedEnclaveSecret, error) {
+	return &crypto.SharedEnclaveSecret{}, nil
+}
+
+func (s *disclosureStorage) StoreAttestedKey(gethcommon.Address, *ecdsa.PublicKey) error {
+	return nil
+}
+
+func (s *disclosureStorage) StoreSecretDisclosure(record *common.SecretDisclosureRecord) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *disclosureStorage) SecretRequestAnswered(requester gethcommon.Address, attestationHash gethcommon.Hash) (bool, error) {
+	for _, record := range s.records {
+		if record.Approved && record.RequesterID == requester && record.AttestationHash == attestationHash {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// secretRequest - an encoded attestation of the requester, with a fresh key
+func secretRequest(t *testing.T, requester gethcommon.Address) []byte {
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	attestation, err := common.EncodeAttestation(&common.AttestationReport{PubKey: gethcrypto.CompressPubkey(&key.PublicKey), Owner: requester})
+	require.NoError(t, err)
+	return attestation
+}
+
+// secretRequestBlock - a block at the height with a successful transaction for each of the requests
+func secretRequestBlock(t *testing.T, height int64, requests ...[]byte) *common.BlockAndReceipts {
+	txs := make(types.Transactions, len(requests))
+	receipts := make(types.Receipts, len(requests))
+	for i, request := range requests {
+		txs[i] = types.NewTx(&types.LegacyTx{Nonce: uint64(height), Data: request})
+		receipts[i] = &types.Receipt{Status: types.ReceiptStatusSuccessful}
+	}
+	block := types.NewBlock(&types.Header{Number: big.NewInt(height)}, txs, nil, receipts, trie.NewStackTrie(nil))
+	br, err := common.ParseBlockAndReceipts(block, &receipts, nil, nil)
+	require.NoError(t, err)
+	return br
+}
+
+func TestSecretDisclosuresAreAudited(t *testing.T) {
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	requester := gethcommon.HexToAddress("0x1")
+	attestation, err := common.EncodeAttestation(&common.AttestationReport{PubKey: gethcrypto.CompressPubkey(&key.PublicKey), Owner: requester})
+	require.NoError(t, err)
+	malformed := []byte("not an attestation")
+
+	txs := types.Transactions{
+		types.NewTx(&types.LegacyTx{Nonce: 0, Data: attestation}),
+		types.NewTx(&types.LegacyTx{Nonce: 1, Data: malformed}),
+	}
+	receipts := types.Receipts{{Status: types.ReceiptStatusSuccessful}, {Status: types.ReceiptStatusSuccessful}}
+	block := types.NewBlock(&types.Header{Number: big.NewInt(7)}, txs, nil, receipts, trie.NewStackTrie(nil))
+	br, err := common.ParseBlockAndReceipts(block, &receipts, nil, nil)
+	require.NoError(t, err)
+
+	db := &disclosureStorage{}
+	processor := NewSharedSecretProcessor(&requestSecretContractLib{}, &DummyAttestationProvider{}, db, 0, 0, gethlog.New())
+	responses := processor.ProcessNetworkSecretMsgs(br)
+	require.Len(t, responses, 1)
+	require.Equal(t, requester, responses[0].RequesterID)
+
+	// both requests are on record, the denied one with the reason
+	require.Len(t, db.records, 2)
+	approved, denied := db.records[0], db.records[1]
+	require.True(t, approved.Approved)
+	require.Empty(t, approved.Reason)
+	require.Equal(t, requester, approved.RequesterID)
+	require.Equal(t, gethcrypto.Keccak256Hash(attestation), approved.AttestationHash)
+	require.Equal(t, block.Hash(), approved.L1BlockHash)
+	require.Equal(t, uint64(7), approved.L1BlockHeight)
+	require.Equal(t, txs[0].Hash(), approved.L1TxHash)
+	require.False(t, approved.Timestamp.IsZero())
+
+	require.False(t, denied.Approved)
+	require.NotEmpty(t, denied.Reason)
+	require.Equal(t, gethcommon.Address{}, denied.RequesterID)
+	require.Equal(t, gethcrypto.Keccak256Hash(malformed), denied.AttestationHash)
+	require.Equal(t, txs[1].Hash(), denied.L1TxHash)
+}
+
+func TestRebroadcastSecretRequestIsAnsweredOnce(t *testing.T) {
+	requester := gethcommon.HexToAddress("0x1")
+	request := secretRequest(t, requester)
+
+	db := &disclosureStorage{}
+	processor := NewSharedSecretProcessor(&requestSecretContractLib{}, &DummyAttestationProvider{}, db, 10, time.Hour, gethlog.New())
+	var responses []*common.ProducedSecretResponse
+	for height := int64(1); height <= 5; height++ {
+		responses = append(responses, processor.ProcessNetworkSecretMsgs(secretRequestBlock(t, height, request))...)
+	}
+	require.Len(t, responses, 1)
+
+	// every request is on record, the re-broadcast ones as skipped
+	require.Len(t, db.records, 5)
+	require.True(t, db.records[0].Approved)
+	for _, record := range db.records[1:] {
+		require.False(t, record.Approved)
+		require.Equal(t, errSecretAlreadyDisclosed.Error(), record.Reason)
+		require.Equal(t, requester, record.RequesterID)
+	}
+
+	// a requester which wiped its database comes back with a new attestation
+	responses = processor.ProcessNetworkSecretMsgs(secretRequestBlock(t, 6, secretRequest(t, requester)))
+	require.Len(t, responses, 1)
+	require.True(t, db.records[5].Approved)
+}
+
+func TestSecretResponsesAreRateLimitedPerRequester(t *testing.T) {
+	requester := gethcommon.HexToAddress("0x1")
+	other := gethcommon.HexToAddress("0x2")
+
+	db := &disclosureStorage{}
+	processor := NewSharedSecretProcessor(&requestSecretContractLib{}, &DummyAttestationProvider{}, db, 2, time.Hour, gethlog.New())
+	responses := processor.ProcessNetworkSecretMsgs(secretRequestBlock(t, 1,
+		secretRequest(t, requester), secretRequest(t, requester), secretRequest(t, requester), secretRequest(t, other)))
+	require.Len(t, responses, 3)
+	require.Equal(t, other, responses[2].RequesterID)
+
+	require.Len(t, db.records, 4)
+	require.False(t, db.records[2].Approved)
+	require.Equal(t, errSecretRateLimited.Error(), db.records[2].Reason)
+	require.True(t, db.records[3].Approved)
+}
+
+func TestSecretResponseLimiterWindow(t *testing.T) {
+	requester := gethcommon.HexToAddress("0x1")
+	limiter := newSecretResponseLimiter(1, time.Hour)
+	now := time.Now()
+
+	require.True(t, limiter.allow(requester, now))
+	limiter.record(requester, now)
+	require.False(t, limiter.allow(requester, now.Add(59*time.Minute)))
+	// the response is out of the window
+	require.True(t, limiter.allow(requester, now.Add(time.Hour)))
+	require.Empty(t, limiter.responses)
+
+	// the limit is disabled
+	limiter = newSecretResponseLimiter(0, time.Hour)
+	limiter.record(requester, now)
+	require.True(t, limiter.allow(requester, now))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -12,25 +13,38 @@ import (
 
 type SignatureValidator struct {
 	SequencerID gethcommon.Address
+	scheme      common.SignatureScheme
 	attestedKey *ecdsa.PublicKey
 	storage     storage.Storage
 }
 
-func NewSignatureValidator(seqID gethcommon.Address, storage storage.Storage) (*SignatureValidator, error) {
+func NewSignatureValidator(seqID gethcommon.Address, scheme common.SignatureScheme, storage storage.Storage) (*SignatureValidator, error) {
 	// todo (#718) - sequencer identities should be retrieved from the L1 management contract
 	return &SignatureValidator{
 		SequencerID: seqID,
+		scheme:      scheme,
 		storage:     storage,
 		attestedKey: nil,
 	}, nil
 }
 
-// CheckSequencerSignature - verifies the signature against the registered sequencer
-func (sigChecker *SignatureValidator) CheckSequencerSignature(headerHash gethcommon.Hash, sigR *big.Int, sigS *big.Int) error {
-	if sigR == nil || sigS == nil {
+// CheckBatchSignature - verifies the signature of the batch against the registered sequencer
+func (sigChecker *SignatureValidator) CheckBatchSignature(header *common.BatchHeader) error {
+	if header.R == nil || header.S == nil {
 		return fmt.Errorf("missing signature on batch")
 	}
+	return sigChecker.checkSequencerSignature(sigChecker.scheme.BatchDigest(header), header.R, header.S)
+}
+
+// CheckRollupSignature - verifies the signature of the rollup against the registered sequencer
+func (sigChecker *SignatureValidator) CheckRollupSignature(header *common.RollupHeader) error {
+	if header.R == nil || header.S == nil {
+		return fmt.Errorf("missing signature on rollup")
+	}
+	return sigChecker.checkSequencerSignature(sigChecker.scheme.RollupDigest(header), header.R, header.S)
+}
 
+func (sigChecker *SignatureValidator) checkSequencerSignature(digest gethcommon.Hash, sigR *big.Int, sigS *big.Int) error {
 	if sigChecker.attestedKey == nil {
 		attestedKey, err := sigChecker.storage.FetchAttestedKey(sigChecker.SequencerID)
 		if err != nil {
@@ -39,7 +53,7 @@ func (sigChecker *SignatureValidator) CheckSequencerSignature(headerHash gethcom
 		sigChecker.attestedKey = attestedKey
 	}
 
-	if !ecdsa.Verify(sigChecker.attestedKey, headerHash.Bytes(), sigR, sigS) {
+	if !ecdsa.Verify(sigChecker.attestedKey, digest.Bytes(), sigR, sigS) {
 		return fmt.Errorf("could not verify ECDSA signature")
 	}
 	return nil
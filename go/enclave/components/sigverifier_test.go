@@ -0,0 +1,66 @@
+package components
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+// attestedKeyStorage - holds the key of the sequencer only
+type attestedKeyStorage struct {
+	storage.Storage
+	key *ecdsa.PublicKey
+}
+
+func (s *attestedKeyStorage) FetchAttestedKey(gethcommon.Address) (*ecdsa.PublicKey, error) {
+	return s.key, nil
+}
+
+func TestSignaturesAreDomainSeparatedFromTheActivation(t *testing.T) {
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	scheme := common.SignatureScheme{ChainID: 443, ActivationSeqNo: 10}
+	validator, err := NewSignatureValidator(gethcommon.Address{}, scheme, &attestedKeyStorage{key: &key.PublicKey})
+	require.NoError(t, err)
+
+	// the batches signed before the activation still verify
+	legacy := &common.BatchHeader{SequencerOrderNo: big.NewInt(9)}
+	sign(t, key, legacy.Hash(), &legacy.R, &legacy.S)
+	require.NoError(t, validator.CheckBatchSignature(legacy))
+
+	// from the activation on, only the domain separated digest is accepted
+	batch := &common.BatchHeader{SequencerOrderNo: big.NewInt(10)}
+	sign(t, key, batch.Hash(), &batch.R, &batch.S)
+	require.Error(t, validator.CheckBatchSignature(batch))
+	sign(t, key, scheme.BatchDigest(batch), &batch.R, &batch.S)
+	require.NoError(t, validator.CheckBatchSignature(batch))
+
+	// the signatures of another network are rejected
+	otherNetwork := common.SignatureScheme{ChainID: 444, ActivationSeqNo: 10}
+	sign(t, key, otherNetwork.BatchDigest(batch), &batch.R, &batch.S)
+	require.Error(t, validator.CheckBatchSignature(batch))
+
+	// as are the signatures of another type of message over the same hash
+	sign(t, key, common.DomainSeparatedDigest(common.RollupSignatureDomain, scheme.ChainID, batch.Hash()), &batch.R, &batch.S)
+	require.Error(t, validator.CheckBatchSignature(batch))
+
+	// the rollups follow the scheme of their last batch
+	rollup := &common.RollupHeader{LastBatchSeqNo: 12}
+	sign(t, key, rollup.Hash(), &rollup.R, &rollup.S)
+	require.Error(t, validator.CheckRollupSignature(rollup))
+	sign(t, key, scheme.RollupDigest(rollup), &rollup.R, &rollup.S)
+	require.NoError(t, validator.CheckRollupSignature(rollup))
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, digest gethcommon.Hash, r **big.Int, s **big.Int) {
+	var err error
+	*r, *s, err = ecdsa.Sign(rand.Reader, key, digest.Bytes())
+	require.NoError(t, err)
+}
@@ -0,0 +1,65 @@
+package components
+
+import (
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+// inboundQueue - the inbound cross chain messages and value transfers waiting for their synthetic transactions, in the
+// order the transactions are created for them: the messages, then the value transfers, each oldest first
+type inboundQueue struct {
+	messages  common.CrossChainMessages
+	transfers common.ValueTransferEvents
+}
+
+// pendingInbound - the items deferred by the parent batch, followed by the ones received since the parent
+func (executor *batchExecutor) pendingInbound(parent common.L2BatchHash, messages common.CrossChainMessages, transfers common.ValueTransferEvents) (inboundQueue, error) {
+	deferredMessages, deferredTransfers, err := executor.storage.FetchDeferredInbound(parent)
+	if err != nil {
+		return inboundQueue{}, err
+	}
+	return inboundQueue{
+		messages:  append(deferredMessages, messages...),
+		transfers: append(deferredTransfers, transfers...),
+	}, nil
+}
+
+func (q inboundQueue) size() int {
+	return len(q.messages) + len(q.transfers)
+}
+
+// split - the items consumed by the first n synthetic transactions, and the remaining ones
+func (q inboundQueue) split(n int) (inboundQueue, inboundQueue) {
+	if n < len(q.messages) {
+		return inboundQueue{messages: q.messages[:n]}, inboundQueue{messages: q.messages[n:], transfers: q.transfers}
+	}
+	n -= len(q.messages)
+	return inboundQueue{messages: q.messages, transfers: q.transfers[:n]}, inboundQueue{transfers: q.transfers[n:]}
+}
+
+// syntheticGasBudget - the gas the synthetic transactions of the inbound items can use in the batch. They are executed
+// with the free transactions, within the gas limit of the batch header, so every enclave computes the same budget.
+func syntheticGasBudget(batchGasLimit uint64, freeTransactions common.L2PricedTransactions) uint64 {
+	available := batchGasLimit
+	for _, tx := range freeTransactions {
+		if tx.Tx.Gas() >= available {
+			return 0
+		}
+		available -= tx.Tx.Gas()
+	}
+	if common.SyntheticTxGasBudget < available {
+		return common.SyntheticTxGasBudget
+	}
+	return available
+}
+
+// syntheticTxsWithinBudget - the number of synthetic transactions, from the first, whose gas limits fit in the budget. The
+// gas limits are known before the execution, so every enclave includes the same transactions.
+func syntheticTxsWithinBudget(txs common.L2Transactions, budget uint64) int {
+	for i, tx := range txs {
+		if tx.Gas() > budget {
+			return i
+		}
+		budget -= tx.Gas()
+	}
+	return len(txs)
+}
@@ -44,10 +44,12 @@ func (e *EnclaveContainer) Stop() error {
 }
 
 // NewEnclaveContainerFromConfig wires up the components of the Enclave and its RPC server. Manages their lifecycle/monitors their status
-func NewEnclaveContainerFromConfig(config *config.EnclaveConfig) *EnclaveContainer {
+func NewEnclaveContainerFromConfig(config *config.EnclaveConfig) (*EnclaveContainer, error) {
 	// todo - improve this wiring, perhaps setup DB etc. at this level and inject into enclave
 	// (at that point the WithLogger constructor could be a full DI constructor like the HostContainer tries, for testability)
-	logger := log.New(log.EnclaveCmp, config.LogLevel, config.LogPath, log.NodeIDKey, config.HostID)
+	// the enclave filters its records at LogLevel, or at the level of their component, so the logger lets through the
+	// most verbose of them. The components can then be logged at a more verbose level than the rest of the enclave
+	logger := log.New(log.EnclaveCmp, config.MostVerboseLogLevel(), config.LogPath, log.NodeIDKey, config.HostID)
 
 	// todo - this is for debugging purposes only, should be remove in the future
 	fmt.Printf("Building enclave container with config: %+v\n", config)
@@ -57,7 +59,7 @@ func NewEnclaveContainerFromConfig(config *config.EnclaveConfig) *EnclaveContain
 }
 
 // NewEnclaveContainerWithLogger is useful for testing etc.
-func NewEnclaveContainerWithLogger(config *config.EnclaveConfig, logger gethlog.Logger) *EnclaveContainer {
+func NewEnclaveContainerWithLogger(config *config.EnclaveConfig, logger gethlog.Logger) (*EnclaveContainer, error) {
 	contractAddr := config.ManagementContractAddress
 	mgmtContractLib := mgmtcontractlib.NewMgmtContractLib(&contractAddr, logger)
 
@@ -67,15 +69,18 @@ func NewEnclaveContainerWithLogger(config *config.EnclaveConfig, logger gethlog.
 
 	genesis, err := obscuroGenesis.New(config.ObscuroGenesis)
 	if err != nil {
-		logger.Crit("unable to parse obscuro genesis", log.ErrKey, err)
+		return nil, fmt.Errorf("unable to parse obscuro genesis. Cause: %w", err)
 	}
 
-	encl := enclave.NewEnclave(config, genesis, mgmtContractLib, logger)
+	encl, err := enclave.NewEnclave(config, genesis, mgmtContractLib, logger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the enclave. Cause: %w", err)
+	}
 	rpcServer := enclave.NewEnclaveRPCServer(config.Address, encl, logger)
 
 	return &EnclaveContainer{
 		Enclave:   encl,
 		RPCServer: rpcServer,
 		Logger:    logger,
-	}
+	}, nil
 }
@@ -11,6 +11,7 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/ten-protocol/go-ten/go/common"
 )
 
@@ -55,6 +56,27 @@ func (b *Batch) IsGenesis() bool {
 	return b.Header.Number.Cmp(big.NewInt(int64(common.L2GenesisHeight))) == 0
 }
 
+// ExecutionSummary - the summary of the execution of the batch which produced the receipts
+func (b *Batch) ExecutionSummary(receipts types.Receipts) *common.ExecutedBatchSummary {
+	receiptsRoot := types.EmptyRootHash
+	if len(receipts) > 0 {
+		receiptsRoot = types.DeriveSha(receipts, trie.NewStackTrie(nil))
+	}
+	var gasUsed uint64
+	for _, receipt := range receipts {
+		gasUsed += receipt.GasUsed
+	}
+	return &common.ExecutedBatchSummary{
+		SeqNo:        b.SeqNo().Uint64(),
+		Height:       b.NumberU64(),
+		BatchHash:    b.Hash(),
+		StateRoot:    b.Header.Root,
+		ReceiptsRoot: receiptsRoot,
+		GasUsed:      gasUsed,
+		TxCount:      uint64(len(b.Transactions)),
+	}
+}
+
 func (b *Batch) ToExtBatch(transactionBlobCrypto crypto.DataEncryptionService, compression compression.DataCompressionService) (*common.ExtBatch, error) {
 	txHashes := make([]gethcommon.Hash, len(b.Transactions))
 	for idx, tx := range b.Transactions {
@@ -69,7 +91,7 @@ func (b *Batch) ToExtBatch(transactionBlobCrypto crypto.DataEncryptionService, c
 	if err != nil {
 		return nil, err
 	}
-	enc, err := transactionBlobCrypto.Encrypt(compressed)
+	enc, err := transactionBlobCrypto.Encrypt(EncodePayload(compressed, uint64(len(b.Transactions))))
 	if err != nil {
 		return nil, err
 	}
@@ -81,11 +103,15 @@ func (b *Batch) ToExtBatch(transactionBlobCrypto crypto.DataEncryptionService, c
 }
 
 func ToBatch(extBatch *common.ExtBatch, transactionBlobCrypto crypto.DataEncryptionService, compression compression.DataCompressionService) (*Batch, error) {
-	compressed, err := transactionBlobCrypto.Decrypt(extBatch.EncryptedTxBlob)
+	envelope, err := transactionBlobCrypto.Decrypt(extBatch.EncryptedTxBlob)
 	if err != nil {
 		return nil, err
 	}
-	encoded, err := compression.Decompress(compressed)
+	payload, err := DecodePayload(envelope)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := compression.Decompress(payload.Compressed)
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +120,9 @@ func ToBatch(extBatch *common.ExtBatch, transactionBlobCrypto crypto.DataEncrypt
 	if err != nil {
 		return nil, err
 	}
+	if err = payload.CheckTxCount(len(txs)); err != nil {
+		return nil, err
+	}
 	return &Batch{
 		Header:       extBatch.Header,
 		Transactions: txs,
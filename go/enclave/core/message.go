@@ -0,0 +1,22 @@
+package core
+
+import (
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+// MessageTransition - a step in the lifecycle of a cross chain message, recorded as batches and L1 blocks are processed
+type MessageTransition struct {
+	MessageHash gethcommon.Hash
+	Inbound     bool // true for L1->L2 messages
+	State       common.CrossChainMessageState
+	BatchSeqNo  *uint64             // the batch which included the message
+	L1Block     *common.L1BlockHash // the L1 block where the message was published or consumed
+
+	// resolved when the transitions are read back
+	BatchHash     *common.L2BatchHash
+	BatchHeight   *big.Int
+	L1BlockHeight *big.Int
+}
@@ -0,0 +1,88 @@
+package core
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// The encrypted payloads of the batches and of the rollups start with PayloadMagic and the version of their encoding, so
+// an enclave can decode the payloads produced by the older versions during an upgrade. The payloads are always encoded
+// with the latest version.
+const (
+	// PayloadMagic - the first byte of the versioned payloads. A brotli stream never starts with it, because the window
+	// size it encodes is invalid, so the payloads encoded before the versions, which are bare brotli streams, are told apart
+	PayloadMagic byte = 0x91
+
+	// PayloadLegacy - the compressed RLP encoding, without the magic and the version. Only decoded
+	PayloadLegacy byte = 0
+	// PayloadV1 - the compressed RLP encoding
+	PayloadV1 byte = 1
+	// PayloadV2 - the number of transactions in the payload as a uvarint, followed by the compressed RLP encoding
+	PayloadV2 byte = 2
+
+	LatestPayloadVersion = PayloadV2
+)
+
+// ErrUnknownPayloadVersion - the payload was encoded by a newer version of the enclave
+var ErrUnknownPayloadVersion = errors.New("unknown payload version, the enclave is too old")
+
+// Payload - the content of a payload envelope
+type Payload struct {
+	Version    byte
+	TxCount    *uint64 // nil for the versions which do not carry it
+	Compressed []byte
+}
+
+// EncodePayload - wraps the compressed payload in an envelope of the latest version
+func EncodePayload(compressed []byte, txCount uint64) []byte {
+	envelope, _ := EncodePayloadVersion(LatestPayloadVersion, compressed, txCount)
+	return envelope
+}
+
+// EncodePayloadVersion - wraps the compressed payload in an envelope of the given version
+func EncodePayloadVersion(version byte, compressed []byte, txCount uint64) ([]byte, error) {
+	switch version {
+	case PayloadV1:
+		return append([]byte{PayloadMagic, PayloadV1}, compressed...), nil
+	case PayloadV2:
+		envelope := binary.AppendUvarint([]byte{PayloadMagic, PayloadV2}, txCount)
+		return append(envelope, compressed...), nil
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownPayloadVersion, version)
+	}
+}
+
+// DecodePayload - opens the envelope of a payload of any known version, or returns the legacy payload as it is
+func DecodePayload(envelope []byte) (*Payload, error) {
+	if len(envelope) == 0 {
+		return nil, fmt.Errorf("empty payload")
+	}
+	if envelope[0] != PayloadMagic {
+		return &Payload{Version: PayloadLegacy, Compressed: envelope}, nil
+	}
+	if len(envelope) < 2 {
+		return nil, fmt.Errorf("payload without a version")
+	}
+	version, body := envelope[1], envelope[2:]
+	switch version {
+	case PayloadV1:
+		return &Payload{Version: version, Compressed: body}, nil
+	case PayloadV2:
+		txCount, n := binary.Uvarint(body)
+		if n <= 0 {
+			return nil, fmt.Errorf("invalid transaction count in a payload of version %d", version)
+		}
+		return &Payload{Version: version, TxCount: &txCount, Compressed: body[n:]}, nil
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownPayloadVersion, version)
+	}
+}
+
+// CheckTxCount - checks the number of decoded transactions against the count carried by the payload, if any
+func (p *Payload) CheckTxCount(count int) error {
+	if p.TxCount != nil && *p.TxCount != uint64(count) {
+		return fmt.Errorf("the payload has %d transactions, but declares %d", count, *p.TxCount)
+	}
+	return nil
+}
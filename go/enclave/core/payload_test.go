@@ -0,0 +1,94 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/compression"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+)
+
+func TestBatchPayloadsOfAllVersionsAreDecoded(t *testing.T) {
+	encryption := crypto.NewDataEncryptionService(gethlog.New())
+	dataCompression := compression.NewBrotliDataCompressionService()
+	batch := &Batch{
+		Header:       &common.BatchHeader{Number: big.NewInt(1)},
+		Transactions: []*common.L2Tx{types.NewTx(&types.LegacyTx{Nonce: 0}), types.NewTx(&types.LegacyTx{Nonce: 1})},
+	}
+
+	// the latest version
+	extBatch, err := batch.ToExtBatch(encryption, dataCompression)
+	require.NoError(t, err)
+	decoded, err := ToBatch(extBatch, encryption, dataCompression)
+	require.NoError(t, err)
+	require.Len(t, decoded.Transactions, 2)
+
+	for _, version := range []byte{PayloadV1, PayloadV2} {
+		extBatch.EncryptedTxBlob = encryptedBatchPayload(t, encryption, dataCompression, batch, version, 2)
+		decoded, err = ToBatch(extBatch, encryption, dataCompression)
+		require.NoError(t, err)
+		require.Equal(t, batch.Transactions[1].Hash(), decoded.Transactions[1].Hash())
+	}
+
+	// the transaction count must match the transactions
+	extBatch.EncryptedTxBlob = encryptedBatchPayload(t, encryption, dataCompression, batch, PayloadV2, 3)
+	_, err = ToBatch(extBatch, encryption, dataCompression)
+	require.ErrorContains(t, err, "declares 3")
+
+	// the payloads of the newer versions are reported, not decoded
+	envelope, err := EncodePayloadVersion(PayloadV1, []byte{1, 2, 3}, 0)
+	require.NoError(t, err)
+	envelope[1] = LatestPayloadVersion + 1
+	extBatch.EncryptedTxBlob, err = encryption.Encrypt(envelope)
+	require.NoError(t, err)
+	_, err = ToBatch(extBatch, encryption, dataCompression)
+	require.ErrorIs(t, err, ErrUnknownPayloadVersion)
+}
+
+func TestLegacyBatchPayloadsAreDecoded(t *testing.T) {
+	encryption := crypto.NewDataEncryptionService(gethlog.New())
+	dataCompression := compression.NewBrotliDataCompressionService()
+	for _, txs := range []int{0, 1, 100} {
+		batch := &Batch{Header: &common.BatchHeader{Number: big.NewInt(1)}}
+		for nonce := 0; nonce < txs; nonce++ {
+			batch.Transactions = append(batch.Transactions, types.NewTx(&types.LegacyTx{Nonce: uint64(nonce), Data: []byte{1, 2}}))
+		}
+
+		// the payload as encoded before the versions: the encrypted compressed RLP encoding
+		encoded, err := rlp.EncodeToBytes(batch.Transactions)
+		require.NoError(t, err)
+		compressed, err := dataCompression.CompressBatch(encoded)
+		require.NoError(t, err)
+		require.NotEqual(t, PayloadMagic, compressed[0])
+		encrypted, err := encryption.Encrypt(compressed)
+		require.NoError(t, err)
+
+		decoded, err := ToBatch(&common.ExtBatch{Header: batch.Header, EncryptedTxBlob: encrypted}, encryption, dataCompression)
+		require.NoError(t, err)
+		require.Len(t, decoded.Transactions, txs)
+		for i, tx := range decoded.Transactions {
+			require.Equal(t, batch.Transactions[i].Hash(), tx.Hash())
+		}
+	}
+
+	// a brotli stream never starts with the magic
+	_, err := dataCompression.Decompress([]byte{PayloadMagic, 0, 0, 0})
+	require.Error(t, err)
+}
+
+func encryptedBatchPayload(t *testing.T, encryption crypto.DataEncryptionService, dataCompression compression.DataCompressionService, batch *Batch, version byte, txCount uint64) []byte {
+	encoded, err := rlp.EncodeToBytes(batch.Transactions)
+	require.NoError(t, err)
+	compressed, err := dataCompression.CompressBatch(encoded)
+	require.NoError(t, err)
+	envelope, err := EncodePayloadVersion(version, compressed, txCount)
+	require.NoError(t, err)
+	encrypted, err := encryption.Encrypt(envelope)
+	require.NoError(t, err)
+	return encrypted
+}
@@ -3,6 +3,8 @@ package core
 import (
 	"sync/atomic"
 
+	"github.com/ten-protocol/go-ten/contracts/generated/MessageBus"
+
 	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/ten-protocol/go-ten/go/common"
@@ -26,3 +28,19 @@ func (r *Rollup) Hash() common.L2BatchHash {
 	r.hash.Store(v)
 	return v
 }
+
+// Prefix returns a rollup made of the first n batches of r. The header is copied, with the fields derived from the
+// batches recomputed.
+func (r *Rollup) Prefix(n int) *Rollup {
+	header := *r.Header
+	header.CrossChainMessages = make([]MessageBus.StructsCrossChainMessage, 0)
+	for _, b := range r.Batches[:n] {
+		header.CrossChainMessages = append(header.CrossChainMessages, b.Header.CrossChainMessages...)
+	}
+	header.LastBatchSeqNo = r.Batches[n-1].SeqNo().Uint64()
+	return &Rollup{
+		Header:  &header,
+		Batches: r.Batches[:n],
+		Blocks:  r.Blocks,
+	}
+}
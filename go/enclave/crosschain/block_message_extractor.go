@@ -8,6 +8,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/log"
@@ -15,20 +16,23 @@ import (
 )
 
 type blockMessageExtractor struct {
-	busAddress *common.L1Address
-	storage    storage.Storage
-	logger     gethlog.Logger
+	busAddress       *common.L1Address
+	messengerAddress *common.L1Address // the only messenger whose relayed messages are recorded as consumed
+	storage          storage.Storage
+	logger           gethlog.Logger
 }
 
 func NewBlockMessageExtractor(
 	busAddress *common.L1Address,
+	messengerAddress *common.L1Address,
 	storage storage.Storage,
 	logger gethlog.Logger,
 ) BlockMessageExtractor {
 	return &blockMessageExtractor{
-		busAddress: busAddress,
-		storage:    storage,
-		logger:     logger.New(log.CmpKey, log.CrossChainCmp),
+		busAddress:       busAddress,
+		messengerAddress: messengerAddress,
+		storage:          storage,
+		logger:           logger.New(log.CmpKey, log.CrossChainCmp),
 	}
 }
 
@@ -46,7 +50,7 @@ func (m *blockMessageExtractor) StoreCrossChainValueTransfers(block *common.L1Bl
 		return fmt.Errorf("receipts do not match the receipt root for the block")
 	}*/
 
-	if len(receipts) == 0 {
+	if len(receipts) == 0 || !common.MayContainL1Log(block, *m.GetBusAddress(), ValueTransferEventID) {
 		return nil
 	}
 
@@ -78,7 +82,7 @@ func (m *blockMessageExtractor) StoreCrossChainValueTransfers(block *common.L1Bl
 func (m *blockMessageExtractor) StoreCrossChainMessages(block *common.L1Block, receipts common.L1Receipts) error {
 	defer core.LogMethodDuration(m.logger, measure.NewStopwatch(), "Block cross chain messages processed", log.BlockHashKey, block.Hash())
 
-	if len(receipts) == 0 {
+	if len(receipts) == 0 || !common.MayContainL1Log(block, *m.GetBusAddress(), CrossChainEventID) {
 		return nil
 	}
 
@@ -96,11 +100,63 @@ func (m *blockMessageExtractor) StoreCrossChainMessages(block *common.L1Block, r
 			m.logger.Crit("Unable to store the messages", log.ErrKey, err)
 			return err
 		}
+		if err = m.storeTransitions(block, messages, true, common.MessagePending); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// StoreConsumedMessages - records the outbound messages relayed on the L1 by the successful transactions of the block.
+// Only the calls to the configured messenger whose receipts were supplied by the host are considered, since any
+// contract can expose a method with the same selector.
+func (m *blockMessageExtractor) StoreConsumedMessages(block *common.L1Block, receipts common.L1Receipts) error {
+	if m.messengerAddress == nil || *m.messengerAddress == (gethcommon.Address{}) {
+		return nil
+	}
+	messages := make(common.CrossChainMessages, 0)
+	for i, tx := range block.Transactions() {
+		if tx.To() == nil || *tx.To() != *m.messengerAddress {
+			continue
+		}
+		if i >= len(receipts) || receipts[i] == nil || receipts[i].Status != types.ReceiptStatusSuccessful {
+			continue
+		}
+		msg, found, err := decodeRelayedMessage(tx.Data())
+		if err != nil {
+			m.logger.Warn("Could not decode relayed message", log.TxKey, tx.Hash(), log.ErrKey, err)
+			continue
+		}
+		if found {
+			messages = append(messages, msg)
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+	m.logger.Debug(fmt.Sprintf("Found %d relayed messages", len(messages)), log.BlockHashKey, block.Hash())
+	return m.storeTransitions(block, messages, false, common.MessageConsumedOnL1)
+}
+
+func (m *blockMessageExtractor) storeTransitions(block *common.L1Block, messages common.CrossChainMessages, inbound bool, state common.CrossChainMessageState) error {
+	blockHash := block.Hash()
+	transitions := make([]*core.MessageTransition, len(messages))
+	for i, msg := range messages {
+		t, err := newTransition(msg, inbound, state)
+		if err != nil {
+			return err
+		}
+		t.L1Block = &blockHash
+		transitions[i] = t
+	}
+	if err := m.storage.StoreMessageTransitions(transitions); err != nil {
+		return fmt.Errorf("could not store the message transitions of block %s. Cause: %w", blockHash, err)
+	}
+	return nil
+}
+
 // GetBusAddress - Returns the address of the L1 message bus.
 func (m *blockMessageExtractor) GetBusAddress() *common.L1Address {
 	return m.busAddress
@@ -0,0 +1,172 @@
+package crosschain
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+var (
+	extractorBusAddress = gethcommon.HexToAddress("0xb05")
+	messengerAddress    = gethcommon.HexToAddress("0xe55")
+	erc20TransferTopic  = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// extractorStorage - records the value transfers stored for each block and the message transitions
+type extractorStorage struct {
+	storage.Storage
+	transfers   map[common.L1BlockHash]common.ValueTransferEvents
+	transitions []*core.MessageTransition
+}
+
+func (s *extractorStorage) StoreMessageTransitions(transitions []*core.MessageTransition) error {
+	s.transitions = append(s.transitions, transitions...)
+	return nil
+}
+
+func (s *extractorStorage) StoreValueTransfers(blockHash common.L1BlockHash, transfers common.ValueTransferEvents) error {
+	s.transfers[blockHash] = transfers
+	return nil
+}
+
+// newL1Block - a block with a successful transaction for each of the receipts. The bloom of the header is the one of
+// the receipts, unless it is given.
+func newL1Block(height int64, receipts types.Receipts, bloom *types.Bloom) *types.Block {
+	txs := make(types.Transactions, len(receipts))
+	for i := range receipts {
+		txs[i] = types.NewTx(&types.LegacyTx{Nonce: uint64(i), To: &gethcommon.Address{}})
+		receipts[i].Status = types.ReceiptStatusSuccessful
+		receipts[i].Bloom = types.CreateBloom(types.Receipts{receipts[i]})
+	}
+	header := &types.Header{Number: big.NewInt(height), Bloom: types.CreateBloom(receipts)}
+	if bloom != nil {
+		header.Bloom = *bloom
+	}
+	return types.NewBlockWithHeader(header).WithBody(txs, nil)
+}
+
+func valueTransferLog(t *testing.T, amount int64) *types.Log {
+	data, err := MessageBusABI.Events[ValueTransferEventName].Inputs.Pack(gethcommon.HexToAddress("0x1"), gethcommon.HexToAddress("0x2"), big.NewInt(amount))
+	require.NoError(t, err)
+	return &types.Log{Address: extractorBusAddress, Topics: []gethcommon.Hash{ValueTransferEventID}, Data: data}
+}
+
+// erc20Receipt - the receipt of a token transfer, the most common log on the mainnet
+func erc20Receipt(token gethcommon.Address) *types.Receipt {
+	return &types.Receipt{Logs: []*types.Log{{
+		Address: token,
+		Topics:  []gethcommon.Hash{erc20TransferTopic, gethcommon.BytesToHash(token.Bytes()), gethcommon.BytesToHash(extractorBusAddress.Bytes())},
+		Data:    make([]byte, 32),
+	}}}
+}
+
+func saturatedBloom() *types.Bloom {
+	var bloom types.Bloom
+	for i := range bloom {
+		bloom[i] = 0xff
+	}
+	return &bloom
+}
+
+func TestValueTransfersAreNotMissedByTheBloomCheck(t *testing.T) {
+	s := &extractorStorage{transfers: map[common.L1BlockHash]common.ValueTransferEvents{}}
+	extractor := NewBlockMessageExtractor(&extractorBusAddress, &gethcommon.Address{}, s, gethlog.New())
+
+	relevant := types.Receipts{erc20Receipt(gethcommon.HexToAddress("0xe1")), {Logs: []*types.Log{valueTransferLog(t, 5)}}}
+	block := newL1Block(1, relevant, nil)
+	require.NoError(t, extractor.StoreCrossChainValueTransfers(block, relevant))
+	require.Len(t, s.transfers[block.Hash()], 1)
+	require.Equal(t, big.NewInt(5), s.transfers[block.Hash()][0].Amount)
+
+	// a false positive of the bloom falls back to the full scan
+	relevant = types.Receipts{{Logs: []*types.Log{valueTransferLog(t, 6)}}}
+	block = newL1Block(2, relevant, saturatedBloom())
+	require.NoError(t, extractor.StoreCrossChainValueTransfers(block, relevant))
+	require.Len(t, s.transfers[block.Hash()], 1)
+
+	irrelevant := types.Receipts{erc20Receipt(gethcommon.HexToAddress("0xe1"))}
+	block = newL1Block(3, irrelevant, saturatedBloom())
+	require.NoError(t, extractor.StoreCrossChainValueTransfers(block, irrelevant))
+	require.NotContains(t, s.transfers, block.Hash())
+
+	// the receipts are not searched when the bloom proves they have no transfer
+	block = newL1Block(4, irrelevant, nil)
+	require.False(t, common.MayContainL1Log(block, extractorBusAddress, ValueTransferEventID))
+	require.NoError(t, extractor.StoreCrossChainValueTransfers(block, irrelevant))
+	require.NotContains(t, s.transfers, block.Hash())
+}
+
+func TestOnlyTheMessagesRelayedByTheMessengerAreConsumed(t *testing.T) {
+	s := &extractorStorage{}
+	extractor := NewBlockMessageExtractor(&extractorBusAddress, &messengerAddress, s, gethlog.New())
+
+	relayed := common.CrossChainMessage{Sender: gethcommon.HexToAddress("0x1234"), Sequence: 1, Payload: []byte{1}}
+	spoofed := common.CrossChainMessage{Sender: gethcommon.HexToAddress("0x1234"), Sequence: 2, Payload: []byte{2}}
+	relayCall := func(msg common.CrossChainMessage) []byte {
+		calldata, err := MessengerABI.Pack("relayMessage", msg)
+		require.NoError(t, err)
+		return calldata
+	}
+	other := gethcommon.HexToAddress("0xbad")
+	txs := types.Transactions{
+		types.NewTx(&types.LegacyTx{Nonce: 0, To: &messengerAddress, Data: relayCall(relayed)}),
+		// a contract exposing a method with the same selector
+		types.NewTx(&types.LegacyTx{Nonce: 1, To: &other, Data: relayCall(spoofed)}),
+	}
+	receipts := types.Receipts{{Status: types.ReceiptStatusSuccessful}, {Status: types.ReceiptStatusSuccessful}}
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1)}).WithBody(txs, nil)
+
+	require.NoError(t, extractor.StoreConsumedMessages(block, receipts))
+	require.Len(t, s.transitions, 1)
+	relayedHash, err := MessageHash(relayed)
+	require.NoError(t, err)
+	require.Equal(t, relayedHash, s.transitions[0].MessageHash)
+	require.Equal(t, common.MessageConsumedOnL1, s.transitions[0].State)
+
+	// nothing is recorded without a messenger
+	s.transitions = nil
+	extractor = NewBlockMessageExtractor(&extractorBusAddress, &gethcommon.Address{}, s, gethlog.New())
+	require.NoError(t, extractor.StoreConsumedMessages(block, receipts))
+	require.Empty(t, s.transitions)
+}
+
+// BenchmarkIrrelevantL1Blocks - the cross chain scanning of 1000 blocks with 150 token transfers each and no event of
+// the message bus. With the saturated bloom, every block is a false positive, so the receipts are always searched.
+func BenchmarkIrrelevantL1Blocks(b *testing.B) {
+	const blockCount, txsPerBlock = 1000, 150
+	receipts := make([]types.Receipts, blockCount)
+	blocks := make([]*types.Block, blockCount)
+	fullScanBlocks := make([]*types.Block, blockCount)
+	for i := range blocks {
+		receipts[i] = make(types.Receipts, txsPerBlock)
+		for j := range receipts[i] {
+			receipts[i][j] = erc20Receipt(gethcommon.BigToAddress(big.NewInt(int64(j + 1))))
+		}
+		blocks[i] = newL1Block(int64(i), receipts[i], nil)
+		fullScanBlocks[i] = newL1Block(int64(i), receipts[i], saturatedBloom())
+	}
+	extractor := NewBlockMessageExtractor(&extractorBusAddress, &gethcommon.Address{}, &extractorStorage{}, gethlog.New())
+
+	for name, blocks := range map[string][]*types.Block{"bloom": blocks, "full scan": fullScanBlocks} {
+		b.Run(name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				for i, block := range blocks {
+					if err := extractor.StoreCrossChainMessages(block, receipts[i]); err != nil {
+						b.Fatal(err)
+					}
+					if err := extractor.StoreCrossChainValueTransfers(block, receipts[i]); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/contracts/generated/CrossChainMessenger"
 	"github.com/ten-protocol/go-ten/contracts/generated/MessageBus"
 	"github.com/ten-protocol/go-ten/go/common"
 	"golang.org/x/crypto/sha3"
@@ -23,8 +24,23 @@ var (
 	CrossChainEventID      = MessageBusABI.Events[CrossChainEventName].ID
 	ValueTransferEventName = "ValueTransfer"
 	ValueTransferEventID   = MessageBusABI.Events["ValueTransfer"].ID
+	MessengerABI, _        = abi.JSON(strings.NewReader(CrossChainMessenger.CrossChainMessengerMetaData.ABI))
+	RelayMessageMethod     = MessengerABI.Methods["relayMessage"]
+
+	// ValueTransferTopic - the topic of the messages which move native value between the layers, `Topics.VALUE` in the bridge
+	ValueTransferTopic = uint32(2)
+	// valueTransferArguments - the encoding of the `ValueTransfer` struct of the bridge
+	valueTransferArguments = abi.Arguments{{Type: mustNewType("uint256")}, {Type: mustNewType("address")}}
 )
 
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
 func lazilyLogReceiptChecksum(block *common.L1Block, receipts types.Receipts, logger gethlog.Logger) {
 	logger.Trace("Processing block", log.BlockHashKey, block.Hash(), "nr_rec", len(receipts), "Hash",
 		gethlog.Lazy{Fn: func() string {
@@ -171,3 +187,19 @@ func convertLogsToValueTransfers(logs []types.Log, eventName string, messageBusA
 
 	return messages, nil
 }
+
+// decodeRelayedMessage - extracts the message from the calldata of a call to the relayMessage method of a messenger
+func decodeRelayedMessage(calldata []byte) (common.CrossChainMessage, bool, error) {
+	if len(calldata) < 4 || !bytes.Equal(calldata[:4], RelayMessageMethod.ID) {
+		return common.CrossChainMessage{}, false, nil
+	}
+	args, err := RelayMessageMethod.Inputs.Unpack(calldata[4:])
+	if err != nil {
+		return common.CrossChainMessage{}, false, err
+	}
+	msg, ok := abi.ConvertType(args[0], new(MessageBus.StructsCrossChainMessage)).(*MessageBus.StructsCrossChainMessage)
+	if !ok {
+		return common.CrossChainMessage{}, false, errors.New("unexpected relayMessage arguments")
+	}
+	return *msg, true, nil
+}
@@ -18,6 +18,9 @@ type BlockMessageExtractor interface {
 
 	StoreCrossChainValueTransfers(block *common.L1Block, receipts common.L1Receipts) error
 
+	// StoreConsumedMessages - records the outbound messages relayed on the L1 by the transactions of the block
+	StoreConsumedMessages(block *common.L1Block, receipts common.L1Receipts) error
+
 	// GetBusAddress - Returns the L1 message bus address.
 	GetBusAddress() *common.L1Address
 
@@ -47,9 +50,21 @@ type Manager interface {
 
 	ExtractOutboundTransfers(receipts common.L2Receipts) (common.ValueTransferEvents, error)
 
-	CreateSyntheticTransactions(messages common.CrossChainMessages, rollupState *state.StateDB) common.L2Transactions
+	// CreateSyntheticTransactions - Creates the transactions which store the inbound messages in the L2 message bus and
+	// release the value deposited on the L1 to the receivers.
+	CreateSyntheticTransactions(messages common.CrossChainMessages, transfers common.ValueTransferEvents, rollupState *state.StateDB) common.L2Transactions
 
+	// ExecuteValueTransfers - Locks the value deposited on the L1 in the L2 message bus, ready to be released by the synthetic transactions.
 	ExecuteValueTransfers(transfers common.ValueTransferEvents, rollupState *state.StateDB)
 
-	RetrieveInboundMessages(fromBlock *common.L1Block, toBlock *common.L1Block, rollupState *state.StateDB) (common.CrossChainMessages, common.ValueTransferEvents)
+	// BurnOutboundTransfers - Removes the value sent to the L1 from the L2 message bus.
+	BurnOutboundTransfers(transfers common.ValueTransferEvents, rollupState *state.StateDB)
+
+	// CreateWithdrawalMessages - Converts the outbound value transfers of a batch to messages for the L1.
+	CreateWithdrawalMessages(transfers common.ValueTransferEvents, batchSeqNo uint64) (common.CrossChainMessages, error)
+
+	// RetrieveInboundMessages - the cross chain messages and value transfers of the confirmed blocks between the two,
+	// oldest block first when oldestFirst is set, otherwise newest block first, as the batches before the activation
+	// of the synthetic gas budget included them
+	RetrieveInboundMessages(fromBlock *common.L1Block, toBlock *common.L1Block, rollupState *state.StateDB, oldestFirst bool) (common.CrossChainMessages, common.ValueTransferEvents)
 }
@@ -30,11 +30,13 @@ type MessageBusManager struct {
 	storage           storage.Storage
 	logger            gethlog.Logger
 	wallet            wallet.Wallet
+	confirmationDepth uint64
 }
 
 func NewObscuroMessageBusManager(
 	storage storage.Storage, /*key *ecdsa.PrivateKey,*/
 	chainID *big.Int,
+	confirmationDepth uint64,
 	logger gethlog.Logger,
 ) Manager {
 	// todo (#1549) - implement with cryptography epic, remove this key and use the DeriveKey
@@ -52,6 +54,7 @@ func NewObscuroMessageBusManager(
 		storage:           storage,
 		logger:            logger,
 		wallet:            wallet,
+		confirmationDepth: confirmationDepth,
 	}
 }
 
@@ -139,13 +142,25 @@ func (m *MessageBusManager) ExtractOutboundTransfers(receipts common.L2Receipts)
 }
 
 // RetrieveInboundMessages - Retrieves the cross chain messages between two blocks.
+// Only the messages of the blocks with at least `confirmationDepth` blocks on top are retrieved, so the range is
+// shifted back by the depth. The blocks are walked through the ancestors of the toBlock, so the messages of blocks
+// reorged out before they were confirmed are never included.
 // todo (@stefan) - fix ordering of messages, currently it is irrelevant.
 // todo (@stefan) - do not extract messages below their consistency level. Irrelevant security wise.
 // todo (@stefan) - surface errors
-func (m *MessageBusManager) RetrieveInboundMessages(fromBlock *common.L1Block, toBlock *common.L1Block, _ *state.StateDB) (common.CrossChainMessages, common.ValueTransferEvents) {
+func (m *MessageBusManager) RetrieveInboundMessages(fromBlock *common.L1Block, toBlock *common.L1Block, _ *state.StateDB, oldestFirst bool) (common.CrossChainMessages, common.ValueTransferEvents) {
 	messages := make(common.CrossChainMessages, 0)
 	transfers := make(common.ValueTransferEvents, 0)
 
+	toBlock, confirmed := m.confirmedAncestor(toBlock)
+	if !confirmed {
+		m.logger.Debug("No L1 block deep enough for its cross chain messages to be confirmed")
+		return messages, transfers
+	}
+	// the blocks the previous batch could not include yet are eligible now. If the chain is not long enough,
+	// the walk starts from the earliest known block.
+	fromBlock, _ = m.confirmedAncestor(fromBlock)
+
 	from := fromBlock.Hash()
 	height := fromBlock.NumberU64()
 	if !m.storage.IsAncestor(toBlock, fromBlock) {
@@ -170,8 +185,15 @@ func (m *MessageBusManager) RetrieveInboundMessages(fromBlock *common.L1Block, t
 			m.logger.Crit("Unable to get L1 transfers for block that should be there.", log.ErrKey, err)
 		}
 
-		messages = append(messages, messagesForBlock...) // Ordering here might work in POBI, but might be weird for fast finality
-		transfers = append(transfers, transfersForBlock...)
+		// the walk goes from the newest block, so the items of each block are put in front to return them oldest first,
+		// which is the order in which the synthetic transactions are created and the overflowing ones are deferred
+		if oldestFirst {
+			messages = append(messagesForBlock, messages...)
+			transfers = append(transfersForBlock, transfers...)
+		} else {
+			messages = append(messages, messagesForBlock...)
+			transfers = append(transfers, transfersForBlock...)
+		}
 
 		// No deposits before genesis.
 		if b.NumberU64() < height {
@@ -193,20 +215,66 @@ func (m *MessageBusManager) RetrieveInboundMessages(fromBlock *common.L1Block, t
 	return messages, transfers
 }
 
+// confirmedAncestor - returns the ancestor `confirmationDepth` blocks below the block, or the earliest known ancestor and
+// false if the chain is not that long
+func (m *MessageBusManager) confirmedAncestor(block *common.L1Block) (*common.L1Block, bool) {
+	for i := uint64(0); i < m.confirmationDepth; i++ {
+		parent, err := m.storage.FetchBlock(block.ParentHash())
+		if err != nil {
+			return block, false
+		}
+		block = parent
+	}
+	return block, true
+}
+
+// ExecuteValueTransfers - locks the value deposited on the L1 in the L2 message bus. It is released to the receivers by
+// the synthetic transactions created for the transfers, so the deposits go through the same path as any other
+// bus call and every enclave reaches the same state.
 func (m *MessageBusManager) ExecuteValueTransfers(transfers common.ValueTransferEvents, rollupState *state.StateDB) {
 	for _, transfer := range transfers {
-		rollupState.AddBalance(transfer.Receiver, transfer.Amount)
+		rollupState.AddBalance(*m.messageBusAddress, transfer.Amount)
 	}
 }
 
-// CreateSyntheticTransactions - generates transactions that the enclave should execute internally for the messages.
-func (m *MessageBusManager) CreateSyntheticTransactions(messages common.CrossChainMessages, rollupState *state.StateDB) common.L2Transactions {
+// BurnOutboundTransfers - removes the value sent to the L1 from the L2 message bus. The value is released on the L1 by
+// the withdrawal messages.
+func (m *MessageBusManager) BurnOutboundTransfers(transfers common.ValueTransferEvents, rollupState *state.StateDB) {
+	for _, transfer := range transfers {
+		rollupState.SubBalance(*m.messageBusAddress, transfer.Amount)
+	}
+}
+
+// CreateWithdrawalMessages - converts the outbound value transfers of a batch to messages for the L1. The sequence is the
+// batch sequence number and the nonce is the position of the transfer in the batch, which makes each message unique.
+func (m *MessageBusManager) CreateWithdrawalMessages(transfers common.ValueTransferEvents, batchSeqNo uint64) (common.CrossChainMessages, error) {
+	messages := make(common.CrossChainMessages, 0, len(transfers))
+	for idx, transfer := range transfers {
+		payload, err := valueTransferArguments.Pack(transfer.Amount, transfer.Receiver)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode value transfer. Cause: %w", err)
+		}
+		messages = append(messages, common.CrossChainMessage{
+			Sender:           *m.messageBusAddress,
+			Sequence:         batchSeqNo,
+			Nonce:            uint32(idx),
+			Topic:            ValueTransferTopic,
+			Payload:          payload,
+			ConsistencyLevel: 0,
+		})
+	}
+	return messages, nil
+}
+
+// CreateSyntheticTransactions - generates transactions that the enclave should execute internally for the messages
+// and for the value transfers.
+func (m *MessageBusManager) CreateSyntheticTransactions(messages common.CrossChainMessages, transfers common.ValueTransferEvents, rollupState *state.StateDB) common.L2Transactions {
 	// Get current nonce for this stateDB.
 	// There can be forks thus we cannot trust the wallet.
-	startingNonce := rollupState.GetNonce(m.GetOwner())
+	nonce := rollupState.GetNonce(m.GetOwner())
 
-	signedTransactions := make(types.Transactions, 0)
-	for idx, message := range messages {
+	signedTransactions := make(types.Transactions, 0, len(messages)+len(transfers))
+	for _, message := range messages {
 		delayInBlocks := big.NewInt(int64(message.ConsistencyLevel))
 		data, err := MessageBusABI.Pack("storeCrossChainMessage", message, delayInBlocks)
 		if err != nil {
@@ -216,22 +284,36 @@ func (m *MessageBusManager) CreateSyntheticTransactions(messages common.CrossCha
 			// todo (@stefan) - return error
 			// return nil, fmt.Errorf("failed packing submitOutOfNetworkMessage %w", err)
 		}
+		signedTransactions = append(signedTransactions, m.signSyntheticTransaction(nonce, data))
+		nonce++
+	}
 
-		tx := &types.LegacyTx{
-			Nonce:    startingNonce + uint64(idx),
-			Value:    gethcommon.Big0,
-			Gas:      5_000_000,
-			GasPrice: gethcommon.Big0, // Synthetic transactions are on the house. Or the house.
-			Data:     data,
-			To:       m.messageBusAddress,
-		}
-
-		stx, err := m.wallet.SignTransaction(tx)
+	for _, transfer := range transfers {
+		data, err := MessageBusABI.Pack("receiveValueFromL2", transfer.Receiver, transfer.Amount)
 		if err != nil {
-			panic(err)
+			m.logger.Crit("Failed packing value transfer!", log.ErrKey, err)
+			return signedTransactions
 		}
-		signedTransactions = append(signedTransactions, stx)
+		signedTransactions = append(signedTransactions, m.signSyntheticTransaction(nonce, data))
+		nonce++
 	}
 
 	return signedTransactions
 }
+
+func (m *MessageBusManager) signSyntheticTransaction(nonce uint64, data []byte) *common.L2Tx {
+	tx := &types.LegacyTx{
+		Nonce:    nonce,
+		Value:    gethcommon.Big0,
+		Gas:      common.SyntheticTxGasLimit,
+		GasPrice: gethcommon.Big0, // Synthetic transactions are on the house. Or the house.
+		Data:     data,
+		To:       m.messageBusAddress,
+	}
+
+	stx, err := m.wallet.SignTransaction(tx)
+	if err != nil {
+		panic(err)
+	}
+	return stx
+}
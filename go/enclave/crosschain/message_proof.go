@@ -0,0 +1,70 @@
+package crosschain
+
+import (
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+)
+
+// storeMessageTree - persists the tree of the outbound messages of an executed batch, so that proofs can be served
+// without rebuilding it from the batch
+func (c *Processors) storeMessageTree(batch *core.Batch) error {
+	messages := batch.Header.CrossChainMessages
+	tree, err := NewMessageTreeFromMessages(messages)
+	if err != nil {
+		return err
+	}
+	senders := make([]gethcommon.Address, len(messages))
+	for i, msg := range messages {
+		senders[i] = msg.Sender
+	}
+
+	if err := c.storage.StoreMessageTree(batch.SeqNo().Uint64(), tree.Root(), tree.Leaves(), senders); err != nil {
+		return fmt.Errorf("could not store the message tree of batch %s. Cause: %w", batch.Hash(), err)
+	}
+	c.logger.Trace("Stored message tree", log.BatchHashKey, batch.Hash(), "root", tree.Root(), "size", len(messages))
+	return nil
+}
+
+// GetMessageProof - returns the proof of inclusion of the outbound message, and the sender of the message.
+// Messages of batches which were not yet published in a rollup are reported as pending.
+// Returns errutil.ErrNotFound for messages not emitted by a canonical batch.
+func (c *Processors) GetMessageProof(messageHash gethcommon.Hash) (*common.CrossChainMessageProof, gethcommon.Address, error) {
+	entry, err := c.storage.FetchMessageTree(messageHash)
+	if err != nil {
+		return nil, gethcommon.Address{}, err
+	}
+	proof, err := NewMessageTree(entry.Leaves).Proof(entry.Index)
+	if err != nil {
+		return nil, gethcommon.Address{}, fmt.Errorf("corrupt message tree for batch %d. Cause: %w", entry.BatchSeqNo, err)
+	}
+	batch, err := c.storage.FetchBatchBySeqNo(entry.BatchSeqNo)
+	if err != nil {
+		return nil, gethcommon.Address{}, fmt.Errorf("could not fetch batch %d. Cause: %w", entry.BatchSeqNo, err)
+	}
+
+	result := &common.CrossChainMessageProof{
+		Status:      common.CrossChainProofPending,
+		MessageHash: messageHash,
+		Proof:       proof,
+		Root:        entry.Root,
+		BatchHash:   batch.Hash(),
+		BatchHeight: batch.Number(),
+	}
+
+	rollup, err := c.storage.FetchRollupForBatch(entry.BatchSeqNo)
+	switch {
+	case err == nil:
+		rollupHash := rollup.Hash()
+		result.Status = common.CrossChainProofPublished
+		result.RollupHash = &rollupHash
+	case !errors.Is(err, errutil.ErrNotFound):
+		return nil, gethcommon.Address{}, fmt.Errorf("could not fetch the rollup of batch %d. Cause: %w", entry.BatchSeqNo, err)
+	}
+	return result, entry.Sender, nil
+}
@@ -0,0 +1,141 @@
+package crosschain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+)
+
+// the order in which a message moves through its lifecycle
+var messageStateOrder = map[common.CrossChainMessageState]int{
+	common.MessageUnknown:           0,
+	common.MessagePending:           1,
+	common.MessageIncludedInBatch:   2,
+	common.MessagePublishedInRollup: 3,
+	common.MessageConsumedOnL1:      4,
+}
+
+// OnBatchCommitted - records the messages of a batch whose execution was committed: the tree of the outbound messages,
+// and the inclusion of both the outbound messages and the inbound messages it consumed
+func (c *Processors) OnBatchCommitted(batch *core.Batch, inboundMessages common.CrossChainMessages) error {
+	if err := c.storeMessageTree(batch); err != nil {
+		return err
+	}
+
+	seqNo := batch.SeqNo().Uint64()
+	transitions := make([]*core.MessageTransition, 0, len(inboundMessages)+len(batch.Header.CrossChainMessages))
+	for _, msg := range inboundMessages {
+		t, err := newTransition(msg, true, common.MessageIncludedInBatch)
+		if err != nil {
+			return err
+		}
+		t.BatchSeqNo = &seqNo
+		transitions = append(transitions, t)
+	}
+	for _, msg := range batch.Header.CrossChainMessages {
+		t, err := newTransition(msg, false, common.MessageIncludedInBatch)
+		if err != nil {
+			return err
+		}
+		t.BatchSeqNo = &seqNo
+		transitions = append(transitions, t)
+	}
+	if len(transitions) == 0 {
+		return nil
+	}
+	return c.storage.StoreMessageTransitions(transitions)
+}
+
+// GetMessageStatus - returns the furthest state reached by the message on the canonical chains. For L2->L1 messages,
+// the sender is returned as well, because their status must only be revealed to the sender.
+func (c *Processors) GetMessageStatus(messageHash gethcommon.Hash) (*common.CrossChainMessageStatus, *gethcommon.Address, error) {
+	transitions, err := c.storage.FetchMessageTransitions(messageHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := &common.CrossChainMessageStatus{MessageHash: messageHash, State: common.MessageUnknown}
+	var includedIn *uint64
+	var publishedAt *big.Int
+	for _, t := range transitions {
+		status.Inbound = t.Inbound
+		if t.State == common.MessageIncludedInBatch && t.BatchSeqNo != nil {
+			includedIn = t.BatchSeqNo
+			status.BatchHash = t.BatchHash
+			status.BatchHeight = t.BatchHeight
+		}
+		// the inbound messages are published on the L1, the outbound ones are consumed there
+		if t.L1Block != nil {
+			status.L1BlockHash = t.L1Block
+			publishedAt = t.L1BlockHeight
+		}
+		if messageStateOrder[t.State] > messageStateOrder[status.State] {
+			status.State = t.State
+		}
+	}
+	if status.State == common.MessageUnknown {
+		return status, nil, nil
+	}
+	if status.Inbound {
+		if status.State == common.MessagePending && publishedAt != nil {
+			remaining, err := c.confirmationsRemaining(publishedAt)
+			if err != nil {
+				return nil, nil, err
+			}
+			status.ConfirmationsRemaining = &remaining
+		}
+		return status, nil, nil
+	}
+
+	// the outbound message was emitted by a canonical batch, which might have been published since
+	if includedIn != nil {
+		rollup, err := c.storage.FetchRollupForBatch(*includedIn)
+		switch {
+		case err == nil:
+			rollupHash := rollup.Hash()
+			status.RollupHash = &rollupHash
+			if messageStateOrder[common.MessagePublishedInRollup] > messageStateOrder[status.State] {
+				status.State = common.MessagePublishedInRollup
+			}
+		case !errors.Is(err, errutil.ErrNotFound):
+			return nil, nil, fmt.Errorf("could not fetch the rollup of batch %d. Cause: %w", *includedIn, err)
+		}
+	}
+
+	entry, err := c.storage.FetchMessageTree(messageHash)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			// consumed on the L1, but the batch that emitted it is no longer canonical
+			return status, nil, nil
+		}
+		return nil, nil, err
+	}
+	return status, &entry.Sender, nil
+}
+
+// confirmationsRemaining - the number of L1 blocks to be processed on top of the head before a message published at
+// the given height is eligible for a batch
+func (c *Processors) confirmationsRemaining(publishedAt *big.Int) (uint64, error) {
+	head, err := c.storage.FetchHeadBlock()
+	if err != nil {
+		return 0, fmt.Errorf("could not fetch the L1 head. Cause: %w", err)
+	}
+	eligibleAt := publishedAt.Uint64() + c.confirmationDepth
+	if head.NumberU64() >= eligibleAt {
+		return 0, nil
+	}
+	return eligibleAt - head.NumberU64(), nil
+}
+
+func newTransition(msg common.CrossChainMessage, inbound bool, state common.CrossChainMessageState) (*core.MessageTransition, error) {
+	hash, err := MessageHash(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &core.MessageTransition{MessageHash: hash, Inbound: inbound, State: state}, nil
+}
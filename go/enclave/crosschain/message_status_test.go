@@ -0,0 +1,138 @@
+package crosschain
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+// lifecycleStorage - serves the message transitions and trees from memory
+type lifecycleStorage struct {
+	storage.Storage
+	transitions map[gethcommon.Hash][]*core.MessageTransition
+	trees       map[gethcommon.Hash]*storage.MessageTreeEntry
+	rollups     map[uint64]*common.RollupHeader
+	head        *types.Block
+}
+
+func (s *lifecycleStorage) FetchHeadBlock() (*types.Block, error) {
+	return s.head, nil
+}
+
+func (s *lifecycleStorage) FetchMessageTransitions(hash gethcommon.Hash) ([]*core.MessageTransition, error) {
+	return s.transitions[hash], nil
+}
+
+func (s *lifecycleStorage) FetchMessageTree(hash gethcommon.Hash) (*storage.MessageTreeEntry, error) {
+	if entry, found := s.trees[hash]; found {
+		return entry, nil
+	}
+	return nil, errutil.ErrNotFound
+}
+
+func (s *lifecycleStorage) FetchRollupForBatch(seqNo uint64) (*common.RollupHeader, error) {
+	if rollup, found := s.rollups[seqNo]; found {
+		return rollup, nil
+	}
+	return nil, errutil.ErrNotFound
+}
+
+func TestMessageStatus(t *testing.T) {
+	sender := gethcommon.HexToAddress("0xabcd")
+	inbound, outbound, unknown := gethcommon.Hash{1}, gethcommon.Hash{2}, gethcommon.Hash{3}
+	seqNo := uint64(5)
+	block := gethcommon.Hash{9}
+
+	s := &lifecycleStorage{
+		transitions: map[gethcommon.Hash][]*core.MessageTransition{
+			inbound: {
+				{MessageHash: inbound, Inbound: true, State: common.MessageIncludedInBatch, BatchSeqNo: &seqNo, BatchHeight: big.NewInt(3)},
+				{MessageHash: inbound, Inbound: true, State: common.MessagePending, L1Block: &block},
+			},
+			outbound: {
+				{MessageHash: outbound, State: common.MessageIncludedInBatch, BatchSeqNo: &seqNo, BatchHeight: big.NewInt(3)},
+			},
+		},
+		trees:   map[gethcommon.Hash]*storage.MessageTreeEntry{outbound: {BatchSeqNo: seqNo, Sender: sender}},
+		rollups: map[uint64]*common.RollupHeader{},
+	}
+	processors := &Processors{storage: s}
+
+	status, _, err := processors.GetMessageStatus(inbound)
+	if err != nil || status.State != common.MessageIncludedInBatch || !status.Inbound || *status.L1BlockHash != block {
+		t.Fatalf("unexpected inbound status %+v, err %v", status, err)
+	}
+
+	status, msgSender, err := processors.GetMessageStatus(outbound)
+	if err != nil || status.State != common.MessageIncludedInBatch || *msgSender != sender {
+		t.Fatalf("unexpected outbound status %+v, err %v", status, err)
+	}
+
+	// once the batch is published, the message is reported as part of the rollup
+	s.rollups[seqNo] = &common.RollupHeader{LastBatchSeqNo: seqNo}
+	status, _, err = processors.GetMessageStatus(outbound)
+	if err != nil || status.State != common.MessagePublishedInRollup || status.RollupHash == nil {
+		t.Fatalf("unexpected outbound status %+v, err %v", status, err)
+	}
+
+	// relaying the message on the L1 is the final state
+	s.transitions[outbound] = append(s.transitions[outbound], &core.MessageTransition{MessageHash: outbound, State: common.MessageConsumedOnL1, L1Block: &block})
+	status, _, err = processors.GetMessageStatus(outbound)
+	if err != nil || status.State != common.MessageConsumedOnL1 || *status.L1BlockHash != block {
+		t.Fatalf("unexpected outbound status %+v, err %v", status, err)
+	}
+
+	status, msgSender, err = processors.GetMessageStatus(unknown)
+	if err != nil || status.State != common.MessageUnknown || msgSender != nil {
+		t.Fatalf("unexpected status for an unknown message %+v, err %v", status, err)
+	}
+}
+
+func TestConfirmationsRemaining(t *testing.T) {
+	pending := gethcommon.Hash{1}
+	block := gethcommon.Hash{9}
+	s := &lifecycleStorage{
+		transitions: map[gethcommon.Hash][]*core.MessageTransition{
+			pending: {{MessageHash: pending, Inbound: true, State: common.MessagePending, L1Block: &block, L1BlockHeight: big.NewInt(10)}},
+		},
+		head: types.NewBlockWithHeader(&types.Header{Number: big.NewInt(12)}),
+	}
+	processors := &Processors{storage: s, confirmationDepth: 5}
+
+	status, _, err := processors.GetMessageStatus(pending)
+	if err != nil || status.ConfirmationsRemaining == nil || *status.ConfirmationsRemaining != 3 {
+		t.Fatalf("expected 3 confirmations remaining, got %+v, err %v", status, err)
+	}
+
+	s.head = types.NewBlockWithHeader(&types.Header{Number: big.NewInt(20)})
+	status, _, err = processors.GetMessageStatus(pending)
+	if err != nil || *status.ConfirmationsRemaining != 0 {
+		t.Fatalf("expected the message to be confirmed, got %+v, err %v", status, err)
+	}
+}
+
+func TestDecodeRelayedMessage(t *testing.T) {
+	msg := common.CrossChainMessage{Sender: gethcommon.HexToAddress("0x1234"), Sequence: 3, Payload: []byte{1, 2, 3}}
+	calldata, err := MessengerABI.Pack("relayMessage", msg)
+	if err != nil {
+		t.Fatalf("could not pack calldata. Cause: %s", err)
+	}
+
+	decoded, found, err := decodeRelayedMessage(calldata)
+	if err != nil || !found {
+		t.Fatalf("expected the relayed message to be decoded, found %t, err %v", found, err)
+	}
+	if decoded.Sender != msg.Sender || decoded.Sequence != msg.Sequence || string(decoded.Payload) != string(msg.Payload) {
+		t.Fatalf("unexpected decoded message %+v", decoded)
+	}
+
+	if _, found, _ := decodeRelayedMessage([]byte{1, 2, 3, 4, 5}); found {
+		t.Fatal("expected other calls to be ignored")
+	}
+}
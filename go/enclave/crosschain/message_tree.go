@@ -0,0 +1,121 @@
+package crosschain
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+// messageArguments - a single tuple, so the encoding matches `abi.encode(crossChainMessage)` in the L1 message bus
+var messageArguments = func() abi.Arguments {
+	messageType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "sender", Type: "address"},
+		{Name: "sequence", Type: "uint64"},
+		{Name: "nonce", Type: "uint32"},
+		{Name: "topic", Type: "uint32"},
+		{Name: "payload", Type: "bytes"},
+		{Name: "consistencyLevel", Type: "uint8"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return abi.Arguments{{Type: messageType}}
+}()
+
+// MessageHash - the leaf of the message tree. It is the keccak256 of the abi encoded message, the same hash the L1
+// message bus computes, so it can be recomputed when verifying a proof.
+func MessageHash(msg common.CrossChainMessage) (gethcommon.Hash, error) {
+	encoded, err := messageArguments.Pack(msg)
+	if err != nil {
+		return gethcommon.Hash{}, fmt.Errorf("could not encode cross chain message. Cause: %w", err)
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// MessageTree - a merkle tree over the outbound messages of a batch.
+// Pairs of nodes are sorted before hashing, so a proof is just the list of siblings, and an odd node is carried to the
+// next level unchanged.
+type MessageTree struct {
+	levels [][]gethcommon.Hash // levels[0] are the leaves, the last level is the root
+}
+
+// NewMessageTree - builds the tree of the leaves, in the order in which the messages were emitted
+func NewMessageTree(leaves []gethcommon.Hash) *MessageTree {
+	levels := [][]gethcommon.Hash{leaves}
+	for current := leaves; len(current) > 1; {
+		next := make([]gethcommon.Hash, 0, (len(current)+1)/2)
+		for i := 0; i < len(current); i += 2 {
+			if i+1 == len(current) {
+				next = append(next, current[i])
+				continue
+			}
+			next = append(next, hashPair(current[i], current[i+1]))
+		}
+		levels = append(levels, next)
+		current = next
+	}
+	return &MessageTree{levels: levels}
+}
+
+// NewMessageTreeFromMessages - builds the tree of the messages emitted by a batch
+func NewMessageTreeFromMessages(messages common.CrossChainMessages) (*MessageTree, error) {
+	leaves := make([]gethcommon.Hash, len(messages))
+	for i, msg := range messages {
+		leaf, err := MessageHash(msg)
+		if err != nil {
+			return nil, err
+		}
+		leaves[i] = leaf
+	}
+	return NewMessageTree(leaves), nil
+}
+
+// Leaves - the message hashes
+func (t *MessageTree) Leaves() []gethcommon.Hash {
+	return t.levels[0]
+}
+
+// Root - the root of the tree. The empty hash for a batch without messages.
+func (t *MessageTree) Root() gethcommon.Hash {
+	top := t.levels[len(t.levels)-1]
+	if len(top) == 0 {
+		return gethcommon.Hash{}
+	}
+	return top[0]
+}
+
+// Proof - the siblings on the path from the leaf at the index to the root
+func (t *MessageTree) Proof(index int) ([]gethcommon.Hash, error) {
+	if index < 0 || index >= len(t.levels[0]) {
+		return nil, fmt.Errorf("leaf %d out of range", index)
+	}
+	proof := make([]gethcommon.Hash, 0, len(t.levels)-1)
+	for _, level := range t.levels[:len(t.levels)-1] {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		index /= 2
+	}
+	return proof, nil
+}
+
+// VerifyMessageProof - checks that the leaf is part of the tree with the given root
+func VerifyMessageProof(leaf gethcommon.Hash, proof []gethcommon.Hash, root gethcommon.Hash) bool {
+	computed := leaf
+	for _, sibling := range proof {
+		computed = hashPair(computed, sibling)
+	}
+	return computed == root
+}
+
+func hashPair(a, b gethcommon.Hash) gethcommon.Hash {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256Hash(a[:], b[:])
+}
@@ -0,0 +1,71 @@
+package crosschain
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+func TestMessageTreeProofs(t *testing.T) {
+	for size := 1; size <= 9; size++ {
+		messages := make(common.CrossChainMessages, size)
+		for i := range messages {
+			messages[i] = common.CrossChainMessage{Sender: gethcommon.BigToAddress(gethcommon.Big1), Sequence: uint64(i), Payload: []byte{byte(i)}}
+		}
+		tree, err := NewMessageTreeFromMessages(messages)
+		if err != nil {
+			t.Fatalf("could not build tree. Cause: %s", err)
+		}
+
+		for i, leaf := range tree.Leaves() {
+			proof, err := tree.Proof(i)
+			if err != nil {
+				t.Fatalf("could not build proof. Cause: %s", err)
+			}
+			if !VerifyMessageProof(leaf, proof, tree.Root()) {
+				t.Fatalf("proof of leaf %d in a tree of %d messages does not verify", i, size)
+			}
+			if size > 1 && VerifyMessageProof(tree.Leaves()[(i+1)%size], proof, tree.Root()) {
+				t.Fatalf("proof of leaf %d verifies a different leaf", i)
+			}
+		}
+	}
+}
+
+func TestEmptyMessageTree(t *testing.T) {
+	tree := NewMessageTree(nil)
+	if tree.Root() != (gethcommon.Hash{}) {
+		t.Fatalf("expected the empty root, got %s", tree.Root())
+	}
+	if _, err := tree.Proof(0); err == nil {
+		t.Fatal("expected an error for a proof in an empty tree")
+	}
+}
+
+func TestMessageHashMatchesAbiEncode(t *testing.T) {
+	msg := common.CrossChainMessage{Sender: gethcommon.HexToAddress("0x1234"), Sequence: 7, Nonce: 1, Topic: 2, Payload: []byte{0xca, 0xfe}, ConsistencyLevel: 3}
+	hash, err := MessageHash(msg)
+	if err != nil {
+		t.Fatalf("could not hash message. Cause: %s", err)
+	}
+
+	// abi.encode of a struct with a dynamic member starts with the offset of the struct
+	word := func(v uint64) []byte { return gethcommon.BigToHash(new(big.Int).SetUint64(v)).Bytes() }
+	var encoded []byte
+	encoded = append(encoded, word(0x20)...)
+	encoded = append(encoded, gethcommon.BytesToHash(msg.Sender.Bytes()).Bytes()...)
+	encoded = append(encoded, word(7)...)
+	encoded = append(encoded, word(1)...)
+	encoded = append(encoded, word(2)...)
+	encoded = append(encoded, word(6*32)...) // offset of the payload within the struct
+	encoded = append(encoded, word(3)...)
+	encoded = append(encoded, word(2)...) // payload length
+	encoded = append(encoded, gethcommon.RightPadBytes(msg.Payload, 32)...)
+
+	if expected := crypto.Keccak256Hash(encoded); hash != expected {
+		t.Fatalf("expected %s, got %s", expected, hash)
+	}
+}
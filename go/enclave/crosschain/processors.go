@@ -16,17 +16,25 @@ import (
 type Processors struct {
 	Local  Manager
 	Remote BlockMessageExtractor
+
+	// the number of L1 blocks that must be built on top of the block of an inbound message before it can be included
+	confirmationDepth uint64
+
+	storage storage.Storage
+	logger  gethlog.Logger
 }
 
 func New(
 	l1BusAddress *gethcommon.Address,
+	l1MessengerAddress *gethcommon.Address,
 	storage storage.Storage,
 	chainID *big.Int,
+	confirmationDepth uint64,
 	logger gethlog.Logger,
 ) *Processors {
-	processors := Processors{}
-	processors.Local = NewObscuroMessageBusManager(storage, chainID, logger)
-	processors.Remote = NewBlockMessageExtractor(l1BusAddress, storage, logger)
+	processors := Processors{confirmationDepth: confirmationDepth, storage: storage, logger: logger}
+	processors.Local = NewObscuroMessageBusManager(storage, chainID, confirmationDepth, logger)
+	processors.Remote = NewBlockMessageExtractor(l1BusAddress, l1MessengerAddress, storage, logger)
 	return &processors
 }
 
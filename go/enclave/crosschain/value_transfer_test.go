@@ -0,0 +1,181 @@
+package crosschain
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+// transferStorage - serves the L1 blocks and the value transfers stored for them from memory
+type transferStorage struct {
+	storage.Storage
+	blocks    map[common.L1BlockHash]*types.Block
+	transfers map[common.L1BlockHash]common.ValueTransferEvents
+}
+
+func (s *transferStorage) FetchBlock(hash common.L1BlockHash) (*types.Block, error) {
+	if b, found := s.blocks[hash]; found {
+		return b, nil
+	}
+	return nil, errutil.ErrNotFound
+}
+
+func (s *transferStorage) IsAncestor(block *types.Block, maybeAncestor *types.Block) bool {
+	for b := block; b != nil; b = s.blocks[b.ParentHash()] {
+		if b.Hash() == maybeAncestor.Hash() {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *transferStorage) GetL1Messages(common.L1BlockHash) (common.CrossChainMessages, error) {
+	return common.CrossChainMessages{}, nil
+}
+
+func (s *transferStorage) GetL1Transfers(hash common.L1BlockHash) (common.ValueTransferEvents, error) {
+	return s.transfers[hash], nil
+}
+
+func (s *transferStorage) addBlock(parent *types.Block, extra byte, transfers common.ValueTransferEvents) *types.Block {
+	header := &types.Header{Number: big.NewInt(0), Extra: []byte{extra}}
+	if parent != nil {
+		header.ParentHash = parent.Hash()
+		header.Number = new(big.Int).Add(parent.Number(), big.NewInt(1))
+	}
+	b := types.NewBlockWithHeader(header)
+	s.blocks[b.Hash()] = b
+	s.transfers[b.Hash()] = transfers
+	return b
+}
+
+func newTestManager(s storage.Storage, confirmationDepth uint64) *MessageBusManager {
+	return NewObscuroMessageBusManager(s, big.NewInt(443), confirmationDepth, gethlog.New()).(*MessageBusManager)
+}
+
+func TestReorgedDepositIsNotBridged(t *testing.T) {
+	s := &transferStorage{blocks: map[common.L1BlockHash]*types.Block{}, transfers: map[common.L1BlockHash]common.ValueTransferEvents{}}
+	reorged := common.ValueTransferEvent{Receiver: gethcommon.HexToAddress("0x1"), Amount: big.NewInt(100)}
+	canonical := common.ValueTransferEvent{Receiver: gethcommon.HexToAddress("0x2"), Amount: big.NewInt(200)}
+
+	// the deposit of the fork block is processed, but the next batch is produced on the other fork
+	genesis := s.addBlock(nil, 0, nil)
+	s.addBlock(genesis, 1, common.ValueTransferEvents{reorged})
+	head := s.addBlock(s.addBlock(genesis, 2, nil), 3, common.ValueTransferEvents{canonical})
+
+	_, transfers := newTestManager(s, 0).RetrieveInboundMessages(genesis, head, nil, true)
+	if len(transfers) != 1 || transfers[0].Receiver != canonical.Receiver {
+		t.Fatalf("expected only the canonical deposit, got %+v", transfers)
+	}
+}
+
+func TestDepositsWaitForConfirmations(t *testing.T) {
+	s := &transferStorage{blocks: map[common.L1BlockHash]*types.Block{}, transfers: map[common.L1BlockHash]common.ValueTransferEvents{}}
+	deposit := common.ValueTransferEvent{Receiver: gethcommon.HexToAddress("0x1"), Amount: big.NewInt(100)}
+	reorged := common.ValueTransferEvent{Receiver: gethcommon.HexToAddress("0x2"), Amount: big.NewInt(200)}
+
+	genesis := s.addBlock(nil, 0, nil)
+	b1 := s.addBlock(genesis, 1, common.ValueTransferEvents{deposit})
+	// a deposit which is reorged out while it waits for the confirmations
+	s.addBlock(b1, 2, common.ValueTransferEvents{reorged})
+	b2 := s.addBlock(b1, 3, nil)
+	b3 := s.addBlock(b2, 4, nil)
+	m := newTestManager(s, 2)
+
+	if _, transfers := m.RetrieveInboundMessages(genesis, b2, nil, true); len(transfers) != 0 {
+		t.Fatalf("expected the deposit to wait for its confirmations, got %+v", transfers)
+	}
+	_, transfers := m.RetrieveInboundMessages(b2, b3, nil, true)
+	if len(transfers) != 1 || transfers[0].Receiver != deposit.Receiver {
+		t.Fatalf("expected only the confirmed deposit, got %+v", transfers)
+	}
+	if _, transfers := m.RetrieveInboundMessages(b3, s.addBlock(b3, 5, nil), nil, true); len(transfers) != 0 {
+		t.Fatalf("expected the reorged deposit to be dropped, got %+v", transfers)
+	}
+}
+
+func TestDepositsOrderBeforeTheBudgetActivation(t *testing.T) {
+	s := &transferStorage{blocks: map[common.L1BlockHash]*types.Block{}, transfers: map[common.L1BlockHash]common.ValueTransferEvents{}}
+	older := common.ValueTransferEvent{Receiver: gethcommon.HexToAddress("0x1"), Amount: big.NewInt(100)}
+	newer := common.ValueTransferEvent{Receiver: gethcommon.HexToAddress("0x2"), Amount: big.NewInt(200)}
+
+	genesis := s.addBlock(nil, 0, nil)
+	head := s.addBlock(s.addBlock(genesis, 1, common.ValueTransferEvents{older}), 2, common.ValueTransferEvents{newer})
+	m := newTestManager(s, 0)
+
+	_, transfers := m.RetrieveInboundMessages(genesis, head, nil, true)
+	if len(transfers) != 2 || transfers[0].Receiver != older.Receiver {
+		t.Fatalf("expected the deposits oldest first, got %+v", transfers)
+	}
+	// the batches before the activation included them newest block first, which their replay must reproduce
+	_, transfers = m.RetrieveInboundMessages(genesis, head, nil, false)
+	if len(transfers) != 2 || transfers[0].Receiver != newer.Receiver {
+		t.Fatalf("expected the deposits newest first, got %+v", transfers)
+	}
+}
+
+func TestDepositsAndWithdrawals(t *testing.T) {
+	m := newTestManager(nil, 0)
+	stateDB, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateDB.SetNonce(m.GetOwner(), 7)
+
+	messages := common.CrossChainMessages{{Sender: gethcommon.HexToAddress("0x3"), Payload: []byte{}}}
+	deposits := common.ValueTransferEvents{
+		{Receiver: gethcommon.HexToAddress("0x1"), Amount: big.NewInt(100)},
+		{Receiver: gethcommon.HexToAddress("0x2"), Amount: big.NewInt(200)},
+	}
+
+	txs := m.CreateSyntheticTransactions(messages, deposits, stateDB)
+	if len(txs) != 3 {
+		t.Fatalf("expected 3 synthetic transactions, got %d", len(txs))
+	}
+	for i, tx := range txs {
+		if tx.Nonce() != 7+uint64(i) || *tx.To() != *m.GetBusAddress() {
+			t.Errorf("unexpected synthetic transaction %d: nonce %d to %s", i, tx.Nonce(), tx.To())
+		}
+	}
+	args, err := MessageBusABI.Methods["receiveValueFromL2"].Inputs.Unpack(txs[2].Data()[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if args[0].(gethcommon.Address) != deposits[1].Receiver || args[1].(*big.Int).Cmp(deposits[1].Amount) != 0 {
+		t.Errorf("deposit transaction does not credit the receiver: %v", args)
+	}
+
+	m.ExecuteValueTransfers(deposits, stateDB)
+	if balance := stateDB.GetBalance(*m.GetBusAddress()); balance.Cmp(big.NewInt(300)) != 0 {
+		t.Errorf("expected the deposits to be locked in the bus, got %s", balance)
+	}
+
+	withdrawals := common.ValueTransferEvents{{Sender: gethcommon.HexToAddress("0x1"), Receiver: gethcommon.HexToAddress("0x4"), Amount: big.NewInt(50)}}
+	m.BurnOutboundTransfers(withdrawals, stateDB)
+	if balance := stateDB.GetBalance(*m.GetBusAddress()); balance.Cmp(big.NewInt(250)) != 0 {
+		t.Errorf("expected the withdrawal to be burned, got %s", balance)
+	}
+
+	withdrawalMessages, err := m.CreateWithdrawalMessages(withdrawals, 11)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(withdrawalMessages) != 1 || withdrawalMessages[0].Topic != ValueTransferTopic || withdrawalMessages[0].Sequence != 11 {
+		t.Fatalf("unexpected withdrawal messages %+v", withdrawalMessages)
+	}
+	payload, err := valueTransferArguments.Unpack(withdrawalMessages[0].Payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payload[0].(*big.Int).Cmp(big.NewInt(50)) != 0 || payload[1].(gethcommon.Address) != withdrawals[0].Receiver {
+		t.Errorf("withdrawal message does not carry the amount: %v", payload)
+	}
+}
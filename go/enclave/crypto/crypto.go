@@ -75,6 +75,23 @@ func EncryptSecret(pubKeyEncoded []byte, secret SharedEnclaveSecret, logger geth
 	return encKey, err
 }
 
+// EncryptForEnclave - encrypts the data with the compressed public key of an attested enclave
+func EncryptForEnclave(pubKeyEncoded []byte, data []byte) ([]byte, error) {
+	key, err := crypto.DecompressPubkey(pubKeyEncoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key %w", err)
+	}
+	return encryptWithPublicKey(data, key)
+}
+
+// DecryptWithEnclaveKey - decrypts the data encrypted with the public key of the enclave
+func DecryptWithEnclaveKey(ciphertext []byte, privateKey *ecdsa.PrivateKey) ([]byte, error) {
+	if privateKey == nil {
+		return nil, errors.New("private key not found - shouldn't happen")
+	}
+	return decryptWithPrivateKey(ciphertext, privateKey)
+}
+
 // Encrypts data with public key
 func encryptWithPublicKey(msg []byte, pub *ecdsa.PublicKey) ([]byte, error) {
 	ciphertext, err := ecies.Encrypt(rand.Reader, ecies.ImportECDSAPublic(pub), msg, nil, nil)
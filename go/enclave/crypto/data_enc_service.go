@@ -11,6 +11,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/log"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 const (
@@ -33,14 +34,23 @@ type dataEncryptionServiceImpl struct {
 }
 
 func NewDataEncryptionService(logger gethlog.Logger) DataEncryptionService {
-	key := gethcommon.Hex2Bytes(RollupEncryptionKeyHex)
+	return newDataEncryptionService(gethcommon.Hex2Bytes(RollupEncryptionKeyHex), logger)
+}
+
+// NewSecretDataEncryptionService - encrypts data that only the enclaves of the network can read, with a key derived
+// from the shared secret and the purpose of the data.
+func NewSecretDataEncryptionService(secret *SharedEnclaveSecret, purpose string, logger gethlog.Logger) DataEncryptionService {
+	return newDataEncryptionService(crypto.Keccak256(secret[:], []byte(purpose)), logger)
+}
+
+func newDataEncryptionService(key []byte, logger gethlog.Logger) DataEncryptionService {
 	block, err := aes.NewCipher(key)
 	if err != nil {
-		logger.Crit("could not initialise AES cipher for enclave rollup key.", log.ErrKey, err)
+		logger.Crit("could not initialise AES cipher for enclave data key.", log.ErrKey, err)
 	}
 	cipher, err := cipher.NewGCM(block)
 	if err != nil {
-		logger.Crit("could not initialise wrapper for AES cipher for enclave rollup key. ", log.ErrKey, err)
+		logger.Crit("could not initialise wrapper for AES cipher for enclave data key. ", log.ErrKey, err)
 	}
 	return dataEncryptionServiceImpl{
 		cipher: cipher,
@@ -4,7 +4,6 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 
-	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ten-protocol/go-ten/go/common"
 )
@@ -19,11 +18,10 @@ func GenerateEnclaveKey() (*EnclaveKey, error) {
 
 func NewEnclaveKey(privKey *ecdsa.PrivateKey) *EnclaveKey {
 	pubKey := gethcrypto.CompressPubkey(&privKey.PublicKey)
-	enclaveID := common.EnclaveID(pubKey[gethcommon.HashLength-gethcommon.AddressLength:])
 	return &EnclaveKey{
 		privateKey:     privKey,
 		publicKeyBytes: pubKey,
-		enclaveID:      enclaveID,
+		enclaveID:      common.EnclaveIDFromPublicKey(pubKey),
 	}
 }
 
@@ -49,3 +47,20 @@ func (k *EnclaveKey) EnclaveID() common.EnclaveID {
 func (k *EnclaveKey) PublicKeyBytes() []byte {
 	return k.publicKeyBytes
 }
+
+// ProveID - signs the nonce chosen by the verifier, to prove the enclave controls the key of its ID
+func (k *EnclaveKey) ProveID(nonce []byte) (*common.EnclaveIDProof, error) {
+	if err := common.ValidateEnclaveIDProofNonce(nonce); err != nil {
+		return nil, err
+	}
+	digest := common.EnclaveIDProofDigest(nonce)
+	signature, err := gethcrypto.Sign(digest.Bytes(), k.privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign the enclave ID proof. Cause: %w", err)
+	}
+	return &common.EnclaveIDProof{
+		EnclaveID: k.enclaveID,
+		PublicKey: k.publicKeyBytes,
+		Signature: signature,
+	}, nil
+}
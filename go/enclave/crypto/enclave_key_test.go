@@ -0,0 +1,47 @@
+package crypto
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+func TestEnclaveIDProofVerifiesAgainstItsNonce(t *testing.T) {
+	key, err := GenerateEnclaveKey()
+	require.NoError(t, err)
+	nonce := []byte("registration nonce")
+
+	proof, err := key.ProveID(nonce)
+	require.NoError(t, err)
+	require.Equal(t, key.EnclaveID(), proof.EnclaveID)
+	require.NoError(t, proof.Verify(nonce))
+
+	// the signature does not prove the possession of the key for another nonce
+	require.True(t, errors.Is(proof.Verify([]byte("other nonce")), common.ErrInvalidEnclaveIDProof))
+
+	// nor for another enclave ID
+	otherKey, err := GenerateEnclaveKey()
+	require.NoError(t, err)
+	forged := *proof
+	forged.EnclaveID = otherKey.EnclaveID()
+	require.True(t, errors.Is(forged.Verify(nonce), common.ErrInvalidEnclaveIDProof))
+
+	// nor when the key is swapped along with the ID
+	forged.PublicKey = otherKey.PublicKeyBytes()
+	require.True(t, errors.Is(forged.Verify(nonce), common.ErrInvalidEnclaveIDProof))
+}
+
+func TestEnclaveIDProofRejectsBadNonces(t *testing.T) {
+	key, err := GenerateEnclaveKey()
+	require.NoError(t, err)
+
+	_, err = key.ProveID(nil)
+	require.Error(t, err)
+	_, err = key.ProveID(bytes.Repeat([]byte{1}, common.MaxEnclaveIDProofNonceSize+1))
+	require.Error(t, err)
+	_, err = key.ProveID(bytes.Repeat([]byte{1}, common.MaxEnclaveIDProofNonceSize))
+	require.NoError(t, err)
+}
@@ -0,0 +1,86 @@
+package debugger
+
+import (
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/events"
+)
+
+// MaxLogRelevancyResults - the largest page returned by BatchLogRelevancy, whatever the caller asks for
+const MaxLogRelevancyResults = 256
+
+// BatchLogRelevancy - a page of the relevancy decisions the subscriptions apply to the logs of the batch, starting at
+// the log at offset among the logs of the batch. Only the logs emitted by the contracts accepted by include are
+// reported, all of them when include is nil.
+// The errors caused by the request are returned as user errors. The other errors are internal errors.
+func (d *Debugger) BatchLogRelevancy(batchHash common.L2BatchHash, offset uint64, maxResult int, include func(contract gethcommon.Address) (bool, error)) (*common.LogRelevancyPage, error) {
+	batch, err := d.storage.FetchBatch(batchHash)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, fmt.Errorf("batch %s not found - %w", batchHash, err)
+		}
+		return nil, syserr.NewInternalError(fmt.Errorf("could not retrieve batch - %w", err))
+	}
+	if maxResult <= 0 || maxResult > MaxLogRelevancyResults {
+		maxResult = MaxLogRelevancyResults
+	}
+
+	receipts, err := d.storage.GetReceiptsByBatchHash(batchHash)
+	if err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not retrieve the receipts of the batch - %w", err))
+	}
+	// the receipts are not stored in the order of the transactions of the batch
+	receiptsByTx := make(map[gethcommon.Hash]*types.Receipt, len(receipts))
+	for _, receipt := range receipts {
+		receiptsByTx[receipt.TxHash] = receipt
+	}
+	var logs []*types.Log
+	senders := map[gethcommon.Hash]*gethcommon.Address{}
+	for _, tx := range batch.Transactions {
+		if receipt, found := receiptsByTx[tx.Hash()]; found {
+			logs = append(logs, receipt.Logs...)
+		}
+		if sender, err := core.GetTxSigner(tx); err == nil {
+			senders[tx.Hash()] = &sender
+		}
+	}
+
+	// the subscriptions decide with the state after the batch, and the rules configured in the state before it
+	stateDB, err := d.storage.CreateStateDB(batchHash)
+	if err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not create state DB to filter logs - %w", err))
+	}
+	rulesDB, err := d.storage.CreateStateDB(batch.Header.ParentHash)
+	if err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not create state DB to filter logs - %w", err))
+	}
+
+	page := &common.LogRelevancyPage{Logs: []common.LogRelevancy{}}
+	for i := offset; i < uint64(len(logs)); i++ {
+		if len(page.Logs) == maxResult {
+			next := i
+			page.NextOffset = &next
+			break
+		}
+		if include != nil {
+			included, err := include(logs[i].Address)
+			if err != nil {
+				return nil, syserr.NewInternalError(fmt.Errorf("could not select the logs - %w", err))
+			}
+			if !included {
+				continue
+			}
+		}
+		relevancy := events.LogRelevancy(logs[i], senders[logs[i].TxHash], rulesDB, stateDB)
+		relevancy.Offset = i
+		page.Logs = append(page.Logs, relevancy)
+	}
+	return page, nil
+}
@@ -0,0 +1,61 @@
+package debugger
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+// init: emits 5 logs without data, with the topics 1 to 5, and deploys an empty runtime
+const loggingContractInit = "600160006000a1" + "600260006000a1" + "600360006000a1" + "600460006000a1" + "600560006000a1" + "00"
+
+func TestBatchLogRelevancyIsPaged(t *testing.T) {
+	debugger, sealTx := newTestChain(t, false)
+	deployTx, batch := sealTx(&types.LegacyTx{Nonce: 0, Gas: 200_000, GasPrice: big.NewInt(1_000_000_000), Data: gethcommon.FromHex(loggingContractInit)})
+	contract := gethcrypto.CreateAddress(testSender(t), deployTx.Nonce())
+
+	var offsets []uint64
+	var topics []gethcommon.Hash
+	offset, pages := uint64(0), 0
+	for {
+		page, err := debugger.BatchLogRelevancy(batch.Hash(), offset, 2, nil)
+		require.NoError(t, err)
+		pages++
+		require.LessOrEqual(t, len(page.Logs), 2)
+		for _, log := range page.Logs {
+			require.Equal(t, contract, log.Address)
+			offsets = append(offsets, log.Offset)
+			topics = append(topics, log.Topics[0].Topic)
+		}
+		if page.NextOffset == nil {
+			break
+		}
+		offset = *page.NextOffset
+	}
+	require.Equal(t, 3, pages)
+	require.Equal(t, []uint64{0, 1, 2, 3, 4}, offsets)
+	require.Equal(t, []gethcommon.Hash{{31: 1}, {31: 2}, {31: 3}, {31: 4}, {31: 5}}, topics)
+
+	// a page which starts in the middle, and a page size of 0 returns the maximum page
+	page, err := debugger.BatchLogRelevancy(batch.Hash(), 3, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, page.Logs, 2)
+	require.Equal(t, uint64(3), page.Logs[0].Offset)
+	require.Nil(t, page.NextOffset)
+
+	// past the last log
+	page, err = debugger.BatchLogRelevancy(batch.Hash(), 5, 2, nil)
+	require.NoError(t, err)
+	require.Equal(t, &common.LogRelevancyPage{Logs: []common.LogRelevancy{}}, page)
+
+	// the logs which are not included do not fill the page
+	page, err = debugger.BatchLogRelevancy(batch.Hash(), 0, 2, func(gethcommon.Address) (bool, error) { return false, nil })
+	require.NoError(t, err)
+	require.Empty(t, page.Logs)
+	require.Nil(t, page.NextOffset)
+}
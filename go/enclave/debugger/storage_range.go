@@ -0,0 +1,92 @@
+package debugger
+
+import (
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/responses"
+)
+
+// MaxStorageRangeResults - the largest page returned by StorageRangeAt, whatever the caller asks for. The iterator
+// holds the nodes of the trie it went through, so the larger pages are split in several requests.
+const MaxStorageRangeResults = 1024
+
+// StorageRangeAt - a page of the storage of the contract, in the state in which the transaction at txIndex of the batch
+// is executed. The keys are ordered by their hash, and the page starts at the hash of start.
+// The errors caused by the request are returned as user errors. The other errors are internal errors.
+func (d *Debugger) StorageRangeAt(batchHash common.L2BatchHash, txIndex int, address gethcommon.Address, start []byte, maxResult int) (*common.StorageRange, error) {
+	batch, err := d.storage.FetchBatch(batchHash)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, fmt.Errorf("batch %s not found - %w", batchHash, err)
+		}
+		return nil, syserr.NewInternalError(fmt.Errorf("could not retrieve batch - %w", err))
+	}
+	if txIndex < 0 || (txIndex > 0 && txIndex >= len(batch.Transactions)) {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, fmt.Errorf("transaction index %d out of range for batch %s", txIndex, batchHash))
+	}
+	if maxResult <= 0 || maxResult > MaxStorageRangeResults {
+		maxResult = MaxStorageRangeResults
+	}
+
+	// the state is committed to the trie database of the stateDB, which is discarded with it
+	statedb, err := d.chain.GetIsolatedStateAtTransaction(batch, txIndex)
+	if err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not compute the state at the transaction - %w", err))
+	}
+	root, err := statedb.Commit(batch.NumberU64(), true)
+	if err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not commit the state at the transaction - %w", err))
+	}
+
+	// the committed stateDB is no longer usable
+	committed, err := state.New(root, statedb.Database(), nil)
+	if err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not open the state at the transaction - %w", err))
+	}
+
+	result := &common.StorageRange{Storage: map[gethcommon.Hash]common.StorageEntry{}}
+	storageRoot := committed.GetStorageRoot(address)
+	if storageRoot == types.EmptyRootHash || storageRoot == (gethcommon.Hash{}) {
+		return result, nil
+	}
+	storageTrie, err := trie.NewStateTrie(trie.StorageTrieID(root, crypto.Keccak256Hash(address.Bytes()), storageRoot), committed.Database().TrieDB())
+	if err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not open the storage trie - %w", err))
+	}
+	nodeIt, err := storageTrie.NodeIterator(start)
+	if err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not iterate the storage trie - %w", err))
+	}
+	it := trie.NewIterator(nodeIt)
+	for i := 0; i < maxResult && it.Next(); i++ {
+		_, content, _, err := rlp.Split(it.Value)
+		if err != nil {
+			return nil, syserr.NewInternalError(fmt.Errorf("could not decode the storage value - %w", err))
+		}
+		entry := common.StorageEntry{Value: gethcommon.BytesToHash(content)}
+		if preimage := storageTrie.GetKey(it.Key); preimage != nil {
+			key := gethcommon.BytesToHash(preimage)
+			entry.Key = &key
+		}
+		result.Storage[gethcommon.BytesToHash(it.Key)] = entry
+	}
+	if it.Err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not iterate the storage trie - %w", it.Err))
+	}
+	// the clients continue from the next key
+	if it.Next() {
+		next := gethcommon.BytesToHash(it.Key)
+		result.NextKey = &next
+	}
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package debugger
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/responses"
+)
+
+const (
+	// init: stores 1, 2 and 3 in the slots 1, 2 and 3, then returns the 6 bytes of the runtime which follow its 27 bytes
+	storingContractInit = "600160015560026002556003600355" + "6006601b60003960066000f3"
+	// runtime: stores 42 in the slot 1
+	storingContractRuntime = "602a60015500"
+)
+
+func TestStorageRangeAt(t *testing.T) {
+	debugger, sealTx := newTestChain(t, false)
+	deployTx, _ := sealTx(&types.LegacyTx{Nonce: 0, Gas: 200_000, GasPrice: big.NewInt(1_000_000_000), Data: gethcommon.FromHex(storingContractInit + storingContractRuntime)})
+	contract := gethcrypto.CreateAddress(testSender(t), deployTx.Nonce())
+	_, callBatch := sealTx(&types.LegacyTx{Nonce: 1, To: &contract, Gas: 100_000, GasPrice: big.NewInt(1_000_000_000)})
+	to := gethcommon.HexToAddress("0x1")
+	_, nextBatch := sealTx(&types.LegacyTx{Nonce: 2, To: &to, Gas: 21_000, GasPrice: big.NewInt(1_000_000_000)})
+
+	// the storage is paged, before the call stores 42
+	firstPage, err := debugger.StorageRangeAt(callBatch.Hash(), 0, contract, nil, 2)
+	require.NoError(t, err)
+	require.Len(t, firstPage.Storage, 2)
+	require.NotNil(t, firstPage.NextKey)
+	lastPage, err := debugger.StorageRangeAt(callBatch.Hash(), 0, contract, firstPage.NextKey.Bytes(), 2)
+	require.NoError(t, err)
+	require.Len(t, lastPage.Storage, 1)
+	require.Nil(t, lastPage.NextKey)
+	for hashedKey, entry := range lastPage.Storage {
+		firstPage.Storage[hashedKey] = entry
+	}
+	require.Equal(t, storageValues(1, 1, 2, 2, 3, 3), firstPage.Storage)
+
+	// once the call executed
+	storageRange, err := debugger.StorageRangeAt(nextBatch.Hash(), 0, contract, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, storageValues(1, 42, 2, 2, 3, 3), storageRange.Storage)
+
+	// the accounts without storage
+	storageRange, err = debugger.StorageRangeAt(nextBatch.Hash(), 0, to, nil, 10)
+	require.NoError(t, err)
+	require.Empty(t, storageRange.Storage)
+
+	_, err = debugger.StorageRangeAt(nextBatch.Hash(), 1, contract, nil, 10)
+	require.Equal(t, responses.ErrCodeInvalidParams, responses.ErrorCodeOf(err))
+}
+
+// storageValues - the entries of the slots, from pairs of slot and value. The keys are unknown, as no preimages are recorded.
+func storageValues(slotsAndValues ...int64) map[gethcommon.Hash]common.StorageEntry {
+	entries := map[gethcommon.Hash]common.StorageEntry{}
+	for i := 0; i < len(slotsAndValues); i += 2 {
+		slot := gethcommon.BigToHash(big.NewInt(slotsAndValues[i]))
+		entries[gethcrypto.Keccak256Hash(slot.Bytes())] = common.StorageEntry{Value: gethcommon.BigToHash(big.NewInt(slotsAndValues[i+1]))}
+	}
+	return entries
+}
@@ -0,0 +1,87 @@
+package debugger
+
+import (
+	"math/big"
+	"runtime/metrics"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	gethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+const allocatedBytesMetric = "/gc/heap/allocs:bytes"
+
+// meteredTracer - counts the bytes allocated by each hook of a JS tracer, whose VM has no memory limit of its own, and
+// stops the trace once they add up to more than the cap. Only the time spent in the hooks is metered, so the memory of
+// the EVM and of the rest of the enclave is not attributed to the tracer, except for what other goroutines allocate
+// during a hook.
+type meteredTracer struct {
+	gethtracers.Tracer
+	memoryCap uint64
+	allocated uint64
+	exceeded  bool
+	abort     func() // cancels the EVM once the cap is exceeded
+	sample    []metrics.Sample
+}
+
+func newMeteredTracer(tracer gethtracers.Tracer, memoryCap uint64) *meteredTracer {
+	return &meteredTracer{
+		Tracer:    tracer,
+		memoryCap: memoryCap,
+		abort:     func() {},
+		sample:    []metrics.Sample{{Name: allocatedBytesMetric}},
+	}
+}
+
+// allocatedBytes - the bytes allocated by the process since it started
+func (m *meteredTracer) allocatedBytes() uint64 {
+	metrics.Read(m.sample)
+	return m.sample[0].Value.Uint64()
+}
+
+// meter - runs the hook, and adds the bytes allocated meanwhile to the ones of the tracer
+func (m *meteredTracer) meter(hook func()) {
+	if m.exceeded {
+		return
+	}
+	before := m.allocatedBytes()
+	hook()
+	m.allocated += m.allocatedBytes() - before
+	if m.allocated > m.memoryCap {
+		m.exceeded = true
+		m.Tracer.Stop(errMemoryCapExceeded)
+		m.abort()
+	}
+}
+
+func (m *meteredTracer) CaptureTxStart(gasLimit uint64) {
+	m.meter(func() { m.Tracer.CaptureTxStart(gasLimit) })
+}
+
+func (m *meteredTracer) CaptureTxEnd(restGas uint64) {
+	m.meter(func() { m.Tracer.CaptureTxEnd(restGas) })
+}
+
+func (m *meteredTracer) CaptureStart(env *vm.EVM, from gethcommon.Address, to gethcommon.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	m.meter(func() { m.Tracer.CaptureStart(env, from, to, create, input, gas, value) })
+}
+
+func (m *meteredTracer) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	m.meter(func() { m.Tracer.CaptureEnd(output, gasUsed, err) })
+}
+
+func (m *meteredTracer) CaptureEnter(typ vm.OpCode, from gethcommon.Address, to gethcommon.Address, input []byte, gas uint64, value *big.Int) {
+	m.meter(func() { m.Tracer.CaptureEnter(typ, from, to, input, gas, value) })
+}
+
+func (m *meteredTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	m.meter(func() { m.Tracer.CaptureExit(output, gasUsed, err) })
+}
+
+func (m *meteredTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	m.meter(func() { m.Tracer.CaptureState(pc, op, gas, cost, scope, rData, depth, err) })
+}
+
+func (m *meteredTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	m.meter(func() { m.Tracer.CaptureFault(pc, op, gas, cost, scope, depth, err) })
+}
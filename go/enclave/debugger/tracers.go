@@ -4,37 +4,70 @@ package debugger
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 
+	gethcore "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	gethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
 	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/enclave/evm"
 	"github.com/ten-protocol/go-ten/go/enclave/l2chain"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/responses"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	// the tracers register themselves in the geth directory
+	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 )
 
 const (
-// defaultTraceTimeout is the amount of time a single transaction can execute
-// by default before being forcefully aborted.
-// defaultTraceTimeout = 5 * time.Second
-
-// defaultTraceReexec is the number of blocks the tracer is willing to go back
-// and reexecute to produce missing historical state necessary to run a specific
-// trace.
-// defaultTraceReexec = uint64(128)
+	// defaultTraceTimeout is the amount of time a single transaction can execute
+	// by default before being forcefully aborted, when the execution limits of the enclave set no timeout.
+	defaultTraceTimeout = 5 * time.Second
+
+	// defaultTraceReexec is the number of blocks the tracer is willing to go back
+	// and reexecute to produce missing historical state necessary to run a specific
+	// trace.
+	defaultTraceReexec = uint64(128)
+
+	// tracerMemoryCap - the trace is aborted when a JS tracer allocated more than this. The JS tracers run arbitrary
+	// code, and their VM has no memory limit of its own.
+	tracerMemoryCap = 256 << 20
+)
+
+var (
+	errJSTracersDisabled = errors.New("the JavaScript tracers are not enabled on this node")
+	errMemoryCapExceeded = fmt.Errorf("the tracer used more than %d MiB", tracerMemoryCap>>20)
 )
 
 type Debugger struct {
-	chain       l2chain.ObscuroChain
-	storage     storage.Storage
-	chainConfig *params.ChainConfig
+	chain            l2chain.ObscuroChain
+	storage          storage.Storage
+	chainConfig      *params.ChainConfig
+	jsTracersEnabled bool
+	limits           evm.ExecutionLimits
+	tracerMemoryCap  uint64
 }
 
-func New(chain l2chain.ObscuroChain, storage storage.Storage, config *params.ChainConfig) *Debugger {
+// New - jsTracersEnabled allows the callers to trace the transactions with their own JavaScript code. The traces are
+// executions requested by the users, so they are bounded by the execution limits.
+func New(chain l2chain.ObscuroChain, storage storage.Storage, config *params.ChainConfig, jsTracersEnabled bool, limits evm.ExecutionLimits) *Debugger {
 	return &Debugger{
-		chain:       chain,
-		chainConfig: config,
-		storage:     storage,
+		chain:            chain,
+		chainConfig:      config,
+		storage:          storage,
+		jsTracersEnabled: jsTracersEnabled,
+		limits:           limits,
+		tracerMemoryCap:  tracerMemoryCap,
 	}
 }
 
@@ -52,111 +85,116 @@ func (d *Debugger) DebugEventLogRelevancy(txHash gethcommon.Hash) (json.RawMessa
 	return jsonRaw, nil
 }
 
-func (d *Debugger) DebugTraceTransaction(context.Context, gethcommon.Hash, *tracers.TraceConfig) (json.RawMessage, error) {
-	return nil, nil
-	//_, blockHash, blockNumber, index, err := d.storage.GetTransaction(txHash)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//// It shouldn't happen in practice.
-	//if blockNumber == 0 {
-	//	return nil, errors.New("genesis is not traceable")
-	//}
-	//reexec := defaultTraceReexec
-	//if config != nil && config.Reexec != nil {
-	//	reexec = *config.Reexec
-	//}
-	//batch, err := d.storage.FetchBatch(blockHash)
-	//if err != nil {
-	//	return nil, err
-	//}
-
-	//msg, vmctx, statedb, err := d.chain.GetChainStateAtTransaction(batch, int(index), reexec)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//
-	//txctx := &gethtracers.Context{
-	//	BlockHash: blockHash,
-	//	TxIndex:   int(index),
-	//	TxHash:    txHash,
-	//}
-	//return d.traceTx(ctx, msg, txctx, vmctx, statedb, config)
+// DebugTraceTransaction - replays the transaction on the state of its batch with the configured tracer. The errors
+// caused by the request, e.g. an unknown transaction or a tracer which does not compile, are returned as user errors.
+// The other errors are internal errors.
+func (d *Debugger) DebugTraceTransaction(ctx context.Context, txHash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, error) {
+	_, blockHash, blockNumber, index, err := d.storage.GetTransaction(txHash)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, fmt.Errorf("transaction %s not found - %w", txHash, err)
+		}
+		return nil, syserr.NewInternalError(fmt.Errorf("could not retrieve transaction - %w", err))
+	}
+	// It shouldn't happen in practice.
+	if blockNumber == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	reexec := defaultTraceReexec
+	if config != nil && config.Reexec != nil {
+		reexec = *config.Reexec
+	}
+	batch, err := d.storage.FetchBatch(blockHash)
+	if err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not retrieve batch - %w", err))
+	}
+
+	msg, vmctx, statedb, err := d.chain.GetChainStateAtTransaction(batch, int(index), reexec)
+	if err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not compute the state at the transaction - %w", err))
+	}
+
+	txctx := &gethtracers.Context{
+		BlockHash:   blockHash,
+		BlockNumber: batch.Number(),
+		TxIndex:     int(index),
+		TxHash:      txHash,
+	}
+	return d.traceTx(ctx, msg, txctx, vmctx, statedb, config)
 }
 
 // traceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
-//
-//func (d *Debugger) traceTx(ctx context.Context, message *core.Message, txctx *gethtracers.Context, vmctx vm.BlockContext, statedb *state.StateDB, config *tracers.TraceConfig) (json.RawMessage, error) {
-//	// Assemble the structured logger or the JavaScript tracer
-//	var (
-//		tracer    vm.EVMLogger
-//		err       error
-//		txContext = core.NewEVMTxContext(message)
-//	)
-//	switch {
-//	case config == nil:
-//		tracer = logger.NewStructLogger(nil)
-//	case config.Tracer != nil:
-//		// Define a meaningful timeout of a single transaction trace
-//		timeout := defaultTraceTimeout
-//		if config.Timeout != nil {
-//			if timeout, err = time.ParseDuration(*config.Timeout); err != nil {
-//				return nil, err
-//			}
-//		}
-//		//if t, err := tracers.New(*config.Tracer, (*tracers.Context)(txctx)); err != nil {
-//		//	return nil, err
-//		//} else {
-//		//	deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
-//		//	go func() {
-//		//		<-deadlineCtx.Done()
-//		//		if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
-//		//			t.Stop(errors.New("execution timeout"))
-//		//		}
-//		//	}()
-//		//	defer cancel()
-//		//	tracer = t
-//		//}
-//	default:
-//		tracer = logger.NewStructLogger(config.Config)
-//	}
-//	// Run the transaction with tracing enabled.
-//	vmenv := vm.NewEVM(vmctx, txContext, statedb, d.chainConfig, vm.Config{Debug: true, Tracer: tracer, NoBaseFee: true})
-//
-//	// Call Prepare to clear out the statedb access list
-//	statedb.Prepare(txctx.TxHash, txctx.TxIndex)
-//
-//	result, err := core.ApplyMessage(vmenv, message, new(core.GasPool).AddGas(message.Gas()))
-//	if err != nil {
-//		return nil, fmt.Errorf("tracing failed: %w", err)
-//	}
-//
-//	// Depending on the tracer type, format and return the output.
-//	switch tracer := tracer.(type) {
-//	case *logger.StructLogger:
-//		// If the result contains a revert reason, return it.
-//		returnVal := fmt.Sprintf("%x", result.Return())
-//		if len(result.Revert()) > 0 {
-//			returnVal = fmt.Sprintf("%x", result.Revert())
-//		}
-//		exec := &gethapi.ExecutionResult{
-//			Gas:         result.UsedGas,
-//			Failed:      result.Failed(),
-//			ReturnValue: returnVal,
-//			StructLogs:  gethapi.FormatLogs(tracer.StructLogs()),
-//		}
-//		jsonRaw, err := json.Marshal(exec)
-//		if err != nil {
-//			return nil, err
-//		}
-//		return jsonRaw, nil
-//
-//	case tracers.Tracer:
-//		return tracer.GetResult()
-//
-//	default:
-//		panic(fmt.Sprintf("bad tracer type %T", tracer))
-//	}
-//}
+func (d *Debugger) traceTx(ctx context.Context, message *gethcore.Message, txctx *gethtracers.Context, vmctx vm.BlockContext, statedb *state.StateDB, config *tracers.TraceConfig) (json.RawMessage, error) {
+	if config == nil {
+		config = &tracers.TraceConfig{}
+	}
+	tracer, err := d.newTracer(txctx, config)
+	if err != nil {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, err)
+	}
+	timeout := defaultTraceTimeout
+	if d.limits.Timeout != 0 {
+		timeout = d.limits.Timeout
+	}
+	if config.Timeout != nil {
+		requested, err := time.ParseDuration(*config.Timeout)
+		if err != nil {
+			return nil, responses.WithCode(responses.ErrCodeInvalidParams, fmt.Errorf("invalid timeout - %w", err))
+		}
+		// the callers can shorten the timeout of the enclave, not extend it
+		if d.limits.Timeout == 0 || requested < d.limits.Timeout {
+			timeout = requested
+		}
+	}
+	vmCfg, memoryLimiter := evm.LimitMemory(vm.Config{Tracer: tracer, NoBaseFee: true}, d.limits.MemoryCap)
+	vmenv := vm.NewEVM(vmctx, gethcore.NewEVMTxContext(message), statedb, d.chainConfig, vmCfg)
+	if metered, ok := tracer.(*meteredTracer); ok {
+		metered.abort = vmenv.Cancel
+	}
+
+	// the trace is aborted on timeout, or when the enclave stops
+	deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	go func() {
+		<-deadlineCtx.Done()
+		if ctx.Err() != nil {
+			tracer.Stop(fmt.Errorf("trace aborted - %w", ctx.Err()))
+		} else if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
+			tracer.Stop(errutil.ErrExecutionTimeout)
+		}
+		// Stop evm execution. Note cancellation is not necessarily immediate.
+		vmenv.Cancel()
+	}()
+
+	// Call Prepare to clear out the statedb access list
+	statedb.SetTxContext(txctx.TxHash, txctx.TxIndex)
+	if _, err = gethcore.ApplyMessage(vmenv, message, new(gethcore.GasPool).AddGas(message.GasLimit)); err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("tracing failed: %w", err))
+	}
+	if memoryLimiter.Exceeded() {
+		return nil, errutil.ErrExecutionMemoryCapExceeded
+	}
+	// the errors of the tracer, e.g. a JS exception or the timeout, are caused by the request
+	return tracer.GetResult()
+}
+
+// newTracer - the struct logger by default, otherwise the native or JS tracer of the config. The JS tracers, which
+// are either code or one of the geth built-in JS tracers, are only available when enabled, and their memory is metered.
+func (d *Debugger) newTracer(txctx *gethtracers.Context, config *tracers.TraceConfig) (gethtracers.Tracer, error) {
+	if config.Tracer == nil {
+		return logger.NewStructLogger(config.Config), nil
+	}
+	if !gethtracers.DefaultDirectory.IsJS(*config.Tracer) {
+		return gethtracers.DefaultDirectory.New(*config.Tracer, txctx, config.TracerConfig)
+	}
+	if !d.jsTracersEnabled {
+		return nil, errJSTracersDisabled
+	}
+	tracer, err := gethtracers.DefaultDirectory.New(*config.Tracer, txctx, config.TracerConfig)
+	if err != nil {
+		return nil, err
+	}
+	return newMeteredTracer(tracer, d.tracerMemoryCap), nil
+}
@@ -0,0 +1,182 @@
+package debugger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	gethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common/compression"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/enclavetest"
+	"github.com/ten-protocol/go-ten/go/enclave/evm"
+	"github.com/ten-protocol/go-ten/go/enclave/genesis"
+	"github.com/ten-protocol/go-ten/go/enclave/l2chain"
+	"github.com/ten-protocol/go-ten/go/enclave/nodetype"
+	"github.com/ten-protocol/go-ten/go/responses"
+)
+
+const (
+	// runtime: LOG1 with no data, then a CALL to the sha256 precompile
+	testContractRuntime = "600060006000a1" + "6000600060006000600060025af15000"
+	// init: copies the 23 bytes of the runtime which follow the 12 bytes of the init code, and returns them
+	testContractInit = "6017600c60003960176000f3"
+
+	countingJSTracer = `{count: 0, step: function() { this.count++ }, fault: function() {}, result: function() { return this.count }}`
+	loopingJSTracer  = `{step: function() { while (true) {} }, fault: function() {}, result: function() { return 0 }}`
+	// keeps an array of 10k numbers for every step
+	hoardingJSTracer = `{steps: [], step: function() { var a = []; for (var i = 0; i < 10000; i++) { a.push(i) } this.steps.push(a) }, fault: function() {}, result: function() { return this.steps.length }}`
+)
+
+type callFrame struct {
+	Calls []callFrame `json:"calls"`
+	Logs  []any       `json:"logs"`
+}
+
+func TestCallTracerOptions(t *testing.T) {
+	debugger, txHash := newTracedTx(t, false)
+
+	frame := traceCall(t, debugger, txHash, `{}`)
+	require.Len(t, frame.Calls, 1)
+	require.Empty(t, frame.Logs)
+
+	frame = traceCall(t, debugger, txHash, `{"onlyTopCall": true}`)
+	require.Empty(t, frame.Calls)
+
+	frame = traceCall(t, debugger, txHash, `{"withLog": true}`)
+	require.Len(t, frame.Logs, 1)
+}
+
+func TestJSTracersAreDisabledByDefault(t *testing.T) {
+	debugger, txHash := newTracedTx(t, false)
+
+	_, err := debugger.DebugTraceTransaction(context.Background(), txHash, &tracers.TraceConfig{Tracer: ptr(countingJSTracer)})
+	require.ErrorIs(t, err, errJSTracersDisabled)
+	require.Equal(t, responses.ErrCodeInvalidParams, responses.ErrorCodeOf(err))
+}
+
+func TestJSTracers(t *testing.T) {
+	debugger, txHash := newTracedTx(t, true)
+
+	result, err := debugger.DebugTraceTransaction(context.Background(), txHash, &tracers.TraceConfig{Tracer: ptr(countingJSTracer)})
+	require.NoError(t, err)
+	var steps int
+	require.NoError(t, json.Unmarshal(result, &steps))
+	require.Greater(t, steps, 0)
+
+	// a tracer which does not compile is the fault of the caller
+	_, err = debugger.DebugTraceTransaction(context.Background(), txHash, &tracers.TraceConfig{Tracer: ptr("{ invalid")})
+	require.Error(t, err)
+	require.False(t, errors.Is(err, &syserr.InternalError{}))
+	require.Equal(t, responses.ErrCodeInvalidParams, responses.ErrorCodeOf(err))
+
+	// the tracers which never return are aborted
+	_, err = debugger.DebugTraceTransaction(context.Background(), txHash, &tracers.TraceConfig{Tracer: ptr(loopingJSTracer), Timeout: ptr("100ms")})
+	require.ErrorContains(t, err, errutil.ErrExecutionTimeout.Error())
+	require.False(t, errors.Is(err, &syserr.InternalError{}))
+}
+
+func TestJSTracersAreStoppedAtTheMemoryCap(t *testing.T) {
+	debugger, txHash := newTracedTx(t, true)
+
+	// the tracer fits in the default cap
+	_, err := debugger.DebugTraceTransaction(context.Background(), txHash, &tracers.TraceConfig{Tracer: ptr(hoardingJSTracer)})
+	require.NoError(t, err)
+
+	debugger.tracerMemoryCap = 1 << 20
+	_, err = debugger.DebugTraceTransaction(context.Background(), txHash, &tracers.TraceConfig{Tracer: ptr(hoardingJSTracer)})
+	require.ErrorContains(t, err, errMemoryCapExceeded.Error())
+
+	// the cap is not shared between the traces
+	_, err = debugger.DebugTraceTransaction(context.Background(), txHash, &tracers.TraceConfig{Tracer: ptr(countingJSTracer)})
+	require.NoError(t, err)
+}
+
+func TestMeteringTheTracerDoesNotAllocate(t *testing.T) {
+	noop, err := gethtracers.DefaultDirectory.New("noopTracer", &gethtracers.Context{}, nil)
+	require.NoError(t, err)
+	metered := newMeteredTracer(noop, tracerMemoryCap)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		metered.CaptureState(0, vm.STOP, 0, 0, nil, nil, 0, nil)
+	})
+	require.Zero(t, allocs)
+	require.Zero(t, metered.allocated)
+}
+
+func traceCall(t *testing.T, debugger *Debugger, txHash gethcommon.Hash, tracerConfig string) callFrame {
+	result, err := debugger.DebugTraceTransaction(context.Background(), txHash, &tracers.TraceConfig{
+		Tracer:       ptr("callTracer"),
+		TracerConfig: json.RawMessage(tracerConfig),
+	})
+	require.NoError(t, err)
+	var frame callFrame
+	require.NoError(t, json.Unmarshal(result, &frame))
+	return frame
+}
+
+// newTracedTx - a debugger, and the hash of a transaction which calls a contract emitting a log and calling a precompile
+func newTracedTx(t *testing.T, jsTracersEnabled bool) (*Debugger, gethcommon.Hash) {
+	debugger, sealTx := newTestChain(t, jsTracersEnabled)
+	deployTx, _ := sealTx(&types.LegacyTx{Nonce: 0, Gas: 100_000, GasPrice: big.NewInt(1_000_000_000), Data: gethcommon.FromHex(testContractInit + testContractRuntime)})
+	contract := gethcrypto.CreateAddress(testSender(t), deployTx.Nonce())
+	callTx, _ := sealTx(&types.LegacyTx{Nonce: 1, To: &contract, Gas: 100_000, GasPrice: big.NewInt(1_000_000_000)})
+	return debugger, callTx.Hash()
+}
+
+// newTestChain - a debugger, and a function which seals a transaction of the prefunded account in a new batch
+func newTestChain(t *testing.T, jsTracersEnabled bool) (*Debugger, func(*types.LegacyTx) (*types.Transaction, *core.Batch)) {
+	c := enclavetest.NewChain(t, 0)
+	seq := nodetype.NewSequencer(c.BlockProcessor, c.BatchExecutor, c.Registry, nil, nil, nil, c.GethEncoding, c.Logger, gethcommon.Address{}, c.ChainConfig, c.EnclaveKey, c.Mempool, c.Storage,
+		crypto.NewDataEncryptionService(c.Logger), compression.NewBrotliDataCompressionService(),
+		nodetype.SequencerSettings{MaxBatchSize: 1024 * 1024, BatchGasLimit: enclavetest.BatchGasLimit}, c.GasOracle, c.Blockchain)
+	_, err := seq.CreateBatch(false)
+	require.NoError(t, err)
+
+	key, err := gethcrypto.HexToECDSA(genesis.TestnetPrefundedPK)
+	require.NoError(t, err)
+	signer := types.LatestSigner(c.ChainConfig)
+	sealTx := func(txData *types.LegacyTx) (*types.Transaction, *core.Batch) {
+		tx, err := types.SignNewTx(key, signer, txData)
+		require.NoError(t, err)
+		require.NoError(t, seq.SubmitTransaction(tx))
+		// the pool promotes the transaction asynchronously, and a batch only includes the pending ones
+		require.Eventually(t, func() bool {
+			for _, pending := range c.Mempool.PendingTransactions()[gethcrypto.PubkeyToAddress(key.PublicKey)] {
+				if pending.Hash == tx.Hash() {
+					return true
+				}
+			}
+			return false
+		}, 5*time.Second, 10*time.Millisecond)
+		batch, err := seq.CreateBatch(false)
+		require.NoError(t, err)
+		require.Len(t, batch.Transactions, 1)
+		return tx, batch
+	}
+
+	chain := l2chain.NewChain(c.Storage, c.GethEncoding, c.ChainConfig, &genesis.TestnetGenesis, c.Logger, c.Registry, enclavetest.BatchGasLimit, evm.ExecutionLimits{})
+	return New(chain, c.Storage, c.ChainConfig, jsTracersEnabled, evm.ExecutionLimits{}), sealTx
+}
+
+func testSender(t *testing.T) gethcommon.Address {
+	key, err := gethcrypto.HexToECDSA(genesis.TestnetPrefundedPK)
+	require.NoError(t, err)
+	return gethcrypto.PubkeyToAddress(key.PublicKey)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
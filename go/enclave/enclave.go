@@ -6,13 +6,18 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"runtime"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ten-protocol/go-ten/go/common/compression"
 	"github.com/ten-protocol/go-ten/go/common/measure"
+	"github.com/ten-protocol/go-ten/go/enclave/evm"
 	"github.com/ten-protocol/go-ten/go/enclave/evm/ethchainadapter"
 	"github.com/ten-protocol/go-ten/go/enclave/gas"
+	"github.com/ten-protocol/go-ten/go/enclave/metrics"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 	"github.com/ten-protocol/go-ten/go/enclave/txpool"
 
@@ -28,15 +33,16 @@ import (
 
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 
+	gethtxpool "github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto/ecies"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/gethencoding"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/profiler"
 	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
-	"github.com/ten-protocol/go-ten/go/common/syserr"
-	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
 	"github.com/ten-protocol/go-ten/go/config"
 	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
 	"github.com/ten-protocol/go-ten/go/enclave/crypto"
@@ -49,20 +55,32 @@ import (
 	_ "github.com/ten-protocol/go-ten/go/common/tracers/native" // make sure the tracers are loaded
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
-	gethcore "github.com/ethereum/go-ethereum/core"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var _noHeadBatch = big.NewInt(0)
 
+// the purpose of the key used to encrypt the transactions that validators forward to the sequencer
+const forwardedTxEncryptionPurpose = "FORWARDED_TX"
+
+// defaultStopTimeout - used when the stop timeout is not configured
+const defaultStopTimeout = 10 * time.Second
+
+// Version - the enclave software version, reported in the status. Injected at build time with
+// -ldflags "-X github.com/ten-protocol/go-ten/go/enclave.Version=<version>"
+var Version = "dev"
+
 type enclaveImpl struct {
 	config                *config.EnclaveConfig
+	chainConfig           *params.ChainConfig
 	storage               storage.Storage
 	blockResolver         storage.BlockResolver
 	l1BlockProcessor      components.L1BlockProcessor
 	rollupConsumer        components.RollupConsumer
-	l1Blockchain          *gethcore.BlockChain
+	l1BlockValidator      *components.L1BlockValidator
 	rpcEncryptionManager  *rpc.EncryptionManager
 	subscriptionManager   *events.SubscriptionManager
 	crossChainProcessors  *crosschain.Processors
@@ -72,6 +90,7 @@ type enclaveImpl struct {
 	service   nodetype.NodeType
 	registry  components.BatchRegistry
 	gasOracle gas.Oracle
+	mempool   *txpool.TxPool
 
 	mgmtContractLib     mgmtcontractlib.MgmtContractLib
 	attestationProvider components.AttestationProvider // interface for producing attestation reports and verifying them
@@ -79,52 +98,125 @@ type enclaveImpl struct {
 	enclaveKey *crypto.EnclaveKey // the enclave's private key (used to identify the enclave and sign messages)
 
 	dataEncryptionService  crypto.DataEncryptionService
+	forwardedTxEncryption  crypto.DataEncryptionService // derived from the shared secret, so only available after it was received
+	forwardedTxMutex       sync.Mutex
 	dataCompressionService compression.DataCompressionService
 	gethEncodingService    gethencoding.EncodingService
-	profiler               *profiler.Profiler
+	profiler               *profiler.Profiler // nil until the profiler is started, at creation or at runtime
+	profilerMutex          sync.Mutex
+	metrics                *metrics.Registry
+	tracerProvider         *tracing.Provider // nil when tracing is disabled
+	tracer                 trace.Tracer
 	debugger               *debugger.Debugger
 	logger                 gethlog.Logger
+	logLevels              *log.ComponentLevels // the levels of the loggers of the components, adjusted at runtime
 
 	stopControl *stopcontrol.StopControl
-	mainMutex   sync.Mutex // serialises all data ingestion or creation to avoid weird races
+	// The locks which serialise the data ingestion and creation. When both are needed, l1Mutex is acquired first, to avoid
+	// deadlocks. The rollups are created from a snapshot of the sealed batches, so they need neither lock for long.
+	l1Mutex     sync.Mutex // serialises the ingestion of the L1 blocks
+	l2HeadMutex sync.Mutex // serialises the changes to the L2 head: storing or executing batches, and moving the L1 head the batches are built on
+
+	batchProductionPaused atomic.Bool // changed while holding l2HeadMutex, so no batch is in production once it is set
+
+	// the replay of the batches whose state was lost. The blocks and batches are refused until the state is restored
+	replay replayTracker
+
+	// the open L2 updates stream, closed when stopping so the host gets an EOF
+	l2Updates   *l2UpdatesStream
+	streamMutex sync.Mutex
 }
 
 // NewEnclave creates a new enclave.
 // `genesisJSON` is the configuration for the corresponding L1's genesis block. This is used to validate the blocks
 // received from the L1 node if `validateBlocks` is set to true.
+// An error is returned if the enclave cannot be set up (e.g. the database is unreachable), after releasing what was
+// already started.
 func NewEnclave(
 	config *config.EnclaveConfig,
 	genesis *genesis.Genesis,
 	mgmtContractLib mgmtcontractlib.MgmtContractLib,
 	logger gethlog.Logger,
-) common.Enclave {
+) (common.Enclave, error) {
+	return newEnclave(config, genesis, mgmtContractLib, storage.NewStorageFromConfig, logger)
+}
+
+// storageOpener - opens the database of the enclave
+type storageOpener func(config *config.EnclaveConfig, chainConfig *params.ChainConfig, logger gethlog.Logger) (storage.Storage, error)
+
+// newEnclave - as NewEnclave, with the database opened by openStorage, so the tests can simulate the failures of the
+// storage
+func newEnclave(
+	config *config.EnclaveConfig,
+	genesis *genesis.Genesis,
+	mgmtContractLib mgmtcontractlib.MgmtContractLib,
+	openStorage storageOpener,
+	logger gethlog.Logger,
+) (_ common.Enclave, err error) {
+	// the records of the components are filtered by the level of their component, see EnclaveConfig.ComponentLogLevels
+	logLevels := newComponentLogLevels(config, logger)
+	logger = logger.New()
+	logger.SetHandler(logLevels.Handler(logger.GetHandler()))
+
 	jsonConfig, _ := json.MarshalIndent(config, "", "  ")
 	logger.Info("Creating enclave service with following config", log.CfgKey, string(jsonConfig))
 
+	if err = config.Validate(); err != nil {
+		return nil, err
+	}
+
+	// the resources started so far, released in reverse order if the enclave cannot be created
+	var cleanups []func()
+	defer func() {
+		if err != nil {
+			for i := len(cleanups) - 1; i >= 0; i-- {
+				cleanups[i]()
+			}
+		}
+	}()
+
 	// todo (#1053) - add the delay: N hashes
 
 	var prof *profiler.Profiler
 	// don't run a profiler on an attested enclave
 	if !config.WillAttest && config.ProfilerEnabled {
 		prof = profiler.NewProfiler(profiler.DefaultEnclavePort, logger)
-		err := prof.Start()
+		if err = prof.Start(); err != nil {
+			return nil, fmt.Errorf("unable to start the profiler. Cause: %w", err)
+		}
+		cleanups = append(cleanups, func() { _ = prof.Stop() })
+	}
+
+	// the registry is nil when the metrics are disabled, and the components then record nothing
+	metricsRegistry := metrics.New(config.MetricsEnabled)
+
+	var tracerProvider *tracing.Provider
+	tracer := tracing.Tracer(nil)
+	if config.TracesPath != "" {
+		tracerProvider, err = tracing.NewProvider("enclave", config.TracesPath)
 		if err != nil {
-			logger.Crit("unable to start the profiler", log.ErrKey, err)
+			return nil, fmt.Errorf("unable to start tracing. Cause: %w", err)
 		}
+		cleanups = append(cleanups, func() { _ = tracerProvider.Shutdown(context.Background()) })
+		tracer = tracing.Tracer(tracerProvider)
 	}
 
 	// Initialise the database
 	chainConfig := ethchainadapter.ChainParams(big.NewInt(config.ObscuroChainID))
-	storage := storage.NewStorageFromConfig(config, chainConfig, logger)
+	storage, err := openStorage(config, chainConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+	cleanups = append(cleanups, func() { _ = storage.Close() })
 
 	// Initialise the Ethereum "Blockchain" structure that will allow us to validate incoming blocks
-	// todo (#1056) - valid block
-	var l1Blockchain *gethcore.BlockChain
+	var l1BlockValidator *components.L1BlockValidator
 	if config.ValidateL1Blocks {
-		if config.GenesisJSON == nil {
-			logger.Crit("enclave is configured to validate blocks, but genesis JSON is nil")
+		l1Blockchain, err := l2chain.NewL1Blockchain(config.GenesisJSON)
+		if err != nil {
+			return nil, err
 		}
-		l1Blockchain = l2chain.NewL1Blockchain(config.GenesisJSON, logger)
+		l1BlockValidator = components.NewL1BlockValidator(l1Blockchain.Engine(), l1Blockchain.Config(), storage, config.L1MaxForkDepth, logger)
 	} else {
 		logger.Info("validateBlocks is set to false. L1 blocks will not be validated.")
 	}
@@ -142,18 +234,18 @@ func NewEnclave(
 	enclaveKey, err := storage.GetEnclaveKey()
 	if err != nil {
 		if !errors.Is(err, errutil.ErrNotFound) {
-			logger.Crit("Failed to fetch enclave key", log.ErrKey, err)
+			return nil, fmt.Errorf("failed to fetch enclave key. Cause: %w", err)
 		}
 		// enclave key not found - new key should be generated
 		// todo (#1053) - revisit the crypto for this key generation/lifecycle before production
 		logger.Info("Generating new enclave key")
 		enclaveKey, err = crypto.GenerateEnclaveKey()
 		if err != nil {
-			logger.Crit("Failed to generate enclave key.", log.ErrKey, err)
+			return nil, fmt.Errorf("failed to generate enclave key. Cause: %w", err)
 		}
 		err = storage.StoreEnclaveKey(enclaveKey)
 		if err != nil {
-			logger.Crit("Failed to store enclave key.", log.ErrKey, err)
+			return nil, fmt.Errorf("failed to store enclave key. Cause: %w", err)
 		}
 	}
 	logger.Info(fmt.Sprintf("Enclave key available. EnclaveID=%s, publicKey=%s", enclaveKey.EnclaveID(), gethcommon.Bytes2Hex(enclaveKey.PublicKeyBytes())))
@@ -164,26 +256,47 @@ func NewEnclave(
 	dataEncryptionService := crypto.NewDataEncryptionService(logger)
 	dataCompressionService := compression.NewBrotliDataCompressionService()
 
-	crossChainProcessors := crosschain.New(&config.MessageBusAddress, storage, big.NewInt(config.ObscuroChainID), logger)
-
-	gasOracle := gas.NewGasOracle()
-	blockProcessor := components.NewBlockProcessor(storage, crossChainProcessors, gasOracle, logger)
-	batchExecutor := components.NewBatchExecutor(storage, gethEncodingService, crossChainProcessors, genesis, gasOracle, chainConfig, config.GasBatchExecutionLimit, logger)
-	sigVerifier, err := components.NewSignatureValidator(config.SequencerID, storage)
-	registry := components.NewBatchRegistry(storage, logger)
-	rProducer := components.NewRollupProducer(config.SequencerID, storage, registry, logger)
+	crossChainProcessors := crosschain.New(&config.MessageBusAddress, &config.MessengerAddress, storage, big.NewInt(config.ObscuroChainID), config.L1ConfirmationDepth, logger)
+
+	gasOracle := gas.NewGasOracle(common.NetworkParameters{
+		MinGasPrice:       config.MinGasPrice,
+		BaseFee:           config.BaseFee,
+		GasPaymentAddress: config.GasPaymentAddress,
+	}, storage, logger)
+	if l1Head, err := storage.FetchHeadBlock(); err == nil {
+		// the parameters updated by the management contract before the restart
+		gasOracle.ProcessL1Block(l1Head)
+	}
+	blockProcessor := components.NewBlockProcessor(storage, crossChainProcessors, mgmtContractLib, gasOracle, metricsRegistry, logger.New(log.CmpKey, log.BlockProcessorCmp))
+	batchExecutor := components.NewBatchExecutor(storage, gethEncodingService, crossChainProcessors, genesis, gasOracle, chainConfig, config.GasBatchExecutionLimit, config.InboundBudgetActivationSeqNo, metricsRegistry, logger.New(log.CmpKey, log.BatchExecutorCmp))
+	signatureScheme := common.SignatureScheme{ChainID: config.ObscuroChainID, ActivationSeqNo: config.SignatureDomainActivationSeqNo}
+	timeRules := components.BatchTimeRules{
+		L1Drift:         time.Duration(common.BatchTimeL1Drift) * time.Second,
+		ActivationSeqNo: config.BatchTimeRulesActivationSeqNo,
+	}
+	sigVerifier, err := components.NewSignatureValidator(config.SequencerID, signatureScheme, storage)
 	if err != nil {
-		logger.Crit("Could not initialise the signature validator", log.ErrKey, err)
+		return nil, fmt.Errorf("could not initialise the signature validator. Cause: %w", err)
 	}
-	rollupCompression := components.NewRollupCompression(registry, batchExecutor, dataEncryptionService, dataCompressionService, storage, gethEncodingService, chainConfig, logger)
-	rConsumer := components.NewRollupConsumer(mgmtContractLib, registry, rollupCompression, storage, logger, sigVerifier)
-	sharedSecretProcessor := components.NewSharedSecretProcessor(mgmtContractLib, attestationProvider, storage, logger)
+	registry := components.NewBatchRegistry(storage, logger.New(log.CmpKey, log.BatchRegistryCmp))
+	rProducer := components.NewRollupProducer(config.SequencerID, storage, registry, metricsRegistry, logger)
+	rollupCompression := components.NewRollupCompression(registry, batchExecutor, dataEncryptionService, dataCompressionService, storage, gethEncodingService, chainConfig, metricsRegistry, logger)
+	rConsumer := components.NewRollupConsumer(mgmtContractLib, registry, rollupCompression, storage, logger.New(log.CmpKey, log.RollupConsumerCmp), sigVerifier, metricsRegistry)
+	sharedSecretProcessor := components.NewSharedSecretProcessor(mgmtContractLib, attestationProvider, storage, config.SecretResponseLimit, config.SecretResponseWindow, logger)
 
 	blockchain := ethchainadapter.NewEthChainAdapter(big.NewInt(config.ObscuroChainID), registry, storage, gethEncodingService, logger)
-	mempool, err := txpool.NewTxPool(blockchain, config.MinGasPrice, logger)
+	mempool, err := txpool.NewTxPool(blockchain, gasOracle.Parameters().MinGasPrice, config.TxPoolPriceBump, txpool.Limits{
+		GlobalCap:              config.TxPoolGlobalCap,
+		AccountCap:             config.TxPoolAccountCap,
+		TTL:                    config.TxPoolTTL,
+		HealthOccupancy:        config.TxPoolHealthOccupancy,
+		HealthAdmissionTimeout: config.TxPoolHealthAdmissionTimeout,
+	}, storage, metricsRegistry, logger.New(log.CmpKey, log.TxPoolCmp))
 	if err != nil {
-		logger.Crit("unable to init eth tx pool", log.ErrKey, err)
+		return nil, fmt.Errorf("unable to init eth tx pool. Cause: %w", err)
 	}
+	// the node type starts the mempool once the chain is initialised
+	cleanups = append(cleanups, func() { _ = mempool.Close() })
 
 	var service nodetype.NodeType
 	if config.NodeType == common.Sequencer {
@@ -204,18 +317,21 @@ func NewEnclave(
 			dataEncryptionService,
 			dataCompressionService,
 			nodetype.SequencerSettings{
-				MaxBatchSize:      config.MaxBatchSize,
-				MaxRollupSize:     config.MaxRollupSize,
-				GasPaymentAddress: config.GasPaymentAddress,
-				BatchGasLimit:     config.GasBatchExecutionLimit,
-				BaseFee:           config.BaseFee,
+				MaxBatchSize:         config.MaxBatchSize,
+				MaxRollupSize:        config.MaxRollupSize,
+				BatchGasLimit:        config.GasBatchExecutionLimit,
+				BatchExecutionTarget: config.BatchExecutionTarget,
+				SignatureScheme:      signatureScheme,
+				TimeRules:            timeRules,
 			},
+			gasOracle,
 			blockchain,
 		)
 	} else {
-		service = nodetype.NewValidator(blockProcessor, batchExecutor, registry, rConsumer, chainConfig, config.SequencerID, storage, sigVerifier, mempool, logger)
+		service = nodetype.NewValidator(blockProcessor, batchExecutor, registry, rConsumer, chainConfig, config.SequencerID, storage, sigVerifier, mempool, gasOracle, timeRules, logger)
 	}
 
+	executionLimits := evm.ExecutionLimits{Timeout: config.CallExecutionTimeout, MemoryCap: config.CallMemoryCap}
 	chain := l2chain.NewChain(
 		storage,
 		gethEncodingService,
@@ -224,27 +340,39 @@ func NewEnclave(
 		logger,
 		registry,
 		config.GasLocalExecutionCapFlag,
+		executionLimits,
 	)
-	rpcEncryptionManager := rpc.NewEncryptionManager(ecies.ImportECDSA(obscuroKey), storage, registry, crossChainProcessors, service, config, gasOracle, storage, chain, logger)
-	subscriptionManager := events.NewSubscriptionManager(storage, config.ObscuroChainID, logger)
+	debug := debugger.New(chain, storage, chainConfig, config.DebugJSTracersEnabled, executionLimits)
+	stopControl := stopcontrol.New()
+	rpcEncryptionManager := rpc.NewEncryptionManager(ecies.ImportECDSA(obscuroKey), storage, registry, crossChainProcessors, service, config, gasOracle, storage, gethEncodingService, mempool, chain, debug, metricsRegistry, stopControl, logger.New(log.CmpKey, log.EnclaveRPCCmp))
+	subscriptionManager := events.NewSubscriptionManager(storage, gethEncodingService, config.ObscuroChainID, events.SubscriptionLimits{
+		GlobalCap:     config.SubscriptionsGlobalCap,
+		ViewingKeyCap: config.SubscriptionsViewingKeyCap,
+		KeepAlive:     config.SubscriptionKeepAlive,
+		ReorgDepth:    config.SubscriptionReorgDepth,
+		HealthBacklog: config.SubscriptionHealthBacklog,
+	}, logger.New(log.CmpKey, log.SubscriptionManagerCmp))
+	cleanups = append(cleanups, subscriptionManager.Close)
+	if err := subscriptionManager.RestoreSubscriptions(); err != nil {
+		logger.Error("Could not restore the log subscriptions", log.ErrKey, err)
+	}
+	metricsRegistry.GaugeFunc("enclave/subscriptions/active", func() int64 { return int64(subscriptionManager.Stats().Active) })
 
 	// ensure cached chain state data is up-to-date using the persisted batch data
-	err = restoreStateDBCache(storage, registry, batchExecutor, genesis, logger)
+	replayNeeded, err := stateDBCacheNeedsReplay(storage, registry, logger)
 	if err != nil {
-		logger.Crit("failed to resync L2 chain state DB after restart", log.ErrKey, err)
+		return nil, fmt.Errorf("failed to resync L2 chain state DB after restart. Cause: %w", err)
 	}
 
-	// TODO ensure debug is allowed/disallowed
-	debug := debugger.New(chain, storage, chainConfig)
-
 	logger.Info("Enclave service created with following config", log.CfgKey, config.HostID)
-	return &enclaveImpl{
+	enclave := &enclaveImpl{
 		config:                 config,
+		chainConfig:            chainConfig,
 		storage:                storage,
 		blockResolver:          storage,
 		l1BlockProcessor:       blockProcessor,
 		rollupConsumer:         rConsumer,
-		l1Blockchain:           l1Blockchain,
+		l1BlockValidator:       l1BlockValidator,
 		rpcEncryptionManager:   rpcEncryptionManager,
 		subscriptionManager:    subscriptionManager,
 		crossChainProcessors:   crossChainProcessors,
@@ -256,17 +384,28 @@ func NewEnclave(
 		dataCompressionService: dataCompressionService,
 		gethEncodingService:    gethEncodingService,
 		profiler:               prof,
+		metrics:                metricsRegistry,
+		tracerProvider:         tracerProvider,
+		tracer:                 tracer,
 		logger:                 logger,
+		logLevels:              logLevels,
 		debugger:               debug,
-		stopControl:            stopcontrol.New(),
+		stopControl:            stopControl,
 
 		chain:     chain,
 		registry:  registry,
 		service:   service,
 		gasOracle: gasOracle,
-
-		mainMutex: sync.Mutex{},
+		mempool:   mempool,
+	}
+	if replayNeeded {
+		// the enclave reports the Recovering status until the replay completes, then it starts producing the batches
+		enclave.replay.start(registry.HeadBatchSeq().Uint64())
+		go enclave.restoreStateDBCache(batchExecutor, genesis)
+	} else if config.EnclaveBatchProduction {
+		go enclave.produceBatches()
 	}
+	return enclave, nil
 }
 
 func (e *enclaveImpl) GetBatch(hash common.L2BatchHash) (*common.ExtBatch, common.SystemError) {
@@ -282,8 +421,23 @@ func (e *enclaveImpl) GetBatch(hash common.L2BatchHash) (*common.ExtBatch, commo
 	return b, nil
 }
 
+// GetBatchBySeqNo - the seq number 0 precedes the genesis batch, and is requested by the consumers which start from the
+// beginning of the chain, so it returns the genesis batch
 func (e *enclaveImpl) GetBatchBySeqNo(seqNo uint64) (*common.ExtBatch, common.SystemError) {
-	batch, err := e.storage.FetchBatchBySeqNo(seqNo)
+	batch, err := e.storage.FetchBatchBySeqNo(fromGenesis(seqNo))
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("failed getting batch. Cause: %w", err))
+	}
+
+	b, err := batch.ToExtBatch(e.dataEncryptionService, e.dataCompressionService)
+	if err != nil {
+		return nil, responses.ToInternalError(err)
+	}
+	return b, nil
+}
+
+func (e *enclaveImpl) GetBatchByHeight(height uint64) (*common.ExtBatch, common.SystemError) {
+	batch, err := e.storage.FetchBatchByHeight(height)
 	if err != nil {
 		return nil, responses.ToInternalError(fmt.Errorf("failed getting batch. Cause: %w", err))
 	}
@@ -295,38 +449,164 @@ func (e *enclaveImpl) GetBatchBySeqNo(seqNo uint64) (*common.ExtBatch, common.Sy
 	return b, nil
 }
 
+func (e *enclaveImpl) GetBatchesBySeqRange(fromSeqNo uint64, toSeqNo uint64, maxCount uint64) ([]*common.ExtBatch, common.SystemError) {
+	if fromSeqNo > toSeqNo {
+		return nil, responses.ToInternalError(fmt.Errorf("invalid batch range. From: %d is after to: %d", fromSeqNo, toSeqNo))
+	}
+	if maxCount == 0 || maxCount > common.MaxBatchesPerRange {
+		maxCount = common.MaxBatchesPerRange
+	}
+
+	batches, err := e.storage.FetchBatchesBySeqRange(fromGenesis(fromSeqNo), toSeqNo, maxCount)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("failed getting batches. Cause: %w", err))
+	}
+
+	extBatches := make([]*common.ExtBatch, len(batches))
+	for i, batch := range batches {
+		extBatches[i], err = batch.ToExtBatch(e.dataEncryptionService, e.dataCompressionService)
+		if err != nil {
+			return nil, responses.ToInternalError(err)
+		}
+	}
+	return extBatches, nil
+}
+
+// fromGenesis - the seq numbers before the genesis batch designate the beginning of the chain
+func fromGenesis(seqNo uint64) uint64 {
+	if seqNo < common.L2GenesisSeqNo {
+		return common.L2GenesisSeqNo
+	}
+	return seqNo
+}
+
+func (e *enclaveImpl) GetBatchExecutionSummary(seqNo uint64) (*common.ExecutedBatchSummary, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetBatchExecutionSummary with the enclave stopping"))
+	}
+	summary, err := e.storage.FetchBatchExecutionSummary(seqNo)
+	if errors.Is(err, errutil.ErrNotFound) {
+		// the batch was not executed yet, or was executed before the summaries were recorded
+		return nil, responses.WithCode(responses.ErrCodeNotFound, fmt.Errorf("no execution summary for batch %d", seqNo))
+	}
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("failed getting the execution summary of batch %d. Cause: %w", seqNo, err))
+	}
+	return summary, nil
+}
+
+func (e *enclaveImpl) GetBatchExecutionSummaries(fromSeqNo uint64, toSeqNo uint64, maxCount uint64) ([]*common.ExecutedBatchSummary, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetBatchExecutionSummaries with the enclave stopping"))
+	}
+	if fromSeqNo > toSeqNo {
+		return nil, responses.ToInternalError(fmt.Errorf("invalid batch range. From: %d is after to: %d", fromSeqNo, toSeqNo))
+	}
+	if maxCount == 0 || maxCount > common.MaxBatchesPerRange {
+		maxCount = common.MaxBatchesPerRange
+	}
+
+	summaries, err := e.storage.FetchBatchExecutionSummaries(fromSeqNo, toSeqNo, maxCount)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("failed getting the batch execution summaries. Cause: %w", err))
+	}
+	return summaries, nil
+}
+
+func (e *enclaveImpl) GetRollupData(hash common.L2RollupHash) (*common.PublicRollupMetadata, common.SystemError) {
+	rollup, err := e.storage.FetchRollupMetadata(hash)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("failed getting rollup. Cause: %w", err))
+	}
+	return rollup, nil
+}
+
+func (e *enclaveImpl) GetRollupByBatchSeqNo(seqNo uint64) (*common.PublicRollupMetadata, common.SystemError) {
+	rollup, err := e.storage.FetchRollupMetadataForBatch(seqNo)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("failed getting rollup for batch %d. Cause: %w", seqNo, err))
+	}
+	return rollup, nil
+}
+
 // Status is only implemented by the RPC wrapper
 func (e *enclaveImpl) Status() (common.Status, common.SystemError) {
 	if e.stopControl.IsStopping() {
 		return common.Status{StatusCode: common.Unavailable}, responses.ToInternalError(fmt.Errorf("requested Status with the enclave stopping"))
 	}
 
+	status := common.Status{
+		L2Head:     _noHeadBatch,
+		Version:    Version,
+		NodeType:   e.config.NodeType,
+		EnclaveID:  e.enclaveKey.EnclaveID(),
+		WillAttest: e.config.WillAttest,
+	}
+
 	_, err := e.storage.FetchSecret()
 	if err != nil {
 		if errors.Is(err, errutil.ErrNotFound) {
-			return common.Status{StatusCode: common.AwaitingSecret, L2Head: _noHeadBatch}, nil
+			status.StatusCode = common.AwaitingSecret
+			return status, nil
 		}
 		return common.Status{StatusCode: common.Unavailable}, responses.ToInternalError(err)
 	}
-	var l1HeadHash gethcommon.Hash
+	status.StatusCode = common.Running
+	status.Replay = e.replay.Status()
+	if !e.replay.restored() {
+		status.StatusCode = common.Recovering
+		if status.Replay.Error != "" {
+			// the replay failed, so the enclave will not recover on its own
+			status.StatusCode = common.Unavailable
+		}
+	}
+	status.HasSecret = true
+	status.Ready = e.registry.IsReady()
+	status.StateRetention = e.storage.StateRetention()
+	if executedSeqNo := e.registry.HeadBatchSeq(); executedSeqNo != nil {
+		status.OldestStateSeqNo = e.storage.OldestStateSeqNo(executedSeqNo.Uint64())
+	}
+	status.TxPool = e.mempool.Status()
+	if sequencer, ok := e.service.(nodetype.Sequencer); ok {
+		status.BatchGasLimit = sequencer.BatchGasLimit()
+		status.BatchProductionPaused = e.batchProductionPaused.Load()
+	}
+
 	l1Head, err := e.l1BlockProcessor.GetHead()
 	if err != nil {
 		// this might be normal while enclave is starting up, just send empty hash
 		e.logger.Debug("failed to fetch L1 head block for status response", log.ErrKey, err)
 	} else {
-		l1HeadHash = l1Head.Hash()
+		status.L1Head = l1Head.Hash()
 	}
 	// we use zero when there's no head batch yet, the first seq number is 1
-	l2HeadSeqNo := _noHeadBatch
 	// this is the highest seq number that has been received and stored on the enclave (it may not have been executed)
 	currSeqNo, err := e.storage.FetchCurrentSequencerNo()
 	if err != nil {
 		// this might be normal while enclave is starting up, just send empty hash
 		e.logger.Debug("failed to fetch L2 head batch for status response", log.ErrKey, err)
+		return status, nil
+	}
+	status.L2Head = currSeqNo
+	if executedSeqNo := e.registry.HeadBatchSeq(); executedSeqNo != nil {
+		status.L2ExecutedHead = executedSeqNo
+	} else {
+		status.L2ExecutedHead = _noHeadBatch
+	}
+	headBatch, err := e.storage.FetchBatchBySeqNo(currSeqNo.Uint64())
+	if err != nil {
+		e.logger.Debug("failed to fetch L2 head batch for status response", log.ErrKey, err)
 	} else {
-		l2HeadSeqNo = currSeqNo
+		status.L2HeadHash = headBatch.Hash()
 	}
-	return common.Status{StatusCode: common.Running, L1Head: l1HeadHash, L2Head: l2HeadSeqNo}, nil
+	latestRollup, err := e.storage.FetchLatestRollupMetadata()
+	if err != nil {
+		// normal until the first rollup is published
+		e.logger.Debug("failed to fetch the latest rollup for status response", log.ErrKey, err)
+	} else {
+		status.LatestRollup = latestRollup
+	}
+	return status, nil
 }
 
 // StopClient is only implemented by the RPC wrapper
@@ -334,7 +614,9 @@ func (e *enclaveImpl) StopClient() common.SystemError {
 	return nil // The enclave is local so there is no client to stop
 }
 
-func (e *enclaveImpl) sendBatch(batch *core.Batch, outChannel chan common.StreamL2UpdatesResponse) {
+// sendBatch - streams the batch along with the logs it emitted for the subscriptions, which only exist when the batch
+// is the new head, so they are compressed together when the host asked for compressed updates
+func (e *enclaveImpl) sendBatch(batch *core.Batch, receipts types.Receipts, stream *l2UpdatesStream) {
 	e.logger.Info("Streaming batch to host", log.BatchHashKey, batch.Hash(), log.BatchSeqNoKey, batch.SeqNo())
 	extBatch, err := batch.ToExtBatch(e.dataEncryptionService, e.dataCompressionService)
 	if err != nil {
@@ -344,82 +626,182 @@ func (e *enclaveImpl) sendBatch(batch *core.Batch, outChannel chan common.Stream
 	resp := common.StreamL2UpdatesResponse{
 		Batch: extBatch,
 	}
-	outChannel <- resp
+	if receipts != nil {
+		resp.Logs = e.subscribedLogsForNewHeadBatch(batch, receipts)
+	}
+	stream.send(resp)
 }
 
 // this function is only called when the executed batch is the new head
-func (e *enclaveImpl) streamEventsForNewHeadBatch(batch *core.Batch, receipts types.Receipts, outChannel chan common.StreamL2UpdatesResponse) {
+func (e *enclaveImpl) subscribedLogsForNewHeadBatch(batch *core.Batch, receipts types.Receipts) common.EncryptedSubscriptionLogs {
 	logs, err := e.subscriptionManager.GetSubscribedLogsForBatch(batch, receipts)
 	e.logger.Debug("Stream Events for", log.BatchHashKey, batch.Hash(), "nr_events", len(logs))
 	if err != nil {
 		e.logger.Error("Error while getting subscription logs", log.ErrKey, err)
+		return nil
+	}
+	return logs
+}
+
+// streamNewHead - streams the converted header of the new head batch to the newHeads subscriptions. The batches
+// recovered from rollups, which have no receipts, are new heads too.
+func (e *enclaveImpl) streamNewHead(batch *core.Batch, stream *l2UpdatesStream) {
+	heads, err := e.subscriptionManager.GetNewHeadsForBatch(batch)
+	if err != nil {
+		e.logger.Error("Error while getting the new head for the subscriptions", log.ErrKey, err)
 		return
 	}
-	if logs != nil {
-		outChannel <- common.StreamL2UpdatesResponse{
-			Logs: logs,
-		}
+	if heads != nil {
+		stream.send(common.StreamL2UpdatesResponse{
+			NewHeads: heads,
+		})
 	}
 }
 
 func (e *enclaveImpl) StreamL2Updates() (chan common.StreamL2UpdatesResponse, func()) {
-	l2UpdatesChannel := make(chan common.StreamL2UpdatesResponse, 100)
+	stream := newL2UpdatesStream()
 
-	if e.stopControl.IsStopping() {
-		close(l2UpdatesChannel)
-		return l2UpdatesChannel, func() {}
+	if !e.stopControl.Enter() {
+		stream.close()
+		return stream.updates, func() {}
 	}
+	defer e.stopControl.Exit()
+
+	e.streamMutex.Lock()
+	e.l2Updates = stream
+	e.streamMutex.Unlock()
 
 	e.registry.SubscribeForExecutedBatches(func(batch *core.Batch, receipts types.Receipts) {
-		e.sendBatch(batch, l2UpdatesChannel)
-		if receipts != nil {
-			e.streamEventsForNewHeadBatch(batch, receipts, l2UpdatesChannel)
-		}
+		e.sendBatch(batch, receipts, stream)
+		e.streamNewHead(batch, stream)
 	})
+	// the historical logs requested by new subscriptions are streamed alongside the live ones
+	e.subscriptionManager.SetBackfillSink(func(logs common.EncryptedSubscriptionLogs) {
+		stream.send(common.StreamL2UpdatesResponse{
+			Logs: logs,
+		})
+	})
+
+	return stream.updates, func() {
+		e.streamMutex.Lock()
+		if e.l2Updates == stream {
+			e.l2Updates = nil
+		}
+		e.streamMutex.Unlock()
+		// the host stopped reading, so the senders in progress must not wait for it. They are released first, as the
+		// callbacks run under the locks taken to unsubscribe them.
+		stream.close()
 
-	return l2UpdatesChannel, func() {
 		e.registry.UnsubscribeFromBatches()
+		e.subscriptionManager.SetBackfillSink(nil)
+	}
+}
+
+// openL2UpdatesStream - the open stream, or nil when the host is not streaming
+func (e *enclaveImpl) openL2UpdatesStream() *l2UpdatesStream {
+	e.streamMutex.Lock()
+	defer e.streamMutex.Unlock()
+	return e.l2Updates
+}
+
+// closeL2UpdatesStream - the senders still running, if the requests did not finish in time, drop their updates
+func (e *enclaveImpl) closeL2UpdatesStream() {
+	e.streamMutex.Lock()
+	stream := e.l2Updates
+	e.l2Updates = nil
+	e.streamMutex.Unlock()
+	if stream != nil {
+		stream.close()
 	}
 }
 
 // SubmitL1Block is used to update the enclave with an additional L1 block.
-func (e *enclaveImpl) SubmitL1Block(block types.Block, receipts types.Receipts, _ bool) (*common.BlockSubmissionResponse, common.SystemError) {
-	if e.stopControl.IsStopping() {
+func (e *enclaveImpl) SubmitL1Block(ctx context.Context, block types.Block, receipts types.Receipts, receiptProofs common.L1ReceiptProofs, _ bool) (_ *common.BlockSubmissionResponse, err common.SystemError) {
+	if !e.stopControl.Enter() {
 		return nil, responses.ToInternalError(fmt.Errorf("requested SubmitL1Block with the enclave stopping"))
 	}
+	defer e.stopControl.Exit()
+	if !e.replay.restored() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested SubmitL1Block with the enclave recovering its state"))
+	}
 
-	e.mainMutex.Lock()
-	defer e.mainMutex.Unlock()
+	e.l1Mutex.Lock()
+	defer e.l1Mutex.Unlock()
 
 	e.logger.Info("SubmitL1Block", log.BlockHeightKey, block.Number(), log.BlockHashKey, block.Hash())
 
+	ctx, span := e.tracer.Start(ctx, "SubmitL1Block", trace.WithAttributes(
+		tracing.BlockHashKey.String(block.Hash().Hex()),
+		tracing.BlockHeightKey.Int64(block.Number().Int64()),
+	))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	// If the block and receipts do not match, reject the block.
-	br, err := common.ParseBlockAndReceipts(&block, &receipts)
-	if err != nil {
-		return nil, e.rejectBlockErr(fmt.Errorf("could not submit L1 block. Cause: %w", err))
+	trackedAddresses := []gethcommon.Address{e.config.ManagementContractAddress, e.config.MessageBusAddress}
+	br, parseErr := common.ParseBlockAndReceipts(&block, &receipts, receiptProofs, trackedAddresses)
+	if parseErr != nil {
+		return nil, e.rejectBlockErr(fmt.Errorf("could not submit L1 block. Cause: %w", parseErr))
 	}
 
-	result, err := e.ingestL1Block(br)
-	if err != nil {
-		return nil, e.rejectBlockErr(fmt.Errorf("could not submit L1 block. Cause: %w", err))
+	// local test networks run without validation
+	if e.l1BlockValidator != nil {
+		if validationErr := e.l1BlockValidator.Validate(br); validationErr != nil {
+			if errors.Is(validationErr, errutil.ErrInvalidL1Block) {
+				e.logger.Warn("Rejected invalid L1 block", log.BlockHashKey, block.Hash(), log.ErrKey, validationErr)
+			}
+			return nil, e.rejectSubmittedBlockErr(&block, fmt.Errorf("could not submit L1 block. Cause: %w", validationErr))
+		}
+	}
+
+	if err := e.ingestAndProcessL1Block(ctx, br); err != nil {
+		return nil, err
+	}
+
+	bsr := &common.BlockSubmissionResponse{ProducedSecretResponses: e.sharedSecretProcessor.ProcessNetworkSecretMsgs(br)}
+	return bsr, nil
+}
+
+// ingestAndProcessL1Block - the new L1 head and its effects on the L2 chain (the batches of the rollups, the batches
+// duplicated on a fork) are stored under the L2 head lock, so no batch is built on an L1 block before it was ingested
+func (e *enclaveImpl) ingestAndProcessL1Block(ctx context.Context, br *common.BlockAndReceipts) common.SystemError {
+	e.l2HeadMutex.Lock()
+	defer e.l2HeadMutex.Unlock()
+
+	result, ingestErr := e.ingestL1Block(ctx, br)
+	if ingestErr != nil {
+		return e.rejectSubmittedBlockErr(br.Block, fmt.Errorf("could not submit L1 block. Cause: %w", ingestErr))
 	}
 
 	if result.IsFork() {
-		e.logger.Info(fmt.Sprintf("Detected fork at block %s with height %d", block.Hash(), block.Number()))
+		e.logger.Info(fmt.Sprintf("Detected fork at block %s with height %d", br.Block.Hash(), br.Block.Number()))
 	}
 
-	err = e.service.OnL1Block(block, result)
-	if err != nil {
-		return nil, e.rejectBlockErr(fmt.Errorf("could not submit L1 block. Cause: %w", err))
+	if err := e.service.OnL1Block(ctx, *br.Block, result); err != nil {
+		return e.rejectBlockErr(fmt.Errorf("could not submit L1 block. Cause: %w", err))
 	}
+	return nil
+}
 
-	bsr := &common.BlockSubmissionResponse{ProducedSecretResponses: e.sharedSecretProcessor.ProcessNetworkSecretMsgs(br)}
-	return bsr, nil
+// SubmitL1Headers - only reads the stored blocks, the blocks are submitted afterwards with SubmitL1Block
+func (e *enclaveImpl) SubmitL1Headers(headers []*types.Header) (*common.L1ForkPoint, common.SystemError) {
+	if !e.stopControl.Enter() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested SubmitL1Headers with the enclave stopping"))
+	}
+	defer e.stopControl.Exit()
+
+	e.l1Mutex.Lock()
+	defer e.l1Mutex.Unlock()
+
+	forkPoint, err := e.l1BlockProcessor.FindForkPoint(headers)
+	if err != nil {
+		return nil, e.rejectBlockErr(fmt.Errorf("could not find the l1 fork point. Cause: %w", err))
+	}
+	return forkPoint, nil
 }
 
-func (e *enclaveImpl) ingestL1Block(br *common.BlockAndReceipts) (*components.BlockIngestionType, error) {
+func (e *enclaveImpl) ingestL1Block(ctx context.Context, br *common.BlockAndReceipts) (*components.BlockIngestionType, error) {
 	e.logger.Info("Start ingesting block", log.BlockHashKey, br.Block.Hash())
-	ingestion, err := e.l1BlockProcessor.Process(br)
+	ingestion, err := e.l1BlockProcessor.Process(ctx, br)
 	if err != nil {
 		// only warn for unexpected errors
 		if errors.Is(err, errutil.ErrBlockAncestorNotFound) || errors.Is(err, errutil.ErrBlockAlreadyProcessed) {
@@ -430,26 +812,132 @@ func (e *enclaveImpl) ingestL1Block(br *common.BlockAndReceipts) (*components.Bl
 		return nil, err
 	}
 
-	err = e.rollupConsumer.ProcessRollupsInBlock(br)
+	err = e.rollupConsumer.ProcessRollupsInBlock(ctx, br)
 	if err != nil && !errors.Is(err, components.ErrDuplicateRollup) {
-		e.logger.Error("Encountered error while processing l1 block", log.ErrKey, err)
-		// Unsure what to do here; block has been stored
+		// the block is stored, and the rollups which were not processed are retried with the next block
+		e.logger.Error("Could not process the rollups. They will be retried with the next block", log.BlockHashKey, br.Block.Hash(), log.ErrKey, err)
 	}
 
 	if ingestion.IsFork() {
-		err := e.service.OnL1Fork(ingestion.ChainFork)
+		// the batches of the dead branch are read before the sequencer duplicates them on the canonical one
+		orphans, err := e.registry.OrphanedBatches(ingestion.ChainFork.NonCanonicalPath)
 		if err != nil {
 			return nil, err
 		}
+		err = e.service.OnL1Fork(ingestion.ChainFork)
+		if err != nil {
+			return nil, err
+		}
+		if err = e.streamL2Fork(orphans); err != nil {
+			e.logger.Error("Could not stream the L2 fork", log.ErrKey, err)
+		}
 	}
 	return ingestion, nil
 }
 
-func (e *enclaveImpl) SubmitTx(encryptedTxParams common.EncryptedTx) (*responses.RawTx, common.SystemError) {
+func (e *enclaveImpl) SubmitTx(encryptedTxParams common.EncryptedTx) (*common.SubmitTxResponse, common.SystemError) {
 	if e.stopControl.IsStopping() {
 		return nil, responses.ToInternalError(fmt.Errorf("requested SubmitTx with the enclave stopping"))
 	}
-	return rpc.WithVKEncryption(e.rpcEncryptionManager, encryptedTxParams, rpc.SubmitTxValidate, rpc.SubmitTxExecute)
+
+	var validatedTx *common.L2Tx
+	enclaveResponse, sysErr := rpc.WithVKEncryption(context.Background(), e.rpcEncryptionManager, "submitTx", encryptedTxParams, rpc.SubmitTxValidate,
+		func(ctx context.Context, builder *rpc.CallBuilder[common.L2Tx, gethcommon.Hash], rpcManager *rpc.EncryptionManager) error {
+			err := rpc.SubmitTxExecute(ctx, builder, rpcManager)
+			if err == nil && builder.Err == nil {
+				validatedTx = builder.Param
+			}
+			return err
+		})
+	if sysErr != nil {
+		return nil, sysErr
+	}
+
+	response := &common.SubmitTxResponse{Response: enclaveResponse}
+	// the validators only check the transaction, it is up to the sequencer to include it in a batch
+	if validatedTx != nil && e.config.NodeType != common.Sequencer {
+		forwardTx, err := e.encryptForwardedTx(validatedTx)
+		if err != nil {
+			return nil, responses.ToInternalError(fmt.Errorf("could not encrypt tx for forwarding. Cause: %w", err))
+		}
+		response.ForwardTx = forwardTx
+	}
+	return response, nil
+}
+
+// SubmitForwardedTx - the transaction was already validated and decrypted by a validator enclave, so there is no
+// viewing key involved. A tx received both directly and through forwarding is only added once, as the mempool
+// rejects the transactions it holds or which were included in a recent batch.
+func (e *enclaveImpl) SubmitForwardedTx(encryptedTx common.EncryptedForwardedTx) common.SystemError {
+	if e.stopControl.IsStopping() {
+		return responses.ToInternalError(fmt.Errorf("requested SubmitForwardedTx with the enclave stopping"))
+	}
+	if e.config.NodeType != common.Sequencer {
+		return responses.ToInternalError(fmt.Errorf("forwarded transactions are only accepted by the sequencer"))
+	}
+
+	tx, err := e.decryptForwardedTx(encryptedTx)
+	if err != nil {
+		return responses.ToInternalError(fmt.Errorf("could not decrypt forwarded tx. Cause: %w", err))
+	}
+	if e.crossChainProcessors.Local.IsSyntheticTransaction(*tx) {
+		return responses.ToInternalError(fmt.Errorf("synthetic transaction forwarded by a validator"))
+	}
+
+	err = e.service.SubmitTransaction(tx)
+	switch {
+	case errors.Is(err, gethtxpool.ErrAlreadyKnown):
+		e.logger.Debug("Forwarded transaction is already known", log.TxKey, tx.Hash())
+	case err != nil:
+		// the state of the sequencer can be ahead of the validator, so this is not a system error
+		e.logger.Debug("Could not submit forwarded transaction", log.TxKey, tx.Hash(), log.ErrKey, err)
+	}
+	return nil
+}
+
+func (e *enclaveImpl) encryptForwardedTx(tx *common.L2Tx) (common.EncryptedForwardedTx, error) {
+	encryption, err := e.forwardedTxEncryptionService()
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("could not encode tx. Cause: %w", err)
+	}
+	return encryption.Encrypt(txBytes)
+}
+
+func (e *enclaveImpl) decryptForwardedTx(encryptedTx common.EncryptedForwardedTx) (*common.L2Tx, error) {
+	encryption, err := e.forwardedTxEncryptionService()
+	if err != nil {
+		return nil, err
+	}
+	if len(encryptedTx) < crypto.NonceLength {
+		return nil, fmt.Errorf("forwarded tx is too short")
+	}
+	txBytes, err := encryption.Decrypt(encryptedTx)
+	if err != nil {
+		return nil, err
+	}
+	tx := &common.L2Tx{}
+	if err := tx.UnmarshalBinary(txBytes); err != nil {
+		return nil, fmt.Errorf("could not decode tx. Cause: %w", err)
+	}
+	return tx, nil
+}
+
+func (e *enclaveImpl) forwardedTxEncryptionService() (crypto.DataEncryptionService, error) {
+	e.forwardedTxMutex.Lock()
+	defer e.forwardedTxMutex.Unlock()
+	if e.forwardedTxEncryption != nil {
+		return e.forwardedTxEncryption, nil
+	}
+	secret, err := e.storage.FetchSecret()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the shared secret. Cause: %w", err)
+	}
+	e.forwardedTxEncryption = crypto.NewSecretDataEncryptionService(secret, forwardedTxEncryptionPurpose, e.logger)
+	return e.forwardedTxEncryption, nil
 }
 
 func (e *enclaveImpl) Validator() nodetype.ObsValidator {
@@ -468,101 +956,283 @@ func (e *enclaveImpl) Sequencer() nodetype.Sequencer {
 	return sequencer
 }
 
-func (e *enclaveImpl) SubmitBatch(extBatch *common.ExtBatch) common.SystemError {
-	if e.stopControl.IsStopping() {
-		return responses.ToInternalError(fmt.Errorf("requested SubmitBatch with the enclave stopping"))
+func (e *enclaveImpl) SubmitBatch(ctx context.Context, extBatch *common.ExtBatch) (summary *common.BatchExecutionSummary, err common.SystemError) {
+	if !e.stopControl.Enter() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested SubmitBatch with the enclave stopping"))
+	}
+	defer e.stopControl.Exit()
+	if !e.replay.restored() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested SubmitBatch with the enclave recovering its state"))
 	}
 
 	defer core.LogMethodDuration(e.logger, measure.NewStopwatch(), "SubmitBatch call completed.", log.BatchHashKey, extBatch.Hash())
 
 	e.logger.Info("Received new p2p batch", log.BatchHeightKey, extBatch.Header.Number, log.BatchHashKey, extBatch.Hash(), "l1", extBatch.Header.L1Proof)
 	seqNo := extBatch.Header.SequencerOrderNo.Uint64()
+
+	ctx, span := e.tracer.Start(ctx, "SubmitBatch", trace.WithAttributes(
+		tracing.BatchHashKey.String(extBatch.Hash().Hex()),
+		tracing.BatchSeqNoKey.Int64(int64(seqNo)),
+	))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	if seqNo > common.L2GenesisSeqNo+1 {
 		_, err := e.storage.FetchBatchBySeqNo(seqNo - 1)
 		if err != nil {
-			return responses.ToInternalError(fmt.Errorf("could not find previous batch with seq: %d", seqNo-1))
+			return nil, responses.ToInternalError(fmt.Errorf("could not find previous batch with seq: %d", seqNo-1))
 		}
 	}
 
-	batch, err := core.ToBatch(extBatch, e.dataEncryptionService, e.dataCompressionService)
-	if err != nil {
-		return responses.ToInternalError(fmt.Errorf("could not convert batch. Cause: %w", err))
+	batch, convertedHash, sysErr := e.verifyAndConvertBatch(extBatch)
+	if sysErr != nil {
+		return nil, sysErr
 	}
 
-	err = e.Validator().VerifySequencerSignature(batch)
-	if err != nil {
-		return responses.ToInternalError(fmt.Errorf("invalid batch received. Could not verify signature. Cause: %w", err))
+	// only storing and executing the batch changes the L2 head
+	e.l2HeadMutex.Lock()
+	defer e.l2HeadMutex.Unlock()
+
+	// if the signature is valid, then store the batch together with the converted hash
+	_, storeSpan := tracing.StartSpan(ctx, "storage.StoreBatch", tracing.BatchHashKey.String(extBatch.Hash().Hex()))
+	storeErr := e.storage.StoreBatch(batch, convertedHash)
+	tracing.EndSpan(storeSpan, storeErr)
+	if storeErr != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not store batch. Cause: %w", storeErr))
 	}
 
-	// calculate the converted hash, and store it in the db for chaining of the converted chain
-	convertedHeader, err := e.gethEncodingService.CreateEthHeaderForBatch(extBatch.Header)
-	if err != nil {
-		return err
+	summary, execErr := e.Validator().ExecuteStoredBatches(ctx)
+	if execErr != nil {
+		return summary, responses.ToInternalError(fmt.Errorf("could not execute batches. Cause: %w", execErr))
 	}
+	if summary.StoppedAtSeqNo != 0 {
+		e.logger.Info("Stored batches waiting to be executed", log.BatchSeqNoKey, summary.StoppedAtSeqNo, "reason", summary.StopReason)
+	}
+	return summary, nil
+}
 
-	e.mainMutex.Lock()
-	defer e.mainMutex.Unlock()
+// verifyAndConvertBatch - the signature is verified on the header, while the transactions are decrypted and decompressed,
+// and the converted header is derived. Whichever finishes first, a bad signature is reported before a conversion error.
+func (e *enclaveImpl) verifyAndConvertBatch(extBatch *common.ExtBatch) (*core.Batch, gethcommon.Hash, common.SystemError) {
+	sigErrChan := make(chan error, 1)
+	go func() {
+		sigErrChan <- e.Validator().VerifySequencerSignature(extBatch)
+	}()
+
+	batch, convErr := core.ToBatch(extBatch, e.dataEncryptionService, e.dataCompressionService)
+	var convertedHeader *types.Header
+	if convErr == nil {
+		// calculate the converted hash, and store it in the db for chaining of the converted chain
+		convertedHeader, convErr = e.gethEncodingService.CreateEthHeaderForBatch(extBatch.Header)
+		if convErr != nil {
+			convErr = responses.ToInternalError(fmt.Errorf("could not convert batch header. Cause: %w", convErr))
+		}
+	} else {
+		convErr = responses.ToInternalError(fmt.Errorf("could not convert batch. Cause: %w", convErr))
+	}
 
-	// if the signature is valid, then store the batch together with the converted hash
-	err = e.storage.StoreBatch(batch, convertedHeader.Hash())
-	if err != nil {
-		return responses.ToInternalError(fmt.Errorf("could not store batch. Cause: %w", err))
+	if sigErr := <-sigErrChan; sigErr != nil {
+		return nil, gethcommon.Hash{}, responses.ToInternalError(fmt.Errorf("invalid batch received. Could not verify signature. Cause: %w", sigErr))
+	}
+	if convErr != nil {
+		return nil, gethcommon.Hash{}, convErr
+	}
+	return batch, convertedHeader.Hash(), nil
+}
+
+func (e *enclaveImpl) CreateBatch(skipBatchIfEmpty bool) (*common.CreateBatchResult, common.SystemError) {
+	defer core.LogMethodDuration(e.logger, measure.NewStopwatch(), "CreateBatch call ended")
+	if !e.stopControl.Enter() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested CreateBatch with the enclave stopping"))
+	}
+	defer e.stopControl.Exit()
+	if !e.replay.restored() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested CreateBatch with the enclave recovering its state"))
 	}
 
-	err = e.Validator().ExecuteStoredBatches()
+	if e.config.EnclaveBatchProduction {
+		return &common.CreateBatchResult{SkipReason: common.BatchSkippedInEnclave}, nil
+	}
+	return e.createBatch(skipBatchIfEmpty)
+}
+
+// createBatch - must be called as part of an operation registered with the stop control
+func (e *enclaveImpl) createBatch(skipBatchIfEmpty bool) (*common.CreateBatchResult, common.SystemError) {
+	e.l2HeadMutex.Lock()
+	defer e.l2HeadMutex.Unlock()
+
+	if e.batchProductionPaused.Load() {
+		e.logger.Debug("Skipping batch production, the batch production is paused")
+		return &common.CreateBatchResult{SkipReason: common.BatchSkippedPaused}, nil
+	}
+
+	batch, err := e.Sequencer().CreateBatch(skipBatchIfEmpty)
 	if err != nil {
-		return responses.ToInternalError(fmt.Errorf("could not execute batches. Cause: %w", err))
+		if errors.Is(err, errutil.ErrNotReady) {
+			return nil, errutil.ErrNotReady
+		}
+		return nil, responses.ToInternalError(err)
+	}
+	if batch == nil {
+		return &common.CreateBatchResult{SkipReason: common.BatchSkippedEmpty}, nil
+	}
+
+	return &common.CreateBatchResult{
+		Produced:  true,
+		BatchHash: batch.Hash(),
+		SeqNo:     batch.SeqNo().Uint64(),
+		TxCount:   len(batch.Transactions),
+	}, nil
+}
+
+// produceBatches - produces a batch every BatchInterval, when the enclave drives the batch production. Like when the host
+// drives it, the empty batches are skipped until MaxBatchInterval elapsed since the last batch.
+func (e *enclaveImpl) produceBatches() {
+	if !e.stopControl.Enter() {
+		return
+	}
+	defer e.stopControl.Exit()
+	e.logger.Info("Starting the batch production", "interval", e.config.BatchInterval)
+	defer e.logger.Info("Stopping the batch production")
+
+	ticker := time.NewTicker(e.config.BatchInterval)
+	defer ticker.Stop()
+	lastBatchProduced := time.Now()
+	for {
+		select {
+		case <-e.stopControl.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// the batches are built on the L1 head, so there is nothing to produce before the first block is ingested
+		if _, err := e.l1BlockProcessor.GetHead(); err != nil {
+			continue
+		}
+		skipIfEmpty := e.config.MaxBatchInterval > e.config.BatchInterval && time.Since(lastBatchProduced) < e.config.MaxBatchInterval
+		result, err := e.createBatch(skipIfEmpty)
+		if err != nil {
+			e.logger.Warn("Could not produce batch", log.ErrKey, err)
+			continue
+		}
+		if result.Produced {
+			lastBatchProduced = time.Now()
+		}
 	}
+}
+
+func (e *enclaveImpl) PauseBatchProduction() common.SystemError {
+	return e.setBatchProductionPaused(true)
+}
+
+func (e *enclaveImpl) ResumeBatchProduction() common.SystemError {
+	return e.setBatchProductionPaused(false)
+}
 
+func (e *enclaveImpl) setBatchProductionPaused(paused bool) common.SystemError {
+	if !e.stopControl.Enter() {
+		return responses.ToInternalError(fmt.Errorf("requested to change the batch production with the enclave stopping"))
+	}
+	defer e.stopControl.Exit()
+
+	if _, ok := e.service.(nodetype.Sequencer); !ok {
+		return errutil.ErrNotSequencer
+	}
+
+	// waits for the batch in production, so no batch is created once the production is paused
+	e.l2HeadMutex.Lock()
+	defer e.l2HeadMutex.Unlock()
+
+	if e.batchProductionPaused.Swap(paused) != paused {
+		e.logger.Info("Batch production changed", "paused", paused)
+	}
 	return nil
 }
 
-func (e *enclaveImpl) CreateBatch(skipBatchIfEmpty bool) common.SystemError {
-	defer core.LogMethodDuration(e.logger, measure.NewStopwatch(), "CreateBatch call ended")
-	if e.stopControl.IsStopping() {
-		return responses.ToInternalError(fmt.Errorf("requested CreateBatch with the enclave stopping"))
+func (e *enclaveImpl) ReprocessRollup(rollupHash common.L2RollupHash) (*common.RollupProcessing, common.SystemError) {
+	if !e.stopControl.Enter() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested ReprocessRollup with the enclave stopping"))
 	}
+	defer e.stopControl.Exit()
 
-	e.mainMutex.Lock()
-	defer e.mainMutex.Unlock()
+	// the rollups are processed with the L1 blocks, which must not be ingested at the same time
+	e.l2HeadMutex.Lock()
+	defer e.l2HeadMutex.Unlock()
 
-	err := e.Sequencer().CreateBatch(skipBatchIfEmpty)
+	processing, err := e.storage.FetchRollupProcessing(rollupHash)
 	if err != nil {
-		return responses.ToInternalError(err)
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, responses.WithCode(responses.ErrCodeInvalidParams, fmt.Errorf("rollup %s was not published in an ingested block", rollupHash))
+		}
+		return nil, responses.ToInternalError(err)
+	}
+	if processing.Processed() {
+		return processing, nil
 	}
 
-	return nil
+	// the rollups published before it are processed first. A failure is recorded in the returned processing
+	if err := e.rollupConsumer.ProcessPendingRollups(context.Background()); err != nil {
+		e.logger.Warn("Could not reprocess the rollups", log.RollupHashKey, rollupHash, log.ErrKey, err)
+	}
+	processing, err = e.storage.FetchRollupProcessing(rollupHash)
+	if err != nil {
+		return nil, responses.ToInternalError(err)
+	}
+	return processing, nil
 }
 
-func (e *enclaveImpl) CreateRollup(fromSeqNo uint64) (*common.ExtRollup, common.SystemError) {
+func (e *enclaveImpl) CreateRollup(fromSeqNo uint64) (*common.ExtRollup, *common.ExtRollupMetadata, common.SystemError) {
 	defer core.LogMethodDuration(e.logger, measure.NewStopwatch(), "CreateRollup call ended")
-	if e.stopControl.IsStopping() {
-		return nil, responses.ToInternalError(fmt.Errorf("requested GenerateRollup with the enclave stopping"))
+	if !e.stopControl.Enter() {
+		return nil, nil, responses.ToInternalError(fmt.Errorf("requested GenerateRollup with the enclave stopping"))
+	}
+	defer e.stopControl.Exit()
+	if !e.replay.restored() {
+		return nil, nil, responses.ToInternalError(fmt.Errorf("requested GenerateRollup with the enclave recovering its state"))
 	}
 
-	// todo - remove once the db operations are more atomic
-	e.mainMutex.Lock()
-	defer e.mainMutex.Unlock()
+	snapshot, err := e.rollupSnapshot()
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotReady) {
+			return nil, nil, errutil.ErrNotReady
+		}
+		return nil, nil, responses.ToInternalError(err)
+	}
 
-	if e.registry.HeadBatchSeq() == nil {
-		return nil, responses.ToInternalError(fmt.Errorf("not initialised yet"))
+	rollup, metadata, err := e.Sequencer().CreateRollup(fromSeqNo, *snapshot)
+	if err != nil {
+		return nil, nil, responses.ToInternalError(err)
 	}
+	return rollup, metadata, nil
+}
+
+// rollupSnapshot - reads the heads consistently, and releases the L2 head lock before the slow rollup compression
+func (e *enclaveImpl) rollupSnapshot() (*nodetype.RollupSnapshot, error) {
+	e.l2HeadMutex.Lock()
+	defer e.l2HeadMutex.Unlock()
 
-	rollup, err := e.Sequencer().CreateRollup(fromSeqNo)
+	headBatchSeq := e.registry.HeadBatchSeq()
+	if headBatchSeq == nil {
+		return nil, errutil.ErrNotReady
+	}
+	l1Head, err := e.l1BlockProcessor.GetHead()
 	if err != nil {
-		return nil, responses.ToInternalError(err)
+		return nil, fmt.Errorf("could not read the L1 head. Cause: %w", err)
 	}
-	return rollup, nil
+	return &nodetype.RollupSnapshot{HeadBatchSeqNo: headBatchSeq.Uint64(), L1Head: l1Head}, nil
 }
 
 // ObsCall handles param decryption, validation and encryption
 // and requests the Rollup chain to execute the payload (eth_call)
-func (e *enclaveImpl) ObsCall(encryptedParams common.EncryptedParamsCall) (*responses.Call, common.SystemError) {
+func (e *enclaveImpl) ObsCall(ctx context.Context, encryptedParams common.EncryptedParamsCall) (_ *responses.Call, err common.SystemError) {
 	if e.stopControl.IsStopping() {
 		return nil, responses.ToInternalError(fmt.Errorf("requested ObsCall with the enclave stopping"))
 	}
 
-	return rpc.WithVKEncryption(e.rpcEncryptionManager, encryptedParams, rpc.TenCallValidate, rpc.TenCallExecute)
+	// the parameters are encrypted, so the span carries no attributes
+	_, span := e.tracer.Start(ctx, "ObsCall")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	return rpc.WithVKEncryption(ctx, e.rpcEncryptionManager, "call", encryptedParams, rpc.TenCallValidate, rpc.TenCallExecute)
 }
 
 func (e *enclaveImpl) GetTransactionCount(encryptedParams common.EncryptedParamsGetTxCount) (*responses.TxCount, common.SystemError) {
@@ -570,7 +1240,7 @@ func (e *enclaveImpl) GetTransactionCount(encryptedParams common.EncryptedParams
 		return nil, responses.ToInternalError(fmt.Errorf("requested GetTransactionCount with the enclave stopping"))
 	}
 
-	return rpc.WithVKEncryption(e.rpcEncryptionManager, encryptedParams, rpc.GetTransactionCountValidate, rpc.GetTransactionCountExecute)
+	return rpc.WithVKEncryption(context.Background(), e.rpcEncryptionManager, "getTransactionCount", encryptedParams, rpc.GetTransactionCountValidate, rpc.GetTransactionCountExecute)
 }
 
 func (e *enclaveImpl) GetTransaction(encryptedParams common.EncryptedParamsGetTxByHash) (*responses.TxByHash, common.SystemError) {
@@ -578,15 +1248,31 @@ func (e *enclaveImpl) GetTransaction(encryptedParams common.EncryptedParamsGetTx
 		return nil, responses.ToInternalError(fmt.Errorf("requested GetTransaction with the enclave stopping"))
 	}
 
-	return rpc.WithVKEncryption(e.rpcEncryptionManager, encryptedParams, rpc.GetTransactionValidate, rpc.GetTransactionExecute)
+	return rpc.WithVKEncryption(context.Background(), e.rpcEncryptionManager, "getTransaction", encryptedParams, rpc.GetTransactionValidate, rpc.GetTransactionExecute)
 }
 
-func (e *enclaveImpl) GetTransactionReceipt(encryptedParams common.EncryptedParamsGetTxReceipt) (*responses.TxReceipt, common.SystemError) {
+func (e *enclaveImpl) GetTransactionReceipt(ctx context.Context, encryptedParams common.EncryptedParamsGetTxReceipt) (*responses.TxReceipt, common.SystemError) {
 	if e.stopControl.IsStopping() {
 		return nil, responses.ToInternalError(fmt.Errorf("requested GetTransactionReceipt with the enclave stopping"))
 	}
 
-	return rpc.WithVKEncryption(e.rpcEncryptionManager, encryptedParams, rpc.GetTransactionReceiptValidate, rpc.GetTransactionReceiptExecute)
+	return rpc.WithVKEncryption(ctx, e.rpcEncryptionManager, "getTransactionReceipt", encryptedParams, rpc.GetTransactionReceiptValidate, rpc.GetTransactionReceiptExecute)
+}
+
+func (e *enclaveImpl) GetCrossChainMessageProof(encryptedParams common.EncryptedParamsGetMessageProof) (*responses.MessageProof, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetCrossChainMessageProof with the enclave stopping"))
+	}
+
+	return rpc.WithVKEncryption(context.Background(), e.rpcEncryptionManager, "getCrossChainMessageProof", encryptedParams, rpc.GetCrossChainMessageProofValidate, rpc.GetCrossChainMessageProofExecute)
+}
+
+func (e *enclaveImpl) GetCrossChainMessageStatus(encryptedParams common.EncryptedParamsGetMessageStatus) (*responses.MessageStatus, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetCrossChainMessageStatus with the enclave stopping"))
+	}
+
+	return rpc.WithVKEncryption(context.Background(), e.rpcEncryptionManager, "getCrossChainMessageStatus", encryptedParams, rpc.GetCrossChainMessageStatusValidate, rpc.GetCrossChainMessageStatusExecute)
 }
 
 func (e *enclaveImpl) Attestation() (*common.AttestationReport, common.SystemError) {
@@ -644,6 +1330,18 @@ func (e *enclaveImpl) EnclaveID() (common.EnclaveID, common.SystemError) {
 	return e.enclaveKey.EnclaveID(), nil
 }
 
+// EnclaveIDProof signs the nonce with the enclave key. The nonce comes from the caller, so a bad one is a user error
+func (e *enclaveImpl) EnclaveIDProof(nonce []byte) (*common.EnclaveIDProof, common.SystemError) {
+	if err := common.ValidateEnclaveIDProofNonce(nonce); err != nil {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, err)
+	}
+	proof, err := e.enclaveKey.ProveID(nonce)
+	if err != nil {
+		return nil, responses.ToInternalError(err)
+	}
+	return proof, nil
+}
+
 // GetBalance handles param decryption, validation and encryption
 // and requests the Rollup chain to execute the payload (eth_getBalance)
 func (e *enclaveImpl) GetBalance(encryptedParams common.EncryptedParamsGetBalance) (*responses.Balance, common.SystemError) {
@@ -651,7 +1349,7 @@ func (e *enclaveImpl) GetBalance(encryptedParams common.EncryptedParamsGetBalanc
 		return nil, responses.ToInternalError(fmt.Errorf("requested GetBalance with the enclave stopping"))
 	}
 
-	return rpc.WithVKEncryption(e.rpcEncryptionManager, encryptedParams, rpc.GetBalanceValidate, rpc.GetBalanceExecute)
+	return rpc.WithVKEncryption(context.Background(), e.rpcEncryptionManager, "getBalance", encryptedParams, rpc.GetBalanceValidate, rpc.GetBalanceExecute)
 }
 
 // todo - needs to be encrypted
@@ -667,6 +1365,21 @@ func (e *enclaveImpl) GetCode(address gethcommon.Address, batchHash *common.L2Ba
 	return stateDB.GetCode(address), nil
 }
 
+func (e *enclaveImpl) ConvertBatchHash(hash gethcommon.Hash) (*common.BatchHashes, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested ConvertBatchHash with the enclave stopping"))
+	}
+
+	batchHash, convertedHash, err := e.gethEncodingService.ConvertBatchHash(hash)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, nil //nolint:nilnil
+		}
+		return nil, responses.ToInternalError(fmt.Errorf("could not convert the batch hash. Cause: %w", err))
+	}
+	return &common.BatchHashes{BatchHash: batchHash, ConvertedHash: convertedHash}, nil
+}
+
 func (e *enclaveImpl) Subscribe(id gethrpc.ID, encryptedSubscription common.EncryptedParamsLogSubscription) common.SystemError {
 	if e.stopControl.IsStopping() {
 		return responses.ToInternalError(fmt.Errorf("requested SubscribeForExecutedBatches with the enclave stopping"))
@@ -680,6 +1393,14 @@ func (e *enclaveImpl) Subscribe(id gethrpc.ID, encryptedSubscription common.Encr
 	return e.subscriptionManager.AddSubscription(id, encodedSubscription)
 }
 
+func (e *enclaveImpl) SubscribeNewHeads(id gethrpc.ID) common.SystemError {
+	if e.stopControl.IsStopping() {
+		return responses.ToInternalError(fmt.Errorf("requested SubscribeNewHeads with the enclave stopping"))
+	}
+
+	return e.subscriptionManager.AddHeadsSubscription(id)
+}
+
 func (e *enclaveImpl) Unsubscribe(id gethrpc.ID) common.SystemError {
 	if e.stopControl.IsStopping() {
 		return responses.ToInternalError(fmt.Errorf("requested Unsubscribe with the enclave stopping"))
@@ -689,33 +1410,64 @@ func (e *enclaveImpl) Unsubscribe(id gethrpc.ID) common.SystemError {
 	return nil
 }
 
+// Stop blocks the new requests, waits for the ones ingesting or creating data to finish, then closes the subscriptions,
+// the node service and the storage, in that order.
+// Note: the storage is closed even if some requests did not finish within the timeout.
 func (e *enclaveImpl) Stop() common.SystemError {
-	// block all requests
 	e.stopControl.Stop()
 
+	failures := make(map[string]error)
+	timeout := e.config.StopTimeout
+	if timeout == 0 {
+		timeout = defaultStopTimeout
+	}
+	if !e.stopControl.WaitForOperations(timeout) {
+		e.logger.Error("Requests in progress did not finish before stopping", "timeout", timeout)
+		failures["requests"] = fmt.Errorf("requests in progress did not finish within %s", timeout)
+	}
+
+	// the profiler may have been started at runtime
+	e.profilerMutex.Lock()
 	if e.profiler != nil {
 		if err := e.profiler.Stop(); err != nil {
 			e.logger.Error("Could not stop profiler", log.ErrKey, err)
-			return err
+			failures["profiler"] = err
 		}
 	}
+	e.profilerMutex.Unlock()
 
+	// the stream is closed so the host gets an EOF. The requests which did not finish in time drop their updates, and
+	// are released before unsubscribing, as the callbacks run under the locks taken to unsubscribe them.
+	e.closeL2UpdatesStream()
 	if e.registry != nil {
 		e.registry.UnsubscribeFromBatches()
 	}
+	if e.subscriptionManager != nil {
+		e.subscriptionManager.Close()
+	}
 
-	err := e.service.Close()
-	if err != nil {
+	if err := e.service.Close(); err != nil {
 		e.logger.Error("Could not stop node service", log.ErrKey, err)
+		failures["service"] = err
 	}
 
-	time.Sleep(time.Second)
-	err = e.storage.Close()
-	if err != nil {
+	if err := e.storage.Close(); err != nil {
 		e.logger.Error("Could not stop db", log.ErrKey, err)
-		return err
+		failures["storage"] = err
+	}
+
+	if e.tracerProvider != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		if err := e.tracerProvider.Shutdown(ctx); err != nil {
+			e.logger.Error("Could not export the remaining traces", log.ErrKey, err)
+			failures["tracing"] = err
+		}
 	}
 
+	if len(failures) > 0 {
+		return &errutil.StopError{Failures: failures}
+	}
 	return nil
 }
 
@@ -727,49 +1479,247 @@ func (e *enclaveImpl) EstimateGas(encryptedParams common.EncryptedParamsEstimate
 	}
 
 	defer core.LogMethodDuration(e.logger, measure.NewStopwatch(), "enclave.go:EstimateGas()")
-	return rpc.WithVKEncryption(e.rpcEncryptionManager, encryptedParams, rpc.EstimateGasValidate, rpc.EstimateGasExecute)
+	return rpc.WithVKEncryption(context.Background(), e.rpcEncryptionManager, "estimateGas", encryptedParams, rpc.EstimateGasValidate, rpc.EstimateGasExecute)
+}
+
+// EstimateL1Fee decrypts CallMsg data and estimates the cost of publishing the transaction to the L1.
+func (e *enclaveImpl) EstimateL1Fee(encryptedParams common.EncryptedParamsEstimateL1Fee) (*responses.L1Fee, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested EstimateL1Fee with the enclave stopping"))
+	}
+
+	return rpc.WithVKEncryption(context.Background(), e.rpcEncryptionManager, "estimateL1Fee", encryptedParams, rpc.EstimateL1FeeValidate, rpc.EstimateL1FeeExecute)
 }
 
 func (e *enclaveImpl) GetLogs(encryptedParams common.EncryptedParamsGetLogs) (*responses.Logs, common.SystemError) {
 	if e.stopControl.IsStopping() {
 		return nil, responses.ToInternalError(fmt.Errorf("requested GetLogs with the enclave stopping"))
 	}
-	return rpc.WithVKEncryption(e.rpcEncryptionManager, encryptedParams, rpc.GetLogsValidate, rpc.GetLogsExecute)
+	return rpc.WithVKEncryption(context.Background(), e.rpcEncryptionManager, "getLogs", encryptedParams, rpc.GetLogsValidate, rpc.GetLogsExecute)
 }
 
-// HealthCheck returns whether the enclave is deemed healthy
-func (e *enclaveImpl) HealthCheck() (bool, common.SystemError) {
+// HealthCheck returns whether the enclave is deemed healthy, with the health of each component
+func (e *enclaveImpl) HealthCheck() (*common.HealthStatus, common.SystemError) {
 	if e.stopControl.IsStopping() {
-		return false, responses.ToInternalError(fmt.Errorf("requested HealthCheck with the enclave stopping"))
+		return nil, responses.ToInternalError(fmt.Errorf("requested HealthCheck with the enclave stopping"))
 	}
 
-	// check the storage health
-	storageHealthy, err := e.storage.HealthCheck()
+	// todo (#1148) - enclave healthcheck operations
+	components := []common.ComponentHealth{
+		checkComponentHealth("storage", e.storage.HealthCheck, func() map[string]string {
+			// the highest seq number stored, which may not have been executed yet
+			currSeqNo, err := e.storage.FetchCurrentSequencerNo()
+			if err != nil {
+				return nil
+			}
+			return map[string]string{"currentSeqNo": currSeqNo.String()}
+		}),
+		checkComponentHealth("l1BlockProcessor", e.l1BlockProcessor.HealthCheck, func() map[string]string {
+			head, err := e.l1BlockProcessor.GetHead()
+			if err != nil {
+				return nil
+			}
+			return map[string]string{
+				"headHeight": head.Number().String(),
+				"headAge":    time.Since(time.Unix(int64(head.Time()), 0)).Round(time.Second).String(),
+			}
+		}),
+		checkComponentHealth("batchRegistry", e.registry.HealthCheck, func() map[string]string {
+			headSeqNo := e.registry.HeadBatchSeq()
+			if headSeqNo == nil {
+				return nil
+			}
+			return map[string]string{"headSeqNo": headSeqNo.String()}
+		}),
+		checkComponentHealth("mempool", e.mempool.HealthCheck, func() map[string]string {
+			status := e.mempool.Status()
+			return map[string]string{
+				"pending":            strconv.FormatUint(status.Pending, 10),
+				"queued":             strconv.FormatUint(status.Queued, 10),
+				"capacity":           strconv.FormatUint(status.Capacity, 10),
+				"sinceLastAdmission": e.mempool.SinceLastAdmission().Round(time.Second).String(),
+			}
+		}),
+		checkComponentHealth("stateDivergence", e.checkStateDivergence, func() map[string]string {
+			reports, err := e.storage.FetchStateDivergences()
+			if err != nil {
+				return nil
+			}
+			for _, report := range reports {
+				if report.Canonical {
+					return map[string]string{
+						"batchHash":    report.BatchHash.Hex(),
+						"seqNo":        strconv.FormatUint(report.SeqNo, 10),
+						"expectedRoot": report.ExpectedStateRoot.Hex(),
+						"computedRoot": report.ComputedStateRoot.Hex(),
+					}
+				}
+			}
+			return nil
+		}),
+		checkComponentHealth("rollupCompression", func() (bool, error) {
+			return true, nil
+		}, func() map[string]string {
+			stats, err := e.storage.FetchRollupCompressionStats()
+			if err != nil {
+				return nil
+			}
+			return rollupCompressionDetail(stats)
+		}),
+		checkComponentHealth("rollupProcessing", e.rollupConsumer.HealthCheck, func() map[string]string {
+			processings, err := e.storage.FetchUnprocessedRollups()
+			if err != nil || len(processings) == 0 {
+				return nil
+			}
+			// the first rollup which is not processed holds back the ones after it
+			return map[string]string{
+				"unprocessed": strconv.Itoa(len(processings)),
+				"rollupHash":  processings[0].RollupHash.Hex(),
+				"status":      string(processings[0].Status),
+				"attempts":    strconv.FormatUint(processings[0].Attempts, 10),
+				"reason":      processings[0].Reason,
+			}
+		}),
+		checkComponentHealth("subscriptionManager", e.subscriptionManager.HealthCheck, func() map[string]string {
+			subStats := e.subscriptionManager.Stats()
+			return map[string]string{
+				"active":      strconv.FormatUint(subStats.Active, 10),
+				"heads":       strconv.FormatUint(subStats.Heads, 10),
+				"viewingKeys": strconv.FormatUint(subStats.ViewingKeys, 10),
+				"expired":     strconv.FormatUint(subStats.Expired, 10),
+				"backfilling": strconv.FormatUint(subStats.Backfilling, 10),
+				"backlog":     strconv.FormatUint(subStats.Backlog, 10),
+			}
+		}),
+	}
+
+	status := &common.HealthStatus{OverallHealth: true, Components: components}
+	for _, component := range components {
+		if !component.Healthy {
+			// simplest iteration, log the error and just report that it's not healthy
+			e.logger.Info("HealthCheck failed", "component", component.Name, log.ErrKey, component.Error)
+			status.OverallHealth = false
+		}
+	}
+	return status, nil
+}
+
+// checkStateDivergence - the validator is unhealthy while it is halted by a canonical batch it computed differently
+func (e *enclaveImpl) checkStateDivergence() (bool, error) {
+	reports, err := e.storage.FetchStateDivergences()
 	if err != nil {
-		// simplest iteration, log the error and just return that it's not healthy
-		e.logger.Info("HealthCheck failed for the enclave storage", log.ErrKey, err)
-		return false, nil
+		return false, err
 	}
+	for _, report := range reports {
+		if report.Canonical {
+			return false, fmt.Errorf("state divergence at batch %s", report.BatchHash)
+		}
+	}
+	return true, nil
+}
 
-	// todo (#1148) - enclave healthcheck operations
-	l1blockHealthy, err := e.l1BlockProcessor.HealthCheck()
+// checkComponentHealth - times the health check of a component. The detail is collected even when the component is not
+// healthy, since it usually tells why.
+func checkComponentHealth(name string, check func() (bool, error), detail func() map[string]string) common.ComponentHealth {
+	start := time.Now()
+	healthy, err := check()
+	health := common.ComponentHealth{
+		Name:    name,
+		Healthy: healthy && err == nil,
+		Latency: time.Since(start),
+		Detail:  detail(),
+	}
 	if err != nil {
-		// simplest iteration, log the error and just return that it's not healthy
-		e.logger.Info("HealthCheck failed for the l1 block processor", log.ErrKey, err)
-		return false, nil
+		health.Error = err.Error()
 	}
+	return health
+}
 
-	l2batchHealthy, err := e.registry.HealthCheck()
+// rollupCompressionDetail - the aggregates of the recent rollups, for the created and the consumed ones separately
+func rollupCompressionDetail(stats []*common.RollupCompressionStats) map[string]string {
+	detail := map[string]string{}
+	for _, side := range []bool{true, false} {
+		prefix := "consumed"
+		if side {
+			prefix = "created"
+		}
+		var count, raw, compressed uint64
+		var duration time.Duration
+		for _, s := range stats {
+			if s.Created != side {
+				continue
+			}
+			count++
+			raw += s.RawBytes
+			compressed += s.CompressedBytes
+			duration += s.Duration
+		}
+		if count == 0 {
+			continue
+		}
+		aggregate := common.RollupCompressionStats{RawBytes: raw, CompressedBytes: compressed}
+		detail[prefix+"Rollups"] = strconv.FormatUint(count, 10)
+		detail[prefix+"Ratio"] = strconv.FormatFloat(aggregate.Ratio(), 'f', 2, 64)
+		detail[prefix+"AvgCompressedBytes"] = strconv.FormatUint(compressed/count, 10)
+		detail[prefix+"AvgDuration"] = (duration / time.Duration(count)).String()
+	}
+	return detail
+}
+
+// GetRollupCompressionStats returns the compression stats of the most recent rollups, created or consumed
+func (e *enclaveImpl) GetRollupCompressionStats() ([]*common.RollupCompressionStats, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetRollupCompressionStats with the enclave stopping"))
+	}
+	stats, err := e.storage.FetchRollupCompressionStats()
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not fetch the rollup compression stats. Cause: %w", err))
+	}
+	return stats, nil
+}
+
+// GetStateDivergenceReports returns the batches whose re-execution did not match the header signed by the sequencer
+func (e *enclaveImpl) GetStateDivergenceReports() ([]*common.StateDivergenceReport, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetStateDivergenceReports with the enclave stopping"))
+	}
+	reports, err := e.storage.FetchStateDivergences()
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not fetch the state divergence reports. Cause: %w", err))
+	}
+	return reports, nil
+}
+
+// GetSecretDisclosures returns a page of the audit log of the secret requests
+func (e *enclaveImpl) GetSecretDisclosures(fromID uint64, maxCount uint64) ([]*common.SecretDisclosureRecord, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetSecretDisclosures with the enclave stopping"))
+	}
+	if maxCount == 0 || maxCount > common.MaxSecretDisclosuresPerPage {
+		maxCount = common.MaxSecretDisclosuresPerPage
+	}
+	records, err := e.storage.FetchSecretDisclosures(fromID, maxCount)
 	if err != nil {
-		// simplest iteration, log the error and just return that it's not healthy
-		e.logger.Info("HealthCheck failed for the l2 batch registry", log.ErrKey, err)
-		return false, nil
+		return nil, responses.ToInternalError(fmt.Errorf("could not fetch the secret disclosure records. Cause: %w", err))
 	}
+	return records, nil
+}
 
-	return storageHealthy && l1blockHealthy && l2batchHealthy, nil
+// GetEnclaveAPIVersion returns the revision of the interface implemented by this enclave
+func (e *enclaveImpl) GetEnclaveAPIVersion() (*common.EnclaveAPIVersion, common.SystemError) {
+	version := common.CurrentEnclaveAPIVersion
+	return &version, nil
+}
+
+// GetMetrics returns the metrics of the enclave components in the Prometheus text format
+func (e *enclaveImpl) GetMetrics() ([]byte, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetMetrics with the enclave stopping"))
+	}
+	return e.metrics.Export(), nil
 }
 
-func (e *enclaveImpl) DebugTraceTransaction(txHash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, common.SystemError) {
+func (e *enclaveImpl) DebugTraceTransaction(encryptedParams common.EncryptedParamsDebugTraceTx) (*responses.DebugTrace, common.SystemError) {
 	// ensure the enclave is running
 	if e.stopControl.IsStopping() {
 		return nil, responses.ToInternalError(fmt.Errorf("requested DebugTraceTransaction with the enclave stopping"))
@@ -780,22 +1730,30 @@ func (e *enclaveImpl) DebugTraceTransaction(txHash gethcommon.Hash, config *trac
 		return nil, responses.ToInternalError(fmt.Errorf("debug namespace not enabled"))
 	}
 
-	jsonMsg, err := e.debugger.DebugTraceTransaction(context.Background(), txHash, config)
-	if err != nil {
-		if errors.Is(err, syserr.InternalError{}) {
-			return nil, responses.ToInternalError(err)
-		}
-		// TODO *Pedro* MOVE THIS TO Enclave Response
-		return json.RawMessage(err.Error()), nil
+	// the trace is aborted when the enclave stops
+	ctx, cancel := e.stopControl.WithContext(context.Background())
+	defer cancel()
+	return rpc.WithVKEncryption(ctx, e.rpcEncryptionManager, "debugTraceTransaction", encryptedParams, rpc.DebugTraceTransactionValidate, rpc.DebugTraceTransactionExecute)
+}
+
+func (e *enclaveImpl) DebugStorageRangeAt(encryptedParams common.EncryptedParamsStorageRangeAt) (*responses.StorageRange, common.SystemError) {
+	// ensure the enclave is running
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested DebugStorageRangeAt with the enclave stopping"))
 	}
 
-	return jsonMsg, nil
+	// ensure the debug namespace is enabled
+	if !e.config.DebugNamespaceEnabled {
+		return nil, responses.ToInternalError(fmt.Errorf("debug namespace not enabled"))
+	}
+
+	return rpc.WithVKEncryption(context.Background(), e.rpcEncryptionManager, "debugStorageRangeAt", encryptedParams, rpc.DebugStorageRangeAtValidate, rpc.DebugStorageRangeAtExecute)
 }
 
-func (e *enclaveImpl) DebugEventLogRelevancy(txHash gethcommon.Hash) (json.RawMessage, common.SystemError) {
+func (e *enclaveImpl) DebugBatchLogRelevancy(encryptedParams common.EncryptedParamsBatchLogs) (*responses.BatchLogRelevancy, common.SystemError) {
 	// ensure the enclave is running
 	if e.stopControl.IsStopping() {
-		return nil, responses.ToInternalError(fmt.Errorf("requested DebugEventLogRelevancy with the enclave stopping"))
+		return nil, responses.ToInternalError(fmt.Errorf("requested DebugBatchLogRelevancy with the enclave stopping"))
 	}
 
 	// ensure the debug namespace is enabled
@@ -803,16 +1761,150 @@ func (e *enclaveImpl) DebugEventLogRelevancy(txHash gethcommon.Hash) (json.RawMe
 		return nil, responses.ToInternalError(fmt.Errorf("debug namespace not enabled"))
 	}
 
-	jsonMsg, err := e.debugger.DebugEventLogRelevancy(txHash)
-	if err != nil {
-		if errors.Is(err, syserr.InternalError{}) {
+	return rpc.WithVKEncryption(context.Background(), e.rpcEncryptionManager, "debugBatchLogRelevancy", encryptedParams, rpc.DebugBatchLogRelevancyValidate, rpc.DebugBatchLogRelevancyExecute)
+}
+
+// SetProfilerEnabled starts or stops the profiler at runtime. Starting it while it is running, or stopping it while it
+// is stopped, changes nothing.
+func (e *enclaveImpl) SetProfilerEnabled(enabled bool) (*common.ProfilerStatus, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested SetProfilerEnabled with the enclave stopping"))
+	}
+
+	// the profiler exposes the memory of the enclave, so it is never available on an attested enclave
+	if e.config.WillAttest {
+		return nil, responses.ToInternalError(fmt.Errorf("the profiler is not available on an attested enclave"))
+	}
+	// ensure the debug namespace is enabled
+	if !e.config.DebugNamespaceEnabled {
+		return nil, responses.ToInternalError(fmt.Errorf("debug namespace not enabled"))
+	}
+
+	e.profilerMutex.Lock()
+	defer e.profilerMutex.Unlock()
+
+	if e.profiler == nil {
+		e.profiler = profiler.NewProfiler(profiler.DefaultEnclavePort, e.logger)
+	}
+	running := e.profiler.Running()
+	status := &common.ProfilerStatus{}
+	switch {
+	case enabled && running:
+		status.Message = "profiler already running"
+	case enabled:
+		if err := e.profiler.Start(); err != nil {
 			return nil, responses.ToInternalError(err)
 		}
-		// TODO *Pedro* MOVE THIS TO Enclave Response
-		return json.RawMessage(err.Error()), nil
+		status.Message = "profiler started"
+	case running:
+		if err := e.profiler.Stop(); err != nil {
+			return nil, responses.ToInternalError(fmt.Errorf("could not stop the profiler. Cause: %w", err))
+		}
+		status.Message = "profiler stopped"
+	default:
+		status.Message = "profiler not running"
+	}
+
+	status.Running = e.profiler.Running()
+	if status.Running {
+		status.Address = e.profiler.Address()
+	}
+	e.logger.Info("SetProfilerEnabled", "enabled", enabled, "result", status.Message)
+	return status, nil
+}
+
+// SetLogLevel logs the records of the enclave component at the given level from now on, and returns the levels of the
+// components which are not logged at the default level. The level cannot be more verbose than the most verbose one
+// configured, which is the level of the logger of the enclave.
+func (e *enclaveImpl) SetLogLevel(component string, level int) (map[string]int, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested SetLogLevel with the enclave stopping"))
+	}
+	// ensure the debug namespace is enabled
+	if !e.config.DebugNamespaceEnabled {
+		return nil, responses.ToInternalError(fmt.Errorf("debug namespace not enabled"))
+	}
+	if !isEnclaveComponent(component) {
+		return nil, responses.ToInternalError(fmt.Errorf("unknown enclave component %s, expected one of %v", component, log.EnclaveComponents))
+	}
+	if maxLevel := e.config.MostVerboseLogLevel(); level > maxLevel {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, fmt.Errorf("the enclave logs up to level %d, it must be restarted with a more verbose level to log %s at level %d", maxLevel, component, level))
+	}
+
+	e.logLevels.SetLevel(component, level)
+	e.logger.Info("SetLogLevel", "name", component, "level", level)
+	return e.logLevels.Levels(), nil
+}
+
+// GetEnclaveConfiguration returns the sanitized configuration of the enclave, with the chain config and the genesis
+// hashes derived from it
+func (e *enclaveImpl) GetEnclaveConfiguration() (*common.EnclaveConfiguration, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetEnclaveConfiguration with the enclave stopping"))
+	}
+	if !e.config.DebugNamespaceEnabled && !e.config.ConfigIntrospectionEnabled {
+		return nil, responses.WithCode(responses.ErrCodeUserError, fmt.Errorf("config introspection not enabled"))
+	}
+
+	encodedConfig, err := json.Marshal(e.config.Sanitized())
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not encode the enclave config. Cause: %w", err))
+	}
+	encodedChainConfig, err := json.Marshal(e.chainConfig)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not encode the chain config. Cause: %w", err))
 	}
+	configuration := &common.EnclaveConfiguration{Config: encodedConfig, ChainConfig: encodedChainConfig}
 
-	return jsonMsg, nil
+	genesisBatch, err := e.storage.FetchBatchBySeqNo(common.L2GenesisSeqNo)
+	switch {
+	case err == nil:
+		genesisHash := genesisBatch.Hash()
+		configuration.GenesisBatchHash = &genesisHash
+	case !errors.Is(err, errutil.ErrNotFound):
+		return nil, responses.ToInternalError(fmt.Errorf("could not fetch the genesis batch. Cause: %w", err))
+	}
+	if e.config.GenesisJSON != nil {
+		l1GenesisHash := gethcrypto.Keccak256Hash(e.config.GenesisJSON)
+		configuration.L1GenesisConfigHash = &l1GenesisHash
+	}
+	return configuration, nil
+}
+
+// GetNetworkInfo - unlike the other queries, it neither requires the shared secret nor a viewing key, so the wallets
+// can detect the chain while the node is provisioned
+func (e *enclaveImpl) GetNetworkInfo() (*common.NetworkInfo, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested GetNetworkInfo with the enclave stopping"))
+	}
+
+	info := &common.NetworkInfo{
+		ChainID:        e.config.ObscuroChainID,
+		NetworkVersion: strconv.FormatInt(e.config.ObscuroChainID, 10),
+		ClientVersion:  fmt.Sprintf("Ten/%s/%s-%s/%s", Version, runtime.GOOS, runtime.GOARCH, runtime.Version()),
+	}
+	genesisBatch, err := e.storage.FetchBatchBySeqNo(common.L2GenesisSeqNo)
+	switch {
+	case err == nil:
+		info.GenesisBatchHash = genesisBatch.Hash()
+	case !errors.Is(err, errutil.ErrNotFound):
+		return nil, responses.ToInternalError(fmt.Errorf("could not fetch the genesis batch. Cause: %w", err))
+	}
+	return info, nil
+}
+
+func (e *enclaveImpl) DebugEventLogRelevancy(encryptedParams common.EncryptedParamsDebugLogs) (*responses.DebugLogs, common.SystemError) {
+	// ensure the enclave is running
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested DebugEventLogRelevancy with the enclave stopping"))
+	}
+
+	// ensure the debug namespace is enabled
+	if !e.config.DebugNamespaceEnabled {
+		return nil, responses.ToInternalError(fmt.Errorf("debug namespace not enabled"))
+	}
+
+	return rpc.WithVKEncryption(context.Background(), e.rpcEncryptionManager, "debugEventLogRelevancy", encryptedParams, rpc.DebugEventLogRelevancyValidate, rpc.DebugEventLogRelevancyExecute)
 }
 
 func (e *enclaveImpl) GetTotalContractCount() (*big.Int, common.SystemError) {
@@ -830,7 +1922,7 @@ func (e *enclaveImpl) GetCustomQuery(encryptedParams common.EncryptedParamsGetSt
 		return nil, responses.ToInternalError(fmt.Errorf("requested GetReceiptsByAddress with the enclave stopping"))
 	}
 
-	return rpc.WithVKEncryption(e.rpcEncryptionManager, encryptedParams, rpc.GetCustomQueryValidate, rpc.GetCustomQueryExecute)
+	return rpc.WithVKEncryption(context.Background(), e.rpcEncryptionManager, "getCustomQuery", encryptedParams, rpc.GetCustomQueryValidate, rpc.GetCustomQueryExecute)
 }
 
 func (e *enclaveImpl) GetPublicTransactionData(pagination *common.QueryPagination) (*common.TransactionListingResponse, common.SystemError) {
@@ -861,7 +1953,19 @@ func (e *enclaveImpl) EnclavePublicConfig() (*common.EnclavePublicConfig, common
 	if systemError != nil {
 		return nil, systemError
 	}
-	return &common.EnclavePublicConfig{L2MessageBusAddress: address}, nil
+	parameters := e.gasOracle.Parameters()
+	return &common.EnclavePublicConfig{
+		L2MessageBusAddress: address,
+		L1MessageBusAddress: e.config.MessageBusAddress,
+		// the bridge is deployed by a regular transaction, so its address is not known to the enclave
+		BridgeAddress:     gethcommon.Address{},
+		SystemContracts:   map[string]gethcommon.Address{"MessageBus": address},
+		L2ChainID:         e.config.ObscuroChainID,
+		MinGasPrice:       parameters.MinGasPrice,
+		BaseFee:           parameters.BaseFee,
+		GasPaymentAddress: parameters.GasPaymentAddress,
+		Version:           Version,
+	}, nil
 }
 
 func (e *enclaveImpl) rejectBlockErr(cause error) *errutil.BlockRejectError {
@@ -877,88 +1981,39 @@ func (e *enclaveImpl) rejectBlockErr(cause error) *errutil.BlockRejectError {
 	}
 }
 
-// this function looks at the batch chain and makes sure the resulting stateDB snapshots are available, replaying them if needed
-// (if there had been a clean shutdown and all stateDB data was persisted this should do nothing)
-func restoreStateDBCache(storage storage.Storage, registry components.BatchRegistry, producer components.BatchExecutor, gen *genesis.Genesis, logger gethlog.Logger) error {
-	if registry.HeadBatchSeq() == nil {
-		// not initialised yet
-		return nil
+// rejectSubmittedBlockErr - when the block was rejected because of a missing ancestor, tells the host which blocks it
+// must submit first, if the enclave can infer it
+func (e *enclaveImpl) rejectSubmittedBlockErr(block *common.L1Block, cause error) *errutil.BlockRejectError {
+	rejErr := e.rejectBlockErr(cause)
+	if !errors.Is(cause, errutil.ErrBlockAncestorNotFound) {
+		return rejErr
 	}
-	batch, err := storage.FetchBatchBySeqNo(registry.HeadBatchSeq().Uint64())
-	if err != nil {
-		if errors.Is(err, errutil.ErrNotFound) {
-			// there is no head batch, this is probably a new node - there is no state to rebuild
-			logger.Info("no head batch found in DB after restart", log.ErrKey, err)
-			return nil
-		}
-		return fmt.Errorf("unexpected error fetching head batch to resync- %w", err)
+	if forkPoint := e.l1BlockProcessor.MissingAncestors(block); forkPoint != nil {
+		rejErr.LatestAncestor = forkPoint.Ancestor
+		rejErr.LatestAncestorHeight = forkPoint.AncestorHeight
+		rejErr.MissingBlocks = forkPoint.MissingBlocks
 	}
-	if !stateDBAvailableForBatch(storage, batch.Hash()) {
-		logger.Info("state not available for latest batch after restart - rebuilding stateDB cache from batches")
-		err = replayBatchesToValidState(storage, registry, producer, gen, logger)
-		if err != nil {
-			return fmt.Errorf("unable to replay batches to restore valid state - %w", err)
-		}
-	}
-	return nil
+	return rejErr
 }
 
-// The enclave caches a stateDB instance against each batch hash, this is the input state when producing the following
-// batch in the chain and is used to query state at a certain height.
-//
-// This method checks if the stateDB data is available for a given batch hash (so it can be restored if not)
-func stateDBAvailableForBatch(storage storage.Storage, hash common.L2BatchHash) bool {
-	_, err := storage.CreateStateDB(hash)
-	return err == nil
-}
-
-// replayBatchesToValidState is used to repopulate the stateDB cache with data from persisted batches. Two step process:
-// 1. step backwards from head batch until we find a batch that is already in stateDB cache, builds list of batches to replay
-// 2. iterate that list of batches from the earliest, process the transactions to calculate and cache the stateDB
-// todo (#1416) - get unit test coverage around this (and L2 Chain code more widely, see ticket #1416 )
-func replayBatchesToValidState(storage storage.Storage, registry components.BatchRegistry, batchExecutor components.BatchExecutor, gen *genesis.Genesis, logger gethlog.Logger) error {
-	// this slice will be a stack of batches to replay as we walk backwards in search of latest valid state
-	// todo - consider capping the size of this batch list using FIFO to avoid memory issues, and then repeating as necessary
-	var batchesToReplay []*core.Batch
-	// `batchToReplayFrom` variable will eventually be the latest batch for which we are able to produce a StateDB
-	// - we will then set that as the head of the L2 so that this node can rebuild its missing state
-	batchToReplayFrom, err := storage.FetchBatchBySeqNo(registry.HeadBatchSeq().Uint64())
-	if err != nil {
-		return fmt.Errorf("no head batch found in DB but expected to replay batches - %w", err)
-	}
-	// loop backwards building a slice of all batches that don't have cached stateDB data available
-	for !stateDBAvailableForBatch(storage, batchToReplayFrom.Hash()) {
-		batchesToReplay = append(batchesToReplay, batchToReplayFrom)
-		if batchToReplayFrom.NumberU64() == 0 {
-			// no more parents to check, replaying from genesis
-			break
-		}
-		batchToReplayFrom, err = storage.FetchBatch(batchToReplayFrom.Header.ParentHash)
-		if err != nil {
-			return fmt.Errorf("unable to fetch previous batch while rolling back to stable state - %w", err)
-		}
-	}
-	logger.Info("replaying batch data into stateDB cache", "fromBatch", batchesToReplay[len(batchesToReplay)-1].NumberU64(),
-		"toBatch", batchesToReplay[0].NumberU64())
-	// loop through the slice of batches without stateDB data to cache the state (loop in reverse because slice is newest to oldest)
-	for i := len(batchesToReplay) - 1; i >= 0; i-- {
-		batch := batchesToReplay[i]
-
-		// if genesis batch then create the genesis state before continuing on with remaining batches
-		if batch.NumberU64() == 0 {
-			err := gen.CommitGenesisState(storage)
-			if err != nil {
-				return err
-			}
+// newComponentLogLevels - the log levels of the enclave components set in the config. The unknown components are ignored.
+func newComponentLogLevels(config *config.EnclaveConfig, logger gethlog.Logger) *log.ComponentLevels {
+	levels := log.NewComponentLevels(config.LogLevel)
+	for component, level := range config.ComponentLogLevels {
+		if !isEnclaveComponent(component) {
+			logger.Warn("Ignoring the log level of an unknown enclave component", "name", component, "level", level)
 			continue
 		}
+		levels.SetLevel(component, level)
+	}
+	return levels
+}
 
-		// calculate the stateDB after this batch and store it in the cache
-		_, err := batchExecutor.ExecuteBatch(batch)
-		if err != nil {
-			return err
+func isEnclaveComponent(component string) bool {
+	for _, c := range log.EnclaveComponents {
+		if c == component {
+			return true
 		}
 	}
-
-	return nil
+	return false
 }
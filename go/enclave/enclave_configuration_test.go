@@ -0,0 +1,36 @@
+package enclave
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/responses"
+)
+
+func TestEnclaveConfigurationIsOnlyServedWhenEnabled(t *testing.T) {
+	enclave, _ := newStorageTestEnclave(t)
+	enclave.chainConfig = params.AllEthashProtocolChanges
+	enclave.config = &config.EnclaveConfig{ObscuroChainID: 443}
+
+	// a node which does not serve its configuration is not failing
+	_, err := enclave.GetEnclaveConfiguration()
+	require.Equal(t, responses.ErrCodeUserError, responses.ErrorCodeOf(err))
+	require.False(t, errors.Is(err, &syserr.InternalError{}))
+
+	for _, cfg := range []*config.EnclaveConfig{
+		{ObscuroChainID: 443, DebugNamespaceEnabled: true},
+		{ObscuroChainID: 443, ConfigIntrospectionEnabled: true},
+	} {
+		enclave.config = cfg
+		configuration, err := enclave.GetEnclaveConfiguration()
+		require.NoError(t, err)
+		var served config.EnclaveConfig
+		require.NoError(t, json.Unmarshal(configuration.Config, &served))
+		require.Equal(t, int64(443), served.ObscuroChainID)
+	}
+}
@@ -0,0 +1,96 @@
+package enclave
+
+import (
+	"errors"
+	"math/big"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/genesis"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
+)
+
+// unreachableStorage - the database becomes unreachable once the subscriptions were restored, which is the last step
+// before the enclave checks the state of its head batch
+type unreachableStorage struct {
+	storage.Storage
+	unreachable atomic.Bool
+}
+
+func (s *unreachableStorage) FetchSubscriptions() (map[gethrpc.ID][]byte, error) {
+	defer s.unreachable.Store(true)
+	return s.Storage.FetchSubscriptions()
+}
+
+func (s *unreachableStorage) FetchBatchBySeqNo(seqNum uint64) (*core.Batch, error) {
+	if s.unreachable.Load() {
+		return nil, errors.New("database unreachable")
+	}
+	return s.Storage.FetchBatchBySeqNo(seqNum)
+}
+
+func TestFailedEnclaveCreationReleasesItsResources(t *testing.T) {
+	// a validator with a few batches, so its mempool is started when it is created
+	openStorage := func(config *config.EnclaveConfig, chainConfig *params.ChainConfig, logger gethlog.Logger) (storage.Storage, error) {
+		storageDB, err := storage.NewStorageFromConfig(config, chainConfig, logger)
+		require.NoError(t, err)
+		require.NoError(t, storageDB.StoreSecret(crypto.SharedEnclaveSecret{}))
+		g := genesis.Genesis{}
+		root, err := g.GetGenesisRoot(storageDB)
+		require.NoError(t, err)
+		require.NoError(t, g.CommitGenesisState(storageDB))
+		block := testBlock("")
+		require.NoError(t, storageDB.StoreBlock(block, nil))
+		parent := gethcommon.Hash{}
+		for seqNo := int64(common.L2GenesisSeqNo); seqNo <= int64(common.L2GenesisSeqNo)+2; seqNo++ {
+			batch := &core.Batch{Header: &common.BatchHeader{
+				Root:             *root,
+				Number:           big.NewInt(seqNo),
+				SequencerOrderNo: big.NewInt(seqNo),
+				ParentHash:       parent,
+				L1Proof:          block.Hash(),
+				BaseFee:          big.NewInt(1),
+			}}
+			require.NoError(t, storageDB.StoreBatch(batch, batch.Hash()))
+			require.NoError(t, storageDB.StoreExecutedBatch(batch, nil))
+			parent = batch.Hash()
+		}
+		return &unreachableStorage{Storage: storageDB}, nil
+	}
+	enclaveConfig := &config.EnclaveConfig{
+		NodeType:       common.Validator,
+		SequencerID:    gethcommon.HexToAddress("0x1"),
+		ObscuroChainID: 443,
+		UseInMemoryDB:  true,
+		MinGasPrice:    big.NewInt(1),
+		BaseFee:        big.NewInt(1),
+		MaxBatchSize:   1024,
+		MaxRollupSize:  1024,
+
+		StateRetention:       common.ArchiveStateRetention,
+		CallExecutionTimeout: time.Second,
+		CallMemoryCap:        1 << 20,
+	}
+	goroutines := runtime.NumGoroutine()
+
+	_, err := newEnclave(enclaveConfig, &genesis.Genesis{}, mgmtcontractlib.NewMgmtContractLib(&gethcommon.Address{}, gethlog.New()), openStorage, gethlog.New())
+	require.ErrorContains(t, err, "database unreachable")
+	// the mempool and the other components started before the failure are stopped. This is polled without
+	// require.Eventually, which checks the condition from a goroutine of its own.
+	for deadline := time.Now().Add(5 * time.Second); runtime.NumGoroutine() > goroutines && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.LessOrEqual(t, runtime.NumGoroutine(), goroutines)
+}
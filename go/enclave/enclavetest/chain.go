@@ -0,0 +1,90 @@
+// Package enclavetest - the components of an enclave, built for the unit tests which produce and execute batches
+package enclavetest
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/evm/ethchainadapter"
+	"github.com/ten-protocol/go-ten/go/enclave/gas"
+	"github.com/ten-protocol/go-ten/go/enclave/genesis"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+	"github.com/ten-protocol/go-ten/go/enclave/txpool"
+	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
+)
+
+const (
+	ChainID = 443
+	// BatchGasLimit - the message bus is deployed by the genesis batches, with a lot of gas
+	BatchGasLimit = 3_000_000_000
+)
+
+// Chain - the components a sequencer is built from, on a temporary database which has processed a single L1 block.
+// The genesis is the testnet one, which prefunds genesis.TestnetPrefundedPK.
+type Chain struct {
+	Logger         gethlog.Logger
+	ChainConfig    *params.ChainConfig
+	Storage        storage.Storage
+	GethEncoding   gethencoding.EncodingService
+	GasOracle      gas.Oracle
+	Registry       components.BatchRegistry
+	BlockProcessor components.L1BlockProcessor
+	BatchExecutor  components.BatchExecutor
+	Blockchain     *ethchainadapter.EthChainAdapter
+	Mempool        *txpool.TxPool
+	EnclaveKey     *crypto.EnclaveKey
+}
+
+// NewChain - inboundBudgetActivationSeqNo is the batch seq no the synthetic transactions are budgeted from
+func NewChain(t testing.TB, inboundBudgetActivationSeqNo uint64) *Chain {
+	logger := gethlog.New()
+	chainConfig := ethchainadapter.ChainParams(big.NewInt(ChainID))
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	require.NoError(t, err)
+	storageDB := storage.NewStorage(backingDB, chainConfig, logger)
+	require.NoError(t, storageDB.StoreSecret(crypto.SharedEnclaveSecret{}))
+
+	gethEncoding := gethencoding.NewGethEncodingService(storageDB, logger)
+	crossChain := crosschain.New(&gethcommon.Address{}, &gethcommon.Address{}, storageDB, big.NewInt(ChainID), 0, logger)
+	gasOracle := gas.NewGasOracle(common.NetworkParameters{BaseFee: big.NewInt(1), MinGasPrice: big.NewInt(1)}, storageDB, logger)
+	registry := components.NewBatchRegistry(storageDB, logger)
+	blockProcessor := components.NewBlockProcessor(storageDB, crossChain, mgmtcontractlib.NewMgmtContractLib(&gethcommon.Address{}, logger), gasOracle, nil, logger)
+	batchExecutor := components.NewBatchExecutor(storageDB, gethEncoding, crossChain, &genesis.TestnetGenesis, gasOracle, chainConfig, BatchGasLimit, inboundBudgetActivationSeqNo, nil, logger)
+	blockchain := ethchainadapter.NewEthChainAdapter(big.NewInt(ChainID), registry, storageDB, gethEncoding, logger)
+	mempool, err := txpool.NewTxPool(blockchain, big.NewInt(1), 0, txpool.Limits{}, storageDB, nil, logger)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = mempool.Close() })
+	enclaveKey, err := crypto.GenerateEnclaveKey()
+	require.NoError(t, err)
+
+	l1Genesis := types.NewBlock(&types.Header{Number: big.NewInt(0)}, nil, nil, nil, trie.NewStackTrie(nil))
+	_, err = blockProcessor.Process(context.Background(), &common.BlockAndReceipts{Block: l1Genesis, Receipts: &types.Receipts{}})
+	require.NoError(t, err)
+
+	return &Chain{
+		Logger:         logger,
+		ChainConfig:    chainConfig,
+		Storage:        storageDB,
+		GethEncoding:   gethEncoding,
+		GasOracle:      gasOracle,
+		Registry:       registry,
+		BlockProcessor: blockProcessor,
+		BatchExecutor:  batchExecutor,
+		Blockchain:     blockchain,
+		Mempool:        mempool,
+		EnclaveKey:     enclaveKey,
+	}
+}
@@ -0,0 +1,212 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/log"
+)
+
+const (
+	// the number of batches whose logs are loaded from the db in one go
+	backfillChunkSize = 100
+	// the pause between two chunks, so that a resync doesn't starve the rest of the enclave of db access
+	backfillChunkInterval = 50 * time.Millisecond
+	// the maximum number of subscriptions backfilled at the same time
+	maxConcurrentBackfills = 2
+)
+
+// LogsSink - receives the logs of the historical batches requested by a subscription
+type LogsSink func(common.EncryptedSubscriptionLogs)
+
+// SetBackfillSink sets the destination of the historical logs. When there is no sink, backfills are paused, and the
+// live logs of the subscriptions being backfilled are buffered.
+func (s *SubscriptionManager) SetBackfillSink(sink LogsSink) {
+	s.sinkMutex.Lock()
+	defer s.sinkMutex.Unlock()
+	s.backfillSink = sink
+}
+
+// prepareBackfill - when the filter starts in the past, the subscription is put in backfill mode, and the range of
+// historical batches that must be delivered before the live logs is returned.
+// Must be called with the subscription mutex held.
+func (s *SubscriptionManager) prepareBackfill(sub *logSubscription) (uint64, uint64, bool, error) {
+	fromBlock := sub.Subscription.Filter.FromBlock
+	// negative values are the special "latest"/"pending" tags
+	if fromBlock == nil || fromBlock.Sign() < 0 {
+		return 0, 0, false, nil
+	}
+
+	head, err := s.currentHead()
+	if err != nil {
+		return 0, 0, false, err
+	}
+	to, backfill := s.startBackfill(sub, fromBlock.Uint64(), head)
+	return fromBlock.Uint64(), to, backfill, nil
+}
+
+// currentHead - the height from which the live delivery continues. Zero if there is no batch yet.
+// Must be called with the subscription mutex held.
+func (s *SubscriptionManager) currentHead() (uint64, error) {
+	if s.liveHeight > 0 {
+		return s.liveHeight, nil
+	}
+	headBatch, err := s.storage.FetchHeadBatch()
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("could not retrieve the head batch. Cause: %w", err)
+	}
+	return headBatch.NumberU64(), nil
+}
+
+// startBackfill - if the subscription must receive the logs of batches up to the head starting from `from`, it is put
+// in backfill mode, and the last historical batch is returned.
+// Live delivery takes over from the batch after the head, so every log is delivered exactly once.
+func (s *SubscriptionManager) startBackfill(sub *logSubscription, from uint64, head uint64) (uint64, bool) {
+	if head == 0 || from > head {
+		return 0, false
+	}
+
+	to := head
+	if toBlock := sub.Subscription.Filter.ToBlock; toBlock != nil && toBlock.Sign() > 0 && toBlock.Uint64() < to {
+		to = toBlock.Uint64()
+	}
+
+	sub.backfilling = true
+	sub.liveFrom = head + 1
+	return to, true
+}
+
+// backfill - delivers the logs of the historical batches [from, to] in chunks, then flushes the live logs buffered in
+// the meantime and switches the subscription to live delivery.
+func (s *SubscriptionManager) backfill(id gethrpc.ID, sub *logSubscription, from uint64, to uint64) {
+	s.backfillSlots <- struct{}{}
+	defer func() { <-s.backfillSlots }()
+
+	filter := sub.Subscription.Filter
+	for start := from; start <= to; start += backfillChunkSize {
+		if !s.isActive(id, sub) {
+			return
+		}
+		end := start + backfillChunkSize - 1
+		if end > to {
+			end = to
+		}
+
+		// the stored logs are already filtered for relevancy to the requesting account
+		logs, err := s.storage.FilterLogs(context.Background(), sub.ViewingKeyEncryptor.AccountAddress, new(big.Int).SetUint64(start), new(big.Int).SetUint64(end), nil, filter.Addresses, filter.Topics)
+		if err != nil {
+			s.logger.Error("Could not backfill the subscription logs", log.SubIDKey, id, log.ErrKey, err)
+			break
+		}
+		if len(logs) > 0 {
+			if !s.deliverBackfill(id, sub, logs) {
+				return
+			}
+		}
+		time.Sleep(backfillChunkInterval)
+	}
+
+	for !s.switchToLive(id, sub) {
+		time.Sleep(backfillChunkInterval)
+	}
+	s.logger.Debug("Subscription backfill complete", log.SubIDKey, id, "from", from, "to", to)
+}
+
+// switchToLive - flushes the live logs buffered during the backfill and ends the backfill mode.
+// The buffered logs are taken under the lock and delivered after releasing it, because the sink can block. The live
+// logs keep being buffered until there is nothing left to flush, so that none can overtake the buffered ones.
+// Returns false if there is no sink to flush to yet.
+func (s *SubscriptionManager) switchToLive(id gethrpc.ID, sub *logSubscription) bool {
+	for {
+		s.subscriptionMutex.Lock()
+		if s.closed || s.subscriptions[id] != sub {
+			s.subscriptionMutex.Unlock()
+			return true
+		}
+		buffered := sub.pending
+		sub.pending = nil
+		if len(buffered) == 0 {
+			sub.backfilling = false
+			s.subscriptionMutex.Unlock()
+			return true
+		}
+		s.subscriptionMutex.Unlock()
+
+		if !s.deliverEncrypted(id, sub, buffered) {
+			// the logs buffered in the meantime are more recent
+			s.subscriptionMutex.Lock()
+			sub.pending = append(buffered, sub.pending...)
+			s.subscriptionMutex.Unlock()
+			return false
+		}
+	}
+}
+
+// deliverBackfill - waits for a sink to be available, then delivers the logs. Returns false if the subscription was
+// removed in the meantime.
+func (s *SubscriptionManager) deliverBackfill(id gethrpc.ID, sub *logSubscription, logs []*types.Log) bool {
+	for {
+		if !s.isActive(id, sub) {
+			return false
+		}
+		if s.deliverEncrypted(id, sub, logs) {
+			return true
+		}
+		time.Sleep(backfillChunkInterval)
+	}
+}
+
+// deliverEncrypted - encrypts the logs with the subscription viewing key and sends them to the sink.
+// Returns false if there is no sink. The sink is called without holding any lock, since it can block.
+func (s *SubscriptionManager) deliverEncrypted(id gethrpc.ID, sub *logSubscription, logs []*types.Log) bool {
+	s.sinkMutex.RLock()
+	sink := s.backfillSink
+	s.sinkMutex.RUnlock()
+	if sink == nil {
+		return false
+	}
+
+	jsonLogs, err := json.Marshal(logs)
+	if err != nil {
+		s.logger.Error("Could not marshal the backfilled logs", log.SubIDKey, id, log.ErrKey, err)
+		return true
+	}
+	encryptedLogs, err := sub.ViewingKeyEncryptor.Encrypt(jsonLogs)
+	if err != nil {
+		s.logger.Error("Could not encrypt the backfilled logs", log.SubIDKey, id, log.ErrKey, err)
+		return true
+	}
+	sink(common.EncryptedSubscriptionLogs{id: encryptedLogs})
+	return true
+}
+
+func (s *SubscriptionManager) isActive(id gethrpc.ID, sub *logSubscription) bool {
+	s.subscriptionMutex.RLock()
+	defer s.subscriptionMutex.RUnlock()
+	return !s.closed && s.subscriptions[id] == sub
+}
+
+// Close ends the backfills and removes the sink. The subscriptions stay persisted, so they are restored on restart,
+// along with the last batch delivered to them.
+func (s *SubscriptionManager) Close() {
+	s.subscriptionMutex.Lock()
+	s.closed = true
+	var deliveredHeight uint64
+	if len(s.subscriptions) > 0 {
+		deliveredHeight = s.liveHeight
+	}
+	s.subscriptionMutex.Unlock()
+	s.persistLastDelivered(deliveredHeight, true)
+	s.SetBackfillSink(nil)
+}
@@ -0,0 +1,132 @@
+package events
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/viewingkey"
+	"github.com/ten-protocol/go-ten/go/enclave/vkhandler"
+)
+
+func subscriptionFrom(fromBlock, toBlock *big.Int) *logSubscription {
+	sub := subscriptionFor("alice", time.Now())
+	sub.Subscription = &common.LogSubscription{Filter: &filters.FilterCriteria{FromBlock: fromBlock, ToBlock: toBlock}}
+	return sub
+}
+
+func TestPastFromBlockIsBackfilledUpToTheLiveHead(t *testing.T) {
+	s := newTestManager(SubscriptionLimits{})
+	s.liveHeight = 10
+
+	sub := subscriptionFrom(big.NewInt(3), nil)
+	from, to, backfill, err := s.prepareBackfill(sub)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if !backfill || from != 3 || to != 10 {
+		t.Fatalf("expected to backfill [3, 10], got %t [%d, %d]", backfill, from, to)
+	}
+	// live delivery takes over exactly after the backfilled range
+	if !sub.backfilling || sub.liveFrom != 11 {
+		t.Fatalf("expected the live logs to be buffered and delivered from 11, got %t %d", sub.backfilling, sub.liveFrom)
+	}
+}
+
+func TestBackfillIsCappedByToBlock(t *testing.T) {
+	s := newTestManager(SubscriptionLimits{})
+	s.liveHeight = 10
+
+	_, to, backfill, _ := s.prepareBackfill(subscriptionFrom(big.NewInt(3), big.NewInt(5)))
+	if !backfill || to != 5 {
+		t.Fatalf("expected the backfill to stop at 5, got %t %d", backfill, to)
+	}
+}
+
+func TestNoBackfillForLiveSubscriptions(t *testing.T) {
+	s := newTestManager(SubscriptionLimits{})
+	s.liveHeight = 10
+
+	for _, fromBlock := range []*big.Int{nil, big.NewInt(-1), big.NewInt(11)} {
+		sub := subscriptionFrom(fromBlock, nil)
+		_, _, backfill, _ := s.prepareBackfill(sub)
+		if backfill || sub.backfilling || sub.liveFrom != 0 {
+			t.Fatalf("unexpected backfill for fromBlock %v", fromBlock)
+		}
+	}
+}
+
+// encryptingSubscription - a subscription whose logs can be encrypted with its viewing key
+func encryptingSubscription(t *testing.T) *logSubscription {
+	userKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vkKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := crypto.PubkeyToAddress(userKey.PublicKey)
+	vkPubKey := crypto.CompressPubkey(&vkKey.PublicKey)
+	signature, err := crypto.Sign(accounts.TextHash([]byte(viewingkey.GenerateSignMessage(vkPubKey))), userKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vk, err := vkhandler.VerifyViewingKey(&viewingkey.RPCSignedViewingKey{Account: &account, PublicKey: vkPubKey, SignatureWithAccountKey: signature}, 443)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub := subscriptionFrom(big.NewInt(1), nil)
+	sub.ViewingKeyEncryptor = vk
+	return sub
+}
+
+func TestSwitchToLiveDoesNotHoldTheLockWhileTheSinkBlocks(t *testing.T) {
+	s := newTestManager(SubscriptionLimits{})
+	sub := encryptingSubscription(t)
+	if err := s.add("1", sub); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	sub.backfilling = true
+	sub.pending = []*types.Log{{Index: 1}}
+
+	flushes := make(chan struct{}, 2)
+	release := make(chan struct{})
+	s.SetBackfillSink(func(common.EncryptedSubscriptionLogs) {
+		flushes <- struct{}{}
+		<-release
+	})
+	switched := make(chan bool)
+	go func() { switched <- s.switchToLive("1", sub) }()
+	<-flushes
+
+	// the live delivery is not blocked by the sink, and keeps buffering while the flush is in progress
+	locked := make(chan struct{})
+	go func() {
+		s.subscriptionMutex.Lock()
+		sub.pending = append(sub.pending, &types.Log{Index: 2})
+		s.subscriptionMutex.Unlock()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the subscription lock is held while the sink blocks")
+	}
+
+	// the log buffered in the meantime is flushed before the subscription goes live
+	close(release)
+	select {
+	case <-flushes:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a second flush")
+	}
+	if !<-switched || sub.backfilling || len(sub.pending) != 0 {
+		t.Fatalf("expected the subscription to be live, got %t with %d pending", sub.backfilling, len(sub.pending))
+	}
+}
@@ -0,0 +1,68 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+)
+
+// AddHeadsSubscription adds a newHeads subscription to the enclave under the given ID. The headers are public, so the
+// subscription has no viewing key, but it counts towards the global cap. Re-adding an existing ID renews it for another
+// keep-alive window.
+func (s *SubscriptionManager) AddHeadsSubscription(id gethrpc.ID) error {
+	s.subscriptionMutex.Lock()
+	defer s.subscriptionMutex.Unlock()
+	if err := s.addHeads(id, time.Now()); err != nil {
+		return err
+	}
+	// the persisted newHeads subscriptions have no encoding
+	s.persist(id, nil)
+	return nil
+}
+
+// addHeads - registers the newHeads subscription if the global cap allows it. Must be called with the subscription
+// mutex held.
+func (s *SubscriptionManager) addHeads(id gethrpc.ID, lastKeepAlive time.Time) error {
+	s.expireStale(lastKeepAlive)
+	if _, renewing := s.headSubscriptions[id]; !renewing && s.subscriptionCount() >= s.limits.GlobalCap {
+		return ErrTooManySubscriptions
+	}
+	s.headSubscriptions[id] = lastKeepAlive
+	return nil
+}
+
+// GetNewHeadsForBatch - the converted Ethereum header of the new head batch, JSON-encoded, for each newHeads
+// subscription. On a fork switch, the new head is delivered like any other.
+// The headers are public, so they are not encrypted.
+func (s *SubscriptionManager) GetNewHeadsForBatch(batch *core.Batch) (map[gethrpc.ID][]byte, error) {
+	s.subscriptionMutex.RLock()
+	defer s.subscriptionMutex.RUnlock()
+	if len(s.headSubscriptions) == 0 {
+		return nil, nil
+	}
+
+	header, err := s.gethEncoding.CreateEthHeaderForBatch(batch.Header)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert the header of batch %s. Cause: %w", batch.Hash(), err)
+	}
+	jsonHeader, err := json.Marshal(header)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal header to JSON. Cause: %w", err)
+	}
+
+	headsByID := make(map[gethrpc.ID][]byte, len(s.headSubscriptions))
+	for id := range s.headSubscriptions {
+		headsByID[id] = jsonHeader
+	}
+	s.logger.Debug("Delivering new head", log.BatchHashKey, batch.Hash(), "subscriptions", len(headsByID))
+	return headsByID, nil
+}
+
+// subscriptionCount - the number of log and newHeads subscriptions. Must be called with the subscription mutex held.
+func (s *SubscriptionManager) subscriptionCount() uint64 {
+	return uint64(len(s.subscriptions) + len(s.headSubscriptions))
+}
@@ -0,0 +1,101 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+)
+
+// testEncoding - converts the batch headers by keeping their number only
+type testEncoding struct {
+	gethencoding.EncodingService
+}
+
+func (testEncoding) CreateEthHeaderForBatch(h *common.BatchHeader) (*types.Header, error) {
+	return &types.Header{Number: h.Number, Difficulty: big.NewInt(0)}, nil
+}
+
+func newTestHeadsManager(limits SubscriptionLimits) *SubscriptionManager {
+	return NewSubscriptionManager(&testStorage{subscriptions: map[gethrpc.ID][]byte{}}, testEncoding{}, 443, limits, gethlog.New())
+}
+
+func TestNewHeadsAreDeliveredUntilUnsubscribed(t *testing.T) {
+	s := newTestHeadsManager(SubscriptionLimits{})
+	if err := s.AddHeadsSubscription("1"); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if err := s.AddHeadsSubscription("2"); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	batch := &core.Batch{Header: &common.BatchHeader{Number: big.NewInt(7)}}
+	heads, err := s.GetNewHeadsForBatch(batch)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if len(heads) != 2 {
+		t.Fatalf("expected the head to be delivered to both subscriptions, got %d", len(heads))
+	}
+	var header types.Header
+	if err := json.Unmarshal(heads["1"], &header); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if header.Number.Uint64() != 7 {
+		t.Fatalf("expected the converted header of the batch, got number %d", header.Number)
+	}
+
+	s.RemoveSubscription("1")
+	s.RemoveSubscription("2")
+	heads, err = s.GetNewHeadsForBatch(batch)
+	if err != nil || heads != nil {
+		t.Fatalf("expected no heads after unsubscribing, got %v, %v", heads, err)
+	}
+	if len(s.storage.(*testStorage).subscriptions) != 0 {
+		t.Fatal("expected the removed subscriptions to be deleted")
+	}
+}
+
+func TestNewHeadsCountTowardsTheGlobalCap(t *testing.T) {
+	s := newTestHeadsManager(SubscriptionLimits{GlobalCap: 2, ViewingKeyCap: 2})
+	_ = s.add("1", subscriptionFor("alice", time.Now()))
+	if err := s.AddHeadsSubscription("2"); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if err := s.AddHeadsSubscription("3"); !errors.Is(err, ErrTooManySubscriptions) {
+		t.Fatalf("expected the global cap to be hit, got %v", err)
+	}
+	if err := s.add("3", subscriptionFor("bob", time.Now())); !errors.Is(err, ErrTooManySubscriptions) {
+		t.Fatalf("expected the global cap to be hit, got %v", err)
+	}
+	// renewing does not consume more quota
+	if err := s.AddHeadsSubscription("2"); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if stats := s.Stats(); stats.Active != 1 || stats.Heads != 1 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
+
+func TestPersistedNewHeadsAreRestored(t *testing.T) {
+	s := newTestHeadsManager(SubscriptionLimits{})
+	if err := s.AddHeadsSubscription("1"); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	restarted := NewSubscriptionManager(s.storage, testEncoding{}, 443, SubscriptionLimits{}, gethlog.New())
+	if err := restarted.RestoreSubscriptions(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if _, found := restarted.headSubscriptions["1"]; !found {
+		t.Fatal("expected the newHeads subscription to be restored")
+	}
+}
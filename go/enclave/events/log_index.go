@@ -0,0 +1,115 @@
+package events
+
+import (
+	"runtime"
+	"sort"
+	"sync"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// logIndex - an inverted index of the logs of a batch, built once per batch so that each subscription only looks at
+// the logs emitted by the contracts and events it filters for, instead of every log of the batch.
+type logIndex struct {
+	logs      []*types.Log
+	byAddress map[gethcommon.Address][]int
+	byTopic0  map[gethcommon.Hash][]int
+}
+
+func newLogIndex(logs []*types.Log) *logIndex {
+	idx := &logIndex{
+		logs:      logs,
+		byAddress: map[gethcommon.Address][]int{},
+		byTopic0:  map[gethcommon.Hash][]int{},
+	}
+	for i, l := range logs {
+		idx.byAddress[l.Address] = append(idx.byAddress[l.Address], i)
+		if len(l.Topics) > 0 {
+			idx.byTopic0[l.Topics[0]] = append(idx.byTopic0[l.Topics[0]], i)
+		}
+	}
+	return idx
+}
+
+// candidates - returns the logs that can possibly match the filter, in their original order.
+// Filters that don't restrict the address or the first topic fall back to all the logs.
+func (idx *logIndex) candidates(addresses []gethcommon.Address, topics [][]gethcommon.Hash) []*types.Log {
+	var positions map[int]struct{}
+
+	if len(addresses) > 0 {
+		positions = map[int]struct{}{}
+		for _, addr := range addresses {
+			for _, p := range idx.byAddress[addr] {
+				positions[p] = struct{}{}
+			}
+		}
+	}
+
+	if len(topics) > 0 && len(topics[0]) > 0 {
+		byTopic := map[int]struct{}{}
+		for _, topic := range topics[0] {
+			for _, p := range idx.byTopic0[topic] {
+				// when both the address and the topic are set, a log must match both
+				if _, found := positions[p]; positions == nil || found {
+					byTopic[p] = struct{}{}
+				}
+			}
+		}
+		positions = byTopic
+	}
+
+	// wildcard filter
+	if positions == nil {
+		return idx.logs
+	}
+
+	sorted := make([]int, 0, len(positions))
+	for p := range positions {
+		sorted = append(sorted, p)
+	}
+	sort.Ints(sorted)
+	result := make([]*types.Log, len(sorted))
+	for i, p := range sorted {
+		result[i] = idx.logs[p]
+	}
+	return result
+}
+
+// split - divides the items in one contiguous part per worker of forEachParallel
+func split[T any](items []T) [][]T {
+	parts := runtime.NumCPU()
+	if parts > len(items) {
+		parts = len(items)
+	}
+	result := make([][]T, 0, parts)
+	for i := 0; i < parts; i++ {
+		result = append(result, items[i*len(items)/parts:(i+1)*len(items)/parts])
+	}
+	return result
+}
+
+// forEachParallel - runs fn for every item on a bounded pool of workers and waits for all of them
+func forEachParallel[T any](items []T, fn func(T)) {
+	workers := runtime.NumCPU()
+	if workers > len(items) {
+		workers = len(items)
+	}
+
+	jobs := make(chan T)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for item := range jobs {
+				fn(item)
+			}
+		}()
+	}
+	for _, item := range items {
+		jobs <- item
+	}
+	close(jobs)
+	wg.Wait()
+}
@@ -0,0 +1,76 @@
+package events
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+func testLogs(count int, contracts int, events int) []*types.Log {
+	logs := make([]*types.Log, count)
+	for i := range logs {
+		logs[i] = &types.Log{
+			Address: gethcommon.BigToAddress(big.NewInt(int64(i % contracts))),
+			Topics:  []gethcommon.Hash{gethcommon.BigToHash(big.NewInt(int64(i % events)))},
+			Index:   uint(i),
+		}
+	}
+	return logs
+}
+
+func TestIndexCandidatesMatchTheLinearScan(t *testing.T) {
+	logs := testLogs(500, 7, 5)
+	idx := newLogIndex(logs)
+
+	addr := func(i int64) gethcommon.Address { return gethcommon.BigToAddress(big.NewInt(i)) }
+	topic := func(i int64) gethcommon.Hash { return gethcommon.BigToHash(big.NewInt(i)) }
+	filters := []struct {
+		addresses []gethcommon.Address
+		topics    [][]gethcommon.Hash
+	}{
+		{nil, nil},
+		{[]gethcommon.Address{addr(1)}, nil},
+		{[]gethcommon.Address{addr(1), addr(3)}, nil},
+		{nil, [][]gethcommon.Hash{{topic(2)}}},
+		{[]gethcommon.Address{addr(2)}, [][]gethcommon.Hash{{topic(2), topic(4)}}},
+		{[]gethcommon.Address{addr(2)}, [][]gethcommon.Hash{{}, {topic(2)}}},
+		{[]gethcommon.Address{addr(100)}, nil},
+	}
+	for i, f := range filters {
+		expected := filterLogs(logs, nil, nil, f.addresses, f.topics, gethlog.New())
+		actual := filterLogs(idx.candidates(f.addresses, f.topics), nil, nil, f.addresses, f.topics, gethlog.New())
+		if fmt.Sprint(expected) != fmt.Sprint(actual) {
+			t.Fatalf("filter %d: the indexed logs differ from the linear scan", i)
+		}
+	}
+}
+
+// BenchmarkSubscriptionMatching - 1000 subscriptions, each following one of 100 contracts, against a batch of 5000 logs
+func BenchmarkSubscriptionMatching(b *testing.B) {
+	logs := testLogs(5000, 100, 10)
+	logger := gethlog.New()
+	subscriptionAddresses := make([][]gethcommon.Address, 1000)
+	for i := range subscriptionAddresses {
+		subscriptionAddresses[i] = []gethcommon.Address{gethcommon.BigToAddress(big.NewInt(int64(i % 100)))}
+	}
+
+	b.Run("linear", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			for _, addresses := range subscriptionAddresses {
+				filterLogs(logs, nil, nil, addresses, nil, logger)
+			}
+		}
+	})
+	b.Run("indexed", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			idx := newLogIndex(logs)
+			for _, addresses := range subscriptionAddresses {
+				filterLogs(idx.candidates(addresses, nil), nil, nil, addresses, nil, logger)
+			}
+		}
+	})
+}
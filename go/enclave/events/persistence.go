@@ -0,0 +1,116 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/log"
+)
+
+// lastDeliveredPersistInterval - how often the last delivered batch is stored while the batches are produced
+const lastDeliveredPersistInterval = 5 * time.Second
+
+// RestoreSubscriptions - reloads the subscriptions persisted before the enclave restarted. The logs of the batches
+// executed since the last delivered batch are backfilled, so the clients don't miss any events.
+// Must be called before the logs are streamed.
+func (s *SubscriptionManager) RestoreSubscriptions() error {
+	subscriptions, err := s.storage.FetchSubscriptions()
+	if err != nil {
+		// nothing could have been persisted before the enclave received the shared secret
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("could not fetch the persisted subscriptions. Cause: %w", err)
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	lastDelivered, err := s.storage.FetchLastDeliveredBatch()
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return fmt.Errorf("could not fetch the last delivered batch. Cause: %w", err)
+	}
+
+	s.subscriptionMutex.Lock()
+	defer s.subscriptionMutex.Unlock()
+	head, err := s.currentHead()
+	if err != nil {
+		return err
+	}
+	s.liveHeight = head
+
+	for id, encodedSubscription := range subscriptions {
+		// the newHeads subscriptions have no encoding, and start again from the current head
+		if len(encodedSubscription) == 0 {
+			if err := s.addHeads(id, time.Now()); err != nil {
+				s.logger.Info("Dropping persisted subscription", log.SubIDKey, id, log.ErrKey, err)
+				s.unpersist(id)
+			}
+			continue
+		}
+		sub, err := s.decodeSubscription(encodedSubscription)
+		if err != nil {
+			// e.g. the viewing key expired
+			s.logger.Info("Dropping persisted subscription", log.SubIDKey, id, log.ErrKey, err)
+			s.unpersist(id)
+			continue
+		}
+
+		from := lastDelivered + 1
+		if fromBlock := sub.Subscription.Filter.FromBlock; fromBlock != nil && fromBlock.Sign() > 0 && fromBlock.Uint64() > from {
+			from = fromBlock.Uint64()
+		}
+		to, backfill := s.startBackfill(sub, from, head)
+		if err := s.add(id, sub); err != nil {
+			s.logger.Info("Dropping persisted subscription", log.SubIDKey, id, log.ErrKey, err)
+			s.unpersist(id)
+			continue
+		}
+		if backfill {
+			go s.backfill(id, sub, from, to)
+		}
+	}
+	s.logger.Info("Restored log subscriptions", "restored", len(s.subscriptions), "heads", len(s.headSubscriptions), "lastDeliveredBatch", lastDelivered)
+	return nil
+}
+
+// persist - stores the subscription, so it survives enclave restarts. Must be called with the subscription mutex held.
+func (s *SubscriptionManager) persist(id gethrpc.ID, encodedSubscription []byte) {
+	if err := s.storage.StoreSubscription(id, encodedSubscription); err != nil {
+		s.logger.Warn("Could not persist subscription. It will not survive an enclave restart", log.SubIDKey, id, log.ErrKey, err)
+	}
+	// the first subscription marks where its delivery starts from
+	if len(s.subscriptions) == 1 {
+		s.persistLastDelivered(s.liveHeight, true)
+	}
+}
+
+// unpersist - deletes a subscription that was removed. Must be called with the subscription mutex held.
+func (s *SubscriptionManager) unpersist(id gethrpc.ID) {
+	if err := s.storage.DeleteSubscription(id); err != nil {
+		s.logger.Warn("Could not delete persisted subscription", log.SubIDKey, id, log.ErrKey, err)
+	}
+}
+
+// persistLastDelivered - stores the height of the last batch whose logs were delivered, at most once per
+// lastDeliveredPersistInterval unless forced. The restored subscriptions are backfilled from the stored height, so a
+// height behind the delivered batches only means that their logs are delivered again after a restart.
+func (s *SubscriptionManager) persistLastDelivered(height uint64, force bool) {
+	if height == 0 {
+		return
+	}
+	s.persistMutex.Lock()
+	defer s.persistMutex.Unlock()
+	if !force && (height == s.persistedHeight || time.Since(s.lastPersisted) < lastDeliveredPersistInterval) {
+		return
+	}
+	if err := s.storage.StoreLastDeliveredBatch(height); err != nil {
+		s.logger.Warn("Could not persist the last delivered batch", log.ErrKey, err)
+		return
+	}
+	s.persistedHeight = height
+	s.lastPersisted = time.Now()
+}
@@ -0,0 +1,88 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+)
+
+func (t *testStorage) FetchSubscriptions() (map[gethrpc.ID][]byte, error) {
+	return t.subscriptions, nil
+}
+
+func (t *testStorage) FetchLastDeliveredBatch() (uint64, error) {
+	return t.lastDelivered, nil
+}
+
+func (t *testStorage) FetchHeadBatch() (*core.Batch, error) {
+	return nil, errutil.ErrNotFound
+}
+
+func TestInvalidPersistedSubscriptionsAreDropped(t *testing.T) {
+	s := newTestManager(SubscriptionLimits{})
+	persisted := s.storage.(*testStorage)
+	persisted.subscriptions["1"] = []byte("not a subscription")
+
+	if err := s.RestoreSubscriptions(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if len(s.subscriptions) != 0 || len(persisted.subscriptions) != 0 {
+		t.Fatal("expected the invalid subscription to be dropped")
+	}
+}
+
+func TestRemovedSubscriptionsAreNotPersisted(t *testing.T) {
+	s := newTestManager(SubscriptionLimits{KeepAlive: time.Minute})
+	persisted := s.storage.(*testStorage)
+
+	persisted.subscriptions["1"] = nil
+	persisted.subscriptions["2"] = nil
+	_ = s.add("1", subscriptionFor("alice", time.Now()))
+	_ = s.add("2", subscriptionFor("alice", time.Now().Add(-2*time.Minute)))
+
+	s.RemoveSubscription("1")
+	s.expireStale(time.Now())
+	if len(persisted.subscriptions) != 0 {
+		t.Fatal("expected the removed and the expired subscriptions to be deleted")
+	}
+}
+
+func TestTheLastDeliveredBatchIsPersistedOnceInAWhile(t *testing.T) {
+	s := newTestManager(SubscriptionLimits{})
+	persisted := s.storage.(*testStorage)
+	_ = s.add("1", subscriptionFor("alice", time.Now()))
+
+	var batch *core.Batch
+	for seqNo := int64(1); seqNo <= 3; seqNo++ {
+		batch = testBatch(batch, seqNo)
+		if _, err := s.GetSubscribedLogsForBatch(batch, nil); err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+	}
+	if persisted.lastDelivered != 1 || persisted.lastDeliveredWrites != 1 {
+		t.Fatalf("expected only the first batch to be persisted, got %d after %d writes", persisted.lastDelivered, persisted.lastDeliveredWrites)
+	}
+
+	// once the interval elapsed, the next batch is persisted
+	s.lastPersisted = time.Now().Add(-lastDeliveredPersistInterval)
+	batch = testBatch(batch, 4)
+	if _, err := s.GetSubscribedLogsForBatch(batch, nil); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if persisted.lastDelivered != 4 {
+		t.Fatalf("expected batch 4 to be persisted, got %d", persisted.lastDelivered)
+	}
+
+	// the last batch delivered is persisted when the enclave stops
+	batch = testBatch(batch, 5)
+	if _, err := s.GetSubscribedLogsForBatch(batch, nil); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	s.Close()
+	if persisted.lastDelivered != 5 || persisted.lastDeliveredWrites != 3 {
+		t.Fatalf("expected batch 5 to be persisted on close, got %d after %d writes", persisted.lastDelivered, persisted.lastDeliveredWrites)
+	}
+}
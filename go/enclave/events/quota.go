@@ -0,0 +1,161 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/responses"
+)
+
+const (
+	defaultGlobalCap     = 10_000
+	defaultViewingKeyCap = 100
+	defaultHealthBacklog = 10_000
+)
+
+var (
+	// ErrTooManySubscriptions - the enclave already serves the maximum number of log subscriptions
+	ErrTooManySubscriptions = responses.WithCode(responses.ErrCodeTooManySubscriptions, errors.New("too many log subscriptions"))
+	// ErrTooManyViewingKeySubscriptions - the viewing key already registered the maximum number of log subscriptions
+	ErrTooManyViewingKeySubscriptions = responses.WithCode(responses.ErrCodeTooManySubscriptions, errors.New("too many log subscriptions for this viewing key"))
+)
+
+// SubscriptionLimits - bound the number of log subscriptions, so a single client can't slow down the log matching
+// performed after every batch, and the memory used to track them. Zero caps and thresholds mean the defaults.
+type SubscriptionLimits struct {
+	// GlobalCap - the maximum number of subscriptions across all clients, including the newHeads subscriptions
+	GlobalCap uint64
+	// ViewingKeyCap - the maximum number of subscriptions registered with the same viewing key
+	ViewingKeyCap uint64
+	// KeepAlive - subscriptions that were not renewed by the client within this window are dropped. Zero disables it.
+	KeepAlive time.Duration
+	// ReorgDepth - the number of batches for which the delivered logs are remembered, to flag them as removed on reorgs
+	ReorgDepth uint64
+	// HealthBacklog - the subscriptions are unhealthy when more logs than this are waiting to be delivered
+	HealthBacklog uint64
+}
+
+func (l SubscriptionLimits) withDefaults() SubscriptionLimits {
+	if l.GlobalCap == 0 {
+		l.GlobalCap = defaultGlobalCap
+	}
+	if l.ViewingKeyCap == 0 {
+		l.ViewingKeyCap = defaultViewingKeyCap
+	}
+	if l.ReorgDepth == 0 {
+		l.ReorgDepth = defaultReorgDepth
+	}
+	if l.HealthBacklog == 0 {
+		l.HealthBacklog = defaultHealthBacklog
+	}
+	return l
+}
+
+// SubscriptionStats - counters describing the subscriptions served by the enclave
+type SubscriptionStats struct {
+	Active      uint64 // subscriptions currently matched against every batch
+	Heads       uint64 // newHeads subscriptions, which receive every new head
+	ViewingKeys uint64 // distinct viewing keys owning the active subscriptions
+	Expired     uint64 // subscriptions dropped because they were not renewed within the keep-alive window
+	Backfilling uint64 // subscriptions whose historical logs are still being delivered
+	Backlog     uint64 // live logs buffered until the backfill of their subscription completes
+}
+
+// add - registers the subscription if the quota allows it. Re-adding an existing ID replaces it and counts as a keep-alive.
+// Must be called with the subscription mutex held.
+func (s *SubscriptionManager) add(id gethrpc.ID, sub *logSubscription) error {
+	s.expireStale(sub.lastKeepAlive)
+
+	userID := sub.ViewingKeyEncryptor.UserID
+	existing, replacing := s.subscriptions[id]
+	sameOwner := replacing && existing.ViewingKeyEncryptor.UserID == userID
+
+	if !replacing && s.subscriptionCount() >= s.limits.GlobalCap {
+		return ErrTooManySubscriptions
+	}
+	if !sameOwner && s.subscriptionsPerVK[userID] >= s.limits.ViewingKeyCap {
+		return ErrTooManyViewingKeySubscriptions
+	}
+
+	if replacing {
+		s.remove(id)
+	}
+	s.subscriptions[id] = sub
+	s.subscriptionsPerVK[userID]++
+	return nil
+}
+
+// remove - drops the subscription and releases its quota. Must be called with the subscription mutex held.
+func (s *SubscriptionManager) remove(id gethrpc.ID) bool {
+	if _, found := s.headSubscriptions[id]; found {
+		delete(s.headSubscriptions, id)
+		return true
+	}
+	sub, found := s.subscriptions[id]
+	if !found {
+		return false
+	}
+	delete(s.subscriptions, id)
+
+	userID := sub.ViewingKeyEncryptor.UserID
+	s.subscriptionsPerVK[userID]--
+	if s.subscriptionsPerVK[userID] == 0 {
+		delete(s.subscriptionsPerVK, userID)
+	}
+	return true
+}
+
+// expireStale - drops the subscriptions that were not renewed within the keep-alive window.
+// Must be called with the subscription mutex held.
+func (s *SubscriptionManager) expireStale(now time.Time) {
+	if s.limits.KeepAlive == 0 {
+		return
+	}
+	for id, sub := range s.subscriptions {
+		if now.Sub(sub.lastKeepAlive) > s.limits.KeepAlive {
+			s.remove(id)
+			s.unpersist(id)
+			s.expired++
+			s.logger.Info("Log subscription expired", log.SubIDKey, id)
+		}
+	}
+	for id, lastKeepAlive := range s.headSubscriptions {
+		if now.Sub(lastKeepAlive) > s.limits.KeepAlive {
+			s.remove(id)
+			s.unpersist(id)
+			s.expired++
+			s.logger.Info("NewHeads subscription expired", log.SubIDKey, id)
+		}
+	}
+}
+
+// Stats returns the counters of the active subscriptions
+func (s *SubscriptionManager) Stats() SubscriptionStats {
+	s.subscriptionMutex.RLock()
+	defer s.subscriptionMutex.RUnlock()
+	stats := SubscriptionStats{
+		Active:      uint64(len(s.subscriptions)),
+		Heads:       uint64(len(s.headSubscriptions)),
+		ViewingKeys: uint64(len(s.subscriptionsPerVK)),
+		Expired:     s.expired,
+	}
+	for _, sub := range s.subscriptions {
+		if sub.backfilling {
+			stats.Backfilling++
+			stats.Backlog += uint64(len(sub.pending))
+		}
+	}
+	return stats
+}
+
+// HealthCheck - the subscriptions are healthy while the logs waiting to be delivered stay below the backlog threshold
+func (s *SubscriptionManager) HealthCheck() (bool, error) {
+	stats := s.Stats()
+	if stats.Backlog > s.limits.HealthBacklog {
+		return false, fmt.Errorf("%d logs are waiting for the backfill of %d subscriptions to complete", stats.Backlog, stats.Backfilling)
+	}
+	return true, nil
+}
@@ -0,0 +1,141 @@
+package events
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/vkhandler"
+	"github.com/ten-protocol/go-ten/go/responses"
+)
+
+// testStorage - keeps the persisted subscriptions in memory
+type testStorage struct {
+	storage.Storage
+	subscriptions       map[gethrpc.ID][]byte
+	lastDelivered       uint64
+	lastDeliveredWrites int
+}
+
+func (t *testStorage) StoreSubscription(id gethrpc.ID, encodedSubscription []byte) error {
+	t.subscriptions[id] = encodedSubscription
+	return nil
+}
+
+func (t *testStorage) DeleteSubscription(id gethrpc.ID) error {
+	delete(t.subscriptions, id)
+	return nil
+}
+
+func (t *testStorage) StoreLastDeliveredBatch(height uint64) error {
+	t.lastDelivered = height
+	t.lastDeliveredWrites++
+	return nil
+}
+
+func newTestManager(limits SubscriptionLimits) *SubscriptionManager {
+	return NewSubscriptionManager(&testStorage{subscriptions: map[gethrpc.ID][]byte{}}, nil, 443, limits, gethlog.New())
+}
+
+func subscriptionFor(userID string, lastKeepAlive time.Time) *logSubscription {
+	return &logSubscription{
+		ViewingKeyEncryptor: &vkhandler.AuthenticatedViewingKey{UserID: userID},
+		lastKeepAlive:       lastKeepAlive,
+	}
+}
+
+func TestViewingKeyCapIsEnforced(t *testing.T) {
+	s := newTestManager(SubscriptionLimits{GlobalCap: 10, ViewingKeyCap: 2})
+	now := time.Now()
+
+	for _, id := range []gethrpc.ID{"1", "2"} {
+		if err := s.add(id, subscriptionFor("alice", now)); err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+	}
+	err := s.add("3", subscriptionFor("alice", now))
+	if !errors.Is(err, ErrTooManyViewingKeySubscriptions) {
+		t.Fatalf("expected the viewing key cap to be hit, got %v", err)
+	}
+	if code := responses.ErrorCodeOf(err); code != responses.ErrCodeTooManySubscriptions {
+		t.Fatalf("expected the cap to be a user error with its own code, got %d", code)
+	}
+	// renewing an existing subscription does not consume more quota
+	if err := s.add("2", subscriptionFor("alice", now)); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	// other viewing keys are not affected
+	if err := s.add("3", subscriptionFor("bob", now)); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	// removing a subscription releases its quota
+	s.remove("1")
+	if err := s.add("4", subscriptionFor("alice", now)); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if stats := s.Stats(); stats.Active != 3 || stats.ViewingKeys != 2 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
+
+func TestGlobalCapIsEnforced(t *testing.T) {
+	s := newTestManager(SubscriptionLimits{GlobalCap: 2, ViewingKeyCap: 2})
+	now := time.Now()
+
+	_ = s.add("1", subscriptionFor("alice", now))
+	_ = s.add("2", subscriptionFor("bob", now))
+	err := s.add("3", subscriptionFor("carol", now))
+	if !errors.Is(err, ErrTooManySubscriptions) {
+		t.Fatalf("expected the global cap to be hit, got %v", err)
+	}
+	if code := responses.ErrorCodeOf(err); code != responses.ErrCodeTooManySubscriptions {
+		t.Fatalf("expected the cap to be a user error with its own code, got %d", code)
+	}
+}
+
+func TestStaleSubscriptionsExpire(t *testing.T) {
+	s := newTestManager(SubscriptionLimits{GlobalCap: 2, ViewingKeyCap: 2, KeepAlive: time.Minute})
+	now := time.Now()
+
+	_ = s.add("1", subscriptionFor("alice", now.Add(-2*time.Minute)))
+	_ = s.add("2", subscriptionFor("alice", now))
+
+	// the stale subscription is dropped, which makes room for the new one
+	if err := s.add("3", subscriptionFor("alice", now)); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if _, found := s.subscriptions["1"]; found {
+		t.Fatal("expected the stale subscription to be dropped")
+	}
+	if stats := s.Stats(); stats.Active != 2 || stats.Expired != 1 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
+
+func TestBacklogOverTheThresholdIsUnhealthy(t *testing.T) {
+	s := newTestManager(SubscriptionLimits{HealthBacklog: 2})
+	now := time.Now()
+
+	// the live logs are buffered while the historical ones are delivered
+	backfilling := subscriptionFor("alice", now)
+	backfilling.backfilling = true
+	_ = s.add("1", backfilling)
+	_ = s.add("2", subscriptionFor("bob", now))
+	backfilling.pending = make([]*types.Log, 2)
+	if healthy, err := s.HealthCheck(); !healthy || err != nil {
+		t.Fatalf("expected a backlog at the threshold to be healthy, got %v", err)
+	}
+
+	backfilling.pending = append(backfilling.pending, &types.Log{})
+	if healthy, err := s.HealthCheck(); healthy || err == nil {
+		t.Fatal("expected a backlog over the threshold to be unhealthy")
+	}
+	if stats := s.Stats(); stats.Backfilling != 1 || stats.Backlog != 3 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
@@ -0,0 +1,140 @@
+package events
+
+import (
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/visibility"
+)
+
+const (
+	// The leading zero bytes in a hash indicating that it is possibly an address, since it only has 20 bytes of data.
+	zeroBytesHex = "000000000000000000000000"
+)
+
+// LogRelevancy - the accounts to which the log is visible, with the outcome of each of its topics. The rule configured
+// by the contract for the event is read from rulesDB, the state before the batch of the log. The user accounts are
+// looked up in db. The sender of the transaction is only needed by the sender-only rule, and is nil when unknown.
+func LogRelevancy(log *types.Log, sender *gethcommon.Address, rulesDB *state.StateDB, db *state.StateDB) common.LogRelevancy {
+	relevancy := common.LogRelevancy{
+		TxHash:    log.TxHash,
+		Address:   log.Address,
+		Topics:    make([]common.TopicRelevancy, 0, len(log.Topics)),
+		Rule:      common.LifecycleEventRule,
+		Public:    true,
+		VisibleTo: []gethcommon.Address{},
+	}
+	var userAddrs []gethcommon.Address
+	for i, topic := range log.Topics {
+		outcome := common.EventSignatureTopic
+		if i > 0 {
+			var userAddr *gethcommon.Address
+			outcome, userAddr = classifyTopic(topic, db)
+			if userAddr != nil {
+				userAddrs = append(userAddrs, *userAddr)
+			}
+		}
+		relevancy.Topics = append(relevancy.Topics, common.TopicRelevancy{Topic: topic, Outcome: outcome})
+	}
+
+	// the rule configured by the contract replaces the heuristics
+	switch visibility.RuleOf(log, rulesDB) {
+	case visibility.Public:
+		relevancy.Rule = common.ConfiguredPublicRule
+	case visibility.Topic1Owner:
+		relevancy.Rule, relevancy.Public = common.ConfiguredTopic1OwnerRule, false
+		if owner := visibility.Topic1Account(log); owner != nil {
+			relevancy.VisibleTo = append(relevancy.VisibleTo, *owner)
+		}
+	case visibility.SenderOnly:
+		relevancy.Rule, relevancy.Public = common.ConfiguredSenderRule, false
+		if sender != nil {
+			relevancy.VisibleTo = append(relevancy.VisibleTo, *sender)
+		}
+	default:
+		if len(userAddrs) > 0 {
+			relevancy.Rule, relevancy.Public = common.TopicAddressRule, false
+			relevancy.VisibleTo = append(relevancy.VisibleTo, userAddrs...)
+		}
+	}
+	return relevancy
+}
+
+// isVisibleTo - whether the account can see the log
+func isVisibleTo(relevancy common.LogRelevancy, account *gethcommon.Address) bool {
+	if relevancy.Public {
+		return true
+	}
+	for _, addr := range relevancy.VisibleTo {
+		if addr == *account {
+			return true
+		}
+	}
+	return false
+}
+
+// txSenders - the senders of the transactions, by hash. The transactions whose sender can't be recovered are left out.
+func txSenders(txs []*common.L2Tx) map[gethcommon.Hash]*gethcommon.Address {
+	senders := make(map[gethcommon.Hash]*gethcommon.Address, len(txs))
+	for _, tx := range txs {
+		if sender, err := core.GetTxSigner(tx); err == nil {
+			senders[tx.Hash()] = &sender
+		}
+	}
+	return senders
+}
+
+// logRelevancies - the relevancy of each log. The user addresses are extracted by a pool of workers, each resolving
+// a share of the logs on its own copy of the state, as the stateDB is not safe for concurrent use.
+func logRelevancies(logs []*types.Log, senders map[gethcommon.Hash]*gethcommon.Address, rulesDB *state.StateDB, db *state.StateDB) map[*types.Log]common.LogRelevancy {
+	type share struct {
+		logs        []*types.Log
+		relevancies []common.LogRelevancy
+		rulesDB     *state.StateDB
+		db          *state.StateDB
+	}
+	// the copies are made before the workers start, as copying reads the original state
+	var shares []*share
+	for _, part := range split(logs) {
+		shares = append(shares, &share{logs: part, relevancies: make([]common.LogRelevancy, len(part)), rulesDB: rulesDB.Copy(), db: db.Copy()})
+	}
+	forEachParallel(shares, func(sh *share) {
+		for i, logItem := range sh.logs {
+			sh.relevancies[i] = LogRelevancy(logItem, senders[logItem.TxHash], sh.rulesDB, sh.db)
+		}
+	})
+
+	relevancyOfLog := make(map[*types.Log]common.LogRelevancy, len(logs))
+	for _, sh := range shares {
+		for i, logItem := range sh.logs {
+			relevancyOfLog[logItem] = sh.relevancies[i]
+		}
+	}
+	return relevancyOfLog
+}
+
+// classifyTopic - whether the topic is a user address. A topic is considered a user address if:
+//   - It has 12 leading zero bytes (since addresses are 20 bytes long, while hashes are 32)
+//   - It has a non-zero nonce (to prevent accidental or malicious creation of the address matching a given topic,
+//     forcing its events to become permanently private
+//   - It does not have associated code (meaning it's a smart-contract address)
+func classifyTopic(topic gethcommon.Hash, db *state.StateDB) (common.TopicOutcome, *gethcommon.Address) {
+	if topic.Hex()[2:len(zeroBytesHex)+2] != zeroBytesHex {
+		return common.NotAnAddressTopic, nil
+	}
+
+	potentialAddr := gethcommon.BytesToAddress(topic.Bytes())
+
+	// A user address must have a non-zero nonce. This prevents accidental or malicious sending of funds to an
+	// address matching a topic, forcing its events to become permanently private.
+	if db.GetNonce(potentialAddr) == 0 {
+		return common.NoNonceTopic, nil
+	}
+	// If the address has code, it's a smart contract address instead.
+	if db.GetCode(potentialAddr) != nil {
+		return common.ContractTopic, nil
+	}
+	return common.UserAccountTopic, &potentialAddr
+}
@@ -0,0 +1,137 @@
+package events
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/visibility"
+)
+
+func TestLogRelevancyExplainsEachTopic(t *testing.T) {
+	db, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, contract, fresh := gethcommon.HexToAddress("0x01"), gethcommon.HexToAddress("0x02"), gethcommon.HexToAddress("0x03")
+	db.SetNonce(user, 1)
+	db.SetNonce(contract, 1)
+	db.SetCode(contract, []byte{0x00})
+
+	signature := gethcommon.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	toTopic := func(addr gethcommon.Address) gethcommon.Hash { return gethcommon.BytesToHash(addr.Bytes()) }
+	logItem := &types.Log{Topics: []gethcommon.Hash{signature, toTopic(user), toTopic(contract), toTopic(fresh)}}
+
+	relevancy := LogRelevancy(logItem, nil, db, db)
+	if relevancy.Rule != common.TopicAddressRule {
+		t.Fatalf("expected the topic address rule, got %s", relevancy.Rule)
+	}
+	if len(relevancy.VisibleTo) != 1 || relevancy.VisibleTo[0] != user {
+		t.Fatalf("expected the log to only be visible to the user account, got %v", relevancy.VisibleTo)
+	}
+	expected := []common.TopicOutcome{common.EventSignatureTopic, common.UserAccountTopic, common.ContractTopic, common.NoNonceTopic}
+	for i, topic := range relevancy.Topics {
+		if topic.Outcome != expected[i] {
+			t.Fatalf("expected the outcome %s for topic %d, got %s", expected[i], i, topic.Outcome)
+		}
+	}
+
+	// without a user account among its topics, the log is visible to everyone
+	lifecycle := LogRelevancy(&types.Log{Topics: []gethcommon.Hash{signature, signature, toTopic(contract)}}, nil, db, db)
+	if lifecycle.Rule != common.LifecycleEventRule || !lifecycle.Public || len(lifecycle.VisibleTo) != 0 {
+		t.Fatalf("expected a lifecycle event, got %s visible to %v", lifecycle.Rule, lifecycle.VisibleTo)
+	}
+	if lifecycle.Topics[1].Outcome != common.NotAnAddressTopic {
+		t.Fatalf("expected the signature not to be an address, got %s", lifecycle.Topics[1].Outcome)
+	}
+}
+
+func TestConfiguredRulesReplaceTheHeuristics(t *testing.T) {
+	db, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, other, sender := gethcommon.HexToAddress("0x01"), gethcommon.HexToAddress("0x02"), gethcommon.HexToAddress("0x03")
+	db.SetNonce(user, 1)
+	db.SetNonce(other, 1)
+
+	contract := gethcommon.HexToAddress("0xc0")
+	transfer, approval, deposit := gethcommon.HexToHash("0xaa"), gethcommon.HexToHash("0xbb"), gethcommon.HexToHash("0xcc")
+	configure := func(event gethcommon.Hash, rule visibility.Rule) *types.Log {
+		return &types.Log{Address: contract, Topics: []gethcommon.Hash{visibility.ConfigEvent, event}, Data: gethcommon.BigToHash(big.NewInt(int64(rule))).Bytes()}
+	}
+	rulesDB := db.Copy()
+	visibility.Record(types.Receipts{{Logs: []*types.Log{configure(transfer, visibility.Public), configure(approval, visibility.Topic1Owner), configure(deposit, visibility.SenderOnly)}}}, rulesDB, gethlog.New())
+
+	toTopic := func(addr gethcommon.Address) gethcommon.Hash { return gethcommon.BytesToHash(addr.Bytes()) }
+	for event, expected := range map[gethcommon.Hash]struct {
+		rule    common.RelevancyRule
+		visible []gethcommon.Address
+		hidden  []gethcommon.Address
+	}{
+		transfer: {common.ConfiguredPublicRule, []gethcommon.Address{user, other, sender}, nil},
+		approval: {common.ConfiguredTopic1OwnerRule, []gethcommon.Address{other}, []gethcommon.Address{user, sender}},
+		deposit:  {common.ConfiguredSenderRule, []gethcommon.Address{sender}, []gethcommon.Address{user, other}},
+	} {
+		// by default, the log would only be visible to the user of the second topic
+		logItem := &types.Log{Address: contract, Topics: []gethcommon.Hash{event, toTopic(other), toTopic(user)}}
+		if LogRelevancy(logItem, &sender, db, db).Rule != common.TopicAddressRule {
+			t.Fatal("expected the heuristics to apply before the rule is configured")
+		}
+
+		relevancy := LogRelevancy(logItem, &sender, rulesDB, db)
+		if relevancy.Rule != expected.rule {
+			t.Fatalf("expected the rule %s, got %s", expected.rule, relevancy.Rule)
+		}
+		for _, account := range expected.visible {
+			account := account
+			if !isVisibleTo(relevancy, &account) {
+				t.Fatalf("expected the %s log to be visible to %s", relevancy.Rule, account)
+			}
+		}
+		for _, account := range expected.hidden {
+			account := account
+			if isVisibleTo(relevancy, &account) {
+				t.Fatalf("expected the %s log to be hidden from %s", relevancy.Rule, account)
+			}
+		}
+	}
+}
+
+func TestParallelRelevanciesMatchTheSequentialOnes(t *testing.T) {
+	db, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature := gethcommon.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	var logs []*types.Log
+	for i := 1; i <= 200; i++ {
+		account := gethcommon.BigToAddress(big.NewInt(int64(i)))
+		// only the even accounts are users, the others have no nonce
+		if i%2 == 0 {
+			db.SetNonce(account, 1)
+		}
+		logs = append(logs, &types.Log{TxHash: gethcommon.BigToHash(big.NewInt(int64(i))), Topics: []gethcommon.Hash{signature, gethcommon.BytesToHash(account.Bytes())}})
+	}
+
+	relevancyOfLog := logRelevancies(logs, map[gethcommon.Hash]*gethcommon.Address{}, db, db)
+	if len(relevancyOfLog) != len(logs) {
+		t.Fatalf("expected the relevancy of %d logs, got %d", len(logs), len(relevancyOfLog))
+	}
+	for _, logItem := range logs {
+		expected, relevancy := LogRelevancy(logItem, nil, db, db), relevancyOfLog[logItem]
+		if relevancy.Rule != expected.Rule || len(relevancy.VisibleTo) != len(expected.VisibleTo) {
+			t.Fatalf("expected %s visible to %v, got %s visible to %v", expected.Rule, expected.VisibleTo, relevancy.Rule, relevancy.VisibleTo)
+		}
+		for i := range expected.VisibleTo {
+			if relevancy.VisibleTo[i] != expected.VisibleTo[i] {
+				t.Fatalf("expected the log to be visible to %v, got %v", expected.VisibleTo, relevancy.VisibleTo)
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package events
+
+import (
+	"github.com/ethereum/go-ethereum/core/types"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+)
+
+const defaultReorgDepth = 64
+
+// deliveredBatch - the logs delivered to each subscription for a batch, kept so that they can be sent again flagged
+// as removed if the batch stops being canonical
+type deliveredBatch struct {
+	height uint64
+	hash   common.L2BatchHash
+	logs   map[gethrpc.ID][]*types.Log
+}
+
+// removedLogs - when the batch doesn't extend the last delivered batch, the batches it replaces are no longer canonical.
+// Like geth, the logs delivered for them are returned again with the Removed flag set, oldest first.
+// The subscriptions being backfilled buffer them, like the live logs.
+// Must be called with the subscription mutex held.
+func (s *SubscriptionManager) removedLogs(batch *core.Batch) map[gethrpc.ID][]*types.Log {
+	var orphaned []*deliveredBatch
+	for len(s.delivered) > 0 {
+		last := s.delivered[len(s.delivered)-1]
+		// the new batch replaces every batch at its height or above, and its parent if it's a different batch
+		replaced := last.height >= batch.NumberU64() ||
+			(last.height+1 == batch.NumberU64() && last.hash != batch.Header.ParentHash)
+		if !replaced {
+			break
+		}
+		orphaned = append(orphaned, last)
+		s.delivered = s.delivered[:len(s.delivered)-1]
+	}
+
+	removed := map[gethrpc.ID][]*types.Log{}
+	for i := len(orphaned) - 1; i >= 0; i-- {
+		s.logger.Info("Batch no longer canonical, removing its logs", log.BatchHashKey, orphaned[i].hash, log.BatchHeightKey, orphaned[i].height)
+		for id, logs := range orphaned[i].logs {
+			sub, found := s.subscriptions[id]
+			if !found {
+				continue
+			}
+			removedLogs := make([]*types.Log, len(logs))
+			for j, l := range logs {
+				removedLog := *l
+				removedLog.Removed = true
+				removedLogs[j] = &removedLog
+			}
+			if sub.backfilling {
+				sub.pending = append(sub.pending, removedLogs...)
+				continue
+			}
+			removed[id] = append(removed[id], removedLogs...)
+		}
+	}
+	return removed
+}
+
+// recordDelivered - remembers the logs delivered for the batch, up to the configured reorg depth.
+// Must be called with the subscription mutex held.
+func (s *SubscriptionManager) recordDelivered(batch *core.Batch, logs map[gethrpc.ID][]*types.Log) {
+	s.delivered = append(s.delivered, &deliveredBatch{height: batch.NumberU64(), hash: batch.Hash(), logs: logs})
+	if uint64(len(s.delivered)) > s.limits.ReorgDepth {
+		s.delivered = s.delivered[uint64(len(s.delivered))-s.limits.ReorgDepth:]
+	}
+}
@@ -0,0 +1,79 @@
+package events
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+)
+
+func testBatch(parent *core.Batch, seqNo int64) *core.Batch {
+	header := &common.BatchHeader{Number: big.NewInt(1), SequencerOrderNo: big.NewInt(seqNo)}
+	if parent != nil {
+		header.ParentHash = parent.Hash()
+		header.Number = new(big.Int).Add(parent.Number(), big.NewInt(1))
+	}
+	return &core.Batch{Header: header}
+}
+
+func deliver(s *SubscriptionManager, batch *core.Batch, id gethrpc.ID) (map[gethrpc.ID][]*types.Log, *types.Log) {
+	removed := s.removedLogs(batch)
+	l := &types.Log{BlockHash: batch.Hash(), BlockNumber: batch.NumberU64(), Topics: []gethcommon.Hash{{}}}
+	s.recordDelivered(batch, map[gethrpc.ID][]*types.Log{id: {l}})
+	return removed, l
+}
+
+func TestReorgedLogsAreDeliveredAsRemoved(t *testing.T) {
+	s := newTestManager(SubscriptionLimits{})
+	_ = s.add("1", subscriptionFor("alice", time.Now()))
+
+	b1 := testBatch(nil, 1)
+	b2 := testBatch(b1, 2)
+	b3 := testBatch(b2, 3)
+	for _, b := range []*core.Batch{b1, b2, b3} {
+		if removed, _ := deliver(s, b, "1"); len(removed) != 0 {
+			t.Fatalf("unexpected removed logs when extending the chain")
+		}
+	}
+
+	// a fork from b1 replaces b2 and b3
+	b2Fork := testBatch(b1, 4)
+	removed, _ := deliver(s, b2Fork, "1")
+	logs := removed["1"]
+	if len(logs) != 2 {
+		t.Fatalf("expected the logs of the two orphaned batches, got %d", len(logs))
+	}
+	if logs[0].BlockHash != b2.Hash() || logs[1].BlockHash != b3.Hash() {
+		t.Fatal("expected the removed logs in chain order")
+	}
+	for _, l := range logs {
+		if !l.Removed {
+			t.Fatal("expected the logs to be flagged as removed")
+		}
+	}
+
+	// the new canonical chain is then extended normally
+	if removed, _ := deliver(s, testBatch(b2Fork, 5), "1"); len(removed) != 0 {
+		t.Fatalf("unexpected removed logs after the reorg")
+	}
+}
+
+func TestReorgDepthBoundsTheDeliveredLogs(t *testing.T) {
+	s := newTestManager(SubscriptionLimits{ReorgDepth: 2})
+	_ = s.add("1", subscriptionFor("alice", time.Now()))
+
+	b1 := testBatch(nil, 1)
+	b2 := testBatch(b1, 2)
+	b3 := testBatch(b2, 3)
+	for _, b := range []*core.Batch{b1, b2, b3} {
+		deliver(s, b, "1")
+	}
+	if len(s.delivered) != 2 || s.delivered[0].hash != b2.Hash() {
+		t.Fatal("expected only the last two batches to be remembered")
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"time"
 
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
 	"github.com/ten-protocol/go-ten/go/enclave/vkhandler"
 
 	"github.com/ten-protocol/go-ten/go/common/log"
@@ -23,70 +25,126 @@ import (
 	"github.com/ten-protocol/go-ten/go/common"
 )
 
-const (
-	// The leading zero bytes in a hash indicating that it is possibly an address, since it only has 20 bytes of data.
-	zeroBytesHex = "000000000000000000000000"
-)
-
 type logSubscription struct {
 	Subscription *common.LogSubscription
 	// Handles the viewing key encryption
 	ViewingKeyEncryptor *vkhandler.AuthenticatedViewingKey
+	// the last time the client (re)registered the subscription
+	lastKeepAlive time.Time
+	// while the historical logs are delivered, the live logs are buffered in pending
+	backfilling bool
+	pending     []*types.Log
+	// the first batch height delivered live. Lower ones are covered by the backfill
+	liveFrom uint64
+}
+
+// subscriptionMatch - the logs of a batch selected for a subscription
+type subscriptionMatch struct {
+	id           gethrpc.ID
+	sub          *logSubscription
+	filteredLogs []*types.Log // the logs matching the filter
+	relevantLogs []*types.Log // the logs the subscriber is allowed to see
 }
 
 // SubscriptionManager manages the creation/deletion of subscriptions, and the filtering and encryption of logs for
 // active subscriptions.
 type SubscriptionManager struct {
-	storage storage.Storage
-
-	subscriptions     map[gethrpc.ID]*logSubscription
-	chainID           int64
-	subscriptionMutex *sync.RWMutex // the mutex guards the subscriptions/lastHead pair
+	storage      storage.Storage
+	gethEncoding gethencoding.EncodingService
+
+	subscriptions      map[gethrpc.ID]*logSubscription
+	headSubscriptions  map[gethrpc.ID]time.Time // the newHeads subscriptions, with the last time they were (re)registered
+	subscriptionsPerVK map[string]uint64        // the number of subscriptions registered by each viewing key (user ID)
+	expired            uint64
+	limits             SubscriptionLimits
+	liveHeight         uint64            // the height of the last batch whose logs were delivered live
+	delivered          []*deliveredBatch // the logs delivered for the last batches, oldest first
+	chainID            int64
+	subscriptionMutex  *sync.RWMutex // the mutex guards the subscriptions and their quota
+
+	backfillSink  LogsSink
+	sinkMutex     sync.RWMutex
+	backfillSlots chan struct{}
+	closed        bool // set when the enclave stops, guarded by the subscription mutex
+
+	// the last delivered batch is persisted outside the subscription mutex, and only once in a while
+	persistMutex    sync.Mutex
+	persistedHeight uint64
+	lastPersisted   time.Time
 
 	logger gethlog.Logger
 }
 
-func NewSubscriptionManager(storage storage.Storage, chainID int64, logger gethlog.Logger) *SubscriptionManager {
+func NewSubscriptionManager(storage storage.Storage, gethEncoding gethencoding.EncodingService, chainID int64, limits SubscriptionLimits, logger gethlog.Logger) *SubscriptionManager {
 	return &SubscriptionManager{
-		storage: storage,
-
-		subscriptions:     map[gethrpc.ID]*logSubscription{},
-		chainID:           chainID,
-		subscriptionMutex: &sync.RWMutex{},
-		logger:            logger,
+		storage:      storage,
+		gethEncoding: gethEncoding,
+
+		subscriptions:      map[gethrpc.ID]*logSubscription{},
+		headSubscriptions:  map[gethrpc.ID]time.Time{},
+		subscriptionsPerVK: map[string]uint64{},
+		limits:             limits.withDefaults(),
+		backfillSlots:      make(chan struct{}, maxConcurrentBackfills),
+		chainID:            chainID,
+		subscriptionMutex:  &sync.RWMutex{},
+		logger:             logger,
 	}
 }
 
 // AddSubscription adds a log subscription to the enclave under the given ID, provided the request is authenticated
-// correctly. If there is an existing subscription with the given ID, it is overwritten.
+// correctly and the quota allows it. If there is an existing subscription with the given ID, it is overwritten, which
+// also renews it for another keep-alive window.
+// When the filter starts at a past batch, the matching historical logs are delivered first, then the live ones.
 func (s *SubscriptionManager) AddSubscription(id gethrpc.ID, encodedSubscription []byte) error {
+	sub, err := s.decodeSubscription(encodedSubscription)
+	if err != nil {
+		return err
+	}
+
+	s.subscriptionMutex.Lock()
+	defer s.subscriptionMutex.Unlock()
+	from, to, backfill, err := s.prepareBackfill(sub)
+	if err != nil {
+		return err
+	}
+	if err := s.add(id, sub); err != nil {
+		return err
+	}
+	s.persist(id, encodedSubscription)
+	if backfill {
+		go s.backfill(id, sub, from, to)
+	}
+	return nil
+}
+
+// decodeSubscription - decodes the subscription and authenticates its viewing key
+func (s *SubscriptionManager) decodeSubscription(encodedSubscription []byte) (*logSubscription, error) {
 	subscription := &common.LogSubscription{}
 	if err := rlp.DecodeBytes(encodedSubscription, subscription); err != nil {
-		return fmt.Errorf("could not decocde log subscription from RLP. Cause: %w", err)
+		return nil, fmt.Errorf("could not decocde log subscription from RLP. Cause: %w", err)
 	}
 
 	// verify the viewing key
 	authenticateViewingKey, err := vkhandler.VerifyViewingKey(subscription.ViewingKey, s.chainID)
 	if err != nil {
-		return fmt.Errorf("unable to authenticate the viewing key for subscription  - %w", err)
+		return nil, fmt.Errorf("unable to authenticate the viewing key for subscription  - %w", err)
 	}
 
-	s.subscriptionMutex.Lock()
-	defer s.subscriptionMutex.Unlock()
-	s.subscriptions[id] = &logSubscription{
+	return &logSubscription{
 		Subscription:        subscription,
 		ViewingKeyEncryptor: authenticateViewingKey,
-	}
-
-	return nil
+		lastKeepAlive:       time.Now(),
+	}, nil
 }
 
-// RemoveSubscription removes the log subscription with the given ID from the enclave. If there is no subscription with
-// the given ID, nothing is deleted.
+// RemoveSubscription removes the log or newHeads subscription with the given ID from the enclave. If there is no
+// subscription with the given ID, nothing is deleted.
 func (s *SubscriptionManager) RemoveSubscription(id gethrpc.ID) {
 	s.subscriptionMutex.Lock()
 	defer s.subscriptionMutex.Unlock()
-	delete(s.subscriptions, id)
+	if s.remove(id) {
+		s.unpersist(id)
+	}
 }
 
 // FilterLogsForReceipt removes the logs that the sender of a transaction is not allowed to view
@@ -96,10 +154,14 @@ func FilterLogsForReceipt(receipt *types.Receipt, account *gethcommon.Address, s
 	if err != nil {
 		return nil, fmt.Errorf("could not create state DB to filter logs. Cause: %w", err)
 	}
+	rulesDB, err := rulesStateDB(receipt.BlockHash, storage)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, logItem := range receipt.Logs {
-		userAddrs := getUserAddrsFromLogTopics(logItem, stateDB)
-		if isRelevant(account, userAddrs) {
+		// the account is the sender of the transaction
+		if isVisibleTo(LogRelevancy(logItem, account, rulesDB, stateDB), account) {
 			filteredLogs = append(filteredLogs, logItem)
 		}
 	}
@@ -107,19 +169,45 @@ func FilterLogsForReceipt(receipt *types.Receipt, account *gethcommon.Address, s
 	return filteredLogs, nil
 }
 
+// rulesStateDB - the state with the visibility rules which apply to the logs of the batch, the state of its parent
+func rulesStateDB(batchHash common.L2BatchHash, storage storage.Storage) (*state.StateDB, error) {
+	batch, err := storage.FetchBatchHeader(batchHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve batch to filter logs. Cause: %w", err)
+	}
+	rulesDB, err := storage.CreateStateDB(batch.ParentHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not create state DB to filter logs. Cause: %w", err)
+	}
+	return rulesDB, nil
+}
+
 // GetSubscribedLogsForBatch - Retrieves and encrypts the logs for the batch in live mode.
 // The assumption is that this function is called synchronously after the batch is produced
 func (s *SubscriptionManager) GetSubscribedLogsForBatch(batch *core.Batch, receipts types.Receipts) (common.EncryptedSubscriptionLogs, error) {
-	s.subscriptionMutex.RLock()
-	defer s.subscriptionMutex.RUnlock()
+	// the height is persisted once the mutex is released
+	var deliveredHeight uint64
+	defer func() { s.persistLastDelivered(deliveredHeight, false) }()
+
+	// the write lock is held, because the subscriptions being backfilled buffer their live logs
+	s.subscriptionMutex.Lock()
+	defer s.subscriptionMutex.Unlock()
+	s.expireStale(time.Now())
+	s.liveHeight = batch.NumberU64()
+	if len(s.subscriptions) > 0 {
+		deliveredHeight = s.liveHeight
+	}
+
+	// the logs delivered for the batches replaced by this one are sent again, flagged as removed, before its own logs
+	relevantLogsPerSubscription := s.removedLogs(batch)
+	deliveredForBatch := map[gethrpc.ID][]*types.Log{}
+	defer s.recordDelivered(batch, deliveredForBatch)
 
 	// exit early if there are no subscriptions
 	if len(s.subscriptions) == 0 {
-		return nil, nil
+		return s.encryptLogs(relevantLogsPerSubscription)
 	}
 
-	relevantLogsPerSubscription := map[gethrpc.ID][]*types.Log{}
-
 	// extract the logs from all receipts
 	var allLogs []*types.Log
 	for _, receipt := range receipts {
@@ -127,7 +215,7 @@ func (s *SubscriptionManager) GetSubscribedLogsForBatch(batch *core.Batch, recei
 	}
 
 	if len(allLogs) == 0 {
-		return nil, nil
+		return s.encryptLogs(relevantLogsPerSubscription)
 	}
 
 	// the stateDb is needed to extract the user addresses from the topics
@@ -135,54 +223,68 @@ func (s *SubscriptionManager) GetSubscribedLogsForBatch(batch *core.Batch, recei
 	if err != nil {
 		return nil, fmt.Errorf("could not create state DB to filter logs. Cause: %w", err)
 	}
+	// the visibility rules configured by the contracts are the ones of the parent state
+	rulesDB, err := s.storage.CreateStateDB(batch.Header.ParentHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not create state DB to filter logs. Cause: %w", err)
+	}
+	senders := txSenders(batch.Transactions)
 
-	// cache for the user addresses extracted from the individual logs
-	// this is an expensive operation so we are doing it lazy, and caching the result
-	userAddrsForLog := map[*types.Log][]*gethcommon.Address{}
-
+	// first filter the logs of each subscription, looking only at the logs that can match it
+	idx := newLogIndex(allLogs)
+	matches := make([]*subscriptionMatch, 0, len(s.subscriptions))
 	for id, sub := range s.subscriptions {
-		// first filter the logs
-		filteredLogs := filterLogs(allLogs, sub.Subscription.Filter.FromBlock, sub.Subscription.Filter.ToBlock, sub.Subscription.Filter.Addresses, sub.Subscription.Filter.Topics, s.logger)
+		filter := sub.Subscription.Filter
+		filteredLogs := filterLogs(idx.candidates(filter.Addresses, filter.Topics), filter.FromBlock, filter.ToBlock, filter.Addresses, filter.Topics, s.logger)
+		if len(filteredLogs) > 0 {
+			matches = append(matches, &subscriptionMatch{id: id, sub: sub, filteredLogs: filteredLogs})
+		}
+	}
 
-		// the account requesting the logs is retrieved from the Viewing Key
-		requestingAccount := sub.ViewingKeyEncryptor.AccountAddress
-		relevantLogsForSub := []*types.Log{}
-		for _, logItem := range filteredLogs {
-			userAddrs, f := userAddrsForLog[logItem]
-			if !f {
-				userAddrs = getUserAddrsFromLogTopics(logItem, stateDB)
-				userAddrsForLog[logItem] = userAddrs
+	// the relevancy is decided once per log, because extracting the user addresses is an expensive operation
+	var matchedLogs []*types.Log
+	seen := map[*types.Log]bool{}
+	for _, m := range matches {
+		for _, logItem := range m.filteredLogs {
+			if !seen[logItem] {
+				seen[logItem] = true
+				matchedLogs = append(matchedLogs, logItem)
 			}
-			relevant := isRelevant(requestingAccount, userAddrs)
-			if relevant {
-				relevantLogsForSub = append(relevantLogsForSub, logItem)
+		}
+	}
+	relevancyOfLog := logRelevancies(matchedLogs, senders, rulesDB, stateDB)
+
+	for _, m := range matches {
+		// the account requesting the logs is retrieved from the Viewing Key
+		requestingAccount := m.sub.ViewingKeyEncryptor.AccountAddress
+		for _, logItem := range m.filteredLogs {
+			relevancy := relevancyOfLog[logItem]
+			relevant := isVisibleTo(relevancy, requestingAccount)
+			if relevant && logItem.BlockNumber >= m.sub.liveFrom {
+				m.relevantLogs = append(m.relevantLogs, logItem)
 			}
-			s.logger.Debug("Subscription", log.SubIDKey, id, "acc", requestingAccount, "log", logItem, "extr_addr", userAddrs, "relev", relevant)
+			s.logger.Debug("Subscription", log.SubIDKey, m.id, "acc", requestingAccount, "log", logItem, "rule", relevancy.Rule, "visible_to", relevancy.VisibleTo, "relev", relevant)
 		}
-		if len(relevantLogsForSub) > 0 {
-			relevantLogsPerSubscription[id] = relevantLogsForSub
+		if len(m.relevantLogs) == 0 {
+			continue
+		}
+		deliveredForBatch[m.id] = m.relevantLogs
+		if m.sub.backfilling {
+			m.sub.pending = append(m.sub.pending, m.relevantLogs...)
+			continue
 		}
+		relevantLogsPerSubscription[m.id] = append(relevantLogsPerSubscription[m.id], m.relevantLogs...)
 	}
 
 	// Encrypt the results
 	return s.encryptLogs(relevantLogsPerSubscription)
 }
 
-func isRelevant(sub *gethcommon.Address, userAddrs []*gethcommon.Address) bool {
-	// If there are no user addresses, this is a lifecycle event, and is therefore relevant to everyone.
-	if len(userAddrs) == 0 {
-		return true
-	}
-	for _, addr := range userAddrs {
-		if *addr == *sub {
-			return true
-		}
-	}
-	return false
-}
-
 // Encrypts each log with the appropriate viewing key.
 func (s *SubscriptionManager) encryptLogs(logsByID map[gethrpc.ID][]*types.Log) (map[gethrpc.ID][]byte, error) {
+	if len(logsByID) == 0 {
+		return nil, nil
+	}
 	encryptedLogsByID := map[gethrpc.ID][]byte{}
 
 	for subID, logs := range logsByID {
@@ -207,35 +309,6 @@ func (s *SubscriptionManager) encryptLogs(logsByID map[gethrpc.ID][]*types.Log)
 	return encryptedLogsByID, nil
 }
 
-// Of the log's topics, returns those that are (potentially) user addresses. A topic is considered a user address if:
-//   - It has 12 leading zero bytes (since addresses are 20 bytes long, while hashes are 32)
-//   - It has a non-zero nonce (to prevent accidental or malicious creation of the address matching a given topic,
-//     forcing its events to become permanently private
-//   - It does not have associated code (meaning it's a smart-contract address)
-func getUserAddrsFromLogTopics(log *types.Log, db *state.StateDB) []*gethcommon.Address {
-	var userAddrs []*gethcommon.Address
-
-	// We skip over the first topic, which is always the hash of the event.
-	for _, topic := range log.Topics[1:len(log.Topics)] {
-		if topic.Hex()[2:len(zeroBytesHex)+2] != zeroBytesHex {
-			continue
-		}
-
-		potentialAddr := gethcommon.BytesToAddress(topic.Bytes())
-
-		// A user address must have a non-zero nonce. This prevents accidental or malicious sending of funds to an
-		// address matching a topic, forcing its events to become permanently private.
-		if db.GetNonce(potentialAddr) != 0 {
-			// If the address has code, it's a smart contract address instead.
-			if db.GetCode(potentialAddr) == nil {
-				userAddrs = append(userAddrs, &potentialAddr)
-			}
-		}
-	}
-
-	return userAddrs
-}
-
 // Lifted from eth/filters/filter.go in the go-ethereum repository.
 // filterLogs creates a slice of logs matching the given criteria.
 func filterLogs(logs []*types.Log, fromBlock, toBlock *big.Int, addresses []gethcommon.Address, topics [][]gethcommon.Hash, logger gethlog.Logger) []*types.Log { //nolint:gocognit
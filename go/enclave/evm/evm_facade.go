@@ -1,6 +1,7 @@
 package evm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -230,6 +231,7 @@ func logReceipt(r *types.Receipt, logger gethlog.Logger) {
 
 // ExecuteObsCall - executes the eth_call call
 func ExecuteObsCall(
+	ctx context.Context,
 	msg *gethcore.Message,
 	s *state.StateDB,
 	header *common.BatchHeader,
@@ -237,6 +239,7 @@ func ExecuteObsCall(
 	gethEncodingService gethencoding.EncodingService,
 	chainConfig *params.ChainConfig,
 	gasEstimationCap uint64,
+	limits ExecutionLimits,
 	logger gethlog.Logger,
 ) (*gethcore.ExecutionResult, error) {
 	noBaseFee := true
@@ -258,12 +261,30 @@ func ExecuteObsCall(
 
 	// sets TxKey.origin
 	txContext := gethcore.NewEVMTxContext(msg)
+	vmCfg, memoryLimiter := LimitMemory(vmCfg, limits.MemoryCap)
 	vmenv := vm.NewEVM(blockContext, txContext, s, chainConfig, vmCfg)
 
+	// abort the execution when the request is cancelled, e.g. when the enclave is stopping or the request timed out, or
+	// when the execution itself runs for longer than its timeout
+	execCtx, cancel := ctx, context.CancelFunc(func() {})
+	if limits.Timeout != 0 {
+		execCtx, cancel = context.WithTimeout(ctx, limits.Timeout)
+	}
+	defer cancel()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-execCtx.Done():
+			vmenv.Cancel()
+		case <-done:
+		}
+	}()
+
 	result, err := gethcore.ApplyMessage(vmenv, msg, &gp)
 	// Follow the same error check structure as in geth
 	// 1 - vmError / stateDB err check
-	// 2 - evm.Cancelled()  todo (#1576) - support the ability to cancel function call if it takes too long
+	// 2 - evm.Cancelled()
 	// 3 - error check the ApplyMessage
 
 	// Read the error stored in the database.
@@ -271,6 +292,17 @@ func ExecuteObsCall(
 		return nil, newErrorWithReasonAndCode(dbErr)
 	}
 
+	if vmenv.Cancelled() {
+		switch {
+		case memoryLimiter.Exceeded():
+			return nil, errutil.ErrExecutionMemoryCapExceeded
+		case ctx.Err() != nil:
+			return nil, AbortedErr(ctx)
+		default:
+			return nil, errutil.ErrExecutionTimeout
+		}
+	}
+
 	// If the result contains a revert reason, try to unpack and return it.
 	if result != nil && len(result.Revert()) > 0 {
 		return nil, newRevertError(result)
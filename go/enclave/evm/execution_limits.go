@@ -0,0 +1,146 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// ExecutionLimits - the limits of the executions requested by the users: the calls, the gas estimations and the traces.
+// The code they run is untrusted, and the gas cap alone does not keep it from holding the CPU and the memory of the
+// enclave. The execution of the batches is consensus-critical, and is never limited. A zero field means no limit.
+type ExecutionLimits struct {
+	Timeout   time.Duration // the wall-clock time after which the execution is aborted
+	MemoryCap uint64        // the most memory, in bytes, the call frames of the execution can hold together
+}
+
+// executionDeadline - the deadline shared by the executions of a request, and the context of the request itself
+type executionDeadline struct {
+	request  context.Context
+	deadline context.Context
+}
+
+type executionDeadlineKey struct{}
+
+// WithExecutionTimeout - a context which aborts every execution run with it once the timeout expires, for the requests
+// which run several executions, e.g. the binary search of a gas estimation. They are then reported as timed out, like
+// a single execution which runs for longer than its timeout. A zero timeout means no limit.
+func WithExecutionTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout == 0 {
+		return ctx, func() {}
+	}
+	deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
+	return context.WithValue(deadlineCtx, executionDeadlineKey{}, executionDeadline{request: ctx, deadline: deadlineCtx}), cancel
+}
+
+// AbortedErr - the error of an execution aborted because its context is done
+func AbortedErr(ctx context.Context) error {
+	if d, ok := ctx.Value(executionDeadlineKey{}).(executionDeadline); ok && d.request.Err() == nil && errors.Is(d.deadline.Err(), context.DeadlineExceeded) {
+		return errutil.ErrExecutionTimeout
+	}
+	return fmt.Errorf("execution aborted - %w", ctx.Err())
+}
+
+// MemoryLimiter - an EVM tracer which cancels the execution once the memory of its call frames exceeds the cap, and
+// forwards the events to the tracer of the execution, if any.
+//
+// The EVM only checks whether it was cancelled on the jumps, so the execution runs on until the next one. The code
+// between two jumps is bounded by the size of the contracts, and the memory it can expand by the gas.
+type MemoryLimiter struct {
+	tracer    vm.EVMLogger
+	memoryCap uint64
+	env       *vm.EVM
+	frames    []uint64 // the memory size of the call frames, by depth
+	total     uint64
+	exceeded  bool
+}
+
+// LimitMemory - the configuration of an EVM whose execution is cancelled once its memory exceeds the cap. The limiter
+// wraps the tracer of the configuration.
+func LimitMemory(cfg vm.Config, memoryCap uint64) (vm.Config, *MemoryLimiter) {
+	limiter := &MemoryLimiter{tracer: cfg.Tracer, memoryCap: memoryCap}
+	if memoryCap != 0 {
+		cfg.Tracer = limiter
+	}
+	return cfg, limiter
+}
+
+// Exceeded - whether the execution was cancelled because of its memory
+func (l *MemoryLimiter) Exceeded() bool {
+	return l.exceeded
+}
+
+func (l *MemoryLimiter) CaptureTxStart(gasLimit uint64) {
+	if l.tracer != nil {
+		l.tracer.CaptureTxStart(gasLimit)
+	}
+}
+
+func (l *MemoryLimiter) CaptureTxEnd(restGas uint64) {
+	if l.tracer != nil {
+		l.tracer.CaptureTxEnd(restGas)
+	}
+}
+
+func (l *MemoryLimiter) CaptureStart(env *vm.EVM, from gethcommon.Address, to gethcommon.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	l.env = env
+	if l.tracer != nil {
+		l.tracer.CaptureStart(env, from, to, create, input, gas, value)
+	}
+}
+
+func (l *MemoryLimiter) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	if l.tracer != nil {
+		l.tracer.CaptureEnd(output, gasUsed, err)
+	}
+}
+
+func (l *MemoryLimiter) CaptureEnter(typ vm.OpCode, from gethcommon.Address, to gethcommon.Address, input []byte, gas uint64, value *big.Int) {
+	if l.tracer != nil {
+		l.tracer.CaptureEnter(typ, from, to, input, gas, value)
+	}
+}
+
+func (l *MemoryLimiter) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if l.tracer != nil {
+		l.tracer.CaptureExit(output, gasUsed, err)
+	}
+}
+
+func (l *MemoryLimiter) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	l.trackMemory(depth, uint64(scope.Memory.Len()))
+	if l.tracer != nil {
+		l.tracer.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+	}
+}
+
+func (l *MemoryLimiter) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	if l.tracer != nil {
+		l.tracer.CaptureFault(pc, op, gas, cost, scope, depth, err)
+	}
+}
+
+// trackMemory - records the memory of the call frame at the depth. The frames deeper than it have returned.
+func (l *MemoryLimiter) trackMemory(depth int, size uint64) {
+	for len(l.frames) > depth {
+		l.total -= l.frames[len(l.frames)-1]
+		l.frames = l.frames[:len(l.frames)-1]
+	}
+	for len(l.frames) < depth {
+		l.frames = append(l.frames, 0)
+	}
+	l.total = l.total - l.frames[depth-1] + size
+	l.frames[depth-1] = size
+
+	if l.total > l.memoryCap && !l.exceeded {
+		l.exceeded = true
+		l.env.Cancel()
+	}
+}
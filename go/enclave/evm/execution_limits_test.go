@@ -0,0 +1,116 @@
+package evm
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// so much gas that the gas cap never ends the calls
+	testCallGas = 1_000_000_000_000
+	// JUMPDEST, PUSH1 0, JUMP
+	infiniteLoopCode = "5b600056"
+	// JUMPDEST, MSIZE, PUSH1 32, ADD, MLOAD, POP, PUSH1 0, JUMP: reads past the end of the memory, forever
+	memoryHogCode = "5b596020015150600056"
+)
+
+var testContract = gethcommon.HexToAddress("0xc0de")
+
+func TestInfiniteLoopCallIsAbortedOnTimeout(t *testing.T) {
+	call := newTestCall(t, infiniteLoopCode)
+
+	start := time.Now()
+	_, err := call(context.Background(), ExecutionLimits{Timeout: 100 * time.Millisecond})
+	require.ErrorIs(t, err, errutil.ErrExecutionTimeout)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestCallIsAbortedWhenItsMemoryExceedsTheCap(t *testing.T) {
+	call := newTestCall(t, memoryHogCode)
+
+	_, err := call(context.Background(), ExecutionLimits{Timeout: time.Minute, MemoryCap: 1 << 20})
+	require.ErrorIs(t, err, errutil.ErrExecutionMemoryCapExceeded)
+}
+
+func TestCallIsAbortedWhenTheRequestIsCancelled(t *testing.T) {
+	call := newTestCall(t, infiniteLoopCode)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := call(ctx, ExecutionLimits{Timeout: time.Minute})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NotErrorIs(t, err, errutil.ErrExecutionTimeout)
+}
+
+func TestExecutionsShareTheTimeoutOfTheirRequest(t *testing.T) {
+	call := newTestCall(t, infiniteLoopCode)
+
+	// the execution is within its own timeout, but not within the one of its request
+	ctx, cancel := WithExecutionTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := call(ctx, ExecutionLimits{Timeout: time.Minute})
+	require.ErrorIs(t, err, errutil.ErrExecutionTimeout)
+	require.Less(t, time.Since(start), 5*time.Second)
+	// the following executions are not started
+	require.ErrorIs(t, AbortedErr(ctx), errutil.ErrExecutionTimeout)
+
+	// the cancellation of the request itself is not reported as a timeout
+	requestCtx, cancelRequest := context.WithCancel(context.Background())
+	ctx, cancel = WithExecutionTimeout(requestCtx, time.Minute)
+	defer cancel()
+	cancelRequest()
+	err = AbortedErr(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.NotErrorIs(t, err, errutil.ErrExecutionTimeout)
+}
+
+// newTestCall - a function which calls a contract with the given runtime code, within the limits
+func newTestCall(t *testing.T, code string) func(context.Context, ExecutionLimits) (*gethcore.ExecutionResult, error) {
+	logger := gethlog.New()
+	chainConfig := params.AllEthashProtocolChanges
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	require.NoError(t, err)
+	storageDB := storage.NewStorage(backingDB, chainConfig, logger)
+	require.NoError(t, storageDB.StoreSecret(crypto.SharedEnclaveSecret{}))
+	gethEncoding := gethencoding.NewGethEncodingService(storageDB, logger)
+
+	header := &common.BatchHeader{
+		Number:           big.NewInt(0),
+		SequencerOrderNo: new(big.Int).SetUint64(common.L2GenesisSeqNo),
+		GasLimit:         testCallGas,
+	}
+	msg := &gethcore.Message{
+		To:                &testContract,
+		GasLimit:          testCallGas,
+		GasPrice:          gethcommon.Big0,
+		GasFeeCap:         gethcommon.Big0,
+		GasTipCap:         gethcommon.Big0,
+		Value:             gethcommon.Big0,
+		SkipAccountChecks: true,
+	}
+	return func(ctx context.Context, limits ExecutionLimits) (*gethcore.ExecutionResult, error) {
+		statedb, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		require.NoError(t, err)
+		statedb.SetCode(testContract, gethcommon.FromHex(code))
+		return ExecuteObsCall(ctx, msg, statedb, header, storageDB, gethEncoding, chainConfig, testCallGas, limits, logger)
+	}
+}
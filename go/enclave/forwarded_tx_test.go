@@ -0,0 +1,93 @@
+package enclave
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/nodetype"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+)
+
+// mempoolSequencer - the submitted transactions are added to the mempool, unless it rejects them
+type mempoolSequencer struct {
+	nodetype.Sequencer
+	rejection error
+	mempool   []gethcommon.Hash
+}
+
+func (s *mempoolSequencer) SubmitTransaction(tx *common.L2Tx) error {
+	if s.rejection != nil {
+		return s.rejection
+	}
+	s.mempool = append(s.mempool, tx.Hash())
+	return nil
+}
+
+func TestForwardedTxIsSubmittedToTheSequencer(t *testing.T) {
+	secret := crypto.GenerateEntropy(gethlog.New())
+	validator, _ := newForwardingTestEnclave(t, common.Validator, secret)
+	sequencer, seq := newForwardingTestEnclave(t, common.Sequencer, secret)
+
+	tx := newForwardedTestTx(t)
+	forwarded, err := validator.encryptForwardedTx(tx)
+	require.NoError(t, err)
+	require.NoError(t, sequencer.SubmitForwardedTx(forwarded))
+	require.Equal(t, []gethcommon.Hash{tx.Hash()}, seq.mempool)
+}
+
+func TestRejectedForwardedTxIsNotAdded(t *testing.T) {
+	secret := crypto.GenerateEntropy(gethlog.New())
+	validator, _ := newForwardingTestEnclave(t, common.Validator, secret)
+	sequencer, seq := newForwardingTestEnclave(t, common.Sequencer, secret)
+	forwarded, err := validator.encryptForwardedTx(newForwardedTestTx(t))
+	require.NoError(t, err)
+
+	// the state of the sequencer can be ahead of the one of the validator, so the rejection is not a system error
+	seq.rejection = gethcore.ErrNonceTooLow
+	require.NoError(t, sequencer.SubmitForwardedTx(forwarded))
+	require.Empty(t, seq.mempool)
+
+	// only the sequencer accepts the forwarded transactions, and only from the enclaves of its network
+	require.ErrorContains(t, validator.SubmitForwardedTx(forwarded), "only accepted by the sequencer")
+	otherNetwork, _ := newForwardingTestEnclave(t, common.Sequencer, crypto.GenerateEntropy(gethlog.New()))
+	require.ErrorContains(t, otherNetwork.SubmitForwardedTx(forwarded), "could not decrypt forwarded tx")
+}
+
+func newForwardingTestEnclave(t *testing.T, nodeType common.NodeType, secret crypto.SharedEnclaveSecret) (*enclaveImpl, *mempoolSequencer) {
+	logger := gethlog.New()
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	require.NoError(t, err)
+	storageDB := storage.NewStorage(backingDB, nil, logger)
+	require.NoError(t, storageDB.StoreSecret(secret))
+
+	seq := &mempoolSequencer{}
+	return &enclaveImpl{
+		config:               &config.EnclaveConfig{NodeType: nodeType},
+		storage:              storageDB,
+		crossChainProcessors: crosschain.New(&gethcommon.Address{}, &gethcommon.Address{}, storageDB, big.NewInt(443), 0, logger),
+		service:              seq,
+		stopControl:          stopcontrol.New(),
+		logger:               logger,
+	}, seq
+}
+
+func newForwardedTestTx(t *testing.T) *common.L2Tx {
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	to := gethcommon.HexToAddress("0x1")
+	tx, err := types.SignNewTx(key, types.NewLondonSigner(big.NewInt(443)), &types.LegacyTx{To: &to, Gas: 21_000, GasPrice: big.NewInt(1), Value: big.NewInt(1)})
+	require.NoError(t, err)
+	return tx
+}
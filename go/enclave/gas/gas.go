@@ -9,6 +9,9 @@ import (
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
+// compressionRatio - the percentage of the size of the non-zero data that remains after compression
+const compressionRatio = 90
+
 type ObscuroGasPool struct {
 	gp gethcore.GasPool
 }
@@ -33,10 +36,23 @@ func (gp *ObscuroGasPool) ForTransaction(tx *types.Transaction) (*gethcore.GasPo
 
 // CalculateL1GasUsed - calculates the gas cost of having a transaction on the l1.
 func CalculateL1GasUsed(data []byte, overhead *big.Int) *big.Int {
-	reducedTxSize := uint64(len(data))
-	reducedTxSize = (reducedTxSize * 90) / 100
-	reducedTxSize = reducedTxSize * params.TxDataNonZeroGasEIP2028
+	compressedSize := EstimateCompressedSize(data)
 
-	l1Gas := new(big.Int).SetUint64(reducedTxSize)
+	l1Gas := new(big.Int).SetUint64(compressedSize * params.TxDataNonZeroGasEIP2028)
 	return new(big.Int).Add(l1Gas, overhead)
 }
+
+// EstimateCompressedSize - estimates the size of the data once the batch containing it is compressed.
+// The transactions are compressed together with the whole batch, so compressing them one by one would not give
+// their actual share of the rollup. Instead, the runs of zero bytes, which the compression nearly eliminates,
+// count for a quarter of their size, and the result is reduced by the ratio achieved on the rest of the data.
+func EstimateCompressedSize(data []byte) uint64 {
+	zeroBytes := uint64(0)
+	for _, b := range data {
+		if b == 0 {
+			zeroBytes++
+		}
+	}
+	size := uint64(len(data)) - zeroBytes + zeroBytes/4
+	return (size * compressionRatio) / 100
+}
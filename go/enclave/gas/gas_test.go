@@ -0,0 +1,96 @@
+package gas
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+// heightUpdates - the parameters updates by L1 height
+type heightUpdates map[uint64]*common.NetworkParametersUpdate
+
+func (h heightUpdates) FetchNetworkParametersUpdates(upToHeight uint64) ([]*common.NetworkParametersUpdate, error) {
+	var updates []*common.NetworkParametersUpdate
+	for height := uint64(0); height <= upToHeight; height++ {
+		if update, found := h[height]; found {
+			updates = append(updates, update)
+		}
+	}
+	return updates, nil
+}
+
+func TestEstimateCompressedSize(t *testing.T) {
+	nonZero := make([]byte, 100)
+	for i := range nonZero {
+		nonZero[i] = 1
+	}
+	if size := EstimateCompressedSize(nonZero); size != 90 {
+		t.Errorf("expected 90 bytes, got %d", size)
+	}
+	// the zero bytes count for a quarter of their size
+	if size := EstimateCompressedSize(make([]byte, 100)); size != 22 {
+		t.Errorf("expected 22 bytes, got %d", size)
+	}
+}
+
+func TestL1StorageCostUsesBlockGasPrice(t *testing.T) {
+	tx := types.NewTx(&types.LegacyTx{Data: []byte{1, 2, 3}})
+	o := NewGasOracle(common.NetworkParameters{}, heightUpdates{}, gethlog.New())
+
+	noBaseFee := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1)})
+	cost, err := o.EstimateL1StorageGasCost(tx, noBaseFee)
+	if err != nil || cost.Sign() != 0 {
+		t.Fatalf("expected no cost without an l1 gas price, got %s, err %v", cost, err)
+	}
+
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(10)})
+	cost, err = o.EstimateL1StorageGasCost(tx, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cost.Sign() <= 0 || new(big.Int).Mod(cost, big.NewInt(10*int64(params.TxDataNonZeroGasEIP2028))).Sign() != 0 {
+		t.Errorf("expected the cost to be charged per estimated byte at the block gas price, got %s", cost)
+	}
+}
+
+func TestParametersApplyFromTheirBlock(t *testing.T) {
+	payee := gethcommon.HexToAddress("0x1")
+	defaults := common.NetworkParameters{MinGasPrice: big.NewInt(1), BaseFee: big.NewInt(2)}
+	o := NewGasOracle(defaults, heightUpdates{
+		5: {MinGasPrice: big.NewInt(10)},
+		8: {BaseFee: big.NewInt(20), GasPaymentAddress: &payee},
+	}, gethlog.New())
+
+	atHeight := func(height int64) common.NetworkParameters {
+		parameters, err := o.ParametersAt(types.NewBlockWithHeader(&types.Header{Number: big.NewInt(height)}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parameters
+	}
+
+	if p := atHeight(4); p.MinGasPrice.Int64() != 1 || p.BaseFee.Int64() != 2 {
+		t.Errorf("expected the defaults before the first update, got %+v", p)
+	}
+	// the fields missing from an update keep their previous values
+	if p := atHeight(5); p.MinGasPrice.Int64() != 10 || p.BaseFee.Int64() != 2 {
+		t.Errorf("expected the min gas price update only, got %+v", p)
+	}
+	if p := atHeight(9); p.MinGasPrice.Int64() != 10 || p.BaseFee.Int64() != 20 || p.GasPaymentAddress != payee {
+		t.Errorf("expected both updates, got %+v", p)
+	}
+
+	// the parameters in force follow the processed L1 blocks
+	if p := o.Parameters(); p.MinGasPrice.Int64() != 1 {
+		t.Errorf("expected the defaults before any block, got %+v", p)
+	}
+	o.ProcessL1Block(types.NewBlockWithHeader(&types.Header{Number: big.NewInt(6)}))
+	if p := o.Parameters(); p.MinGasPrice.Int64() != 10 {
+		t.Errorf("expected the update of block 5, got %+v", p)
+	}
+}
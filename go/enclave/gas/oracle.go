@@ -1,12 +1,17 @@
 package gas
 
 import (
+	"fmt"
 	"math/big"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/gethapi"
+	"github.com/ten-protocol/go-ten/go/common/log"
 )
 
 // Oracle - the interface for the future precompiled gas oracle contract
@@ -15,15 +20,38 @@ type Oracle interface {
 	ProcessL1Block(block *types.Block)
 	EstimateL1StorageGasCost(tx *types.Transaction, block *types.Block) (*big.Int, error)
 	EstimateL1CostForMsg(args *gethapi.TransactionArgs, block *types.Block) (*big.Int, error)
+
+	// ParametersAt - the network parameters in force for the batches built on the L1 block, i.e. with all the updates
+	// of the canonical blocks up to its height applied. It only depends on the block, so every enclave switches to the
+	// new values at the same batch.
+	ParametersAt(block *types.Block) (common.NetworkParameters, error)
+	// Parameters - the network parameters in force at the last processed L1 block
+	Parameters() common.NetworkParameters
+}
+
+// ParametersStorage - the updates of the network parameters set by the management contract
+type ParametersStorage interface {
+	FetchNetworkParametersUpdates(upToHeight uint64) ([]*common.NetworkParametersUpdate, error)
 }
 
 type oracle struct {
 	baseFee *big.Int
+
+	defaults    common.NetworkParameters // from the enclave config
+	storage     ParametersStorage
+	current     common.NetworkParameters
+	currentLock sync.RWMutex
+	logger      gethlog.Logger
 }
 
-func NewGasOracle() Oracle {
+// NewGasOracle - the defaults are in force until the management contract updates them
+func NewGasOracle(defaults common.NetworkParameters, storage ParametersStorage, logger gethlog.Logger) Oracle {
 	return &oracle{
-		baseFee: big.NewInt(1),
+		baseFee:  big.NewInt(1),
+		defaults: defaults,
+		storage:  storage,
+		current:  defaults,
+		logger:   logger,
 	}
 }
 
@@ -35,6 +63,42 @@ func (o *oracle) ProcessL1Block(block *types.Block) {
 	if blockBaseFee != nil {
 		o.baseFee = blockBaseFee
 	}
+
+	parameters, err := o.ParametersAt(block)
+	if err != nil {
+		o.logger.Error("Could not read the network parameters", log.BlockHashKey, block.Hash(), log.ErrKey, err)
+		return
+	}
+	o.currentLock.Lock()
+	defer o.currentLock.Unlock()
+	o.current = parameters
+}
+
+func (o *oracle) ParametersAt(block *types.Block) (common.NetworkParameters, error) {
+	updates, err := o.storage.FetchNetworkParametersUpdates(block.NumberU64())
+	if err != nil {
+		return common.NetworkParameters{}, fmt.Errorf("could not fetch the network parameters updates. Cause: %w", err)
+	}
+	parameters := o.defaults
+	for _, update := range updates {
+		parameters = parameters.Apply(update)
+	}
+	return parameters, nil
+}
+
+func (o *oracle) Parameters() common.NetworkParameters {
+	o.currentLock.RLock()
+	defer o.currentLock.RUnlock()
+	return o.current
+}
+
+// L1GasPriceAt - the l1 gas price used to charge the publishing cost of the transactions of the batches built on the
+// block. It only depends on the block, so every enclave charges the same fees.
+func L1GasPriceAt(block *types.Block) *big.Int {
+	if block.BaseFee() == nil {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Set(block.BaseFee())
 }
 
 // EstimateL1StorageGasCost - Returns the expected l1 gas cost for a transaction at a given l1 block.
@@ -44,13 +108,8 @@ func (o *oracle) EstimateL1StorageGasCost(tx *types.Transaction, block *types.Bl
 		return nil, err
 	}
 
-	blockBaseFee := block.BaseFee()
-	if blockBaseFee == nil {
-		return big.NewInt(0), nil
-	}
-
 	l1Gas := CalculateL1GasUsed(encodedTx, big.NewInt(0))
-	return big.NewInt(0).Mul(l1Gas, block.BaseFee()), nil
+	return big.NewInt(0).Mul(l1Gas, L1GasPriceAt(block)), nil
 }
 
 func (o *oracle) EstimateL1CostForMsg(args *gethapi.TransactionArgs, block *types.Block) (*big.Int, error) {
@@ -65,5 +124,5 @@ func (o *oracle) EstimateL1CostForMsg(args *gethapi.TransactionArgs, block *type
 	nonZeroGas := big.NewInt(int64(params.TxDataNonZeroGasEIP2028))
 	overhead := big.NewInt(0).Mul(big.NewInt(150), nonZeroGas)
 	l1Gas := CalculateL1GasUsed(encoded, overhead)
-	return big.NewInt(0).Mul(l1Gas, block.BaseFee()), nil
+	return big.NewInt(0).Mul(l1Gas, L1GasPriceAt(block)), nil
 }
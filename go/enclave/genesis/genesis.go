@@ -1,9 +1,14 @@
 package genesis
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 
@@ -23,7 +28,8 @@ type Genesis struct {
 	Accounts []Account
 }
 
-// New creates a new Genesis given a json string
+// New creates a new Genesis given a json string (the `obscuroGenesis` enclave flag), e.g.
+// {"Accounts": [{"Address": "0x...", "Amount": 1000}]}
 // if the string is empty it defaults to the testnet genesis
 func New(genesisJSON string) (*Genesis, error) {
 	// defaults to the testnet genesis
@@ -39,6 +45,21 @@ func New(genesisJSON string) (*Genesis, error) {
 	return genesis, nil
 }
 
+// Hash - the hash of the prefunded accounts, included in the genesis batch so the nodes configured with different
+// allocations detect it when receiving the genesis batch. It does not depend on the order of the accounts.
+func (g Genesis) Hash() gethcommon.Hash {
+	accounts := make([]Account, len(g.Accounts))
+	copy(accounts, g.Accounts)
+	sort.SliceStable(accounts, func(i, j int) bool {
+		return bytes.Compare(accounts[i].Address.Bytes(), accounts[j].Address.Bytes()) < 0
+	})
+	encoded, err := rlp.EncodeToBytes(accounts)
+	if err != nil {
+		panic(fmt.Sprintf("unable to encode the genesis accounts. Cause: %s", err))
+	}
+	return crypto.Keccak256Hash(encoded)
+}
+
 func (g Genesis) CommitGenesisState(storage storage.Storage) error {
 	stateDB, err := g.applyAllocations(storage)
 	if err != nil {
@@ -94,3 +94,18 @@ func TestCustomGenesis(t *testing.T) {
 		t.Fatalf("unexpected balance")
 	}
 }
+
+func TestGenesisHash(t *testing.T) {
+	acc1 := Account{Address: datagenerator.RandomAddress(), Amount: big.NewInt(1)}
+	acc2 := Account{Address: datagenerator.RandomAddress(), Amount: big.NewInt(2)}
+
+	gen := Genesis{Accounts: []Account{acc1, acc2}}
+	if gen.Hash() != (Genesis{Accounts: []Account{acc2, acc1}}).Hash() {
+		t.Fatal("expected the hash not to depend on the order of the accounts")
+	}
+
+	acc2.Amount = big.NewInt(3)
+	if gen.Hash() == (Genesis{Accounts: []Account{acc1, acc2}}).Hash() {
+		t.Fatal("expected different allocations to have different hashes")
+	}
+}
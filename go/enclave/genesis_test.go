@@ -0,0 +1,146 @@
+package enclave
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/compression"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/events"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/enclavedb"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+)
+
+func TestGenesisOfAFreshSequencer(t *testing.T) {
+	enclave, _ := newGenesisTestEnclave(t, nil)
+	updates, stop := enclave.StreamL2Updates()
+	defer stop()
+
+	chain := extendGenesisTestChain(t, enclave, 3)
+	require.Equal(t, []uint64{1, 2, 3}, streamedSeqNos(t, updates, 3))
+	requireGenesis(t, enclave, chain[0])
+}
+
+func TestGenesisOfASyncedValidator(t *testing.T) {
+	enclave, _ := newGenesisTestEnclave(t, nil)
+	chain := extendGenesisTestChain(t, enclave, 3)
+
+	// the host of the validator connects once the batches are executed, and resumes from the beginning of the chain
+	updates, stop := enclave.StreamL2Updates()
+	defer stop()
+	require.Equal(t, []uint64{1, 2, 3, 4}, resumedSeqNos(t, enclave, updates, 0, 4))
+	requireGenesis(t, enclave, chain[0])
+}
+
+func TestGenesisOfARestartedNode(t *testing.T) {
+	enclave, backingDB := newGenesisTestEnclave(t, nil)
+	chain := extendGenesisTestChain(t, enclave, 3)
+
+	// the restarted node reads the same database, with empty caches. The genesis is read by height first, which used
+	// to share its cache entry with the batch of seq number 1.
+	restarted, _ := newGenesisTestEnclave(t, backingDB)
+	restarted.registry.OnBatchExecuted(chain[len(chain)-1], nil)
+	byHeight, err := restarted.GetBatchByHeight(common.L2GenesisHeight)
+	require.Nil(t, err)
+	require.Equal(t, chain[0].Hash(), byHeight.Hash())
+	requireGenesis(t, restarted, chain[0])
+
+	updates, stop := restarted.StreamL2Updates()
+	defer stop()
+	require.Equal(t, []uint64{1, 2, 3, 4}, resumedSeqNos(t, restarted, updates, 0, 4))
+}
+
+// requireGenesis - the genesis batch is returned by its seq number, by the seq number 0 which precedes it, by its height,
+// and first by the ranges starting from the beginning of the chain
+func requireGenesis(t *testing.T, enclave *enclaveImpl, genesis *core.Batch) {
+	for _, seqNo := range []uint64{0, common.L2GenesisSeqNo} {
+		batch, err := enclave.GetBatchBySeqNo(seqNo)
+		require.Nil(t, err)
+		require.Equal(t, genesis.Hash(), batch.Hash())
+	}
+	batch, err := enclave.storage.FetchBatchBySeqNo(common.L2GenesisSeqNo)
+	require.NoError(t, err)
+	require.Equal(t, genesis.Hash(), batch.Hash())
+
+	byHeight, err := enclave.GetBatchByHeight(common.L2GenesisHeight)
+	require.Nil(t, err)
+	require.Equal(t, genesis.Hash(), byHeight.Hash())
+
+	batches, err := enclave.GetBatchesBySeqRange(0, math.MaxInt64, 1)
+	require.Nil(t, err)
+	require.Len(t, batches, 1)
+	require.Equal(t, genesis.Hash(), batches[0].Hash())
+}
+
+// resumedSeqNos - the seq numbers of the batches received by a host which resumes the stream after lastSeqNo, as the
+// guardian does: the stored batches are fetched, then a new batch is produced and streamed
+func resumedSeqNos(t *testing.T, enclave *enclaveImpl, updates chan common.StreamL2UpdatesResponse, lastSeqNo uint64, n int) []uint64 {
+	missed, err := enclave.GetBatchesBySeqRange(lastSeqNo+1, math.MaxInt64, 0)
+	require.Nil(t, err)
+	var seqNos []uint64
+	for _, batch := range missed {
+		seqNos = append(seqNos, batch.Header.SequencerOrderNo.Uint64())
+	}
+	extendGenesisTestChain(t, enclave, n-len(seqNos))
+	for _, seqNo := range streamedSeqNos(t, updates, n-len(seqNos)) {
+		if len(seqNos) == 0 || seqNo > seqNos[len(seqNos)-1] {
+			seqNos = append(seqNos, seqNo)
+		}
+	}
+	return seqNos
+}
+
+// extendGenesisTestChain - stores and executes the next batches of the chain, starting with the genesis
+func extendGenesisTestChain(t *testing.T, enclave *enclaveImpl, count int) []*core.Batch {
+	parentHash, height, l1Proof := gethcommon.Hash{}, int64(common.L2GenesisHeight), testBlock("").Hash()
+	head, err := enclave.storage.FetchHeadBatch()
+	if err == nil {
+		parentHash, height, l1Proof = head.Hash(), head.Number().Int64()+1, head.Header.L1Proof
+	} else {
+		require.NoError(t, enclave.storage.StoreBlock(testBlock(""), nil))
+	}
+
+	batches := make([]*core.Batch, count)
+	for i := range batches {
+		batches[i] = &core.Batch{Header: &common.BatchHeader{
+			ParentHash:       parentHash,
+			Number:           big.NewInt(height),
+			SequencerOrderNo: big.NewInt(height + int64(common.L2GenesisSeqNo)),
+			L1Proof:          l1Proof,
+		}}
+		require.NoError(t, enclave.storage.StoreBatch(batches[i], batches[i].Hash()))
+		require.NoError(t, enclave.storage.StoreExecutedBatch(batches[i], nil))
+		enclave.registry.OnBatchExecuted(batches[i], nil)
+		parentHash, height = batches[i].Hash(), height+1
+	}
+	return batches
+}
+
+// newGenesisTestEnclave - an enclave on the database, or on a new one when it is nil
+func newGenesisTestEnclave(t *testing.T, backingDB enclavedb.EnclaveDB) (*enclaveImpl, enclavedb.EnclaveDB) {
+	logger := gethlog.New()
+	if backingDB == nil {
+		var err error
+		backingDB, err = sqlite.CreateTemporarySQLiteDB("", "", logger)
+		require.NoError(t, err)
+	}
+	storageDB := storage.NewStorage(backingDB, nil, logger)
+	return &enclaveImpl{
+		storage:                storageDB,
+		registry:               components.NewBatchRegistry(storageDB, logger),
+		dataEncryptionService:  crypto.NewDataEncryptionService(logger),
+		dataCompressionService: compression.NewBrotliDataCompressionService(),
+		subscriptionManager:    events.NewSubscriptionManager(storageDB, nil, 443, events.SubscriptionLimits{}, logger),
+		stopControl:            stopcontrol.New(),
+		logger:                 logger,
+	}, backingDB
+}
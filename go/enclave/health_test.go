@@ -0,0 +1,85 @@
+package enclave
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/events"
+	"github.com/ten-protocol/go-ten/go/enclave/evm/ethchainadapter"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+	"github.com/ten-protocol/go-ten/go/enclave/txpool"
+)
+
+// healthyStorage, healthyBlockProcessor, healthyRegistry and healthyRollupConsumer - components which report that they
+// are healthy, whatever their state
+type healthyStorage struct{ storage.Storage }
+
+func (s *healthyStorage) HealthCheck() (bool, error) { return true, nil }
+
+type healthyBlockProcessor struct{ components.L1BlockProcessor }
+
+func (p *healthyBlockProcessor) HealthCheck() (bool, error) { return true, nil }
+
+func (p *healthyBlockProcessor) GetHead() (*common.L1Block, error) {
+	return types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10)}), nil
+}
+
+type healthyRegistry struct{ components.BatchRegistry }
+
+func (r *healthyRegistry) HealthCheck() (bool, error) { return true, nil }
+
+func (r *healthyRegistry) HeadBatchSeq() *big.Int { return big.NewInt(5) }
+
+type healthyRollupConsumer struct{ components.RollupConsumer }
+
+func (c *healthyRollupConsumer) HealthCheck() (bool, error) { return true, nil }
+
+func TestUnhealthyComponentIsReportedWithItsDetail(t *testing.T) {
+	logger := gethlog.New()
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	require.NoError(t, err)
+	storageDB := &healthyStorage{Storage: storage.NewStorage(backingDB, nil, logger)}
+	gethEncoding := gethencoding.NewGethEncodingService(storageDB, logger)
+	registry := &healthyRegistry{}
+	// the mempool is not started, as it would be before the chain has a head batch
+	mempool, err := txpool.NewTxPool(ethchainadapter.NewEthChainAdapter(big.NewInt(443), registry, storageDB, gethEncoding, logger), big.NewInt(1), 0, txpool.Limits{}, storageDB, nil, logger)
+	require.NoError(t, err)
+	enclave := &enclaveImpl{
+		storage:             storageDB,
+		l1BlockProcessor:    &healthyBlockProcessor{},
+		registry:            registry,
+		mempool:             mempool,
+		rollupConsumer:      &healthyRollupConsumer{},
+		subscriptionManager: events.NewSubscriptionManager(storageDB, gethEncoding, 443, events.SubscriptionLimits{}, logger),
+		stopControl:         stopcontrol.New(),
+		logger:              logger,
+	}
+
+	status, err := enclave.HealthCheck()
+	require.NoError(t, err)
+	require.False(t, status.OverallHealth)
+	byName := map[string]common.ComponentHealth{}
+	for _, component := range status.Components {
+		byName[component.Name] = component
+		if component.Name != "mempool" {
+			require.True(t, component.Healthy, "component %s is unhealthy: %s", component.Name, component.Error)
+		}
+	}
+
+	mempoolHealth := byName["mempool"]
+	require.False(t, mempoolHealth.Healthy)
+	require.Equal(t, "mempool not running", mempoolHealth.Error)
+	require.Equal(t, "0", mempoolHealth.Detail["pending"])
+	require.Contains(t, mempoolHealth.Detail, "capacity")
+	// the healthy components are reported with their detail too
+	require.Equal(t, "10", byName["l1BlockProcessor"].Detail["headHeight"])
+	require.Equal(t, "5", byName["batchRegistry"].Detail["headSeqNo"])
+}
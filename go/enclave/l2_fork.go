@@ -0,0 +1,94 @@
+package enclave
+
+import (
+	"fmt"
+
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+// streamL2Fork - notifies the host that the orphaned batches it was streamed are on a dead branch, then streams again
+// the batches of the new canonical chain above the common ancestor, so the consumers which drop the batches above the
+// ancestor on the notification get the canonical chain after it. It is called once the sequencer duplicated the
+// orphaned batches, which were streamed already as they were produced.
+func (e *enclaveImpl) streamL2Fork(orphans []*core.Batch) error {
+	stream := e.openL2UpdatesStream()
+	if stream == nil || len(orphans) == 0 {
+		return nil
+	}
+	headSeqNo := e.registry.HeadBatchSeq()
+	if headSeqNo == nil || headSeqNo.Cmp(orphans[0].SeqNo()) < 0 {
+		// the orphaned batches were not executed yet, so they were not streamed
+		return nil
+	}
+
+	ancestor, err := e.storage.FetchBatch(orphans[0].Header.ParentHash)
+	if err != nil {
+		return fmt.Errorf("could not fetch the common ancestor of the orphaned batches. Cause: %w", err)
+	}
+	head, err := e.storage.FetchBatchBySeqNo(headSeqNo.Uint64())
+	if err != nil {
+		return fmt.Errorf("could not fetch the head batch. Cause: %w", err)
+	}
+	canonical, err := canonicalBatchesAbove(e.storage, ancestor, head, orphans)
+	if err != nil {
+		return err
+	}
+	newHead := ancestor
+	if len(canonical) > 0 {
+		newHead = canonical[len(canonical)-1]
+	}
+
+	e.logger.Info("Streaming the L2 fork caused by the L1 fork", "commonAncestor", ancestor.SeqNo(), log.BatchSeqNoKey, newHead.SeqNo(),
+		"orphaned", len(orphans))
+	sent := stream.send(common.StreamL2UpdatesResponse{
+		Fork: &common.L2ForkNotification{
+			CommonAncestorSeqNo: ancestor.SeqNo().Uint64(),
+			CommonAncestorHash:  ancestor.Hash(),
+			NewHeadSeqNo:        newHead.SeqNo().Uint64(),
+			NewHeadHash:         newHead.Hash(),
+		},
+	})
+	if !sent {
+		// the host is gone, and it gets the canonical chain from the enclave head once it streams again
+		return nil
+	}
+	for _, batch := range canonical {
+		e.sendBatch(batch, nil, stream)
+	}
+	return nil
+}
+
+// canonicalBatchesAbove - the batches from the child of the ancestor to the head, oldest first. There are none when the
+// head is one of the orphaned batches or is not above the ancestor, as the canonical batches are not known yet.
+func canonicalBatchesAbove(storage storage.Storage, ancestor *core.Batch, head *core.Batch, orphans []*core.Batch) ([]*core.Batch, error) {
+	orphaned := make(map[common.L2BatchHash]bool, len(orphans))
+	for _, orphan := range orphans {
+		orphaned[orphan.Hash()] = true
+	}
+
+	var batches []*core.Batch
+	batch := head
+	for batch.NumberU64() > ancestor.NumberU64() {
+		if orphaned[batch.Hash()] {
+			return nil, nil
+		}
+		batches = append(batches, batch)
+		parent, err := storage.FetchBatch(batch.Header.ParentHash)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch the parent of the batch %s. Cause: %w", batch.Hash(), err)
+		}
+		batch = parent
+	}
+	if batch.Hash() != ancestor.Hash() {
+		return nil, nil
+	}
+
+	// the slice is newest to oldest
+	for i, j := 0, len(batches)-1; i < j; i, j = i+1, j-1 {
+		batches[i], batches[j] = batches[j], batches[i]
+	}
+	return batches, nil
+}
@@ -0,0 +1,249 @@
+package enclave
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/compression"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/events"
+	"github.com/ten-protocol/go-ten/go/enclave/nodetype"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/integration/ethereummock"
+)
+
+// duplicatingSequencer - produces the batches on the L1 block it is given, and duplicates the batches of the reorged L1
+// blocks on the new canonical head on a fork, as the sequencer does
+type duplicatingSequencer struct {
+	nodetype.Sequencer
+	storage  storage.Storage
+	registry components.BatchRegistry
+	head     *core.Batch
+}
+
+func (s *duplicatingSequencer) produce(l1Proof common.L1BlockHash) (*core.Batch, error) {
+	header := &common.BatchHeader{
+		Number:           big.NewInt(0),
+		SequencerOrderNo: big.NewInt(int64(common.L2GenesisSeqNo)),
+		L1Proof:          l1Proof,
+	}
+	if s.head != nil {
+		header.ParentHash = s.head.Hash()
+		header.Number = new(big.Int).Add(s.head.Number(), big.NewInt(1))
+	}
+	if seqNo, err := s.storage.FetchCurrentSequencerNo(); err == nil {
+		header.SequencerOrderNo = new(big.Int).Add(seqNo, big.NewInt(1))
+	}
+	batch := &core.Batch{Header: header}
+	if err := s.storage.StoreBatch(batch, batch.Hash()); err != nil {
+		return nil, err
+	}
+	s.registry.OnBatchExecuted(batch, nil)
+	s.head = batch
+	return batch, nil
+}
+
+func (s *duplicatingSequencer) OnL1Fork(fork *common.ChainFork) error {
+	orphans, err := s.registry.OrphanedBatches(fork.NonCanonicalPath)
+	if err != nil || len(orphans) == 0 {
+		return err
+	}
+	if s.head, err = s.storage.FetchBatch(orphans[0].Header.ParentHash); err != nil {
+		return err
+	}
+	for range orphans {
+		if _, err = s.produce(fork.NewCanonical.Hash()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *duplicatingSequencer) OnL1Block(_ context.Context, _ types.Block, _ *components.BlockIngestionType) error {
+	return nil
+}
+
+func TestL1ForkIsStreamedBeforeTheNewCanonicalBatches(t *testing.T) {
+	enclave, seq := newForkTestEnclave(t)
+	updates, stop := enclave.StreamL2Updates()
+	defer stop()
+
+	// the batches are produced on the mock L1, two of them on a block which is reorged
+	submitL1Block(t, enclave, ethereummock.MockGenesisBlock)
+	ancestor := produceTestBatch(t, seq, ethereummock.MockGenesisBlock.Hash())
+	reorgedBlock := ethereummock.NewBlock(ethereummock.MockGenesisBlock, gethcommon.HexToAddress("0xa"), nil)
+	submitL1Block(t, enclave, reorgedBlock)
+	orphans := []*core.Batch{produceTestBatch(t, seq, reorgedBlock.Hash()), produceTestBatch(t, seq, reorgedBlock.Hash())}
+	for _, batch := range append([]*core.Batch{ancestor}, orphans...) {
+		require.Equal(t, batch.Hash(), nextStreamedBatch(t, updates).Hash())
+	}
+
+	// the mock L1 forks to a sibling of the block, and the sequencer duplicates the orphaned batches on it
+	canonicalBlock := ethereummock.NewBlock(ethereummock.MockGenesisBlock, gethcommon.HexToAddress("0xb"), nil)
+	submitL1Block(t, enclave, canonicalBlock)
+	duplicates, err := enclave.storage.FetchBatchesByBlock(canonicalBlock.Hash())
+	require.NoError(t, err)
+	require.Len(t, duplicates, len(orphans))
+	for _, batch := range duplicates {
+		require.Equal(t, batch.Hash(), nextStreamedBatch(t, updates).Hash())
+	}
+
+	// the duplicates are streamed as they are produced, then the fork is notified, and the new canonical batches above
+	// the common ancestor are streamed again
+	fork := nextStreamedUpdate(t, updates).Fork
+	require.NotNil(t, fork)
+	newHead := duplicates[len(duplicates)-1]
+	require.Equal(t, &common.L2ForkNotification{
+		CommonAncestorSeqNo: ancestor.SeqNo().Uint64(),
+		CommonAncestorHash:  ancestor.Hash(),
+		NewHeadSeqNo:        newHead.SeqNo().Uint64(),
+		NewHeadHash:         newHead.Hash(),
+	}, fork)
+	parent := ancestor.Hash()
+	for _, batch := range duplicates {
+		streamed := nextStreamedBatch(t, updates)
+		require.Equal(t, batch.Hash(), streamed.Hash())
+		require.Equal(t, parent, streamed.Header.ParentHash)
+		parent = streamed.Hash()
+	}
+	select {
+	case update := <-updates:
+		t.Fatalf("unexpected update after the canonical batches: %+v", update)
+	case <-time.After(10 * testBatchInterval):
+	}
+}
+
+func TestL1ForkIsNotBlockedByAHostWhichStoppedReading(t *testing.T) {
+	enclave, seq := newForkTestEnclave(t)
+	updates, stop := enclave.StreamL2Updates()
+
+	submitL1Block(t, enclave, ethereummock.MockGenesisBlock)
+	produceTestBatch(t, seq, ethereummock.MockGenesisBlock.Hash())
+	reorgedBlock := ethereummock.NewBlock(ethereummock.MockGenesisBlock, gethcommon.HexToAddress("0xa"), nil)
+	submitL1Block(t, enclave, reorgedBlock)
+	produceTestBatch(t, seq, reorgedBlock.Hash())
+	nextStreamedBatch(t, updates)
+	nextStreamedBatch(t, updates)
+
+	// the host stops reading until the stream is full, then disconnects while the fork is being streamed
+	stream := enclave.openL2UpdatesStream()
+	for len(updates) < cap(updates) {
+		require.True(t, stream.send(common.StreamL2UpdatesResponse{}))
+	}
+	time.AfterFunc(10*testBatchInterval, stop)
+	canonicalBlock := ethereummock.NewBlock(ethereummock.MockGenesisBlock, gethcommon.HexToAddress("0xb"), nil)
+	submitL1Block(t, enclave, canonicalBlock)
+	require.False(t, stream.send(common.StreamL2UpdatesResponse{}))
+}
+
+func TestL1ReturningToAReorgedBlockOrphansOnlyTheHeadChain(t *testing.T) {
+	enclave, seq := newForkTestEnclave(t)
+	updates, stop := enclave.StreamL2Updates()
+	defer stop()
+
+	submitL1Block(t, enclave, ethereummock.MockGenesisBlock)
+	ancestor := produceTestBatch(t, seq, ethereummock.MockGenesisBlock.Hash())
+	blockA := ethereummock.NewBlock(ethereummock.MockGenesisBlock, gethcommon.HexToAddress("0xa"), nil)
+	submitL1Block(t, enclave, blockA)
+	produceTestBatch(t, seq, blockA.Hash())
+	produceTestBatch(t, seq, blockA.Hash())
+
+	// the mock L1 forks to B, back to a child of A, then back to a child of B
+	blockB := ethereummock.NewBlock(ethereummock.MockGenesisBlock, gethcommon.HexToAddress("0xb"), nil)
+	submitL1Block(t, enclave, blockB)
+	nextStreamedFork(t, updates)
+	childA := ethereummock.NewBlock(blockA, gethcommon.HexToAddress("0xa"), nil)
+	submitL1Block(t, enclave, childA)
+	nextStreamedFork(t, updates)
+	childB := ethereummock.NewBlock(blockB, gethcommon.HexToAddress("0xb"), nil)
+	submitL1Block(t, enclave, childB)
+
+	// the batches on A were already replaced by the duplicates on B, so only the ones on the child of A are duplicated
+	duplicates, err := enclave.storage.FetchBatchesByBlock(childB.Hash())
+	require.NoError(t, err)
+	require.Len(t, duplicates, 2)
+	newHead := duplicates[len(duplicates)-1]
+	require.Equal(t, &common.L2ForkNotification{
+		CommonAncestorSeqNo: ancestor.SeqNo().Uint64(),
+		CommonAncestorHash:  ancestor.Hash(),
+		NewHeadSeqNo:        newHead.SeqNo().Uint64(),
+		NewHeadHash:         newHead.Hash(),
+	}, nextStreamedFork(t, updates))
+}
+
+func TestNoForkIsStreamedWhenNoBatchWasOrphaned(t *testing.T) {
+	enclave, seq := newForkTestEnclave(t)
+	updates, stop := enclave.StreamL2Updates()
+	defer stop()
+
+	submitL1Block(t, enclave, ethereummock.MockGenesisBlock)
+	produceTestBatch(t, seq, ethereummock.MockGenesisBlock.Hash())
+	nextStreamedBatch(t, updates)
+
+	// the reorged block has no batch
+	submitL1Block(t, enclave, ethereummock.NewBlock(ethereummock.MockGenesisBlock, gethcommon.HexToAddress("0xa"), nil))
+	submitL1Block(t, enclave, ethereummock.NewBlock(ethereummock.MockGenesisBlock, gethcommon.HexToAddress("0xb"), nil))
+	select {
+	case update := <-updates:
+		t.Fatalf("unexpected update: %+v", update)
+	case <-time.After(10 * testBatchInterval):
+	}
+}
+
+func newForkTestEnclave(t *testing.T) (*enclaveImpl, *duplicatingSequencer) {
+	logger := gethlog.New()
+	enclave, _ := newLockTestEnclave(t)
+	registry := components.NewBatchRegistry(enclave.storage, logger)
+	seq := &duplicatingSequencer{storage: enclave.storage, registry: registry}
+	enclave.service = seq
+	enclave.registry = registry
+	enclave.dataEncryptionService = crypto.NewDataEncryptionService(logger)
+	enclave.dataCompressionService = compression.NewBrotliDataCompressionService()
+	enclave.subscriptionManager = events.NewSubscriptionManager(enclave.storage, enclave.gethEncodingService, 443, events.SubscriptionLimits{}, logger)
+	return enclave, seq
+}
+
+func produceTestBatch(t *testing.T, seq *duplicatingSequencer, l1Proof common.L1BlockHash) *core.Batch {
+	batch, err := seq.produce(l1Proof)
+	require.NoError(t, err)
+	return batch
+}
+
+// nextStreamedBatch - the batch of the next update of the stream, skipping the new heads
+func nextStreamedBatch(t *testing.T, updates chan common.StreamL2UpdatesResponse) *common.ExtBatch {
+	for {
+		update := nextStreamedUpdate(t, updates)
+		require.Nil(t, update.Fork)
+		if update.Batch != nil {
+			return update.Batch
+		}
+	}
+}
+
+// nextStreamedFork - the next fork notification of the stream, skipping the batches and the new heads
+func nextStreamedFork(t *testing.T, updates chan common.StreamL2UpdatesResponse) *common.L2ForkNotification {
+	for {
+		if update := nextStreamedUpdate(t, updates); update.Fork != nil {
+			return update.Fork
+		}
+	}
+}
+
+func nextStreamedUpdate(t *testing.T, updates chan common.StreamL2UpdatesResponse) common.StreamL2UpdatesResponse {
+	select {
+	case update := <-updates:
+		return update
+	case <-time.After(lockTestTimeout):
+		t.Fatal("no update was streamed")
+		return common.StreamL2UpdatesResponse{}
+	}
+}
@@ -0,0 +1,52 @@
+package enclave
+
+import (
+	"sync"
+
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+// l2UpdatesStream - the channel of an open L2 updates stream. Every send selects on the done channel, so the senders
+// neither block on a host which stopped reading nor panic on a closed channel: the updates channel is only closed once
+// done is and the senders in progress have returned.
+type l2UpdatesStream struct {
+	updates chan common.StreamL2UpdatesResponse
+	done    chan struct{}
+
+	closeOnce sync.Once
+	sending   sync.RWMutex // held for reading by the senders, and for writing when closing the updates channel
+	closed    bool
+}
+
+func newL2UpdatesStream() *l2UpdatesStream {
+	return &l2UpdatesStream{
+		updates: make(chan common.StreamL2UpdatesResponse, 100),
+		done:    make(chan struct{}),
+	}
+}
+
+// send - returns false when the update was dropped, as the stream is closed
+func (s *l2UpdatesStream) send(update common.StreamL2UpdatesResponse) bool {
+	s.sending.RLock()
+	defer s.sending.RUnlock()
+	if s.closed {
+		return false
+	}
+	select {
+	case s.updates <- update:
+		return true
+	case <-s.done:
+		return false
+	}
+}
+
+// close - releases the blocked senders, then closes the updates channel once they returned, so the host gets an EOF
+func (s *l2UpdatesStream) close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.sending.Lock()
+		defer s.sending.Unlock()
+		s.closed = true
+		close(s.updates)
+	})
+}
@@ -0,0 +1,54 @@
+package enclave
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+func TestL2UpdatesStreamClosedWhileSending(t *testing.T) {
+	stream := newL2UpdatesStream()
+	// the host stopped reading, so the buffer is full
+	for i := 0; i < cap(stream.updates); i++ {
+		require.True(t, stream.send(common.StreamL2UpdatesResponse{}))
+	}
+
+	var senders sync.WaitGroup
+	dropped := make(chan bool, 3)
+	for i := 0; i < 3; i++ {
+		senders.Add(1)
+		go func() {
+			defer senders.Done()
+			dropped <- !stream.send(common.StreamL2UpdatesResponse{})
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	closed := make(chan struct{})
+	go func() {
+		stream.close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("closing the stream waited for the blocked senders")
+	}
+	senders.Wait()
+	close(dropped)
+	for d := range dropped {
+		require.True(t, d)
+	}
+
+	// the updates sent after closing are dropped, and the consumer gets the buffered ones before the end of the stream
+	require.False(t, stream.send(common.StreamL2UpdatesResponse{}))
+	received := 0
+	for range stream.updates {
+		received++
+	}
+	require.Equal(t, cap(stream.updates), received)
+	stream.close()
+}
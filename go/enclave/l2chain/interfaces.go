@@ -1,6 +1,8 @@
 package l2chain
 
 import (
+	"context"
+
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethcore "github.com/ethereum/go-ethereum/core"
@@ -23,12 +25,18 @@ type ObscuroChain interface {
 	// GetBalanceAtBlock - will return the balance of a specific address at the specific given block number (batch number).
 	GetBalanceAtBlock(accountAddr gethcommon.Address, blockNumber *gethrpc.BlockNumber) (*hexutil.Big, error)
 
-	// ObsCall - The interface for executing eth_call RPC commands against obscuro.
-	ObsCall(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*gethcore.ExecutionResult, error)
+	// ObsCall - The interface for executing eth_call RPC commands against obscuro. The execution is aborted when ctx is done.
+	// The gas requested in apiArgs is honoured up to GasLocalExecutionCapFlag, above which it is clamped. The calls which
+	// exceed the execution limits of the chain return errutil.ErrExecutionTimeout or errutil.ErrExecutionMemoryCapExceeded.
+	ObsCall(ctx context.Context, apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*gethcore.ExecutionResult, error)
 
 	// ObsCallAtBlock - Execute eth_call RPC against obscuro for a specific block (batch) number.
-	ObsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*gethcore.ExecutionResult, error)
+	ObsCallAtBlock(ctx context.Context, apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*gethcore.ExecutionResult, error)
 
 	// GetChainStateAtTransaction - returns the stateDB after applying all the transactions in the batch leading to the desired transaction.
 	GetChainStateAtTransaction(batch *core.Batch, txIndex int, reexec uint64) (*gethcore.Message, vm.BlockContext, *state.StateDB, error)
+
+	// GetIsolatedStateAtTransaction - as GetChainStateAtTransaction, but the stateDB is backed by its own trie database.
+	// It can be committed, e.g. to iterate its tries, without adding the nodes to the cache of the chain.
+	GetIsolatedStateAtTransaction(batch *core.Batch, txIndex int) (*state.StateDB, error)
 }
@@ -2,6 +2,7 @@ package l2chain
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path"
 
@@ -17,9 +18,6 @@ import (
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/trie"
-	"github.com/ten-protocol/go-ten/go/common/log"
-
-	gethlog "github.com/ethereum/go-ethereum/log"
 )
 
 const (
@@ -36,17 +34,29 @@ const (
 
 // NewL1Blockchain creates a Geth BlockChain object. `genesisJSON` is the Genesis block config in JSON format.
 // A Geth node can be made to output this using the `dumpgenesis` startup command.
-func NewL1Blockchain(genesisJSON []byte, logger gethlog.Logger) *core.BlockChain {
-	dataDir := createDataDir(logger)
+func NewL1Blockchain(genesisJSON []byte) (*core.BlockChain, error) {
+	dataDir, err := createDataDir()
+	if err != nil {
+		return nil, err
+	}
 
-	db := createDB(dataDir, logger)
+	db, err := createDB(dataDir)
+	if err != nil {
+		return nil, err
+	}
 	cacheConfig := createCacheConfig()
 	trieDB := createTrie(db, cacheConfig)
-	genesis := createGenesis(genesisJSON, logger)
-	chainConfig := createChainConfig(db, trieDB, genesis, logger)
+	genesis, err := createGenesis(genesisJSON)
+	if err != nil {
+		return nil, err
+	}
+	chainConfig, err := createChainConfig(db, trieDB, genesis)
+	if err != nil {
+		return nil, err
+	}
 	engine, err := createEngine(chainConfig, db)
 	if err != nil {
-		logger.Crit(err.Error())
+		return nil, err
 	}
 	vmConfig := createVMConfig()
 	shouldPreserve := createShouldPreserve()
@@ -54,9 +64,9 @@ func NewL1Blockchain(genesisJSON []byte, logger gethlog.Logger) *core.BlockChain
 
 	blockchain, err := core.NewBlockChain(db, cacheConfig, genesis, nil, engine, vmConfig, shouldPreserve, &txLookupLimit)
 	if err != nil {
-		logger.Crit("l1 blockchain could not be created. ", log.ErrKey, err)
+		return nil, fmt.Errorf("l1 blockchain could not be created. Cause: %w", err)
 	}
-	return blockchain
+	return blockchain, nil
 }
 
 func createTrie(db ethdb.Database, cacheConfig *core.CacheConfig) *trie.Database {
@@ -66,20 +76,20 @@ func createTrie(db ethdb.Database, cacheConfig *core.CacheConfig) *trie.Database
 	})
 }
 
-func createDataDir(logger gethlog.Logger) string {
+func createDataDir() (string, error) {
 	err := os.MkdirAll(dataDirRoot, 0o700)
 	if err != nil {
-		logger.Crit("l1 blockchain data directory could not be created. ", log.ErrKey, err)
+		return "", fmt.Errorf("l1 blockchain data directory could not be created. Cause: %w", err)
 	}
 	dataDir, err := os.MkdirTemp(dataDirRoot, "")
 	if err != nil {
-		logger.Crit("l1 blockchain data directory could not be created. ", log.ErrKey, err)
+		return "", fmt.Errorf("l1 blockchain data directory could not be created. Cause: %w", err)
 	}
 
-	return dataDir
+	return dataDir, nil
 }
 
-func createDB(dataDir string, logger gethlog.Logger) ethdb.Database {
+func createDB(dataDir string) (ethdb.Database, error) {
 	root := path.Join(dataDir, gethDir, chainDataDir) // Defaults to `geth/chaindata` in the node's data directory.
 	cache := 2048                                     // Default.
 	handles := 2048                                   // Default.
@@ -88,9 +98,9 @@ func createDB(dataDir string, logger gethlog.Logger) ethdb.Database {
 
 	db, err := rawdb.NewLevelDBDatabase(root, cache, handles, namespace, readonly)
 	if err != nil {
-		logger.Crit("l1 blockchain database could not be created. ", log.ErrKey, err)
+		return nil, fmt.Errorf("l1 blockchain database could not be created. Cause: %w", err)
 	}
-	return db
+	return db, nil
 }
 
 func createCacheConfig() *core.CacheConfig {
@@ -107,7 +117,7 @@ func createCacheConfig() *core.CacheConfig {
 	}
 }
 
-func createChainConfig(db ethdb.Database, triedb *trie.Database, genesis *core.Genesis, logger gethlog.Logger) *params.ChainConfig {
+func createChainConfig(db ethdb.Database, triedb *trie.Database, genesis *core.Genesis) (*params.ChainConfig, error) {
 	chainConfig, _, err := core.SetupGenesisBlockWithOverride(
 		db,
 		triedb,
@@ -115,9 +125,9 @@ func createChainConfig(db ethdb.Database, triedb *trie.Database, genesis *core.G
 		nil, // Default.
 	)
 	if err != nil {
-		logger.Crit("l1 blockchain genesis block could not be created. ", log.ErrKey, err)
+		return nil, fmt.Errorf("l1 blockchain genesis block could not be created. Cause: %w", err)
 	}
-	return chainConfig
+	return chainConfig, nil
 }
 
 // Recreates `eth/ethconfig/config.go/CreateConsensusEngine()`.
@@ -141,13 +151,13 @@ func createVMConfig() vm.Config {
 	}
 }
 
-func createGenesis(genesisJSON []byte, logger gethlog.Logger) *core.Genesis {
+func createGenesis(genesisJSON []byte) (*core.Genesis, error) {
 	genesis := &core.Genesis{}
 	err := genesis.UnmarshalJSON(genesisJSON)
 	if err != nil {
-		logger.Crit("l1 blockchain genesis JSON could not be parsed. ", log.ErrKey, err)
+		return nil, fmt.Errorf("l1 blockchain genesis JSON could not be parsed. Cause: %w", err)
 	}
-	return genesis
+	return genesis, nil
 }
 
 // We indicate that no blocks are authored by local accounts, and thus all blocks are discarded during reorgs.
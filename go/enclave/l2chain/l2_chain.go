@@ -1,6 +1,7 @@
 package l2chain
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -10,12 +11,14 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethcore "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/status-im/keycard-go/hexutils"
 	"github.com/ten-protocol/go-ten/go/common/gethapi"
 	"github.com/ten-protocol/go-ten/go/common/gethencoding"
@@ -36,7 +39,8 @@ type obscuroChain struct {
 	logger gethlog.Logger
 
 	Registry         components.BatchRegistry
-	gasEstimationCap uint64
+	gasEstimationCap uint64 // the most gas a call can use, see GasLocalExecutionCapFlag
+	limits           evm.ExecutionLimits
 }
 
 func NewChain(
@@ -47,6 +51,7 @@ func NewChain(
 	logger gethlog.Logger,
 	registry components.BatchRegistry,
 	gasEstimationCap uint64,
+	limits evm.ExecutionLimits,
 ) ObscuroChain {
 	return &obscuroChain{
 		storage:             storage,
@@ -56,6 +61,7 @@ func NewChain(
 		genesis:             genesis,
 		Registry:            registry,
 		gasEstimationCap:    gasEstimationCap,
+		limits:              limits,
 	}
 }
 
@@ -96,8 +102,8 @@ func (oc *obscuroChain) GetBalanceAtBlock(accountAddr gethcommon.Address, blockN
 	return (*hexutil.Big)(chainState.GetBalance(accountAddr)), nil
 }
 
-func (oc *obscuroChain) ObsCall(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*gethcore.ExecutionResult, error) {
-	result, err := oc.ObsCallAtBlock(apiArgs, blockNumber)
+func (oc *obscuroChain) ObsCall(ctx context.Context, apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*gethcore.ExecutionResult, error) {
+	result, err := oc.ObsCallAtBlock(ctx, apiArgs, blockNumber)
 	if err != nil {
 		oc.logger.Info(fmt.Sprintf("Obs_Call: failed to execute contract %s.", apiArgs.To), log.CtrErrKey, err.Error())
 		return nil, err
@@ -106,6 +112,10 @@ func (oc *obscuroChain) ObsCall(apiArgs *gethapi.TransactionArgs, blockNumber *g
 	// the execution might have succeeded (err == nil) but the evm contract logic might have failed (result.Failed() == true)
 	if result.Failed() {
 		oc.logger.Debug(fmt.Sprintf("Obs_Call: Failed to execute contract %s.", apiArgs.To), log.CtrErrKey, result.Err)
+		if errors.Is(result.Err, vm.ErrOutOfGas) {
+			// the caller can retry with more gas, as long as it is within the cap
+			return nil, fmt.Errorf("%w - the call used all of its %d gas, the calls can use up to %d gas", result.Err, result.UsedGas, oc.gasEstimationCap)
+		}
 		return nil, result.Err
 	}
 
@@ -115,7 +125,7 @@ func (oc *obscuroChain) ObsCall(apiArgs *gethapi.TransactionArgs, blockNumber *g
 	return result, nil
 }
 
-func (oc *obscuroChain) ObsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*gethcore.ExecutionResult, error) {
+func (oc *obscuroChain) ObsCallAtBlock(ctx context.Context, apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*gethcore.ExecutionResult, error) {
 	// fetch the chain state at given batch
 	blockState, err := oc.Registry.GetBatchStateAtHeight(blockNumber)
 	if err != nil {
@@ -127,7 +137,7 @@ func (oc *obscuroChain) ObsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNu
 		return nil, fmt.Errorf("unable to fetch head state batch. Cause: %w", err)
 	}
 
-	callMsg, err := apiArgs.ToMessage(batch.Header.GasLimit-1, batch.Header.BaseFee)
+	callMsg, err := apiArgs.ToMessage(callGasLimit(apiArgs.Gas, batch.Header.GasLimit, oc.gasEstimationCap), batch.Header.BaseFee)
 	if err != nil {
 		return nil, fmt.Errorf("unable to convert TransactionArgs to Message - %w", err)
 	}
@@ -141,7 +151,7 @@ func (oc *obscuroChain) ObsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNu
 			batch.Header.Root.Hex())
 	}})
 
-	result, err := evm.ExecuteObsCall(callMsg, blockState, batch.Header, oc.storage, oc.gethEncodingService, oc.chainConfig, oc.gasEstimationCap, oc.logger)
+	result, err := evm.ExecuteObsCall(ctx, callMsg, blockState, batch.Header, oc.storage, oc.gethEncodingService, oc.chainConfig, oc.gasEstimationCap, oc.limits, oc.logger)
 	if err != nil {
 		// also return the result as the result can be evaluated on some errors like ErrIntrinsicGas
 		return result, err
@@ -150,6 +160,19 @@ func (oc *obscuroChain) ObsCallAtBlock(apiArgs *gethapi.TransactionArgs, blockNu
 	return result, nil
 }
 
+// callGasLimit - the gas available to a call. The gas requested by the caller is honoured up to the cap, above which it
+// is clamped. The calls which do not request gas get the gas limit of the batch, within the cap.
+func callGasLimit(requested *hexutil.Uint64, batchGasLimit uint64, gasCap uint64) uint64 {
+	gasLimit := batchGasLimit - 1
+	if requested != nil {
+		gasLimit = uint64(*requested)
+	}
+	if gasLimit > gasCap {
+		return gasCap
+	}
+	return gasLimit
+}
+
 // GetChainStateAtTransaction Returns the state of the chain at certain block height after executing transactions up to the selected transaction
 // TODO make this cacheable
 func (oc *obscuroChain) GetChainStateAtTransaction(batch *core.Batch, txIndex int, _ uint64) (*gethcore.Message, vm.BlockContext, *state.StateDB, error) {
@@ -170,6 +193,30 @@ func (oc *obscuroChain) GetChainStateAtTransaction(batch *core.Batch, txIndex in
 	if err != nil {
 		return nil, vm.BlockContext{}, nil, err
 	}
+	return oc.replayUpTo(batch, txIndex, statedb)
+}
+
+func (oc *obscuroChain) GetIsolatedStateAtTransaction(batch *core.Batch, txIndex int) (*state.StateDB, error) {
+	if batch.NumberU64() == 0 {
+		return nil, errors.New("no transaction in genesis")
+	}
+	parent, err := oc.Registry.GetBatchAtHeight(gethrpc.BlockNumber(batch.NumberU64() - 1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch parent batch - %w", err)
+	}
+
+	// the trie database only reads from the disk, and keeps the nodes written by a commit to itself
+	isolatedDB := state.NewDatabaseWithConfig(rawdb.NewDatabase(oc.storage.StateDB().DiskDB()), &trie.Config{})
+	statedb, err := state.New(parent.Header.Root, isolatedDB, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create state DB for %s - %w", parent.Header.Root, err)
+	}
+	_, _, statedb, err = oc.replayUpTo(batch, txIndex, statedb)
+	return statedb, err
+}
+
+// replayUpTo - executes the transactions of the batch which precede the one at txIndex on top of the state of its parent
+func (oc *obscuroChain) replayUpTo(batch *core.Batch, txIndex int, statedb *state.StateDB) (*gethcore.Message, vm.BlockContext, *state.StateDB, error) {
 	if txIndex == 0 && len(batch.Transactions) == 0 {
 		return nil, vm.BlockContext{}, statedb, nil
 	}
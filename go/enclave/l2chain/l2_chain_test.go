@@ -0,0 +1,25 @@
+package l2chain
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallGasLimitIsBoundedByTheCap(t *testing.T) {
+	const gasCap = 4_000_000_000
+	requested := func(gas uint64) *hexutil.Uint64 {
+		return (*hexutil.Uint64)(&gas)
+	}
+
+	// the gas requested above the gas limit of the batch is honoured up to the cap
+	require.Equal(t, uint64(gasCap-1), callGasLimit(requested(gasCap-1), 30_000_000, gasCap))
+	require.Equal(t, uint64(gasCap), callGasLimit(requested(gasCap), 30_000_000, gasCap))
+	// and clamped above it, rather than rejected
+	require.Equal(t, uint64(gasCap), callGasLimit(requested(gasCap+1), 30_000_000, gasCap))
+
+	// the calls which do not request gas get the gas limit of the batch, within the cap
+	require.Equal(t, uint64(30_000_000-1), callGasLimit(nil, 30_000_000, gasCap))
+	require.Equal(t, uint64(gasCap), callGasLimit(nil, gasCap+2, gasCap))
+}
@@ -0,0 +1,80 @@
+package limiters
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	executionTimeWeight = 4  // the execution time of a new batch counts for 1/executionTimeWeight of the recent average
+	recoverySteps       = 10 // the number of fast batches after which the packing limit recovers from the floor to the max
+	minLimitFraction    = 10 // the packing limit is never scaled below 1/minLimitFraction of the max
+)
+
+// adaptiveGasLimiter - scales down the gas packed in a batch when the recent batches took longer to execute than the
+// target, so the sequencer keeps its cadence when the transactions get heavier. The limit recovers gradually when the
+// batches run fast again. Only the packing is affected, the gas limit of the batch headers stays the same.
+type adaptiveGasLimiter struct {
+	maxGas uint64
+	minGas uint64
+	target time.Duration
+
+	limit            uint64
+	avgExecutionTime time.Duration // the moving average of the recent execution times, zero before the first batch
+	mutex            sync.Mutex
+}
+
+// NewAdaptiveGasLimiter - maxGas is the gas limit of the batch headers. A zero target disables the scaling.
+func NewAdaptiveGasLimiter(maxGas uint64, target time.Duration) GasLimiter {
+	return &adaptiveGasLimiter{
+		maxGas: maxGas,
+		minGas: maxGas / minLimitFraction,
+		target: target,
+		limit:  maxGas,
+	}
+}
+
+func (l *adaptiveGasLimiter) Limit() uint64 {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.limit
+}
+
+func (l *adaptiveGasLimiter) RecordExecution(executionTime time.Duration, gasUsed uint64) {
+	if l.target == 0 {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.avgExecutionTime == 0 {
+		l.avgExecutionTime = executionTime
+	} else {
+		l.avgExecutionTime += (executionTime - l.avgExecutionTime) / executionTimeWeight
+	}
+
+	if l.avgExecutionTime > l.target {
+		// the execution time is roughly proportional to the gas, so the limit is scaled to what this batch would have
+		// executed within the target. When the batch was not full, only the gas it used is known to take this long.
+		// The limit is held, not raised, until the recent batches run within the target again
+		executedGas := l.limit
+		if gasUsed < executedGas {
+			executedGas = gasUsed
+		}
+		if executionTime > l.target {
+			scaled := uint64(float64(executedGas) * float64(l.target) / float64(executionTime))
+			if scaled < l.limit {
+				l.limit = scaled
+			}
+		}
+		if l.limit < l.minGas {
+			l.limit = l.minGas
+		}
+		return
+	}
+
+	l.limit += (l.maxGas - l.minGas) / recoverySteps
+	if l.limit > l.maxGas {
+		l.limit = l.maxGas
+	}
+}
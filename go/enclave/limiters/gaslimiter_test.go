@@ -0,0 +1,56 @@
+package limiters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMaxGas = 30_000_000
+	testTarget = 100 * time.Millisecond
+)
+
+func TestGasLimitScalesDownForSlowBatches(t *testing.T) {
+	limiter := NewAdaptiveGasLimiter(testMaxGas, testTarget)
+	require.Equal(t, uint64(testMaxGas), limiter.Limit())
+
+	// a full batch took twice the target, so only half of it fits
+	limiter.RecordExecution(2*testTarget, testMaxGas)
+	require.Equal(t, uint64(testMaxGas/2), limiter.Limit())
+
+	// the limit is held while the recent batches are still slow on average, even if this one ran within the target
+	limiter.RecordExecution(testTarget, testMaxGas/2)
+	require.Equal(t, uint64(testMaxGas/2), limiter.Limit())
+
+	// a very slow batch never takes the limit below the floor
+	limiter.RecordExecution(100*testTarget, testMaxGas/2)
+	require.Equal(t, uint64(testMaxGas/minLimitFraction), limiter.Limit())
+}
+
+func TestGasLimitRecoversGradually(t *testing.T) {
+	limiter := NewAdaptiveGasLimiter(testMaxGas, testTarget)
+	limiter.RecordExecution(100*testTarget, testMaxGas)
+	floor := limiter.Limit()
+	require.Equal(t, uint64(testMaxGas/minLimitFraction), floor)
+
+	previous := floor
+	fastBatches := 0
+	for limiter.Limit() < testMaxGas {
+		limiter.RecordExecution(time.Millisecond, limiter.Limit())
+		require.GreaterOrEqual(t, limiter.Limit(), previous)
+		require.LessOrEqual(t, limiter.Limit(), uint64(testMaxGas))
+		previous = limiter.Limit()
+		fastBatches++
+		require.Less(t, fastBatches, 100, "the limit did not recover")
+	}
+	// the first fast batches only bring the slow average down, then the limit recovers in steps
+	require.Greater(t, fastBatches, recoverySteps)
+}
+
+func TestGasLimitIsFixedWithoutTarget(t *testing.T) {
+	limiter := NewAdaptiveGasLimiter(testMaxGas, 0)
+	limiter.RecordExecution(time.Hour, testMaxGas)
+	require.Equal(t, uint64(testMaxGas), limiter.Limit())
+}
@@ -2,6 +2,7 @@ package limiters
 
 import (
 	"errors"
+	"time"
 
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 
@@ -17,4 +18,13 @@ var ErrInsufficientSpace = errors.New("insufficient space in BatchSizeLimiter")
 
 type RollupLimiter interface {
 	AcceptBatch(batch *core.Batch) (bool, error)
+	// LimitReached - whether a batch was refused because there was no space left in the rollup
+	LimitReached() bool
+}
+
+type GasLimiter interface {
+	// Limit - the gas the transactions of the next batch can use. It never exceeds the gas limit of the batch headers
+	Limit() uint64
+	// RecordExecution - the wall time and the gas used by the execution of a produced batch
+	RecordExecution(executionTime time.Duration, gasUsed uint64)
 }
@@ -16,7 +16,9 @@ const (
 )
 
 type rollupLimiter struct {
-	remainingSize uint64
+	remainingSize   uint64
+	limitReached    bool
+	acceptedBatches int
 }
 
 func NewRollupLimiter(size uint64) RollupLimiter {
@@ -25,6 +27,8 @@ func NewRollupLimiter(size uint64) RollupLimiter {
 	}
 }
 
+// AcceptBatch - the first batch is always accepted, so a batch that cannot fit in any rollup is reported when the rollup
+// is compressed, instead of producing an empty rollup.
 // todo (@stefan) figure out how to optimize the serialization out of the limiter
 func (rl *rollupLimiter) AcceptBatch(batch *core.Batch) (bool, error) {
 	encodedData, err := rlp.EncodeToBytes(batch.Transactions)
@@ -35,9 +39,18 @@ func (rl *rollupLimiter) AcceptBatch(batch *core.Batch) (bool, error) {
 	// adjust with a compression factor and add the size of a compressed batch header
 	encodedSize := uint64(float64(len(encodedData))*txCompressionFactor) + compressedHeaderSize
 	if encodedSize > rl.remainingSize {
-		return false, nil
+		if rl.acceptedBatches > 0 {
+			rl.limitReached = true
+			return false, nil
+		}
+		encodedSize = rl.remainingSize
 	}
 
 	rl.remainingSize -= encodedSize
+	rl.acceptedBatches++
 	return true, nil
 }
+
+func (rl *rollupLimiter) LimitReached() bool {
+	return rl.limitReached
+}
@@ -0,0 +1,247 @@
+package enclave
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
+	"github.com/ten-protocol/go-ten/go/enclave/gas"
+	"github.com/ten-protocol/go-ten/go/enclave/nodetype"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
+)
+
+const lockTestTimeout = 5 * time.Second
+
+// blockingSequencer - the batches and the rollups are produced until released, and the L1 events are recorded
+type blockingSequencer struct {
+	nodetype.Sequencer
+	producing chan struct{}
+	release   chan struct{}
+
+	events     []string
+	eventsLock sync.Mutex
+}
+
+func (s *blockingSequencer) CreateBatch(bool) (*core.Batch, error) {
+	s.record("batch started")
+	close(s.producing)
+	<-s.release
+	s.record("batch produced")
+	return &core.Batch{Header: &common.BatchHeader{SequencerOrderNo: big.NewInt(int64(common.L2GenesisSeqNo))}}, nil
+}
+
+func (s *blockingSequencer) CreateRollup(uint64, nodetype.RollupSnapshot) (*common.ExtRollup, *common.ExtRollupMetadata, error) {
+	close(s.producing)
+	<-s.release
+	return &common.ExtRollup{}, &common.ExtRollupMetadata{}, nil
+}
+
+func (s *blockingSequencer) OnL1Fork(fork *common.ChainFork) error {
+	if fork.IsFork() {
+		s.record("fork")
+	}
+	return nil
+}
+
+func (s *blockingSequencer) OnL1Block(context.Context, types.Block, *components.BlockIngestionType) error {
+	s.record("block")
+	return nil
+}
+
+func (s *blockingSequencer) record(event string) {
+	s.eventsLock.Lock()
+	defer s.eventsLock.Unlock()
+	s.events = append(s.events, event)
+}
+
+func (s *blockingSequencer) recorded() []string {
+	s.eventsLock.Lock()
+	defer s.eventsLock.Unlock()
+	return append([]string{}, s.events...)
+}
+
+// sealedRegistry - the rollups need an executed head batch
+type sealedRegistry struct {
+	components.BatchRegistry
+}
+
+func (r *sealedRegistry) HeadBatchSeq() *big.Int {
+	return big.NewInt(int64(common.L2GenesisSeqNo))
+}
+
+func (r *sealedRegistry) OrphanedBatches([]common.L1BlockHash) ([]*core.Batch, error) {
+	return nil, nil
+}
+
+func TestCreateRollupDoesNotBlockL1Ingestion(t *testing.T) {
+	enclave, seq := newLockTestEnclave(t)
+	genesis := lockTestBlock(gethcommon.Hash{}, 0, "")
+	submitL1Block(t, enclave, genesis)
+
+	rollupCreated := make(chan common.SystemError)
+	go func() {
+		_, _, err := enclave.CreateRollup(common.L2GenesisSeqNo)
+		rollupCreated <- err
+	}()
+	<-seq.producing
+
+	// the rollup is being compressed, and the L1 blocks are still ingested
+	submitL1Block(t, enclave, lockTestBlock(genesis.Hash(), 1, ""))
+	require.Equal(t, []string{"block", "block"}, seq.recorded())
+
+	close(seq.release)
+	require.Nil(t, <-rollupCreated)
+}
+
+func TestForkWaitsForBatchProduction(t *testing.T) {
+	enclave, seq := newLockTestEnclave(t)
+	genesis := lockTestBlock(gethcommon.Hash{}, 0, "")
+	submitL1Block(t, enclave, genesis)
+	submitL1Block(t, enclave, lockTestBlock(genesis.Hash(), 1, ""))
+
+	batchCreated := make(chan common.SystemError)
+	go func() {
+		_, err := enclave.CreateBatch(false)
+		batchCreated <- err
+	}()
+	<-seq.producing
+
+	// a fork arrives while the batch is produced
+	fork := lockTestBlock(genesis.Hash(), 1, "fork")
+	// the block is copied before the goroutine starts, as reading the hash of the fork below caches it in the block
+	forkBlock := *fork
+	forkSubmitted := make(chan common.SystemError)
+	go func() {
+		_, err := enclave.SubmitL1Block(context.Background(), forkBlock, types.Receipts{}, nil, false)
+		forkSubmitted <- err
+	}()
+
+	// the fork is only ingested once the batch was built on the previous head
+	select {
+	case <-forkSubmitted:
+		t.Fatal("the L1 block was ingested while a batch was produced")
+	case <-time.After(100 * time.Millisecond):
+	}
+	head, err := enclave.l1BlockProcessor.GetHead()
+	require.NoError(t, err)
+	require.NotEqual(t, fork.Hash(), head.Hash())
+
+	close(seq.release)
+	require.Nil(t, <-batchCreated)
+	select {
+	case err := <-forkSubmitted:
+		require.Nil(t, err)
+	case <-time.After(lockTestTimeout):
+		t.Fatal("the L1 block was not ingested after the batch was produced")
+	}
+	require.Equal(t, []string{"block", "block", "batch started", "batch produced", "fork", "block"}, seq.recorded())
+}
+
+func TestPauseWaitsForBatchProduction(t *testing.T) {
+	enclave, seq := newLockTestEnclave(t)
+	submitL1Block(t, enclave, lockTestBlock(gethcommon.Hash{}, 0, ""))
+
+	batchCreated := make(chan common.SystemError)
+	go func() {
+		_, err := enclave.CreateBatch(false)
+		batchCreated <- err
+	}()
+	<-seq.producing
+
+	paused := make(chan common.SystemError)
+	go func() {
+		paused <- enclave.PauseBatchProduction()
+	}()
+	select {
+	case <-paused:
+		t.Fatal("the batch production was paused while a batch was produced")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(seq.release)
+	require.Nil(t, <-batchCreated)
+	require.Nil(t, <-paused)
+	require.Equal(t, []string{"block", "batch started", "batch produced"}, seq.recorded())
+
+	// no batch is produced while paused, but the rollups are still created
+	result, err := enclave.CreateBatch(false)
+	require.Nil(t, err)
+	require.Equal(t, &common.CreateBatchResult{SkipReason: common.BatchSkippedPaused}, result)
+	seq.producing = make(chan struct{})
+	_, _, err = enclave.CreateRollup(common.L2GenesisSeqNo)
+	require.Nil(t, err)
+	require.Equal(t, []string{"block", "batch started", "batch produced"}, seq.recorded())
+
+	require.Nil(t, enclave.ResumeBatchProduction())
+	seq.producing = make(chan struct{})
+	result, err = enclave.CreateBatch(false)
+	require.Nil(t, err)
+	require.True(t, result.Produced)
+	require.Equal(t, uint64(common.L2GenesisSeqNo), result.SeqNo)
+	require.Equal(t, []string{"block", "batch started", "batch produced", "batch started", "batch produced"}, seq.recorded())
+}
+
+func TestOnlyTheSequencerPausesBatchProduction(t *testing.T) {
+	enclave, _ := newLockTestEnclave(t)
+	enclave.service = &noopNodeType{}
+
+	require.ErrorIs(t, enclave.PauseBatchProduction(), errutil.ErrNotSequencer)
+	require.ErrorIs(t, enclave.ResumeBatchProduction(), errutil.ErrNotSequencer)
+}
+
+func newLockTestEnclave(t *testing.T) (*enclaveImpl, *blockingSequencer) {
+	logger := gethlog.New()
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	require.NoError(t, err)
+	storageDB := storage.NewStorage(backingDB, nil, logger)
+	mgmtContractLib := mgmtcontractlib.NewMgmtContractLib(&gethcommon.Address{}, logger)
+
+	seq := &blockingSequencer{producing: make(chan struct{}), release: make(chan struct{})}
+	return &enclaveImpl{
+		config:                &config.EnclaveConfig{},
+		storage:               storageDB,
+		registry:              &sealedRegistry{},
+		l1BlockProcessor:      components.NewBlockProcessor(storageDB, crosschain.New(&gethcommon.Address{}, &gethcommon.Address{}, storageDB, big.NewInt(443), 0, logger), mgmtContractLib, gas.NewGasOracle(common.NetworkParameters{BaseFee: big.NewInt(1), MinGasPrice: big.NewInt(1)}, storageDB, logger), nil, logger),
+		rollupConsumer:        components.NewRollupConsumer(mgmtContractLib, nil, nil, storageDB, logger, nil, nil),
+		sharedSecretProcessor: components.NewSharedSecretProcessor(mgmtContractLib, &components.DummyAttestationProvider{}, storageDB, 0, 0, logger),
+		service:               seq,
+		tracer:                tracing.Tracer(nil),
+		stopControl:           stopcontrol.New(),
+		logger:                logger,
+	}, seq
+}
+
+func lockTestBlock(parent gethcommon.Hash, height int64, extra string) *types.Block {
+	return types.NewBlock(&types.Header{ParentHash: parent, Number: big.NewInt(height), Extra: []byte(extra)}, nil, nil, nil, trie.NewStackTrie(nil))
+}
+
+func submitL1Block(t *testing.T, enclave *enclaveImpl, block *types.Block) {
+	submitted := make(chan common.SystemError)
+	go func() {
+		_, err := enclave.SubmitL1Block(context.Background(), *block, types.Receipts{}, nil, false)
+		submitted <- err
+	}()
+	select {
+	case err := <-submitted:
+		require.Nil(t, err)
+	case <-time.After(lockTestTimeout):
+		t.Fatalf("block %d was not ingested", block.NumberU64())
+	}
+}
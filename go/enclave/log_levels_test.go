@@ -0,0 +1,29 @@
+package enclave
+
+import (
+	"testing"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/responses"
+)
+
+func TestComponentLogLevelIsCappedByTheConfiguredLevels(t *testing.T) {
+	cfg := &config.EnclaveConfig{
+		DebugNamespaceEnabled: true,
+		LogLevel:              int(gethlog.LvlInfo),
+		ComponentLogLevels:    map[string]int{log.TxPoolCmp: int(gethlog.LvlDebug)},
+	}
+	enclave := &enclaveImpl{config: cfg, logLevels: newComponentLogLevels(cfg, gethlog.New()), stopControl: stopcontrol.New(), logger: gethlog.New()}
+
+	levels, err := enclave.SetLogLevel(log.SubscriptionManagerCmp, int(gethlog.LvlDebug))
+	require.NoError(t, err)
+	require.Equal(t, int(gethlog.LvlDebug), levels[log.SubscriptionManagerCmp])
+
+	// the logger of the enclave drops the trace records
+	_, err = enclave.SetLogLevel(log.SubscriptionManagerCmp, int(gethlog.LvlTrace))
+	require.Equal(t, responses.ErrCodeInvalidParams, responses.ErrorCodeOf(err))
+}
@@ -25,6 +25,9 @@ func main() {
 		panic(fmt.Errorf("unable to create config from flags - %w", err))
 	}
 
-	enclaveContainer := enclavecontainer.NewEnclaveContainerFromConfig(enclaveConfig)
+	enclaveContainer, err := enclavecontainer.NewEnclaveContainerFromConfig(enclaveConfig)
+	if err != nil {
+		panic(fmt.Errorf("unable to create the enclave container - %w", err))
+	}
 	container.Serve(enclaveContainer)
 }
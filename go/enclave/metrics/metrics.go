@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+)
+
+var _quantiles = []float64{0.5, 0.75, 0.95, 0.99}
+
+// Registry - the metrics of the enclave components, exported in the Prometheus text format.
+// Only aggregates are recorded (durations, sizes, counts), never anything that identifies a user or a transaction.
+// A nil Registry hands out no-op metrics, so the components record their metrics without checking if they are enabled.
+type Registry struct {
+	registry gethmetrics.Registry
+
+	// gauges sampled when the metrics are exported, for the values which are cheaper to read than to track
+	gaugeFuncs     map[string]func() int64
+	gaugeFuncsLock sync.Mutex
+}
+
+// New returns nil when the metrics are disabled
+func New(enabled bool) *Registry {
+	if !enabled {
+		return nil
+	}
+	// the geth constructors hand out no-op metrics unless the metrics are enabled globally
+	gethmetrics.Enabled = true
+	return &Registry{
+		registry:   gethmetrics.NewRegistry(),
+		gaugeFuncs: map[string]func() int64{},
+	}
+}
+
+func (r *Registry) Counter(name string) gethmetrics.Counter {
+	if r == nil {
+		return gethmetrics.NilCounter{}
+	}
+	return gethmetrics.GetOrRegisterCounter(name, r.registry)
+}
+
+func (r *Registry) Gauge(name string) gethmetrics.Gauge {
+	if r == nil {
+		return gethmetrics.NilGauge{}
+	}
+	return gethmetrics.GetOrRegisterGauge(name, r.registry)
+}
+
+// Histogram - the distribution of a value, e.g. a size
+func (r *Registry) Histogram(name string) gethmetrics.Histogram {
+	if r == nil {
+		return gethmetrics.NilHistogram{}
+	}
+	return gethmetrics.GetOrRegisterHistogramLazy(name, r.registry, func() gethmetrics.Sample {
+		return gethmetrics.NewExpDecaySample(1028, 0.015)
+	})
+}
+
+// Timer - the distribution of a duration, in nanoseconds
+func (r *Registry) Timer(name string) gethmetrics.Timer {
+	if r == nil {
+		return gethmetrics.NilTimer{}
+	}
+	return gethmetrics.GetOrRegisterTimer(name, r.registry)
+}
+
+// GaugeFunc - a gauge whose value is read from f every time the metrics are exported
+func (r *Registry) GaugeFunc(name string, f func() int64) {
+	if r == nil {
+		return
+	}
+	r.Gauge(name)
+	r.gaugeFuncsLock.Lock()
+	defer r.gaugeFuncsLock.Unlock()
+	r.gaugeFuncs[name] = f
+}
+
+// Export - the metrics in the Prometheus text format, sorted by name. Nothing is exported when the metrics are disabled.
+func (r *Registry) Export() []byte {
+	if r == nil {
+		return nil
+	}
+
+	r.gaugeFuncsLock.Lock()
+	for name, f := range r.gaugeFuncs {
+		r.Gauge(name).Update(f())
+	}
+	r.gaugeFuncsLock.Unlock()
+
+	var names []string
+	r.registry.Each(func(name string, _ interface{}) {
+		names = append(names, name)
+	})
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		key := prometheusName(name)
+		switch m := r.registry.Get(name).(type) {
+		case gethmetrics.Counter:
+			fmt.Fprintf(&buf, "# TYPE %s counter\n%s %d\n", key, key, m.Snapshot().Count())
+		case gethmetrics.Gauge:
+			fmt.Fprintf(&buf, "# TYPE %s gauge\n%s %d\n", key, key, m.Snapshot().Value())
+		case gethmetrics.Histogram:
+			writeSummary(&buf, key, m.Snapshot())
+		case gethmetrics.Timer:
+			writeSummary(&buf, key, m.Snapshot())
+		}
+	}
+	return buf.Bytes()
+}
+
+func writeSummary(buf *bytes.Buffer, key string, s gethmetrics.SampleSnapshot) {
+	fmt.Fprintf(buf, "# TYPE %s summary\n", key)
+	for i, value := range s.Percentiles(_quantiles) {
+		fmt.Fprintf(buf, "%s{quantile=\"%g\"} %g\n", key, _quantiles[i], value)
+	}
+	fmt.Fprintf(buf, "%s_sum %d\n%s_count %d\n", key, s.Sum(), key, s.Count())
+}
+
+// prometheusName - e.g. "enclave/batch/compute" becomes "enclave_batch_compute"
+func prometheusName(name string) string {
+	return strings.NewReplacer("/", "_", "-", "_", ".", "_").Replace(name)
+}
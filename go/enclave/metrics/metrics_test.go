@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExportPrometheusText(t *testing.T) {
+	registry := New(true)
+	registry.Counter("enclave/txpool/added").Inc(3)
+	registry.Histogram("enclave/rollup/size").Update(100)
+	registry.Timer("enclave/batch/compute").Update(time.Millisecond)
+	depth := int64(0)
+	registry.GaugeFunc("enclave/txpool/pending", func() int64 { return depth })
+
+	depth = 7
+	exported := string(registry.Export())
+	for _, expected := range []string{
+		"# TYPE enclave_txpool_added counter\nenclave_txpool_added 3\n",
+		"# TYPE enclave_txpool_pending gauge\nenclave_txpool_pending 7\n",
+		"# TYPE enclave_rollup_size summary\n",
+		"enclave_rollup_size{quantile=\"0.5\"} 100\n",
+		"enclave_rollup_size_sum 100\nenclave_rollup_size_count 1\n",
+		"enclave_batch_compute_count 1\n",
+	} {
+		if !strings.Contains(exported, expected) {
+			t.Errorf("expected %q in the exported metrics:\n%s", expected, exported)
+		}
+	}
+	if strings.Index(exported, "enclave_batch_compute") > strings.Index(exported, "enclave_txpool_added") {
+		t.Errorf("expected the metrics to be sorted by name")
+	}
+}
+
+func TestDisabledMetrics(t *testing.T) {
+	registry := New(false)
+	registry.Counter("enclave/txpool/added").Inc(1)
+	registry.Timer("enclave/batch/compute").UpdateSince(time.Now())
+	registry.GaugeFunc("enclave/txpool/pending", func() int64 { return 1 })
+	if exported := registry.Export(); len(exported) != 0 {
+		t.Errorf("expected nothing to be exported, got %s", exported)
+	}
+}
@@ -1,6 +1,8 @@
 package nodetype
 
 import (
+	"context"
+
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/enclave/components"
@@ -19,27 +21,40 @@ type NodeType interface {
 	OnL1Fork(fork *common.ChainFork) error
 
 	// OnL1Block - performed after the block was processed
-	OnL1Block(block types.Block, result *components.BlockIngestionType) error
+	OnL1Block(ctx context.Context, block types.Block, result *components.BlockIngestionType) error
 
 	Close() error
 }
 
 type Sequencer interface {
-	// CreateBatch - creates a new head batch for the latest known L1 head block.
-	CreateBatch(skipBatchIfEmpty bool) error
+	// CreateBatch - creates a new head batch for the latest known L1 head block, and returns it. Returns no batch when
+	// the batch was skipped because it had no transactions.
+	CreateBatch(skipBatchIfEmpty bool) (*core.Batch, error)
 
 	// CreateRollup - creates a new rollup from the latest recorded rollup in the head l1 chain
-	// and adds as many batches to it as possible.
-	CreateRollup(lastBatchNo uint64) (*common.ExtRollup, error)
+	// and adds as many batches to it as possible, from the sealed batches of the snapshot.
+	CreateRollup(lastBatchNo uint64, snapshot RollupSnapshot) (*common.ExtRollup, *common.ExtRollupMetadata, error)
+
+	// BatchGasLimit - the gas the transactions of the next batch can use, scaled down while the batches execute slowly
+	BatchGasLimit() uint64
 
 	NodeType
 }
 
+// RollupSnapshot - the chain heads a rollup is created from, read while holding the lock of the L2 head. The batches up to
+// HeadBatchSeqNo are sealed and never modified, so the rollup is created without holding the lock.
+type RollupSnapshot struct {
+	HeadBatchSeqNo uint64
+	L1Head         *common.L1Block
+}
+
 type ObsValidator interface {
-	// ExecuteStoredBatches - try to execute all stored by unexecuted batches
-	ExecuteStoredBatches() error
+	// ExecuteStoredBatches - try to execute all stored by unexecuted batches, in order, until one cannot be executed.
+	// The summary is returned along with the error of a failed execution.
+	ExecuteStoredBatches(ctx context.Context) (*common.BatchExecutionSummary, error)
 
-	VerifySequencerSignature(*core.Batch) error
+	// VerifySequencerSignature - verifies the signature of the header, before the transactions are decrypted
+	VerifySequencerSignature(*common.ExtBatch) error
 
 	NodeType
 }
@@ -1,12 +1,12 @@
 package nodetype
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"errors"
 	"fmt"
 	"math/big"
-	"sort"
 	"time"
 
 	"github.com/ten-protocol/go-ten/go/common/gethencoding"
@@ -28,17 +28,28 @@ import (
 	"github.com/ten-protocol/go-ten/go/enclave/components"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/gas"
 	"github.com/ten-protocol/go-ten/go/enclave/limiters"
 )
 
-const RollupDelay = 2 // number of L1 blocks to exclude when creating a rollup. This will minimize compression reorg issues.
+const (
+	RollupDelay            = 2 // number of L1 blocks to exclude when creating a rollup. This will minimize compression reorg issues.
+	rollupCandidatesFactor = 2 // the candidate batches of a rollup are estimated to compress to at most this many times the max rollup size
+)
 
+// SequencerSettings - the gas payment address and the base fee of the batches are network parameters, read from the
+// gas oracle for the L1 block each batch is built on
 type SequencerSettings struct {
-	MaxBatchSize      uint64
-	MaxRollupSize     uint64
-	GasPaymentAddress gethcommon.Address
-	BatchGasLimit     uint64
-	BaseFee           *big.Int
+	MaxBatchSize  uint64
+	MaxRollupSize uint64
+	BatchGasLimit uint64
+	// BatchExecutionTarget - the packing limit of the batches is scaled down while they take longer than this to
+	// execute. Zero disables the scaling
+	BatchExecutionTarget time.Duration
+	// SignatureScheme - what is signed for the batches and the rollups
+	SignatureScheme common.SignatureScheme
+	// TimeRules - the batch times are clamped to the rules the validators enforce
+	TimeRules components.BatchTimeRules
 }
 
 type sequencer struct {
@@ -60,10 +71,12 @@ type sequencer struct {
 	dataEncryptionService  crypto.DataEncryptionService
 	dataCompressionService compression.DataCompressionService
 	settings               SequencerSettings
+	gasLimiter             limiters.GasLimiter
+	gasOracle              gas.Oracle
 	blockchain             *ethchainadapter.EthChainAdapter
 }
 
-func NewSequencer(blockProcessor components.L1BlockProcessor, batchExecutor components.BatchExecutor, registry components.BatchRegistry, rollupProducer components.RollupProducer, rollupConsumer components.RollupConsumer, rollupCompression *components.RollupCompression, gethEncodingService gethencoding.EncodingService, logger gethlog.Logger, hostID gethcommon.Address, chainConfig *params.ChainConfig, enclavePrivateKey *crypto.EnclaveKey, mempool *txpool.TxPool, storage storage.Storage, dataEncryptionService crypto.DataEncryptionService, dataCompressionService compression.DataCompressionService, settings SequencerSettings, blockchain *ethchainadapter.EthChainAdapter) Sequencer {
+func NewSequencer(blockProcessor components.L1BlockProcessor, batchExecutor components.BatchExecutor, registry components.BatchRegistry, rollupProducer components.RollupProducer, rollupConsumer components.RollupConsumer, rollupCompression *components.RollupCompression, gethEncodingService gethencoding.EncodingService, logger gethlog.Logger, hostID gethcommon.Address, chainConfig *params.ChainConfig, enclavePrivateKey *crypto.EnclaveKey, mempool *txpool.TxPool, storage storage.Storage, dataEncryptionService crypto.DataEncryptionService, dataCompressionService compression.DataCompressionService, settings SequencerSettings, gasOracle gas.Oracle, blockchain *ethchainadapter.EthChainAdapter) Sequencer {
 	return &sequencer{
 		blockProcessor:         blockProcessor,
 		batchProducer:          batchExecutor,
@@ -81,20 +94,26 @@ func NewSequencer(blockProcessor components.L1BlockProcessor, batchExecutor comp
 		dataEncryptionService:  dataEncryptionService,
 		dataCompressionService: dataCompressionService,
 		settings:               settings,
+		gasLimiter:             limiters.NewAdaptiveGasLimiter(settings.BatchGasLimit, settings.BatchExecutionTarget),
+		gasOracle:              gasOracle,
 		blockchain:             blockchain,
 	}
 }
 
-func (s *sequencer) CreateBatch(skipBatchIfEmpty bool) error {
+func (s *sequencer) CreateBatch(skipBatchIfEmpty bool) (*core.Batch, error) {
 	hasGenesis, err := s.batchRegistry.HasGenesisBatch()
 	if err != nil {
-		return fmt.Errorf("unknown genesis batch state. Cause: %w", err)
+		return nil, fmt.Errorf("unknown genesis batch state. Cause: %w", err)
 	}
 
 	// L1 Head is only updated when isLatest: true
 	l1HeadBlock, err := s.blockProcessor.GetHead()
 	if err != nil {
-		return fmt.Errorf("failed retrieving l1 head. Cause: %w", err)
+		if !hasGenesis && errors.Is(err, errutil.ErrNotFound) {
+			// the genesis batch is created on top of the first L1 head
+			return nil, errutil.ErrNotReady
+		}
+		return nil, fmt.Errorf("failed retrieving l1 head. Cause: %w", err)
 	}
 
 	// the sequencer creates the initial genesis batch if one does not exist yet
@@ -107,7 +126,7 @@ func (s *sequencer) CreateBatch(skipBatchIfEmpty bool) error {
 		// if the node restarted the mempool must be started again
 		err = s.mempool.Start()
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -118,36 +137,40 @@ func (s *sequencer) CreateBatch(skipBatchIfEmpty bool) error {
 // should only create batches and stateDBs but not commit them to the database,
 // this is the responsibility of the sequencer. Refactor the code so genesis state
 // won't be committed by the producer.
-func (s *sequencer) createGenesisBatch(block *common.L1Block) error {
+func (s *sequencer) createGenesisBatch(block *common.L1Block) (*core.Batch, error) {
 	s.logger.Info("Initializing genesis state", log.BlockHashKey, block.Hash())
+	parameters, err := s.gasOracle.ParametersAt(block)
+	if err != nil {
+		return nil, err
+	}
 	batch, msgBusTx, err := s.batchProducer.CreateGenesisState(
 		block.Hash(),
 		uint64(time.Now().Unix()),
-		s.settings.GasPaymentAddress,
-		s.settings.BaseFee,
+		parameters.GasPaymentAddress,
+		parameters.BaseFee,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := s.signBatch(batch); err != nil {
-		return fmt.Errorf("failed signing created batch. Cause: %w", err)
+		return nil, fmt.Errorf("failed signing created batch. Cause: %w", err)
 	}
 
 	if err := s.StoreExecutedBatch(batch, nil); err != nil {
-		return fmt.Errorf("1. failed storing batch. Cause: %w", err)
+		return nil, fmt.Errorf("1. failed storing batch. Cause: %w", err)
 	}
 
 	// this is the actual first block produced in chain
 	err = s.blockchain.IngestNewBlock(batch)
 	if err != nil {
-		return fmt.Errorf("failed to feed batch into the virtual eth chain - %w", err)
+		return nil, fmt.Errorf("failed to feed batch into the virtual eth chain - %w", err)
 	}
 
 	// the mempool can only be started after at least 1 block is in the blockchain object
 	err = s.mempool.Start()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// errors in unit test seem to suggest that batch 2 was received before batch 1
@@ -161,85 +184,118 @@ func (s *sequencer) createGenesisBatch(block *common.L1Block) error {
 		common.L2Transactions{msgBusTx},
 		uint64(time.Now().Unix()),
 		false,
+		0,
 	)
 	if err != nil {
 		if errors.Is(err, components.ErrNoTransactionsToProcess) {
 			// skip batch production when there are no transactions to process
 			// todo: this might be a useful event to track for metrics (skipping batch production because empty batch)
 			s.logger.Debug("Skipping batch production, no transactions to execute")
-			return nil
+			return batch, nil
 		}
-		return fmt.Errorf(" failed producing batch. Cause: %w", err)
+		return nil, fmt.Errorf(" failed producing batch. Cause: %w", err)
 	}
 
 	if len(cb.Receipts) == 0 || cb.Receipts[0].TxHash.Hex() != msgBusTx.Hash().Hex() {
 		err = fmt.Errorf("message Bus contract not minted - no receipts in batch")
 		s.logger.Error(err.Error())
-		return err
+		return nil, err
 	}
 
 	s.logger.Info("Message Bus Contract minted successfully", "address", cb.Receipts[0].ContractAddress.Hex())
 
-	return nil
+	return cb.Batch, nil
 }
 
-func (s *sequencer) createNewHeadBatch(l1HeadBlock *common.L1Block, skipBatchIfEmpty bool) error {
+func (s *sequencer) createNewHeadBatch(l1HeadBlock *common.L1Block, skipBatchIfEmpty bool) (*core.Batch, error) {
 	headBatchSeq := s.batchRegistry.HeadBatchSeq()
 	if headBatchSeq == nil {
 		headBatchSeq = big.NewInt(int64(common.L2GenesisSeqNo))
 	}
 	headBatch, err := s.storage.FetchBatchBySeqNo(headBatchSeq.Uint64())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// todo - sanity check that the headBatch.Header.L1Proof is an ancestor of the l1HeadBlock
 	b, err := s.storage.FetchBlock(headBatch.Header.L1Proof)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if !s.storage.IsAncestor(l1HeadBlock, b) {
-		return fmt.Errorf("attempted to create batch on top of batch=%s. With l1 head=%s", headBatch.Hash(), l1HeadBlock.Hash())
+		return nil, fmt.Errorf("attempted to create batch on top of batch=%s. With l1 head=%s", headBatch.Hash(), l1HeadBlock.Hash())
 	}
 
-	// todo (@stefan) - limit on receipts too
-	limiter := limiters.NewBatchSizeLimiter(s.settings.MaxBatchSize)
-	pendingTransactions := s.mempool.PendingTransactions()
-	var transactions []*types.Transaction
-	for _, group := range pendingTransactions {
-		// lazily resolve transactions until the batch runs out of space
-		for _, lazyTx := range group {
-			if tx := lazyTx.Resolve(); tx != nil {
-				err = limiter.AcceptTransaction(tx)
-				if err != nil {
-					if errors.Is(err, limiters.ErrInsufficientSpace) { // Batch ran out of space
-						break
-					}
-					// Limiter encountered unexpected error
-					return fmt.Errorf("limiter encountered unexpected error - %w", err)
-				}
-				transactions = append(transactions, tx)
-			}
-		}
+	parameters, err := s.gasOracle.ParametersAt(l1HeadBlock)
+	if err != nil {
+		return nil, err
+	}
+	transactions, skippedForGas, err := s.selectTransactions(parameters.BaseFee)
+	if err != nil {
+		return nil, err
 	}
 
 	sequencerNo, err := s.storage.FetchCurrentSequencerNo()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// todo - time is set only here; take from l1 block?
-	if _, err := s.produceBatch(sequencerNo.Add(sequencerNo, big.NewInt(1)), l1HeadBlock.Hash(), headBatch.Hash(), transactions, uint64(time.Now().Unix()), skipBatchIfEmpty); err != nil {
+	cb, err := s.produceBatch(sequencerNo.Add(sequencerNo, big.NewInt(1)), l1HeadBlock.Hash(), headBatch.Hash(), transactions, uint64(time.Now().Unix()), skipBatchIfEmpty, skippedForGas)
+	if err != nil {
 		if errors.Is(err, components.ErrNoTransactionsToProcess) {
 			// skip batch production when there are no transactions to process
 			// todo: this might be a useful event to track for metrics (skipping batch production because empty batch)
 			s.logger.Debug("Skipping batch production, no transactions to execute")
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf(" failed producing batch. Cause: %w", err)
+		return nil, fmt.Errorf(" failed producing batch. Cause: %w", err)
 	}
 
-	return nil
+	return cb.Batch, nil
+}
+
+// selectTransactions - picks the mempool transactions for the next batch, ordered by effective tip while respecting
+// the nonce order of each sender. Senders whose next transaction doesn't fit in the remaining batch gas are skipped.
+// Returns the selected transactions and the number of transactions skipped for gas reasons.
+func (s *sequencer) selectTransactions(baseFee *big.Int) (common.L2Transactions, int, error) {
+	// todo (@stefan) - limit on receipts too
+	limiter := limiters.NewBatchSizeLimiter(s.settings.MaxBatchSize)
+	remainingGas := s.gasLimiter.Limit()
+	orderedTxs := txpool.NewTransactionsByPriceAndNonce(s.mempool.PendingTransactions(), baseFee)
+
+	var transactions common.L2Transactions
+	skippedForGas := 0
+	for lazyTx := orderedTxs.Peek(); lazyTx != nil; lazyTx = orderedTxs.Peek() {
+		// lazily resolve transactions until the batch runs out of space
+		tx := lazyTx.Resolve()
+		if tx == nil {
+			orderedTxs.Pop()
+			continue
+		}
+
+		// the following transactions of this sender can't be included without this one
+		if tx.Gas() > remainingGas {
+			skippedForGas++
+			orderedTxs.Pop()
+			continue
+		}
+
+		err := limiter.AcceptTransaction(tx)
+		if err != nil {
+			if errors.Is(err, limiters.ErrInsufficientSpace) { // Batch ran out of space for this sender
+				orderedTxs.Pop()
+				continue
+			}
+			// Limiter encountered unexpected error
+			return nil, 0, fmt.Errorf("limiter encountered unexpected error - %w", err)
+		}
+
+		transactions = append(transactions, tx)
+		remainingGas -= tx.Gas()
+		orderedTxs.Shift()
+	}
+	return transactions, skippedForGas, nil
 }
 
 func (s *sequencer) produceBatch(
@@ -249,14 +305,32 @@ func (s *sequencer) produceBatch(
 	transactions common.L2Transactions,
 	batchTime uint64,
 	failForEmptyBatch bool,
+	skippedForGas int,
 ) (*components.ComputedBatch, error) {
+	// the network parameters in force for the L1 block the batch is built on
+	block, err := s.storage.FetchBlock(l1Hash)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the L1 block of the batch. Cause: %w", err)
+	}
+	parameters, err := s.gasOracle.ParametersAt(block)
+	if err != nil {
+		return nil, err
+	}
+	// a skewed local clock must not produce a batch the validators reject
+	parent, err := s.storage.FetchBatchHeader(headBatch)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the parent of the batch. Cause: %w", err)
+	}
+	if batchTime, err = s.settings.TimeRules.Clamp(sequencerNo.Uint64(), batchTime, parent.Time, block.Time()); err != nil {
+		return nil, fmt.Errorf("no batch time follows the time rules. Cause: %w", err)
+	}
 	cb, err := s.batchProducer.ComputeBatch(&components.BatchExecutionContext{
 		BlockPtr:     l1Hash,
 		ParentPtr:    headBatch,
 		Transactions: transactions,
 		AtTime:       batchTime,
-		Creator:      s.settings.GasPaymentAddress,
-		BaseFee:      s.settings.BaseFee,
+		Creator:      parameters.GasPaymentAddress,
+		BaseFee:      parameters.BaseFee,
 		ChainConfig:  s.chainConfig,
 		SequencerNo:  sequencerNo,
 	}, failForEmptyBatch)
@@ -264,6 +338,8 @@ func (s *sequencer) produceBatch(
 		return nil, fmt.Errorf("failed computing batch. Cause: %w", err)
 	}
 
+	s.gasLimiter.RecordExecution(cb.ExecutionTime, cb.GasUsed)
+
 	if _, err := cb.Commit(true); err != nil {
 		return nil, fmt.Errorf("failed committing batch state. Cause: %w", err)
 	}
@@ -277,7 +353,10 @@ func (s *sequencer) produceBatch(
 	}
 
 	s.logger.Info("Produced new batch", log.BatchHashKey, cb.Batch.Hash(),
-		"height", cb.Batch.Number(), "numTxs", len(cb.Batch.Transactions), log.BatchSeqNoKey, cb.Batch.SeqNo(), "parent", cb.Batch.Header.ParentHash)
+		"height", cb.Batch.Number(), "numTxs", len(cb.Batch.Transactions), "skippedForGas", skippedForGas, log.BatchSeqNoKey, cb.Batch.SeqNo(), "parent", cb.Batch.Header.ParentHash)
+
+	// the transactions are recorded before they leave the pool, so a copy received later is still rejected
+	s.mempool.RecordInclusion(cb.Batch.SeqNo().Uint64(), cb.Batch.Transactions)
 
 	// add the batch to the chain so it can remove pending transactions from the pool
 	err = s.blockchain.IngestNewBlock(cb.Batch)
@@ -285,6 +364,11 @@ func (s *sequencer) produceBatch(
 		return nil, fmt.Errorf("failed to feed batch into the virtual eth chain - %w", err)
 	}
 
+	// the included transactions no longer have to survive a restart
+	if err := s.mempool.PruneJournal(cb.Batch.Transactions); err != nil {
+		s.logger.Warn("Could not prune the mempool journal", log.BatchHashKey, cb.Batch.Hash(), log.ErrKey, err)
+	}
+
 	return cb, nil
 }
 
@@ -317,56 +401,95 @@ func (s *sequencer) StoreExecutedBatch(batch *core.Batch, receipts types.Receipt
 	return nil
 }
 
-func (s *sequencer) CreateRollup(lastBatchNo uint64) (*common.ExtRollup, error) {
-	rollupLimiter := limiters.NewRollupLimiter(s.settings.MaxRollupSize)
+func (s *sequencer) CreateRollup(lastBatchNo uint64, snapshot RollupSnapshot) (*common.ExtRollup, *common.ExtRollupMetadata, error) {
+	// the limiter only estimates the compressed size, so it selects more batches than can fit, and the exact compressed
+	// size decides where the rollup is cut
+	rollupLimiter := limiters.NewRollupLimiter(s.settings.MaxRollupSize * rollupCandidatesFactor)
 
-	currentL1Head, err := s.blockProcessor.GetHead()
+	upToL1Height := snapshot.L1Head.NumberU64() - RollupDelay
+	candidates, err := s.rollupProducer.CreateInternalRollup(lastBatchNo, snapshot.HeadBatchSeqNo, upToL1Height, rollupLimiter)
 	if err != nil {
-		return nil, err
-	}
-	upToL1Height := currentL1Head.NumberU64() - RollupDelay
-	rollup, err := s.rollupProducer.CreateInternalRollup(lastBatchNo, upToL1Height, rollupLimiter)
-	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	extRollup, err := s.rollupCompression.CreateExtRollup(rollup)
+	rollup, extRollup, stats, err := s.compressWithinSize(candidates)
 	if err != nil {
-		return nil, fmt.Errorf("failed to compress rollup: %w", err)
+		return nil, nil, fmt.Errorf("failed to compress rollup: %w", err)
 	}
+	s.rollupCompression.RecordStats(stats)
 
 	// todo - double-check that this signing approach is secure, and it properly includes the entire payload
 	if err := s.signRollup(extRollup); err != nil {
-		return nil, fmt.Errorf("failed to sign created rollup: %w", err)
+		return nil, nil, fmt.Errorf("failed to sign created rollup: %w", err)
 	}
 
-	return extRollup, nil
+	metadata := &common.ExtRollupMetadata{
+		FirstBatchSeqNo:  rollup.Batches[0].SeqNo().Uint64(),
+		LastBatchSeqNo:   rollup.Header.LastBatchSeqNo,
+		CompressedSize:   extRollup.CompressedSize(),
+		SizeLimitReached: rollupLimiter.LimitReached() || len(rollup.Batches) < len(candidates.Batches),
+	}
+	if snapshot.HeadBatchSeqNo > metadata.LastBatchSeqNo {
+		metadata.RemainingBatches = snapshot.HeadBatchSeqNo - metadata.LastBatchSeqNo
+	}
+	return extRollup, metadata, nil
 }
 
-func (s *sequencer) duplicateBatches(l1Head *types.Block, nonCanonicalL1Path []common.L1BlockHash) error {
-	batchesToDuplicate := make([]*core.Batch, 0)
+// compressWithinSize - compresses the longest prefix of the candidate batches that fits in the maximum rollup size.
+// The compressed size grows with every batch appended, so the cut-off is found with a binary search, instead of
+// compressing every prefix.
+func (s *sequencer) compressWithinSize(candidates *core.Rollup) (*core.Rollup, *common.ExtRollup, *common.RollupCompressionStats, error) {
+	extRollup, stats, err := s.rollupCompression.CreateExtRollup(candidates)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if extRollup.CompressedSize() <= s.settings.MaxRollupSize {
+		return candidates, extRollup, stats, nil
+	}
 
-	// read the batches attached to these blocks
-	for _, l1BlockHash := range nonCanonicalL1Path {
-		batches, err := s.storage.FetchBatchesByBlock(l1BlockHash)
+	// the first batch must fit on its own, otherwise no rollup can include it
+	rollup := candidates.Prefix(1)
+	if extRollup, stats, err = s.rollupCompression.CreateExtRollup(rollup); err != nil {
+		return nil, nil, nil, err
+	}
+	if extRollup.CompressedSize() > s.settings.MaxRollupSize {
+		return nil, nil, nil, fmt.Errorf("batch %d does not fit in a rollup. Compressed size: %d bytes, max rollup size: %d bytes",
+			rollup.Header.LastBatchSeqNo, extRollup.CompressedSize(), s.settings.MaxRollupSize)
+	}
+
+	// the first `fits` batches are within the limit, the first `exceeds` batches are not
+	fits, exceeds := 1, len(candidates.Batches)
+	for exceeds-fits > 1 {
+		n := (fits + exceeds) / 2
+		prefix := candidates.Prefix(n)
+		prefixExtRollup, prefixStats, err := s.rollupCompression.CreateExtRollup(prefix)
 		if err != nil {
-			if errors.Is(err, errutil.ErrNotFound) {
-				continue
-			}
-			return fmt.Errorf("could not FetchBatchesByBlock %s. Cause %w", l1BlockHash, err)
+			return nil, nil, nil, err
+		}
+		if prefixExtRollup.CompressedSize() > s.settings.MaxRollupSize {
+			exceeds = n
+			continue
 		}
-		batchesToDuplicate = append(batchesToDuplicate, batches...)
+		fits, rollup, extRollup, stats = n, prefix, prefixExtRollup, prefixStats
+	}
+
+	s.logger.Info(fmt.Sprintf("Rollup cut at batch %d to fit in %d bytes. %d candidate batches left out.",
+		rollup.Header.LastBatchSeqNo, s.settings.MaxRollupSize, len(candidates.Batches)-len(rollup.Batches)))
+	return rollup, extRollup, stats, nil
+}
+
+func (s *sequencer) duplicateBatches(l1Head *types.Block, nonCanonicalL1Path []common.L1BlockHash) error {
+	// read the batches of the L2 chain attached to these blocks. The batches of these blocks which are not on the chain
+	// were duplicated already, when the L1 forked away from these blocks before.
+	batchesToDuplicate, err := s.batchRegistry.OrphanedBatches(nonCanonicalL1Path)
+	if err != nil {
+		return fmt.Errorf("could not fetch the orphaned batches. Cause %w", err)
 	}
 
 	if len(batchesToDuplicate) == 0 {
 		return nil
 	}
 
-	// sort by height
-	sort.Slice(batchesToDuplicate, func(i, j int) bool {
-		return batchesToDuplicate[i].Number().Cmp(batchesToDuplicate[j].Number()) == -1
-	})
-
 	currentHead := batchesToDuplicate[0].Header.ParentHash
 
 	// find all batches for that path
@@ -380,8 +503,10 @@ func (s *sequencer) duplicateBatches(l1Head *types.Block, nonCanonicalL1Path []c
 			return fmt.Errorf("could not fetch sequencer no. Cause %w", err)
 		}
 		sequencerNo = sequencerNo.Add(sequencerNo, big.NewInt(1))
+		// the transactions of the orphan are only included again by its duplicate
+		s.mempool.RevertInclusion(orphanBatch.SeqNo().Uint64())
 		// create the duplicate and store/broadcast it, recreate batch even if it was empty
-		cb, err := s.produceBatch(sequencerNo, l1Head.ParentHash(), currentHead, orphanBatch.Transactions, orphanBatch.Header.Time, false)
+		cb, err := s.produceBatch(sequencerNo, l1Head.ParentHash(), currentHead, orphanBatch.Transactions, orphanBatch.Header.Time, false, 0)
 		if err != nil {
 			return fmt.Errorf("could not produce batch. Cause %w", err)
 		}
@@ -419,9 +544,13 @@ func (s *sequencer) OnL1Fork(fork *common.ChainFork) error {
 	return nil
 }
 
+func (s *sequencer) BatchGasLimit() uint64 {
+	return s.gasLimiter.Limit()
+}
+
 func (s *sequencer) signBatch(batch *core.Batch) error {
 	var err error
-	h := batch.Hash()
+	h := s.settings.SignatureScheme.BatchDigest(batch.Header)
 	batch.Header.R, batch.Header.S, err = ecdsa.Sign(rand.Reader, s.enclaveKey.PrivateKey(), h[:])
 	if err != nil {
 		return fmt.Errorf("could not sign batch. Cause: %w", err)
@@ -431,16 +560,17 @@ func (s *sequencer) signBatch(batch *core.Batch) error {
 
 func (s *sequencer) signRollup(rollup *common.ExtRollup) error {
 	var err error
-	h := rollup.Header.Hash()
+	h := s.settings.SignatureScheme.RollupDigest(rollup.Header)
 	rollup.Header.R, rollup.Header.S, err = ecdsa.Sign(rand.Reader, s.enclaveKey.PrivateKey(), h[:])
 	if err != nil {
-		return fmt.Errorf("could not sign batch. Cause: %w", err)
+		return fmt.Errorf("could not sign rollup. Cause: %w", err)
 	}
 	return nil
 }
 
-func (s *sequencer) OnL1Block(_ types.Block, _ *components.BlockIngestionType) error {
-	// nothing to do
+func (s *sequencer) OnL1Block(_ context.Context, _ types.Block, _ *components.BlockIngestionType) error {
+	// the min gas price follows the L1 head, including when a fork drops an update
+	s.mempool.SetMinGasPrice(s.gasOracle.Parameters().MinGasPrice)
 	return nil
 }
 
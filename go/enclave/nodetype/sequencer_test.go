@@ -0,0 +1,397 @@
+package nodetype
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core"
+	gethtxpool "github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/compression"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/enclavetest"
+	"github.com/ten-protocol/go-ten/go/enclave/genesis"
+	"github.com/ten-protocol/go-ten/go/enclave/limiters"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+	"github.com/ten-protocol/go-ten/go/enclave/txpool"
+	"github.com/ten-protocol/go-ten/go/responses"
+)
+
+const (
+	testBatches       = 10
+	testTxSize        = 1000
+	testL1Blocks      = RollupDelay + 1
+	testBatchGasLimit = enclavetest.BatchGasLimit
+)
+
+// fixedHeadBlockProcessor - only the L1 head is needed to create rollups
+type fixedHeadBlockProcessor struct {
+	components.L1BlockProcessor
+	head *types.Block
+}
+
+func (bp *fixedHeadBlockProcessor) GetHead() (*common.L1Block, error) {
+	return bp.head, nil
+}
+
+func TestRollupsSplitWhenFull(t *testing.T) {
+	seq := newTestSequencer(t, 3*testTxSize)
+
+	var rollups []*common.ExtRollupMetadata
+	for fromSeqNo := common.L2GenesisSeqNo; fromSeqNo <= testBatches; {
+		rollup, metadata, err := seq.CreateRollup(fromSeqNo, testSnapshot(seq))
+		require.NoError(t, err)
+		require.Equal(t, metadata.LastBatchSeqNo, rollup.Header.LastBatchSeqNo)
+		require.Equal(t, rollup.CompressedSize(), metadata.CompressedSize)
+		require.LessOrEqual(t, metadata.CompressedSize, seq.settings.MaxRollupSize)
+		require.Equal(t, fromSeqNo, metadata.FirstBatchSeqNo)
+		require.Equal(t, testBatches-metadata.LastBatchSeqNo, metadata.RemainingBatches)
+		rollups = append(rollups, metadata)
+		fromSeqNo = metadata.LastBatchSeqNo + 1
+	}
+
+	require.Greater(t, len(rollups), 1)
+	for i, metadata := range rollups {
+		// only the last rollup has room left
+		require.Equal(t, i < len(rollups)-1, metadata.SizeLimitReached)
+		if metadata.SizeLimitReached {
+			require.Greater(t, compressedSize(t, seq, metadata.FirstBatchSeqNo, metadata.LastBatchSeqNo+1), seq.settings.MaxRollupSize)
+		}
+	}
+}
+
+func TestRollupSizeBoundary(t *testing.T) {
+	seq := newTestSequencer(t, 0)
+	threeBatchesSize := compressedSize(t, seq, common.L2GenesisSeqNo, 3)
+
+	// the three batches fit exactly
+	seq.settings.MaxRollupSize = threeBatchesSize
+	_, metadata, err := seq.CreateRollup(common.L2GenesisSeqNo, testSnapshot(seq))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), metadata.LastBatchSeqNo)
+	require.Equal(t, threeBatchesSize, metadata.CompressedSize)
+	require.True(t, metadata.SizeLimitReached)
+
+	// one byte short, so the third batch is left for the next rollup
+	seq.settings.MaxRollupSize = threeBatchesSize - 1
+	_, metadata, err = seq.CreateRollup(common.L2GenesisSeqNo, testSnapshot(seq))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), metadata.LastBatchSeqNo)
+	require.Less(t, metadata.CompressedSize, threeBatchesSize)
+	require.True(t, metadata.SizeLimitReached)
+
+	_, metadata, err = seq.CreateRollup(3, testSnapshot(seq))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), metadata.FirstBatchSeqNo)
+}
+
+func TestOnlyTheStatsOfTheCreatedRollupAreRecorded(t *testing.T) {
+	seq := newTestSequencer(t, 0)
+	seq.settings.MaxRollupSize = compressedSize(t, seq, common.L2GenesisSeqNo, 3)
+
+	// the binary search compresses several candidates, but only the rollup is recorded
+	extRollup, metadata, err := seq.CreateRollup(common.L2GenesisSeqNo, testSnapshot(seq))
+	require.NoError(t, err)
+	stats, err := seq.storage.FetchRollupCompressionStats()
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, extRollup.Hash(), stats[0].RollupHash)
+	require.True(t, stats[0].Created)
+	require.Equal(t, metadata.FirstBatchSeqNo, stats[0].FirstBatchSeqNo)
+	require.Equal(t, metadata.LastBatchSeqNo, stats[0].LastBatchSeqNo)
+	require.Equal(t, uint64(3), stats[0].Batches)
+	// the test batches are too small to compress well once the genesis hash is in the header
+	require.NotZero(t, stats[0].RawBytes)
+	require.NotZero(t, stats[0].CompressedBytes)
+	require.Less(t, stats[0].CompressedBytes, metadata.CompressedSize)
+	require.False(t, stats[0].RecordedAt.IsZero())
+}
+
+func TestBatchLargerThanRollup(t *testing.T) {
+	seq := newTestSequencer(t, 0)
+	seq.settings.MaxRollupSize = compressedSize(t, seq, common.L2GenesisSeqNo, common.L2GenesisSeqNo) - 1
+
+	_, _, err := seq.CreateRollup(common.L2GenesisSeqNo, testSnapshot(seq))
+	require.ErrorContains(t, err, "batch 1 does not fit in a rollup")
+}
+
+func TestRollupWithAllBatches(t *testing.T) {
+	seq := newTestSequencer(t, 100*testTxSize)
+
+	_, metadata, err := seq.CreateRollup(common.L2GenesisSeqNo, testSnapshot(seq))
+	require.NoError(t, err)
+	require.Equal(t, common.ExtRollupMetadata{
+		FirstBatchSeqNo:  common.L2GenesisSeqNo,
+		LastBatchSeqNo:   testBatches,
+		CompressedSize:   metadata.CompressedSize,
+		RemainingBatches: 0,
+		SizeLimitReached: false,
+	}, *metadata)
+}
+
+func TestRollupOnlyIncludesTheSealedBatches(t *testing.T) {
+	seq := newTestSequencer(t, 100*testTxSize)
+	snapshot := testSnapshot(seq)
+	snapshot.HeadBatchSeqNo = 5
+
+	_, metadata, err := seq.CreateRollup(common.L2GenesisSeqNo, snapshot)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), metadata.LastBatchSeqNo)
+	require.Equal(t, uint64(0), metadata.RemainingBatches)
+	require.False(t, metadata.SizeLimitReached)
+}
+
+// compressedSize - the size of a rollup with the batches from fromSeqNo to toSeqNo
+func compressedSize(t *testing.T, seq *sequencer, fromSeqNo uint64, toSeqNo uint64) uint64 {
+	candidates, err := seq.rollupProducer.CreateInternalRollup(fromSeqNo, testBatches, testL1Blocks-RollupDelay, limiters.NewRollupLimiter(math.MaxUint64))
+	require.NoError(t, err)
+	extRollup, _, err := seq.rollupCompression.CreateExtRollup(candidates.Prefix(int(toSeqNo - fromSeqNo + 1)))
+	require.NoError(t, err)
+	return extRollup.CompressedSize()
+}
+
+// testSnapshot - all the test batches are sealed
+func testSnapshot(seq *sequencer) RollupSnapshot {
+	head, _ := seq.blockProcessor.GetHead()
+	return RollupSnapshot{HeadBatchSeqNo: testBatches, L1Head: head}
+}
+
+func newTestSequencer(t *testing.T, maxRollupSize uint64) *sequencer {
+	logger := gethlog.New()
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	require.NoError(t, err)
+	storageDB := storage.NewStorage(backingDB, nil, logger)
+
+	// the batches are created on the first block, which must be RollupDelay blocks behind the head to be rolled up
+	var blocks []*types.Block
+	parent := gethcommon.Hash{}
+	for height := int64(1); height <= testL1Blocks; height++ {
+		block := types.NewBlock(&types.Header{Number: big.NewInt(height), ParentHash: parent}, nil, nil, nil, trie.NewStackTrie(nil))
+		require.NoError(t, storageDB.StoreBlock(block, nil))
+		blocks = append(blocks, block)
+		parent = block.Hash()
+	}
+	storeTestBatches(t, storageDB, blocks[0].Hash())
+
+	enclaveKey, err := crypto.GenerateEnclaveKey()
+	require.NoError(t, err)
+	registry := components.NewBatchRegistry(storageDB, logger)
+	return &sequencer{
+		blockProcessor:    &fixedHeadBlockProcessor{head: blocks[len(blocks)-1]},
+		batchRegistry:     registry,
+		rollupProducer:    components.NewRollupProducer(gethcommon.Address{}, storageDB, registry, nil, logger),
+		rollupCompression: components.NewRollupCompression(registry, nil, crypto.NewDataEncryptionService(logger), compression.NewBrotliDataCompressionService(), storageDB, nil, nil, nil, logger),
+		enclaveKey:        enclaveKey,
+		storage:           storageDB,
+		settings:          SequencerSettings{MaxRollupSize: maxRollupSize},
+		logger:            logger,
+	}
+}
+
+// storeTestBatches - stores the executed batches, each with a transaction of testTxSize random bytes
+func storeTestBatches(t *testing.T, storageDB storage.Storage, l1Proof common.L1BlockHash) {
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	signer := types.LatestSignerForChainID(big.NewInt(443))
+	parent := common.L2BatchHash{}
+	for seqNo := int64(1); seqNo <= testBatches; seqNo++ {
+		data := make([]byte, testTxSize)
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+		tx, err := types.SignNewTx(key, signer, &types.LegacyTx{Nonce: uint64(seqNo), Data: data})
+		require.NoError(t, err)
+		batch := &core.Batch{
+			Header: &common.BatchHeader{
+				ParentHash:       parent,
+				Number:           big.NewInt(seqNo),
+				SequencerOrderNo: big.NewInt(seqNo),
+				Time:             uint64(seqNo),
+				L1Proof:          l1Proof,
+			},
+			Transactions: []*common.L2Tx{tx},
+		}
+		require.NoError(t, storageDB.StoreBatch(batch, batch.Hash()))
+		require.NoError(t, storageDB.StoreExecutedBatch(batch, nil))
+		parent = batch.Hash()
+	}
+}
+
+func TestResubmittedTxIsRejectedOnceSealed(t *testing.T) {
+	seq, mempool := newProducingSequencer(t)
+	_, err := seq.CreateBatch(false)
+	require.NoError(t, err)
+
+	key, err := gethcrypto.HexToECDSA(genesis.TestnetPrefundedPK)
+	require.NoError(t, err)
+	signer := types.LatestSigner(seq.chainConfig)
+	to := gethcommon.HexToAddress("0x1")
+	tx, err := types.SignNewTx(key, signer, &types.LegacyTx{Nonce: 0, To: &to, Gas: 21_000, GasPrice: big.NewInt(1_000_000_000), Value: big.NewInt(1)})
+	require.NoError(t, err)
+
+	require.NoError(t, seq.SubmitTransaction(tx))
+	// the pool promotes the transaction asynchronously
+	require.Eventually(t, func() bool { return len(mempool.PendingTransactions()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	batch, err := seq.CreateBatch(false)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+
+	// the same raw transaction, e.g. forwarded by a validator, once the pool released it
+	require.Eventually(t, func() bool { return len(mempool.PendingTransactions()) == 0 }, 5*time.Second, 10*time.Millisecond)
+	resubmitted := new(common.L2Tx)
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, resubmitted.UnmarshalBinary(raw))
+	err = seq.SubmitTransaction(resubmitted)
+	require.ErrorIs(t, err, gethtxpool.ErrAlreadyKnown)
+
+	// no batch includes it twice
+	_, err = seq.CreateBatch(true)
+	require.NoError(t, err)
+	require.Equal(t, batch.SeqNo(), seq.batchRegistry.HeadBatchSeq())
+}
+
+func TestSubmittedTxFailuresAreTheGethErrors(t *testing.T) {
+	seq, mempool := newProducingSequencer(t)
+	_, err := seq.CreateBatch(false)
+	require.NoError(t, err)
+
+	key, err := gethcrypto.HexToECDSA(genesis.TestnetPrefundedPK)
+	require.NoError(t, err)
+	unfundedKey, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	signer := types.LatestSigner(seq.chainConfig)
+	to := gethcommon.HexToAddress("0x1")
+	newTx := func(key *ecdsa.PrivateKey, nonce uint64, gasPrice int64, data []byte) *common.L2Tx {
+		tx, err := types.SignNewTx(key, signer, &types.LegacyTx{Nonce: nonce, To: &to, Gas: 21_000, GasPrice: big.NewInt(gasPrice), Value: big.NewInt(1), Data: data})
+		require.NoError(t, err)
+		return tx
+	}
+
+	// the account has a transaction in a batch, and one in the mempool
+	require.NoError(t, seq.SubmitTransaction(newTx(key, 0, 1_000_000_000, nil)))
+	require.Eventually(t, func() bool { return len(mempool.PendingTransactions()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	_, err = seq.CreateBatch(false)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return len(mempool.PendingTransactions()) == 0 }, 5*time.Second, 10*time.Millisecond)
+	pending := newTx(key, 1, 1_000_000_000, nil)
+	require.NoError(t, seq.SubmitTransaction(pending))
+
+	tests := map[string]struct {
+		tx      *common.L2Tx
+		gethErr error
+		code    responses.ErrorCode
+	}{
+		"nonce too low":       {newTx(key, 0, 2_000_000_000, nil), gethcore.ErrNonceTooLow, responses.ErrCodeNonceTooLow},
+		"underpriced":         {newTx(key, 2, 0, nil), gethtxpool.ErrUnderpriced, responses.ErrCodeUnderpriced},
+		"replace underpriced": {newTx(key, 1, 1_000_000_001, nil), gethtxpool.ErrReplaceUnderpriced, responses.ErrCodeUnderpriced},
+		"insufficient funds":  {newTx(unfundedKey, 0, 1_000_000_000, nil), gethcore.ErrInsufficientFunds, responses.ErrCodeInsufficientFunds},
+		"oversized data":      {newTx(key, 2, 1_000_000_000, make([]byte, 128*1024)), gethtxpool.ErrOversizedData, responses.ErrCodeOversizedData},
+		"already known":       {pending, gethtxpool.ErrAlreadyKnown, responses.ErrCodeAlreadyKnown},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := seq.SubmitTransaction(test.tx)
+			require.ErrorIs(t, err, test.gethErr)
+			userErr := responses.ToUserError(err)
+			require.Equal(t, test.code, responses.ErrorCodeOf(userErr))
+			require.Contains(t, userErr.Error(), test.gethErr.Error())
+		})
+	}
+}
+
+func TestDepositBurstIsSpreadOverTheSyntheticBudgets(t *testing.T) {
+	seq, _ := newProducingSequencer(t)
+	// a burst of deposits in a single L1 block, which needs the synthetic budgets of three batches
+	perBatch := int(common.SyntheticTxGasBudget / common.SyntheticTxGasLimit)
+	deposits := storeDepositBurst(t, seq, 2*perBatch+perBatch/2)
+
+	// the deposits are released in the order of the L1 events, as many as fit in each batch
+	released := 0
+	for _, expected := range []int{perBatch, perBatch, perBatch / 2} {
+		batch, err := seq.CreateBatch(true)
+		require.NoError(t, err)
+		require.Equal(t, uint64(expected), batch.Header.SyntheticTxCount)
+		released += expected
+
+		stateDB, err := seq.storage.CreateStateDB(batch.Hash())
+		require.NoError(t, err)
+		for i, deposit := range deposits {
+			expectedBalance := big.NewInt(0)
+			if i < released {
+				expectedBalance = deposit.Amount
+			}
+			require.Equal(t, expectedBalance, stateDB.GetBalance(deposit.Receiver), "receiver of deposit %d", i)
+		}
+	}
+
+	// nothing is left to release
+	batch, err := seq.CreateBatch(true)
+	require.NoError(t, err)
+	require.Nil(t, batch)
+}
+
+func TestDepositBurstIsNotBudgetedBeforeTheActivation(t *testing.T) {
+	seq, _ := newSequencerBudgetingFrom(t, math.MaxUint64)
+	perBatch := int(common.SyntheticTxGasBudget / common.SyntheticTxGasLimit)
+	deposits := storeDepositBurst(t, seq, 2*perBatch)
+
+	// the existing chains released every deposit in the batch after it was received, which their replay reproduces
+	batch, err := seq.CreateBatch(true)
+	require.NoError(t, err)
+	require.Zero(t, batch.Header.SyntheticTxCount)
+	stateDB, err := seq.storage.CreateStateDB(batch.Hash())
+	require.NoError(t, err)
+	for i, deposit := range deposits {
+		require.Equal(t, deposit.Amount, stateDB.GetBalance(deposit.Receiver), "receiver of deposit %d", i)
+	}
+}
+
+// storeDepositBurst - produces the first batch, then stores the deposits in a new L1 block
+func storeDepositBurst(t *testing.T, seq *sequencer, count int) common.ValueTransferEvents {
+	_, err := seq.CreateBatch(false)
+	require.NoError(t, err)
+
+	head, err := seq.blockProcessor.GetHead()
+	require.NoError(t, err)
+	block := types.NewBlock(&types.Header{Number: big.NewInt(1), ParentHash: head.Hash()}, nil, nil, nil, trie.NewStackTrie(nil))
+	_, err = seq.blockProcessor.Process(context.Background(), &common.BlockAndReceipts{Block: block, Receipts: &types.Receipts{}})
+	require.NoError(t, err)
+	deposits := make(common.ValueTransferEvents, count)
+	for i := range deposits {
+		deposits[i] = common.ValueTransferEvent{
+			Sender:   gethcommon.HexToAddress("0xdead"),
+			Receiver: gethcommon.BigToAddress(big.NewInt(int64(0x1000 + i))),
+			Amount:   big.NewInt(int64(i + 1)),
+		}
+	}
+	require.NoError(t, seq.storage.StoreValueTransfers(block.Hash(), deposits))
+	return deposits
+}
+
+// newProducingSequencer - a sequencer with its mempool and an executor, on a single L1 block
+func newProducingSequencer(tb testing.TB) (*sequencer, *txpool.TxPool) {
+	return newSequencerBudgetingFrom(tb, 0)
+}
+
+// newSequencerBudgetingFrom - a producing sequencer which budgets the synthetic transactions from the batch seq no
+func newSequencerBudgetingFrom(tb testing.TB, inboundBudgetActivationSeqNo uint64) (*sequencer, *txpool.TxPool) {
+	chain := enclavetest.NewChain(tb, inboundBudgetActivationSeqNo)
+	seq := NewSequencer(chain.BlockProcessor, chain.BatchExecutor, chain.Registry, nil, nil, nil, chain.GethEncoding, chain.Logger, gethcommon.Address{}, chain.ChainConfig, chain.EnclaveKey, chain.Mempool, chain.Storage,
+		crypto.NewDataEncryptionService(chain.Logger), compression.NewBrotliDataCompressionService(),
+		SequencerSettings{MaxBatchSize: 1024 * 1024, BatchGasLimit: testBatchGasLimit}, chain.GasOracle, chain.Blockchain)
+	return seq.(*sequencer), chain.Mempool
+}
@@ -1,16 +1,23 @@
 package nodetype
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
+	"runtime"
+	"sync"
+	"time"
 
+	"github.com/ten-protocol/go-ten/go/enclave/gas"
 	"github.com/ten-protocol/go-ten/go/enclave/txpool"
 
 	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -21,6 +28,12 @@ import (
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 )
 
+// catchUpPageSize - the number of unexecuted batches read from the storage at once, ahead of their execution
+const catchUpPageSize = 100
+
+// errBatchNotExecuted - stops the execution of the stored batches at the first one which is not executed
+var errBatchNotExecuted = errors.New("batch not executed")
+
 type obsValidator struct {
 	blockProcessor components.L1BlockProcessor
 	batchExecutor  components.BatchExecutor
@@ -33,11 +46,13 @@ type obsValidator struct {
 	storage      storage.Storage
 	sigValidator *components.SignatureValidator
 	mempool      *txpool.TxPool
+	gasOracle    gas.Oracle
+	timeRules    components.BatchTimeRules
 
 	logger gethlog.Logger
 }
 
-func NewValidator(consumer components.L1BlockProcessor, batchExecutor components.BatchExecutor, registry components.BatchRegistry, rollupConsumer components.RollupConsumer, chainConfig *params.ChainConfig, sequencerID gethcommon.Address, storage storage.Storage, sigValidator *components.SignatureValidator, mempool *txpool.TxPool, logger gethlog.Logger) ObsValidator {
+func NewValidator(consumer components.L1BlockProcessor, batchExecutor components.BatchExecutor, registry components.BatchRegistry, rollupConsumer components.RollupConsumer, chainConfig *params.ChainConfig, sequencerID gethcommon.Address, storage storage.Storage, sigValidator *components.SignatureValidator, mempool *txpool.TxPool, gasOracle gas.Oracle, timeRules components.BatchTimeRules, logger gethlog.Logger) ObsValidator {
 	startMempool(registry, mempool)
 
 	return &obsValidator{
@@ -50,6 +65,8 @@ func NewValidator(consumer components.L1BlockProcessor, batchExecutor components
 		storage:        storage,
 		sigValidator:   sigValidator,
 		mempool:        mempool,
+		gasOracle:      gasOracle,
+		timeRules:      timeRules,
 		logger:         logger,
 	}
 }
@@ -59,6 +76,8 @@ func (val *obsValidator) SubmitTransaction(tx *common.L2Tx) error {
 	if headBatch == nil || headBatch.Uint64() <= common.L2GenesisSeqNo+1 {
 		return fmt.Errorf("not initialised")
 	}
+	// the transactions failing the state checks (nonce, balance) are returned to the user instead of being forwarded,
+	// as the sequencer drops them without a response
 	return val.mempool.Validate(tx)
 }
 
@@ -67,73 +86,293 @@ func (val *obsValidator) OnL1Fork(_ *common.ChainFork) error {
 	return nil
 }
 
-func (val *obsValidator) VerifySequencerSignature(b *core.Batch) error {
-	return val.sigValidator.CheckSequencerSignature(b.Hash(), b.Header.R, b.Header.S)
+func (val *obsValidator) VerifySequencerSignature(b *common.ExtBatch) error {
+	return val.sigValidator.CheckBatchSignature(b.Header)
 }
 
-func (val *obsValidator) ExecuteStoredBatches() error {
+func (val *obsValidator) ExecuteStoredBatches(ctx context.Context) (*common.BatchExecutionSummary, error) {
 	headBatchSeq := val.batchRegistry.HeadBatchSeq()
 	if headBatchSeq == nil {
 		headBatchSeq = big.NewInt(int64(common.L2GenesisSeqNo))
 	}
-	batches, err := val.storage.FetchCanonicalUnexecutedBatches(headBatchSeq)
+
+	startMempool(val.batchRegistry, val.mempool)
+
+	return val.executeStoredBatchesFrom(ctx, headBatchSeq)
+}
+
+// executeStoredBatchesFrom - executes the stored batches in order from the seq number, until one cannot be executed
+func (val *obsValidator) executeStoredBatchesFrom(ctx context.Context, from *big.Int) (*common.BatchExecutionSummary, error) {
+	summary := &common.BatchExecutionSummary{StopReason: common.BatchExecutionUpToDate}
+	var execErr error
+	err := val.executeInOrder(from, func(batch *core.Batch) error {
+		summary.StopReason, execErr = val.executeBatch(ctx, batch)
+		if execErr != nil || summary.StopReason != "" {
+			summary.StoppedAtSeqNo = batch.SeqNo().Uint64()
+			// the following batches descend from this one, so they cannot be executed either
+			return errBatchNotExecuted
+		}
+		summary.Executed++
+		summary.HeadSeqNo = batch.SeqNo().Uint64()
+		summary.HeadHash = batch.Hash()
+		return nil
+	})
+	switch {
+	case errors.Is(err, errBatchNotExecuted):
+		// the batch waits for its prerequisites, unless its execution failed
+		err = execErr
+	case err != nil:
+		// the stored batches could not be read
+		summary.StopReason = common.BatchExecutionFailed
+	default:
+		summary.StopReason = common.BatchExecutionUpToDate
+	}
+	if summary.Executed == 0 {
+		val.setCurrentHead(summary)
+	}
+	if summary.Executed > 1 {
+		val.logger.Info(fmt.Sprintf("Executed %d stored batches", summary.Executed), log.BatchSeqNoKey, summary.HeadSeqNo,
+			"stopReason", summary.StopReason, "stoppedAt", summary.StoppedAtSeqNo)
+	}
+	return summary, err
+}
+
+// setCurrentHead - sets the head of the summary to the head batch, when no batch was executed
+func (val *obsValidator) setCurrentHead(summary *common.BatchExecutionSummary) {
+	headSeq := val.batchRegistry.HeadBatchSeq()
+	if headSeq == nil {
+		return
+	}
+	summary.HeadSeqNo = headSeq.Uint64()
+	head, err := val.storage.FetchBatchBySeqNo(summary.HeadSeqNo)
 	if err != nil {
-		if errors.Is(err, errutil.ErrNotFound) {
-			return nil
+		val.logger.Debug("Could not fetch the head batch", log.BatchSeqNoKey, summary.HeadSeqNo, log.ErrKey, err)
+		return
+	}
+	summary.HeadHash = head.Hash()
+}
+
+// executeBatch - executes the stored batch, if its prerequisites are met, and stores the result. It returns why the
+// batch was not executed, which is empty when it was.
+func (val *obsValidator) executeBatch(ctx context.Context, batch *core.Batch) (common.BatchExecutionStop, error) {
+	// the genesis batch has no parent, it is executed by creating the genesis state
+	if batch.IsGenesis() {
+		if err := val.handleGenesis(batch); err != nil {
+			return common.BatchExecutionFailed, err
 		}
-		return err
+		return "", nil
 	}
 
-	startMempool(val.batchRegistry, val.mempool)
+	// check batch execution prerequisites
+	stop, err := val.executionPrerequisites(batch)
+	if err != nil {
+		return stop, fmt.Errorf("could not determine the execution prerequisites for batch %s. Cause: %w", batch.Hash(), err)
+	}
+	if stop != "" {
+		return stop, nil
+	}
 
-	for _, batch := range batches {
-		if batch.IsGenesis() {
-			if err = val.handleGenesis(batch); err != nil {
-				return err
+	// the validator does not follow the sequencer past a divergent batch, nor executes it again
+	if _, err = val.storage.FetchStateDivergence(batch.Hash()); err == nil {
+		return common.BatchExecutionFailed, fmt.Errorf("%w: batch %s diverged from the state signed by the sequencer", errutil.ErrStateDivergence, batch.Hash())
+	} else if !errors.Is(err, errutil.ErrNotFound) {
+		return common.BatchExecutionFailed, fmt.Errorf("could not check the state divergence of batch %s. Cause: %w", batch.Hash(), err)
+	}
+
+	if err = val.checkBatchTime(batch); err != nil {
+		return common.BatchExecutionFailed, err
+	}
+
+	receipts, err := val.batchExecutor.ExecuteBatch(ctx, batch)
+	if err != nil {
+		var divergence *components.StateDivergenceError
+		if errors.As(err, &divergence) {
+			val.logger.Error("State divergence. The validator stops executing the batches", log.BatchHashKey, batch.Hash(),
+				log.BatchSeqNoKey, batch.SeqNo(), "expectedRoot", divergence.Report.ExpectedStateRoot, "computedRoot", divergence.Report.ComputedStateRoot)
+			// a divergence after a snapshot may come from the snapshot rather than from the execution of the validator
+			if snapshot, err := val.storage.FetchImportedStateSnapshot(); err == nil {
+				divergence.Report.Snapshot = snapshot
+			}
+			if storeErr := val.storage.StoreStateDivergence(divergence.Report); storeErr != nil {
+				return common.BatchExecutionFailed, fmt.Errorf("could not store the state divergence of batch %s. Cause: %w", batch.Hash(), storeErr)
 			}
+			return common.BatchExecutionFailed, fmt.Errorf("%w: %s", errutil.ErrStateDivergence, err)
 		}
+		return common.BatchExecutionFailed, fmt.Errorf("could not execute batch %s. Cause: %w", batch.Hash(), err)
+	}
+	_, span := tracing.StartSpan(ctx, "storage.StoreExecutedBatch", tracing.BatchHashKey.String(batch.Hash().Hex()))
+	err = val.storage.StoreExecutedBatch(batch, receipts)
+	tracing.EndSpan(span, err)
+	if err != nil {
+		return common.BatchExecutionFailed, fmt.Errorf("could not store executed batch %s. Cause: %w", batch.Hash(), err)
+	}
+	err = val.mempool.Chain.IngestNewBlock(batch)
+	if err != nil {
+		return common.BatchExecutionFailed, fmt.Errorf("failed to feed batch into the virtual eth chain- %w", err)
+	}
+	val.batchRegistry.OnBatchExecuted(batch, receipts)
+	return "", nil
+}
 
-		// check batch execution prerequisites
-		canExecute, err := val.executionPrerequisites(batch)
-		if err != nil {
-			return fmt.Errorf("could not determine the execution prerequisites for batch %s. Cause: %w", batch.Hash(), err)
+// executeInOrder - calls execute for each canonical unexecuted batch, in order, starting at the seq number.
+// While a page of batches is executed, the next page is read from the storage, and the senders of its transactions are
+// recovered by a pool of workers, so catching up on a long history is not limited to a single core.
+func (val *obsValidator) executeInOrder(from *big.Int, execute func(*core.Batch) error) error {
+	done := make(chan struct{})
+	batches := val.prefetchBatches(from, done)
+	defer func() {
+		close(done)
+		// the prefetching stops once done is closed, and is waited for so it never outlives the execution
+		for range batches {
 		}
+	}()
 
-		if canExecute {
-			receipts, err := val.batchExecutor.ExecuteBatch(batch)
+	for prefetched := range batches {
+		if prefetched.err != nil {
+			return prefetched.err
+		}
+		if err := execute(prefetched.batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prefetchedBatch - a stored batch, or the error which stopped the batches from being read
+type prefetchedBatch struct {
+	batch *core.Batch
+	err   error
+}
+
+// prefetchBatches - sends the canonical unexecuted batches in order, reading a page ahead of the execution. The channel
+// is closed after the last batch, after an error, or when done is closed.
+func (val *obsValidator) prefetchBatches(from *big.Int, done <-chan struct{}) <-chan prefetchedBatch {
+	out := make(chan prefetchedBatch, catchUpPageSize)
+	go func() {
+		defer close(out)
+		for {
+			batches, err := val.storage.FetchCanonicalUnexecutedBatches(from, catchUpPageSize)
 			if err != nil {
-				return fmt.Errorf("could not execute batch %s. Cause: %w", batch.Hash(), err)
+				if !errors.Is(err, errutil.ErrNotFound) {
+					select {
+					case out <- prefetchedBatch{err: fmt.Errorf("could not fetch the unexecuted batches from %d. Cause: %w", from, err)}:
+					case <-done:
+					}
+				}
+				return
 			}
-			err = val.storage.StoreExecutedBatch(batch, receipts)
-			if err != nil {
-				return fmt.Errorf("could not store executed batch %s. Cause: %w", batch.Hash(), err)
+			if len(batches) == 0 {
+				return
 			}
-			err = val.mempool.Chain.IngestNewBlock(batch)
-			if err != nil {
-				return fmt.Errorf("failed to feed batch into the virtual eth chain- %w", err)
+			recoverSenders(val.chainConfig, batches)
+			for _, batch := range batches {
+				select {
+				case out <- prefetchedBatch{batch: batch}:
+				case <-done:
+					return
+				}
+			}
+			if len(batches) < catchUpPageSize {
+				return
 			}
-			val.batchRegistry.OnBatchExecuted(batch, receipts)
+			from = big.NewInt(0).Add(batches[len(batches)-1].SeqNo(), big.NewInt(1))
 		}
+	}()
+	return out
+}
+
+// recoverSenders - recovers the senders and the hashes of the transactions on a worker per CPU. They are cached in the
+// transactions, so the execution doesn't recover them again.
+func recoverSenders(chainConfig *params.ChainConfig, batches []*core.Batch) {
+	// the same signer as the execution, because the cached sender is only used for an equal signer
+	signer := types.LatestSigner(chainConfig)
+	txs := make(chan *common.L2Tx)
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for tx := range txs {
+				// an invalid signature is reported when the batch is executed
+				_, _ = types.Sender(signer, tx)
+				tx.Hash()
+			}
+		}()
 	}
-	return nil
+	for _, batch := range batches {
+		for _, tx := range batch.Transactions {
+			txs <- tx
+		}
+	}
+	close(txs)
+	wg.Wait()
 }
 
-func (val *obsValidator) executionPrerequisites(batch *core.Batch) (bool, error) {
-	// 1.l1 block exists
+// executionPrerequisites - the prerequisite of the execution the batch is missing, empty when it can be executed
+func (val *obsValidator) executionPrerequisites(batch *core.Batch) (common.BatchExecutionStop, error) {
+	// 1.l1 block exists. A missing block is expected while the enclave catches up with the L1, so it is not an error
 	block, err := val.storage.FetchBlock(batch.Header.L1Proof)
-	if err != nil && errors.Is(err, errutil.ErrNotFound) {
-		val.logger.Info("Error fetching block", log.BlockHashKey, batch.Header.L1Proof, log.ErrKey, err)
-		return false, err
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			val.logger.Debug("Batch waiting for its L1 block", log.BatchHashKey, batch.Hash(), log.BlockHashKey, batch.Header.L1Proof)
+			return common.BatchExecutionMissingL1Block, nil
+		}
+		return common.BatchExecutionFailed, fmt.Errorf("could not fetch block %s. Cause: %w", batch.Header.L1Proof, err)
 	}
 
 	// 2. parent was executed
 	parentExecuted, err := val.storage.BatchWasExecuted(batch.Header.ParentHash)
 	if err != nil {
 		val.logger.Info("Error reading execution status of batch", log.BatchHashKey, batch.Header.ParentHash, log.ErrKey, err)
-		return false, err
+		return common.BatchExecutionFailed, err
+	}
+
+	switch {
+	case block == nil:
+		return common.BatchExecutionMissingL1Block, nil
+	case !parentExecuted:
+		return common.BatchExecutionMissingParent, nil
+	}
+	return "", nil
+}
+
+// checkBatchTime - the timestamp signed by the sequencer must follow the time rules of the network. A batch which breaks
+// them is recorded with its header, and the validator does not follow the sequencer past it.
+func (val *obsValidator) checkBatchTime(batch *core.Batch) error {
+	if violation, err := val.storage.FetchBatchTimeViolation(batch.Hash()); err == nil {
+		return fmt.Errorf("%w: batch %s was rejected. Cause: %s", errutil.ErrInvalidBatchTime, batch.Hash(), violation.Reason)
+	} else if !errors.Is(err, errutil.ErrNotFound) {
+		return fmt.Errorf("could not check the time violation of batch %s. Cause: %w", batch.Hash(), err)
+	}
+
+	parent, err := val.storage.FetchBatchHeader(batch.Header.ParentHash)
+	if err != nil {
+		return fmt.Errorf("could not fetch the parent of batch %s. Cause: %w", batch.Hash(), err)
+	}
+	block, err := val.storage.FetchBlock(batch.Header.L1Proof)
+	if err != nil {
+		return fmt.Errorf("could not fetch the L1 block of batch %s. Cause: %w", batch.Hash(), err)
+	}
+	timeErr := val.timeRules.Check(batch.SeqNo().Uint64(), batch.Header.Time, parent.Time, block.Time())
+	if timeErr == nil {
+		return nil
 	}
 
-	return block != nil && parentExecuted, nil
+	val.logger.Error("Invalid batch timestamp. The validator stops executing the batches", log.BatchHashKey, batch.Hash(),
+		log.BatchSeqNoKey, batch.SeqNo(), log.ErrKey, timeErr)
+	violation := &common.BatchTimeViolation{
+		BatchHash:  batch.Hash(),
+		SeqNo:      batch.SeqNo().Uint64(),
+		Header:     batch.Header,
+		ParentTime: parent.Time,
+		L1Time:     block.Time(),
+		Reason:     timeErr.Error(),
+		DetectedAt: time.Now(),
+	}
+	if err := val.storage.StoreBatchTimeViolation(violation); err != nil {
+		return fmt.Errorf("could not store the time violation of batch %s. Cause: %w", batch.Hash(), err)
+	}
+	return fmt.Errorf("%w: %s", errutil.ErrInvalidBatchTime, timeErr)
 }
 
 func (val *obsValidator) handleGenesis(batch *core.Batch) error {
@@ -142,8 +381,12 @@ func (val *obsValidator) handleGenesis(batch *core.Batch) error {
 		return err
 	}
 
+	if !bytes.Equal(genBatch.Header.Extra, batch.Header.Extra) {
+		return fmt.Errorf("%w - the sequencer genesis allocations hash is %s, the local one is %s. The enclaves must be configured with the same genesis",
+			errutil.ErrGenesisMismatch, gethcommon.BytesToHash(batch.Header.Extra), gethcommon.BytesToHash(genBatch.Header.Extra))
+	}
 	if genBatch.Hash() != batch.Hash() {
-		return fmt.Errorf("received invalid genesis batch")
+		return fmt.Errorf("%w - the sequencer genesis batch is %s, the local one is %s", errutil.ErrGenesisMismatch, batch.Hash(), genBatch.Hash())
 	}
 
 	err = val.storage.StoreExecutedBatch(genBatch, nil)
@@ -154,8 +397,11 @@ func (val *obsValidator) handleGenesis(batch *core.Batch) error {
 	return nil
 }
 
-func (val *obsValidator) OnL1Block(_ types.Block, _ *components.BlockIngestionType) error {
-	return val.ExecuteStoredBatches()
+func (val *obsValidator) OnL1Block(ctx context.Context, _ types.Block, _ *components.BlockIngestionType) error {
+	// the min gas price follows the L1 head, including when a fork drops an update
+	val.mempool.SetMinGasPrice(val.gasOracle.Parameters().MinGasPrice)
+	_, err := val.ExecuteStoredBatches(ctx)
+	return err
 }
 
 func (val *obsValidator) Close() error {
@@ -0,0 +1,633 @@
+package nodetype
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/enclavetest"
+	"github.com/ten-protocol/go-ten/go/enclave/genesis"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
+)
+
+func TestStoredBatchesAreExecutedInOrder(t *testing.T) {
+	batches := 2*catchUpPageSize + catchUpPageSize/2
+	val, stored := newCatchUpValidator(t, batches, 2)
+
+	var executed []uint64
+	err := val.executeInOrder(big.NewInt(int64(common.L2GenesisSeqNo)), func(batch *core.Batch) error {
+		executed = append(executed, batch.SeqNo().Uint64())
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, executed, batches)
+	for i, seqNo := range executed {
+		require.Equal(t, uint64(i+1), seqNo)
+	}
+	// the last page is not full, so there is no need to read another one
+	require.EqualValues(t, 3, stored.fetches.Load())
+}
+
+func TestStoredBatchesStopAtTheFirstFailure(t *testing.T) {
+	val, _ := newCatchUpValidator(t, 2*catchUpPageSize, 1)
+
+	failure := errors.New("execution failed")
+	var executed uint64
+	err := val.executeInOrder(big.NewInt(int64(common.L2GenesisSeqNo)), func(batch *core.Batch) error {
+		if batch.SeqNo().Uint64() == catchUpPageSize/2 {
+			return failure
+		}
+		executed++
+		return nil
+	})
+	require.ErrorIs(t, err, failure)
+	require.Equal(t, uint64(catchUpPageSize/2-1), executed)
+}
+
+// catchUpBenchmarkBatches - the length of the history replayed by BenchmarkCatchUp
+const catchUpBenchmarkBatches = 10_000
+
+// BenchmarkCatchUp - replays a history of batches with transfers produced by a sequencer, on a fresh validator for each
+// replay. The batches are either read a page at a time and executed one after the other, or pipelined.
+func BenchmarkCatchUp(b *testing.B) {
+	batches := produceTransferBatches(b, catchUpBenchmarkBatches, 2)
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			val := newReplayingValidator(b, batches)
+			b.StartTimer()
+
+			from := big.NewInt(int64(common.L2GenesisSeqNo))
+			executed := 0
+			for {
+				page, err := val.storage.FetchCanonicalUnexecutedBatches(from, catchUpPageSize)
+				if errors.Is(err, errutil.ErrNotFound) || len(page) == 0 {
+					break
+				}
+				require.NoError(b, err)
+				for _, batch := range page {
+					stop, err := val.executeBatch(context.Background(), batch)
+					require.NoError(b, err)
+					require.Empty(b, stop)
+					executed++
+				}
+				from = big.NewInt(0).Add(page[len(page)-1].SeqNo(), big.NewInt(1))
+			}
+			require.Equal(b, len(batches), executed)
+		}
+	})
+	b.Run("pipelined", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			val := newReplayingValidator(b, batches)
+			b.StartTimer()
+
+			summary, err := val.executeStoredBatchesFrom(context.Background(), big.NewInt(int64(common.L2GenesisSeqNo)))
+			require.NoError(b, err)
+			require.Equal(b, uint64(len(batches)), summary.Executed)
+		}
+	})
+}
+
+// produceTransferBatches - the genesis batch, followed by batches with the given number of transfers each
+func produceTransferBatches(tb testing.TB, batches int, txsPerBatch int) []*core.Batch {
+	seq, mempool := newProducingSequencer(tb)
+	key, err := gethcrypto.HexToECDSA(genesis.TestnetPrefundedPK)
+	require.NoError(tb, err)
+	to := gethcommon.HexToAddress("0x1")
+
+	// the genesis batch is created with the batch deploying the message bus, which starts the mempool
+	deployment, err := seq.CreateBatch(false)
+	require.NoError(tb, err)
+	genesisBatch, err := seq.storage.FetchBatchBySeqNo(common.L2GenesisSeqNo)
+	require.NoError(tb, err)
+	produced := []*core.Batch{genesisBatch, deployment}
+	nonce := uint64(0)
+	for len(produced) < batches {
+		for i := 0; i < txsPerBatch; i++ {
+			tx, err := types.SignNewTx(key, types.LatestSigner(seq.chainConfig), &types.LegacyTx{Nonce: nonce, To: &to, Gas: 21_000, GasPrice: big.NewInt(1_000_000_000), Value: big.NewInt(1)})
+			require.NoError(tb, err)
+			require.NoError(tb, seq.SubmitTransaction(tx))
+			nonce++
+		}
+		// the transactions are promoted to pending in the background
+		require.Eventually(tb, func() bool {
+			pending := mempool.PendingTransactions()
+			return len(pending) == 1 && len(pending[gethcrypto.PubkeyToAddress(key.PublicKey)]) == txsPerBatch
+		}, 5*time.Second, time.Millisecond)
+		batch, err := seq.CreateBatch(false)
+		require.NoError(tb, err)
+		require.Len(tb, batch.Transactions, txsPerBatch)
+		produced = append(produced, batch)
+	}
+	return produced
+}
+
+// newReplayingValidator - a validator on a new database, which has stored the batches without executing them
+func newReplayingValidator(tb testing.TB, batches []*core.Batch) *obsValidator {
+	chain := enclavetest.NewChain(tb, 0)
+	for _, batch := range batches {
+		convertedHeader, err := chain.GethEncoding.CreateEthHeaderForBatch(batch.Header)
+		require.NoError(tb, err)
+		require.NoError(tb, chain.Storage.StoreBatch(batch, convertedHeader.Hash()))
+	}
+	return NewValidator(chain.BlockProcessor, chain.BatchExecutor, chain.Registry, nil, chain.ChainConfig, gethcommon.Address{},
+		chain.Storage, nil, chain.Mempool, chain.GasOracle, components.BatchTimeRules{}, chain.Logger).(*obsValidator)
+}
+
+// encodedBatchStorage - serves the unexecuted batches from their encoded transactions, so, like the database, every
+// read decodes new transactions, without the senders cached
+type encodedBatchStorage struct {
+	storage.Storage
+	headers      []*common.BatchHeader
+	transactions [][]byte
+	fetches      atomic.Int64 // read while the batches are prefetched
+}
+
+func (s *encodedBatchStorage) FetchCanonicalUnexecutedBatches(from *big.Int, limit uint64) ([]*core.Batch, error) {
+	s.fetches.Add(1)
+	var batches []*core.Batch
+	for seqNo := from.Uint64(); seqNo <= uint64(len(s.headers)) && uint64(len(batches)) < limit; seqNo++ {
+		var txs []*common.L2Tx
+		if err := rlp.DecodeBytes(s.transactions[seqNo-1], &txs); err != nil {
+			return nil, err
+		}
+		batches = append(batches, &core.Batch{Header: s.headers[seqNo-1], Transactions: txs})
+	}
+	if len(batches) == 0 {
+		return nil, errutil.ErrNotFound
+	}
+	return batches, nil
+}
+
+func newCatchUpValidator(tb testing.TB, batches int, txsPerBatch int) (*obsValidator, *encodedBatchStorage) {
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(tb, err)
+	chainConfig := params.AllEthashProtocolChanges
+	signer := types.LatestSigner(chainConfig)
+
+	stored := &encodedBatchStorage{}
+	nonce := uint64(0)
+	for seqNo := 1; seqNo <= batches; seqNo++ {
+		txs := make([]*common.L2Tx, txsPerBatch)
+		for i := range txs {
+			txs[i], err = types.SignNewTx(key, signer, &types.LegacyTx{Nonce: nonce})
+			require.NoError(tb, err)
+			nonce++
+		}
+		encoded, err := rlp.EncodeToBytes(txs)
+		require.NoError(tb, err)
+		stored.headers = append(stored.headers, &common.BatchHeader{Number: big.NewInt(int64(seqNo)), SequencerOrderNo: big.NewInt(int64(seqNo))})
+		stored.transactions = append(stored.transactions, encoded)
+	}
+	return &obsValidator{storage: stored, chainConfig: chainConfig}, stored
+}
+
+// divergenceStorage - the prerequisites of every batch are met, and the divergence reports and the time violations are
+// kept in memory. The parents and the L1 blocks of the batches have the given timestamps, and the validator started
+// from the snapshot, if any.
+type divergenceStorage struct {
+	storage.Storage
+	reports    map[common.L2BatchHash]*common.StateDivergenceReport
+	violations map[common.L2BatchHash]*common.BatchTimeViolation
+	parentTime uint64
+	l1Time     uint64
+	snapshot   *common.StateSnapshotInfo
+}
+
+func newDivergenceStorage() *divergenceStorage {
+	return &divergenceStorage{
+		reports:    map[common.L2BatchHash]*common.StateDivergenceReport{},
+		violations: map[common.L2BatchHash]*common.BatchTimeViolation{},
+	}
+}
+
+func (s *divergenceStorage) FetchBlock(common.L1BlockHash) (*types.Block, error) {
+	return types.NewBlockWithHeader(&types.Header{Time: s.l1Time}), nil
+}
+
+func (s *divergenceStorage) FetchBatchHeader(common.L2BatchHash) (*common.BatchHeader, error) {
+	return &common.BatchHeader{Time: s.parentTime}, nil
+}
+
+func (s *divergenceStorage) StoreBatchTimeViolation(violation *common.BatchTimeViolation) error {
+	s.violations[violation.BatchHash] = violation
+	return nil
+}
+
+func (s *divergenceStorage) FetchBatchTimeViolation(batchHash common.L2BatchHash) (*common.BatchTimeViolation, error) {
+	violation, found := s.violations[batchHash]
+	if !found {
+		return nil, errutil.ErrNotFound
+	}
+	return violation, nil
+}
+
+func (s *divergenceStorage) FetchImportedStateSnapshot() (*common.StateSnapshotInfo, error) {
+	if s.snapshot == nil {
+		return nil, errutil.ErrNotFound
+	}
+	return s.snapshot, nil
+}
+
+func (s *divergenceStorage) BatchWasExecuted(common.L2BatchHash) (bool, error) {
+	return true, nil
+}
+
+func (s *divergenceStorage) StoreStateDivergence(report *common.StateDivergenceReport) error {
+	s.reports[report.BatchHash] = report
+	return nil
+}
+
+func (s *divergenceStorage) FetchStateDivergence(batchHash common.L2BatchHash) (*common.StateDivergenceReport, error) {
+	report, found := s.reports[batchHash]
+	if !found {
+		return nil, errutil.ErrNotFound
+	}
+	return report, nil
+}
+
+// divergentExecutor - computes a different state root than the one of every batch
+type divergentExecutor struct {
+	components.BatchExecutor
+	executions int
+}
+
+func (e *divergentExecutor) ExecuteBatch(_ context.Context, batch *core.Batch) (types.Receipts, error) {
+	e.executions++
+	return nil, &components.StateDivergenceError{Report: &common.StateDivergenceReport{
+		BatchHash:         batch.Hash(),
+		SeqNo:             batch.SeqNo().Uint64(),
+		ExpectedStateRoot: batch.Header.Root,
+		ComputedStateRoot: gethcommon.HexToHash("0x1"),
+	}}
+}
+
+// genesisExecutor - recreates the given genesis batch
+type genesisExecutor struct {
+	components.BatchExecutor
+	genesis *core.Batch
+}
+
+func (e *genesisExecutor) CreateGenesisState(common.L1BlockHash, uint64, gethcommon.Address, *big.Int) (*core.Batch, *types.Transaction, error) {
+	return e.genesis, nil, nil
+}
+
+func TestGenesisOfTheSequencerMustMatchTheLocalOne(t *testing.T) {
+	genesisBatch := func(extra byte, root gethcommon.Hash) *core.Batch {
+		return &core.Batch{Header: &common.BatchHeader{Number: big.NewInt(0), SequencerOrderNo: big.NewInt(int64(common.L2GenesisSeqNo)), Extra: []byte{extra}, Root: root}}
+	}
+	local := genesisBatch(1, gethcommon.HexToHash("0x1"))
+	// the storage is not set, so the mismatching genesis cannot be stored
+	val := &obsValidator{batchExecutor: &genesisExecutor{genesis: local}, logger: gethlog.New()}
+
+	for name, sequencerGenesis := range map[string]*core.Batch{
+		"other allocations": genesisBatch(2, gethcommon.HexToHash("0x1")),
+		"other state":       genesisBatch(1, gethcommon.HexToHash("0x2")),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, val.handleGenesis(sequencerGenesis), errutil.ErrGenesisMismatch)
+		})
+	}
+}
+
+func TestValidatorHaltsOnStateDivergence(t *testing.T) {
+	stored := newDivergenceStorage()
+	executor := &divergentExecutor{}
+	val := &obsValidator{storage: stored, batchExecutor: executor, logger: gethlog.New()}
+	batch := &core.Batch{Header: &common.BatchHeader{Number: big.NewInt(2), SequencerOrderNo: big.NewInt(2), Time: 1}}
+
+	stop, err := val.executeBatch(context.Background(), batch)
+	require.ErrorIs(t, err, errutil.ErrStateDivergence)
+	require.Equal(t, common.BatchExecutionFailed, stop)
+	require.Contains(t, stored.reports, batch.Hash())
+	require.Equal(t, gethcommon.HexToHash("0x1"), stored.reports[batch.Hash()].ComputedStateRoot)
+	require.Nil(t, stored.reports[batch.Hash()].Snapshot)
+
+	// the divergent batch is not executed again
+	_, err = val.executeBatch(context.Background(), batch)
+	require.ErrorIs(t, err, errutil.ErrStateDivergence)
+	require.Equal(t, 1, executor.executions)
+}
+
+func TestDivergenceIsAttributedToTheImportedSnapshot(t *testing.T) {
+	stored := newDivergenceStorage()
+	stored.snapshot = &common.StateSnapshotInfo{RollupHash: gethcommon.HexToHash("0x2"), SeqNo: 1}
+	val := &obsValidator{storage: stored, batchExecutor: &divergentExecutor{}, logger: gethlog.New()}
+	batch := &core.Batch{Header: &common.BatchHeader{Number: big.NewInt(2), SequencerOrderNo: big.NewInt(2), Time: 1}}
+
+	_, err := val.executeBatch(context.Background(), batch)
+	require.ErrorIs(t, err, errutil.ErrStateDivergence)
+	require.Equal(t, stored.snapshot, stored.reports[batch.Hash()].Snapshot)
+}
+
+func TestValidatorRejectsBatchTimeViolations(t *testing.T) {
+	rules := components.BatchTimeRules{L1Drift: time.Minute}
+	for name, tc := range map[string]struct {
+		batchTime uint64
+		violation bool
+	}{
+		"after the parent, within the drift": {batchTime: 1_001, violation: false},
+		"at the drift bound":                 {batchTime: 1_060, violation: false},
+		"same time as the parent":            {batchTime: 1_000, violation: false},
+		"before the parent":                  {batchTime: 999, violation: true},
+		"too far after the L1 block":         {batchTime: 1_061, violation: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			stored := newDivergenceStorage()
+			stored.parentTime = 1_000
+			stored.l1Time = 1_000
+			executor := &divergentExecutor{}
+			val := &obsValidator{storage: stored, batchExecutor: executor, timeRules: rules, logger: gethlog.New()}
+			batch := &core.Batch{Header: &common.BatchHeader{Number: big.NewInt(2), SequencerOrderNo: big.NewInt(2), Time: tc.batchTime}}
+
+			_, err := val.executeBatch(context.Background(), batch)
+			if !tc.violation {
+				// the batch reaches the execution
+				require.ErrorIs(t, err, errutil.ErrStateDivergence)
+				require.Empty(t, stored.violations)
+				return
+			}
+			require.ErrorIs(t, err, errutil.ErrInvalidBatchTime)
+			require.Zero(t, executor.executions)
+			violation := stored.violations[batch.Hash()]
+			require.NotNil(t, violation)
+			require.Equal(t, batch.Header, violation.Header)
+			require.Equal(t, uint64(1_000), violation.ParentTime)
+			require.Equal(t, uint64(1_000), violation.L1Time)
+
+			// the rejected batch is not checked again
+			stored.parentTime = 0
+			_, err = val.executeBatch(context.Background(), batch)
+			require.ErrorIs(t, err, errutil.ErrInvalidBatchTime)
+			require.Zero(t, executor.executions)
+		})
+	}
+}
+
+func TestBatchTimeIsClampedToTheRules(t *testing.T) {
+	rules := components.BatchTimeRules{L1Drift: time.Minute}
+
+	// a clock behind the parent, or too far from the L1 block, is moved to the closest valid time
+	for _, tc := range []struct{ now, parentTime, l1Time, expected uint64 }{
+		{now: 1_010, parentTime: 1_000, l1Time: 1_000, expected: 1_010},
+		{now: 990, parentTime: 1_000, l1Time: 1_000, expected: 1_000},
+		{now: 2_000, parentTime: 1_000, l1Time: 1_000, expected: 1_060},
+		{now: 100, parentTime: 0, l1Time: 1_000, expected: 940},
+	} {
+		batchTime, err := rules.Clamp(2, tc.now, tc.parentTime, tc.l1Time)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, batchTime)
+		require.NoError(t, rules.Check(2, batchTime, tc.parentTime, tc.l1Time))
+	}
+
+	// the parent is already after the latest time the L1 block allows
+	_, err := rules.Clamp(2, 1_100, 1_061, 1_000)
+	require.Error(t, err)
+}
+
+func TestBatchTimeDoesNotRunAheadOfTheClock(t *testing.T) {
+	rules := components.BatchTimeRules{L1Drift: time.Minute}
+
+	// the batches produced within the same second keep the time of the clock, instead of one second more each
+	parentTime := uint64(1_000)
+	for seqNo := uint64(2); seqNo < 100; seqNo++ {
+		batchTime, err := rules.Clamp(seqNo, 1_000, parentTime, 1_000)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1_000), batchTime)
+		parentTime = batchTime
+	}
+}
+
+func TestBatchTimeRulesApplyFromTheActivation(t *testing.T) {
+	rules := components.BatchTimeRules{L1Drift: time.Minute, ActivationSeqNo: 10}
+
+	// the batches of the existing chains are replayed as they were produced
+	require.NoError(t, rules.Check(9, 999, 1_000, 5_000))
+	batchTime, err := rules.Clamp(9, 999, 1_000, 5_000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(999), batchTime)
+
+	require.Error(t, rules.Check(10, 999, 1_000, 5_000))
+}
+
+// prerequisitesStorage - the stored batches, with their parents executed or not, and their L1 blocks stored or not
+type prerequisitesStorage struct {
+	*encodedBatchStorage
+	parentsExecuted bool
+	blocksStored    bool
+}
+
+func (s *prerequisitesStorage) FetchBlock(common.L1BlockHash) (*types.Block, error) {
+	if !s.blocksStored {
+		return nil, errutil.ErrNotFound
+	}
+	return types.NewBlockWithHeader(&types.Header{}), nil
+}
+
+func (s *prerequisitesStorage) BatchWasExecuted(common.L2BatchHash) (bool, error) {
+	return s.parentsExecuted, nil
+}
+
+// noHeadRegistry - the registry of an enclave without a head batch
+type noHeadRegistry struct {
+	components.BatchRegistry
+}
+
+func (r *noHeadRegistry) HeadBatchSeq() *big.Int {
+	return nil
+}
+
+func TestExecutionSummaryReportsWhyTheBatchesWereNotExecuted(t *testing.T) {
+	catchUpVal, stored := newCatchUpValidator(t, 2*catchUpPageSize, 1)
+	from := big.NewInt(int64(common.L2GenesisSeqNo + 1))
+
+	for name, tc := range map[string]struct {
+		storage  *prerequisitesStorage
+		expected common.BatchExecutionStop
+		err      bool
+	}{
+		"missing parent":   {storage: &prerequisitesStorage{encodedBatchStorage: stored, blocksStored: true}, expected: common.BatchExecutionMissingParent},
+		"missing L1 block": {storage: &prerequisitesStorage{encodedBatchStorage: stored, parentsExecuted: true}, expected: common.BatchExecutionMissingL1Block},
+		"up to date":       {storage: &prerequisitesStorage{encodedBatchStorage: &encodedBatchStorage{}}, expected: common.BatchExecutionUpToDate},
+	} {
+		t.Run(name, func(t *testing.T) {
+			val := &obsValidator{storage: tc.storage, chainConfig: catchUpVal.chainConfig, batchRegistry: &noHeadRegistry{}, logger: gethlog.New()}
+			fetches := tc.storage.fetches.Load()
+
+			summary, err := val.executeStoredBatchesFrom(context.Background(), from)
+			require.Equal(t, tc.err, err != nil, err)
+			require.Equal(t, tc.expected, summary.StopReason)
+			require.Zero(t, summary.Executed)
+			if tc.expected == common.BatchExecutionUpToDate {
+				require.Zero(t, summary.StoppedAtSeqNo)
+				return
+			}
+			require.Equal(t, from.Uint64(), summary.StoppedAtSeqNo)
+			// the following batches cannot be executed either, so they are not read
+			require.LessOrEqual(t, tc.storage.fetches.Load()-fetches, int64(2))
+		})
+	}
+}
+
+// failingExecutionStorage - fails to record the execution of the batch with the seq number, the given number of times
+type failingExecutionStorage struct {
+	storage.Storage
+	seqNo    uint64
+	failures int
+}
+
+func (s *failingExecutionStorage) StoreExecutedBatch(batch *core.Batch, receipts []*types.Receipt) error {
+	if batch.SeqNo().Uint64() == s.seqNo && s.failures > 0 {
+		s.failures--
+		return errors.New("injected storage error")
+	}
+	return s.Storage.StoreExecutedBatch(batch, receipts)
+}
+
+func TestRollupIsRetriedAfterAStorageError(t *testing.T) {
+	seq, _ := newProducingSequencer(t)
+	seq.settings.MaxRollupSize = 100 * testTxSize
+	seq.rollupProducer = components.NewRollupProducer(seq.hostID, seq.storage, seq.batchRegistry, nil, seq.logger)
+	seq.rollupCompression = components.NewRollupCompression(seq.batchRegistry, seq.batchProducer, seq.dataEncryptionService, seq.dataCompressionService, seq.storage, seq.gethEncoding, seq.chainConfig, nil, seq.logger)
+	key, err := gethcrypto.HexToECDSA(genesis.TestnetPrefundedPK)
+	require.NoError(t, err)
+	to := gethcommon.HexToAddress("0x1")
+	tx, err := types.SignNewTx(key, types.LatestSigner(seq.chainConfig), &types.LegacyTx{Nonce: 0, To: &to, Gas: 21_000, GasPrice: big.NewInt(1_000_000_000), Value: big.NewInt(1)})
+	require.NoError(t, err)
+	var batches []*core.Batch
+	for i := 0; i < 3; i++ {
+		if i == 1 {
+			require.NoError(t, seq.SubmitTransaction(tx))
+		}
+		batch, err := seq.CreateBatch(false)
+		require.NoError(t, err)
+		batches = append(batches, batch)
+	}
+	rollup, _, err := seq.CreateRollup(common.L2GenesisSeqNo, RollupSnapshot{
+		HeadBatchSeqNo: batches[2].SeqNo().Uint64(),
+		L1Head:         types.NewBlockWithHeader(&types.Header{Number: big.NewInt(RollupDelay)}),
+	})
+	require.NoError(t, err)
+
+	// the validator stores the second batch, then fails to record its execution
+	val, _ := newProducingSequencer(t)
+	stored := &failingExecutionStorage{Storage: val.storage, seqNo: batches[1].SeqNo().Uint64(), failures: components.RollupProcessingFailuresUnhealthy}
+	sequencerID := gethcommon.HexToAddress("0x2")
+	require.NoError(t, val.storage.StoreAttestedKey(sequencerID, seq.enclaveKey.PublicKey()))
+	sigValidator, err := components.NewSignatureValidator(sequencerID, seq.settings.SignatureScheme, stored)
+	require.NoError(t, err)
+	mgmtContractLib := mgmtcontractlib.NewMgmtContractLib(&gethcommon.Address{}, val.logger)
+	consumer := components.NewRollupConsumer(mgmtContractLib, val.batchRegistry,
+		components.NewRollupCompression(val.batchRegistry, val.batchProducer, val.dataEncryptionService, val.dataCompressionService, stored, val.gethEncoding, val.chainConfig, nil, val.logger),
+		stored, val.logger, sigValidator, nil)
+
+	encoded, err := common.EncodeRollup(rollup)
+	require.NoError(t, err)
+	l1Head, err := val.storage.FetchCanonicaBlockByHeight(big.NewInt(0))
+	require.NoError(t, err)
+	nextBlock := func(txs ...*types.Transaction) *common.BlockAndReceipts {
+		block := types.NewBlock(&types.Header{Number: new(big.Int).Add(l1Head.Number(), big.NewInt(1)), ParentHash: l1Head.Hash()}, txs, nil, nil, trie.NewStackTrie(nil))
+		require.NoError(t, val.storage.StoreBlock(block, nil))
+		l1Head = block
+		receipts := map[int]*types.Receipt{}
+		for i := range txs {
+			receipts[i] = &types.Receipt{Status: types.ReceiptStatusSuccessful}
+		}
+		return &common.BlockAndReceipts{Block: block, ReceiptsMap: receipts}
+	}
+
+	// the rollup is retried with each block, and reported once it failed too many times
+	br := nextBlock(types.NewTx(mgmtContractLib.CreateRollup(&ethadapter.L1RollupTx{Rollup: encoded})))
+	for attempt := uint64(1); attempt <= components.RollupProcessingFailuresUnhealthy; attempt++ {
+		require.ErrorContains(t, consumer.ProcessRollupsInBlock(context.Background(), br), "injected storage error")
+		processing, err := val.storage.FetchRollupProcessing(rollup.Hash())
+		require.NoError(t, err)
+		require.Equal(t, common.RollupProcessingFailed, processing.Status)
+		require.Equal(t, attempt, processing.Attempts)
+		require.Contains(t, processing.Reason, "injected storage error")
+		healthy, err := consumer.HealthCheck()
+		require.Equal(t, attempt < components.RollupProcessingFailuresUnhealthy, healthy)
+		require.Equal(t, attempt < components.RollupProcessingFailuresUnhealthy, err == nil)
+		br = nextBlock()
+	}
+
+	// the batch stored by the failed attempts is executed by the retry
+	partial, err := val.storage.FetchBatchBySeqNo(batches[1].SeqNo().Uint64())
+	require.NoError(t, err)
+	executed, err := val.storage.BatchWasExecuted(partial.Hash())
+	require.NoError(t, err)
+	require.False(t, executed)
+
+	require.NoError(t, consumer.ProcessRollupsInBlock(context.Background(), br))
+	processing, err := val.storage.FetchRollupProcessing(rollup.Hash())
+	require.NoError(t, err)
+	require.Equal(t, common.RollupProcessingComplete, processing.Status)
+	require.Empty(t, processing.Reason)
+	healthy, err := consumer.HealthCheck()
+	require.NoError(t, err)
+	require.True(t, healthy)
+	for _, batch := range batches {
+		processed, err := val.storage.FetchBatchBySeqNo(batch.SeqNo().Uint64())
+		require.NoError(t, err)
+		require.Equal(t, batch.Hash(), processed.Hash())
+		executed, err := val.storage.BatchWasExecuted(processed.Hash())
+		require.NoError(t, err)
+		require.True(t, executed)
+	}
+	_, err = val.storage.FetchUnprocessedRollup(rollup.Hash())
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+
+	// the rollup published again is not processed again
+	require.NoError(t, consumer.ProcessRollupsInBlock(context.Background(), nextBlock(types.NewTx(mgmtContractLib.CreateRollup(&ethadapter.L1RollupTx{Rollup: encoded})))))
+	processing, err = val.storage.FetchRollupProcessing(rollup.Hash())
+	require.NoError(t, err)
+	require.Equal(t, common.RollupProcessingComplete, processing.Status)
+	require.Equal(t, uint64(components.RollupProcessingFailuresUnhealthy), processing.Attempts)
+}
+
+func TestValidatorRunsTheStateChecksBeforeForwarding(t *testing.T) {
+	seq, mempool := newProducingSequencer(t)
+	for i := 0; i < 3; i++ {
+		_, err := seq.CreateBatch(false)
+		require.NoError(t, err)
+	}
+	val := &obsValidator{batchRegistry: seq.batchRegistry, mempool: mempool}
+
+	key, err := gethcrypto.HexToECDSA(genesis.TestnetPrefundedPK)
+	require.NoError(t, err)
+	unfundedKey, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	to := gethcommon.HexToAddress("0x1")
+	newTx := func(key *ecdsa.PrivateKey) *common.L2Tx {
+		tx, err := types.SignNewTx(key, types.LatestSigner(seq.chainConfig), &types.LegacyTx{To: &to, Gas: 21_000, GasPrice: big.NewInt(1_000_000_000), Value: big.NewInt(1)})
+		require.NoError(t, err)
+		return tx
+	}
+
+	require.NoError(t, val.SubmitTransaction(newTx(key)))
+	// the sequencer would drop it without a response, so the user is told straight away
+	require.ErrorIs(t, val.SubmitTransaction(newTx(unfundedKey)), gethcore.ErrInsufficientFunds)
+	// the validator only checks the transactions, which are added to the mempool of the sequencer
+	require.Empty(t, mempool.PendingTransactions())
+}
@@ -0,0 +1,83 @@
+package enclave
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common/profiler"
+	"github.com/ten-protocol/go-ten/go/config"
+)
+
+// closableNodeType - the node service only needs to be closed when the enclave stops
+type closableNodeType struct {
+	noopNodeType
+}
+
+func (n *closableNodeType) Close() error {
+	return nil
+}
+
+func TestProfilerIsStartedAndStoppedAtRuntime(t *testing.T) {
+	enclave, address := newProfilerTestEnclave(t)
+
+	status, err := enclave.SetProfilerEnabled(true)
+	require.NoError(t, err)
+	require.Equal(t, "profiler started", status.Message)
+	require.True(t, status.Running)
+	requireProfilerServing(t, address, true)
+
+	// enabling it again leaves it running
+	status, err = enclave.SetProfilerEnabled(true)
+	require.NoError(t, err)
+	require.Equal(t, "profiler already running", status.Message)
+	require.True(t, status.Running)
+	requireProfilerServing(t, address, true)
+
+	status, err = enclave.SetProfilerEnabled(false)
+	require.NoError(t, err)
+	require.Equal(t, "profiler stopped", status.Message)
+	require.False(t, status.Running)
+	requireProfilerServing(t, address, false)
+
+	status, err = enclave.SetProfilerEnabled(false)
+	require.NoError(t, err)
+	require.Equal(t, "profiler not running", status.Message)
+}
+
+func TestProfilerIsStoppedWithTheEnclave(t *testing.T) {
+	enclave, address := newProfilerTestEnclave(t)
+	_, err := enclave.SetProfilerEnabled(true)
+	require.NoError(t, err)
+	requireProfilerServing(t, address, true)
+
+	require.NoError(t, enclave.Stop())
+	requireProfilerServing(t, address, false)
+}
+
+// newProfilerTestEnclave - an enclave whose profiler listens on a free port, instead of the default one
+func newProfilerTestEnclave(t *testing.T) (*enclaveImpl, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	enclave, _ := newStorageTestEnclave(t)
+	enclave.config = &config.EnclaveConfig{DebugNamespaceEnabled: true}
+	enclave.service = &closableNodeType{}
+	enclave.profiler = profiler.NewProfiler(port, enclave.logger)
+	t.Cleanup(func() { _ = enclave.profiler.Stop() })
+	return enclave, fmt.Sprintf("127.0.0.1:%d", port)
+}
+
+func requireProfilerServing(t *testing.T, address string, serving bool) {
+	conn, err := net.DialTimeout("tcp", address, time.Second)
+	if !serving {
+		require.Error(t, err, "the profiler is still serving")
+		return
+	}
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+}
@@ -0,0 +1,64 @@
+package enclave
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+)
+
+func TestRollupsAreCreatedOnceTheGenesisBatchArrives(t *testing.T) {
+	enclave, seq := newLockTestEnclave(t)
+	registry := components.NewBatchRegistry(enclave.storage, enclave.logger)
+	enclave.registry = registry
+	submitL1Block(t, enclave, lockTestBlock(gethcommon.Hash{}, 0, ""))
+
+	// the rollups requested before the genesis batch are rejected, without reaching the sequencer
+	for i := 0; i < 3; i++ {
+		_, _, err := enclave.CreateRollup(common.L2GenesisSeqNo)
+		require.ErrorIs(t, err, errutil.ErrNotReady)
+	}
+	select {
+	case <-registry.Ready():
+		t.Fatal("the registry is ready without a head batch")
+	case <-seq.producing:
+		t.Fatal("a rollup was created without a head batch")
+	default:
+	}
+
+	registry.OnBatchExecuted(&core.Batch{Header: &common.BatchHeader{SequencerOrderNo: big.NewInt(int64(common.L2GenesisSeqNo))}}, nil)
+	select {
+	case <-registry.Ready():
+	case <-time.After(lockTestTimeout):
+		t.Fatal("the registry is not ready after the genesis batch")
+	}
+	require.True(t, registry.IsReady())
+
+	close(seq.release)
+	_, _, err := enclave.CreateRollup(common.L2GenesisSeqNo)
+	require.Nil(t, err)
+}
+
+func TestBlocksAreRefusedUntilTheStateIsRestored(t *testing.T) {
+	enclave, _ := newLockTestEnclave(t)
+	enclave.replay.start(10)
+
+	_, err := enclave.SubmitL1Block(context.Background(), *lockTestBlock(gethcommon.Hash{}, 0, ""), types.Receipts{}, nil, false)
+	require.ErrorContains(t, err, "recovering its state")
+	require.True(t, enclave.replay.Status().InProgress)
+
+	enclave.replay.planned(1)
+	enclave.replay.replayed(10)
+	enclave.replay.finish(nil)
+	require.True(t, enclave.replay.restored())
+	require.Equal(t, &common.ReplayStatus{CurrentSeqNo: 10, TargetSeqNo: 10, Replayed: 1, Total: 1}, enclave.replay.Status())
+	submitL1Block(t, enclave, lockTestBlock(gethcommon.Hash{}, 0, ""))
+}
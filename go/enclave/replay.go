@@ -0,0 +1,280 @@
+package enclave
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/genesis"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// the number of replayed batches between two checkpoints, so an interrupted replay resumes from the last one
+	replayCheckpointInterval = 100
+	// how often the progress of the replay is logged
+	replayProgressInterval = 10 * time.Second
+)
+
+// replayTracker - the progress of the replay of the batches whose state was lost, reported in the enclave status. The
+// zero value is an enclave which did not replay batches.
+type replayTracker struct {
+	mutex  sync.RWMutex
+	status *common.ReplayStatus
+}
+
+func (t *replayTracker) start(targetSeqNo uint64) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.status = &common.ReplayStatus{InProgress: true, TargetSeqNo: targetSeqNo}
+}
+
+// planned - records the batches to replay, once they are known
+func (t *replayTracker) planned(total uint64) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.status.Total = total
+}
+
+func (t *replayTracker) replayed(seqNo uint64) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.status.CurrentSeqNo = seqNo
+	t.status.Replayed++
+}
+
+func (t *replayTracker) finish(err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.status.InProgress = false
+	if err != nil {
+		t.status.Error = err.Error()
+	}
+}
+
+// restored - whether the state of every batch is available, i.e. there was nothing to replay or the replay succeeded.
+// The enclave cannot process the L1 blocks and the batches until then.
+func (t *replayTracker) restored() bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.status == nil || (!t.status.InProgress && t.status.Error == "")
+}
+
+// Status - a copy of the progress of the replay, nil if the enclave did not replay batches
+func (t *replayTracker) Status() *common.ReplayStatus {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	if t.status == nil {
+		return nil
+	}
+	status := *t.status
+	return &status
+}
+
+// stateDBCacheNeedsReplay - whether the state of the head batch was lost, e.g. because the enclave stopped uncleanly,
+// and must be restored by replaying the batches
+func stateDBCacheNeedsReplay(storage storage.Storage, registry components.BatchRegistry, logger gethlog.Logger) (bool, error) {
+	if !registry.IsReady() {
+		// there is no state to restore before the genesis batch
+		return false, nil
+	}
+	batch, err := storage.FetchBatchBySeqNo(registry.HeadBatchSeq().Uint64())
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			// there is no head batch, this is probably a new node - there is no state to rebuild
+			logger.Info("no head batch found in DB after restart", log.ErrKey, err)
+			return false, nil
+		}
+		return false, fmt.Errorf("unexpected error fetching head batch to resync- %w", err)
+	}
+	return !stateDBAvailableForBatch(storage, batch.Hash()), nil
+}
+
+// restoreStateDBCache - replays the batches whose state was lost. It runs in the background, so the host can follow the
+// progress in the status, and the enclave only starts producing batches once the state is restored.
+func (e *enclaveImpl) restoreStateDBCache(batchExecutor components.BatchExecutor, gen *genesis.Genesis) {
+	if !e.stopControl.Enter() {
+		e.replay.finish(errors.New("the enclave stopped before the replay started"))
+		return
+	}
+	defer e.stopControl.Exit()
+
+	e.logger.Info("state not available for latest batch after restart - rebuilding stateDB cache from batches")
+	err := replayBatchesToValidState(e.storage, e.registry, batchExecutor, gen, &e.replay, e.stopControl, e.logger)
+	e.replay.finish(err)
+	if err != nil {
+		e.logger.Error("unable to replay batches to restore valid state", log.ErrKey, err)
+		return
+	}
+	e.logger.Info("Restored the stateDB cache")
+	if e.config.EnclaveBatchProduction {
+		go e.produceBatches()
+	}
+}
+
+// The enclave caches a stateDB instance against each batch hash, this is the input state when producing the following
+// batch in the chain and is used to query state at a certain height.
+//
+// This method checks if the stateDB data is available for a given batch hash (so it can be restored if not)
+func stateDBAvailableForBatch(storage storage.Storage, hash common.L2BatchHash) bool {
+	_, err := storage.CreateStateDB(hash)
+	return err == nil
+}
+
+// replayBatchesToValidState is used to repopulate the stateDB cache with data from persisted batches. Two step process:
+// 1. find the batches to replay: the batches after the checkpoint of an interrupted replay, or else step backwards from
+// head batch until we find a batch that is already in stateDB cache
+// 2. iterate that list of batches from the earliest, process the transactions to calculate and cache the stateDB
+// A checkpoint is stored every replayCheckpointInterval batches, and cleared once the replay completes. On a full node,
+// the storage drops the replayed states which fall out of the retention window as it goes.
+// todo (#1416) - get unit test coverage around this (and L2 Chain code more widely, see ticket #1416 )
+func replayBatchesToValidState(storage storage.Storage, registry components.BatchRegistry, batchExecutor components.BatchExecutor, gen *genesis.Genesis, tracker *replayTracker, stopControl *stopcontrol.StopControl, logger gethlog.Logger) error {
+	head, err := storage.FetchBatchBySeqNo(registry.HeadBatchSeq().Uint64())
+	if err != nil {
+		return fmt.Errorf("no head batch found in DB but expected to replay batches - %w", err)
+	}
+	batchesToReplay, err := batchesAfterCheckpoint(storage, head, logger)
+	if err != nil {
+		return err
+	}
+	if batchesToReplay == nil {
+		if batchesToReplay, err = batchesWithoutState(storage, head); err != nil {
+			return err
+		}
+	}
+	total := uint64(len(batchesToReplay))
+	tracker.planned(total)
+	if total == 0 {
+		return nil
+	}
+	logger.Info("replaying batch data into stateDB cache", "fromBatch", batchesToReplay[0].NumberU64(),
+		"toBatch", head.NumberU64(), "batches", total)
+
+	start := time.Now()
+	lastProgressLog := start
+	for i, batch := range batchesToReplay {
+		if stopControl.IsStopping() {
+			// the next start resumes from the last checkpoint
+			return fmt.Errorf("replay interrupted by the enclave stopping after %d of %d batches", i, total)
+		}
+
+		// if genesis batch then create the genesis state before continuing on with remaining batches
+		if batch.NumberU64() == 0 {
+			err = gen.CommitGenesisState(storage)
+		} else {
+			// calculate the stateDB after this batch and store it in the cache
+			_, err = batchExecutor.ExecuteBatch(context.Background(), batch)
+		}
+		if err != nil {
+			return err
+		}
+		tracker.replayed(batch.SeqNo().Uint64())
+
+		replayed := uint64(i + 1)
+		if replayCheckpointDue(storage, batch.SeqNo().Uint64(), replayed) && replayed < total {
+			if err = storage.StoreReplayCheckpoint(batch.SeqNo().Uint64()); err != nil {
+				return fmt.Errorf("could not store the replay checkpoint. Cause: %w", err)
+			}
+		}
+		if time.Since(lastProgressLog) >= replayProgressInterval {
+			lastProgressLog = time.Now()
+			elapsed := time.Since(start)
+			eta := elapsed / time.Duration(replayed) * time.Duration(total-replayed)
+			logger.Info(fmt.Sprintf("Replayed %d of %d batches", replayed, total), log.BatchSeqNoKey, batch.SeqNo(),
+				"elapsed", elapsed.Round(time.Second), "eta", eta.Round(time.Second))
+		}
+	}
+
+	if err = storage.StoreReplayCheckpoint(0); err != nil {
+		return fmt.Errorf("could not clear the replay checkpoint. Cause: %w", err)
+	}
+	logger.Info(fmt.Sprintf("Replayed %d batches", total), "duration", time.Since(start).Round(time.Millisecond))
+	return nil
+}
+
+// replayCheckpointDue - whether the replay stores a checkpoint after the batch. A full node keeps most of the replayed
+// states in memory only, so the checkpoints are the batches whose state it persists, once per retention window.
+func replayCheckpointDue(storage storage.Storage, seqNo uint64, replayed uint64) bool {
+	if storage.StateRetention() == common.FullStateRetention {
+		return storage.StatePersisted(seqNo)
+	}
+	return replayed%replayCheckpointInterval == 0
+}
+
+// batchesAfterCheckpoint - the batches from the checkpoint of an interrupted replay to the head batch, oldest first. It
+// returns nil when there is no checkpoint to resume from, or when it is not an ancestor of the head batch anymore.
+func batchesAfterCheckpoint(storage storage.Storage, head *core.Batch, logger gethlog.Logger) ([]*core.Batch, error) {
+	checkpoint, err := storage.FetchReplayCheckpoint()
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not fetch the replay checkpoint. Cause: %w", err)
+	}
+	if checkpoint == 0 || checkpoint >= head.SeqNo().Uint64() {
+		return nil, nil
+	}
+	previous, err := storage.FetchBatchBySeqNo(checkpoint)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the batch of the replay checkpoint. Cause: %w", err)
+	}
+	if !stateDBAvailableForBatch(storage, previous.Hash()) {
+		logger.Warn("The state of the replay checkpoint is not available, replaying from the last batch with a state", log.BatchSeqNoKey, checkpoint)
+		return nil, nil
+	}
+
+	var batches []*core.Batch
+	for seqNo := checkpoint + 1; seqNo <= head.SeqNo().Uint64(); seqNo++ {
+		batch, err := storage.FetchBatchBySeqNo(seqNo)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch batch %d while resuming the replay - %w", seqNo, err)
+		}
+		if batch.Header.ParentHash != previous.Hash() {
+			logger.Warn("The replay checkpoint is not an ancestor of the head batch, replaying from the last batch with a state", log.BatchSeqNoKey, checkpoint)
+			return nil, nil
+		}
+		batches = append(batches, batch)
+		previous = batch
+	}
+	logger.Info("Resuming the replay from the checkpoint", log.BatchSeqNoKey, checkpoint)
+	return batches, nil
+}
+
+// batchesWithoutState - steps backwards from the head batch until a batch with a state, and returns the batches after
+// it, oldest first
+func batchesWithoutState(storage storage.Storage, head *core.Batch) ([]*core.Batch, error) {
+	// this slice will be a stack of batches to replay as we walk backwards in search of latest valid state
+	// todo - consider capping the size of this batch list using FIFO to avoid memory issues, and then repeating as necessary
+	var batchesToReplay []*core.Batch
+	// `batchToReplayFrom` variable will eventually be the latest batch for which we are able to produce a StateDB
+	// - we will then set that as the head of the L2 so that this node can rebuild its missing state
+	batchToReplayFrom := head
+	var err error
+	// loop backwards building a slice of all batches that don't have cached stateDB data available
+	for !stateDBAvailableForBatch(storage, batchToReplayFrom.Hash()) {
+		batchesToReplay = append(batchesToReplay, batchToReplayFrom)
+		if batchToReplayFrom.NumberU64() == 0 {
+			// no more parents to check, replaying from genesis
+			break
+		}
+		batchToReplayFrom, err = storage.FetchBatch(batchToReplayFrom.Header.ParentHash)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch previous batch while rolling back to stable state - %w", err)
+		}
+	}
+	// the slice is newest to oldest
+	for i, j := 0, len(batchesToReplay)-1; i < j; i, j = i+1, j-1 {
+		batchesToReplay[i], batchesToReplay[j] = batchesToReplay[j], batchesToReplay[i]
+	}
+	return batchesToReplay, nil
+}
@@ -0,0 +1,120 @@
+package enclave
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+// statefulStorage - a storage which only has the state of the batches marked available
+type statefulStorage struct {
+	storage.Storage
+	lock      sync.Mutex
+	available map[common.L2BatchHash]bool
+}
+
+func (s *statefulStorage) CreateStateDB(hash common.L2BatchHash) (*state.StateDB, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.available[hash] {
+		return nil, errors.New("missing trie node")
+	}
+	return s.Storage.EmptyStateDB()
+}
+
+// keepStates - the states of the batches are the only ones available, e.g. after a restart
+func (s *statefulStorage) keepStates(batches []*core.Batch) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.available = map[common.L2BatchHash]bool{}
+	for _, batch := range batches {
+		s.available[batch.Hash()] = true
+	}
+}
+
+// replayingExecutor - records the seq numbers of the batches it executes, makes their state available, and stops the
+// enclave once it executed the batch stopAt
+type replayingExecutor struct {
+	components.BatchExecutor
+	storage     *statefulStorage
+	stopControl *stopcontrol.StopControl
+	stopAt      uint64
+	executed    []uint64
+}
+
+func (e *replayingExecutor) ExecuteBatch(_ context.Context, batch *core.Batch) (types.Receipts, error) {
+	e.storage.lock.Lock()
+	e.storage.available[batch.Hash()] = true
+	e.storage.lock.Unlock()
+	e.executed = append(e.executed, batch.SeqNo().Uint64())
+	if batch.SeqNo().Uint64() == e.stopAt {
+		e.stopControl.Stop()
+	}
+	return nil, nil
+}
+
+func TestInterruptedReplayResumesFromTheCheckpoint(t *testing.T) {
+	enclave, _ := newGenesisTestEnclave(t, nil)
+	chain := extendGenesisTestChain(t, enclave, 250)
+	replayStorage := &statefulStorage{Storage: enclave.storage}
+	// only the genesis has a state, the states of the batches 2 to 250 were lost
+	replayStorage.keepStates(chain[:1])
+
+	// the enclave stops while replaying the batch 160, after the checkpoint of the first 100 replayed batches
+	executor := &replayingExecutor{storage: replayStorage, stopControl: stopcontrol.New(), stopAt: 160}
+	tracker := &replayTracker{}
+	tracker.start(250)
+	err := replayBatchesToValidState(replayStorage, enclave.registry, executor, nil, tracker, executor.stopControl, enclave.logger)
+	require.ErrorContains(t, err, "replay interrupted")
+	require.Equal(t, seqNoRange(2, 160), executor.executed)
+	checkpoint, err := replayStorage.FetchReplayCheckpoint()
+	require.NoError(t, err)
+	require.Equal(t, uint64(101), checkpoint)
+
+	// the states of the batches after the checkpoint are lost with the restart, and the replay resumes after it
+	replayStorage.keepStates(chain[:checkpoint])
+	executor = &replayingExecutor{storage: replayStorage, stopControl: stopcontrol.New()}
+	tracker = &replayTracker{}
+	tracker.start(250)
+	require.NoError(t, replayBatchesToValidState(replayStorage, enclave.registry, executor, nil, tracker, executor.stopControl, enclave.logger))
+	require.Equal(t, seqNoRange(102, 250), executor.executed)
+	require.Equal(t, &common.ReplayStatus{InProgress: true, CurrentSeqNo: 250, TargetSeqNo: 250, Replayed: 149, Total: 149}, tracker.Status())
+
+	// the checkpoint is cleared once the replay completes
+	checkpoint, err = replayStorage.FetchReplayCheckpoint()
+	require.NoError(t, err)
+	require.Zero(t, checkpoint)
+}
+
+func TestReplayIgnoresACheckpointWithoutState(t *testing.T) {
+	enclave, _ := newGenesisTestEnclave(t, nil)
+	chain := extendGenesisTestChain(t, enclave, 250)
+	replayStorage := &statefulStorage{Storage: enclave.storage}
+	require.NoError(t, replayStorage.StoreReplayCheckpoint(101))
+
+	// the state of the checkpoint was lost, so the replay starts from the last batch with a state
+	replayStorage.keepStates(chain[:50])
+	executor := &replayingExecutor{storage: replayStorage, stopControl: stopcontrol.New()}
+	tracker := &replayTracker{}
+	tracker.start(250)
+	require.NoError(t, replayBatchesToValidState(replayStorage, enclave.registry, executor, nil, tracker, executor.stopControl, enclave.logger))
+	require.Equal(t, seqNoRange(51, 250), executor.executed)
+}
+
+func seqNoRange(from uint64, to uint64) []uint64 {
+	var seqNos []uint64
+	for seqNo := from; seqNo <= to; seqNo++ {
+		seqNos = append(seqNos, seqNo)
+	}
+	return seqNos
+}
@@ -0,0 +1,72 @@
+package enclave
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+func TestRollupMetadata(t *testing.T) {
+	enclave, storageDB := newStorageTestEnclave(t)
+	blockA := testBlock("")
+	require.NoError(t, storageDB.StoreBlock(blockA, nil))
+	rollupA := storeTestRollup(t, storageDB, blockA.Hash(), 1, 3)
+
+	rollup, err := enclave.GetRollupData(rollupA.Hash())
+	require.NoError(t, err)
+	require.Equal(t, &common.PublicRollupMetadata{
+		Hash:              rollupA.Hash(),
+		L1Block:           blockA.Hash(),
+		CompressionL1Head: blockA.Hash(),
+		CompressedSize:    rollupA.CompressedSize(),
+		FirstBatchSeqNo:   1,
+		LastBatchSeqNo:    3,
+		R:                 rollupA.Header.R,
+		S:                 rollupA.Header.S,
+		IsCanonical:       true,
+	}, rollup)
+
+	rollup, err = enclave.GetRollupByBatchSeqNo(2)
+	require.NoError(t, err)
+	require.Equal(t, rollupA.Hash(), rollup.Hash)
+	_, err = enclave.GetRollupByBatchSeqNo(4)
+	require.Error(t, err)
+
+	// the block which published the rollup is reorged, and the batches are published again on the fork
+	blockB := testBlock("fork")
+	chainFork := &common.ChainFork{CanonicalPath: []common.L1BlockHash{blockB.Hash()}, NonCanonicalPath: []common.L1BlockHash{blockA.Hash()}}
+	require.NoError(t, storageDB.StoreBlock(blockB, chainFork))
+	rollupB := storeTestRollup(t, storageDB, blockB.Hash(), 1, 4)
+
+	rollup, err = enclave.GetRollupData(rollupA.Hash())
+	require.NoError(t, err)
+	require.False(t, rollup.IsCanonical)
+
+	rollup, err = enclave.GetRollupByBatchSeqNo(2)
+	require.NoError(t, err)
+	require.Equal(t, rollupB.Hash(), rollup.Hash)
+	require.True(t, rollup.IsCanonical)
+
+	latest, err := storageDB.FetchLatestRollupMetadata()
+	require.NoError(t, err)
+	require.Equal(t, rollupB.Hash(), latest.Hash)
+}
+
+func storeTestRollup(t *testing.T, storageDB storage.Storage, l1Block common.L1BlockHash, firstSeqNo uint64, lastSeqNo uint64) *common.ExtRollup {
+	rollup := &common.ExtRollup{
+		Header: &common.RollupHeader{
+			CompressionL1Head: l1Block,
+			LastBatchSeqNo:    lastSeqNo,
+			R:                 big.NewInt(1),
+			S:                 big.NewInt(2),
+		},
+		CalldataRollupHeader: []byte{4, 5},
+		BatchPayloads:        []byte{1, 2, 3},
+	}
+	internalHeader := &common.CalldataRollupHeader{FirstBatchSequence: new(big.Int).SetUint64(firstSeqNo)}
+	require.NoError(t, storageDB.StoreRollup(rollup, internalHeader, l1Block))
+	return rollup
+}
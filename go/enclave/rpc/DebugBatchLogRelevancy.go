@@ -0,0 +1,83 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+)
+
+type BatchLogRelevancyReq struct {
+	BatchHash common.L2BatchHash
+	Offset    uint64
+	MaxResult int
+}
+
+func DebugBatchLogRelevancyValidate(reqParams []any, builder *CallBuilder[BatchLogRelevancyReq, common.LogRelevancyPage], _ *EncryptionManager) error {
+	// Parameters are [BatchHash, Offset, MaxResult]
+	if len(reqParams) != 3 {
+		builder.Err = fmt.Errorf("unexpected number of parameters")
+		return nil
+	}
+	batchHashStr, ok := reqParams[0].(string)
+	if !ok {
+		builder.Err = fmt.Errorf("unexpected batch hash parameter")
+		return nil
+	}
+	offset, err := extractInt(reqParams[1])
+	if err != nil {
+		builder.Err = fmt.Errorf("unable to extract offset - %w", err)
+		return nil
+	}
+	if offset < 0 {
+		builder.Err = fmt.Errorf("negative offset %d", offset)
+		return nil
+	}
+	maxResult, err := extractInt(reqParams[2])
+	if err != nil {
+		builder.Err = fmt.Errorf("unable to extract max result - %w", err)
+		return nil
+	}
+
+	builder.Param = &BatchLogRelevancyReq{
+		BatchHash: gethcommon.HexToHash(batchHashStr),
+		Offset:    uint64(offset),
+		MaxResult: maxResult,
+	}
+	return nil
+}
+
+func DebugBatchLogRelevancyExecute(_ context.Context, builder *CallBuilder[BatchLogRelevancyReq, common.LogRelevancyPage], rpc *EncryptionManager) error {
+	// the logs of a contract are only reported to its owner, unless the node reports them to anyone
+	var include func(contract gethcommon.Address) (bool, error)
+	if !rpc.config.DebugAccessUnrestricted {
+		owned := map[gethcommon.Address]bool{}
+		include = func(contract gethcommon.Address) (bool, error) {
+			if isOwner, found := owned[contract]; found {
+				return isOwner, nil
+			}
+			latest := gethrpc.LatestBlockNumber
+			owner, err := rpc.chain.AccountOwner(contract, &latest)
+			if err != nil {
+				return false, err
+			}
+			owned[contract] = owner.Hex() == builder.VK.AccountAddress.Hex()
+			return owned[contract], nil
+		}
+	}
+
+	page, err := rpc.debugger.BatchLogRelevancy(builder.Param.BatchHash, builder.Param.Offset, builder.Param.MaxResult, include)
+	if err != nil {
+		if errors.Is(err, &syserr.InternalError{}) {
+			return err
+		}
+		builder.Err = err
+		return nil
+	}
+	builder.ReturnValue = page
+	return nil
+}
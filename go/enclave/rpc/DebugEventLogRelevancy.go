@@ -0,0 +1,40 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func DebugEventLogRelevancyValidate(reqParams []any, builder *CallBuilder[gethcommon.Hash, json.RawMessage], _ *EncryptionManager) error {
+	// Parameters are [Hash]
+	if len(reqParams) != 1 {
+		builder.Err = fmt.Errorf("unexpected number of parameters")
+		return nil
+	}
+	txHashStr, ok := reqParams[0].(string)
+	if !ok {
+		builder.Err = fmt.Errorf("unexpected tx hash parameter")
+		return nil
+	}
+
+	txHash := gethcommon.HexToHash(txHashStr)
+	builder.Param = &txHash
+	return nil
+}
+
+func DebugEventLogRelevancyExecute(_ context.Context, builder *CallBuilder[gethcommon.Hash, json.RawMessage], rpc *EncryptionManager) error {
+	authorised, err := authoriseDebugTx(builder, *builder.Param, rpc)
+	if !authorised {
+		return err
+	}
+
+	logs, err := rpc.debugger.DebugEventLogRelevancy(*builder.Param)
+	if err != nil {
+		return fmt.Errorf("could not retrieve the logs of the transaction - %w", err)
+	}
+	builder.ReturnValue = &logs
+	return nil
+}
@@ -0,0 +1,111 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+)
+
+type StorageRangeReq struct {
+	BatchHash common.L2BatchHash
+	TxIndex   int
+	Address   *gethcommon.Address
+	Start     []byte
+	MaxResult int
+}
+
+func DebugStorageRangeAtValidate(reqParams []any, builder *CallBuilder[StorageRangeReq, common.StorageRange], _ *EncryptionManager) error {
+	// Parameters are [BatchHash, TxIndex, Address, StartKey, MaxResult]
+	if len(reqParams) != 5 {
+		builder.Err = fmt.Errorf("unexpected number of parameters")
+		return nil
+	}
+	batchHashStr, ok := reqParams[0].(string)
+	if !ok {
+		builder.Err = fmt.Errorf("unexpected batch hash parameter")
+		return nil
+	}
+	txIndex, err := extractInt(reqParams[1])
+	if err != nil {
+		builder.Err = fmt.Errorf("unable to extract tx index - %w", err)
+		return nil
+	}
+	address, err := gethencoding.ExtractAddress(reqParams[2])
+	if err != nil {
+		builder.Err = fmt.Errorf("unable to extract requested Addr - %w", err)
+		return nil
+	}
+	startStr, ok := reqParams[3].(string)
+	if !ok {
+		builder.Err = fmt.Errorf("unexpected start key parameter")
+		return nil
+	}
+	start, err := hexutil.Decode(startStr)
+	if err != nil {
+		builder.Err = fmt.Errorf("unable to decode start key - %w", err)
+		return nil
+	}
+	maxResult, err := extractInt(reqParams[4])
+	if err != nil {
+		builder.Err = fmt.Errorf("unable to extract max result - %w", err)
+		return nil
+	}
+
+	builder.Param = &StorageRangeReq{
+		BatchHash: gethcommon.HexToHash(batchHashStr),
+		TxIndex:   txIndex,
+		Address:   address,
+		Start:     start,
+		MaxResult: maxResult,
+	}
+	return nil
+}
+
+func DebugStorageRangeAtExecute(_ context.Context, builder *CallBuilder[StorageRangeReq, common.StorageRange], rpc *EncryptionManager) error {
+	// authorise the call - the storage of a contract is only revealed to its owner, unless the node allows it to anyone
+	if !rpc.config.DebugAccessUnrestricted {
+		latest := gethrpc.LatestBlockNumber
+		owner, err := rpc.chain.AccountOwner(*builder.Param.Address, &latest)
+		if err != nil {
+			return err
+		}
+		if owner.Hex() != builder.VK.AccountAddress.Hex() {
+			rpc.logger.Debug("Unauthorised call", "address", builder.Param.Address, "vk", builder.VK.AccountAddress, "userId", builder.VK.UserID)
+			builder.Status = NotAuthorised
+			return nil
+		}
+	}
+
+	storageRange, err := rpc.debugger.StorageRangeAt(builder.Param.BatchHash, builder.Param.TxIndex, *builder.Param.Address, builder.Param.Start, builder.Param.MaxResult)
+	if err != nil {
+		if errors.Is(err, &syserr.InternalError{}) {
+			return err
+		}
+		builder.Err = err
+		return nil
+	}
+	builder.ReturnValue = storageRange
+	return nil
+}
+
+// extractInt - the integer parameters are either JSON numbers or hex strings
+func extractInt(param any) (int, error) {
+	switch value := param.(type) {
+	case float64:
+		if value != float64(int(value)) {
+			return 0, fmt.Errorf("not an integer: %v", value)
+		}
+		return int(value), nil
+	case string:
+		decoded, err := hexutil.DecodeUint64(value)
+		return int(decoded), err
+	}
+	return 0, fmt.Errorf("unexpected type %T", param)
+}
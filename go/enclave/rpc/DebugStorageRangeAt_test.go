@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common/rpc"
+	"github.com/ten-protocol/go-ten/go/enclave/l2chain"
+	"github.com/ten-protocol/go-ten/go/responses"
+)
+
+// ownedChain - a chain on which every account is owned by the same account
+type ownedChain struct {
+	l2chain.ObscuroChain
+	owner gethcommon.Address
+}
+
+func (c *ownedChain) AccountOwner(gethcommon.Address, *gethrpc.BlockNumber) (*gethcommon.Address, error) {
+	return &c.owner, nil
+}
+
+func TestStorageRangeOfAnotherContractIsNotAuthorised(t *testing.T) {
+	encManager, vk := setupEncryptionManager(t)
+	encManager.chain = &ownedChain{owner: gethcommon.HexToAddress("0x2")}
+
+	params := []any{gethcommon.Hash{}.Hex(), 0, gethcommon.HexToAddress("0x3").Hex(), "0x", 10}
+	encReq := encryptRequest(t, encManager, &rpc.RequestWithVk{VK: rpcVK(vk), Params: params})
+	resp, sysErr := WithVKEncryption(context.Background(), encManager, "debugStorageRangeAt", encReq, DebugStorageRangeAtValidate, DebugStorageRangeAtExecute)
+	require.Nil(t, sysErr)
+	err := userError(t, resp, vk)
+	require.Equal(t, responses.ErrCodeUnauthorised, responses.ErrorCodeOf(err))
+	require.ErrorContains(t, err, "not authorised")
+}
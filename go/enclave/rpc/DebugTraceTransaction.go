@@ -0,0 +1,116 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/vkhandler"
+)
+
+type TraceTxReq struct {
+	TxHash gethcommon.Hash
+	Config *tracers.TraceConfig
+}
+
+func DebugTraceTransactionValidate(reqParams []any, builder *CallBuilder[TraceTxReq, json.RawMessage], _ *EncryptionManager) error {
+	// Parameters are [Hash, TraceConfig], the config is optional
+	if len(reqParams) < 1 || len(reqParams) > 2 {
+		builder.Err = fmt.Errorf("unexpected number of parameters")
+		return nil
+	}
+	txHashStr, ok := reqParams[0].(string)
+	if !ok {
+		builder.Err = fmt.Errorf("unexpected tx hash parameter")
+		return nil
+	}
+
+	var config *tracers.TraceConfig
+	if len(reqParams) == 2 && reqParams[1] != nil {
+		// the config arrives as a map, so it goes through JSON again to be decoded
+		configJSON, err := json.Marshal(reqParams[1])
+		if err != nil {
+			builder.Err = fmt.Errorf("unable to marshal trace config - %w", err)
+			return nil
+		}
+		config = &tracers.TraceConfig{}
+		if err = json.Unmarshal(configJSON, config); err != nil {
+			builder.Err = fmt.Errorf("unable to decode trace config - %w", err)
+			return nil
+		}
+	}
+
+	builder.Param = &TraceTxReq{TxHash: gethcommon.HexToHash(txHashStr), Config: config}
+	return nil
+}
+
+func DebugTraceTransactionExecute(ctx context.Context, builder *CallBuilder[TraceTxReq, json.RawMessage], rpc *EncryptionManager) error {
+	authorised, err := authoriseDebugTx(builder, builder.Param.TxHash, rpc)
+	if !authorised {
+		return err
+	}
+
+	trace, err := rpc.debugger.DebugTraceTransaction(ctx, builder.Param.TxHash, builder.Param.Config)
+	if err != nil {
+		if errors.Is(err, &syserr.InternalError{}) {
+			return err
+		}
+		builder.Err = err
+		return nil
+	}
+	builder.ReturnValue = &trace
+	return nil
+}
+
+// authoriseDebugTx - whether the viewing key can debug the transaction, because it sent it or because it owns the
+// contract the transaction calls. Otherwise, the status or the error of the builder are set, and the internal errors
+// are returned. Any viewing key can debug any transaction when the debug access is unrestricted.
+func authoriseDebugTx[P any, R any](builder *CallBuilder[P, R], txHash gethcommon.Hash, rpc *EncryptionManager) (bool, error) {
+	if rpc.config.DebugAccessUnrestricted {
+		return true, nil
+	}
+	authorised, err := canDebugTx(builder.VK, txHash, rpc)
+	switch {
+	case errors.Is(err, errutil.ErrNotFound):
+		// distinct from the unauthorised requests
+		builder.Err = fmt.Errorf("transaction %s not found - %w", txHash, err)
+		return false, nil
+	case err != nil:
+		return false, syserr.NewInternalError(err)
+	case !authorised:
+		rpc.logger.Debug("Unauthorised call", "tx", txHash, "vk", builder.VK.AccountAddress, "userId", builder.VK.UserID)
+		builder.Status = NotAuthorised
+	}
+	return authorised, nil
+}
+
+func canDebugTx(vk *vkhandler.AuthenticatedViewingKey, txHash gethcommon.Hash, rpc *EncryptionManager) (bool, error) {
+	tx, _, _, _, err := rpc.storage.GetTransaction(txHash) //nolint:dogsled
+	if err != nil {
+		return false, err
+	}
+	sender, err := core.GetTxSigner(tx)
+	if err != nil {
+		return false, fmt.Errorf("could not recover the tx %s sender. Cause: %w", tx.Hash(), err)
+	}
+	if sender.Hex() == vk.AccountAddress.Hex() {
+		return true, nil
+	}
+	if tx.To() == nil {
+		return false, nil
+	}
+	// the owner of an account which is not a contract is the account itself
+	latest := gethrpc.LatestBlockNumber
+	owner, err := rpc.chain.AccountOwner(*tx.To(), &latest)
+	if err != nil {
+		return false, err
+	}
+	return owner.Hex() != tx.To().Hex() && owner.Hex() == vk.AccountAddress.Hex(), nil
+}
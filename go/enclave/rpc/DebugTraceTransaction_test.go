@@ -0,0 +1,108 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/vkhandler"
+)
+
+// failingTxStorage - a storage which cannot read the transactions
+type failingTxStorage struct {
+	storage.Storage
+}
+
+func (s *failingTxStorage) GetTransaction(common.L2TxHash) (*types.Transaction, common.L2BatchHash, uint64, uint64, error) {
+	return nil, gethcommon.Hash{}, 0, 0, errors.New("storage failure")
+}
+
+func TestOnlyTheSenderCanDebugATransaction(t *testing.T) {
+	tx, sender := newDeployTx(t)
+	encManager := newDebugEncryptionManager(&testTxStorage{included: map[gethcommon.Hash]*common.L2Tx{tx.Hash(): tx}}, false)
+	other := gethcommon.HexToAddress("0x2")
+
+	authorised, err := authoriseDebugTx(debugTxBuilder(tx.Hash(), sender), tx.Hash(), encManager)
+	require.NoError(t, err)
+	require.True(t, authorised)
+
+	// the transaction exists, but the account is neither its sender nor the owner of the contract it calls
+	traceBuilder := &CallBuilder[TraceTxReq, json.RawMessage]{Param: &TraceTxReq{TxHash: tx.Hash()}, VK: debugVK(other)}
+	require.NoError(t, DebugTraceTransactionExecute(context.Background(), traceBuilder, encManager))
+	require.Equal(t, NotAuthorised, traceBuilder.Status)
+	require.NoError(t, traceBuilder.Err)
+	require.Nil(t, traceBuilder.ReturnValue)
+
+	logsBuilder := debugTxBuilder(tx.Hash(), other)
+	require.NoError(t, DebugEventLogRelevancyExecute(context.Background(), logsBuilder, encManager))
+	require.Equal(t, NotAuthorised, logsBuilder.Status)
+	require.NoError(t, logsBuilder.Err)
+	require.Nil(t, logsBuilder.ReturnValue)
+}
+
+func TestUnknownTransactionIsNotFoundForDebugging(t *testing.T) {
+	tx, sender := newDeployTx(t)
+	encManager := newDebugEncryptionManager(&testTxStorage{included: map[gethcommon.Hash]*common.L2Tx{}}, false)
+
+	builder := debugTxBuilder(tx.Hash(), sender)
+	require.NoError(t, DebugEventLogRelevancyExecute(context.Background(), builder, encManager))
+	require.NotEqual(t, NotAuthorised, builder.Status)
+	require.ErrorContains(t, builder.Err, "not found")
+}
+
+func TestStorageFailureIsReturnedWhenAuthorisingTheDebugging(t *testing.T) {
+	tx, sender := newDeployTx(t)
+	encManager := newDebugEncryptionManager(&failingTxStorage{}, false)
+
+	builder := &CallBuilder[TraceTxReq, json.RawMessage]{Param: &TraceTxReq{TxHash: tx.Hash()}, VK: debugVK(sender)}
+	err := DebugTraceTransactionExecute(context.Background(), builder, encManager)
+	require.ErrorIs(t, err, &syserr.InternalError{})
+	require.NoError(t, builder.Err)
+}
+
+func TestUnrestrictedDebugAccessLiftsTheCheck(t *testing.T) {
+	tx, _ := newDeployTx(t)
+	encManager := newDebugEncryptionManager(&testTxStorage{included: map[gethcommon.Hash]*common.L2Tx{tx.Hash(): tx}}, true)
+
+	builder := debugTxBuilder(tx.Hash(), gethcommon.HexToAddress("0x2"))
+	authorised, err := authoriseDebugTx(builder, tx.Hash(), encManager)
+	require.NoError(t, err)
+	require.True(t, authorised)
+	require.Equal(t, NotSet, builder.Status)
+}
+
+// newDeployTx - a contract deployment, so no contract owner can debug it
+func newDeployTx(t *testing.T) (*common.L2Tx, gethcommon.Address) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tx, err := types.SignNewTx(key, types.NewLondonSigner(big.NewInt(testChainID)), &types.LegacyTx{GasPrice: big.NewInt(1), Gas: 100_000})
+	require.NoError(t, err)
+	return tx, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func newDebugEncryptionManager(txStorage storage.Storage, unrestricted bool) *EncryptionManager {
+	return &EncryptionManager{
+		storage: txStorage,
+		config:  &config.EnclaveConfig{ObscuroChainID: testChainID, DebugAccessUnrestricted: unrestricted},
+		logger:  gethlog.New(),
+	}
+}
+
+func debugTxBuilder(txHash gethcommon.Hash, account gethcommon.Address) *CallBuilder[gethcommon.Hash, json.RawMessage] {
+	return &CallBuilder[gethcommon.Hash, json.RawMessage]{Param: &txHash, VK: debugVK(account)}
+}
+
+func debugVK(account gethcommon.Address) *vkhandler.AuthenticatedViewingKey {
+	return &vkhandler.AuthenticatedViewingKey{AccountAddress: &account}
+}
@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/gethapi"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/enclave/gas"
+)
+
+func EstimateL1FeeValidate(reqParams []any, builder *CallBuilder[gethapi.TransactionArgs, common.L1FeeEstimate], _ *EncryptionManager) error {
+	// Parameters are [callMsg]
+	if len(reqParams) < 1 {
+		builder.Err = fmt.Errorf("unexpected number of parameters")
+		return nil
+	}
+
+	callMsg, err := gethencoding.ExtractEthCall(reqParams[0])
+	if err != nil {
+		builder.Err = fmt.Errorf("unable to decode EthCall Params - %w", err)
+		return nil
+	}
+
+	if callMsg.From == nil {
+		builder.Err = fmt.Errorf("no from Addr provided")
+		return nil
+	}
+
+	builder.From = callMsg.From
+	builder.Param = callMsg
+	return nil
+}
+
+func EstimateL1FeeExecute(_ context.Context, builder *CallBuilder[gethapi.TransactionArgs, common.L1FeeEstimate], rpc *EncryptionManager) error {
+	err := authenticateFrom(builder.VK, builder.From)
+	if err != nil {
+		builder.Err = err
+		return nil //nolint:nilerr
+	}
+
+	// the fee is estimated for the current known head block, the same way as in EstimateGas
+	block, err := rpc.blockResolver.FetchHeadBlock()
+	if err != nil {
+		return err
+	}
+	l1Cost, err := rpc.gasOracle.EstimateL1CostForMsg(builder.Param, block)
+	if err != nil {
+		return err
+	}
+
+	batch, err := rpc.storage.FetchHeadBatch()
+	if err != nil {
+		return err
+	}
+
+	builder.ReturnValue = &common.L1FeeEstimate{
+		L1GasPrice:    (*hexutil.Big)(gas.L1GasPriceAt(block)),
+		L1Fee:         (*hexutil.Big)(l1Cost),
+		PublishingGas: hexutil.Uint64(l1CostToGas(l1Cost, batch.Header.BaseFee).Uint64()),
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package rpc
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -8,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/gethencoding"
 )
 
@@ -40,9 +43,13 @@ func GetBalanceValidate(reqParams []any, builder *CallBuilder[BalanceReq, hexuti
 	return nil
 }
 
-func GetBalanceExecute(builder *CallBuilder[BalanceReq, hexutil.Big], rpc *EncryptionManager) error {
+func GetBalanceExecute(_ context.Context, builder *CallBuilder[BalanceReq, hexutil.Big], rpc *EncryptionManager) error {
 	acctOwner, err := rpc.chain.AccountOwner(*builder.Param.Addr, builder.Param.Block)
 	if err != nil {
+		if errors.Is(err, errutil.ErrStateUnavailable) {
+			builder.Err = err
+			return nil
+		}
 		return err
 	}
 
@@ -55,6 +62,10 @@ func GetBalanceExecute(builder *CallBuilder[BalanceReq, hexutil.Big], rpc *Encry
 
 	balance, err := rpc.chain.GetBalanceAtBlock(*builder.Param.Addr, builder.Param.Block)
 	if err != nil {
+		if errors.Is(err, errutil.ErrStateUnavailable) {
+			builder.Err = err
+			return nil
+		}
 		return fmt.Errorf("unable to get balance - %w", err)
 	}
 	builder.ReturnValue = balance
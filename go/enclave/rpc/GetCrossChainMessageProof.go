@@ -0,0 +1,48 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+func GetCrossChainMessageProofValidate(reqParams []any, builder *CallBuilder[gethcommon.Hash, common.CrossChainMessageProof], _ *EncryptionManager) error {
+	// Parameters are [MessageHash]
+	if len(reqParams) < 1 {
+		builder.Err = fmt.Errorf("unexpected number of parameters")
+		return nil
+	}
+	msgHashStr, ok := reqParams[0].(string)
+	if !ok {
+		builder.Err = fmt.Errorf("invalid message hash")
+		return nil
+	}
+
+	msgHash := gethcommon.HexToHash(msgHashStr)
+	builder.Param = &msgHash
+	return nil
+}
+
+func GetCrossChainMessageProofExecute(_ context.Context, builder *CallBuilder[gethcommon.Hash, common.CrossChainMessageProof], rpc *EncryptionManager) error {
+	proof, sender, err := rpc.processors.GetMessageProof(*builder.Param)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			builder.Status = NotFound
+			return nil
+		}
+		return fmt.Errorf("could not build the cross chain message proof. Cause: %w", err)
+	}
+
+	// only the sender of the message can withdraw it
+	if sender.Hex() != builder.VK.AccountAddress.Hex() {
+		builder.Status = NotAuthorised
+		return nil
+	}
+
+	builder.ReturnValue = proof
+	return nil
+}
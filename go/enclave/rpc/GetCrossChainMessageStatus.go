@@ -0,0 +1,44 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+func GetCrossChainMessageStatusValidate(reqParams []any, builder *CallBuilder[gethcommon.Hash, common.CrossChainMessageStatus], _ *EncryptionManager) error {
+	// Parameters are [MessageHash]
+	if len(reqParams) < 1 {
+		builder.Err = fmt.Errorf("unexpected number of parameters")
+		return nil
+	}
+	msgHashStr, ok := reqParams[0].(string)
+	if !ok {
+		builder.Err = fmt.Errorf("invalid message hash")
+		return nil
+	}
+
+	msgHash := gethcommon.HexToHash(msgHashStr)
+	builder.Param = &msgHash
+	return nil
+}
+
+func GetCrossChainMessageStatusExecute(_ context.Context, builder *CallBuilder[gethcommon.Hash, common.CrossChainMessageStatus], rpc *EncryptionManager) error {
+	status, sender, err := rpc.processors.GetMessageStatus(*builder.Param)
+	if err != nil {
+		return fmt.Errorf("could not retrieve the cross chain message status. Cause: %w", err)
+	}
+
+	// the L1->L2 messages are public on the L1, while the L2->L1 messages are only revealed to their sender
+	if !status.Inbound && status.State != common.MessageUnknown {
+		if sender == nil || sender.Hex() != builder.VK.AccountAddress.Hex() {
+			builder.Status = NotAuthorised
+			return nil
+		}
+	}
+
+	builder.ReturnValue = status
+	return nil
+}
@@ -1,10 +1,12 @@
 package rpc
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 
+	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 
 	"github.com/ethereum/go-ethereum/core/types"
@@ -31,7 +33,7 @@ func GetLogsValidate(reqParams []any, builder *CallBuilder[filters.FilterCriteri
 	return nil
 }
 
-func GetLogsExecute(builder *CallBuilder[filters.FilterCriteria, []*types.Log], rpc *EncryptionManager) error { //nolint:gocognit
+func GetLogsExecute(ctx context.Context, builder *CallBuilder[filters.FilterCriteria, []*types.Log], rpc *EncryptionManager) error { //nolint:gocognit
 	err := authenticateFrom(builder.VK, builder.From)
 	if err != nil {
 		builder.Err = err
@@ -58,9 +60,10 @@ func GetLogsExecute(builder *CallBuilder[filters.FilterCriteria, []*types.Log],
 		from = batch.Number()
 	}
 
-	// Set from to the height of the block hash
-	if from == nil && filter.BlockHash != nil {
-		batch, err := rpc.storage.FetchBatchHeader(*filter.BlockHash)
+	// the block hash is either the hash of the batch or the hash of its converted header
+	var batchHash *common.L2BatchHash
+	if filter.BlockHash != nil {
+		batch, err := rpc.fetchBatchHeader(*filter.BlockHash)
 		if err != nil {
 			if errors.Is(err, errutil.ErrNotFound) {
 				builder.Status = NotFound
@@ -68,7 +71,8 @@ func GetLogsExecute(builder *CallBuilder[filters.FilterCriteria, []*types.Log],
 			}
 			return err
 		}
-		from = batch.Number
+		hash := batch.Hash()
+		batchHash = &hash
 	}
 
 	to := filter.ToBlock
@@ -83,7 +87,7 @@ func GetLogsExecute(builder *CallBuilder[filters.FilterCriteria, []*types.Log],
 	}
 
 	// We retrieve the relevant logs that match the filter.
-	filteredLogs, err := rpc.storage.FilterLogs(builder.From, from, to, nil, filter.Addresses, filter.Topics)
+	filteredLogs, err := rpc.storage.FilterLogs(ctx, builder.From, from, to, batchHash, filter.Addresses, filter.Topics)
 	if err != nil {
 		if errors.Is(err, syserr.InternalError{}) {
 			return err
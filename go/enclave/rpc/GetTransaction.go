@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -30,12 +31,14 @@ func GetTransactionValidate(reqParams []any, builder *CallBuilder[gethcommon.Has
 	return nil
 }
 
-func GetTransactionExecute(builder *CallBuilder[gethcommon.Hash, RpcTransaction], rpc *EncryptionManager) error {
-	// Unlike in the Geth impl, we do not try and retrieve unconfirmed transactions from the mempool.
+func GetTransactionExecute(_ context.Context, builder *CallBuilder[gethcommon.Hash, RpcTransaction], rpc *EncryptionManager) error {
 	tx, blockHash, blockNumber, index, err := rpc.storage.GetTransaction(*builder.Param)
 	if err != nil {
 		if errors.Is(err, errutil.ErrNotFound) {
-			builder.Status = NotFound
+			builder.ReturnValue = pendingTransaction(*builder.Param, builder.VK.AccountAddress, rpc)
+			if builder.ReturnValue == nil {
+				builder.Status = NotFound
+			}
 			return nil
 		}
 		return err
@@ -61,6 +64,21 @@ func GetTransactionExecute(builder *CallBuilder[gethcommon.Hash, RpcTransaction]
 	return nil
 }
 
+// pendingTransaction - like in the Geth impl, the transactions of the mempool are returned without the block fields.
+// Only the sender can see its pending transactions, the others get nil, as if the transaction didn't exist.
+func pendingTransaction(txHash gethcommon.Hash, account *gethcommon.Address, rpc *EncryptionManager) *RpcTransaction {
+	tx := rpc.mempool.Get(txHash)
+	if tx == nil {
+		return nil
+	}
+	sender, err := core.GetTxSigner(tx)
+	if err != nil || sender.Hex() != account.Hex() {
+		return nil
+	}
+	signer := types.NewLondonSigner(tx.ChainId())
+	return newRPCTransaction(tx, gethcommon.Hash{}, 0, 0, nil, signer)
+}
+
 // Lifted from Geth's internal `ethapi` package.
 type RpcTransaction struct { //nolint
 	BlockHash        *gethcommon.Hash    `json:"blockHash"`
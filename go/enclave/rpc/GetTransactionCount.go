@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"context"
 	"fmt"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -43,7 +44,7 @@ func GetTransactionCountValidate(reqParams []any, builder *CallBuilder[uint64, s
 	return nil
 }
 
-func GetTransactionCountExecute(builder *CallBuilder[uint64, string], rpc *EncryptionManager) error {
+func GetTransactionCountExecute(_ context.Context, builder *CallBuilder[uint64, string], rpc *EncryptionManager) error {
 	err := authenticateFrom(builder.VK, builder.From)
 	if err != nil {
 		builder.Err = err
@@ -1,8 +1,10 @@
 package rpc
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 
@@ -13,8 +15,22 @@ import (
 	"github.com/ten-protocol/go-ten/go/enclave/events"
 )
 
-func GetTransactionReceiptValidate(reqParams []any, builder *CallBuilder[gethcommon.Hash, types.Receipt], _ *EncryptionManager) error {
-	// Parameters are [Hash]
+const (
+	// maxReceiptWait - the longest a receipt request waits for its transaction, whatever the caller asks for. It is below
+	// the default timeout of the host's enclave requests, so the host receives the answer rather than a timeout. A shorter
+	// deadline of the host ends the wait earlier, since it reaches the enclave with the request.
+	maxReceiptWait = 8 * time.Second
+	// maxReceiptWaiters - the maximum number of receipt requests waiting at the same time. The others return immediately.
+	maxReceiptWaiters = 1024
+)
+
+type ReceiptReq struct {
+	TxHash  gethcommon.Hash
+	WaitFor time.Duration // how long to wait for a transaction of the mempool to be included in a batch
+}
+
+func GetTransactionReceiptValidate(reqParams []any, builder *CallBuilder[ReceiptReq, types.Receipt], _ *EncryptionManager) error {
+	// Parameters are [Hash, optional WaitFor]
 	if len(reqParams) < 1 {
 		builder.Err = fmt.Errorf("unexpected number of parameters")
 		return nil
@@ -25,17 +41,36 @@ func GetTransactionReceiptValidate(reqParams []any, builder *CallBuilder[gethcom
 		return nil
 	}
 
-	txHash := gethcommon.HexToHash(txHashStr)
-	builder.Param = &txHash
+	builder.Param = &ReceiptReq{TxHash: gethcommon.HexToHash(txHashStr)}
+	if len(reqParams) > 1 && reqParams[1] != nil {
+		waitForStr, ok := reqParams[1].(string)
+		if !ok {
+			builder.Err = fmt.Errorf("unexpected waitFor parameter")
+			return nil
+		}
+		waitFor, err := time.ParseDuration(waitForStr)
+		if err != nil || waitFor < 0 {
+			builder.Err = fmt.Errorf("invalid waitFor duration %s", waitForStr)
+			return nil
+		}
+		builder.Param.WaitFor = waitFor
+	}
 	return nil
 }
 
-func GetTransactionReceiptExecute(builder *CallBuilder[gethcommon.Hash, types.Receipt], rpc *EncryptionManager) error {
-	txHash := *builder.Param
+func GetTransactionReceiptExecute(ctx context.Context, builder *CallBuilder[ReceiptReq, types.Receipt], rpc *EncryptionManager) error {
+	txHash := builder.Param.TxHash
 	// todo - optimise these calls. This can be done with a single sql
 	rpc.logger.Trace("Get receipt for ", log.TxKey, txHash)
 	// We retrieve the transaction.
 	tx, _, _, _, err := rpc.storage.GetTransaction(txHash) //nolint:dogsled
+	if errors.Is(err, errutil.ErrNotFound) && builder.Param.WaitFor > 0 {
+		// the execution slot is freed while the request waits, so the waiting requests do not starve the others
+		if err := parked(ctx, func() { waitForInclusion(ctx, builder, rpc) }); err != nil {
+			return err
+		}
+		tx, _, _, _, err = rpc.storage.GetTransaction(txHash) //nolint:dogsled
+	}
 	if err != nil {
 		rpc.logger.Trace("error getting tx ", log.TxKey, txHash, log.ErrKey, err)
 		if errors.Is(err, errutil.ErrNotFound) {
@@ -81,3 +116,45 @@ func GetTransactionReceiptExecute(builder *CallBuilder[gethcommon.Hash, types.Re
 	builder.ReturnValue = txReceipt
 	return nil
 }
+
+// waitForInclusion - parks the request until a batch including the transaction is executed, the wait expires, or the
+// request is aborted. The request doesn't wait when the transaction is not in the mempool, when it was not sent by the
+// caller, or when too many requests are already waiting.
+func waitForInclusion(ctx context.Context, builder *CallBuilder[ReceiptReq, types.Receipt], rpc *EncryptionManager) {
+	txHash := builder.Param.TxHash
+	tx := rpc.mempool.Get(txHash)
+	if tx == nil {
+		return
+	}
+	txSigner, err := core.GetTxSigner(tx)
+	if err != nil || txSigner.Hex() != builder.VK.AccountAddress.Hex() {
+		return
+	}
+
+	select {
+	case rpc.receiptWaitSlots <- struct{}{}:
+		defer func() { <-rpc.receiptWaitSlots }()
+	default:
+		rpc.logger.Debug("Too many receipt requests waiting", log.TxKey, txHash)
+		return
+	}
+
+	included, release := rpc.registry.NotifyOnTransaction(txHash)
+	defer release()
+	// the batch might have been executed before the notification was registered
+	if _, _, _, _, err := rpc.storage.GetTransaction(txHash); err == nil { //nolint:dogsled
+		return
+	}
+
+	waitFor := builder.Param.WaitFor
+	if waitFor > maxReceiptWait {
+		waitFor = maxReceiptWait
+	}
+	timer := time.NewTimer(waitFor)
+	defer timer.Stop()
+	select {
+	case <-included:
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
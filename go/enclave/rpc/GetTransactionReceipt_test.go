@@ -0,0 +1,100 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/vkhandler"
+)
+
+// includingRegistry - notifies the waiting requests when the channel is closed
+type includingRegistry struct {
+	components.BatchRegistry
+	included chan struct{}
+	notified chan struct{}
+}
+
+func (r *includingRegistry) NotifyOnTransaction(gethcommon.Hash) (<-chan struct{}, func()) {
+	r.notified <- struct{}{}
+	return r.included, func() {}
+}
+
+func TestWaitForInclusion(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	tx, err := types.SignNewTx(key, types.NewLondonSigner(big.NewInt(testChainID)), &types.LegacyTx{GasPrice: big.NewInt(1), Gas: 21_000})
+	require.NoError(t, err)
+
+	newWait := func() (*EncryptionManager, *includingRegistry) {
+		registry := &includingRegistry{included: make(chan struct{}), notified: make(chan struct{}, 1)}
+		return &EncryptionManager{
+			storage:          &testTxStorage{included: map[gethcommon.Hash]*common.L2Tx{}},
+			mempool:          testMempool{tx.Hash(): tx},
+			registry:         registry,
+			receiptWaitSlots: make(chan struct{}, 1),
+			logger:           gethlog.New(),
+		}, registry
+	}
+	// waits for the transaction for longer than the test, from the given account
+	wait := func(ctx context.Context, encManager *EncryptionManager, account gethcommon.Address) <-chan struct{} {
+		builder := &CallBuilder[ReceiptReq, types.Receipt]{
+			Param: &ReceiptReq{TxHash: tx.Hash(), WaitFor: time.Hour},
+			VK:    &vkhandler.AuthenticatedViewingKey{AccountAddress: &account},
+		}
+		done := make(chan struct{})
+		go func() {
+			waitForInclusion(ctx, builder, encManager)
+			close(done)
+		}()
+		return done
+	}
+	requireDone := func(done <-chan struct{}, msg string) {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal(msg)
+		}
+	}
+
+	t.Run("included", func(t *testing.T) {
+		encManager, registry := newWait()
+		done := wait(context.Background(), encManager, sender)
+		<-registry.notified
+		close(registry.included)
+		requireDone(done, "the request kept waiting after the inclusion")
+	})
+
+	t.Run("request aborted", func(t *testing.T) {
+		encManager, registry := newWait()
+		ctx, cancel := context.WithCancel(context.Background())
+		done := wait(ctx, encManager, sender)
+		<-registry.notified
+		cancel()
+		requireDone(done, "the request kept waiting after it was aborted")
+		// the slot is released
+		require.Empty(t, encManager.receiptWaitSlots)
+	})
+
+	t.Run("other sender", func(t *testing.T) {
+		encManager, registry := newWait()
+		requireDone(wait(context.Background(), encManager, gethcommon.HexToAddress("0x1")), "the request waited for the transaction of another account")
+		require.Empty(t, registry.notified)
+	})
+
+	t.Run("no slot", func(t *testing.T) {
+		encManager, registry := newWait()
+		encManager.receiptWaitSlots <- struct{}{}
+		requireDone(wait(context.Background(), encManager, sender), "the request waited without a slot")
+		require.Empty(t, registry.notified)
+	})
+}
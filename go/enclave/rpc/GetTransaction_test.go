@@ -0,0 +1,79 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/vkhandler"
+)
+
+// testTxStorage - the transactions included in a batch
+type testTxStorage struct {
+	storage.Storage
+	included map[gethcommon.Hash]*common.L2Tx
+}
+
+func (s *testTxStorage) GetTransaction(txHash common.L2TxHash) (*types.Transaction, common.L2BatchHash, uint64, uint64, error) {
+	tx, found := s.included[txHash]
+	if !found {
+		return nil, gethcommon.Hash{}, 0, 0, errutil.ErrNotFound
+	}
+	return tx, gethcommon.HexToHash("0x1"), 1, 0, nil
+}
+
+// testMempool - the transactions accepted, but not yet included in a batch
+type testMempool map[gethcommon.Hash]*common.L2Tx
+
+func (m testMempool) Get(hash gethcommon.Hash) *common.L2Tx {
+	return m[hash]
+}
+
+func TestPendingTransactionsAreReturnedToTheirSender(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	tx, err := types.SignNewTx(key, types.NewLondonSigner(big.NewInt(testChainID)), &types.LegacyTx{GasPrice: big.NewInt(1), Gas: 21_000})
+	require.NoError(t, err)
+
+	mempool := testMempool{tx.Hash(): tx}
+	txStorage := &testTxStorage{included: map[gethcommon.Hash]*common.L2Tx{}}
+	encManager := &EncryptionManager{storage: txStorage, mempool: mempool}
+
+	// the pending transaction has no block fields
+	builder := getTransaction(t, encManager, tx.Hash(), sender)
+	require.Equal(t, NotSet, builder.Status)
+	require.Equal(t, tx.Hash(), builder.ReturnValue.Hash)
+	require.Nil(t, builder.ReturnValue.BlockHash)
+	require.Nil(t, builder.ReturnValue.BlockNumber)
+	require.Nil(t, builder.ReturnValue.TransactionIndex)
+
+	// the pending transactions of the other accounts don't exist
+	builder = getTransaction(t, encManager, tx.Hash(), gethcommon.HexToAddress("0x2"))
+	require.Equal(t, NotFound, builder.Status)
+	require.Nil(t, builder.ReturnValue)
+
+	// once included, the transaction is returned with its batch
+	delete(mempool, tx.Hash())
+	txStorage.included[tx.Hash()] = tx
+	builder = getTransaction(t, encManager, tx.Hash(), sender)
+	require.Equal(t, NotSet, builder.Status)
+	require.Equal(t, gethcommon.HexToHash("0x1"), *builder.ReturnValue.BlockHash)
+	require.Equal(t, uint64(1), builder.ReturnValue.BlockNumber.ToInt().Uint64())
+}
+
+func getTransaction(t *testing.T, encManager *EncryptionManager, txHash gethcommon.Hash, account gethcommon.Address) *CallBuilder[gethcommon.Hash, RpcTransaction] {
+	builder := &CallBuilder[gethcommon.Hash, RpcTransaction]{
+		Param: &txHash,
+		VK:    &vkhandler.AuthenticatedViewingKey{AccountAddress: &account},
+	}
+	require.NoError(t, GetTransactionExecute(context.Background(), builder, encManager))
+	return builder
+}
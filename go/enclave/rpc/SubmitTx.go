@@ -1,11 +1,15 @@
 package rpc
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtxpool "github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/responses"
 )
 
 func SubmitTxValidate(reqParams []any, builder *CallBuilder[common.L2Tx, gethcommon.Hash], _ *EncryptionManager) error {
@@ -18,18 +22,23 @@ func SubmitTxValidate(reqParams []any, builder *CallBuilder[common.L2Tx, gethcom
 	return nil
 }
 
-func SubmitTxExecute(builder *CallBuilder[common.L2Tx, gethcommon.Hash], rpc *EncryptionManager) error {
+func SubmitTxExecute(_ context.Context, builder *CallBuilder[common.L2Tx, gethcommon.Hash], rpc *EncryptionManager) error {
 	if rpc.processors.Local.IsSyntheticTransaction(*builder.Param) {
 		builder.Err = fmt.Errorf("synthetic transaction coming from external rpc")
 		return nil
 	}
 
+	h := builder.Param.Hash()
 	if err := rpc.service.SubmitTransaction(builder.Param); err != nil {
-		rpc.logger.Debug("Could not submit transaction", log.TxKey, builder.Param.Hash(), log.ErrKey, err)
-		builder.Err = err
+		rpc.logger.Debug("Could not submit transaction", log.TxKey, h, log.ErrKey, err)
+		// the errors of the mempool are the geth ones, so the wallets can tell them apart by their code or their text
+		builder.Err = responses.ToUserError(err)
+		// the transaction is in the mempool or in a batch, so the client can proceed to poll for its receipt
+		if errors.Is(err, gethtxpool.ErrAlreadyKnown) {
+			builder.ReturnValue = &h
+		}
 		return nil
 	}
-	h := builder.Param.Hash()
 	builder.ReturnValue = &h
 	return nil
 }
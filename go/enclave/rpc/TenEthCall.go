@@ -1,15 +1,18 @@
 package rpc
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/gethencoding"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/responses"
 )
 
 func TenCallValidate(reqParams []any, builder *CallBuilder[CallParamsWithBlock, string], _ *EncryptionManager) error {
@@ -29,19 +32,29 @@ func TenCallValidate(reqParams []any, builder *CallBuilder[CallParamsWithBlock,
 		return nil
 	}
 
-	blkNumber, err := gethencoding.ExtractBlockNumber(reqParams[1])
+	if reqParams[1] == nil {
+		builder.Err = fmt.Errorf("unable to extract requested block number - %w", errutil.ErrNotFound)
+		return nil
+	}
+	blockNrOrHash, err := extractBlockNumberOrHash(reqParams[1])
 	if err != nil {
-		builder.Err = fmt.Errorf("unable to extract requested block number - %w", err)
+		builder.Err = fmt.Errorf("unable to extract requested block number or hash - %w", err)
 		return nil
 	}
 
 	builder.From = apiArgs.From
-	builder.Param = &CallParamsWithBlock{apiArgs, blkNumber}
+	builder.Param = &CallParamsWithBlock{callParams: apiArgs}
+	if blkNumber, ok := blockNrOrHash.Number(); ok {
+		builder.Param.block = &blkNumber
+	}
+	if blockHash, ok := blockNrOrHash.Hash(); ok {
+		builder.Param.blockHash = &blockHash
+	}
 
 	return nil
 }
 
-func TenCallExecute(builder *CallBuilder[CallParamsWithBlock, string], rpc *EncryptionManager) error {
+func TenCallExecute(ctx context.Context, builder *CallBuilder[CallParamsWithBlock, string], rpc *EncryptionManager) error {
 	err := authenticateFrom(builder.VK, builder.From)
 	if err != nil {
 		builder.Err = err
@@ -50,10 +63,36 @@ func TenCallExecute(builder *CallBuilder[CallParamsWithBlock, string], rpc *Encr
 
 	apiArgs := builder.Param.callParams
 	blkNumber := builder.Param.block
-	execResult, err := rpc.chain.ObsCall(apiArgs, blkNumber)
+	if hash := builder.Param.blockHash; hash != nil {
+		header, err := rpc.fetchBatchHeader(*hash)
+		if err != nil {
+			if errors.Is(err, errutil.ErrNotFound) {
+				builder.Err = fmt.Errorf("batch %s not found - %w", hash, err)
+				return nil
+			}
+			return fmt.Errorf("could not retrieve batch %s - %w", hash, err)
+		}
+		// the calls are executed on the state of the canonical batch at the height
+		canonical, err := rpc.storage.FetchBatchByHeight(header.Number.Uint64())
+		if err != nil {
+			return fmt.Errorf("could not retrieve the canonical batch at height %d - %w", header.Number, err)
+		}
+		if canonical.Hash() != header.Hash() {
+			builder.Err = fmt.Errorf("batch %s is not canonical", hash)
+			return nil
+		}
+		batchNumber := gethrpc.BlockNumber(header.Number.Int64())
+		blkNumber = &batchNumber
+	}
+	execResult, err := rpc.chain.ObsCall(ctx, apiArgs, blkNumber)
 	if err != nil {
 		rpc.logger.Debug("Failed eth_call.", log.ErrKey, err)
 
+		if errors.Is(err, errutil.ErrStateUnavailable) || abortedByExecutionLimits(err) {
+			builder.Err = err
+			return nil
+		}
+
 		// return system errors to the host
 		if errors.Is(err, syserr.InternalError{}) {
 			return err
@@ -64,7 +103,7 @@ func TenCallExecute(builder *CallBuilder[CallParamsWithBlock, string], rpc *Encr
 		if err == nil {
 			err = fmt.Errorf(string(evmErr))
 		}
-		builder.Err = err
+		builder.Err = responses.WithCode(responses.ErrCodeExecutionReverted, err)
 		return nil
 	}
 
@@ -76,6 +115,12 @@ func TenCallExecute(builder *CallBuilder[CallParamsWithBlock, string], rpc *Encr
 	return nil
 }
 
+// abortedByExecutionLimits - whether the execution requested by the user was aborted for running for too long or for
+// using too much memory, rather than failed in the EVM
+func abortedByExecutionLimits(err error) bool {
+	return errors.Is(err, errutil.ErrExecutionTimeout) || errors.Is(err, errutil.ErrExecutionMemoryCapExceeded)
+}
+
 func serializeEVMError(err error) ([]byte, error) {
 	var errReturn interface{}
 
@@ -0,0 +1,122 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/ten-protocol/go-ten/go/enclave/metrics"
+	"github.com/ten-protocol/go-ten/go/responses"
+	"golang.org/x/sync/semaphore"
+)
+
+// ErrServerBusy - returned to the users whose request cannot even wait to be executed
+var ErrServerBusy = responses.WithCode(responses.ErrCodeServerBusy, errors.New("server busy, too many requests in progress"))
+
+// requestWeights - the execution slots taken by the requests which run the EVM, which are the most expensive. The other
+// requests take one slot. The transactions submitted by the users take none, so they are always admitted, as are the
+// consensus operations (e.g. SubmitBatch, SubmitL1Block, CreateBatch), which do not go through WithVKEncryption.
+// The requests which wait, e.g. for the inclusion of a transaction, do not hold their slots while they are parked.
+var requestWeights = map[string]int64{
+	"submitTx":              0,
+	"call":                  2,
+	"estimateGas":           4,
+	"debugTraceTransaction": 4,
+}
+
+// admissionController - bounds the weight of the user requests executed at the same time. The requests which find no
+// free slot wait in the order in which they arrived, and are refused when the queue is full.
+type admissionController struct {
+	slots     *semaphore.Weighted // nil when the concurrency is unbounded
+	capacity  int64
+	queueSize int64
+	queued    atomic.Int64
+	metrics   *metrics.Registry
+}
+
+func newAdmissionController(maxConcurrency uint64, queueSize uint64, metricsRegistry *metrics.Registry) *admissionController {
+	a := &admissionController{capacity: int64(maxConcurrency), queueSize: int64(queueSize), metrics: metricsRegistry}
+	if maxConcurrency > 0 {
+		a.slots = semaphore.NewWeighted(a.capacity)
+	}
+	metricsRegistry.GaugeFunc("enclave/rpc/admission/queued", a.queued.Load)
+	return a
+}
+
+// admission - the slots held by an admitted request. It is only used by the request, so it needs no synchronisation.
+type admission struct {
+	controller *admissionController
+	weight     int64
+	held       bool
+}
+
+type admissionKey struct{}
+
+// admit - waits for the slots of the request, until the context is cancelled. They are released by the admission.
+func (a *admissionController) admit(ctx context.Context, method string) (*admission, error) {
+	weight := a.weight(method)
+	if a.slots == nil || weight == 0 {
+		return &admission{}, nil
+	}
+	admitted := &admission{controller: a, weight: weight, held: true}
+	// it fails when other requests are waiting, so the request cannot overtake them
+	if a.slots.TryAcquire(weight) {
+		return admitted, nil
+	}
+
+	if a.queued.Add(1) > a.queueSize {
+		a.queued.Add(-1)
+		a.metrics.Counter("enclave/rpc/admission/rejected").Inc(1)
+		return nil, ErrServerBusy
+	}
+	defer a.queued.Add(-1)
+	defer a.metrics.Timer("enclave/rpc/admission/wait").UpdateSince(time.Now())
+	if err := a.slots.Acquire(ctx, weight); err != nil {
+		return nil, err
+	}
+	return admitted, nil
+}
+
+// release - frees the slots of the request
+func (ad *admission) release() {
+	if ad.held {
+		ad.controller.slots.Release(ad.weight)
+		ad.held = false
+	}
+}
+
+// withAdmission - the context of the request executed with the admission
+func withAdmission(ctx context.Context, ad *admission) context.Context {
+	return context.WithValue(ctx, admissionKey{}, ad)
+}
+
+// parked - calls wait without holding the slots of the request, then waits for them again, behind the requests already
+// waiting. The queue is not bounded for the request, as it was already admitted. An error is returned when the context
+// is cancelled before the slots are taken again.
+func parked(ctx context.Context, wait func()) error {
+	ad, found := ctx.Value(admissionKey{}).(*admission)
+	if !found || !ad.held {
+		wait()
+		return nil
+	}
+	ad.release()
+	wait()
+	if err := ad.controller.slots.Acquire(ctx, ad.weight); err != nil {
+		return err
+	}
+	ad.held = true
+	return nil
+}
+
+// weight - at most the capacity, or the request would never be admitted
+func (a *admissionController) weight(method string) int64 {
+	weight, found := requestWeights[method]
+	if !found {
+		weight = 1
+	}
+	if weight > a.capacity {
+		return a.capacity
+	}
+	return weight
+}
@@ -0,0 +1,218 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/rpc"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/metrics"
+	"github.com/ten-protocol/go-ten/go/responses"
+)
+
+// blockingExecutions - executions which record the order in which they start, and block until they are released one by one
+type blockingExecutions struct {
+	started []int
+	running atomic.Int64
+	peak    atomic.Int64
+	lock    sync.Mutex
+	release chan struct{}
+}
+
+func (b *blockingExecutions) execute(i int) func(context.Context, *CallBuilder[any, any], *EncryptionManager) error {
+	return func(context.Context, *CallBuilder[any, any], *EncryptionManager) error {
+		running := b.running.Add(1)
+		b.lock.Lock()
+		b.started = append(b.started, i)
+		if running > b.peak.Load() {
+			b.peak.Store(running)
+		}
+		b.lock.Unlock()
+		<-b.release
+		b.running.Add(-1)
+		return nil
+	}
+}
+
+func (b *blockingExecutions) startedCount() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return len(b.started)
+}
+
+func TestConcurrentRequestsAreAdmittedInOrder(t *testing.T) {
+	const slots, calls, overflow = 16, 500, 20
+	encManager, vk := setupEncryptionManager(t)
+	encManager.metrics = metrics.New(true)
+	encManager.admission = newAdmissionController(slots, calls-slots-overflow, encManager.metrics)
+	validate := func([]any, *CallBuilder[any, any], *EncryptionManager) error { return nil }
+	executions := &blockingExecutions{release: make(chan struct{})}
+
+	call := func(i int, method string) *responses.EnclaveResponse {
+		encReq := encryptRequest(t, encManager, &rpc.RequestWithVk{VK: rpcVK(vk)})
+		resp, sysErr := WithVKEncryption(context.Background(), encManager, method, encReq, validate, executions.execute(i))
+		require.Nil(t, sysErr)
+		return resp
+	}
+
+	// the requests are sent one after the other, so the order in which they wait is known
+	var wg sync.WaitGroup
+	for i := 0; i < calls-overflow; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.Nil(t, call(i, "getBalance").Error())
+		}()
+		require.Eventually(t, func() bool {
+			return executions.startedCount()+int(encManager.admission.queued.Load()) == i+1
+		}, 5*time.Second, time.Millisecond)
+	}
+	require.Equal(t, slots, executions.startedCount())
+
+	// the queue is full
+	for i := 0; i < overflow; i++ {
+		err := userError(t, call(calls-overflow+i, "getBalance"), vk)
+		require.Equal(t, responses.ErrCodeServerBusy, responses.ErrorCodeOf(err))
+		require.ErrorContains(t, err, "server busy")
+	}
+	require.Contains(t, string(encManager.metrics.Export()), "enclave_rpc_admission_queued 464")
+	require.Contains(t, string(encManager.metrics.Export()), "enclave_rpc_admission_rejected 20")
+
+	// each finished execution admits the request which waited the longest
+	for released := 0; released < calls-overflow-slots; released++ {
+		executions.release <- struct{}{}
+		require.Eventually(t, func() bool {
+			return executions.startedCount() == slots+released+1
+		}, 5*time.Second, time.Millisecond)
+	}
+	close(executions.release)
+	wg.Wait()
+
+	for i, started := range executions.started {
+		require.Equal(t, i, started)
+	}
+	require.Equal(t, int64(slots), executions.peak.Load())
+	require.Contains(t, string(encManager.metrics.Export()), "enclave_rpc_admission_queued 0")
+}
+
+func TestAdmissionIsWeightedByMethod(t *testing.T) {
+	encManager, vk := setupEncryptionManager(t)
+	encManager.admission = newAdmissionController(16, 0, nil)
+	validate := func([]any, *CallBuilder[any, any], *EncryptionManager) error { return nil }
+	executions := &blockingExecutions{release: make(chan struct{})}
+	defer close(executions.release)
+
+	call := func(method string, execute func(context.Context, *CallBuilder[any, any], *EncryptionManager) error) error {
+		encReq := encryptRequest(t, encManager, &rpc.RequestWithVk{VK: rpcVK(vk)})
+		resp, _ := WithVKEncryption(context.Background(), encManager, method, encReq, validate, execute)
+		if resp.Error() != nil || resp.EncUserResponse == nil {
+			return resp.Error()
+		}
+		decrypted, err := vk.PrivateKey.Decrypt(resp.EncUserResponse, nil, nil)
+		require.NoError(t, err)
+		_, err = responses.DecodeResponse[any](decrypted)
+		return err
+	}
+
+	// the gas estimations run the EVM many times, so only 4 of them fit in the 16 slots
+	for i := 0; i < 4; i++ {
+		go call("estimateGas", executions.execute(i)) //nolint:errcheck
+	}
+	require.Eventually(t, func() bool { return executions.startedCount() == 4 }, 5*time.Second, time.Millisecond)
+	err := call("estimateGas", executions.execute(4))
+	require.Equal(t, responses.ErrCodeServerBusy, responses.ErrorCodeOf(err))
+	err = call("getBalance", executions.execute(4))
+	require.Equal(t, responses.ErrCodeServerBusy, responses.ErrorCodeOf(err))
+
+	// the transactions are admitted even when the slots are taken
+	noop := func(context.Context, *CallBuilder[any, any], *EncryptionManager) error { return nil }
+	require.NoError(t, call("submitTx", noop))
+}
+
+func TestQueuedRequestIsAbortedByItsTimeout(t *testing.T) {
+	encManager, vk := setupEncryptionManager(t)
+	encManager.admission = newAdmissionController(1, 1, nil)
+	encManager.config.RequestTimeout = 50 * time.Millisecond
+	validate := func([]any, *CallBuilder[any, any], *EncryptionManager) error { return nil }
+	executions := &blockingExecutions{release: make(chan struct{})}
+	defer close(executions.release)
+
+	go func() {
+		encReq := encryptRequest(t, encManager, &rpc.RequestWithVk{VK: rpcVK(vk)})
+		WithVKEncryption(context.Background(), encManager, "getBalance", encReq, validate, executions.execute(0)) //nolint:errcheck
+	}()
+	require.Eventually(t, func() bool { return executions.startedCount() == 1 }, 5*time.Second, time.Millisecond)
+
+	encReq := encryptRequest(t, encManager, &rpc.RequestWithVk{VK: rpcVK(vk)})
+	resp, sysErr := WithVKEncryption(context.Background(), encManager, "getBalance", encReq, validate, executions.execute(1))
+	require.Nil(t, sysErr)
+	require.Equal(t, responses.ErrCodeTimeout, responses.ErrorCodeOf(userError(t, resp, vk)))
+	require.Zero(t, encManager.admission.queued.Load())
+}
+
+// waitingRegistry - never includes the transactions, and signals when a request starts waiting for one
+type waitingRegistry struct {
+	components.BatchRegistry
+	waiting chan struct{}
+}
+
+func (r *waitingRegistry) NotifyOnTransaction(gethcommon.Hash) (<-chan struct{}, func()) {
+	r.waiting <- struct{}{}
+	return make(chan struct{}), func() {}
+}
+
+func TestReceiptRequestDoesNotHoldItsSlotWhileWaiting(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tx, err := types.SignNewTx(key, types.NewLondonSigner(big.NewInt(testChainID)), &types.LegacyTx{GasPrice: big.NewInt(1), Gas: 21_000})
+	require.NoError(t, err)
+
+	encManager, vk := setupEncryptionManagerForAccount(t, key)
+	encManager.admission = newAdmissionController(1, 0, nil)
+	encManager.storage = &testTxStorage{included: map[gethcommon.Hash]*common.L2Tx{}}
+	encManager.mempool = testMempool{tx.Hash(): tx}
+	encManager.receiptWaitSlots = make(chan struct{}, 1)
+	registry := &waitingRegistry{waiting: make(chan struct{})}
+	encManager.registry = registry
+	validate := func([]any, *CallBuilder[any, any], *EncryptionManager) error { return nil }
+	executions := &blockingExecutions{release: make(chan struct{})}
+
+	receiptResp := make(chan *responses.EnclaveResponse)
+	go func() {
+		encReq := encryptRequest(t, encManager, &rpc.RequestWithVk{VK: rpcVK(vk), Params: []any{tx.Hash().Hex(), "100ms"}})
+		resp, _ := WithVKEncryption(context.Background(), encManager, "getTransactionReceipt", encReq, GetTransactionReceiptValidate, GetTransactionReceiptExecute)
+		receiptResp <- resp
+	}()
+	<-registry.waiting
+
+	// the only slot is free while the receipt request waits
+	go func() {
+		encReq := encryptRequest(t, encManager, &rpc.RequestWithVk{VK: rpcVK(vk)})
+		WithVKEncryption(context.Background(), encManager, "getBalance", encReq, validate, executions.execute(0)) //nolint:errcheck
+	}()
+	require.Eventually(t, func() bool { return executions.startedCount() == 1 }, 5*time.Second, time.Millisecond)
+
+	// once the wait is over, the receipt request needs the slot again
+	select {
+	case <-receiptResp:
+		t.Fatal("the receipt request completed without its slot")
+	case <-time.After(300 * time.Millisecond):
+	}
+	close(executions.release)
+	select {
+	case resp := <-receiptResp:
+		require.Nil(t, resp.Error())
+	case <-time.After(5 * time.Second):
+		t.Fatal("the receipt request did not complete")
+	}
+}
@@ -1,24 +1,41 @@
 package rpc
 
 import (
+	"context"
 	"fmt"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
 	"github.com/ten-protocol/go-ten/go/config"
 	"github.com/ten-protocol/go-ten/go/enclave/gas"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/enclave/components"
 	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
+	"github.com/ten-protocol/go-ten/go/enclave/debugger"
 	"github.com/ten-protocol/go-ten/go/enclave/l2chain"
+	"github.com/ten-protocol/go-ten/go/enclave/metrics"
 	"github.com/ten-protocol/go-ten/go/enclave/nodetype"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/responses"
 
 	"github.com/ethereum/go-ethereum/crypto/ecies"
 )
 
+// PendingTxs - the transactions accepted by the mempool, but not yet included in a batch
+type PendingTxs interface {
+	// Get returns the pending transaction with the given hash, or nil if there is none
+	Get(hash gethcommon.Hash) *common.L2Tx
+}
+
 // EncryptionManager manages the decryption and encryption of enclave comms.
 type EncryptionManager struct {
 	chain                  l2chain.ObscuroChain
+	debugger               *debugger.Debugger
 	enclavePrivateKeyECIES *ecies.PrivateKey
 	storage                storage.Storage
 	registry               components.BatchRegistry
@@ -26,25 +43,86 @@ type EncryptionManager struct {
 	service                nodetype.NodeType
 	gasOracle              gas.Oracle
 	blockResolver          storage.BlockResolver
+	gethEncoding           gethencoding.EncodingService
+	mempool                PendingTxs
+	receiptWaitSlots       chan struct{} // bounds the number of receipt requests waiting for their transaction
+	admission              *admissionController
 	config                 *config.EnclaveConfig
+	metrics                *metrics.Registry
+	stopControl            *stopcontrol.StopControl // the requests are aborted when the enclave stops
 	logger                 gethlog.Logger
 }
 
-func NewEncryptionManager(enclavePrivateKeyECIES *ecies.PrivateKey, storage storage.Storage, registry components.BatchRegistry, processors *crosschain.Processors, service nodetype.NodeType, config *config.EnclaveConfig, oracle gas.Oracle, blockResolver storage.BlockResolver, chain l2chain.ObscuroChain, logger gethlog.Logger) *EncryptionManager {
+func NewEncryptionManager(enclavePrivateKeyECIES *ecies.PrivateKey, storage storage.Storage, registry components.BatchRegistry, processors *crosschain.Processors, service nodetype.NodeType, config *config.EnclaveConfig, oracle gas.Oracle, blockResolver storage.BlockResolver, gethEncoding gethencoding.EncodingService, mempool PendingTxs, chain l2chain.ObscuroChain, debugger *debugger.Debugger, metricsRegistry *metrics.Registry, stopControl *stopcontrol.StopControl, logger gethlog.Logger) *EncryptionManager {
 	return &EncryptionManager{
 		storage:                storage,
 		registry:               registry,
 		processors:             processors,
 		service:                service,
 		chain:                  chain,
+		debugger:               debugger,
 		config:                 config,
 		blockResolver:          blockResolver,
+		gethEncoding:           gethEncoding,
+		mempool:                mempool,
+		receiptWaitSlots:       make(chan struct{}, maxReceiptWaiters),
+		admission:              newAdmissionController(config.RPCMaxConcurrency, config.RPCQueueSize, metricsRegistry),
 		gasOracle:              oracle,
+		metrics:                metricsRegistry,
+		stopControl:            stopControl,
 		logger:                 logger,
 		enclavePrivateKeyECIES: enclavePrivateKeyECIES,
 	}
 }
 
+// requestContext - the context of a user request, cancelled when the enclave stops or when the request timeout expires
+func (rpc *EncryptionManager) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := rpc.stopControl.WithContext(ctx)
+	if rpc.config.RequestTimeout == 0 {
+		return ctx, cancel
+	}
+	timeoutCtx, cancelTimeout := context.WithTimeout(ctx, rpc.config.RequestTimeout)
+	return timeoutCtx, func() {
+		cancelTimeout()
+		cancel()
+	}
+}
+
+// abortedRequestErr - the error returned to the user whose request was cancelled
+func (rpc *EncryptionManager) abortedRequestErr(ctx context.Context) error {
+	if rpc.stopControl.IsStopping() {
+		return errutil.ErrShuttingDown
+	}
+	return fmt.Errorf("request aborted - %w", ctx.Err())
+}
+
+// logFailure - records the failed user request under the ID chosen by the caller. The error itself is not logged, as it
+// can quote the decrypted parameters, only its code. The failures of the enclave are warnings, the ones of the user are
+// not.
+func (rpc *EncryptionManager) logFailure(method string, requestID string, stage string, code responses.ErrorCode) {
+	if code.IsSystemError() {
+		rpc.logger.Warn("User request failed", "method", method, log.RequestIDKey, requestID, "stage", stage, "code", code)
+		return
+	}
+	rpc.logger.Info("User request failed", "method", method, log.RequestIDKey, requestID, "stage", stage, "code", code)
+}
+
+// internalErr - the internal errors are logged by the caller of the enclave, so they carry the ID of the request
+func internalErr(requestID string, err error) error {
+	if requestID == "" {
+		return responses.ToInternalError(err)
+	}
+	return responses.ToInternalError(fmt.Errorf("request %s failed - %w", requestID, err))
+}
+
+// padding - the buckets to which the encrypted user responses are padded
+func (rpc *EncryptionManager) padding() responses.Padding {
+	return responses.Padding{
+		MinBucket: rpc.config.ResponsePaddingMinBucket,
+		MaxBucket: rpc.config.ResponsePaddingMaxBucket,
+	}
+}
+
 // DecryptBytes decrypts the bytes with the enclave's private key.
 func (rpc *EncryptionManager) DecryptBytes(encryptedBytes []byte) ([]byte, error) {
 	bytes, err := rpc.enclavePrivateKeyECIES.Decrypt(encryptedBytes, nil, nil)
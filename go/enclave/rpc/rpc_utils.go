@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"encoding/json"
 	"fmt"
 
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
@@ -27,4 +28,30 @@ func ExtractTx(txBinary string) (*common.L2Tx, error) {
 type CallParamsWithBlock struct {
 	callParams *gethapi.TransactionArgs
 	block      *gethrpc.BlockNumber
+	blockHash  *gethcommon.Hash // set instead of the block when the call is on the state of a batch given by hash
+}
+
+// extractBlockNumberOrHash - the block parameter is a block number or tag, a block hash, or an object with either of
+// them (EIP-1898)
+func extractBlockNumberOrHash(param any) (*gethrpc.BlockNumberOrHash, error) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		return nil, err
+	}
+	var blockNrOrHash gethrpc.BlockNumberOrHash
+	if err = blockNrOrHash.UnmarshalJSON(paramJSON); err != nil {
+		return nil, err
+	}
+	return &blockNrOrHash, nil
+}
+
+// fetchBatchHeader - the header of the batch with the hash, which is either the hash of the batch or the block hash
+// seen by the standard tooling, i.e. the hash of the Ethereum header the batch is converted to.
+// errutil.ErrNotFound is returned when neither is known.
+func (rpc *EncryptionManager) fetchBatchHeader(hash gethcommon.Hash) (*common.BatchHeader, error) {
+	batchHash, _, err := rpc.gethEncoding.ConvertBatchHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	return rpc.storage.FetchBatchHeader(batchHash)
 }
@@ -1,9 +1,11 @@
 package rpc
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ten-protocol/go-ten/go/common/rpc"
 
@@ -16,7 +18,7 @@ import (
 // ResourceStatus used as Status for the UserRPCRequests
 type ResourceStatus int
 
-var errInt = errors.New("internal error")
+var errInt = responses.WithCode(responses.ErrCodeInternal, errors.New("internal error"))
 
 const (
 	NotSet        ResourceStatus = iota // after initialisation
@@ -38,56 +40,104 @@ type CallBuilder[P any, R any] struct {
 // WithVKEncryption - handles the decryption, VK, and encryption
 // P - the type of the temporary parameter calculated after phase 1
 // R - the type of the result
+// method - the name of the request in the metrics
 // validate - extract and validate the arguments
-// execute - execute the user call only after authorising. Make sure to return a default value that makes sense in case of NotAuthorised
+// execute - execute the user call only after authorising. The context is cancelled when the enclave stops or the request
+// times out, in which case the result of the execution is discarded and the user gets an error. Make sure to return a default value that makes sense in case of NotAuthorised
 // note - authorisation is specific to each call
 // e.g. - "getTransaction" or "getBalance" have to perform authorisation
 // "Ten_call" , "Estimate_Gas" - have to authenticate the "From" - which will be used by the EVM
 func WithVKEncryption[P any, R any](
+	ctx context.Context,
 	encManager *EncryptionManager,
+	method string,
 	encReq []byte, // encrypted request that contains a signed viewing key
 	validate func([]any, *CallBuilder[P, R], *EncryptionManager) error,
-	execute func(*CallBuilder[P, R], *EncryptionManager) error,
+	execute func(context.Context, *CallBuilder[P, R], *EncryptionManager) error,
 ) (*responses.EnclaveResponse, common.SystemError) {
+	defer encManager.metrics.Timer("enclave/rpc/" + method).UpdateSince(time.Now())
+
+	ctx, cancel := encManager.requestContext(ctx)
+	defer cancel()
+
 	// 1. Decrypt request
 	plaintextRequest, err := encManager.DecryptBytes(encReq)
 	if err != nil {
-		return responses.AsPlaintextError(fmt.Errorf("could not decrypt params - %w", err)), nil
+		return responses.AsPlaintextError(responses.WithCode(responses.ErrCodeInvalidParams, fmt.Errorf("could not decrypt params - %w", err))), nil
 	}
 
 	// 2. Unmarshall
 	var decodedRequest rpc.RequestWithVk
 	if err := json.Unmarshal(plaintextRequest, &decodedRequest); err != nil {
-		return responses.AsPlaintextError(fmt.Errorf("could not unmarshal params - %w", err)), nil
+		return responses.AsPlaintextError(responses.WithCode(responses.ErrCodeInvalidParams, fmt.Errorf("could not unmarshal params - %w", err))), nil
+	}
+
+	// the ID is the only part of the request which is logged
+	requestID := decodedRequest.RequestID
+	if err := rpc.ValidateRequestID(requestID); err != nil {
+		return responses.AsPlaintextError(responses.WithCode(responses.ErrCodeInvalidParams, fmt.Errorf("invalid request - %w", err))), nil
 	}
 
 	// 3. Verify the VK
 	if decodedRequest.VK == nil {
-		return responses.AsPlaintextError(fmt.Errorf("invalid request. viewing key is missing")), nil
+		return responses.AsPlaintextError(responses.WithCode(responses.ErrCodeUnauthorised, fmt.Errorf("invalid request. viewing key is missing"))), nil
 	}
-	vk, err := vkhandler.VerifyViewingKey(decodedRequest.VK, encManager.config.ObscuroChainID)
+	authenticatedVK, err := vkhandler.VerifyViewingKey(decodedRequest.VK, encManager.config.ObscuroChainID)
 	if err != nil {
-		return responses.AsPlaintextError(fmt.Errorf("invalid viewing key - %w", err)), nil
+		encManager.logFailure(method, requestID, "authentication", responses.ErrCodeUnauthorised)
+		return responses.AsPlaintextError(responses.WithCode(responses.ErrCodeUnauthorised, fmt.Errorf("invalid viewing key - %w", err))), nil
 	}
+	// the responses are padded so their size does not reveal their content
+	vk := responses.WithRequestID(responses.WithPadding(authenticatedVK, encManager.padding()), requestID)
 
 	// 4. Call the function that knows how to validate the request
-	builder := &CallBuilder[P, R]{Status: NotSet, VK: vk}
+	builder := &CallBuilder[P, R]{Status: NotSet, VK: authenticatedVK}
 
 	err = validate(decodedRequest.Params, builder, encManager)
 	if err != nil {
-		return responses.AsPlaintextError(errInt), responses.ToInternalError(err)
+		encManager.metrics.Counter("enclave/rpc/" + method + "/failures").Inc(1)
+		encManager.logFailure(method, requestID, "validation", responses.ErrCodeInternal)
+		return responses.AsPlaintextError(errInt), internalErr(requestID, err)
 	}
 	if builder.Err != nil {
-		return responses.AsEncryptedError(builder.Err, vk), nil //nolint:nilerr
+		// the requests which fail validation have invalid parameters, unless the error is more specific
+		userErr := responses.WithDefaultCode(responses.ErrCodeInvalidParams, builder.Err)
+		encManager.logFailure(method, requestID, "validation", responses.ErrorCodeOf(userErr))
+		return responses.AsEncryptedError(userErr, vk), nil //nolint:nilerr
 	}
 
-	// 5. Execute the authorisation and call
+	// 5. Wait for the execution slots, so the user requests do not starve the production of the batches
+	admitted, err := encManager.admission.admit(ctx, method)
+	if err != nil {
+		encManager.metrics.Counter("enclave/rpc/" + method + "/failures").Inc(1)
+		if ctx.Err() != nil {
+			err = encManager.abortedRequestErr(ctx)
+		}
+		encManager.logFailure(method, requestID, "admission", responses.ErrorCodeOf(err))
+		return responses.AsEncryptedError(err, vk), nil
+	}
+	defer admitted.release()
+	ctx = withAdmission(ctx, admitted)
+
+	// 6. Execute the authorisation and call
 	// Note - it is the responsibility of this function to check that the authenticated address is authorised to view the data
-	err = execute(builder, encManager)
+	err = execute(ctx, builder, encManager)
+	if ctx.Err() != nil {
+		encManager.metrics.Counter("enclave/rpc/" + method + "/failures").Inc(1)
+		abortErr := encManager.abortedRequestErr(ctx)
+		encManager.logFailure(method, requestID, "execution", responses.ErrorCodeOf(abortErr))
+		return responses.AsEncryptedError(abortErr, vk), nil
+	}
 	if err != nil {
-		return responses.AsPlaintextError(errInt), responses.ToInternalError(err)
+		encManager.metrics.Counter("enclave/rpc/" + method + "/failures").Inc(1)
+		encManager.logFailure(method, requestID, "execution", responses.ErrCodeInternal)
+		return responses.AsPlaintextError(errInt), internalErr(requestID, err)
 	}
 	if builder.Err != nil {
+		encManager.logFailure(method, requestID, "execution", responses.ErrorCodeOf(builder.Err))
+		if builder.ReturnValue != nil {
+			return responses.AsEncryptedErrorWithResult[R](builder.ReturnValue, builder.Err, vk), nil //nolint:nilerr
+		}
 		return responses.AsEncryptedError(builder.Err, vk), nil //nolint:nilerr
 	}
 	if builder.Status == NotFound {
@@ -99,7 +149,7 @@ func WithVKEncryption[P any, R any](
 	if builder.Status == NotAuthorised {
 		// if the requested resource was not found, return an empty response
 		// todo - this must be encrypted - but we have some logic that expects it unencrypted, which is a bug
-		return responses.AsEncryptedError(errors.New("not authorised"), vk), nil
+		return responses.AsEncryptedError(responses.WithCode(responses.ErrCodeUnauthorised, errors.New("not authorised")), vk), nil
 	}
 
 	return responses.AsEncryptedResponse[R](builder.ReturnValue, vk), nil
@@ -107,7 +157,7 @@ func WithVKEncryption[P any, R any](
 
 func authenticateFrom(vk *vkhandler.AuthenticatedViewingKey, from *gethcommon.Address) error {
 	if from == nil || from.Hex() != vk.AccountAddress.Hex() {
-		return fmt.Errorf("failed authentication. Account: %s does not match the from: %s", vk.AccountAddress, from)
+		return responses.WithCode(responses.ErrCodeUnauthorised, fmt.Errorf("failed authentication. Account: %s does not match the from: %s", vk.AccountAddress, from))
 	}
 	return nil
 }
@@ -0,0 +1,397 @@
+package rpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core"
+	gethtxpool "github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/ecies"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/rpc"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/common/viewingkey"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
+	"github.com/ten-protocol/go-ten/go/enclave/nodetype"
+	"github.com/ten-protocol/go-ten/go/enclave/txpool"
+	"github.com/ten-protocol/go-ten/go/responses"
+	"github.com/ten-protocol/go-ten/go/wallet"
+)
+
+const testChainID = 443
+
+// the endpoint which is called, with the parameters and the execution result which cause the failure
+type failureMode struct {
+	params  []any
+	execute func(*CallBuilder[any, any]) error
+	code    responses.ErrorCode
+}
+
+func TestErrorCodes(t *testing.T) {
+	encManager, vk := setupEncryptionManager(t)
+	notExecuted := func(*CallBuilder[any, any]) error { panic("unexpected execution") }
+	failedWith := func(err error) func(*CallBuilder[any, any]) error {
+		return func(builder *CallBuilder[any, any]) error {
+			builder.Err = err
+			return nil
+		}
+	}
+
+	// the validation of the endpoints is called as it is, the execution failures are simulated
+	tests := map[string]struct {
+		validate func([]any, *CallBuilder[any, any], *EncryptionManager) error
+		failures map[string]failureMode
+	}{
+		"getBalance": {
+			validate: adaptValidate(GetBalanceValidate),
+			failures: map[string]failureMode{
+				"missing parameter":    {[]any{gethcommon.Address{}.Hex()}, notExecuted, responses.ErrCodeInvalidParams},
+				"invalid block number": {[]any{gethcommon.Address{}.Hex(), "not a block"}, notExecuted, responses.ErrCodeInvalidParams},
+				"other account": {[]any{gethcommon.Address{}.Hex(), "latest"}, func(builder *CallBuilder[any, any]) error {
+					builder.Status = NotAuthorised
+					return nil
+				}, responses.ErrCodeUnauthorised},
+				"internal error": {[]any{gethcommon.Address{}.Hex(), "latest"}, func(*CallBuilder[any, any]) error {
+					return errors.New("db failure")
+				}, responses.ErrCodeInternal},
+			},
+		},
+		"call": {
+			validate: adaptValidate(TenCallValidate),
+			failures: map[string]failureMode{
+				"missing from": {[]any{map[string]any{"to": gethcommon.Address{}.Hex()}, "latest"}, notExecuted, responses.ErrCodeInvalidParams},
+				"other account": {[]any{map[string]any{"from": gethcommon.Address{}.Hex()}, "latest"}, func(builder *CallBuilder[any, any]) error {
+					builder.Err = authenticateFrom(builder.VK, builder.From)
+					return nil
+				}, responses.ErrCodeUnauthorised},
+				"reverted": {[]any{map[string]any{"from": gethcommon.Address{}.Hex()}, "latest"},
+					failedWith(responses.WithCode(responses.ErrCodeExecutionReverted, errors.New(`{"Err":"execution reverted"}`))), responses.ErrCodeExecutionReverted},
+				"shutting down": {[]any{map[string]any{"from": gethcommon.Address{}.Hex()}, "latest"},
+					failedWith(errutil.ErrShuttingDown), responses.ErrCodeUnavailable},
+			},
+		},
+		"getTransactionReceipt": {
+			validate: adaptValidate(GetTransactionReceiptValidate),
+			failures: map[string]failureMode{
+				"missing hash":    {[]any{}, notExecuted, responses.ErrCodeInvalidParams},
+				"invalid waitFor": {[]any{gethcommon.Hash{}.Hex(), "soon"}, notExecuted, responses.ErrCodeInvalidParams},
+				"not found": {[]any{gethcommon.Hash{}.Hex()},
+					failedWith(fmt.Errorf("could not retrieve the receipt - %w", errutil.ErrNotFound)), responses.ErrCodeNotFound},
+			},
+		},
+		"sendRawTransaction": {
+			validate: adaptValidate(SubmitTxValidate),
+			failures: map[string]failureMode{
+				"invalid transaction": {[]any{"0x1234"}, notExecuted, responses.ErrCodeInvalidParams},
+				"nonce too low": {[]any{signedTx(t)},
+					failedWith(fmt.Errorf("%w: next nonce 2, tx nonce 1", gethcore.ErrNonceTooLow)), responses.ErrCodeNonceTooLow},
+				"underpriced": {[]any{signedTx(t)},
+					failedWith(responses.ToUserError(gethtxpool.ErrReplaceUnderpriced)), responses.ErrCodeUnderpriced},
+				"sender allowance reached": {[]any{signedTx(t)},
+					failedWith(responses.ToUserError(fmt.Errorf("%w - sender has reached the limit", txpool.ErrTxPoolFull))), responses.ErrCodeRateLimited},
+			},
+		},
+	}
+
+	for endpoint, test := range tests {
+		for name, failure := range test.failures {
+			t.Run(endpoint+"/"+name, func(t *testing.T) {
+				encReq := encryptRequest(t, encManager, &rpc.RequestWithVk{VK: rpcVK(vk), Params: failure.params})
+				resp, sysErr := WithVKEncryption(context.Background(), encManager, endpoint, encReq, test.validate,
+					func(_ context.Context, builder *CallBuilder[any, any], _ *EncryptionManager) error {
+						return failure.execute(builder)
+					})
+				// only the failed executions are reported to the host
+				require.Equal(t, failure.code == responses.ErrCodeInternal, sysErr != nil)
+				require.Equal(t, failure.code, responses.ErrorCodeOf(userError(t, resp, vk)))
+			})
+		}
+	}
+}
+
+func TestRequestErrorCodes(t *testing.T) {
+	encManager, vk := setupEncryptionManager(t)
+	validate := func([]any, *CallBuilder[any, any], *EncryptionManager) error { return nil }
+	execute := func(context.Context, *CallBuilder[any, any], *EncryptionManager) error { return nil }
+
+	tests := map[string]struct {
+		encReq []byte
+		code   responses.ErrorCode
+	}{
+		"not encrypted":       {[]byte("{}"), responses.ErrCodeInvalidParams},
+		"not json":            {encryptBytes(t, encManager, []byte("not json")), responses.ErrCodeInvalidParams},
+		"missing viewing key": {encryptRequest(t, encManager, &rpc.RequestWithVk{}), responses.ErrCodeUnauthorised},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, _ := WithVKEncryption(context.Background(), encManager, "test", test.encReq, validate, execute)
+			require.Equal(t, test.code, responses.ErrorCodeOf(userError(t, resp, vk)))
+		})
+	}
+}
+
+func TestErrorMessagesArePreserved(t *testing.T) {
+	encManager, vk := setupEncryptionManager(t)
+	encReq := encryptRequest(t, encManager, &rpc.RequestWithVk{VK: rpcVK(vk), Params: []any{signedTx(t)}})
+	resp, _ := WithVKEncryption(context.Background(), encManager, "sendRawTransaction", encReq, adaptValidate(SubmitTxValidate),
+		func(_ context.Context, builder *CallBuilder[any, any], _ *EncryptionManager) error {
+			builder.Err = responses.ToUserError(gethtxpool.ErrReplaceUnderpriced)
+			return nil
+		})
+	err := userError(t, resp, vk)
+	require.Equal(t, gethtxpool.ErrReplaceUnderpriced.Error(), err.Error())
+}
+
+// submittingService - a node which fails the submission of the transactions with the error
+type submittingService struct {
+	nodetype.NodeType
+	err error
+}
+
+func (s *submittingService) SubmitTransaction(*common.L2Tx) error {
+	return s.err
+}
+
+type noSyntheticTxs struct {
+	crosschain.Manager
+}
+
+func (noSyntheticTxs) IsSyntheticTransaction(common.L2Tx) bool {
+	return false
+}
+
+func TestAlreadyKnownTxHashIsReturnedWithTheError(t *testing.T) {
+	encManager, vk := setupEncryptionManager(t)
+	encManager.processors = &crosschain.Processors{Local: noSyntheticTxs{}}
+	tx := signedTx(t)
+	submit := func(err error) (*gethcommon.Hash, error) {
+		encManager.service = &submittingService{err: err}
+		encReq := encryptRequest(t, encManager, &rpc.RequestWithVk{VK: rpcVK(vk), Params: []any{tx}})
+		resp, sysErr := WithVKEncryption(context.Background(), encManager, "submitTx", encReq, SubmitTxValidate, SubmitTxExecute)
+		require.Nil(t, sysErr)
+		decrypted, err := vk.PrivateKey.Decrypt(resp.EncUserResponse, nil, nil)
+		require.NoError(t, err)
+		return responses.DecodeResponse[gethcommon.Hash](decrypted)
+	}
+
+	hash, err := submit(nil)
+	require.NoError(t, err)
+	// the tx is in the mempool, so the client can poll for its receipt
+	knownHash, err := submit(errors.Join(gethtxpool.ErrAlreadyKnown))
+	require.Equal(t, responses.ErrCodeAlreadyKnown, responses.ErrorCodeOf(err))
+	require.Equal(t, gethtxpool.ErrAlreadyKnown.Error(), err.Error())
+	require.Equal(t, hash, knownHash)
+	// and in a batch
+	knownHash, err = submit(fmt.Errorf("%w - the transaction was included in batch 5", gethtxpool.ErrAlreadyKnown))
+	require.Equal(t, gethtxpool.ErrAlreadyKnown.Error(), err.Error())
+	require.Equal(t, hash, knownHash)
+
+	// the other failures have no result
+	noHash, err := submit(fmt.Errorf("%w: next nonce 2, tx nonce 1", gethcore.ErrNonceTooLow))
+	require.Equal(t, responses.ErrCodeNonceTooLow, responses.ErrorCodeOf(err))
+	require.Nil(t, noHash)
+}
+
+func TestRequestIDIsEchoedAndLoggedWithoutTheParams(t *testing.T) {
+	encManager, vk := setupEncryptionManager(t)
+	var logged []string
+	var levels []gethlog.Lvl
+	encManager.logger.SetHandler(gethlog.FuncHandler(func(r *gethlog.Record) error {
+		logged = append(logged, fmt.Sprint(r.Msg, r.Ctx))
+		levels = append(levels, r.Lvl)
+		return nil
+	}))
+	const secretParam, requestID = "0xsecret", "gateway-7f3a"
+	// the error quotes the parameter
+	validate := func(params []any, builder *CallBuilder[any, any], _ *EncryptionManager) error {
+		builder.Err = fmt.Errorf("invalid parameter %s", params[0])
+		return nil
+	}
+	notExecuted := func(context.Context, *CallBuilder[any, any], *EncryptionManager) error { panic("unexpected execution") }
+
+	encReq := encryptRequest(t, encManager, &rpc.RequestWithVk{VK: rpcVK(vk), Params: []any{secretParam}, RequestID: requestID})
+	resp, _ := WithVKEncryption(context.Background(), encManager, "test", encReq, validate, notExecuted)
+	decrypted, err := vk.PrivateKey.Decrypt(resp.EncUserResponse, nil, nil)
+	require.NoError(t, err)
+	_, echoed, err := responses.DecodeResponseWithRequestID[json.RawMessage](decrypted)
+	require.ErrorContains(t, err, secretParam)
+	require.Equal(t, requestID, echoed)
+
+	require.Len(t, logged, 1)
+	require.Contains(t, logged[0], requestID)
+	require.NotContains(t, logged[0], secretParam)
+	// the failures of the users are not warnings
+	require.Equal(t, gethlog.LvlInfo, levels[0])
+
+	// the ID is capped, and can't forge log lines
+	for _, invalid := range []string{strings.Repeat("a", rpc.MaxRequestIDLength+1), "id\nforged line"} {
+		encReq = encryptRequest(t, encManager, &rpc.RequestWithVk{VK: rpcVK(vk), Params: []any{secretParam}, RequestID: invalid})
+		resp, _ = WithVKEncryption(context.Background(), encManager, "test", encReq, validate, notExecuted)
+		require.Equal(t, responses.ErrCodeInvalidParams, responses.ErrorCodeOf(resp.Error()))
+	}
+}
+
+func TestInternalFailuresCarryTheRequestID(t *testing.T) {
+	encManager, vk := setupEncryptionManager(t)
+	var levels []gethlog.Lvl
+	encManager.logger.SetHandler(gethlog.FuncHandler(func(r *gethlog.Record) error {
+		levels = append(levels, r.Lvl)
+		return nil
+	}))
+	const requestID = "gateway-7f3a"
+	validate := func([]any, *CallBuilder[any, any], *EncryptionManager) error { return nil }
+	failed := func(context.Context, *CallBuilder[any, any], *EncryptionManager) error {
+		return errors.New("the database is unreachable")
+	}
+
+	encReq := encryptRequest(t, encManager, &rpc.RequestWithVk{VK: rpcVK(vk), RequestID: requestID})
+	_, err := WithVKEncryption(context.Background(), encManager, "test", encReq, validate, failed)
+	// the caller logs the internal error, so it can be matched with the failure logged by the enclave
+	require.ErrorContains(t, err, requestID)
+	require.Equal(t, responses.ErrCodeInternal, responses.ErrorCodeOf(err))
+	require.Equal(t, []gethlog.Lvl{gethlog.LvlWarn}, levels)
+}
+
+// blockingExecution - an execution which only returns once its request is cancelled, signalling when it started
+func blockingExecution(started chan<- struct{}) func(context.Context, *CallBuilder[any, any], *EncryptionManager) error {
+	return func(ctx context.Context, _ *CallBuilder[any, any], _ *EncryptionManager) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}
+}
+
+func TestInFlightRequestIsAbortedOnStop(t *testing.T) {
+	encManager, vk := setupEncryptionManager(t)
+	validate := func([]any, *CallBuilder[any, any], *EncryptionManager) error { return nil }
+	started := make(chan struct{})
+
+	type result struct {
+		resp   *responses.EnclaveResponse
+		sysErr common.SystemError
+	}
+	done := make(chan result, 1)
+	go func() {
+		encReq := encryptRequest(t, encManager, &rpc.RequestWithVk{VK: rpcVK(vk)})
+		resp, sysErr := WithVKEncryption(context.Background(), encManager, "test", encReq, validate, blockingExecution(started))
+		done <- result{resp, sysErr}
+	}()
+	<-started
+	encManager.stopControl.Stop()
+
+	select {
+	case res := <-done:
+		// the enclave is not failing, the user can send the request to another node
+		require.Nil(t, res.sysErr)
+		err := userError(t, res.resp, vk)
+		require.Equal(t, responses.ErrCodeUnavailable, responses.ErrorCodeOf(err))
+		require.Equal(t, errutil.ErrShuttingDown.Error(), err.Error())
+	case <-time.After(5 * time.Second):
+		t.Fatal("the request was not aborted by Stop")
+	}
+}
+
+func TestInFlightRequestIsAbortedOnTimeout(t *testing.T) {
+	encManager, vk := setupEncryptionManager(t)
+	encManager.config.RequestTimeout = 50 * time.Millisecond
+	validate := func([]any, *CallBuilder[any, any], *EncryptionManager) error { return nil }
+
+	start := time.Now()
+	encReq := encryptRequest(t, encManager, &rpc.RequestWithVk{VK: rpcVK(vk)})
+	resp, sysErr := WithVKEncryption(context.Background(), encManager, "test", encReq, validate, blockingExecution(make(chan struct{})))
+	require.Less(t, time.Since(start), 5*time.Second)
+	require.Nil(t, sysErr)
+	require.Equal(t, responses.ErrCodeTimeout, responses.ErrorCodeOf(userError(t, resp, vk)))
+}
+
+func setupEncryptionManager(t *testing.T) (*EncryptionManager, *viewingkey.ViewingKey) {
+	accountKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return setupEncryptionManagerForAccount(t, accountKey)
+}
+
+// setupEncryptionManagerForAccount - the viewing key is the one of the account
+func setupEncryptionManagerForAccount(t *testing.T, accountKey *ecdsa.PrivateKey) (*EncryptionManager, *viewingkey.ViewingKey) {
+	enclaveKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	encManager := &EncryptionManager{
+		enclavePrivateKeyECIES: ecies.ImportECDSA(enclaveKey),
+		config:                 &config.EnclaveConfig{ObscuroChainID: testChainID},
+		admission:              newAdmissionController(0, 0, nil),
+		stopControl:            stopcontrol.New(),
+		logger:                 gethlog.New(),
+	}
+
+	vk, err := viewingkey.GenerateViewingKeyForWallet(wallet.NewInMemoryWalletFromPK(big.NewInt(testChainID), accountKey, gethlog.New()))
+	require.NoError(t, err)
+	return encManager, vk
+}
+
+// adaptValidate - so the validation of the endpoints can be called with the same execution
+func adaptValidate[P any, R any](validate func([]any, *CallBuilder[P, R], *EncryptionManager) error) func([]any, *CallBuilder[any, any], *EncryptionManager) error {
+	return func(params []any, builder *CallBuilder[any, any], encManager *EncryptionManager) error {
+		typedBuilder := &CallBuilder[P, R]{VK: builder.VK}
+		err := validate(params, typedBuilder, encManager)
+		builder.From = typedBuilder.From
+		builder.Err = typedBuilder.Err
+		return err
+	}
+}
+
+func rpcVK(vk *viewingkey.ViewingKey) *viewingkey.RPCSignedViewingKey {
+	return &viewingkey.RPCSignedViewingKey{
+		Account:                 vk.Account,
+		PublicKey:               vk.PublicKey,
+		SignatureWithAccountKey: vk.SignatureWithAccountKey,
+	}
+}
+
+func encryptRequest(t *testing.T, encManager *EncryptionManager, req *rpc.RequestWithVk) []byte {
+	encoded, err := json.Marshal(req)
+	require.NoError(t, err)
+	return encryptBytes(t, encManager, encoded)
+}
+
+func encryptBytes(t *testing.T, encManager *EncryptionManager, bytes []byte) []byte {
+	encrypted, err := ecies.Encrypt(rand.Reader, &encManager.enclavePrivateKeyECIES.PublicKey, bytes, nil, nil)
+	require.NoError(t, err)
+	return encrypted
+}
+
+// userError - the error the user gets from the response, in plaintext or decrypted with the viewing key
+func userError(t *testing.T, resp *responses.EnclaveResponse, vk *viewingkey.ViewingKey) error {
+	if resp.Error() != nil {
+		return resp.Error()
+	}
+	decrypted, err := vk.PrivateKey.Decrypt(resp.EncUserResponse, nil, nil)
+	require.NoError(t, err)
+	_, err = responses.DecodeResponse[json.RawMessage](decrypted)
+	require.Error(t, err)
+	return err
+}
+
+func signedTx(t *testing.T) string {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(testChainID)), &types.LegacyTx{GasPrice: big.NewInt(1), Gas: 21_000})
+	require.NoError(t, err)
+	encoded, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	return hexutil.Encode(encoded)
+}
@@ -11,11 +11,13 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/compression"
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/rpc"
 	"github.com/ten-protocol/go-ten/go/common/rpc/generated"
-	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
+	"github.com/ten-protocol/go-ten/go/responses"
 	"google.golang.org/grpc"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -30,15 +32,18 @@ type RPCServer struct {
 	grpcServer    *grpc.Server
 	logger        gethlog.Logger
 	listenAddress string
+	// compresses the streamed updates for the hosts which ask for it
+	dataCompression compression.DataCompressionService
 }
 
 // NewEnclaveRPCServer prepares an enclave RPCServer (doesn't start listening until `StartServer` is called
 func NewEnclaveRPCServer(listenAddress string, enclave common.Enclave, logger gethlog.Logger) *RPCServer {
 	return &RPCServer{
-		enclave:       enclave,
-		grpcServer:    grpc.NewServer(),
-		logger:        logger,
-		listenAddress: listenAddress,
+		enclave:         enclave,
+		grpcServer:      grpc.NewServer(),
+		logger:          logger,
+		listenAddress:   listenAddress,
+		dataCompression: compression.NewBrotliDataCompressionService(),
 	}
 }
 
@@ -68,15 +73,37 @@ func (s *RPCServer) Status(context.Context, *generated.StatusRequest) (*generate
 	if sysError != nil {
 		s.logger.Error("Enclave error on Status", log.ErrKey, sysError)
 	}
-	var l2Head []byte
+	var l2Head, l2ExecutedHead []byte
 	if status.L2Head != nil {
 		l2Head = status.L2Head.Bytes()
 	}
+	if status.L2ExecutedHead != nil {
+		l2ExecutedHead = status.L2ExecutedHead.Bytes()
+	}
 	return &generated.StatusResponse{
 		StatusCode:  int32(status.StatusCode),
 		L1Head:      status.L1Head.Bytes(),
 		L2Head:      l2Head,
 		SystemError: toRPCError(sysError),
+		TxPool: &generated.TxPoolStatus{
+			Pending:  status.TxPool.Pending,
+			Queued:   status.TxPool.Queued,
+			Capacity: status.TxPool.Capacity,
+		},
+		Version:               status.Version,
+		NodeType:              status.NodeType.String(),
+		EnclaveID:             status.EnclaveID.Bytes(),
+		HasSecret:             status.HasSecret,
+		WillAttest:            status.WillAttest,
+		L2HeadHash:            status.L2HeadHash.Bytes(),
+		LatestRollup:          rpc.ToRollupMetadataMsg(status.LatestRollup),
+		L2ExecutedHead:        l2ExecutedHead,
+		BatchGasLimit:         status.BatchGasLimit,
+		BatchProductionPaused: status.BatchProductionPaused,
+		Ready:                 status.Ready,
+		Replay:                rpc.ToReplayStatusMsg(status.Replay),
+		StateRetention:        string(status.StateRetention),
+		OldestStateSeqNo:      status.OldestStateSeqNo,
 	}, nil
 }
 
@@ -116,7 +143,24 @@ func (s *RPCServer) EnclaveID(_ context.Context, _ *generated.EnclaveIDRequest)
 	return &generated.EnclaveIDResponse{EnclaveID: id.Bytes()}, nil
 }
 
-func (s *RPCServer) SubmitL1Block(_ context.Context, request *generated.SubmitBlockRequest) (*generated.SubmitBlockResponse, error) {
+func (s *RPCServer) EnclaveIDProof(_ context.Context, request *generated.EnclaveIDProofRequest) (*generated.EnclaveIDProofResponse, error) {
+	proof, err := s.enclave.EnclaveIDProof(request.Nonce)
+	if err != nil {
+		var codedErr *responses.CodedError
+		if errors.As(err, &codedErr) && !codedErr.Code.IsSystemError() {
+			return &generated.EnclaveIDProofResponse{UserError: err.Error()}, nil
+		}
+		s.logger.Error("Error proving the enclave ID", log.ErrKey, err)
+		return &generated.EnclaveIDProofResponse{SystemError: toRPCError(err)}, nil
+	}
+	return &generated.EnclaveIDProofResponse{
+		EnclaveID: proof.EnclaveID.Bytes(),
+		PublicKey: proof.PublicKey,
+		Signature: proof.Signature,
+	}, nil
+}
+
+func (s *RPCServer) SubmitL1Block(ctx context.Context, request *generated.SubmitBlockRequest) (*generated.SubmitBlockResponse, error) {
 	bl, err := s.decodeBlock(request.EncodedBlock)
 	if err != nil {
 		s.logger.Error("Error decoding block", log.ErrKey, err)
@@ -127,17 +171,22 @@ func (s *RPCServer) SubmitL1Block(_ context.Context, request *generated.SubmitBl
 		s.logger.Error("Error decoding receipts", log.ErrKey, err)
 		return nil, err
 	}
-	blockSubmissionResponse, err := s.enclave.SubmitL1Block(bl, receipts, request.IsLatest)
+	var receiptProofs common.L1ReceiptProofs
+	if request.EncodedReceiptProofs != nil {
+		receiptProofs = make(common.L1ReceiptProofs, 0)
+		if err = rlp.DecodeBytes(request.EncodedReceiptProofs, &receiptProofs); err != nil {
+			s.logger.Error("Error decoding receipt proofs", log.ErrKey, err)
+			return nil, err
+		}
+	}
+	blockSubmissionResponse, err := s.enclave.SubmitL1Block(tracing.FromIncomingGRPC(ctx), bl, receipts, receiptProofs, request.IsLatest)
 	if err != nil {
 		var rejErr *errutil.BlockRejectError
 		isReject := errors.As(err, &rejErr)
 		if isReject {
 			return &generated.SubmitBlockResponse{
 				BlockSubmissionResponse: &generated.BlockSubmissionResponseMsg{
-					Error: &generated.BlockSubmissionErrorMsg{
-						Cause:  rejErr.Wrapped.Error(),
-						L1Head: rejErr.L1Head.Bytes(),
-					},
+					Error: rpc.ToBlockSubmissionErrorMsg(rejErr),
 				},
 			}, nil
 		}
@@ -152,26 +201,58 @@ func (s *RPCServer) SubmitL1Block(_ context.Context, request *generated.SubmitBl
 	return &generated.SubmitBlockResponse{BlockSubmissionResponse: msg}, nil
 }
 
+func (s *RPCServer) SubmitL1Headers(_ context.Context, request *generated.SubmitL1HeadersRequest) (*generated.SubmitL1HeadersResponse, error) {
+	var headers []*types.Header
+	if err := rlp.DecodeBytes(request.EncodedHeaders, &headers); err != nil {
+		s.logger.Error("Error decoding headers", log.ErrKey, err)
+		return nil, err
+	}
+	forkPoint, err := s.enclave.SubmitL1Headers(headers)
+	if err != nil {
+		var rejErr *errutil.BlockRejectError
+		if errors.As(err, &rejErr) {
+			return &generated.SubmitL1HeadersResponse{Error: rpc.ToBlockSubmissionErrorMsg(rejErr)}, nil
+		}
+		s.logger.Error("Error submitting the L1 headers", log.ErrKey, err)
+		return &generated.SubmitL1HeadersResponse{SystemError: toRPCError(err)}, nil
+	}
+	return &generated.SubmitL1HeadersResponse{ForkPoint: rpc.ToL1ForkPointMsg(forkPoint)}, nil
+}
+
 func (s *RPCServer) SubmitTx(_ context.Context, request *generated.SubmitTxRequest) (*generated.SubmitTxResponse, error) {
-	enclaveResponse, sysError := s.enclave.SubmitTx(request.EncryptedTx)
+	submitResponse, sysError := s.enclave.SubmitTx(request.EncryptedTx)
 	if sysError != nil {
 		s.logger.Error("Error submitting tx", log.ErrKey, sysError)
 		return &generated.SubmitTxResponse{SystemError: toRPCError(sysError)}, nil
 	}
-	return &generated.SubmitTxResponse{EncodedEnclaveResponse: enclaveResponse.Encode()}, nil
+	return &generated.SubmitTxResponse{
+		EncodedEnclaveResponse: submitResponse.Response.Encode(),
+		ForwardTx:              submitResponse.ForwardTx,
+	}, nil
+}
+
+func (s *RPCServer) SubmitForwardedTx(_ context.Context, request *generated.SubmitForwardedTxRequest) (*generated.SubmitForwardedTxResponse, error) {
+	sysError := s.enclave.SubmitForwardedTx(request.ForwardedTx)
+	if sysError != nil {
+		s.logger.Error("Error submitting forwarded tx", log.ErrKey, sysError)
+	}
+	return &generated.SubmitForwardedTxResponse{SystemError: toRPCError(sysError)}, nil
 }
 
-func (s *RPCServer) SubmitBatch(_ context.Context, request *generated.SubmitBatchRequest) (*generated.SubmitBatchResponse, error) {
+func (s *RPCServer) SubmitBatch(ctx context.Context, request *generated.SubmitBatchRequest) (*generated.SubmitBatchResponse, error) {
 	batch := rpc.FromExtBatchMsg(request.Batch)
-	sysError := s.enclave.SubmitBatch(batch)
+	summary, sysError := s.enclave.SubmitBatch(tracing.FromIncomingGRPC(ctx), batch)
 	if sysError != nil {
 		s.logger.Error("Error submitting batch", log.ErrKey, sysError)
 	}
-	return &generated.SubmitBatchResponse{SystemError: toRPCError(sysError)}, nil
+	return &generated.SubmitBatchResponse{
+		SystemError: toRPCError(sysError),
+		Summary:     rpc.ToBatchExecutionSummaryMsg(summary),
+	}, nil
 }
 
-func (s *RPCServer) ObsCall(_ context.Context, request *generated.ObsCallRequest) (*generated.ObsCallResponse, error) {
-	enclaveResp, sysError := s.enclave.ObsCall(request.EncryptedParams)
+func (s *RPCServer) ObsCall(ctx context.Context, request *generated.ObsCallRequest) (*generated.ObsCallResponse, error) {
+	enclaveResp, sysError := s.enclave.ObsCall(tracing.FromIncomingGRPC(ctx), request.EncryptedParams)
 	if sysError != nil {
 		s.logger.Error("Error calling ObsCall", log.ErrKey, sysError)
 		return &generated.ObsCallResponse{SystemError: toRPCError(sysError)}, nil
@@ -203,8 +284,8 @@ func (s *RPCServer) GetTransaction(_ context.Context, request *generated.GetTran
 	return &generated.GetTransactionResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
 }
 
-func (s *RPCServer) GetTransactionReceipt(_ context.Context, request *generated.GetTransactionReceiptRequest) (*generated.GetTransactionReceiptResponse, error) {
-	enclaveResponse, sysError := s.enclave.GetTransactionReceipt(request.EncryptedParams)
+func (s *RPCServer) GetTransactionReceipt(ctx context.Context, request *generated.GetTransactionReceiptRequest) (*generated.GetTransactionReceiptResponse, error) {
+	enclaveResponse, sysError := s.enclave.GetTransactionReceipt(tracing.FromIncomingGRPC(ctx), request.EncryptedParams)
 	if sysError != nil {
 		s.logger.Error("Error getting tx receipt", log.ErrKey, sysError)
 		return &generated.GetTransactionReceiptResponse{SystemError: toRPCError(sysError)}, nil
@@ -212,6 +293,24 @@ func (s *RPCServer) GetTransactionReceipt(_ context.Context, request *generated.
 	return &generated.GetTransactionReceiptResponse{EncodedEnclaveResponse: enclaveResponse.Encode()}, nil
 }
 
+func (s *RPCServer) GetCrossChainMessageProof(_ context.Context, request *generated.GetCrossChainMessageProofRequest) (*generated.GetCrossChainMessageProofResponse, error) {
+	enclaveResponse, sysError := s.enclave.GetCrossChainMessageProof(request.EncryptedParams)
+	if sysError != nil {
+		s.logger.Error("Error getting cross chain message proof", log.ErrKey, sysError)
+		return &generated.GetCrossChainMessageProofResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.GetCrossChainMessageProofResponse{EncodedEnclaveResponse: enclaveResponse.Encode()}, nil
+}
+
+func (s *RPCServer) GetCrossChainMessageStatus(_ context.Context, request *generated.GetCrossChainMessageStatusRequest) (*generated.GetCrossChainMessageStatusResponse, error) {
+	enclaveResponse, sysError := s.enclave.GetCrossChainMessageStatus(request.EncryptedParams)
+	if sysError != nil {
+		s.logger.Error("Error getting cross chain message status", log.ErrKey, sysError)
+		return &generated.GetCrossChainMessageStatusResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.GetCrossChainMessageStatusResponse{EncodedEnclaveResponse: enclaveResponse.Encode()}, nil
+}
+
 func (s *RPCServer) GetBalance(_ context.Context, request *generated.GetBalanceRequest) (*generated.GetBalanceResponse, error) {
 	enclaveResp, sysError := s.enclave.GetBalance(request.EncryptedParams)
 	if sysError != nil {
@@ -233,6 +332,18 @@ func (s *RPCServer) GetCode(_ context.Context, request *generated.GetCodeRequest
 	return &generated.GetCodeResponse{Code: code}, nil
 }
 
+func (s *RPCServer) ConvertBatchHash(_ context.Context, request *generated.ConvertBatchHashRequest) (*generated.ConvertBatchHashResponse, error) {
+	hashes, sysError := s.enclave.ConvertBatchHash(gethcommon.BytesToHash(request.Hash))
+	if sysError != nil {
+		s.logger.Error("Error converting batch hash", log.ErrKey, sysError)
+		return &generated.ConvertBatchHashResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	if hashes == nil {
+		return &generated.ConvertBatchHashResponse{}, nil
+	}
+	return &generated.ConvertBatchHashResponse{BatchHash: hashes.BatchHash.Bytes(), ConvertedHash: hashes.ConvertedHash.Bytes()}, nil
+}
+
 func (s *RPCServer) Subscribe(_ context.Context, req *generated.SubscribeRequest) (*generated.SubscribeResponse, error) {
 	sysError := s.enclave.Subscribe(gethrpc.ID(req.Id), req.EncryptedSubscription)
 	if sysError != nil {
@@ -241,6 +352,14 @@ func (s *RPCServer) Subscribe(_ context.Context, req *generated.SubscribeRequest
 	return &generated.SubscribeResponse{SystemError: toRPCError(sysError)}, nil
 }
 
+func (s *RPCServer) SubscribeNewHeads(_ context.Context, req *generated.SubscribeNewHeadsRequest) (*generated.SubscribeNewHeadsResponse, error) {
+	sysError := s.enclave.SubscribeNewHeads(gethrpc.ID(req.Id))
+	if sysError != nil {
+		s.logger.Error("Error subscribing to new heads", log.ErrKey, sysError)
+	}
+	return &generated.SubscribeNewHeadsResponse{SystemError: toRPCError(sysError)}, nil
+}
+
 func (s *RPCServer) Unsubscribe(_ context.Context, req *generated.UnsubscribeRequest) (*generated.UnsubscribeResponse, error) {
 	sysError := s.enclave.Unsubscribe(gethrpc.ID(req.Id))
 	if sysError != nil {
@@ -258,6 +377,15 @@ func (s *RPCServer) EstimateGas(_ context.Context, req *generated.EstimateGasReq
 	return &generated.EstimateGasResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
 }
 
+func (s *RPCServer) EstimateL1Fee(_ context.Context, req *generated.EstimateL1FeeRequest) (*generated.EstimateL1FeeResponse, error) {
+	enclaveResp, sysError := s.enclave.EstimateL1Fee(req.EncryptedParams)
+	if sysError != nil {
+		s.logger.Error("Error estimating l1 fee", log.ErrKey, sysError)
+		return &generated.EstimateL1FeeResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.EstimateL1FeeResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
+}
+
 func (s *RPCServer) GetLogs(_ context.Context, req *generated.GetLogsRequest) (*generated.GetLogsResponse, error) {
 	enclaveResp, sysError := s.enclave.GetLogs(req.EncryptedParams)
 	if sysError != nil {
@@ -268,11 +396,60 @@ func (s *RPCServer) GetLogs(_ context.Context, req *generated.GetLogsRequest) (*
 }
 
 func (s *RPCServer) HealthCheck(_ context.Context, _ *generated.EmptyArgs) (*generated.HealthCheckResponse, error) {
-	healthy, sysError := s.enclave.HealthCheck()
+	health, sysError := s.enclave.HealthCheck()
 	if sysError != nil {
 		return &generated.HealthCheckResponse{SystemError: toRPCError(sysError)}, nil
 	}
-	return &generated.HealthCheckResponse{Status: healthy}, nil
+	return &generated.HealthCheckResponse{Status: health.OverallHealth, Components: rpc.ToComponentHealthMsgs(health.Components)}, nil
+}
+
+func (s *RPCServer) GetStateDivergenceReports(_ context.Context, _ *generated.EmptyArgs) (*generated.GetStateDivergenceReportsResponse, error) {
+	reports, sysError := s.enclave.GetStateDivergenceReports()
+	if sysError != nil {
+		s.logger.Error("Error getting the state divergence reports", log.ErrKey, sysError)
+		return &generated.GetStateDivergenceReportsResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.GetStateDivergenceReportsResponse{Reports: rpc.ToStateDivergenceReportMsgs(reports)}, nil
+}
+
+func (s *RPCServer) GetSecretDisclosures(_ context.Context, request *generated.GetSecretDisclosuresRequest) (*generated.GetSecretDisclosuresResponse, error) {
+	records, sysError := s.enclave.GetSecretDisclosures(request.FromID, request.MaxCount)
+	if sysError != nil {
+		s.logger.Error("Error getting the secret disclosure records", log.ErrKey, sysError)
+		return &generated.GetSecretDisclosuresResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.GetSecretDisclosuresResponse{Records: rpc.ToSecretDisclosureRecordMsgs(records)}, nil
+}
+
+func (s *RPCServer) GetRollupCompressionStats(_ context.Context, _ *generated.EmptyArgs) (*generated.GetRollupCompressionStatsResponse, error) {
+	stats, sysError := s.enclave.GetRollupCompressionStats()
+	if sysError != nil {
+		s.logger.Error("Error getting the rollup compression stats", log.ErrKey, sysError)
+		return &generated.GetRollupCompressionStatsResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.GetRollupCompressionStatsResponse{Stats: rpc.ToRollupCompressionStatsMsgs(stats)}, nil
+}
+
+func (s *RPCServer) GetEnclaveAPIVersion(_ context.Context, _ *generated.EmptyArgs) (*generated.GetEnclaveAPIVersionResponse, error) {
+	version, sysError := s.enclave.GetEnclaveAPIVersion()
+	if sysError != nil {
+		s.logger.Error("Error getting the enclave API version", log.ErrKey, sysError)
+		return &generated.GetEnclaveAPIVersionResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.GetEnclaveAPIVersionResponse{
+		Major:    version.Major,
+		Minor:    version.Minor,
+		Patch:    version.Patch,
+		Features: uint64(version.Features),
+	}, nil
+}
+
+func (s *RPCServer) GetMetrics(_ context.Context, _ *generated.EmptyArgs) (*generated.GetMetricsResponse, error) {
+	metrics, sysError := s.enclave.GetMetrics()
+	if sysError != nil {
+		return &generated.GetMetricsResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.GetMetricsResponse{Metrics: metrics}, nil
 }
 
 func (s *RPCServer) CreateRollup(_ context.Context, req *generated.CreateRollupRequest) (*generated.CreateRollupResponse, error) {
@@ -281,7 +458,7 @@ func (s *RPCServer) CreateRollup(_ context.Context, req *generated.CreateRollupR
 		fromSeqNo = *req.FromSequenceNumber
 	}
 
-	rollup, sysError := s.enclave.CreateRollup(fromSeqNo)
+	rollup, metadata, sysError := s.enclave.CreateRollup(fromSeqNo)
 	if sysError != nil {
 		s.logger.Error("Error creating rollup", log.ErrKey, sysError)
 	}
@@ -291,32 +468,69 @@ func (s *RPCServer) CreateRollup(_ context.Context, req *generated.CreateRollupR
 	return &generated.CreateRollupResponse{
 		Msg:         &msg,
 		SystemError: toRPCError(sysError),
+		Metadata:    rpc.ToExtRollupMetadataMsg(metadata),
 	}, nil
 }
 
 func (s *RPCServer) CreateBatch(_ context.Context, r *generated.CreateBatchRequest) (*generated.CreateBatchResponse, error) {
-	sysError := s.enclave.CreateBatch(r.SkipIfEmpty)
+	result, sysError := s.enclave.CreateBatch(r.SkipIfEmpty)
 	if sysError != nil {
 		s.logger.Error("Error creating batch", log.ErrKey, sysError)
 	}
-	return &generated.CreateBatchResponse{}, sysError
+	if result == nil {
+		return &generated.CreateBatchResponse{}, sysError
+	}
+	return &generated.CreateBatchResponse{
+		Produced:   result.Produced,
+		BatchHash:  result.BatchHash.Bytes(),
+		SeqNo:      result.SeqNo,
+		TxCount:    uint64(result.TxCount),
+		SkipReason: string(result.SkipReason),
+	}, sysError
 }
 
-func (s *RPCServer) DebugTraceTransaction(_ context.Context, req *generated.DebugTraceTransactionRequest) (*generated.DebugTraceTransactionResponse, error) {
-	txHash := gethcommon.BytesToHash(req.TxHash)
-	var config tracers.TraceConfig
-
-	sysError := json.Unmarshal(req.Config, &config)
+func (s *RPCServer) SetBatchProductionPaused(_ context.Context, req *generated.SetBatchProductionPausedRequest) (*generated.SetBatchProductionPausedResponse, error) {
+	var sysError common.SystemError
+	if req.Paused {
+		sysError = s.enclave.PauseBatchProduction()
+	} else {
+		sysError = s.enclave.ResumeBatchProduction()
+	}
 	if sysError != nil {
-		s.logger.Error("Error calling debug tx", log.ErrKey, sysError)
+		s.logger.Warn("Could not change the batch production", "paused", req.Paused, log.ErrKey, sysError)
+	}
+	return &generated.SetBatchProductionPausedResponse{SystemError: toRPCError(sysError)}, nil
+}
 
-		return &generated.DebugTraceTransactionResponse{
-			SystemError: toRPCError(fmt.Errorf("unable to unmarshall config - %w", sysError)),
-		}, nil
+func (s *RPCServer) ReprocessRollup(_ context.Context, req *generated.ReprocessRollupRequest) (*generated.ReprocessRollupResponse, error) {
+	processing, err := s.enclave.ReprocessRollup(gethcommon.BytesToHash(req.RollupHash))
+	if err != nil {
+		var codedErr *responses.CodedError
+		if errors.As(err, &codedErr) && !codedErr.Code.IsSystemError() {
+			return &generated.ReprocessRollupResponse{UserError: err.Error()}, nil
+		}
+		s.logger.Error("Error reprocessing the rollup", log.ErrKey, err)
+		return &generated.ReprocessRollupResponse{SystemError: toRPCError(err)}, nil
 	}
+	return &generated.ReprocessRollupResponse{Processing: rpc.ToRollupProcessingMsg(processing)}, nil
+}
 
-	traceTx, sysError := s.enclave.DebugTraceTransaction(txHash, &config)
-	return &generated.DebugTraceTransactionResponse{Msg: string(traceTx), SystemError: toRPCError(sysError)}, nil
+func (s *RPCServer) DebugTraceTransaction(_ context.Context, req *generated.DebugTraceTransactionRequest) (*generated.DebugTraceTransactionResponse, error) {
+	enclaveResp, sysError := s.enclave.DebugTraceTransaction(req.EncryptedParams)
+	if sysError != nil {
+		s.logger.Error("Error tracing the transaction", log.ErrKey, sysError)
+		return &generated.DebugTraceTransactionResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.DebugTraceTransactionResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
+}
+
+func (s *RPCServer) DebugStorageRangeAt(_ context.Context, req *generated.DebugStorageRangeAtRequest) (*generated.DebugStorageRangeAtResponse, error) {
+	enclaveResp, sysError := s.enclave.DebugStorageRangeAt(req.EncryptedParams)
+	if sysError != nil {
+		s.logger.Error("Error getting the storage range", log.ErrKey, sysError)
+		return &generated.DebugStorageRangeAtResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.DebugStorageRangeAtResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
 }
 
 func (s *RPCServer) GetBatch(_ context.Context, request *generated.GetBatchRequest) (*generated.GetBatchResponse, error) {
@@ -363,24 +577,148 @@ func (s *RPCServer) GetBatchBySeqNo(_ context.Context, request *generated.GetBat
 	}, err
 }
 
-func (s *RPCServer) StreamL2Updates(_ *generated.StreamL2UpdatesRequest, stream generated.EnclaveProto_StreamL2UpdatesServer) error {
+func (s *RPCServer) GetBatchByHeight(_ context.Context, request *generated.GetBatchByHeightRequest) (*generated.GetBatchResponse, error) {
+	batch, err := s.enclave.GetBatchByHeight(request.Height)
+	if err != nil {
+		s.logger.Error("Error getting batch by height", log.ErrKey, err)
+		return nil, err
+	}
+
+	encodedBatch, encodingErr := batch.Encoded()
+	var sysErr *generated.SystemError
+	if encodingErr != nil {
+		sysErr = &generated.SystemError{
+			ErrorCode:   2,
+			ErrorString: encodingErr.Error(),
+		}
+	}
+	return &generated.GetBatchResponse{
+		Batch:       encodedBatch,
+		SystemError: sysErr,
+	}, nil
+}
+
+// ExportStateSnapshot - the snapshot is streamed in chunks, as the state of a network outgrows a gRPC message
+func (s *RPCServer) ExportStateSnapshot(request *generated.ExportStateSnapshotRequest, stream generated.EnclaveProto_ExportStateSnapshotServer) error {
+	var requester *common.AttestationReport
+	if request.Requester != nil {
+		requester = rpc.FromAttestationReportMsg(request.Requester)
+	}
+	snapshot, sysError := s.enclave.ExportStateSnapshot(gethcommon.BytesToHash(request.RollupHash), requester)
+	if sysError != nil {
+		s.logger.Error("Error exporting the state snapshot", log.ErrKey, sysError)
+		return stream.Send(&generated.StateSnapshotChunkMsg{SystemError: toRPCError(sysError)})
+	}
+	for _, chunk := range rpc.ToStateSnapshotChunkMsgs(snapshot, nil) {
+		if err := stream.Send(chunk); err != nil {
+			s.logger.Info("Failed streaming the state snapshot to the host", log.ErrKey, err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *RPCServer) ImportStateSnapshot(stream generated.EnclaveProto_ImportStateSnapshotServer) error {
+	snapshot, l1Attestations, err := rpc.FromStateSnapshotChunkMsgs(stream.Recv)
+	if err != nil {
+		sysError := responses.WithCode(responses.ErrCodeInvalidParams, fmt.Errorf("could not receive the state snapshot. Cause: %w", err))
+		return stream.SendAndClose(&generated.ImportStateSnapshotResponse{SystemError: toRPCError(sysError)})
+	}
+	info, sysError := s.enclave.ImportStateSnapshot(snapshot, l1Attestations)
+	if sysError != nil {
+		s.logger.Error("Error importing the state snapshot", log.ErrKey, sysError)
+		return stream.SendAndClose(&generated.ImportStateSnapshotResponse{SystemError: toRPCError(sysError)})
+	}
+	return stream.SendAndClose(&generated.ImportStateSnapshotResponse{Info: rpc.ToStateSnapshotInfoMsg(info)})
+}
+
+func (s *RPCServer) GetBatchExecutionSummary(_ context.Context, request *generated.GetBatchExecutionSummaryRequest) (*generated.GetBatchExecutionSummaryResponse, error) {
+	summary, sysError := s.enclave.GetBatchExecutionSummary(request.SeqNo)
+	if sysError != nil {
+		logf := s.logger.Error
+		if responses.ErrorCodeOf(sysError) == responses.ErrCodeNotFound {
+			logf = s.logger.Debug
+		}
+		logf("Error getting the batch execution summary", log.ErrKey, sysError)
+		return &generated.GetBatchExecutionSummaryResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.GetBatchExecutionSummaryResponse{Summary: rpc.ToExecutedBatchSummaryMsg(summary)}, nil
+}
+
+func (s *RPCServer) GetBatchExecutionSummaries(_ context.Context, request *generated.GetBatchesBySeqRangeRequest) (*generated.GetBatchExecutionSummariesResponse, error) {
+	summaries, sysError := s.enclave.GetBatchExecutionSummaries(request.FromSeqNo, request.ToSeqNo, request.MaxCount)
+	if sysError != nil {
+		s.logger.Error("Error getting the batch execution summaries", log.ErrKey, sysError)
+		return &generated.GetBatchExecutionSummariesResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.GetBatchExecutionSummariesResponse{Summaries: rpc.ToExecutedBatchSummaryMsgs(summaries)}, nil
+}
+
+func (s *RPCServer) GetBatchesBySeqRange(_ context.Context, request *generated.GetBatchesBySeqRangeRequest) (*generated.GetBatchesResponse, error) {
+	batches, err := s.enclave.GetBatchesBySeqRange(request.FromSeqNo, request.ToSeqNo, request.MaxCount)
+	if err != nil {
+		s.logger.Error("Error getting batches by seq range", log.ErrKey, err)
+		return nil, err
+	}
+
+	encodedBatches := make([][]byte, len(batches))
+	for i, batch := range batches {
+		encodedBatch, encodingErr := batch.Encoded()
+		if encodingErr != nil {
+			return &generated.GetBatchesResponse{SystemError: &generated.SystemError{
+				ErrorCode:   2,
+				ErrorString: encodingErr.Error(),
+			}}, nil
+		}
+		encodedBatches[i] = encodedBatch
+	}
+	return &generated.GetBatchesResponse{Batches: encodedBatches}, nil
+}
+
+func (s *RPCServer) GetRollupData(_ context.Context, request *generated.GetRollupDataRequest) (*generated.GetRollupDataResponse, error) {
+	rollup, sysErr := s.enclave.GetRollupData(gethcommon.BytesToHash(request.Hash))
+	if sysErr != nil {
+		s.logger.Error("Error getting rollup", log.ErrKey, sysErr)
+		return &generated.GetRollupDataResponse{SystemError: toRPCError(sysErr)}, nil
+	}
+	return &generated.GetRollupDataResponse{Msg: rpc.ToRollupMetadataMsg(rollup)}, nil
+}
+
+func (s *RPCServer) GetRollupByBatchSeqNo(_ context.Context, request *generated.GetRollupByBatchSeqNoRequest) (*generated.GetRollupDataResponse, error) {
+	rollup, sysErr := s.enclave.GetRollupByBatchSeqNo(request.SeqNo)
+	if sysErr != nil {
+		s.logger.Error("Error getting rollup by batch", log.ErrKey, sysErr)
+		return &generated.GetRollupDataResponse{SystemError: toRPCError(sysErr)}, nil
+	}
+	return &generated.GetRollupDataResponse{Msg: rpc.ToRollupMetadataMsg(rollup)}, nil
+}
+
+func (s *RPCServer) StreamL2Updates(request *generated.StreamL2UpdatesRequest, stream generated.EnclaveProto_StreamL2UpdatesServer) error {
 	batchChan, stop := s.enclave.StreamL2Updates()
 	defer stop()
 
 	for {
-		batchResp, ok := <-batchChan
+		var batchResp common.StreamL2UpdatesResponse
+		var ok bool
+		select {
+		case batchResp, ok = <-batchChan:
+		case <-stream.Context().Done():
+			// the host is gone, so the enclave stops streaming to it right away, not once the next update fails to send
+			s.logger.Info("Host closed the L2 updates stream")
+			return nil
+		}
 		if !ok {
 			s.logger.Info("Enclave closed batch channel.")
 			break
 		}
 
-		encoded, err := json.Marshal(batchResp)
+		msg, err := encodeL2Update(batchResp, request.Compressed, s.dataCompression)
 		if err != nil {
 			s.logger.Error("Error marshalling batch response", log.ErrKey, err)
 			return nil
 		}
 
-		if err := stream.Send(&generated.EncodedUpdateResponse{Batch: encoded}); err != nil {
+		if err := stream.Send(msg); err != nil {
 			s.logger.Info("Failed streaming batch back to client", log.ErrKey, err)
 			// not quite sure there is any point to this, we failed to send a batch
 			// so error will probably not get sent either.
@@ -391,15 +729,86 @@ func (s *RPCServer) StreamL2Updates(_ *generated.StreamL2UpdatesRequest, stream
 	return nil
 }
 
-func (s *RPCServer) DebugEventLogRelevancy(_ context.Context, req *generated.DebugEventLogRelevancyRequest) (*generated.DebugEventLogRelevancyResponse, error) {
-	txHash := gethcommon.BytesToHash(req.TxHash)
+// encodeL2Update - the JSON encoding of the update, compressed when the host asked for it. The batches are already
+// compressed, but their encrypted transactions and the logs are bloated by the base64 encoding of JSON.
+func encodeL2Update(resp common.StreamL2UpdatesResponse, compressed bool, dataCompression compression.DataCompressionService) (*generated.EncodedUpdateResponse, error) {
+	encoded, err := json.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	if !compressed {
+		return &generated.EncodedUpdateResponse{Batch: encoded}, nil
+	}
+	compressedUpdate, err := dataCompression.CompressBatch(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("could not compress the update. Cause: %w", err)
+	}
+	return &generated.EncodedUpdateResponse{Batch: compressedUpdate, Compressed: true}, nil
+}
 
-	logs, sysError := s.enclave.DebugEventLogRelevancy(txHash)
+func (s *RPCServer) DebugEventLogRelevancy(_ context.Context, req *generated.DebugEventLogRelevancyRequest) (*generated.DebugEventLogRelevancyResponse, error) {
+	enclaveResp, sysError := s.enclave.DebugEventLogRelevancy(req.EncryptedParams)
 	if sysError != nil {
 		s.logger.Error("Error debugging event relevancy", log.ErrKey, sysError)
+		return &generated.DebugEventLogRelevancyResponse{SystemError: toRPCError(sysError)}, nil
 	}
+	return &generated.DebugEventLogRelevancyResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
+}
 
-	return &generated.DebugEventLogRelevancyResponse{Msg: string(logs), SystemError: toRPCError(sysError)}, nil
+func (s *RPCServer) DebugBatchLogRelevancy(_ context.Context, req *generated.DebugBatchLogRelevancyRequest) (*generated.DebugBatchLogRelevancyResponse, error) {
+	enclaveResp, sysError := s.enclave.DebugBatchLogRelevancy(req.EncryptedParams)
+	if sysError != nil {
+		s.logger.Error("Error debugging the event relevancy of the batch", log.ErrKey, sysError)
+		return &generated.DebugBatchLogRelevancyResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.DebugBatchLogRelevancyResponse{EncodedEnclaveResponse: enclaveResp.Encode()}, nil
+}
+
+func (s *RPCServer) SetProfilerEnabled(_ context.Context, req *generated.SetProfilerEnabledRequest) (*generated.SetProfilerEnabledResponse, error) {
+	status, sysError := s.enclave.SetProfilerEnabled(req.Enabled)
+	if sysError != nil {
+		s.logger.Error("Error setting the profiler", log.ErrKey, sysError)
+		return &generated.SetProfilerEnabledResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.SetProfilerEnabledResponse{Running: status.Running, Address: status.Address, Message: status.Message}, nil
+}
+
+func (s *RPCServer) SetLogLevel(_ context.Context, req *generated.SetLogLevelRequest) (*generated.SetLogLevelResponse, error) {
+	levels, sysError := s.enclave.SetLogLevel(req.Component, int(req.Level))
+	if sysError != nil {
+		s.logger.Error("Error setting the log level", log.ErrKey, sysError)
+		return &generated.SetLogLevelResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	rpcLevels := make(map[string]int32, len(levels))
+	for component, level := range levels {
+		rpcLevels[component] = int32(level)
+	}
+	return &generated.SetLogLevelResponse{Levels: rpcLevels}, nil
+}
+
+func (s *RPCServer) GetEnclaveConfiguration(_ context.Context, _ *generated.EmptyArgs) (*generated.GetEnclaveConfigurationResponse, error) {
+	configuration, sysError := s.enclave.GetEnclaveConfiguration()
+	if sysError != nil {
+		// the enclaves which serve their configuration to no one are not failing
+		if responses.ErrorCodeOf(sysError).IsSystemError() {
+			s.logger.Error("Error getting the enclave configuration", log.ErrKey, sysError)
+		}
+		return &generated.GetEnclaveConfigurationResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	encoded, err := json.Marshal(configuration)
+	if err != nil {
+		return &generated.GetEnclaveConfigurationResponse{SystemError: toRPCError(err)}, nil
+	}
+	return &generated.GetEnclaveConfigurationResponse{EncodedConfiguration: encoded}, nil
+}
+
+func (s *RPCServer) GetNetworkInfo(_ context.Context, _ *generated.EmptyArgs) (*generated.GetNetworkInfoResponse, error) {
+	info, sysError := s.enclave.GetNetworkInfo()
+	if sysError != nil {
+		s.logger.Error("Error getting the network info", log.ErrKey, sysError)
+		return &generated.GetNetworkInfoResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.GetNetworkInfoResponse{NetworkInfo: rpc.ToNetworkInfoMsg(info)}, nil
 }
 
 func (s *RPCServer) GetTotalContractCount(_ context.Context, _ *generated.GetTotalContractCountRequest) (*generated.GetTotalContractCountResponse, error) {
@@ -453,7 +862,29 @@ func (s *RPCServer) EnclavePublicConfig(_ context.Context, _ *generated.EnclaveP
 		s.logger.Error("Error getting message bus address", log.ErrKey, sysError)
 		return &generated.EnclavePublicConfigResponse{SystemError: toRPCError(sysError)}, nil
 	}
-	return &generated.EnclavePublicConfigResponse{L2MessageBusAddress: enclaveCfg.L2MessageBusAddress.Bytes()}, nil
+	systemContracts := make(map[string][]byte, len(enclaveCfg.SystemContracts))
+	for name, address := range enclaveCfg.SystemContracts {
+		systemContracts[name] = address.Bytes()
+	}
+	var minGasPrice []byte
+	if enclaveCfg.MinGasPrice != nil {
+		minGasPrice = enclaveCfg.MinGasPrice.Bytes()
+	}
+	var baseFee []byte
+	if enclaveCfg.BaseFee != nil {
+		baseFee = enclaveCfg.BaseFee.Bytes()
+	}
+	return &generated.EnclavePublicConfigResponse{
+		L2MessageBusAddress: enclaveCfg.L2MessageBusAddress.Bytes(),
+		L1MessageBusAddress: enclaveCfg.L1MessageBusAddress.Bytes(),
+		BridgeAddress:       enclaveCfg.BridgeAddress.Bytes(),
+		SystemContracts:     systemContracts,
+		L2ChainID:           enclaveCfg.L2ChainID,
+		MinGasPrice:         minGasPrice,
+		Version:             enclaveCfg.Version,
+		BaseFee:             baseFee,
+		GasPaymentAddress:   enclaveCfg.GasPaymentAddress.Bytes(),
+	}, nil
 }
 
 func (s *RPCServer) decodeBlock(encodedBlock []byte) (types.Block, error) {
@@ -481,8 +912,14 @@ func toRPCError(err common.SystemError) *generated.SystemError {
 	if err == nil {
 		return nil
 	}
+	// the user errors keep their code, so the host does not mistake them for failures of the enclave
+	code := int32(1)
+	var codedErr *responses.CodedError
+	if errors.As(err, &codedErr) && !codedErr.Code.IsSystemError() {
+		code = int32(codedErr.Code)
+	}
 	return &generated.SystemError{
-		ErrorCode:   1,
+		ErrorCode:   code,
 		ErrorString: err.Error(),
 	}
 }
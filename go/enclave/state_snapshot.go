@@ -0,0 +1,224 @@
+package enclave
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/responses"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// ExportStateSnapshot - the requester is verified as the enclaves requesting the shared secret are, because the
+// snapshot holds the private state of the network
+func (e *enclaveImpl) ExportStateSnapshot(rollupHash common.L2RollupHash, requester *common.AttestationReport) (*common.EncryptedStateSnapshot, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested ExportStateSnapshot with the enclave stopping"))
+	}
+	if requester == nil {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, errors.New("the attestation of the requester is missing"))
+	}
+	data, err := e.attestationProvider.VerifyReport(requester)
+	if err != nil {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, fmt.Errorf("could not verify the attestation of the requester. Cause: %w", err))
+	}
+	if err = components.VerifyIdentity(data, requester); err != nil {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, fmt.Errorf("could not verify the identity of the requester. Cause: %w", err))
+	}
+
+	snapshot, err := e.stateSnapshot(rollupHash)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not export the state snapshot of rollup %s. Cause: %w", rollupHash, err))
+	}
+	encoded, err := rlp.EncodeToBytes(snapshot)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not encode the state snapshot. Cause: %w", err))
+	}
+	encrypted, err := crypto.EncryptForEnclave(requester.PubKey, encoded)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not encrypt the state snapshot. Cause: %w", err))
+	}
+	// anyone can encrypt with the key of the requester, so the snapshot is signed for the importer to know it was
+	// exported by an attested enclave
+	digest, err := common.StateSnapshotDigest(snapshot.Info, encrypted)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not digest the state snapshot. Cause: %w", err))
+	}
+	signature, err := gethcrypto.Sign(digest.Bytes(), e.enclaveKey.PrivateKey())
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not sign the state snapshot. Cause: %w", err))
+	}
+	e.logger.Info("Exported a state snapshot", log.RollupHashKey, rollupHash, log.BatchSeqNoKey, snapshot.Info.SeqNo,
+		"requester", requester.Owner, "stateNodes", len(snapshot.StateNodes), "size", len(encrypted))
+	return &common.EncryptedStateSnapshot{Info: snapshot.Info, Snapshot: encrypted, Signature: signature}, nil
+}
+
+// stateSnapshot - the snapshot of the state after the last batch of the canonical rollup
+func (e *enclaveImpl) stateSnapshot(rollupHash common.L2RollupHash) (*storage.StateSnapshot, error) {
+	rollup, err := e.storage.FetchRollupMetadata(rollupHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the rollup. Cause: %w", err)
+	}
+	if !rollup.IsCanonical {
+		return nil, errors.New("the rollup is not canonical")
+	}
+	batch, err := e.storage.FetchBatchBySeqNo(rollup.LastBatchSeqNo)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the last batch of the rollup. Cause: %w", err)
+	}
+	executed, err := e.storage.BatchWasExecuted(batch.Hash())
+	if err != nil {
+		return nil, err
+	}
+	if !executed {
+		return nil, fmt.Errorf("the last batch of the rollup %d was not executed yet", rollup.LastBatchSeqNo)
+	}
+
+	snapshot, err := e.storage.ExportStateSnapshot(batch)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Info = common.StateSnapshotInfo{
+		RollupHash: rollupHash,
+		BatchHash:  batch.Hash(),
+		SeqNo:      batch.SeqNo().Uint64(),
+		Height:     batch.NumberU64(),
+		StateRoot:  batch.Header.Root,
+		ExporterID: e.enclaveKey.EnclaveID(),
+	}
+	return snapshot, nil
+}
+
+// ImportStateSnapshot - the validator then executes the batches following the snapshot as they arrive
+func (e *enclaveImpl) ImportStateSnapshot(encrypted *common.EncryptedStateSnapshot, l1Attestations []*common.AttestationReport) (*common.StateSnapshotInfo, common.SystemError) {
+	if e.stopControl.IsStopping() {
+		return nil, responses.ToInternalError(fmt.Errorf("requested ImportStateSnapshot with the enclave stopping"))
+	}
+	if e.config.NodeType != common.Validator {
+		return nil, responses.ToInternalError(errors.New("only a validator can import a state snapshot"))
+	}
+	if encrypted == nil {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, errors.New("the state snapshot is missing"))
+	}
+	attestedKeys, err := e.verifyL1Attestations(l1Attestations)
+	if err != nil {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, err)
+	}
+	if _, found := attestedKeys[e.config.SequencerID]; !found {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, fmt.Errorf("the attestation of the sequencer %s is missing", e.config.SequencerID))
+	}
+	if err = verifyStateSnapshotSignature(encrypted, attestedKeys); err != nil {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, err)
+	}
+
+	decrypted, err := crypto.DecryptWithEnclaveKey(encrypted.Snapshot, e.enclaveKey.PrivateKey())
+	if err != nil {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, fmt.Errorf("could not decrypt the state snapshot. Cause: %w", err))
+	}
+	snapshot := new(storage.StateSnapshot)
+	if err = rlp.DecodeBytes(decrypted, snapshot); err != nil {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, fmt.Errorf("could not decode the state snapshot. Cause: %w", err))
+	}
+	if err = checkStateSnapshot(snapshot, encrypted.Info); err != nil {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, fmt.Errorf("invalid state snapshot. Cause: %w", err))
+	}
+
+	if err = e.storage.ImportStateSnapshot(snapshot); err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not import the state snapshot. Cause: %w", err))
+	}
+	// the enclave starts processing the L1 blocks after the ones which published the attestations, so it stores the
+	// attested keys as it would have when processing them. The following batches are verified with the one of the
+	// sequencer.
+	for owner, key := range attestedKeys {
+		if err = e.storage.StoreAttestedKey(owner, key); err != nil {
+			return nil, responses.ToInternalError(fmt.Errorf("could not store the attested key of %s. Cause: %w", owner, err))
+		}
+	}
+	e.registry.OnBatchExecuted(&core.Batch{Header: snapshot.Header, Transactions: snapshot.Transactions}, nil)
+	e.logger.Info("Imported a state snapshot", log.RollupHashKey, snapshot.Info.RollupHash, log.BatchHashKey, snapshot.Info.BatchHash,
+		log.BatchSeqNoKey, snapshot.Info.SeqNo, "exporter", snapshot.Info.ExporterID)
+	return &snapshot.Info, nil
+}
+
+// verifyL1Attestations - the attested keys of the attestations published on L1, by owner. They are verified as the
+// attestations of the secret requests are, as the host could forge them.
+func (e *enclaveImpl) verifyL1Attestations(l1Attestations []*common.AttestationReport) (map[gethcommon.Address]*ecdsa.PublicKey, error) {
+	attestedKeys := make(map[gethcommon.Address]*ecdsa.PublicKey, len(l1Attestations))
+	for _, attestation := range l1Attestations {
+		data, err := e.attestationProvider.VerifyReport(attestation)
+		if err != nil {
+			return nil, fmt.Errorf("could not verify the attestation of %s. Cause: %w", attestation.Owner, err)
+		}
+		if err = components.VerifyIdentity(data, attestation); err != nil {
+			return nil, fmt.Errorf("could not verify the identity of %s. Cause: %w", attestation.Owner, err)
+		}
+		key, err := gethcrypto.DecompressPubkey(attestation.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key in the attestation of %s. Cause: %w", attestation.Owner, err)
+		}
+		attestedKeys[attestation.Owner] = key
+	}
+	return attestedKeys, nil
+}
+
+// verifyStateSnapshotSignature - the snapshot must be signed by the exporting enclave, with one of the attested keys
+func verifyStateSnapshotSignature(encrypted *common.EncryptedStateSnapshot, attestedKeys map[gethcommon.Address]*ecdsa.PublicKey) error {
+	digest, err := common.StateSnapshotDigest(encrypted.Info, encrypted.Snapshot)
+	if err != nil {
+		return fmt.Errorf("could not digest the state snapshot. Cause: %w", err)
+	}
+	signer, err := gethcrypto.SigToPub(digest.Bytes(), encrypted.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature of the state snapshot. Cause: %w", err)
+	}
+	if common.EnclaveIDFromPublicKey(gethcrypto.CompressPubkey(signer)) != encrypted.Info.ExporterID {
+		return fmt.Errorf("the state snapshot was not signed by the exporter %s", encrypted.Info.ExporterID)
+	}
+	for _, key := range attestedKeys {
+		if key.Equal(signer) {
+			return nil
+		}
+	}
+	return fmt.Errorf("the exporter %s of the state snapshot is not attested on L1", encrypted.Info.ExporterID)
+}
+
+// checkStateSnapshot - the snapshot must be the one described by the info shipped with it, and its batch must be
+// consistent with its state, its transactions and its L1 block
+func checkStateSnapshot(snapshot *storage.StateSnapshot, info common.StateSnapshotInfo) error {
+	if snapshot.Header == nil || snapshot.Block == nil || snapshot.Summary == nil {
+		return errors.New("the snapshot is incomplete")
+	}
+	txHash := types.EmptyRootHash
+	if len(snapshot.Transactions) > 0 {
+		txHash = types.DeriveSha(types.Transactions(snapshot.Transactions), trie.NewStackTrie(nil))
+	}
+
+	switch {
+	case snapshot.Info != info:
+		return fmt.Errorf("the snapshot is for batch %s, not for batch %s", snapshot.Info.BatchHash, info.BatchHash)
+	case snapshot.Header.Hash() != info.BatchHash:
+		return fmt.Errorf("the batch of the snapshot is %s, not %s", snapshot.Header.Hash(), info.BatchHash)
+	case snapshot.Header.SequencerOrderNo.Uint64() != info.SeqNo || snapshot.Header.Number.Uint64() != info.Height:
+		return errors.New("the seq number or the height of the batch do not match the info")
+	case snapshot.Header.Root != info.StateRoot:
+		return fmt.Errorf("the state root of the batch is %s, not %s", snapshot.Header.Root, info.StateRoot)
+	case snapshot.Header.TxHash != txHash:
+		return errors.New("the transactions do not match the batch")
+	case snapshot.Block.Hash() != snapshot.Header.L1Proof:
+		return fmt.Errorf("the L1 block is %s, not the L1 proof of the batch %s", snapshot.Block.Hash(), snapshot.Header.L1Proof)
+	case snapshot.Summary.BatchHash != info.BatchHash:
+		return errors.New("the execution summary is not for the batch")
+	}
+	return nil
+}
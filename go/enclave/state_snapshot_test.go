@@ -0,0 +1,54 @@
+package enclave
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+func signedStateSnapshot(t *testing.T, key *ecdsa.PrivateKey) *common.EncryptedStateSnapshot {
+	snapshot := &common.EncryptedStateSnapshot{
+		Info: common.StateSnapshotInfo{
+			SeqNo:      7,
+			ExporterID: common.EnclaveIDFromPublicKey(gethcrypto.CompressPubkey(&key.PublicKey)),
+		},
+		Snapshot: []byte("encrypted state"),
+	}
+	digest, err := common.StateSnapshotDigest(snapshot.Info, snapshot.Snapshot)
+	require.NoError(t, err)
+	snapshot.Signature, err = gethcrypto.Sign(digest.Bytes(), key)
+	require.NoError(t, err)
+	return snapshot
+}
+
+func TestStateSnapshotSignedByAttestedExporter(t *testing.T) {
+	exporter, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	attestedKeys := map[gethcommon.Address]*ecdsa.PublicKey{{1}: &exporter.PublicKey}
+
+	require.NoError(t, verifyStateSnapshotSignature(signedStateSnapshot(t, exporter), attestedKeys))
+}
+
+func TestStateSnapshotSignatureRejected(t *testing.T) {
+	exporter, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	forger, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	attestedKeys := map[gethcommon.Address]*ecdsa.PublicKey{{1}: &exporter.PublicKey}
+
+	unsigned := signedStateSnapshot(t, exporter)
+	unsigned.Signature = nil
+	require.ErrorContains(t, verifyStateSnapshotSignature(unsigned, attestedKeys), "invalid signature")
+
+	// the host altered the snapshot after it was signed
+	tampered := signedStateSnapshot(t, exporter)
+	tampered.Snapshot = []byte("forged state")
+	require.ErrorContains(t, verifyStateSnapshotSignature(tampered, attestedKeys), "not signed by the exporter")
+
+	// the forger signs for itself, but its key was never attested on L1
+	require.ErrorContains(t, verifyStateSnapshotSignature(signedStateSnapshot(t, forger), attestedKeys), "not attested on L1")
+}
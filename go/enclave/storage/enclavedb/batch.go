@@ -173,6 +173,10 @@ func ReadNonCanonicalBatches(db *sql.DB, startAtSeq uint64, endSeq uint64) ([]*c
 	return fetchBatches(db, " where b.sequence>=? and b.sequence <=? and b.is_canonical=false order by b.sequence", startAtSeq, endSeq)
 }
 
+func ReadBatchesBySeqRange(db *sql.DB, fromSeq uint64, toSeq uint64, limit uint64) ([]*core.Batch, error) {
+	return fetchBatches(db, " where b.sequence>=? and b.sequence<=? order by b.sequence limit ?", fromSeq, toSeq, limit)
+}
+
 func ReadBatchHeader(db *sql.DB, hash gethcommon.Hash) (*common.BatchHeader, error) {
 	return fetchBatchHeader(db, " where hash=?", truncTo16(hash))
 }
@@ -455,8 +459,8 @@ func ReadContractCreationCount(db *sql.DB) (*big.Int, error) {
 	return big.NewInt(count), nil
 }
 
-func ReadUnexecutedBatches(db *sql.DB, from *big.Int) ([]*core.Batch, error) {
-	return fetchBatches(db, "where is_executed=false and is_canonical=true and sequence >= ? order by b.sequence", from.Uint64())
+func ReadUnexecutedBatches(db *sql.DB, from *big.Int, limit uint64) ([]*core.Batch, error) {
+	return fetchBatches(db, "where is_executed=false and is_canonical=true and sequence >= ? order by b.sequence limit ?", from.Uint64(), limit)
 }
 
 func BatchWasExecuted(db *sql.DB, hash common.L2BatchHash) (bool, error) {
@@ -547,6 +551,8 @@ func GetPublicTransactionCount(db *sql.DB) (uint64, error) {
 	return count, nil
 }
 
+// FetchConvertedBatchHash - the converted hash of the batch. The batches stored by older versions might miss it, and
+// errutil.ErrNotFound is returned for them too.
 func FetchConvertedBatchHash(db *sql.DB, seqNo uint64) (gethcommon.Hash, error) {
 	var hash []byte
 
@@ -559,5 +565,52 @@ func FetchConvertedBatchHash(db *sql.DB, seqNo uint64) (gethcommon.Hash, error)
 		}
 		return gethcommon.Hash{}, err
 	}
+	convertedHash := gethcommon.BytesToHash(hash)
+	if convertedHash == (gethcommon.Hash{}) {
+		return gethcommon.Hash{}, errutil.ErrNotFound
+	}
+	return convertedHash, nil
+}
+
+// FetchBatchHashByConvertedHash - the hash of the batch converted to the header with the hash, preferring the
+// canonical batch
+func FetchBatchHashByConvertedHash(db *sql.DB, convertedHash gethcommon.Hash) (gethcommon.Hash, error) {
+	var hash []byte
+
+	query := "select full_hash from batch where converted_hash=? order by is_canonical desc limit 1"
+	err := db.QueryRow(query, convertedHash.Bytes()).Scan(&hash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// make sure the error is converted to obscuro-wide not found error
+			return gethcommon.Hash{}, errutil.ErrNotFound
+		}
+		return gethcommon.Hash{}, err
+	}
 	return gethcommon.BytesToHash(hash), nil
 }
+
+// FetchSeqNosWithoutConvertedHash - the sequence numbers of the batches stored without their converted hash, lowest
+// first, at most limit
+func FetchSeqNosWithoutConvertedHash(db *sql.DB, limit uint64) ([]uint64, error) {
+	query := "select sequence from batch where converted_hash is null or converted_hash=? order by sequence limit ?"
+	rows, err := db.Query(query, gethcommon.Hash{}.Bytes(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var seqNos []uint64
+	for rows.Next() {
+		var seqNo uint64
+		if err := rows.Scan(&seqNo); err != nil {
+			return nil, err
+		}
+		seqNos = append(seqNos, seqNo)
+	}
+	return seqNos, rows.Err()
+}
+
+func WriteConvertedBatchHash(db *sql.DB, seqNo uint64, convertedHash gethcommon.Hash) error {
+	_, err := db.Exec("update batch set converted_hash=? where sequence=?", convertedHash.Bytes(), seqNo)
+	return err
+}
@@ -0,0 +1,74 @@
+package enclavedb
+
+import (
+	"database/sql"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+const (
+	// only the canonical batches are executed, so a summary is not orphaned when it is recorded
+	batchExecutionSummaryInsert = "replace into batch_execution_summary values (?,?,?,?,?,?,?,?,false)"
+	selectBatchExecutionSummary = "select sequence, height, batch_hash, state_root, receipts_root, gas_used, tx_count, is_orphaned from batch_execution_summary"
+
+	updateOrphanedSummaries = "update batch_execution_summary set is_orphaned=? where l1_proof in "
+)
+
+// WriteBatchExecutionSummary - records the summary of the execution of the batch, which was built on the L1 block
+func WriteBatchExecutionSummary(dbtx DBTransaction, summary *common.ExecutedBatchSummary, l1Proof common.L1BlockHash) {
+	dbtx.ExecuteSQL(batchExecutionSummaryInsert,
+		summary.SeqNo,
+		summary.BatchHash.Bytes(),
+		truncTo16(l1Proof),
+		summary.Height,
+		summary.StateRoot.Bytes(),
+		summary.ReceiptsRoot.Bytes(),
+		summary.GasUsed,
+		summary.TxCount,
+	)
+}
+
+// FetchBatchExecutionSummary - returns the summary of the executed batch with the seq number, or errutil.ErrNotFound
+func FetchBatchExecutionSummary(db *sql.DB, seqNo uint64) (*common.ExecutedBatchSummary, error) {
+	summaries, err := fetchBatchExecutionSummaries(db, " where sequence=?", seqNo)
+	if err != nil {
+		return nil, err
+	}
+	if len(summaries) == 0 {
+		return nil, errutil.ErrNotFound
+	}
+	return summaries[0], nil
+}
+
+// FetchBatchExecutionSummaries - returns at most limit summaries of the executed batches with the seq numbers between
+// from and to (inclusive), ordered by seq number
+func FetchBatchExecutionSummaries(db *sql.DB, fromSeqNo uint64, toSeqNo uint64, limit uint64) ([]*common.ExecutedBatchSummary, error) {
+	return fetchBatchExecutionSummaries(db, " where sequence>=? and sequence<=? order by sequence limit ?", fromSeqNo, toSeqNo, limit)
+}
+
+func fetchBatchExecutionSummaries(db *sql.DB, whereQuery string, args ...any) ([]*common.ExecutedBatchSummary, error) {
+	rows, err := db.Query(selectBatchExecutionSummary+whereQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query the batch execution summaries. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	summaries := make([]*common.ExecutedBatchSummary, 0)
+	for rows.Next() {
+		var batchHash, stateRoot, receiptsRoot []byte
+		summary := new(common.ExecutedBatchSummary)
+		err := rows.Scan(&summary.SeqNo, &summary.Height, &batchHash, &stateRoot, &receiptsRoot, &summary.GasUsed,
+			&summary.TxCount, &summary.Orphaned)
+		if err != nil {
+			return nil, err
+		}
+		summary.BatchHash = gethcommon.BytesToHash(batchHash)
+		summary.StateRoot = gethcommon.BytesToHash(stateRoot)
+		summary.ReceiptsRoot = gethcommon.BytesToHash(receiptsRoot)
+		summaries = append(summaries, summary)
+	}
+	return summaries, rows.Err()
+}
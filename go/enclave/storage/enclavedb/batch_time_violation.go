@@ -0,0 +1,43 @@
+package enclavedb
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+const (
+	batchTimeViolationInsert = "replace into batch_time_violation values (?,?,?)"
+	selectBatchTimeViolation = "select report from batch_time_violation where batch_hash=?"
+)
+
+// WriteBatchTimeViolation - records the timestamp violation of a batch, replacing the previous one for its seq number
+func WriteBatchTimeViolation(dbtx DBTransaction, violation *common.BatchTimeViolation) error {
+	encoded, err := json.Marshal(violation)
+	if err != nil {
+		return fmt.Errorf("could not encode the batch time violation. Cause: %w", err)
+	}
+	dbtx.ExecuteSQL(batchTimeViolationInsert, violation.SeqNo, violation.BatchHash.Bytes(), encoded)
+	return nil
+}
+
+// FetchBatchTimeViolation - returns the timestamp violation of the batch, or errutil.ErrNotFound
+func FetchBatchTimeViolation(db *sql.DB, batchHash common.L2BatchHash) (*common.BatchTimeViolation, error) {
+	var encoded []byte
+	err := db.QueryRow(selectBatchTimeViolation, batchHash.Bytes()).Scan(&encoded)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errutil.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not query the batch time violation. Cause: %w", err)
+	}
+	violation := new(common.BatchTimeViolation)
+	if err := json.Unmarshal(encoded, violation); err != nil {
+		return nil, fmt.Errorf("could not decode the batch time violation. Cause: %w", err)
+	}
+	return violation, nil
+}
@@ -22,13 +22,15 @@ const (
 	l1msgValue  = "(?,?,?)"
 	selectL1Msg = "select message from l1_msg "
 
-	rollupInsert = "replace into rollup values (?,?,?,?,?)"
+	rollupInsert = "replace into rollup (hash, start_seq, end_seq, header, compression_block, l1_block, compressed_size, is_canonical) values (?,?,?,?,?,?,?,?)"
 	rollupSelect = "select hash from rollup where compression_block in "
 
 	updateCanonicalBlock = "update block set is_canonical=? where hash in "
 
 	// todo - do we need the is_canonical field?
 	updateCanonicalBatches = "update batch set is_canonical=? where l1_proof in "
+	// the reorged rollups are kept, so they can still be looked up
+	updateCanonicalRollups = "update rollup set is_canonical=? where l1_block in "
 )
 
 func WriteBlock(dbtx DBTransaction, b *types.Header) error {
@@ -67,13 +69,23 @@ func updateCanonicalValue(dbtx DBTransaction, isCanonical bool, values []common.
 	updateBlocks := updateCanonicalBlock + "(" + argPlaceholders + ")"
 	updateBatches := updateCanonicalBatches + "(" + argPlaceholders + ")"
 
+	updateRollups := updateCanonicalRollups + "(" + argPlaceholders + ")"
+	updateSummaries := updateOrphanedSummaries + "(" + argPlaceholders + ")"
+
 	args := make([]any, 0)
 	args = append(args, isCanonical)
+	rollupArgs := []any{isCanonical}
+	summaryArgs := []any{!isCanonical}
 	for _, value := range values {
 		args = append(args, truncTo16(value))
+		rollupArgs = append(rollupArgs, value.Bytes())
+		summaryArgs = append(summaryArgs, truncTo16(value))
 	}
 	dbtx.ExecuteSQL(updateBlocks, args...)
 	dbtx.ExecuteSQL(updateBatches, args...)
+	dbtx.ExecuteSQL(updateRollups, rollupArgs...)
+	// the summaries of the executed batches are kept, marked as orphaned while their block is not canonical
+	dbtx.ExecuteSQL(updateSummaries, summaryArgs...)
 }
 
 func FetchBlockHeader(db *sql.DB, hash common.L1BlockHash) (*types.Header, error) {
@@ -146,7 +158,8 @@ func FetchL1Messages[T any](db *sql.DB, blockHash common.L1BlockHash, isTransfer
 	return result, nil
 }
 
-func WriteRollup(dbtx DBTransaction, rollup *common.RollupHeader, internalHeader *common.CalldataRollupHeader) error {
+// WriteRollup - stores the rollup published in the l1Block, which is canonical when the rollup is processed
+func WriteRollup(dbtx DBTransaction, rollup *common.RollupHeader, internalHeader *common.CalldataRollupHeader, l1Block common.L1BlockHash, compressedSize uint64) error {
 	// Write the encoded header
 	data, err := rlp.EncodeToBytes(rollup)
 	if err != nil {
@@ -158,6 +171,9 @@ func WriteRollup(dbtx DBTransaction, rollup *common.RollupHeader, internalHeader
 		rollup.LastBatchSeqNo,
 		data,
 		truncTo16(rollup.CompressionL1Head),
+		l1Block.Bytes(),
+		compressedSize,
+		true,
 	)
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 
 const (
 	cfgInsert = "insert into config values (?,?)"
+	cfgUpsert = "replace into config values (?,?)"
 	cfgUpdate = "update config set val=? where ky=?"
 	cfgSelect = "select val from config where ky=?"
 )
@@ -31,6 +32,16 @@ func WriteConfig(db *sql.DB, key string, value []byte) (sql.Result, error) {
 	return db.Exec(cfgInsert, key, value)
 }
 
+// UpsertConfigToTx - inserts the value, or overwrites it if the key exists
+func UpsertConfigToTx(dbtx *sql.Tx, key string, value any) (sql.Result, error) {
+	return dbtx.Exec(cfgUpsert, key, value)
+}
+
+// UpsertConfig - inserts the value, or overwrites it if the key exists
+func UpsertConfig(db *sql.DB, key string, value []byte) (sql.Result, error) {
+	return db.Exec(cfgUpsert, key, value)
+}
+
 func UpdateConfigToBatch(dbtx DBTransaction, key string, value []byte) {
 	dbtx.ExecuteSQL(cfgUpdate, key, value)
 }
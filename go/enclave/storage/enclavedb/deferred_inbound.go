@@ -0,0 +1,52 @@
+package enclavedb
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+const (
+	deferredInboundInsert = "replace into deferred_inbound values (?,?,?)"
+	selectDeferredInbound = "select messages, transfers from deferred_inbound where batch=?"
+)
+
+// WriteDeferredInbound - records the inbound messages and value transfers which the batch deferred to its children
+func WriteDeferredInbound(dbtx DBTransaction, batchHash common.L2BatchHash, messages common.CrossChainMessages, transfers common.ValueTransferEvents) error {
+	encodedMessages, err := rlp.EncodeToBytes(messages)
+	if err != nil {
+		return fmt.Errorf("could not encode the deferred messages. Cause: %w", err)
+	}
+	encodedTransfers, err := rlp.EncodeToBytes(transfers)
+	if err != nil {
+		return fmt.Errorf("could not encode the deferred value transfers. Cause: %w", err)
+	}
+	dbtx.ExecuteSQL(deferredInboundInsert, batchHash.Bytes(), encodedMessages, encodedTransfers)
+	return nil
+}
+
+// FetchDeferredInbound - returns the inbound messages and value transfers deferred by the batch, or errutil.ErrNotFound
+func FetchDeferredInbound(db *sql.DB, batchHash common.L2BatchHash) (common.CrossChainMessages, common.ValueTransferEvents, error) {
+	var encodedMessages, encodedTransfers []byte
+	err := db.QueryRow(selectDeferredInbound, batchHash.Bytes()).Scan(&encodedMessages, &encodedTransfers)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, errutil.ErrNotFound
+		}
+		return nil, nil, fmt.Errorf("could not query the deferred inbound messages. Cause: %w", err)
+	}
+
+	var messages common.CrossChainMessages
+	if err := rlp.DecodeBytes(encodedMessages, &messages); err != nil {
+		return nil, nil, fmt.Errorf("could not decode the deferred messages. Cause: %w", err)
+	}
+	var transfers common.ValueTransferEvents
+	if err := rlp.DecodeBytes(encodedTransfers, &transfers); err != nil {
+		return nil, nil, fmt.Errorf("could not decode the deferred value transfers. Cause: %w", err)
+	}
+	return messages, transfers, nil
+}
@@ -1,6 +1,7 @@
 package enclavedb
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"math/big"
@@ -11,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/enclave/visibility"
 )
 
 const (
@@ -22,12 +24,14 @@ const (
 	orderBy                    = " order by b.height, tx.idx asc"
 )
 
-func StoreEventLogs(dbtx DBTransaction, receipts []*types.Receipt, stateDB *state.StateDB) error {
+// StoreEventLogs - stores the logs of the receipts with their relevancy, decided with the state before their batch. The
+// senders of the transactions are only needed by the logs configured as visible to the sender.
+func StoreEventLogs(dbtx DBTransaction, receipts []*types.Receipt, senders map[gethcommon.Hash]*gethcommon.Address, stateDB *state.StateDB) error {
 	var args []any
 	totalLogs := 0
 	for _, receipt := range receipts {
 		for _, l := range receipt.Logs {
-			logArgs, err := logDBValues(dbtx.GetDB(), l, receipt, stateDB)
+			logArgs, err := logDBValues(dbtx.GetDB(), l, receipt, senders[l.TxHash], stateDB)
 			if err != nil {
 				return err
 			}
@@ -49,7 +53,12 @@ func StoreEventLogs(dbtx DBTransaction, receipts []*types.Receipt, stateDB *stat
 // The other 4 topics are set by the programmer
 // According to the data relevancy rules, an event is relevant to accounts referenced directly in topics
 // If the event is not referring any user address, it is considered a "lifecycle event", and is relevant to everyone
-func logDBValues(db *sql.DB, l *types.Log, receipt *types.Receipt, stateDB *state.StateDB) ([]any, error) {
+// The contract can replace these rules with the visibility rule it configured for the event
+func logDBValues(db *sql.DB, l *types.Log, receipt *types.Receipt, sender *gethcommon.Address, stateDB *state.StateDB) ([]any, error) {
+	if rule := visibility.RuleOf(l, stateDB); rule != visibility.Default {
+		return configuredLogDBValues(l, receipt, rule, sender), nil
+	}
+
 	// The topics are stored in an array with a maximum of 5 entries, but usually less
 	var t0, t1, t2, t3, t4 []byte
 
@@ -129,7 +138,45 @@ func logDBValues(db *sql.DB, l *types.Log, receipt *types.Receipt, stateDB *stat
 	}, nil
 }
 
+// configuredLogDBValues - the values of a log whose visibility rule was configured by its contract. Only the account
+// which can see a private log is stored, as its first relevant address.
+func configuredLogDBValues(l *types.Log, receipt *types.Receipt, rule visibility.Rule, sender *gethcommon.Address) []any {
+	var t0, t1, t2, t3, t4 []byte
+	topics := []*[]byte{&t0, &t1, &t2, &t3, &t4}
+	for i, topic := range l.Topics {
+		if i < len(topics) {
+			*topics[i] = topic.Bytes()
+		}
+	}
+
+	var a1, a2, a3, a4 []byte
+	switch rule {
+	case visibility.Topic1Owner:
+		if owner := visibility.Topic1Account(l); owner != nil {
+			a1 = owner.Bytes()
+		}
+	case visibility.SenderOnly:
+		if sender != nil {
+			a1 = sender.Bytes()
+		}
+	}
+
+	// normalise the data field to nil to avoid duplicates
+	data := l.Data
+	if len(data) == 0 {
+		data = nil
+	}
+
+	return []any{
+		t0, t1, t2, t3, t4,
+		data, l.Index, l.Address.Bytes(),
+		rule == visibility.Public, a1, a2, a3, a4,
+		executedTransactionID(&receipt.BlockHash, &l.TxHash),
+	}
+}
+
 func FilterLogs(
+	ctx context.Context,
 	db *sql.DB,
 	requestingAccount *gethcommon.Address,
 	fromBlock, toBlock *big.Int,
@@ -176,7 +223,7 @@ func FilterLogs(
 		}
 	}
 
-	return loadLogs(db, requestingAccount, query, queryParams)
+	return loadLogs(ctx, db, requestingAccount, query, queryParams)
 }
 
 func DebugGetLogs(db *sql.DB, txHash common.TxHash) ([]*tracers.DebugLogs, error) {
@@ -292,7 +339,7 @@ func isEndUserAccount(db *sql.DB, topic gethcommon.Hash, stateDB *state.StateDB)
 
 // utility function that knows how to load relevant logs from the database
 // todo always pass in the actual batch hashes because of reorgs, or make sure to clean up log entries from discarded batches
-func loadLogs(db *sql.DB, requestingAccount *gethcommon.Address, whereCondition string, whereParams []any) ([]*types.Log, error) {
+func loadLogs(ctx context.Context, db *sql.DB, requestingAccount *gethcommon.Address, whereCondition string, whereParams []any) ([]*types.Log, error) {
 	if requestingAccount == nil {
 		return nil, fmt.Errorf("logs can only be requested for an account")
 	}
@@ -315,7 +362,7 @@ func loadLogs(db *sql.DB, requestingAccount *gethcommon.Address, whereCondition
 
 	query += orderBy
 
-	rows, err := db.Query(query, queryParams...)
+	rows, err := db.QueryContext(ctx, query, queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,54 @@
+package enclavedb
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+const (
+	mempoolTxInsert = "replace into mempool_tx values (?,?)"
+	mempoolTxDelete = "delete from mempool_tx where hash in "
+	mempoolTxSelect = "select content from mempool_tx"
+)
+
+// WriteMempoolTx - the content is expected to be already encrypted
+func WriteMempoolTx(db *sql.DB, hash common.L2TxHash, content []byte) error {
+	_, err := db.Exec(mempoolTxInsert, hash.Bytes(), content)
+	return err
+}
+
+func DeleteMempoolTxs(db *sql.DB, hashes []common.L2TxHash) error {
+	if len(hashes) == 0 {
+		return nil
+	}
+	argPlaceholders := strings.Repeat("?,", len(hashes))
+	argPlaceholders = argPlaceholders[0 : len(argPlaceholders)-1] // remove trailing comma
+
+	args := make([]any, 0, len(hashes))
+	for _, hash := range hashes {
+		args = append(args, hash.Bytes())
+	}
+	_, err := db.Exec(mempoolTxDelete+"("+argPlaceholders+")", args...)
+	return err
+}
+
+func FetchMempoolTxs(db *sql.DB) ([][]byte, error) {
+	rows, err := db.Query(mempoolTxSelect)
+	if err != nil {
+		return nil, fmt.Errorf("could not query mempool transactions. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	var result [][]byte
+	for rows.Next() {
+		var content []byte
+		if err := rows.Scan(&content); err != nil {
+			return nil, err
+		}
+		result = append(result, content)
+	}
+	return result, rows.Err()
+}
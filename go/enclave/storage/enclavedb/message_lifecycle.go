@@ -0,0 +1,90 @@
+package enclavedb
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strings"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+)
+
+const (
+	// a transition recorded again, when a block or batch is processed twice, replaces the existing one
+	messageTransitionInsert      = "replace into message_lifecycle values "
+	messageTransitionInsertValue = "(?,?,?,?,?)"
+
+	// only the transitions recorded by canonical batches or blocks are returned
+	selectMessageTransitions = "select l.inbound, l.state, l.batch, b.full_hash, b.height, bl.header from message_lifecycle l " +
+		"left join batch b on b.sequence=l.batch left join block bl on bl.hash=l.block " +
+		"where l.hash=? and (b.is_canonical=true or bl.is_canonical=true)"
+)
+
+// WriteMessageTransitions - records the lifecycle transitions of cross chain messages
+func WriteMessageTransitions(dbtx DBTransaction, transitions []*core.MessageTransition) {
+	if len(transitions) == 0 {
+		return
+	}
+	insert := messageTransitionInsert + strings.Repeat(messageTransitionInsertValue+",", len(transitions))
+	insert = insert[0 : len(insert)-1] // remove trailing comma
+
+	args := make([]any, 0, 5*len(transitions))
+	for _, t := range transitions {
+		var block []byte
+		if t.L1Block != nil {
+			block = truncTo16(*t.L1Block)
+		}
+		args = append(args, t.MessageHash.Bytes(), t.Inbound, string(t.State), t.BatchSeqNo, block)
+	}
+	dbtx.ExecuteSQL(insert, args...)
+}
+
+// FetchMessageTransitions - returns the transitions of the message recorded on the canonical chains
+func FetchMessageTransitions(db *sql.DB, messageHash gethcommon.Hash) ([]*core.MessageTransition, error) {
+	rows, err := db.Query(selectMessageTransitions, messageHash.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("could not query message transitions. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]*core.MessageTransition, 0)
+	for rows.Next() {
+		var inbound bool
+		var state string
+		var batchSeq sql.NullInt64
+		var batchHash, header []byte
+		var batchHeight sql.NullInt64
+		if err := rows.Scan(&inbound, &state, &batchSeq, &batchHash, &batchHeight, &header); err != nil {
+			return nil, err
+		}
+
+		t := &core.MessageTransition{
+			MessageHash: messageHash,
+			Inbound:     inbound,
+			State:       common.CrossChainMessageState(state),
+		}
+		if batchSeq.Valid {
+			seq := uint64(batchSeq.Int64)
+			hash := gethcommon.BytesToHash(batchHash)
+			t.BatchSeqNo = &seq
+			t.BatchHash = &hash
+			t.BatchHeight = big.NewInt(batchHeight.Int64)
+		}
+		if len(header) > 0 {
+			h := new(types.Header)
+			if err := rlp.Decode(bytes.NewReader(header), h); err != nil {
+				return nil, fmt.Errorf("could not decode l1 block header. Cause: %w", err)
+			}
+			blockHash := h.Hash()
+			t.L1Block = &blockHash
+			t.L1BlockHeight = h.Number
+		}
+		result = append(result, t)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,89 @@
+package enclavedb
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+const (
+	messageTreeInsert      = "replace into message_tree values (?,?,?)"
+	messageLeafDelete      = "delete from message_leaf where batch=?"
+	messageLeafInsert      = "insert into message_leaf values "
+	messageLeafInsertValue = "(?,?,?,?)"
+
+	// only the trees of canonical batches can be proven
+	selectMessageTree = "select l.batch, l.idx, l.sender, t.root, t.leaves from message_leaf l join message_tree t on t.batch=l.batch join batch b on b.sequence=l.batch where b.is_canonical=true and l.hash=?"
+
+	selectRollupForBatch = "select r.header from rollup r join block b on r.compression_block=b.hash where b.is_canonical=true and r.start_seq<=? and r.end_seq>=?"
+)
+
+// WriteMessageTree - stores the leaves of the tree of the outbound messages of a batch, and indexes them by hash
+func WriteMessageTree(dbtx DBTransaction, batchSeq uint64, root gethcommon.Hash, leaves []gethcommon.Hash, senders []gethcommon.Address) error {
+	encodedLeaves, err := rlp.EncodeToBytes(leaves)
+	if err != nil {
+		return fmt.Errorf("could not encode message tree. Cause: %w", err)
+	}
+	// the leaves reference the tree, so they are deleted before the tree is replaced
+	dbtx.ExecuteSQL(messageLeafDelete, batchSeq)
+	dbtx.ExecuteSQL(messageTreeInsert, batchSeq, root.Bytes(), encodedLeaves)
+
+	if len(leaves) == 0 {
+		return nil
+	}
+	insert := messageLeafInsert + strings.Repeat(messageLeafInsertValue+",", len(leaves))
+	insert = insert[0 : len(insert)-1] // remove trailing comma
+
+	args := make([]any, 0, 4*len(leaves))
+	for i, leaf := range leaves {
+		args = append(args, leaf.Bytes(), batchSeq, i, senders[i].Bytes())
+	}
+	dbtx.ExecuteSQL(insert, args...)
+	return nil
+}
+
+// FetchMessageTree - returns the position of the message in the tree of the canonical batch that emitted it,
+// together with the sender of the message, the root and the leaves of the tree
+func FetchMessageTree(db *sql.DB, messageHash gethcommon.Hash) (uint64, int, gethcommon.Address, gethcommon.Hash, []gethcommon.Hash, error) {
+	var batchSeq uint64
+	var idx int
+	var sender, root, encodedLeaves []byte
+	err := db.QueryRow(selectMessageTree, messageHash.Bytes()).Scan(&batchSeq, &idx, &sender, &root, &encodedLeaves)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// make sure the error is converted to obscuro-wide not found error
+			return 0, 0, gethcommon.Address{}, gethcommon.Hash{}, nil, errutil.ErrNotFound
+		}
+		return 0, 0, gethcommon.Address{}, gethcommon.Hash{}, nil, err
+	}
+
+	var leaves []gethcommon.Hash
+	if err := rlp.DecodeBytes(encodedLeaves, &leaves); err != nil {
+		return 0, 0, gethcommon.Address{}, gethcommon.Hash{}, nil, fmt.Errorf("could not decode message tree. Cause: %w", err)
+	}
+	return batchSeq, idx, gethcommon.BytesToAddress(sender), gethcommon.BytesToHash(root), leaves, nil
+}
+
+// FetchRollupForBatch - returns the header of the canonical rollup which published the batch
+func FetchRollupForBatch(db *sql.DB, batchSeq uint64) (*common.RollupHeader, error) {
+	var data []byte
+	err := db.QueryRow(selectRollupForBatch, batchSeq, batchSeq).Scan(&data)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// make sure the error is converted to obscuro-wide not found error
+			return nil, errutil.ErrNotFound
+		}
+		return nil, err
+	}
+	header := new(common.RollupHeader)
+	if err := rlp.DecodeBytes(data, header); err != nil {
+		return nil, fmt.Errorf("could not decode rollup header. Cause: %w", err)
+	}
+	return header, nil
+}
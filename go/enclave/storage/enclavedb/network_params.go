@@ -0,0 +1,49 @@
+package enclavedb
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+const (
+	networkParamsInsert = "replace into network_params_update values (?,?,?)"
+	selectNetworkParams = "select p.params from network_params_update p join block b on b.hash=p.block " +
+		"where b.is_canonical=true and p.height<=? order by p.height"
+)
+
+// WriteNetworkParametersUpdate - records the network parameters set in the L1 block
+func WriteNetworkParametersUpdate(dbtx DBTransaction, block *types.Header, update *common.NetworkParametersUpdate) error {
+	encoded, err := json.Marshal(update)
+	if err != nil {
+		return fmt.Errorf("could not encode the network parameters update. Cause: %w", err)
+	}
+	dbtx.ExecuteSQL(networkParamsInsert, truncTo16(block.Hash()), block.Number.Uint64(), encoded)
+	return nil
+}
+
+// FetchNetworkParametersUpdates - returns the updates of the canonical blocks up to the height, ordered by height
+func FetchNetworkParametersUpdates(db *sql.DB, upToHeight uint64) ([]*common.NetworkParametersUpdate, error) {
+	rows, err := db.Query(selectNetworkParams, upToHeight)
+	if err != nil {
+		return nil, fmt.Errorf("could not query the network parameters updates. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	updates := make([]*common.NetworkParametersUpdate, 0)
+	for rows.Next() {
+		var encoded []byte
+		if err := rows.Scan(&encoded); err != nil {
+			return nil, err
+		}
+		update := new(common.NetworkParametersUpdate)
+		if err := json.Unmarshal(encoded, update); err != nil {
+			return nil, fmt.Errorf("could not decode the network parameters update. Cause: %w", err)
+		}
+		updates = append(updates, update)
+	}
+	return updates, rows.Err()
+}
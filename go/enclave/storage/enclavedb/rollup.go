@@ -0,0 +1,66 @@
+package enclavedb
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+const (
+	selectRollupMetadata = "select header, start_seq, l1_block, compressed_size, is_canonical from rollup"
+
+	// the canonical rollup is preferred when a batch was published again after a reorg
+	selectRollupMetadataForBatch = selectRollupMetadata + " where start_seq<=? and end_seq>=? order by is_canonical desc, end_seq desc limit 1"
+	selectLatestRollupMetadata   = selectRollupMetadata + " where is_canonical=true order by end_seq desc limit 1"
+)
+
+func FetchRollupMetadata(db *sql.DB, hash common.L2RollupHash) (*common.PublicRollupMetadata, error) {
+	return fetchRollupMetadata(db.QueryRow(selectRollupMetadata+" where hash=?", truncTo16(hash)))
+}
+
+// FetchRollupMetadataForBatch - returns the rollup which published the batch
+func FetchRollupMetadataForBatch(db *sql.DB, batchSeq uint64) (*common.PublicRollupMetadata, error) {
+	return fetchRollupMetadata(db.QueryRow(selectRollupMetadataForBatch, batchSeq, batchSeq))
+}
+
+// FetchLatestRollupMetadata - returns the canonical rollup which published the most recent batches
+func FetchLatestRollupMetadata(db *sql.DB) (*common.PublicRollupMetadata, error) {
+	return fetchRollupMetadata(db.QueryRow(selectLatestRollupMetadata))
+}
+
+func fetchRollupMetadata(row *sql.Row) (*common.PublicRollupMetadata, error) {
+	var header []byte
+	var firstSeq uint64
+	var l1Block []byte
+	var compressedSize sql.NullInt64
+	var isCanonical bool
+	err := row.Scan(&header, &firstSeq, &l1Block, &compressedSize, &isCanonical)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// make sure the error is converted to obscuro-wide not found error
+			return nil, errutil.ErrNotFound
+		}
+		return nil, err
+	}
+	rollup := new(common.RollupHeader)
+	if err := rlp.DecodeBytes(header, rollup); err != nil {
+		return nil, fmt.Errorf("could not decode rollup header. Cause: %w", err)
+	}
+	// the rollups stored before the metadata was recorded have no l1 block or size
+	return &common.PublicRollupMetadata{
+		Hash:              rollup.Hash(),
+		L1Block:           gethcommon.BytesToHash(l1Block),
+		CompressionL1Head: rollup.CompressionL1Head,
+		CompressedSize:    uint64(compressedSize.Int64),
+		FirstBatchSeqNo:   firstSeq,
+		LastBatchSeqNo:    rollup.LastBatchSeqNo,
+		R:                 rollup.R,
+		S:                 rollup.S,
+		IsCanonical:       isCanonical,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package enclavedb
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+const (
+	rollupCompressionStatsInsert = "insert into rollup_compression_stats (rollup, created, stats) values (?,?,?)"
+	rollupCompressionStatsPrune  = "delete from rollup_compression_stats where id <= ?"
+	selectLastRollupStatsID      = "select max(id) from rollup_compression_stats"
+	selectRollupCompressionStats = "select stats from rollup_compression_stats order by id"
+)
+
+// WriteRollupCompressionStats - appends the stats of a rollup
+func WriteRollupCompressionStats(dbtx DBTransaction, stats *common.RollupCompressionStats) error {
+	encoded, err := json.Marshal(stats)
+	if err != nil {
+		return fmt.Errorf("could not encode the rollup compression stats. Cause: %w", err)
+	}
+	dbtx.ExecuteSQL(rollupCompressionStatsInsert, stats.RollupHash.Bytes(), stats.Created, encoded)
+	return nil
+}
+
+// PruneRollupCompressionStats - deletes all but the most recent stats
+func PruneRollupCompressionStats(db *sql.DB, retained uint64) error {
+	var lastID sql.NullInt64
+	if err := db.QueryRow(selectLastRollupStatsID).Scan(&lastID); err != nil {
+		return fmt.Errorf("could not query the rollup compression stats. Cause: %w", err)
+	}
+	if !lastID.Valid || uint64(lastID.Int64) <= retained {
+		return nil
+	}
+	if _, err := db.Exec(rollupCompressionStatsPrune, uint64(lastID.Int64)-retained); err != nil {
+		return fmt.Errorf("could not prune the rollup compression stats. Cause: %w", err)
+	}
+	return nil
+}
+
+// FetchRollupCompressionStats - returns the stats in the order they were written
+func FetchRollupCompressionStats(db *sql.DB) ([]*common.RollupCompressionStats, error) {
+	rows, err := db.Query(selectRollupCompressionStats)
+	if err != nil {
+		return nil, fmt.Errorf("could not query the rollup compression stats. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	all := make([]*common.RollupCompressionStats, 0)
+	for rows.Next() {
+		var encoded []byte
+		if err := rows.Scan(&encoded); err != nil {
+			return nil, err
+		}
+		stats := new(common.RollupCompressionStats)
+		if err := json.Unmarshal(encoded, stats); err != nil {
+			return nil, fmt.Errorf("could not decode the rollup compression stats. Cause: %w", err)
+		}
+		all = append(all, stats)
+	}
+	return all, rows.Err()
+}
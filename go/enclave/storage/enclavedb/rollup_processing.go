@@ -0,0 +1,93 @@
+package enclavedb
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+const (
+	rollupProcessingInsert      = "replace into rollup_processing values (?,?,?,?,?)"
+	selectRollupProcessing      = "select record from rollup_processing where hash=?"
+	selectUnprocessedRollups    = "select record from rollup_processing where processed=false order by l1_height"
+	selectUnprocessedRollupData = "select rollup from rollup_processing where hash=? and processed=false"
+)
+
+// WriteRollupProcessing - records the processing of the rollup. The encoded rollup is kept until it is processed, so
+// it can be retried without the L1 block which published it.
+func WriteRollupProcessing(dbtx DBTransaction, processing *common.RollupProcessing, rollup *common.ExtRollup) error {
+	encoded, err := json.Marshal(processing)
+	if err != nil {
+		return fmt.Errorf("could not encode the rollup processing. Cause: %w", err)
+	}
+	var encodedRollup []byte
+	if !processing.Processed() {
+		encodedRollup, err = common.EncodeRollup(rollup)
+		if err != nil {
+			return fmt.Errorf("could not encode the rollup. Cause: %w", err)
+		}
+	}
+	dbtx.ExecuteSQL(rollupProcessingInsert, processing.RollupHash.Bytes(), processing.L1Height, processing.Processed(), encoded, encodedRollup)
+	return nil
+}
+
+// FetchRollupProcessing - returns the processing of the rollup, or errutil.ErrNotFound
+func FetchRollupProcessing(db *sql.DB, rollupHash common.L2RollupHash) (*common.RollupProcessing, error) {
+	var encoded []byte
+	err := db.QueryRow(selectRollupProcessing, rollupHash.Bytes()).Scan(&encoded)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errutil.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not query the rollup processing. Cause: %w", err)
+	}
+	return decodeRollupProcessing(encoded)
+}
+
+// FetchUnprocessedRollups - returns the rollups which are neither complete nor skipped, in the order of their L1 blocks
+func FetchUnprocessedRollups(db *sql.DB) ([]*common.RollupProcessing, error) {
+	rows, err := db.Query(selectUnprocessedRollups)
+	if err != nil {
+		return nil, fmt.Errorf("could not query the unprocessed rollups. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	processings := make([]*common.RollupProcessing, 0)
+	for rows.Next() {
+		var encoded []byte
+		if err := rows.Scan(&encoded); err != nil {
+			return nil, err
+		}
+		processing, err := decodeRollupProcessing(encoded)
+		if err != nil {
+			return nil, err
+		}
+		processings = append(processings, processing)
+	}
+	return processings, rows.Err()
+}
+
+// FetchUnprocessedRollup - returns the rollup kept for its processing, or errutil.ErrNotFound once it is processed
+func FetchUnprocessedRollup(db *sql.DB, rollupHash common.L2RollupHash) (*common.ExtRollup, error) {
+	var encoded []byte
+	err := db.QueryRow(selectUnprocessedRollupData, rollupHash.Bytes()).Scan(&encoded)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errutil.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not query the unprocessed rollup. Cause: %w", err)
+	}
+	return common.DecodeRollup(encoded)
+}
+
+func decodeRollupProcessing(encoded []byte) (*common.RollupProcessing, error) {
+	processing := new(common.RollupProcessing)
+	if err := json.Unmarshal(encoded, processing); err != nil {
+		return nil, fmt.Errorf("could not decode the rollup processing. Cause: %w", err)
+	}
+	return processing, nil
+}
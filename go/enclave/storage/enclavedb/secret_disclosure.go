@@ -0,0 +1,70 @@
+package enclavedb
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+const (
+	secretDisclosureInsert = "insert into secret_disclosure (record) values (?)"
+	selectSecretDisclosure = "select id, record from secret_disclosure where id >= ? order by id limit ?"
+
+	secretResponseInsert = "replace into secret_response values (?,?)"
+	selectSecretResponse = "select true from secret_response where requester=? and attestation_hash=?"
+)
+
+// WriteSecretDisclosure - appends the record to the audit log. The ID is assigned by the database.
+func WriteSecretDisclosure(dbtx DBTransaction, record *common.SecretDisclosureRecord) error {
+	encoded, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("could not encode the secret disclosure record. Cause: %w", err)
+	}
+	dbtx.ExecuteSQL(secretDisclosureInsert, encoded)
+	if record.Approved {
+		dbtx.ExecuteSQL(secretResponseInsert, record.RequesterID.Bytes(), record.AttestationHash.Bytes())
+	}
+	return nil
+}
+
+// SecretRequestAnswered - whether the secret was already disclosed to the requester, in response to the attestation
+func SecretRequestAnswered(db *sql.DB, requester gethcommon.Address, attestationHash gethcommon.Hash) (bool, error) {
+	var answered bool
+	err := db.QueryRow(selectSecretResponse, requester.Bytes(), attestationHash.Bytes()).Scan(&answered)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return answered, nil
+}
+
+// FetchSecretDisclosures - returns at most count records, from the ID on, in the order they were written
+func FetchSecretDisclosures(db *sql.DB, fromID uint64, count uint64) ([]*common.SecretDisclosureRecord, error) {
+	rows, err := db.Query(selectSecretDisclosure, fromID, count)
+	if err != nil {
+		return nil, fmt.Errorf("could not query the secret disclosure records. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	records := make([]*common.SecretDisclosureRecord, 0)
+	for rows.Next() {
+		var id uint64
+		var encoded []byte
+		if err := rows.Scan(&id, &encoded); err != nil {
+			return nil, err
+		}
+		record := new(common.SecretDisclosureRecord)
+		if err := json.Unmarshal(encoded, record); err != nil {
+			return nil, fmt.Errorf("could not decode the secret disclosure record. Cause: %w", err)
+		}
+		record.ID = id
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
@@ -0,0 +1,69 @@
+package enclavedb
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+const (
+	stateDivergenceInsert = "replace into state_divergence values (?,?,?)"
+	// the divergence is current while the divergent batch is canonical
+	selectStateDivergence = "select d.report, coalesce(b.is_canonical and b.full_hash=d.batch_hash, false) from state_divergence d " +
+		"left join batch b on b.sequence=d.sequence"
+)
+
+// WriteStateDivergence - records the divergence of a batch, replacing the previous report for its seq number
+func WriteStateDivergence(dbtx DBTransaction, report *common.StateDivergenceReport) error {
+	encoded, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("could not encode the state divergence report. Cause: %w", err)
+	}
+	dbtx.ExecuteSQL(stateDivergenceInsert, report.SeqNo, report.BatchHash.Bytes(), encoded)
+	return nil
+}
+
+// FetchStateDivergence - returns the report of the divergence of the batch, or errutil.ErrNotFound
+func FetchStateDivergence(db *sql.DB, batchHash gethcommon.Hash) (*common.StateDivergenceReport, error) {
+	reports, err := fetchStateDivergences(db, " where d.batch_hash=?", batchHash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return nil, errutil.ErrNotFound
+	}
+	return reports[0], nil
+}
+
+// FetchStateDivergences - returns all the reports, ordered by seq number
+func FetchStateDivergences(db *sql.DB) ([]*common.StateDivergenceReport, error) {
+	return fetchStateDivergences(db, " order by d.sequence")
+}
+
+func fetchStateDivergences(db *sql.DB, whereQuery string, args ...any) ([]*common.StateDivergenceReport, error) {
+	rows, err := db.Query(selectStateDivergence+whereQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query the state divergence reports. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	reports := make([]*common.StateDivergenceReport, 0)
+	for rows.Next() {
+		var encoded []byte
+		var canonical bool
+		if err := rows.Scan(&encoded, &canonical); err != nil {
+			return nil, err
+		}
+		report := new(common.StateDivergenceReport)
+		if err := json.Unmarshal(encoded, report); err != nil {
+			return nil, fmt.Errorf("could not decode the state divergence report. Cause: %w", err)
+		}
+		report.Canonical = canonical
+		reports = append(reports, report)
+	}
+	return reports, rows.Err()
+}
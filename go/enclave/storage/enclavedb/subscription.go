@@ -0,0 +1,44 @@
+package enclavedb
+
+import (
+	"database/sql"
+	"fmt"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	subscriptionInsert = "replace into subscription values (?,?)"
+	subscriptionDelete = "delete from subscription where id = ?"
+	subscriptionSelect = "select id, content from subscription"
+)
+
+// WriteSubscription - the content is expected to be already encrypted
+func WriteSubscription(db *sql.DB, id gethrpc.ID, content []byte) error {
+	_, err := db.Exec(subscriptionInsert, string(id), content)
+	return err
+}
+
+func DeleteSubscription(db *sql.DB, id gethrpc.ID) error {
+	_, err := db.Exec(subscriptionDelete, string(id))
+	return err
+}
+
+func FetchSubscriptions(db *sql.DB) (map[gethrpc.ID][]byte, error) {
+	rows, err := db.Query(subscriptionSelect)
+	if err != nil {
+		return nil, fmt.Errorf("could not query subscriptions. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	result := map[gethrpc.ID][]byte{}
+	for rows.Next() {
+		var id string
+		var content []byte
+		if err := rows.Scan(&id, &content); err != nil {
+			return nil, err
+		}
+		result[gethrpc.ID(id)] = content
+	}
+	return result, rows.Err()
+}
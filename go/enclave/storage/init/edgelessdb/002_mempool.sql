@@ -0,0 +1,7 @@
+create table if not exists obsdb.mempool_tx
+(
+    hash    binary(32),
+    content mediumblob NOT NULL,
+    primary key (hash)
+);
+GRANT ALL ON obsdb.mempool_tx TO obscuro;
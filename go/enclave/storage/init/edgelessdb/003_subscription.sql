@@ -0,0 +1,7 @@
+create table if not exists obsdb.subscription
+(
+    id      varchar(128),
+    content mediumblob NOT NULL,
+    primary key (id)
+);
+GRANT ALL ON obsdb.subscription TO obscuro;
@@ -0,0 +1,19 @@
+create table if not exists obsdb.message_tree
+(
+    batch  int,
+    root   binary(32) NOT NULL,
+    leaves mediumblob NOT NULL,
+    primary key (batch)
+);
+GRANT ALL ON obsdb.message_tree TO obscuro;
+
+create table if not exists obsdb.message_leaf
+(
+    hash   binary(32) NOT NULL,
+    batch  int        NOT NULL,
+    idx    int        NOT NULL,
+    sender binary(20) NOT NULL,
+    INDEX (hash),
+    FOREIGN KEY (batch) REFERENCES obsdb.message_tree (batch)
+);
+GRANT ALL ON obsdb.message_leaf TO obscuro;
@@ -0,0 +1,10 @@
+create table if not exists obsdb.message_lifecycle
+(
+    hash    binary(32)  NOT NULL,
+    inbound boolean     NOT NULL,
+    state   varchar(32) NOT NULL,
+    batch   int,
+    block   binary(16),
+    INDEX (hash)
+);
+GRANT ALL ON obsdb.message_lifecycle TO obscuro;
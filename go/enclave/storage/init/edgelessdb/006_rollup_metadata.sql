@@ -0,0 +1,6 @@
+alter table obsdb.rollup
+    add column l1_block        binary(32),
+    add column compressed_size int,
+    add column is_canonical    boolean NOT NULL default true,
+    add INDEX (l1_block),
+    add INDEX (start_seq, end_seq);
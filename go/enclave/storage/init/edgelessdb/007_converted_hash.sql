@@ -0,0 +1,2 @@
+alter table obsdb.batch
+    add INDEX (converted_hash);
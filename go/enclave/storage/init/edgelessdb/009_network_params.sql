@@ -0,0 +1,9 @@
+create table if not exists obsdb.network_params_update
+(
+    block  binary(16),
+    height int  NOT NULL,
+    params blob NOT NULL,
+    primary key (block),
+    INDEX (height)
+);
+GRANT ALL ON obsdb.network_params_update TO obscuro;
@@ -0,0 +1,7 @@
+create table if not exists obsdb.secret_disclosure
+(
+    id     INTEGER AUTO_INCREMENT,
+    record blob NOT NULL,
+    primary key (id)
+);
+GRANT ALL ON obsdb.secret_disclosure TO obscuro;
@@ -0,0 +1,9 @@
+create table if not exists obsdb.rollup_compression_stats
+(
+    id      INTEGER AUTO_INCREMENT,
+    rollup  binary(32) NOT NULL,
+    created boolean    NOT NULL,
+    stats   blob       NOT NULL,
+    primary key (id)
+);
+GRANT ALL ON obsdb.rollup_compression_stats TO obscuro;
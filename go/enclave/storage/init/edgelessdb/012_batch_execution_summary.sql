@@ -0,0 +1,16 @@
+create table if not exists obsdb.batch_execution_summary
+(
+    sequence      int,
+    batch_hash    binary(32)  NOT NULL,
+    l1_proof      binary(16)  NOT NULL,
+    height        int         NOT NULL,
+    state_root    binary(32)  NOT NULL,
+    receipts_root binary(32)  NOT NULL,
+    gas_used      bigint      NOT NULL,
+    logs_bloom    binary(256) NOT NULL,
+    tx_count      int         NOT NULL,
+    is_orphaned   boolean     NOT NULL,
+    primary key (sequence),
+    INDEX (l1_proof)
+);
+GRANT ALL ON obsdb.batch_execution_summary TO obscuro;
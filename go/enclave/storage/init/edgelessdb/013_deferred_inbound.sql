@@ -0,0 +1,8 @@
+create table if not exists obsdb.deferred_inbound
+(
+    batch     binary(32),
+    messages  mediumblob NOT NULL,
+    transfers mediumblob NOT NULL,
+    primary key (batch)
+);
+GRANT ALL ON obsdb.deferred_inbound TO obscuro;
@@ -0,0 +1,8 @@
+create table if not exists obsdb.batch_time_violation
+(
+    sequence   int,
+    batch_hash binary(32) NOT NULL,
+    report     blob       NOT NULL,
+    primary key (sequence)
+);
+GRANT ALL ON obsdb.batch_time_violation TO obscuro;
@@ -0,0 +1,7 @@
+create table if not exists obsdb.secret_response
+(
+    requester        binary(20) NOT NULL,
+    attestation_hash binary(32) NOT NULL,
+    primary key (requester, attestation_hash)
+);
+GRANT ALL ON obsdb.secret_response TO obscuro;
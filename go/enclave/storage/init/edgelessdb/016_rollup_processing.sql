@@ -0,0 +1,11 @@
+create table if not exists obsdb.rollup_processing
+(
+    hash      binary(32),
+    l1_height int     NOT NULL,
+    processed boolean NOT NULL,
+    record    blob    NOT NULL,
+    rollup    mediumblob,
+    primary key (hash),
+    INDEX (processed, l1_height)
+);
+GRANT ALL ON obsdb.rollup_processing TO obscuro;
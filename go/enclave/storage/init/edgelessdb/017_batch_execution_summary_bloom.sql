@@ -0,0 +1 @@
+alter table obsdb.batch_execution_summary drop column logs_bloom;
@@ -0,0 +1,6 @@
+create table obsdb.message_lifecycle_unique as select distinct * from obsdb.message_lifecycle;
+delete from obsdb.message_lifecycle;
+insert into obsdb.message_lifecycle select * from obsdb.message_lifecycle_unique;
+drop table obsdb.message_lifecycle_unique;
+create unique index IDX_MESSAGE_LIFECYCLE_BLOCK on obsdb.message_lifecycle (hash, state, block);
+create unique index IDX_MESSAGE_LIFECYCLE_BATCH on obsdb.message_lifecycle (hash, state, batch);
@@ -26,6 +26,7 @@ func DBMigration(db *sql.DB, sqlFiles embed.FS, logger gethlog.Logger) error {
 
 	maxMigration := int64(len(migrationFiles))
 
+	// the number of migrations already executed
 	var maxDB int64
 	config, err := enclavedb.FetchConfig(db, currentMigrationVersionKey)
 	if err != nil {
@@ -66,7 +67,8 @@ func executeMigration(db *sql.DB, content string, migrationOrder int64) error {
 		return err
 	}
 
-	_, err = enclavedb.WriteConfigToTx(tx, currentMigrationVersionKey, big.NewInt(migrationOrder).Bytes())
+	// record the number of executed migrations, so the next run starts with the following file
+	_, err = enclavedb.UpsertConfigToTx(tx, currentMigrationVersionKey, big.NewInt(migrationOrder+1).Bytes())
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,5 @@
+create table if not exists subscription
+(
+    id      varchar(128) primary key,
+    content mediumblob NOT NULL
+);
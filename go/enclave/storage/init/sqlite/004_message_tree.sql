@@ -0,0 +1,15 @@
+create table if not exists message_tree
+(
+    batch  int primary key,
+    root   binary(32) NOT NULL,
+    leaves mediumblob NOT NULL
+);
+
+create table if not exists message_leaf
+(
+    hash   binary(32) NOT NULL,
+    batch  int        NOT NULL REFERENCES message_tree,
+    idx    int        NOT NULL,
+    sender binary(20) NOT NULL
+);
+create index IDX_MESSAGE_LEAF_HASH on message_leaf (hash);
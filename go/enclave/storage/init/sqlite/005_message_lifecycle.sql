@@ -0,0 +1,9 @@
+create table if not exists message_lifecycle
+(
+    hash    binary(32)  NOT NULL,
+    inbound boolean     NOT NULL,
+    state   varchar(32) NOT NULL,
+    batch   int,
+    block   binary(16)
+);
+create index IDX_MESSAGE_LIFECYCLE_HASH on message_lifecycle (hash);
@@ -0,0 +1,5 @@
+alter table rollup add column l1_block binary(32);
+alter table rollup add column compressed_size int;
+alter table rollup add column is_canonical boolean NOT NULL default true;
+create index IDX_ROLLUP_L1_BLOCK on rollup (l1_block);
+create index IDX_ROLLUP_SEQ on rollup (start_seq, end_seq);
@@ -0,0 +1 @@
+create index IDX_BATCH_CONVERTED_HASH on batch (converted_hash);
@@ -0,0 +1,6 @@
+create table if not exists state_divergence
+(
+    sequence   int primary key,
+    batch_hash binary(32) NOT NULL,
+    report     blob       NOT NULL
+);
@@ -0,0 +1,7 @@
+create table if not exists network_params_update
+(
+    block  binary(16) primary key REFERENCES block,
+    height int  NOT NULL,
+    params blob NOT NULL
+);
+create index IDX_NETWORK_PARAMS_HEIGHT on network_params_update (height);
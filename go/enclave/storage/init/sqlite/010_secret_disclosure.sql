@@ -0,0 +1,5 @@
+create table if not exists secret_disclosure
+(
+    id     INTEGER PRIMARY KEY AUTOINCREMENT,
+    record blob NOT NULL
+);
@@ -0,0 +1,7 @@
+create table if not exists rollup_compression_stats
+(
+    id      INTEGER PRIMARY KEY AUTOINCREMENT,
+    rollup  binary(32) NOT NULL,
+    created boolean    NOT NULL,
+    stats   blob       NOT NULL
+);
@@ -0,0 +1,14 @@
+create table if not exists batch_execution_summary
+(
+    sequence      int primary key,
+    batch_hash    binary(32)  NOT NULL,
+    l1_proof      binary(16)  NOT NULL,
+    height        int         NOT NULL,
+    state_root    binary(32)  NOT NULL,
+    receipts_root binary(32)  NOT NULL,
+    gas_used      int         NOT NULL,
+    logs_bloom    binary(256) NOT NULL,
+    tx_count      int         NOT NULL,
+    is_orphaned   boolean     NOT NULL
+);
+create index IDX_BATCH_EXECUTION_SUMMARY_Block on batch_execution_summary (l1_proof);
@@ -0,0 +1,6 @@
+create table if not exists deferred_inbound
+(
+    batch     binary(32) primary key,
+    messages  blob       NOT NULL,
+    transfers blob       NOT NULL
+);
@@ -0,0 +1,6 @@
+create table if not exists secret_response
+(
+    requester        binary(20) NOT NULL,
+    attestation_hash binary(32) NOT NULL,
+    primary key (requester, attestation_hash)
+);
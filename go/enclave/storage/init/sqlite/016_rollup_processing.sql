@@ -0,0 +1,9 @@
+create table if not exists rollup_processing
+(
+    hash      binary(32) primary key,
+    l1_height int     NOT NULL,
+    processed boolean NOT NULL,
+    record    blob    NOT NULL,
+    rollup    blob
+);
+create index IDX_ROLLUP_PROCESSING_PROCESSED on rollup_processing (processed, l1_height);
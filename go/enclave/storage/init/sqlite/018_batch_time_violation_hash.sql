@@ -0,0 +1 @@
+create index IDX_BATCH_TIME_VIOLATION_HASH on batch_time_violation (batch_hash);
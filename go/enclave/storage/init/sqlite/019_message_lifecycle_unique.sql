@@ -0,0 +1,6 @@
+create table message_lifecycle_unique as select distinct * from message_lifecycle;
+delete from message_lifecycle;
+insert into message_lifecycle select * from message_lifecycle_unique;
+drop table message_lifecycle_unique;
+create unique index IDX_MESSAGE_LIFECYCLE_BLOCK on message_lifecycle (hash, state, block);
+create unique index IDX_MESSAGE_LIFECYCLE_BATCH on message_lifecycle (hash, state, batch);
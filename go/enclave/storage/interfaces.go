@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"io"
 	"math/big"
@@ -11,6 +12,7 @@ import (
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/tracers"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
@@ -44,6 +46,9 @@ type BatchResolver interface {
 	FetchBatchByHeight(height uint64) (*core.Batch, error)
 	// FetchBatchBySeqNo returns the batch with the given seq number.
 	FetchBatchBySeqNo(seqNum uint64) (*core.Batch, error)
+	// FetchBatchesBySeqRange returns the batches with the seq numbers between from and to (inclusive), ordered by seq number.
+	// At most maxCount batches are returned.
+	FetchBatchesBySeqRange(fromSeqNo uint64, toSeqNo uint64, maxCount uint64) ([]*core.Batch, error)
 	// FetchHeadBatch returns the current head batch of the canonical chain.
 	FetchHeadBatch() (*core.Batch, error)
 	// FetchCurrentSequencerNo returns the sequencer number
@@ -52,10 +57,19 @@ type BatchResolver interface {
 	FetchBatchesByBlock(common.L1BlockHash) ([]*core.Batch, error)
 	// FetchNonCanonicalBatchesBetween - returns all reorged batches between the sequences
 	FetchNonCanonicalBatchesBetween(startSeq uint64, endSeq uint64) ([]*core.Batch, error)
-	// FetchCanonicalUnexecutedBatches - return the list of the unexecuted batches that are canonical
-	FetchCanonicalUnexecutedBatches(*big.Int) ([]*core.Batch, error)
+	// FetchCanonicalUnexecutedBatches - return the first unexecuted batches that are canonical, starting at the seq number, at most limit
+	FetchCanonicalUnexecutedBatches(from *big.Int, limit uint64) ([]*core.Batch, error)
 
+	// FetchConvertedHash returns the hash of the Ethereum header the batch is converted to, or errutil.ErrNotFound when
+	// it was not recorded by an older version
 	FetchConvertedHash(hash common.L2BatchHash) (gethcommon.Hash, error)
+	// FetchBatchHashByConvertedHash returns the hash of the batch converted to the Ethereum header with the given hash
+	FetchBatchHashByConvertedHash(convertedHash gethcommon.Hash) (common.L2BatchHash, error)
+	// FetchBatchesWithoutConvertedHash returns the batches whose converted hash was not recorded by an older version,
+	// ordered by seq number. At most limit batches are returned.
+	FetchBatchesWithoutConvertedHash(limit uint64) ([]*core.Batch, error)
+	// StoreConvertedHash records the converted hash of a batch stored without it
+	StoreConvertedHash(hash common.L2BatchHash, convertedHash gethcommon.Hash) error
 
 	// BatchWasExecuted - return true if the batch was executed
 	BatchWasExecuted(hash common.L2BatchHash) (bool, error)
@@ -65,11 +79,16 @@ type BatchResolver interface {
 
 	// StoreBatch stores an un-executed batch.
 	StoreBatch(batch *core.Batch, convertedHash gethcommon.Hash) error
-	// StoreExecutedBatch - store the batch after it was executed
+	// StoreExecutedBatch - store the batch after it was executed, with the summary of its execution
 	StoreExecutedBatch(batch *core.Batch, receipts []*types.Receipt) error
+	// FetchBatchExecutionSummary - returns the summary of the execution of the batch with the seq number
+	FetchBatchExecutionSummary(seqNo uint64) (*common.ExecutedBatchSummary, error)
+	// FetchBatchExecutionSummaries - returns at most limit summaries of the executed batches with the seq numbers
+	// between from and to (inclusive), ordered by seq number
+	FetchBatchExecutionSummaries(fromSeqNo uint64, toSeqNo uint64, limit uint64) ([]*common.ExecutedBatchSummary, error)
 
-	// StoreRollup
-	StoreRollup(rollup *common.ExtRollup, header *common.CalldataRollupHeader) error
+	// StoreRollup - stores the rollup, with its metadata, published in the l1Block
+	StoreRollup(rollup *common.ExtRollup, header *common.CalldataRollupHeader, l1Block common.L1BlockHash) error
 	FetchReorgedRollup(reorgedBlocks []common.L1BlockHash) (*common.L2BatchHash, error)
 }
 
@@ -78,6 +97,11 @@ type GethStateDB interface {
 	CreateStateDB(hash common.L2BatchHash) (*state.StateDB, error)
 	// EmptyStateDB creates the original empty StateDB
 	EmptyStateDB() (*state.StateDB, error)
+	// StoreReplayCheckpoint - stores the seq number of the last batch whose state the replay persisted, zero once the
+	// replay completed
+	StoreReplayCheckpoint(seqNo uint64) error
+	// FetchReplayCheckpoint - returns the seq number of the last batch whose state the interrupted replay persisted
+	FetchReplayCheckpoint() (uint64, error)
 }
 
 type SharedSecretStorage interface {
@@ -103,6 +127,13 @@ type AttestationStorage interface {
 	FetchAttestedKey(aggregator gethcommon.Address) (*ecdsa.PublicKey, error)
 	// StoreAttestedKey - store the public key of an attested aggregator
 	StoreAttestedKey(aggregator gethcommon.Address, key *ecdsa.PublicKey) error
+	// StoreSecretDisclosure - appends the record of a processed secret request to the audit log. The approved requests
+	// are also remembered as answered
+	StoreSecretDisclosure(record *common.SecretDisclosureRecord) error
+	// SecretRequestAnswered - whether the secret was already disclosed to the requester in response to the attestation
+	SecretRequestAnswered(requester gethcommon.Address, attestationHash gethcommon.Hash) (bool, error)
+	// FetchSecretDisclosures - returns at most count records of the audit log, from the ID on, in the order they were stored
+	FetchSecretDisclosures(fromID uint64, count uint64) ([]*common.SecretDisclosureRecord, error)
 }
 
 type CrossChainMessagesStorage interface {
@@ -111,6 +142,13 @@ type CrossChainMessagesStorage interface {
 
 	StoreValueTransfers(blockHash common.L1BlockHash, transfers common.ValueTransferEvents) error
 	GetL1Transfers(blockHash common.L1BlockHash) (common.ValueTransferEvents, error)
+
+	// StoreDeferredInbound - records the inbound messages and value transfers whose synthetic transactions did not fit
+	// in the gas budget of the batch, to be executed by its children
+	StoreDeferredInbound(batchHash common.L2BatchHash, messages common.CrossChainMessages, transfers common.ValueTransferEvents) error
+	// FetchDeferredInbound - returns the inbound messages and value transfers deferred by the batch, none if it deferred
+	// nothing
+	FetchDeferredInbound(batchHash common.L2BatchHash) (common.CrossChainMessages, common.ValueTransferEvents, error)
 }
 
 type EnclaveKeyStorage interface {
@@ -118,7 +156,105 @@ type EnclaveKeyStorage interface {
 	GetEnclaveKey() (*crypto.EnclaveKey, error)
 }
 
+// MempoolStorage - persists the transactions admitted in the mempool, so they survive enclave restarts
+type MempoolStorage interface {
+	// StoreMempoolTx - stores the transaction encrypted with a key derived from the shared secret
+	StoreMempoolTx(tx *common.L2Tx) error
+	// DeleteMempoolTxs - removes the transactions which were included in a batch or dropped from the mempool
+	DeleteMempoolTxs(hashes []common.L2TxHash) error
+	// FetchMempoolTxs - returns all the stored mempool transactions
+	FetchMempoolTxs() ([]*common.L2Tx, error)
+}
+
+// SubscriptionStorage - persists the log subscriptions, so the dapps keep receiving their events across enclave restarts
+type SubscriptionStorage interface {
+	// StoreSubscription - stores the encoded subscription encrypted with a key derived from the shared secret
+	StoreSubscription(id gethrpc.ID, encodedSubscription []byte) error
+	// DeleteSubscription - removes a subscription that was cancelled or dropped
+	DeleteSubscription(id gethrpc.ID) error
+	// FetchSubscriptions - returns all the stored encoded subscriptions
+	FetchSubscriptions() (map[gethrpc.ID][]byte, error)
+	// StoreLastDeliveredBatch - stores the height of the last batch whose logs were delivered to the subscribers
+	StoreLastDeliveredBatch(height uint64) error
+	// FetchLastDeliveredBatch - returns the height of the last batch whose logs were delivered to the subscribers
+	FetchLastDeliveredBatch() (uint64, error)
+}
+
+// MessageTreeEntry - the position of an outbound cross chain message in the tree of the batch that emitted it
+type MessageTreeEntry struct {
+	BatchSeqNo uint64
+	Index      int
+	Sender     gethcommon.Address
+	Root       gethcommon.Hash
+	Leaves     []gethcommon.Hash
+}
+
+// MessageTreeStorage - persists the merkle trees of the outbound cross chain messages of each batch, so that proofs of
+// inclusion can be served to the users withdrawing to the L1, and the lifecycle of the cross chain messages
+type MessageTreeStorage interface {
+	// StoreMessageTree - stores the root and the leaves of the message tree of the batch, and the sender of each message
+	StoreMessageTree(batchSeqNo uint64, root gethcommon.Hash, leaves []gethcommon.Hash, senders []gethcommon.Address) error
+	// FetchMessageTree - returns the tree of the canonical batch which emitted the message
+	FetchMessageTree(messageHash gethcommon.Hash) (*MessageTreeEntry, error)
+	// FetchRollupForBatch - returns the header of the canonical rollup which published the batch
+	FetchRollupForBatch(batchSeqNo uint64) (*common.RollupHeader, error)
+	// FetchRollupMetadata - returns the metadata of the rollup, canonical or not
+	FetchRollupMetadata(hash common.L2RollupHash) (*common.PublicRollupMetadata, error)
+	// FetchRollupMetadataForBatch - returns the metadata of the rollup which published the batch, preferring the canonical one
+	FetchRollupMetadataForBatch(batchSeqNo uint64) (*common.PublicRollupMetadata, error)
+	// FetchLatestRollupMetadata - returns the metadata of the canonical rollup which published the most recent batches
+	FetchLatestRollupMetadata() (*common.PublicRollupMetadata, error)
+	// StoreRollupCompressionStats - records the stats of a rollup, keeping only the most recent RollupCompressionStatsRetained
+	StoreRollupCompressionStats(stats *common.RollupCompressionStats) error
+	// FetchRollupCompressionStats - returns the most recent stats, in the order they were recorded
+	FetchRollupCompressionStats() ([]*common.RollupCompressionStats, error)
+
+	// StoreStateDivergence - records the report of a batch whose re-execution does not match its header
+	StoreStateDivergence(report *common.StateDivergenceReport) error
+	// FetchStateDivergence - returns the divergence report of the batch, or errutil.ErrNotFound
+	FetchStateDivergence(batchHash common.L2BatchHash) (*common.StateDivergenceReport, error)
+	// FetchStateDivergences - returns all the divergence reports, ordered by seq number
+	FetchStateDivergences() ([]*common.StateDivergenceReport, error)
+	// StoreBatchTimeViolation - records a batch rejected because of its timestamp, with its header
+	StoreBatchTimeViolation(violation *common.BatchTimeViolation) error
+	// FetchBatchTimeViolation - returns the timestamp violation of the batch, or errutil.ErrNotFound
+	FetchBatchTimeViolation(batchHash common.L2BatchHash) (*common.BatchTimeViolation, error)
+	// StoreRollupProcessing - records the processing of the rollup, keeping the rollup until it is processed
+	StoreRollupProcessing(processing *common.RollupProcessing, rollup *common.ExtRollup) error
+	// FetchRollupProcessing - returns the processing of the rollup, or errutil.ErrNotFound
+	FetchRollupProcessing(rollupHash common.L2RollupHash) (*common.RollupProcessing, error)
+	// FetchUnprocessedRollups - returns the rollups still to be processed, in the order of their L1 blocks
+	FetchUnprocessedRollups() ([]*common.RollupProcessing, error)
+	// FetchUnprocessedRollup - returns the rollup kept for its processing, or errutil.ErrNotFound once it is processed
+	FetchUnprocessedRollup(rollupHash common.L2RollupHash) (*common.ExtRollup, error)
+
+	// StoreMessageTransitions - records the lifecycle transitions of cross chain messages
+	StoreMessageTransitions(transitions []*core.MessageTransition) error
+	// FetchMessageTransitions - returns the transitions of the message recorded by canonical batches and L1 blocks
+	FetchMessageTransitions(messageHash gethcommon.Hash) ([]*core.MessageTransition, error)
+}
+
+// NetworkParametersStorage - persists the network parameters set by the management contract in each L1 block
+type NetworkParametersStorage interface {
+	// StoreNetworkParametersUpdate - records the parameters set in the block
+	StoreNetworkParametersUpdate(block *types.Block, update *common.NetworkParametersUpdate) error
+	// FetchNetworkParametersUpdates - returns the updates of the canonical blocks up to the height, in the order of the blocks
+	FetchNetworkParametersUpdates(upToHeight uint64) ([]*common.NetworkParametersUpdate, error)
+}
+
 // Storage is the enclave's interface for interacting with the enclave's datastore
+// StateSnapshotStorage - the snapshots of the state which let a new validator start executing the batches from a rollup
+type StateSnapshotStorage interface {
+	// ExportStateSnapshot - the state after the executed batch, with the data needed to execute the following batches.
+	// The info and the sequencer key are left to the caller.
+	ExportStateSnapshot(batch *core.Batch) (*StateSnapshot, error)
+	// ImportStateSnapshot - stores the snapshot as the executed head batch. It fails unless the database has no batches
+	// and no L1 blocks, or when the state of the snapshot is incomplete.
+	ImportStateSnapshot(snapshot *StateSnapshot) error
+	// FetchImportedStateSnapshot - returns the info of the snapshot the enclave started from, or errutil.ErrNotFound
+	FetchImportedStateSnapshot() (*common.StateSnapshotInfo, error)
+}
+
 type Storage interface {
 	BlockResolver
 	BatchResolver
@@ -129,6 +265,11 @@ type Storage interface {
 	CrossChainMessagesStorage
 	EnclaveKeyStorage
 	ScanStorage
+	MempoolStorage
+	SubscriptionStorage
+	MessageTreeStorage
+	NetworkParametersStorage
+	StateSnapshotStorage
 	io.Closer
 
 	// HealthCheck returns whether the storage is deemed healthy or not
@@ -137,7 +278,7 @@ type Storage interface {
 	// FilterLogs - applies the properties the relevancy checks for the requestingAccount to all the stored log events
 	// nil values will be ignored. Make sure to set all fields to the right values before calling this function
 	// the blockHash should always be nil.
-	FilterLogs(requestingAccount *gethcommon.Address, fromBlock, toBlock *big.Int, blockHash *common.L2BatchHash, addresses []gethcommon.Address, topics [][]gethcommon.Hash) ([]*types.Log, error)
+	FilterLogs(ctx context.Context, requestingAccount *gethcommon.Address, fromBlock, toBlock *big.Int, blockHash *common.L2BatchHash, addresses []gethcommon.Address, topics [][]gethcommon.Hash) ([]*types.Log, error)
 
 	// DebugGetLogs returns logs for a given tx hash without any constraints - should only be used for debug purposes
 	DebugGetLogs(txHash common.TxHash) ([]*tracers.DebugLogs, error)
@@ -147,6 +288,21 @@ type Storage interface {
 
 	// StateDB - return the underlying state database
 	StateDB() state.Database
+
+	// CommitState - persists the state of the batch, once the stateDB committed it to the trie database. In the full
+	// state retention mode, the states of the batches which fall out of the retention window are garbage collected
+	CommitState(seqNo uint64, root gethcommon.Hash) error
+
+	// StateRetention - the state retention mode of the storage
+	StateRetention() common.StateRetentionMode
+
+	// StatePersisted - whether the state of the batch is persisted when it is committed, so it survives a restart. A
+	// full node only persists a state once per retention window
+	StatePersisted(seqNo uint64) bool
+
+	// OldestStateSeqNo - the seq number of the oldest batch whose state can be queried, given the head batch. The
+	// queries at older batches should fail with errutil.ErrStateUnavailable
+	OldestStateSeqNo(headSeqNo uint64) uint64
 }
 
 type ScanStorage interface {
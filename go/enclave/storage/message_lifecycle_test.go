@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestMessageTransitionsAreRecordedOnce(t *testing.T) {
+	s := newTestStorage(t, common.FullStateRetention, 4)
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1)})
+	require.NoError(t, s.StoreBlock(block, nil))
+
+	blockHash := block.Hash()
+	message := gethcommon.HexToHash("0x1234")
+	consumed := &core.MessageTransition{MessageHash: message, State: common.MessageConsumedOnL1, L1Block: &blockHash}
+	// the block is processed again, e.g. after a restart
+	require.NoError(t, s.StoreMessageTransitions([]*core.MessageTransition{consumed}))
+	require.NoError(t, s.StoreMessageTransitions([]*core.MessageTransition{consumed}))
+
+	transitions, err := s.FetchMessageTransitions(message)
+	require.NoError(t, err)
+	require.Len(t, transitions, 1)
+	require.Equal(t, common.MessageConsumedOnL1, transitions[0].State)
+}
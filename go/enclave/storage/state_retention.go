@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ten-protocol/go-ten/go/common"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// stateRetention - the states of the batches kept by the storage. An archive node persists the state of every batch. A
+// full node keeps the states of the latest batches in the trie cache, and dereferences the older ones so they are
+// garbage collected. The zero value is an archive node.
+type stateRetention struct {
+	mode    common.StateRetentionMode
+	batches uint64 // the number of the latest batches whose state a full node keeps
+
+	mutex      sync.Mutex
+	roots      []gethcommon.Hash // the roots of the latest states referenced in the trie cache, oldest first
+	firstSeqNo uint64            // the first batch whose state was committed since the enclave started, zero if none
+}
+
+func (s *storageImpl) StateRetention() common.StateRetentionMode {
+	if s.retention.mode != common.FullStateRetention {
+		return common.ArchiveStateRetention
+	}
+	return common.FullStateRetention
+}
+
+func (s *storageImpl) CommitState(seqNo uint64, root gethcommon.Hash) error {
+	r := &s.retention
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.firstSeqNo == 0 {
+		r.firstSeqNo = seqNo
+	}
+	trieDB := s.stateDB.TrieDB()
+	if s.StateRetention() == common.ArchiveStateRetention {
+		return trieDB.Commit(root, false)
+	}
+
+	if err := trieDB.Reference(root, gethcommon.Hash{}); err != nil {
+		return fmt.Errorf("could not reference the state of batch %d. Cause: %w", seqNo, err)
+	}
+	r.roots = append(r.roots, root)
+	// a state is persisted once per window, so an enclave which stops uncleanly replays less than a window of batches
+	if s.StatePersisted(seqNo) {
+		if err := trieDB.Commit(root, false); err != nil {
+			return fmt.Errorf("could not persist the state of batch %d. Cause: %w", seqNo, err)
+		}
+	}
+	for uint64(len(r.roots)) > r.batches {
+		if err := trieDB.Dereference(r.roots[0]); err != nil {
+			return fmt.Errorf("could not dereference the state of a batch older than the retention window. Cause: %w", err)
+		}
+		r.roots = r.roots[1:]
+	}
+	return nil
+}
+
+func (s *storageImpl) StatePersisted(seqNo uint64) bool {
+	return s.StateRetention() == common.ArchiveStateRetention || seqNo%s.retention.batches == 0
+}
+
+func (s *storageImpl) OldestStateSeqNo(headSeqNo uint64) uint64 {
+	if headSeqNo < common.L2GenesisSeqNo {
+		return 0
+	}
+	if s.StateRetention() == common.ArchiveStateRetention {
+		return common.L2GenesisSeqNo
+	}
+
+	oldest := common.L2GenesisSeqNo
+	if headSeqNo >= oldest+s.retention.batches {
+		oldest = headSeqNo - s.retention.batches + 1
+	}
+	s.retention.mutex.Lock()
+	defer s.retention.mutex.Unlock()
+	// the states committed before the enclave started were dropped from the trie cache, except the one the first
+	// committed state was built on, which is on the disk. Without a committed state, only the head state is on the disk.
+	base := headSeqNo
+	if s.retention.firstSeqNo != 0 {
+		base = s.retention.firstSeqNo - 1
+	}
+	if base > oldest {
+		return base
+	}
+	return oldest
+}
+
+// flushRetainedState - persists the latest state kept in the trie cache of a full node, so it is available when the
+// enclave restarts
+func (s *storageImpl) flushRetainedState() error {
+	r := &s.retention
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if len(r.roots) == 0 {
+		return nil
+	}
+	return s.stateDB.TrieDB().Commit(r.roots[len(r.roots)-1], false)
+}
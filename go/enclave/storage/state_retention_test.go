@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+// commitStates - commits a distinct state for each of the batches up to the seq number, and returns their roots
+func commitStates(t *testing.T, s *storageImpl, upToSeqNo uint64) map[uint64]gethcommon.Hash {
+	roots := make(map[uint64]gethcommon.Hash)
+	parentRoot := types.EmptyRootHash
+	for seqNo := common.L2GenesisSeqNo; seqNo <= upToSeqNo; seqNo++ {
+		stateDB, err := state.New(parentRoot, s.stateDB, nil)
+		require.NoError(t, err)
+		stateDB.SetBalance(gethcommon.BigToAddress(big.NewInt(int64(seqNo))), big.NewInt(int64(seqNo)))
+		root, err := stateDB.Commit(seqNo, true)
+		require.NoError(t, err)
+		require.NoError(t, s.CommitState(seqNo, root))
+		roots[seqNo] = root
+		parentRoot = root
+	}
+	return roots
+}
+
+func newTestStorage(t *testing.T, mode common.StateRetentionMode, batches uint64) *storageImpl {
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", gethlog.New())
+	require.NoError(t, err)
+	s := NewStorage(backingDB, nil, gethlog.New()).(*storageImpl)
+	s.retention = stateRetention{mode: mode, batches: batches}
+	return s
+}
+
+func stateAvailable(s *storageImpl, root gethcommon.Hash) bool {
+	_, err := state.New(root, s.stateDB, nil)
+	return err == nil
+}
+
+func TestFullNodeOnlyKeepsTheStatesOfTheRetentionWindow(t *testing.T) {
+	s := newTestStorage(t, common.FullStateRetention, 4)
+	roots := commitStates(t, s, 10)
+
+	require.Equal(t, common.FullStateRetention, s.StateRetention())
+	require.Equal(t, uint64(7), s.OldestStateSeqNo(10))
+	for seqNo := uint64(7); seqNo <= 10; seqNo++ {
+		require.True(t, stateAvailable(s, roots[seqNo]), "state of batch %d", seqNo)
+	}
+	// the states out of the window were garbage collected, except the ones persisted once per window
+	for seqNo := common.L2GenesisSeqNo; seqNo < 7; seqNo++ {
+		require.Equal(t, s.StatePersisted(seqNo), stateAvailable(s, roots[seqNo]), "state of batch %d", seqNo)
+	}
+
+	// the head state is persisted when the storage closes, so it survives a restart
+	require.False(t, s.StatePersisted(10))
+	require.NoError(t, s.flushRetainedState())
+	require.NoError(t, s.stateDB.TrieDB().Dereference(roots[10]))
+	require.True(t, stateAvailable(s, roots[10]))
+}
+
+func TestOldestStateAfterARestart(t *testing.T) {
+	s := newTestStorage(t, common.FullStateRetention, 4)
+	// without a committed state, only the head state is on the disk
+	require.Equal(t, uint64(20), s.OldestStateSeqNo(20))
+	require.Equal(t, uint64(0), s.OldestStateSeqNo(0))
+
+	// the first replayed batch is built on a persisted state
+	s.retention.firstSeqNo = 18
+	require.Equal(t, uint64(17), s.OldestStateSeqNo(20))
+	require.Equal(t, uint64(27), s.OldestStateSeqNo(30))
+}
+
+func TestArchiveNodeKeepsEveryState(t *testing.T) {
+	s := newTestStorage(t, "", 0)
+	roots := commitStates(t, s, 10)
+
+	require.Equal(t, common.ArchiveStateRetention, s.StateRetention())
+	require.Equal(t, common.L2GenesisSeqNo, s.OldestStateSeqNo(10))
+	for seqNo, root := range roots {
+		require.True(t, stateAvailable(s, root), "state of batch %d", seqNo)
+	}
+}
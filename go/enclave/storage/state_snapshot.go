@@ -0,0 +1,271 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/measure"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/enclavedb"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+const stateSnapshotCfg = "STATE_SNAPSHOT"
+
+// StateSnapshot - the state after a batch, with what a new validator needs to execute the batches following it: the
+// batch, its receipts and execution summary, its L1 block and the inbound items it deferred. The trie nodes and the codes are keyed by their hash, so they are verified as they are imported.
+type StateSnapshot struct {
+	Info              common.StateSnapshotInfo
+	Block             *types.Header
+	Header            *common.BatchHeader
+	Transactions      []*common.L2Tx
+	ConvertedHash     gethcommon.Hash
+	Receipts          []*SnapshotReceipt
+	Summary           *common.ExecutedBatchSummary
+	DeferredMessages  common.CrossChainMessages
+	DeferredTransfers common.ValueTransferEvents
+	StateNodes        [][]byte
+	Codes             [][]byte
+}
+
+// SnapshotReceipt - a receipt in its storage form, with the hash of its transaction, from which the other fields are
+// derived again
+type SnapshotReceipt struct {
+	TxHash  gethcommon.Hash
+	Receipt *types.ReceiptForStorage
+}
+
+func (s *storageImpl) ExportStateSnapshot(batch *core.Batch) (*StateSnapshot, error) {
+	defer s.logDuration("ExportStateSnapshot", measure.NewStopwatch())
+	block, err := s.FetchBlock(batch.Header.L1Proof)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the L1 block of the batch. Cause: %w", err)
+	}
+	// the converted hash is computed again by the importer when it is missing
+	convertedHash, err := s.FetchConvertedHash(batch.Hash())
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return nil, fmt.Errorf("could not fetch the converted hash of the batch. Cause: %w", err)
+	}
+	receipts, err := s.GetReceiptsByBatchHash(batch.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the receipts of the batch. Cause: %w", err)
+	}
+	summary, err := s.FetchBatchExecutionSummary(batch.SeqNo().Uint64())
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the execution summary of the batch. Cause: %w", err)
+	}
+	messages, transfers, err := s.FetchDeferredInbound(batch.Hash())
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return nil, fmt.Errorf("could not fetch the inbound items deferred by the batch. Cause: %w", err)
+	}
+
+	snapshot := &StateSnapshot{
+		Block:             block.Header(),
+		Header:            batch.Header,
+		Transactions:      batch.Transactions,
+		ConvertedHash:     convertedHash,
+		Summary:           summary,
+		DeferredMessages:  messages,
+		DeferredTransfers: transfers,
+	}
+	for _, receipt := range receipts {
+		snapshot.Receipts = append(snapshot.Receipts, &SnapshotReceipt{TxHash: receipt.TxHash, Receipt: (*types.ReceiptForStorage)(receipt)})
+	}
+	err = s.walkState(batch.Header.Root,
+		func(node []byte) { snapshot.StateNodes = append(snapshot.StateNodes, node) },
+		func(code []byte) { snapshot.Codes = append(snapshot.Codes, code) },
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not export the state of the batch. Cause: %w", err)
+	}
+	return snapshot, nil
+}
+
+func (s *storageImpl) ImportStateSnapshot(snapshot *StateSnapshot) error {
+	defer s.logDuration("ImportStateSnapshot", measure.NewStopwatch())
+	// a snapshot cannot be mixed with the batches and the blocks processed by the enclave
+	if _, err := s.FetchCurrentSequencerNo(); !errors.Is(err, errutil.ErrNotFound) {
+		return fmt.Errorf("a state snapshot can only be imported in an empty database. The database has batches")
+	}
+	if _, err := s.FetchHeadBlock(); !errors.Is(err, errutil.ErrNotFound) {
+		return fmt.Errorf("a state snapshot can only be imported in an empty database. The database has L1 blocks")
+	}
+
+	batch := &core.Batch{Header: snapshot.Header, Transactions: snapshot.Transactions}
+	receipts, err := s.snapshotReceipts(batch, snapshot.Receipts)
+	if err != nil {
+		return err
+	}
+
+	// the state is written first and checked to be complete. It is only reachable once the batch is written.
+	nodes := s.db.NewBatch()
+	for _, node := range snapshot.StateNodes {
+		rawdb.WriteLegacyTrieNode(nodes, gethcrypto.Keccak256Hash(node), node)
+		if err := writeIfFull(nodes); err != nil {
+			return fmt.Errorf("could not write the state nodes. Cause: %w", err)
+		}
+	}
+	for _, code := range snapshot.Codes {
+		rawdb.WriteCode(nodes, gethcrypto.Keccak256Hash(code), code)
+		if err := writeIfFull(nodes); err != nil {
+			return fmt.Errorf("could not write the contract codes. Cause: %w", err)
+		}
+	}
+	if err := nodes.Write(); err != nil {
+		return fmt.Errorf("could not write the state nodes. Cause: %w", err)
+	}
+	if err := s.walkState(batch.Header.Root, func([]byte) {}, func([]byte) {}); err != nil {
+		return fmt.Errorf("the state of the snapshot is incomplete. Cause: %w", err)
+	}
+
+	encodedInfo, err := json.Marshal(snapshot.Info)
+	if err != nil {
+		return fmt.Errorf("could not encode the snapshot info. Cause: %w", err)
+	}
+	dbTx := s.db.NewDBTransaction()
+	if err := enclavedb.WriteBatchAndTransactions(dbTx, batch, snapshot.ConvertedHash); err != nil {
+		return fmt.Errorf("could not write the batch. Cause: %w", err)
+	}
+	if err := enclavedb.WriteBlock(dbTx, snapshot.Block); err != nil {
+		return fmt.Errorf("could not write the L1 block. Cause: %w", err)
+	}
+	// makes the batch canonical
+	enclavedb.UpdateCanonicalBlocks(dbTx, []common.L1BlockHash{snapshot.Block.Hash()}, nil)
+	// the logs of the batch are not indexed, because they are filtered against the state of its parent, which the
+	// snapshot does not have
+	if err := enclavedb.WriteBatchExecution(dbTx, batch.SeqNo(), receipts); err != nil {
+		return fmt.Errorf("could not write the receipts. Cause: %w", err)
+	}
+	enclavedb.WriteBatchExecutionSummary(dbTx, snapshot.Summary, batch.Header.L1Proof)
+	if len(snapshot.DeferredMessages)+len(snapshot.DeferredTransfers) > 0 {
+		if err := enclavedb.WriteDeferredInbound(dbTx, batch.Hash(), snapshot.DeferredMessages, snapshot.DeferredTransfers); err != nil {
+			return fmt.Errorf("could not write the deferred inbound items. Cause: %w", err)
+		}
+	}
+	enclavedb.WriteConfigToBatch(dbTx, stateSnapshotCfg, encodedInfo)
+	if err := dbTx.Write(); err != nil {
+		return fmt.Errorf("could not commit the snapshot. Cause: %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchImportedStateSnapshot() (*common.StateSnapshotInfo, error) {
+	defer s.logDuration("FetchImportedStateSnapshot", measure.NewStopwatch())
+	encoded, err := enclavedb.FetchConfig(s.db.GetSQLDB(), stateSnapshotCfg)
+	if err != nil {
+		return nil, err
+	}
+	info := new(common.StateSnapshotInfo)
+	if err := json.Unmarshal(encoded, info); err != nil {
+		return nil, fmt.Errorf("could not decode the snapshot info. Cause: %w", err)
+	}
+	return info, nil
+}
+
+// snapshotReceipts - the receipts of the batch, with the fields derived from their transactions, as they are read from
+// the database
+func (s *storageImpl) snapshotReceipts(batch *core.Batch, snapshotReceipts []*SnapshotReceipt) (types.Receipts, error) {
+	txs := make(map[gethcommon.Hash]*common.L2Tx, len(batch.Transactions))
+	for _, tx := range batch.Transactions {
+		txs[tx.Hash()] = tx
+	}
+	receipts := make(types.Receipts, 0, len(snapshotReceipts))
+	for _, snapshotReceipt := range snapshotReceipts {
+		tx, found := txs[snapshotReceipt.TxHash]
+		if !found {
+			return nil, fmt.Errorf("the receipt of tx %s is not for a transaction of the batch", snapshotReceipt.TxHash)
+		}
+		derived := types.Receipts{(*types.Receipt)(snapshotReceipt.Receipt)}
+		if err := derived.DeriveFields(s.chainConfig, batch.Hash(), batch.NumberU64(), 0, big.NewInt(0), big.NewInt(0), []*common.L2Tx{tx}); err != nil {
+			return nil, fmt.Errorf("could not derive the fields of the receipt of tx %s. Cause: %w", tx.Hash(), err)
+		}
+		receipts = append(receipts, derived[0])
+	}
+	return receipts, nil
+}
+
+// walkState - calls onNode with each node of the state trie with the root and of the storage tries of its accounts,
+// and onCode with the code of each contract. It fails when a node or a code is missing.
+func (s *storageImpl) walkState(root gethcommon.Hash, onNode func([]byte), onCode func([]byte)) error {
+	trieDB := s.stateDB.TrieDB()
+	accounts, err := trie.New(trie.StateTrieID(root), trieDB)
+	if err != nil {
+		return fmt.Errorf("could not open the state trie %s. Cause: %w", root, err)
+	}
+	it, err := accounts.NodeIterator(nil)
+	if err != nil {
+		return err
+	}
+
+	storageRoots := make(map[gethcommon.Hash]bool)
+	codes := make(map[gethcommon.Hash]bool)
+	for it.Next(true) {
+		// the nodes without a hash are embedded in their parent
+		if it.Hash() != (gethcommon.Hash{}) {
+			onNode(it.NodeBlob())
+		}
+		if !it.Leaf() {
+			continue
+		}
+
+		var account types.StateAccount
+		if err := rlp.DecodeBytes(it.LeafBlob(), &account); err != nil {
+			return fmt.Errorf("could not decode account %x. Cause: %w", it.LeafKey(), err)
+		}
+		if account.Root != types.EmptyRootHash && !storageRoots[account.Root] {
+			storageRoots[account.Root] = true
+			if err := walkStorage(trieDB, root, gethcommon.BytesToHash(it.LeafKey()), account.Root, onNode); err != nil {
+				return err
+			}
+		}
+		codeHash := gethcommon.BytesToHash(account.CodeHash)
+		if codeHash != types.EmptyCodeHash && !codes[codeHash] {
+			codes[codeHash] = true
+			code, err := s.stateDB.ContractCode(gethcommon.Address{}, codeHash)
+			if err != nil {
+				return fmt.Errorf("could not read code %s. Cause: %w", codeHash, err)
+			}
+			onCode(code)
+		}
+	}
+	return it.Error()
+}
+
+func walkStorage(trieDB *trie.Database, stateRoot gethcommon.Hash, owner gethcommon.Hash, root gethcommon.Hash, onNode func([]byte)) error {
+	storage, err := trie.New(trie.StorageTrieID(stateRoot, owner, root), trieDB)
+	if err != nil {
+		return fmt.Errorf("could not open the storage trie of account %s. Cause: %w", owner, err)
+	}
+	it, err := storage.NodeIterator(nil)
+	if err != nil {
+		return err
+	}
+	for it.Next(true) {
+		if it.Hash() != (gethcommon.Hash{}) {
+			onNode(it.NodeBlob())
+		}
+	}
+	return it.Error()
+}
+
+func writeIfFull(batch ethdb.Batch) error {
+	if batch.ValueSize() < ethdb.IdealBatchSize {
+		return nil
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	batch.Reset()
+	return nil
+}
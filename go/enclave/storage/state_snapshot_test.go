@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	snapshotAccount  = gethcommon.HexToAddress("0x1")
+	snapshotContract = gethcommon.HexToAddress("0x2")
+	snapshotSlot     = gethcommon.HexToHash("0x3")
+)
+
+// storeSnapshotBatch - stores an executed batch whose state has an account, and a contract with code and storage
+func storeSnapshotBatch(t *testing.T, s *storageImpl) *core.Batch {
+	stateDB, err := s.EmptyStateDB()
+	require.NoError(t, err)
+	stateDB.SetBalance(snapshotAccount, big.NewInt(100))
+	stateDB.SetNonce(snapshotAccount, 7)
+	stateDB.SetCode(snapshotContract, []byte{0x60, 0x00})
+	stateDB.SetState(snapshotContract, snapshotSlot, gethcommon.HexToHash("0x4"))
+	root, err := stateDB.Commit(common.L2GenesisSeqNo+1, true)
+	require.NoError(t, err)
+	require.NoError(t, s.CommitState(common.L2GenesisSeqNo+1, root))
+
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1)})
+	require.NoError(t, s.StoreBlock(block, nil))
+	batch := &core.Batch{Header: &common.BatchHeader{
+		Number:           big.NewInt(1),
+		SequencerOrderNo: big.NewInt(int64(common.L2GenesisSeqNo + 1)),
+		Root:             root,
+		L1Proof:          block.Hash(),
+	}}
+	require.NoError(t, s.StoreBatch(batch, gethcommon.Hash{}))
+	require.NoError(t, s.StoreExecutedBatch(batch, nil))
+	return batch
+}
+
+func TestStateSnapshotIsImportedInAnEmptyDatabase(t *testing.T) {
+	exporter := newTestStorage(t, common.ArchiveStateRetention, 0)
+	batch := storeSnapshotBatch(t, exporter)
+	snapshot, err := exporter.ExportStateSnapshot(batch)
+	require.NoError(t, err)
+	require.Len(t, snapshot.Codes, 1)
+	snapshot.Info = common.StateSnapshotInfo{BatchHash: batch.Hash(), SeqNo: batch.SeqNo().Uint64(), StateRoot: batch.Header.Root}
+
+	importer := newTestStorage(t, common.ArchiveStateRetention, 0)
+	require.NoError(t, importer.ImportStateSnapshot(snapshot))
+
+	head, err := importer.FetchHeadBatch()
+	require.NoError(t, err)
+	require.Equal(t, batch.Hash(), head.Hash())
+	stateDB, err := importer.CreateStateDB(batch.Hash())
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), stateDB.GetBalance(snapshotAccount))
+	require.Equal(t, uint64(7), stateDB.GetNonce(snapshotAccount))
+	require.Equal(t, []byte{0x60, 0x00}, stateDB.GetCode(snapshotContract))
+	require.Equal(t, gethcommon.HexToHash("0x4"), stateDB.GetState(snapshotContract, snapshotSlot))
+	info, err := importer.FetchImportedStateSnapshot()
+	require.NoError(t, err)
+	require.Equal(t, snapshot.Info, *info)
+
+	// the database is no longer empty
+	require.ErrorContains(t, importer.ImportStateSnapshot(snapshot), "empty database")
+}
+
+func TestIncompleteStateSnapshotIsRejected(t *testing.T) {
+	exporter := newTestStorage(t, common.ArchiveStateRetention, 0)
+	snapshot, err := exporter.ExportStateSnapshot(storeSnapshotBatch(t, exporter))
+	require.NoError(t, err)
+	snapshot.StateNodes = snapshot.StateNodes[1:]
+
+	importer := newTestStorage(t, common.ArchiveStateRetention, 0)
+	require.ErrorContains(t, importer.ImportStateSnapshot(snapshot), "incomplete")
+	// nothing refers to the nodes which were imported, so the snapshot can be imported again
+	_, err = importer.FetchCurrentSequencerNo()
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+	_, err = importer.FetchImportedStateSnapshot()
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+}
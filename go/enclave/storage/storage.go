@@ -2,10 +2,12 @@ package storage
 
 import (
 	"bytes"
+	"context"
 	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/ristretto"
@@ -19,6 +21,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/enclave/storage/enclavedb"
 
 	"github.com/ethereum/go-ethereum/rlp"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
 	gethcore "github.com/ethereum/go-ethereum/core"
 	gethcrypto "github.com/ethereum/go-ethereum/crypto"
@@ -30,6 +33,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/tracers"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
@@ -42,6 +46,19 @@ import (
 // todo - this will require a dedicated table when upgrades are implemented
 const (
 	masterSeedCfg = "MASTER_SEED"
+	// the purpose used to derive the mempool encryption key from the shared secret
+	mempoolEncryptionPurpose = "MEMPOOL"
+	// the purpose used to derive the subscriptions encryption key from the shared secret
+	subscriptionEncryptionPurpose = "SUBSCRIPTION"
+	lastDeliveredBatchCfg         = "LAST_DELIVERED_BATCH"
+	replayCheckpointCfg           = "REPLAY_CHECKPOINT"
+)
+
+// the caches share a store, in which the keys are hashed along with their type. The seq numbers and the heights have
+// distinct types, otherwise the entries of a height and of a seq number with the same value overwrite each other.
+type (
+	seqNoKey  uint64
+	heightKey uint64
 )
 
 type storageImpl struct {
@@ -62,19 +79,32 @@ type storageImpl struct {
 	// note: to fetch a batch by height will require 2 cache hits
 	seqCacheByHeight *cache.Cache[*big.Int]
 
+	// the store of the caches above, whose background processing is stopped on close
+	ristrettoCache *ristretto.Cache
+
 	cachedSharedSecret *crypto.SharedEnclaveSecret
 
+	// guards the encryption services created lazily, which the concurrent requests may all try to create
+	encryptionLock sync.Mutex
+	// encrypts the persisted mempool transactions. Created lazily, once the shared secret is available
+	mempoolEncryption crypto.DataEncryptionService
+	// encrypts the persisted log subscriptions. Created lazily, once the shared secret is available
+	subscriptionEncryption crypto.DataEncryptionService
+
 	stateDB     state.Database
+	retention   stateRetention
 	chainConfig *params.ChainConfig
 	logger      gethlog.Logger
 }
 
-func NewStorageFromConfig(config *config.EnclaveConfig, chainConfig *params.ChainConfig, logger gethlog.Logger) Storage {
+func NewStorageFromConfig(config *config.EnclaveConfig, chainConfig *params.ChainConfig, logger gethlog.Logger) (Storage, error) {
 	backingDB, err := CreateDBFromConfig(config, logger)
 	if err != nil {
-		logger.Crit("Failed to connect to backing database", log.ErrKey, err)
+		return nil, fmt.Errorf("failed to connect to backing database. Cause: %w", err)
 	}
-	return NewStorage(backingDB, chainConfig, logger)
+	storage := NewStorage(backingDB, chainConfig, logger)
+	storage.(*storageImpl).retention = stateRetention{mode: config.StateRetention, batches: config.StateRetentionBatches}
+	return storage, nil
 }
 
 func NewStorage(backingDB enclavedb.EnclaveDB, chainConfig *params.ChainConfig, logger gethlog.Logger) Storage {
@@ -111,6 +141,7 @@ func NewStorage(backingDB enclavedb.EnclaveDB, chainConfig *params.ChainConfig,
 		batchCacheBySeqNo: cache.New[*core.Batch](ristrettoStore),
 		seqCacheByHash:    cache.New[*big.Int](ristrettoStore),
 		seqCacheByHeight:  cache.New[*big.Int](ristrettoStore),
+		ristrettoCache:    ristrettoCache,
 		logger:            logger,
 	}
 }
@@ -124,6 +155,11 @@ func (s *storageImpl) StateDB() state.Database {
 }
 
 func (s *storageImpl) Close() error {
+	// the states kept in memory by a full node are lost otherwise, and would have to be replayed
+	if err := s.flushRetainedState(); err != nil {
+		s.logger.Error("Could not persist the state of the head batch", log.ErrKey, err)
+	}
+	s.ristrettoCache.Close()
 	return s.db.GetSQLDB().Close()
 }
 
@@ -161,6 +197,37 @@ func (s *storageImpl) FetchConvertedHash(hash common.L2BatchHash) (gethcommon.Ha
 	return enclavedb.FetchConvertedBatchHash(s.db.GetSQLDB(), batch.Header.SequencerOrderNo.Uint64())
 }
 
+func (s *storageImpl) FetchBatchHashByConvertedHash(convertedHash gethcommon.Hash) (common.L2BatchHash, error) {
+	defer s.logDuration("FetchBatchHashByConvertedHash", measure.NewStopwatch())
+	return enclavedb.FetchBatchHashByConvertedHash(s.db.GetSQLDB(), convertedHash)
+}
+
+func (s *storageImpl) FetchBatchesWithoutConvertedHash(limit uint64) ([]*core.Batch, error) {
+	defer s.logDuration("FetchBatchesWithoutConvertedHash", measure.NewStopwatch())
+	seqNos, err := enclavedb.FetchSeqNosWithoutConvertedHash(s.db.GetSQLDB(), limit)
+	if err != nil {
+		return nil, err
+	}
+	batches := make([]*core.Batch, 0, len(seqNos))
+	for _, seqNo := range seqNos {
+		batch, err := s.FetchBatchBySeqNo(seqNo)
+		if err != nil {
+			return nil, err
+		}
+		batches = append(batches, batch)
+	}
+	return batches, nil
+}
+
+func (s *storageImpl) StoreConvertedHash(hash common.L2BatchHash, convertedHash gethcommon.Hash) error {
+	defer s.logDuration("StoreConvertedHash", measure.NewStopwatch())
+	batch, err := s.FetchBatch(hash)
+	if err != nil {
+		return err
+	}
+	return enclavedb.WriteConvertedBatchHash(s.db.GetSQLDB(), batch.SeqNo().Uint64(), convertedHash)
+}
+
 func (s *storageImpl) FetchBatchHeader(hash common.L2BatchHash) (*common.BatchHeader, error) {
 	defer s.logDuration("FetchBatchHeader", measure.NewStopwatch())
 	b, err := s.FetchBatch(hash)
@@ -172,8 +239,7 @@ func (s *storageImpl) FetchBatchHeader(hash common.L2BatchHash) (*common.BatchHe
 
 func (s *storageImpl) FetchBatchByHeight(height uint64) (*core.Batch, error) {
 	defer s.logDuration("FetchBatchByHeight", measure.NewStopwatch())
-	// the key is (height+1), because for some reason it doesn't like a key of 0
-	seqNo, err := common.GetCachedValue(s.seqCacheByHeight, s.logger, height+1, func(h any) (*big.Int, error) {
+	seqNo, err := common.GetCachedValue(s.seqCacheByHeight, s.logger, heightKey(height), func(h any) (*big.Int, error) {
 		batch, err := enclavedb.ReadCanonicalBatchByHeight(s.db.GetSQLDB(), height)
 		if err != nil {
 			return nil, err
@@ -211,10 +277,29 @@ func (s *storageImpl) StoreBlock(b *types.Block, chainFork *common.ChainFork) er
 	}
 
 	common.CacheValue(s.blockCache, s.logger, b.Hash(), b)
+	if chainFork != nil && chainFork.IsFork() {
+		// the canonical batch changed at the heights of the batches of the reorged blocks
+		s.evictBatchHeights(append(chainFork.CanonicalPath, chainFork.NonCanonicalPath...))
+	}
 
 	return nil
 }
 
+// evictBatchHeights - removes the heights of the batches published in the blocks from the cache, so the canonical
+// batches are read from the db
+func (s *storageImpl) evictBatchHeights(blocks []common.L1BlockHash) {
+	for _, block := range blocks {
+		batches, err := enclavedb.ReadBatchesByBlock(s.db.GetSQLDB(), block)
+		if err != nil {
+			s.logger.Error("Could not read the batches of the reorged block", log.BlockHashKey, block, log.ErrKey, err)
+			continue
+		}
+		for _, batch := range batches {
+			_ = s.seqCacheByHeight.Delete(context.Background(), heightKey(batch.NumberU64()))
+		}
+	}
+}
+
 func (s *storageImpl) FetchBlock(blockHash common.L1BlockHash) (*types.Block, error) {
 	defer s.logDuration("FetchBlock", measure.NewStopwatch())
 	return common.GetCachedValue(s.blockCache, s.logger, blockHash, func(hash any) (*types.Block, error) {
@@ -382,9 +467,31 @@ func (s *storageImpl) StoreAttestedKey(aggregator gethcommon.Address, key *ecdsa
 	return err
 }
 
+func (s *storageImpl) StoreSecretDisclosure(record *common.SecretDisclosureRecord) error {
+	defer s.logDuration("StoreSecretDisclosure", measure.NewStopwatch())
+	dbTx := s.db.NewDBTransaction()
+	if err := enclavedb.WriteSecretDisclosure(dbTx, record); err != nil {
+		return err
+	}
+	if err := dbTx.Write(); err != nil {
+		return fmt.Errorf("could not commit secret disclosure record. Cause: %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) SecretRequestAnswered(requester gethcommon.Address, attestationHash gethcommon.Hash) (bool, error) {
+	defer s.logDuration("SecretRequestAnswered", measure.NewStopwatch())
+	return enclavedb.SecretRequestAnswered(s.db.GetSQLDB(), requester, attestationHash)
+}
+
+func (s *storageImpl) FetchSecretDisclosures(fromID uint64, count uint64) ([]*common.SecretDisclosureRecord, error) {
+	defer s.logDuration("FetchSecretDisclosures", measure.NewStopwatch())
+	return enclavedb.FetchSecretDisclosures(s.db.GetSQLDB(), fromID, count)
+}
+
 func (s *storageImpl) FetchBatchBySeqNo(seqNum uint64) (*core.Batch, error) {
 	defer s.logDuration("FetchBatchBySeqNo", measure.NewStopwatch())
-	b, err := common.GetCachedValue(s.batchCacheBySeqNo, s.logger, seqNum, func(seq any) (*core.Batch, error) {
+	b, err := common.GetCachedValue(s.batchCacheBySeqNo, s.logger, seqNoKey(seqNum), func(seq any) (*core.Batch, error) {
 		return enclavedb.ReadBatchBySeqNo(s.db.GetSQLDB(), seqNum)
 	})
 	if err == nil && b == nil {
@@ -393,6 +500,18 @@ func (s *storageImpl) FetchBatchBySeqNo(seqNum uint64) (*core.Batch, error) {
 	return b, err
 }
 
+func (s *storageImpl) FetchBatchesBySeqRange(fromSeqNo uint64, toSeqNo uint64, maxCount uint64) ([]*core.Batch, error) {
+	defer s.logDuration("FetchBatchesBySeqRange", measure.NewStopwatch())
+	batches, err := enclavedb.ReadBatchesBySeqRange(s.db.GetSQLDB(), fromSeqNo, toSeqNo, maxCount)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range batches {
+		common.CacheValue(s.batchCacheBySeqNo, s.logger, seqNoKey(b.SeqNo().Uint64()), b)
+	}
+	return batches, nil
+}
+
 func (s *storageImpl) FetchBatchesByBlock(block common.L1BlockHash) ([]*core.Batch, error) {
 	defer s.logDuration("FetchBatchesByBlock", measure.NewStopwatch())
 	return enclavedb.ReadBatchesByBlock(s.db.GetSQLDB(), block)
@@ -424,11 +543,10 @@ func (s *storageImpl) StoreBatch(batch *core.Batch, convertedHash gethcommon.Has
 		return fmt.Errorf("could not commit batch %w", err)
 	}
 
-	common.CacheValue(s.batchCacheBySeqNo, s.logger, batch.SeqNo().Uint64(), batch)
+	common.CacheValue(s.batchCacheBySeqNo, s.logger, seqNoKey(batch.SeqNo().Uint64()), batch)
 	common.CacheValue(s.seqCacheByHash, s.logger, batch.Hash(), batch.SeqNo())
-	// note: the key is (height+1), because for some reason it doesn't like a key of 0
-	// should always contain the canonical batch because the cache is overwritten by each new batch after a reorg
-	common.CacheValue(s.seqCacheByHeight, s.logger, batch.NumberU64()+1, batch.SeqNo())
+	// the batch is not cached by height because it is not known to be canonical. The height is read again from the db.
+	_ = s.seqCacheByHeight.Delete(context.Background(), heightKey(batch.NumberU64()))
 	return nil
 }
 
@@ -447,6 +565,7 @@ func (s *storageImpl) StoreExecutedBatch(batch *core.Batch, receipts []*types.Re
 	if err := enclavedb.WriteBatchExecution(dbTx, batch.SeqNo(), receipts); err != nil {
 		return fmt.Errorf("could not write transaction receipts. Cause: %w", err)
 	}
+	enclavedb.WriteBatchExecutionSummary(dbTx, batch.ExecutionSummary(receipts), batch.Header.L1Proof)
 
 	if batch.Number().Int64() > 1 {
 		stateDB, err := s.CreateStateDB(batch.Header.ParentHash)
@@ -454,7 +573,13 @@ func (s *storageImpl) StoreExecutedBatch(batch *core.Batch, receipts []*types.Re
 			return fmt.Errorf("could not create state DB to filter logs. Cause: %w", err)
 		}
 
-		err = enclavedb.StoreEventLogs(dbTx, receipts, stateDB)
+		senders := make(map[gethcommon.Hash]*gethcommon.Address, len(batch.Transactions))
+		for _, tx := range batch.Transactions {
+			if sender, err := core.GetTxSigner(tx); err == nil {
+				senders[tx.Hash()] = &sender
+			}
+		}
+		err = enclavedb.StoreEventLogs(dbTx, receipts, senders, stateDB)
 		if err != nil {
 			return fmt.Errorf("could not save logs %w", err)
 		}
@@ -467,6 +592,16 @@ func (s *storageImpl) StoreExecutedBatch(batch *core.Batch, receipts []*types.Re
 	return nil
 }
 
+func (s *storageImpl) FetchBatchExecutionSummary(seqNo uint64) (*common.ExecutedBatchSummary, error) {
+	defer s.logDuration("FetchBatchExecutionSummary", measure.NewStopwatch())
+	return enclavedb.FetchBatchExecutionSummary(s.db.GetSQLDB(), seqNo)
+}
+
+func (s *storageImpl) FetchBatchExecutionSummaries(fromSeqNo uint64, toSeqNo uint64, limit uint64) ([]*common.ExecutedBatchSummary, error) {
+	defer s.logDuration("FetchBatchExecutionSummaries", measure.NewStopwatch())
+	return enclavedb.FetchBatchExecutionSummaries(s.db.GetSQLDB(), fromSeqNo, toSeqNo, limit)
+}
+
 func (s *storageImpl) StoreValueTransfers(blockHash common.L1BlockHash, transfers common.ValueTransferEvents) error {
 	return enclavedb.WriteL1Messages(s.db.GetSQLDB(), blockHash, transfers, true)
 }
@@ -485,6 +620,27 @@ func (s *storageImpl) GetL1Transfers(blockHash common.L1BlockHash) (common.Value
 	return enclavedb.FetchL1Messages[common.ValueTransferEvent](s.db.GetSQLDB(), blockHash, true)
 }
 
+func (s *storageImpl) StoreDeferredInbound(batchHash common.L2BatchHash, messages common.CrossChainMessages, transfers common.ValueTransferEvents) error {
+	defer s.logDuration("StoreDeferredInbound", measure.NewStopwatch())
+	dbTx := s.db.NewDBTransaction()
+	if err := enclavedb.WriteDeferredInbound(dbTx, batchHash, messages, transfers); err != nil {
+		return err
+	}
+	if err := dbTx.Write(); err != nil {
+		return fmt.Errorf("could not commit the deferred inbound messages. Cause: %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchDeferredInbound(batchHash common.L2BatchHash) (common.CrossChainMessages, common.ValueTransferEvents, error) {
+	defer s.logDuration("FetchDeferredInbound", measure.NewStopwatch())
+	messages, transfers, err := enclavedb.FetchDeferredInbound(s.db.GetSQLDB(), batchHash)
+	if errors.Is(err, errutil.ErrNotFound) {
+		return nil, nil, nil
+	}
+	return messages, transfers, err
+}
+
 const enclaveKeyKey = "ek"
 
 func (s *storageImpl) StoreEnclaveKey(enclaveKey *crypto.EnclaveKey) error {
@@ -511,11 +667,155 @@ func (s *storageImpl) GetEnclaveKey() (*crypto.EnclaveKey, error) {
 	return crypto.NewEnclaveKey(ecdsaKey), nil
 }
 
-func (s *storageImpl) StoreRollup(rollup *common.ExtRollup, internalHeader *common.CalldataRollupHeader) error {
+func (s *storageImpl) StoreMempoolTx(tx *common.L2Tx) error {
+	defer s.logDuration("StoreMempoolTx", measure.NewStopwatch())
+	encryption, err := s.mempoolEncryptionService()
+	if err != nil {
+		return err
+	}
+	txBytes, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return fmt.Errorf("could not encode mempool tx. Cause: %w", err)
+	}
+	encrypted, err := encryption.Encrypt(txBytes)
+	if err != nil {
+		return fmt.Errorf("could not encrypt mempool tx. Cause: %w", err)
+	}
+	return enclavedb.WriteMempoolTx(s.db.GetSQLDB(), tx.Hash(), encrypted)
+}
+
+func (s *storageImpl) DeleteMempoolTxs(hashes []common.L2TxHash) error {
+	defer s.logDuration("DeleteMempoolTxs", measure.NewStopwatch())
+	return enclavedb.DeleteMempoolTxs(s.db.GetSQLDB(), hashes)
+}
+
+func (s *storageImpl) FetchMempoolTxs() ([]*common.L2Tx, error) {
+	defer s.logDuration("FetchMempoolTxs", measure.NewStopwatch())
+	encryption, err := s.mempoolEncryptionService()
+	if err != nil {
+		return nil, err
+	}
+	contents, err := enclavedb.FetchMempoolTxs(s.db.GetSQLDB())
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]*common.L2Tx, 0, len(contents))
+	for _, content := range contents {
+		txBytes, err := encryption.Decrypt(content)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt mempool tx. Cause: %w", err)
+		}
+		tx := new(common.L2Tx)
+		if err := rlp.DecodeBytes(txBytes, tx); err != nil {
+			return nil, fmt.Errorf("could not decode mempool tx. Cause: %w", err)
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
+func (s *storageImpl) mempoolEncryptionService() (crypto.DataEncryptionService, error) {
+	s.encryptionLock.Lock()
+	defer s.encryptionLock.Unlock()
+	if s.mempoolEncryption != nil {
+		return s.mempoolEncryption, nil
+	}
+	secret, err := s.FetchSecret()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the shared secret to encrypt the mempool. Cause: %w", err)
+	}
+	s.mempoolEncryption = crypto.NewSecretDataEncryptionService(secret, mempoolEncryptionPurpose, s.logger)
+	return s.mempoolEncryption, nil
+}
+
+func (s *storageImpl) StoreSubscription(id gethrpc.ID, encodedSubscription []byte) error {
+	defer s.logDuration("StoreSubscription", measure.NewStopwatch())
+	encryption, err := s.subscriptionEncryptionService()
+	if err != nil {
+		return err
+	}
+	encrypted, err := encryption.Encrypt(encodedSubscription)
+	if err != nil {
+		return fmt.Errorf("could not encrypt subscription. Cause: %w", err)
+	}
+	return enclavedb.WriteSubscription(s.db.GetSQLDB(), id, encrypted)
+}
+
+func (s *storageImpl) DeleteSubscription(id gethrpc.ID) error {
+	defer s.logDuration("DeleteSubscription", measure.NewStopwatch())
+	return enclavedb.DeleteSubscription(s.db.GetSQLDB(), id)
+}
+
+func (s *storageImpl) FetchSubscriptions() (map[gethrpc.ID][]byte, error) {
+	defer s.logDuration("FetchSubscriptions", measure.NewStopwatch())
+	contents, err := enclavedb.FetchSubscriptions(s.db.GetSQLDB())
+	if err != nil || len(contents) == 0 {
+		return nil, err
+	}
+	encryption, err := s.subscriptionEncryptionService()
+	if err != nil {
+		return nil, err
+	}
+	subscriptions := make(map[gethrpc.ID][]byte, len(contents))
+	for id, content := range contents {
+		encodedSubscription, err := encryption.Decrypt(content)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt subscription. Cause: %w", err)
+		}
+		subscriptions[id] = encodedSubscription
+	}
+	return subscriptions, nil
+}
+
+func (s *storageImpl) StoreLastDeliveredBatch(height uint64) error {
+	defer s.logDuration("StoreLastDeliveredBatch", measure.NewStopwatch())
+	_, err := enclavedb.UpsertConfig(s.db.GetSQLDB(), lastDeliveredBatchCfg, big.NewInt(0).SetUint64(height).Bytes())
+	return err
+}
+
+func (s *storageImpl) FetchLastDeliveredBatch() (uint64, error) {
+	defer s.logDuration("FetchLastDeliveredBatch", measure.NewStopwatch())
+	height, err := enclavedb.FetchConfig(s.db.GetSQLDB(), lastDeliveredBatchCfg)
+	if err != nil {
+		return 0, err
+	}
+	return big.NewInt(0).SetBytes(height).Uint64(), nil
+}
+
+func (s *storageImpl) StoreReplayCheckpoint(seqNo uint64) error {
+	defer s.logDuration("StoreReplayCheckpoint", measure.NewStopwatch())
+	_, err := enclavedb.UpsertConfig(s.db.GetSQLDB(), replayCheckpointCfg, big.NewInt(0).SetUint64(seqNo).Bytes())
+	return err
+}
+
+func (s *storageImpl) FetchReplayCheckpoint() (uint64, error) {
+	defer s.logDuration("FetchReplayCheckpoint", measure.NewStopwatch())
+	seqNo, err := enclavedb.FetchConfig(s.db.GetSQLDB(), replayCheckpointCfg)
+	if err != nil {
+		return 0, err
+	}
+	return big.NewInt(0).SetBytes(seqNo).Uint64(), nil
+}
+
+func (s *storageImpl) subscriptionEncryptionService() (crypto.DataEncryptionService, error) {
+	s.encryptionLock.Lock()
+	defer s.encryptionLock.Unlock()
+	if s.subscriptionEncryption != nil {
+		return s.subscriptionEncryption, nil
+	}
+	secret, err := s.FetchSecret()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the shared secret to encrypt the subscriptions. Cause: %w", err)
+	}
+	s.subscriptionEncryption = crypto.NewSecretDataEncryptionService(secret, subscriptionEncryptionPurpose, s.logger)
+	return s.subscriptionEncryption, nil
+}
+
+func (s *storageImpl) StoreRollup(rollup *common.ExtRollup, internalHeader *common.CalldataRollupHeader, l1Block common.L1BlockHash) error {
 	defer s.logDuration("StoreRollup", measure.NewStopwatch())
 	dbBatch := s.db.NewDBTransaction()
 
-	if err := enclavedb.WriteRollup(dbBatch, rollup.Header, internalHeader); err != nil {
+	if err := enclavedb.WriteRollup(dbBatch, rollup.Header, internalHeader, l1Block, rollup.CompressedSize()); err != nil {
 		return fmt.Errorf("could not write rollup. Cause: %w", err)
 	}
 
@@ -525,6 +825,168 @@ func (s *storageImpl) StoreRollup(rollup *common.ExtRollup, internalHeader *comm
 	return nil
 }
 
+func (s *storageImpl) StoreMessageTree(batchSeqNo uint64, root gethcommon.Hash, leaves []gethcommon.Hash, senders []gethcommon.Address) error {
+	defer s.logDuration("StoreMessageTree", measure.NewStopwatch())
+	dbTx := s.db.NewDBTransaction()
+	if err := enclavedb.WriteMessageTree(dbTx, batchSeqNo, root, leaves, senders); err != nil {
+		return fmt.Errorf("could not write message tree. Cause: %w", err)
+	}
+	if err := dbTx.Write(); err != nil {
+		return fmt.Errorf("could not commit message tree. Cause: %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchMessageTree(messageHash gethcommon.Hash) (*MessageTreeEntry, error) {
+	defer s.logDuration("FetchMessageTree", measure.NewStopwatch())
+	batchSeqNo, idx, sender, root, leaves, err := enclavedb.FetchMessageTree(s.db.GetSQLDB(), messageHash)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageTreeEntry{
+		BatchSeqNo: batchSeqNo,
+		Index:      idx,
+		Sender:     sender,
+		Root:       root,
+		Leaves:     leaves,
+	}, nil
+}
+
+func (s *storageImpl) FetchRollupForBatch(batchSeqNo uint64) (*common.RollupHeader, error) {
+	defer s.logDuration("FetchRollupForBatch", measure.NewStopwatch())
+	return enclavedb.FetchRollupForBatch(s.db.GetSQLDB(), batchSeqNo)
+}
+
+func (s *storageImpl) FetchRollupMetadata(hash common.L2RollupHash) (*common.PublicRollupMetadata, error) {
+	defer s.logDuration("FetchRollupMetadata", measure.NewStopwatch())
+	return enclavedb.FetchRollupMetadata(s.db.GetSQLDB(), hash)
+}
+
+func (s *storageImpl) FetchRollupMetadataForBatch(batchSeqNo uint64) (*common.PublicRollupMetadata, error) {
+	defer s.logDuration("FetchRollupMetadataForBatch", measure.NewStopwatch())
+	return enclavedb.FetchRollupMetadataForBatch(s.db.GetSQLDB(), batchSeqNo)
+}
+
+func (s *storageImpl) FetchLatestRollupMetadata() (*common.PublicRollupMetadata, error) {
+	defer s.logDuration("FetchLatestRollupMetadata", measure.NewStopwatch())
+	return enclavedb.FetchLatestRollupMetadata(s.db.GetSQLDB())
+}
+
+func (s *storageImpl) StoreMessageTransitions(transitions []*core.MessageTransition) error {
+	defer s.logDuration("StoreMessageTransitions", measure.NewStopwatch())
+	dbTx := s.db.NewDBTransaction()
+	enclavedb.WriteMessageTransitions(dbTx, transitions)
+	if err := dbTx.Write(); err != nil {
+		return fmt.Errorf("could not commit message transitions. Cause: %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchMessageTransitions(messageHash gethcommon.Hash) ([]*core.MessageTransition, error) {
+	defer s.logDuration("FetchMessageTransitions", measure.NewStopwatch())
+	return enclavedb.FetchMessageTransitions(s.db.GetSQLDB(), messageHash)
+}
+
+func (s *storageImpl) StoreRollupCompressionStats(stats *common.RollupCompressionStats) error {
+	defer s.logDuration("StoreRollupCompressionStats", measure.NewStopwatch())
+	dbTx := s.db.NewDBTransaction()
+	if err := enclavedb.WriteRollupCompressionStats(dbTx, stats); err != nil {
+		return err
+	}
+	if err := dbTx.Write(); err != nil {
+		return fmt.Errorf("could not commit rollup compression stats. Cause: %w", err)
+	}
+	return enclavedb.PruneRollupCompressionStats(s.db.GetSQLDB(), common.RollupCompressionStatsRetained)
+}
+
+func (s *storageImpl) FetchRollupCompressionStats() ([]*common.RollupCompressionStats, error) {
+	defer s.logDuration("FetchRollupCompressionStats", measure.NewStopwatch())
+	return enclavedb.FetchRollupCompressionStats(s.db.GetSQLDB())
+}
+
+func (s *storageImpl) StoreStateDivergence(report *common.StateDivergenceReport) error {
+	defer s.logDuration("StoreStateDivergence", measure.NewStopwatch())
+	dbTx := s.db.NewDBTransaction()
+	if err := enclavedb.WriteStateDivergence(dbTx, report); err != nil {
+		return err
+	}
+	if err := dbTx.Write(); err != nil {
+		return fmt.Errorf("could not commit state divergence report. Cause: %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchStateDivergence(batchHash common.L2BatchHash) (*common.StateDivergenceReport, error) {
+	defer s.logDuration("FetchStateDivergence", measure.NewStopwatch())
+	return enclavedb.FetchStateDivergence(s.db.GetSQLDB(), batchHash)
+}
+
+func (s *storageImpl) FetchStateDivergences() ([]*common.StateDivergenceReport, error) {
+	defer s.logDuration("FetchStateDivergences", measure.NewStopwatch())
+	return enclavedb.FetchStateDivergences(s.db.GetSQLDB())
+}
+
+func (s *storageImpl) StoreBatchTimeViolation(violation *common.BatchTimeViolation) error {
+	defer s.logDuration("StoreBatchTimeViolation", measure.NewStopwatch())
+	dbTx := s.db.NewDBTransaction()
+	if err := enclavedb.WriteBatchTimeViolation(dbTx, violation); err != nil {
+		return err
+	}
+	if err := dbTx.Write(); err != nil {
+		return fmt.Errorf("could not commit batch time violation. Cause: %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchBatchTimeViolation(batchHash common.L2BatchHash) (*common.BatchTimeViolation, error) {
+	defer s.logDuration("FetchBatchTimeViolation", measure.NewStopwatch())
+	return enclavedb.FetchBatchTimeViolation(s.db.GetSQLDB(), batchHash)
+}
+
+func (s *storageImpl) StoreRollupProcessing(processing *common.RollupProcessing, rollup *common.ExtRollup) error {
+	defer s.logDuration("StoreRollupProcessing", measure.NewStopwatch())
+	dbTx := s.db.NewDBTransaction()
+	if err := enclavedb.WriteRollupProcessing(dbTx, processing, rollup); err != nil {
+		return err
+	}
+	if err := dbTx.Write(); err != nil {
+		return fmt.Errorf("could not commit rollup processing. Cause: %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchRollupProcessing(rollupHash common.L2RollupHash) (*common.RollupProcessing, error) {
+	defer s.logDuration("FetchRollupProcessing", measure.NewStopwatch())
+	return enclavedb.FetchRollupProcessing(s.db.GetSQLDB(), rollupHash)
+}
+
+func (s *storageImpl) FetchUnprocessedRollups() ([]*common.RollupProcessing, error) {
+	defer s.logDuration("FetchUnprocessedRollups", measure.NewStopwatch())
+	return enclavedb.FetchUnprocessedRollups(s.db.GetSQLDB())
+}
+
+func (s *storageImpl) FetchUnprocessedRollup(rollupHash common.L2RollupHash) (*common.ExtRollup, error) {
+	defer s.logDuration("FetchUnprocessedRollup", measure.NewStopwatch())
+	return enclavedb.FetchUnprocessedRollup(s.db.GetSQLDB(), rollupHash)
+}
+
+func (s *storageImpl) StoreNetworkParametersUpdate(block *types.Block, update *common.NetworkParametersUpdate) error {
+	defer s.logDuration("StoreNetworkParametersUpdate", measure.NewStopwatch())
+	dbTx := s.db.NewDBTransaction()
+	if err := enclavedb.WriteNetworkParametersUpdate(dbTx, block.Header(), update); err != nil {
+		return err
+	}
+	if err := dbTx.Write(); err != nil {
+		return fmt.Errorf("could not commit network parameters update. Cause: %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchNetworkParametersUpdates(upToHeight uint64) ([]*common.NetworkParametersUpdate, error) {
+	defer s.logDuration("FetchNetworkParametersUpdates", measure.NewStopwatch())
+	return enclavedb.FetchNetworkParametersUpdates(s.db.GetSQLDB(), upToHeight)
+}
+
 func (s *storageImpl) FetchReorgedRollup(reorgedBlocks []common.L1BlockHash) (*common.L2BatchHash, error) {
 	return enclavedb.FetchReorgedRollup(s.db.GetSQLDB(), reorgedBlocks)
 }
@@ -535,6 +997,7 @@ func (s *storageImpl) DebugGetLogs(txHash common.TxHash) ([]*tracers.DebugLogs,
 }
 
 func (s *storageImpl) FilterLogs(
+	ctx context.Context,
 	requestingAccount *gethcommon.Address,
 	fromBlock, toBlock *big.Int,
 	blockHash *common.L2BatchHash,
@@ -542,7 +1005,7 @@ func (s *storageImpl) FilterLogs(
 	topics [][]gethcommon.Hash,
 ) ([]*types.Log, error) {
 	defer s.logDuration("FilterLogs", measure.NewStopwatch())
-	return enclavedb.FilterLogs(s.db.GetSQLDB(), requestingAccount, fromBlock, toBlock, blockHash, addresses, topics)
+	return enclavedb.FilterLogs(ctx, s.db.GetSQLDB(), requestingAccount, fromBlock, toBlock, blockHash, addresses, topics)
 }
 
 func (s *storageImpl) GetContractCount() (*big.Int, error) {
@@ -550,9 +1013,9 @@ func (s *storageImpl) GetContractCount() (*big.Int, error) {
 	return enclavedb.ReadContractCreationCount(s.db.GetSQLDB())
 }
 
-func (s *storageImpl) FetchCanonicalUnexecutedBatches(from *big.Int) ([]*core.Batch, error) {
+func (s *storageImpl) FetchCanonicalUnexecutedBatches(from *big.Int, limit uint64) ([]*core.Batch, error) {
 	defer s.logDuration("FetchCanonicalUnexecutedBatches", measure.NewStopwatch())
-	return enclavedb.ReadUnexecutedBatches(s.db.GetSQLDB(), from)
+	return enclavedb.ReadUnexecutedBatches(s.db.GetSQLDB(), from, limit)
 }
 
 func (s *storageImpl) BatchWasExecuted(hash common.L2BatchHash) (bool, error) {
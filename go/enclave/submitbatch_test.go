@@ -0,0 +1,171 @@
+package enclave
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/compression"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/nodetype"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+)
+
+var submitBatchTestScheme = common.SignatureScheme{ChainID: 443}
+
+// signatureCheckingValidator - only the signature of the received batches is verified
+type signatureCheckingValidator struct {
+	nodetype.ObsValidator
+	sigValidator *components.SignatureValidator
+}
+
+func (v *signatureCheckingValidator) VerifySequencerSignature(b *common.ExtBatch) error {
+	return v.sigValidator.CheckBatchSignature(b.Header)
+}
+
+func TestSubmitBatchErrorOrder(t *testing.T) {
+	enclave, sequencerKey := newSubmitBatchTestEnclave(t)
+	otherKey, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	valid := submitBatchTestBatch(t, enclave, 1, 0)
+
+	for name, tc := range map[string]struct {
+		seqNo    uint64
+		key      *ecdsa.PrivateKey
+		corrupt  bool
+		expected string
+	}{
+		"missing parent and bad signature": {seqNo: common.L2GenesisSeqNo + 2, key: otherKey, expected: "could not find previous batch"},
+		"bad signature and bad payload":    {seqNo: common.L2GenesisSeqNo, key: otherKey, corrupt: true, expected: "Could not verify signature"},
+		"bad payload":                      {seqNo: common.L2GenesisSeqNo, key: sequencerKey, corrupt: true, expected: "could not convert batch"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			header := *valid.Header
+			header.SequencerOrderNo = big.NewInt(int64(tc.seqNo))
+			extBatch := signedExtBatch(t, tc.key, &header, valid.EncryptedTxBlob)
+			if tc.corrupt {
+				extBatch.EncryptedTxBlob = []byte("not an encrypted payload")
+			}
+			// the signature check runs next to the conversion, so the error is checked more than once
+			for i := 0; i < 10; i++ {
+				_, err := enclave.SubmitBatch(context.Background(), extBatch)
+				require.ErrorContains(t, err, tc.expected)
+			}
+		})
+	}
+}
+
+// BenchmarkSubmitBatch - the preparation of the received batches, which runs outside the lock. Storing and executing
+// the batches is serialised, and is the same whether the preparation is pipelined or not.
+func BenchmarkSubmitBatch(b *testing.B) {
+	for _, txs := range []int{10, 100, 1000} {
+		enclave, sequencerKey := newSubmitBatchTestEnclave(b)
+		batch := submitBatchTestBatch(b, enclave, txs, 100)
+
+		// the converted headers are cached, so every iteration receives a new batch
+		received := func(b *testing.B) []*common.ExtBatch {
+			b.StopTimer()
+			defer b.StartTimer()
+			extBatches := make([]*common.ExtBatch, b.N)
+			for i := range extBatches {
+				header := *batch.Header
+				header.Time = uint64(i)
+				extBatches[i] = signedExtBatch(b, sequencerKey, &header, batch.EncryptedTxBlob)
+			}
+			return extBatches
+		}
+
+		b.Run(fmt.Sprintf("%d txs/sequential", txs), func(b *testing.B) {
+			for _, extBatch := range received(b) {
+				require.NoError(b, enclave.Validator().VerifySequencerSignature(extBatch))
+				_, err := core.ToBatch(extBatch, enclave.dataEncryptionService, enclave.dataCompressionService)
+				require.NoError(b, err)
+				_, err = enclave.gethEncodingService.CreateEthHeaderForBatch(extBatch.Header)
+				require.NoError(b, err)
+			}
+		})
+		b.Run(fmt.Sprintf("%d txs/pipelined", txs), func(b *testing.B) {
+			for _, extBatch := range received(b) {
+				_, _, err := enclave.verifyAndConvertBatch(extBatch)
+				require.Nil(b, err)
+			}
+		})
+	}
+}
+
+func newSubmitBatchTestEnclave(tb testing.TB) (*enclaveImpl, *ecdsa.PrivateKey) {
+	logger := gethlog.New()
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	require.NoError(tb, err)
+	storageDB := storage.NewStorage(backingDB, nil, logger)
+	require.NoError(tb, storageDB.StoreSecret(crypto.SharedEnclaveSecret{}))
+
+	sequencerKey, err := gethcrypto.GenerateKey()
+	require.NoError(tb, err)
+	sequencerID := gethcrypto.PubkeyToAddress(sequencerKey.PublicKey)
+	require.NoError(tb, storageDB.StoreAttestedKey(sequencerID, &sequencerKey.PublicKey))
+	sigValidator, err := components.NewSignatureValidator(sequencerID, submitBatchTestScheme, storageDB)
+	require.NoError(tb, err)
+
+	return &enclaveImpl{
+		storage:                storageDB,
+		dataEncryptionService:  crypto.NewDataEncryptionService(logger),
+		dataCompressionService: compression.NewBrotliDataCompressionService(),
+		gethEncodingService:    gethencoding.NewGethEncodingService(storageDB, logger),
+		service:                &signatureCheckingValidator{sigValidator: sigValidator},
+		tracer:                 tracing.Tracer(nil),
+		stopControl:            stopcontrol.New(),
+		logger:                 logger,
+	}, sequencerKey
+}
+
+// submitBatchTestBatch - a genesis batch with signed transactions, each carrying calldata of the given size
+func submitBatchTestBatch(tb testing.TB, enclave *enclaveImpl, txs int, calldata int) *common.ExtBatch {
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(tb, err)
+	signer := types.LatestSigner(params.AllEthashProtocolChanges)
+	transactions := make([]*common.L2Tx, txs)
+	for i := range transactions {
+		data := make([]byte, calldata)
+		_, err = rand.Read(data)
+		require.NoError(tb, err)
+		to := gethcommon.BigToAddress(big.NewInt(int64(i)))
+		transactions[i], err = types.SignNewTx(key, signer, &types.LegacyTx{Nonce: uint64(i), To: &to, Gas: 100_000, GasPrice: big.NewInt(1), Data: data})
+		require.NoError(tb, err)
+	}
+
+	batch := &core.Batch{
+		Header: &common.BatchHeader{
+			Number:           big.NewInt(int64(common.L2GenesisHeight)),
+			SequencerOrderNo: big.NewInt(int64(common.L2GenesisSeqNo)),
+		},
+		Transactions: transactions,
+	}
+	extBatch, err := batch.ToExtBatch(enclave.dataEncryptionService, enclave.dataCompressionService)
+	require.NoError(tb, err)
+	return extBatch
+}
+
+func signedExtBatch(tb testing.TB, key *ecdsa.PrivateKey, header *common.BatchHeader, txBlob []byte) *common.ExtBatch {
+	h := submitBatchTestScheme.BatchDigest(header)
+	var err error
+	header.R, header.S, err = ecdsa.Sign(rand.Reader, key, h[:])
+	require.NoError(tb, err)
+	return &common.ExtBatch{Header: header, EncryptedTxBlob: txBlob}
+}
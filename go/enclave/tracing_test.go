@@ -0,0 +1,75 @@
+package enclave
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/gas"
+	"github.com/ten-protocol/go-ten/go/enclave/nodetype"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// noopNodeType - the node service is not traced, so only OnL1Block is needed
+type noopNodeType struct {
+	nodetype.NodeType
+}
+
+func (n *noopNodeType) OnL1Block(context.Context, types.Block, *components.BlockIngestionType) error {
+	return nil
+}
+
+func TestSubmitL1BlockSpans(t *testing.T) {
+	logger := gethlog.New()
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	require.NoError(t, err)
+	storageDB := storage.NewStorage(backingDB, nil, logger)
+	mgmtContractLib := mgmtcontractlib.NewMgmtContractLib(&gethcommon.Address{}, logger)
+
+	recorder := tracetest.NewSpanRecorder()
+	enclave := &enclaveImpl{
+		config:                &config.EnclaveConfig{},
+		storage:               storageDB,
+		l1BlockProcessor:      components.NewBlockProcessor(storageDB, nil, mgmtContractLib, gas.NewGasOracle(common.NetworkParameters{BaseFee: big.NewInt(1), MinGasPrice: big.NewInt(1)}, storageDB, logger), nil, logger),
+		rollupConsumer:        components.NewRollupConsumer(mgmtContractLib, nil, nil, storageDB, logger, nil, nil),
+		sharedSecretProcessor: components.NewSharedSecretProcessor(mgmtContractLib, &components.DummyAttestationProvider{}, storageDB, 0, 0, logger),
+		service:               &noopNodeType{},
+		tracer:                sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test"),
+		stopControl:           stopcontrol.New(),
+		logger:                logger,
+	}
+
+	block := types.NewBlock(&types.Header{Number: big.NewInt(0)}, nil, nil, nil, trie.NewStackTrie(nil))
+	_, err = enclave.SubmitL1Block(context.Background(), *block, types.Receipts{}, nil, false)
+	require.NoError(t, err)
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	root := spans["SubmitL1Block"]
+	require.NotNil(t, root)
+	require.False(t, root.Parent().IsValid())
+	for child, parent := range map[string]string{
+		"l1BlockProcessor.Process":             "SubmitL1Block",
+		"storage.StoreBlock":                   "l1BlockProcessor.Process",
+		"rollupConsumer.ProcessRollupsInBlock": "SubmitL1Block",
+	} {
+		require.NotNil(t, spans[child], child)
+		require.Equal(t, spans[parent].SpanContext().SpanID(), spans[child].Parent().SpanID(), child)
+		require.Equal(t, root.SpanContext().TraceID(), spans[child].SpanContext().TraceID(), child)
+	}
+}
@@ -0,0 +1,196 @@
+package txpool
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtxpool "github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/log"
+)
+
+const (
+	defaultGlobalCap  = 6144
+	defaultAccountCap = 64
+	defaultTTL        = 3 * time.Hour
+	// the pool is reported unhealthy when it is almost full, since the cheapest transactions are being evicted by then
+	defaultHealthOccupancy = 95
+
+	// how often the pool is checked for expired transactions. Shorter TTLs are checked more often.
+	maxEvictionInterval = time.Minute
+)
+
+// ErrTxPoolFull - the transaction was refused because there is no room for it in the mempool.
+// It is the geth error, so it is recognised wherever geth reports the same condition.
+var ErrTxPoolFull = legacypool.ErrTxPoolOverflow
+
+// Limits - bound the number of transactions held in the mempool, so spam can't exhaust the enclave memory, and set the
+// thresholds at which the mempool is reported unhealthy. Zero values mean the defaults.
+type Limits struct {
+	// GlobalCap - the maximum number of transactions in the pool. When reached, the lowest paying ones are evicted to
+	// make room for better paying transactions.
+	GlobalCap uint64
+	// AccountCap - the maximum number of transactions a sender can have in the pool
+	AccountCap uint64
+	// TTL - transactions not included in a batch within this duration are dropped
+	TTL time.Duration
+	// HealthOccupancy - the pool is unhealthy when it holds more than this percentage of GlobalCap
+	HealthOccupancy uint64
+	// HealthAdmissionTimeout - the pool is unhealthy when it did not admit a transaction within this duration. Zero
+	// disables the check, which only makes sense on the sequencer, where the transactions of the network are admitted
+	HealthAdmissionTimeout time.Duration
+}
+
+func (l Limits) withDefaults() Limits {
+	if l.GlobalCap == 0 {
+		l.GlobalCap = defaultGlobalCap
+	}
+	if l.AccountCap == 0 {
+		l.AccountCap = defaultAccountCap
+	}
+	if l.TTL == 0 {
+		l.TTL = defaultTTL
+	}
+	if l.HealthOccupancy == 0 {
+		l.HealthOccupancy = defaultHealthOccupancy
+	}
+	return l
+}
+
+// apply - geth evicts the cheapest transactions once pending and queued together go over GlobalSlots+GlobalQueue,
+// so the global cap is split between the two the same way the geth defaults are.
+func (l Limits) apply(cfg *legacypool.Config) {
+	cfg.GlobalQueue = l.GlobalCap / 6
+	cfg.GlobalSlots = l.GlobalCap - cfg.GlobalQueue
+	cfg.AccountQueue = l.AccountCap
+	if cfg.AccountSlots > l.AccountCap {
+		cfg.AccountSlots = l.AccountCap
+	}
+	// geth only applies the lifetime to queued transactions, the pending ones are swept by the eviction loop
+	cfg.Lifetime = l.TTL
+}
+
+// Status - the occupancy of the pool, for monitoring
+func (t *TxPool) Status() common.TxPoolStatus {
+	status := common.TxPoolStatus{Capacity: t.limits.GlobalCap}
+	if !t.running.Load() {
+		return status
+	}
+
+	t.poolLock.RLock()
+	defer t.poolLock.RUnlock()
+	pending, queued := t.pool.Stats()
+	status.Pending = uint64(pending)
+	status.Queued = uint64(queued)
+	return status
+}
+
+// checkSenderCap - refuses a new transaction from a sender that already has AccountCap transactions in the pool.
+// Replacements (same nonce) are always allowed through, since they don't increase the footprint of the sender.
+// Must be called holding the pool lock.
+func (t *TxPool) checkSenderCap(tx *common.L2Tx) error {
+	sender, err := types.Sender(types.LatestSignerForChainID(t.Chain.Config().ChainID), tx)
+	if err != nil {
+		// the pool rejects it with the proper validation error
+		return nil //nolint: nilerr
+	}
+
+	pending, queued := t.pool.ContentFrom(sender)
+	for _, senderTxs := range [][]*types.Transaction{pending, queued} {
+		for _, senderTx := range senderTxs {
+			if senderTx.Nonce() == tx.Nonce() {
+				return nil
+			}
+		}
+	}
+	if uint64(len(pending)+len(queued)) >= t.limits.AccountCap {
+		return fmt.Errorf("%w - sender %s has reached the limit of %d transactions", ErrTxPoolFull, sender, t.limits.AccountCap)
+	}
+	return nil
+}
+
+func (t *TxPool) evictionLoop() {
+	interval := maxEvictionInterval
+	if t.limits.TTL < interval {
+		interval = t.limits.TTL
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := t.evictExpired(time.Now()); err != nil {
+				t.logger.Error("Could not evict expired mempool transactions", log.ErrKey, err)
+			}
+		case <-t.stopEviction:
+			return
+		}
+	}
+}
+
+// evictExpired - drops the transactions admitted before now-TTL.
+// Geth has no public API to remove a transaction, and its private one must be called holding the private lock of the
+// pool, so it can't be linked like the validation. The pool is rebuilt instead from the transactions which have not
+// expired, holding the pool lock so no transaction is added or read meanwhile. They keep their admission times, and the
+// pending ones stay pending, as they are re-added in nonce order.
+func (t *TxPool) evictExpired(now time.Time) error {
+	t.poolLock.Lock()
+	defer t.poolLock.Unlock()
+
+	t.admittedLock.Lock()
+	expired := map[gethcommon.Hash]struct{}{}
+	for hash, admittedAt := range t.admitted {
+		switch {
+		case !t.pool.Has(hash):
+			// included in a batch, replaced or evicted
+			delete(t.admitted, hash)
+		case now.Sub(admittedAt) > t.limits.TTL:
+			expired[hash] = struct{}{}
+			delete(t.admitted, hash)
+		}
+	}
+	t.admittedLock.Unlock()
+	if len(expired) == 0 {
+		return nil
+	}
+
+	var survivors []*types.Transaction
+	pending, queued := t.pool.Content()
+	for _, content := range []map[gethcommon.Address][]*types.Transaction{pending, queued} {
+		for _, senderTxs := range content {
+			for _, tx := range senderTxs {
+				if _, found := expired[tx.Hash()]; !found {
+					survivors = append(survivors, tx)
+				}
+			}
+		}
+	}
+	// re-adding in nonce order keeps the transactions of each sender executable
+	sort.SliceStable(survivors, func(i, j int) bool { return survivors[i].Nonce() < survivors[j].Nonce() })
+
+	// the old pool must stop listening for new heads before the new one subscribes, as they share the chain feed
+	if err := t.pool.Close(); err != nil {
+		return fmt.Errorf("could not close expired pool. Cause: %w", err)
+	}
+	legacyPool := legacypool.New(t.txPoolConfig, t.Chain)
+	memp, err := gethtxpool.New(t.gasTip, t.Chain, []gethtxpool.SubPool{legacyPool})
+	if err != nil {
+		return fmt.Errorf("unable to init geth tx pool - %w", err)
+	}
+	t.legacyPool = legacyPool
+	t.pool = memp
+
+	for i, err := range t.pool.Add(survivors, false, true) {
+		if err != nil {
+			t.logger.Debug("Dropping mempool transaction while evicting expired ones", log.TxKey, survivors[i].Hash(), log.ErrKey, err)
+		}
+	}
+	t.evictedTxs.Inc(int64(len(expired)))
+	t.logger.Info("Evicted expired mempool transactions", "evicted", len(expired), "kept", len(survivors))
+	return nil
+}
@@ -0,0 +1,33 @@
+package txpool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ten-protocol/go-ten/go/enclave/evm/ethchainadapter"
+)
+
+func TestLimitsBoundTheGethPool(t *testing.T) {
+	cfg := ethchainadapter.NewLegacyPoolConfig()
+	limits := Limits{GlobalCap: 600, AccountCap: 8, TTL: time.Minute}.withDefaults()
+	limits.apply(&cfg)
+
+	// geth starts evicting the cheapest txs once pending and queued go over the sum of the two
+	if cfg.GlobalSlots+cfg.GlobalQueue != 600 {
+		t.Fatalf("expected the geth pool to hold 600 txs, got %d", cfg.GlobalSlots+cfg.GlobalQueue)
+	}
+	if cfg.AccountSlots > 8 || cfg.AccountQueue != 8 {
+		t.Fatalf("expected the per account limits to be capped at 8, got %d and %d", cfg.AccountSlots, cfg.AccountQueue)
+	}
+	if cfg.Lifetime != time.Minute {
+		t.Fatalf("expected the queued txs lifetime to be the TTL, got %s", cfg.Lifetime)
+	}
+}
+
+func TestZeroLimitsUseTheDefaults(t *testing.T) {
+	limits := Limits{}.withDefaults()
+	if limits.GlobalCap != defaultGlobalCap || limits.AccountCap != defaultAccountCap || limits.TTL != defaultTTL ||
+		limits.HealthOccupancy != defaultHealthOccupancy || limits.HealthAdmissionTimeout != 0 {
+		t.Fatalf("unexpected limits %+v", limits)
+	}
+}
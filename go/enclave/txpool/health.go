@@ -0,0 +1,45 @@
+package txpool
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+// healthResponseTimeout - the pool is unresponsive when its occupancy can't be read within this duration, e.g. because
+// an eviction or the selection of the transactions of a batch holds the pool lock for too long
+const healthResponseTimeout = 5 * time.Second
+
+// HealthCheck - the pool is healthy when it is running, responsive, not close to its capacity and, if the admission
+// timeout is set, it admitted a transaction recently
+func (t *TxPool) HealthCheck() (bool, error) {
+	// the mempool is started once the chain has a head batch
+	if !t.running.Load() {
+		return false, fmt.Errorf("mempool not running")
+	}
+
+	statusCh := make(chan common.TxPoolStatus, 1)
+	go func() { statusCh <- t.Status() }()
+	var status common.TxPoolStatus
+	select {
+	case status = <-statusCh:
+	case <-time.After(healthResponseTimeout):
+		return false, fmt.Errorf("mempool did not respond within %s", healthResponseTimeout)
+	}
+
+	if occupancy := status.Pending + status.Queued; occupancy*100 > status.Capacity*t.limits.HealthOccupancy {
+		return false, fmt.Errorf("mempool holds %d transactions, more than %d%% of its capacity of %d", occupancy, t.limits.HealthOccupancy, status.Capacity)
+	}
+	if t.limits.HealthAdmissionTimeout > 0 {
+		if since := t.SinceLastAdmission(); since > t.limits.HealthAdmissionTimeout {
+			return false, fmt.Errorf("no transaction was admitted for %s", since.Round(time.Second))
+		}
+	}
+	return true, nil
+}
+
+// SinceLastAdmission - the time elapsed since the pool admitted a transaction, or since it was created
+func (t *TxPool) SinceLastAdmission() time.Duration {
+	return time.Since(t.lastAdmitted.LastTimestamp())
+}
@@ -0,0 +1,52 @@
+package txpool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolIsUnhealthyCloseToItsCapacity(t *testing.T) {
+	pool, keys := newTestPool(t, Limits{GlobalCap: 6, HealthOccupancy: 50}, 0, 4)
+	for _, key := range keys[:3] {
+		require.NoError(t, pool.Add(newPoolTx(t, pool, key, 0, testGasPrice)))
+	}
+	// half of the capacity is not more than the threshold
+	require.Eventually(t, func() bool { return pool.Status().Pending == 3 }, 5*time.Second, 10*time.Millisecond)
+	healthy, err := pool.HealthCheck()
+	require.NoError(t, err)
+	require.True(t, healthy)
+
+	require.NoError(t, pool.Add(newPoolTx(t, pool, keys[3], 0, testGasPrice)))
+	require.Eventually(t, func() bool { return pool.Status().Pending == 4 }, 5*time.Second, 10*time.Millisecond)
+	healthy, err = pool.HealthCheck()
+	require.ErrorContains(t, err, "more than 50% of its capacity of 6")
+	require.False(t, healthy)
+}
+
+func TestPoolIsUnhealthyWithoutRecentAdmissions(t *testing.T) {
+	pool, keys := newTestPool(t, Limits{HealthAdmissionTimeout: 100 * time.Millisecond}, 0, 1)
+	healthy, err := pool.HealthCheck()
+	require.NoError(t, err)
+	require.True(t, healthy)
+
+	time.Sleep(150 * time.Millisecond)
+	healthy, err = pool.HealthCheck()
+	require.ErrorContains(t, err, "no transaction was admitted")
+	require.False(t, healthy)
+
+	// an admission makes it healthy again
+	require.NoError(t, pool.Add(newPoolTx(t, pool, keys[0], 0, testGasPrice)))
+	healthy, err = pool.HealthCheck()
+	require.NoError(t, err)
+	require.True(t, healthy)
+}
+
+func TestClosedPoolIsUnhealthy(t *testing.T) {
+	pool, _ := newTestPool(t, Limits{}, 0, 0)
+	require.NoError(t, pool.Close())
+	healthy, err := pool.HealthCheck()
+	require.ErrorContains(t, err, "not running")
+	require.False(t, healthy)
+}
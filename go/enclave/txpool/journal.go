@@ -0,0 +1,119 @@
+package txpool
+
+import (
+	"fmt"
+	"sync"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+// journal - keeps a persisted copy of the transactions admitted in the pool, so they survive enclave restarts.
+// Transactions are removed once they are included in a batch or dropped from the pool (replaced, evicted, etc.).
+type journal struct {
+	storage storage.MempoolStorage
+	// the journal can't hold more transactions than the live pool
+	maxSize int
+	hashes  map[gethcommon.Hash]struct{}
+	mutex   sync.Mutex
+	logger  gethlog.Logger
+}
+
+func newJournal(mempoolStorage storage.MempoolStorage, maxSize int, logger gethlog.Logger) *journal {
+	return &journal{
+		storage: mempoolStorage,
+		maxSize: maxSize,
+		hashes:  map[gethcommon.Hash]struct{}{},
+		logger:  logger,
+	}
+}
+
+// store - persists a transaction that was admitted in the pool
+func (j *journal) store(tx *common.L2Tx, inPool func(gethcommon.Hash) bool) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if len(j.hashes) >= j.maxSize {
+		// make room by removing what the pool has dropped in the meantime
+		if err := j.removeLocked(nil, inPool); err != nil {
+			return err
+		}
+		if len(j.hashes) >= j.maxSize {
+			j.logger.Warn("Mempool journal is full. Transaction will not survive a restart.", log.TxKey, tx.Hash())
+			return nil
+		}
+	}
+
+	if err := j.storage.StoreMempoolTx(tx); err != nil {
+		return fmt.Errorf("could not persist mempool tx. Cause: %w", err)
+	}
+	j.hashes[tx.Hash()] = struct{}{}
+	return nil
+}
+
+// prune - removes the transactions included in a batch and the ones no longer in the pool
+func (j *journal) prune(included common.L2Transactions, inPool func(gethcommon.Hash) bool) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	return j.removeLocked(included, inPool)
+}
+
+func (j *journal) removeLocked(included common.L2Transactions, inPool func(gethcommon.Hash) bool) error {
+	var toRemove []common.L2TxHash
+	for _, tx := range included {
+		if _, found := j.hashes[tx.Hash()]; found {
+			toRemove = append(toRemove, tx.Hash())
+		}
+	}
+	for hash := range j.hashes {
+		if !inPool(hash) {
+			toRemove = append(toRemove, hash)
+		}
+	}
+	if len(toRemove) == 0 {
+		return nil
+	}
+
+	if err := j.storage.DeleteMempoolTxs(toRemove); err != nil {
+		return fmt.Errorf("could not delete mempool txs. Cause: %w", err)
+	}
+	for _, hash := range toRemove {
+		delete(j.hashes, hash)
+	}
+	return nil
+}
+
+// reload - re-admits the persisted transactions which are still valid against the current state.
+// The ones that are no longer valid (already included, nonce too low, insufficient funds, etc.) are discarded.
+func (j *journal) reload(admit func(*common.L2Tx) error) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	txs, err := j.storage.FetchMempoolTxs()
+	if err != nil {
+		return fmt.Errorf("could not fetch mempool txs. Cause: %w", err)
+	}
+
+	var discarded []common.L2TxHash
+	for _, tx := range txs {
+		if len(j.hashes) >= j.maxSize {
+			discarded = append(discarded, tx.Hash())
+			continue
+		}
+		if err := admit(tx); err != nil {
+			j.logger.Debug("Discarding persisted mempool tx", log.TxKey, tx.Hash(), log.ErrKey, err)
+			discarded = append(discarded, tx.Hash())
+			continue
+		}
+		j.hashes[tx.Hash()] = struct{}{}
+	}
+
+	if err := j.storage.DeleteMempoolTxs(discarded); err != nil {
+		return fmt.Errorf("could not delete discarded mempool txs. Cause: %w", err)
+	}
+	j.logger.Info("Reloaded persisted mempool", "reloaded", len(j.hashes), "discarded", len(discarded))
+	return nil
+}
@@ -0,0 +1,108 @@
+package txpool
+
+import (
+	"errors"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+type inMemoryMempoolStorage struct {
+	txs map[gethcommon.Hash]*common.L2Tx
+}
+
+func (s *inMemoryMempoolStorage) StoreMempoolTx(tx *common.L2Tx) error {
+	s.txs[tx.Hash()] = tx
+	return nil
+}
+
+func (s *inMemoryMempoolStorage) DeleteMempoolTxs(hashes []common.L2TxHash) error {
+	for _, hash := range hashes {
+		delete(s.txs, hash)
+	}
+	return nil
+}
+
+func (s *inMemoryMempoolStorage) FetchMempoolTxs() ([]*common.L2Tx, error) {
+	txs := make([]*common.L2Tx, 0, len(s.txs))
+	for _, tx := range s.txs {
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
+func newTestJournal(maxSize int) (*journal, *inMemoryMempoolStorage) {
+	mempoolStorage := &inMemoryMempoolStorage{txs: map[gethcommon.Hash]*common.L2Tx{}}
+	return newJournal(mempoolStorage, maxSize, gethlog.New()), mempoolStorage
+}
+
+func testTx(nonce uint64) *common.L2Tx {
+	return types.NewTx(&types.LegacyTx{Nonce: nonce, Gas: 21_000})
+}
+
+func TestJournalPrunesIncludedAndDroppedTxs(t *testing.T) {
+	j, mempoolStorage := newTestJournal(10)
+	included, dropped, pending := testTx(0), testTx(1), testTx(2)
+	inPool := map[gethcommon.Hash]bool{included.Hash(): true, dropped.Hash(): true, pending.Hash(): true}
+	has := func(hash gethcommon.Hash) bool { return inPool[hash] }
+
+	for _, tx := range []*common.L2Tx{included, dropped, pending} {
+		if err := j.store(tx, has); err != nil {
+			t.Fatalf("could not store tx: %s", err)
+		}
+	}
+
+	// the pool removes included txs asynchronously, so they are still reported as present
+	delete(inPool, dropped.Hash())
+	if err := j.prune(common.L2Transactions{included}, has); err != nil {
+		t.Fatalf("could not prune journal: %s", err)
+	}
+
+	if len(mempoolStorage.txs) != 1 || mempoolStorage.txs[pending.Hash()] == nil {
+		t.Fatalf("expected only the pending tx to remain persisted, got %d txs", len(mempoolStorage.txs))
+	}
+}
+
+func TestJournalIsBoundedByPoolSize(t *testing.T) {
+	j, mempoolStorage := newTestJournal(2)
+	has := func(gethcommon.Hash) bool { return true }
+
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		if err := j.store(testTx(nonce), has); err != nil {
+			t.Fatalf("could not store tx: %s", err)
+		}
+	}
+
+	if len(mempoolStorage.txs) != 2 {
+		t.Fatalf("expected the journal to hold 2 txs, got %d", len(mempoolStorage.txs))
+	}
+}
+
+func TestJournalReloadDiscardsInvalidTxs(t *testing.T) {
+	j, mempoolStorage := newTestJournal(10)
+	valid, stale := testTx(5), testTx(1)
+	mempoolStorage.txs[valid.Hash()] = valid
+	mempoolStorage.txs[stale.Hash()] = stale
+
+	var admitted []*common.L2Tx
+	err := j.reload(func(tx *common.L2Tx) error {
+		if tx.Nonce() < 5 {
+			return errors.New("nonce too low")
+		}
+		admitted = append(admitted, tx)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("could not reload journal: %s", err)
+	}
+
+	if len(admitted) != 1 || admitted[0].Hash() != valid.Hash() {
+		t.Fatalf("expected only the valid tx to be re-admitted")
+	}
+	if len(mempoolStorage.txs) != 1 || mempoolStorage.txs[valid.Hash()] == nil {
+		t.Fatalf("expected the stale tx to be deleted from storage")
+	}
+}
@@ -0,0 +1,86 @@
+package txpool
+
+import (
+	"sync"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+// inclusionLedgerBatches - how many of the most recent batches the ledger remembers. A transaction included before
+// them is rejected anyway, as its nonce is already used in the state of the head batch.
+const inclusionLedgerBatches = 128
+
+// inclusionLedger - the transactions included in the most recent batches of the sequencer. The pool forgets a
+// transaction once it was included in a batch, so without the ledger a copy of it received later (e.g. forwarded by a
+// validator, or resubmitted by a host after a timeout) could be admitted again while the batch isn't yet the head of
+// the chain the pool validates against.
+type inclusionLedger struct {
+	batches    map[uint64][]gethcommon.Hash // the transactions of each recorded batch, by sequence number
+	included   map[gethcommon.Hash]uint64   // the sequence number of the batch each transaction was included in
+	order      []uint64                     // the sequence numbers of the recorded batches, oldest first
+	maxBatches int
+	lock       sync.Mutex
+}
+
+func newInclusionLedger(maxBatches int) *inclusionLedger {
+	return &inclusionLedger{
+		batches:    map[uint64][]gethcommon.Hash{},
+		included:   map[gethcommon.Hash]uint64{},
+		maxBatches: maxBatches,
+	}
+}
+
+// record - the transactions were included in the batch. The oldest batch is forgotten once there are too many.
+func (l *inclusionLedger) record(seqNo uint64, txs common.L2Transactions) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.forget(seqNo)
+	hashes := make([]gethcommon.Hash, len(txs))
+	for i, tx := range txs {
+		hashes[i] = tx.Hash()
+		l.included[hashes[i]] = seqNo
+	}
+	l.batches[seqNo] = hashes
+	l.order = append(l.order, seqNo)
+
+	for len(l.order) > l.maxBatches {
+		l.forget(l.order[0])
+	}
+}
+
+// revert - the batch is no longer canonical, so its transactions are no longer included
+func (l *inclusionLedger) revert(seqNo uint64) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.forget(seqNo)
+}
+
+// includedIn - the sequence number of the recorded batch which included the transaction
+func (l *inclusionLedger) includedIn(hash gethcommon.Hash) (uint64, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	seqNo, found := l.included[hash]
+	return seqNo, found
+}
+
+func (l *inclusionLedger) forget(seqNo uint64) {
+	hashes, found := l.batches[seqNo]
+	if !found {
+		return
+	}
+	for _, hash := range hashes {
+		// the transaction might have been included again since, by the duplicate of a reverted batch
+		if l.included[hash] == seqNo {
+			delete(l.included, hash)
+		}
+	}
+	delete(l.batches, seqNo)
+	for i, recorded := range l.order {
+		if recorded == seqNo {
+			l.order = append(l.order[:i], l.order[i+1:]...)
+			break
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+func TestLedgerForgetsTheOldestBatches(t *testing.T) {
+	l := newInclusionLedger(2)
+	first, second, third := testTx(0), testTx(1), testTx(2)
+	l.record(1, common.L2Transactions{first})
+	l.record(2, common.L2Transactions{second})
+	l.record(3, common.L2Transactions{third})
+
+	if _, found := l.includedIn(first.Hash()); found {
+		t.Fatal("expected the transaction of the oldest batch to be forgotten")
+	}
+	for seqNo, tx := range map[uint64]*common.L2Tx{2: second, 3: third} {
+		if includedIn, found := l.includedIn(tx.Hash()); !found || includedIn != seqNo {
+			t.Fatalf("expected the transaction to be included in batch %d, got %d", seqNo, includedIn)
+		}
+	}
+}
+
+func TestLedgerRevertsForkedBatches(t *testing.T) {
+	l := newInclusionLedger(10)
+	tx, dropped := testTx(0), testTx(1)
+	l.record(1, common.L2Transactions{tx, dropped})
+
+	// the duplicate of the forked batch includes the transaction again, before the batch is reverted
+	l.record(2, common.L2Transactions{tx})
+	l.revert(1)
+
+	if includedIn, found := l.includedIn(tx.Hash()); !found || includedIn != 2 {
+		t.Fatalf("expected the transaction to be included in the duplicate batch, got %d", includedIn)
+	}
+	if _, found := l.includedIn(dropped.Hash()); found {
+		t.Fatal("expected the transaction left out of the duplicate batch to be forgotten")
+	}
+}
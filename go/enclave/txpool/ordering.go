@@ -0,0 +1,116 @@
+package txpool
+
+import (
+	"bytes"
+	"container/heap"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtxpool "github.com/ethereum/go-ethereum/core/txpool"
+)
+
+// txWithTip - a pending transaction together with the effective tip it pays at the batch base fee
+type txWithTip struct {
+	from gethcommon.Address
+	tx   *gethtxpool.LazyTransaction
+	tip  *big.Int
+}
+
+// txByPriceAndSender implements heap.Interface. Transactions paying a higher tip come first.
+// Ties are broken by the sender address so the ordering is deterministic for a given mempool snapshot
+// (geth uses the time the tx was first seen, which differs between nodes and runs).
+type txByPriceAndSender []*txWithTip
+
+func (s txByPriceAndSender) Len() int { return len(s) }
+func (s txByPriceAndSender) Less(i, j int) bool {
+	cmp := s[i].tip.Cmp(s[j].tip)
+	if cmp == 0 {
+		return bytes.Compare(s[i].from.Bytes(), s[j].from.Bytes()) < 0
+	}
+	return cmp > 0
+}
+func (s txByPriceAndSender) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (s *txByPriceAndSender) Push(x interface{}) {
+	*s = append(*s, x.(*txWithTip))
+}
+
+func (s *txByPriceAndSender) Pop() interface{} {
+	old := *s
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*s = old[0 : n-1]
+	return x
+}
+
+// TransactionsByPriceAndNonce - the set of pending transactions that can return transactions in a profit-maximizing
+// sorted order, while supporting removing entire batches of transactions for non-executable accounts.
+// It is the equivalent of the geth miner ordering, with a deterministic tie-break.
+type TransactionsByPriceAndNonce struct {
+	txs     map[gethcommon.Address][]*gethtxpool.LazyTransaction // per account nonce-sorted list of transactions
+	heads   txByPriceAndSender                                   // next transaction for each unique account (price heap)
+	baseFee *big.Int
+}
+
+// NewTransactionsByPriceAndNonce creates a transaction set that can retrieve price sorted transactions in a
+// nonce-honouring way.
+// Note - the input map is reowned so the caller should not interact any more with it after providing it.
+func NewTransactionsByPriceAndNonce(txs map[gethcommon.Address][]*gethtxpool.LazyTransaction, baseFee *big.Int) *TransactionsByPriceAndNonce {
+	heads := make(txByPriceAndSender, 0, len(txs))
+	for from, accTxs := range txs {
+		if len(accTxs) == 0 {
+			delete(txs, from)
+			continue
+		}
+		heads = append(heads, &txWithTip{from: from, tx: accTxs[0], tip: effectiveTip(accTxs[0], baseFee)})
+		txs[from] = accTxs[1:]
+	}
+	heap.Init(&heads)
+
+	return &TransactionsByPriceAndNonce{
+		txs:     txs,
+		heads:   heads,
+		baseFee: baseFee,
+	}
+}
+
+// Peek returns the next transaction by price, or nil when there are no more transactions.
+func (t *TransactionsByPriceAndNonce) Peek() *gethtxpool.LazyTransaction {
+	if len(t.heads) == 0 {
+		return nil
+	}
+	return t.heads[0].tx
+}
+
+// Shift replaces the current best head with the next one from the same account.
+func (t *TransactionsByPriceAndNonce) Shift() {
+	from := t.heads[0].from
+	if txs, ok := t.txs[from]; ok && len(txs) > 0 {
+		t.heads[0] = &txWithTip{from: from, tx: txs[0], tip: effectiveTip(txs[0], t.baseFee)}
+		t.txs[from] = txs[1:]
+		heap.Fix(&t.heads, 0)
+		return
+	}
+	heap.Pop(&t.heads)
+}
+
+// Pop removes the best transaction, *not* replacing it with the next one from the same account.
+// This should be used when a transaction cannot be executed and hence all subsequent ones should be
+// discarded from the same account.
+func (t *TransactionsByPriceAndNonce) Pop() {
+	heap.Pop(&t.heads)
+}
+
+// effectiveTip - the miner tip the transaction pays given the base fee. Transactions that cannot cover the base fee
+// have a negative tip and end up at the back of the queue.
+func effectiveTip(tx *gethtxpool.LazyTransaction, baseFee *big.Int) *big.Int {
+	if baseFee == nil {
+		return new(big.Int).Set(tx.GasTipCap)
+	}
+	tip := new(big.Int).Sub(tx.GasFeeCap, baseFee)
+	if tip.Cmp(tx.GasTipCap) > 0 {
+		tip.Set(tx.GasTipCap)
+	}
+	return tip
+}
@@ -0,0 +1,106 @@
+package txpool
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtxpool "github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var testBaseFee = big.NewInt(10)
+
+func lazyTx(nonce uint64, feeCap int64, tipCap int64) *gethtxpool.LazyTransaction {
+	tx := types.NewTx(&types.DynamicFeeTx{
+		Nonce:     nonce,
+		GasFeeCap: big.NewInt(feeCap),
+		GasTipCap: big.NewInt(tipCap),
+		Gas:       21_000,
+	})
+	return &gethtxpool.LazyTransaction{
+		Hash:      tx.Hash(),
+		Tx:        tx,
+		GasFeeCap: tx.GasFeeCap(),
+		GasTipCap: tx.GasTipCap(),
+	}
+}
+
+func drain(ordered *TransactionsByPriceAndNonce) []*gethtxpool.LazyTransaction {
+	var result []*gethtxpool.LazyTransaction
+	for tx := ordered.Peek(); tx != nil; tx = ordered.Peek() {
+		result = append(result, tx)
+		ordered.Shift()
+	}
+	return result
+}
+
+func TestOrderingRespectsTipAndNonce(t *testing.T) {
+	alice := gethcommon.HexToAddress("0x1")
+	bob := gethcommon.HexToAddress("0x2")
+
+	// alice's second tx pays the most, but must still come after her first one
+	a0, a1 := lazyTx(0, 12, 1), lazyTx(1, 100, 50)
+	b0, b1 := lazyTx(0, 15, 5), lazyTx(1, 13, 3)
+
+	ordered := NewTransactionsByPriceAndNonce(map[gethcommon.Address][]*gethtxpool.LazyTransaction{
+		alice: {a0, a1},
+		bob:   {b0, b1},
+	}, testBaseFee)
+
+	expected := []*gethtxpool.LazyTransaction{b0, b1, a0, a1}
+	result := drain(ordered)
+	if len(result) != len(expected) {
+		t.Fatalf("expected %d txs, got %d", len(expected), len(result))
+	}
+	for i := range expected {
+		if result[i].Hash != expected[i].Hash {
+			t.Errorf("unexpected tx at position %d", i)
+		}
+	}
+}
+
+func TestOrderingIsDeterministicForEqualTips(t *testing.T) {
+	senders := []gethcommon.Address{
+		gethcommon.HexToAddress("0x3"),
+		gethcommon.HexToAddress("0x1"),
+		gethcommon.HexToAddress("0x2"),
+	}
+
+	for run := 0; run < 10; run++ {
+		pending := map[gethcommon.Address][]*gethtxpool.LazyTransaction{}
+		for _, sender := range senders {
+			pending[sender] = []*gethtxpool.LazyTransaction{lazyTx(0, 20, 5)}
+		}
+		ordered := NewTransactionsByPriceAndNonce(pending, testBaseFee)
+
+		// equal tips are ordered by the sender address
+		var got []gethcommon.Address
+		for ordered.Peek() != nil {
+			got = append(got, ordered.heads[0].from)
+			ordered.Shift()
+		}
+		for i, sender := range []gethcommon.Address{senders[1], senders[2], senders[0]} {
+			if got[i] != sender {
+				t.Fatalf("run %d: unexpected sender at position %d", run, i)
+			}
+		}
+	}
+}
+
+func TestPopDropsRemainingSenderTxs(t *testing.T) {
+	alice := gethcommon.HexToAddress("0x1")
+	bob := gethcommon.HexToAddress("0x2")
+	b0 := lazyTx(0, 11, 1)
+
+	ordered := NewTransactionsByPriceAndNonce(map[gethcommon.Address][]*gethtxpool.LazyTransaction{
+		alice: {lazyTx(0, 30, 10), lazyTx(1, 30, 10)},
+		bob:   {b0},
+	}, testBaseFee)
+
+	ordered.Pop()
+	result := drain(ordered)
+	if len(result) != 1 || result[0].Hash != b0.Hash {
+		t.Fatalf("expected only bob's transaction after popping alice")
+	}
+}
@@ -1,15 +1,19 @@
 package txpool
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
-	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	// unsafe package imported in order to link to a private function in go-ethereum.
 	// This allows us to validate transactions against the tx pool rules.
 	_ "unsafe"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
 	"github.com/ten-protocol/go-ten/go/common/log"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -17,7 +21,10 @@ import (
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/async"
 	"github.com/ten-protocol/go-ten/go/enclave/evm/ethchainadapter"
+	"github.com/ten-protocol/go-ten/go/enclave/metrics"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
 )
 
 // TxPool is an obscuro wrapper around geths transaction pool
@@ -25,24 +32,58 @@ type TxPool struct {
 	txPoolConfig legacypool.Config
 	legacyPool   *legacypool.LegacyPool
 	pool         *gethtxpool.TxPool
+	poolLock     sync.RWMutex // the pools are replaced when expired transactions are evicted
 	Chain        *ethchainadapter.EthChainAdapter
 	gasTip       *big.Int
-	running      bool
+	limits       Limits
+	journal      *journal
+	ledger       *inclusionLedger
+	admitted     map[gethcommon.Hash]time.Time // when each transaction was added, to enforce the TTL
+	admittedLock sync.Mutex
+	lastAdmitted *async.Timestamp // when the last transaction was added, for the health check
+	stopEviction chan struct{}
+	running      atomic.Bool // read by the requests while the pool is started and closed
 	logger       gethlog.Logger
+
+	addedTxs    gethmetrics.Counter
+	rejectedTxs gethmetrics.Counter
+	evictedTxs  gethmetrics.Counter
 }
 
 // NewTxPool returns a new instance of the tx pool
-func NewTxPool(blockchain *ethchainadapter.EthChainAdapter, gasTip *big.Int, logger gethlog.Logger) (*TxPool, error) {
+// priceBump is the minimum percentage a transaction must pay on top of an already pending one (same sender and nonce)
+// in order to replace it. Zero means the geth default (10%).
+// The limits bound the memory used by the pool (see Limits).
+// The admitted transactions are persisted in the mempoolStorage and reloaded when the pool is started.
+// The depth of the pool is sampled when the metrics are exported.
+func NewTxPool(blockchain *ethchainadapter.EthChainAdapter, gasTip *big.Int, priceBump uint64, limits Limits, mempoolStorage storage.MempoolStorage, registry *metrics.Registry, logger gethlog.Logger) (*TxPool, error) {
 	txPoolConfig := ethchainadapter.NewLegacyPoolConfig()
+	if priceBump > 0 {
+		txPoolConfig.PriceBump = priceBump
+	}
+	limits = limits.withDefaults()
+	limits.apply(&txPoolConfig)
 	legacyPool := legacypool.New(txPoolConfig, blockchain)
 
-	return &TxPool{
+	pool := &TxPool{
 		Chain:        blockchain,
 		txPoolConfig: txPoolConfig,
 		legacyPool:   legacyPool,
 		gasTip:       gasTip,
+		limits:       limits,
+		journal:      newJournal(mempoolStorage, int(limits.GlobalCap), logger),
+		ledger:       newInclusionLedger(inclusionLedgerBatches),
+		admitted:     map[gethcommon.Hash]time.Time{},
+		lastAdmitted: async.NewAsyncTimestamp(time.Now()),
+		stopEviction: make(chan struct{}),
 		logger:       logger,
-	}, nil
+		addedTxs:     registry.Counter("enclave/txpool/added"),
+		rejectedTxs:  registry.Counter("enclave/txpool/rejected"),
+		evictedTxs:   registry.Counter("enclave/txpool/evicted"),
+	}
+	registry.GaugeFunc("enclave/txpool/pending", func() int64 { return int64(pool.Status().Pending) })
+	registry.GaugeFunc("enclave/txpool/queued", func() int64 { return int64(pool.Status().Queued) })
+	return pool, nil
 }
 
 // Start starts the pool
@@ -58,30 +99,119 @@ func (t *TxPool) Start() error {
 	}
 
 	t.pool = memp
-	t.running = true
+	t.running.Store(true)
+
+	// the chain head is available at this point, so the persisted transactions can be validated against it
+	err = t.journal.reload(func(tx *common.L2Tx) error {
+		if err := t.Validate(tx); err != nil {
+			return err
+		}
+		return t.add(tx)
+	})
+	if err != nil {
+		// not fatal, the pool starts empty as if nothing was persisted
+		t.logger.Error("Could not reload the persisted mempool", log.ErrKey, err)
+	}
+
+	go t.evictionLoop()
 	return nil
 }
 
 // PendingTransactions returns all pending transactions grouped per address and ordered per nonce
 func (t *TxPool) PendingTransactions() map[gethcommon.Address][]*gethtxpool.LazyTransaction {
+	t.poolLock.RLock()
+	defer t.poolLock.RUnlock()
 	return t.pool.Pending(false)
 }
 
 // Add adds a new transactions to the pool
+// A transaction with the same sender and nonce as a pending one replaces it if it pays at least the configured price
+// bump, otherwise gethtxpool.ErrReplaceUnderpriced is returned. The replaced transaction is dropped from the pool.
+// ErrTxPoolFull is returned when the transaction is refused because the pool, or the allowance of the sender, is full.
+// gethtxpool.ErrAlreadyKnown is returned for a transaction in the pool, or included in one of the recent batches.
 func (t *TxPool) Add(transaction *common.L2Tx) error {
-	var strErrors []string
-	for _, err := range t.pool.Add([]*types.Transaction{transaction}, false, false) {
-		if err != nil {
-			strErrors = append(strErrors, err.Error())
-		}
+	if seqNo, included := t.ledger.includedIn(transaction.Hash()); included {
+		t.rejectedTxs.Inc(1)
+		return fmt.Errorf("%w - the transaction was included in batch %d", gethtxpool.ErrAlreadyKnown, seqNo)
+	}
+	if err := t.add(transaction); err != nil {
+		t.rejectedTxs.Inc(1)
+		return err
+	}
+	t.addedTxs.Inc(1)
+
+	if err := t.journal.store(transaction, t.has); err != nil {
+		// the transaction is in the pool, it just won't survive a restart
+		t.logger.Error("Could not persist mempool transaction", log.TxKey, transaction.Hash(), log.ErrKey, err)
+	}
+	return nil
+}
+
+// PruneJournal - removes the persisted transactions which were included in a batch or dropped from the pool
+func (t *TxPool) PruneJournal(included common.L2Transactions) error {
+	if !t.running.Load() {
+		return nil
+	}
+	return t.journal.prune(included, t.has)
+}
+
+// RecordInclusion - the transactions were included in the batch, so they are rejected if received again
+func (t *TxPool) RecordInclusion(seqNo uint64, included common.L2Transactions) {
+	t.ledger.record(seqNo, included)
+}
+
+// RevertInclusion - the batch was not stored, or is no longer canonical, so its transactions can be received again
+func (t *TxPool) RevertInclusion(seqNo uint64) {
+	t.ledger.revert(seqNo)
+}
+
+func (t *TxPool) add(transaction *common.L2Tx) error {
+	t.poolLock.RLock()
+	defer t.poolLock.RUnlock()
+
+	if err := t.checkSenderCap(transaction); err != nil {
+		return err
 	}
 
-	if len(strErrors) > 0 {
-		return fmt.Errorf(strings.Join(strErrors, "; "))
+	// the errors are joined rather than flattened, so callers can still check for the standard geth errors
+	errs := t.pool.Add([]*types.Transaction{transaction}, false, false)
+	for i, err := range errs {
+		// geth returns the bare error when the pool is full and the tx pays less than everything in it
+		if err == gethtxpool.ErrUnderpriced { //nolint: errorlint
+			errs[i] = fmt.Errorf("%w - the transaction pays less than the cheapest one in the pool", ErrTxPoolFull)
+		}
+		// the legacy pool declares its own copy of the error, with the same text
+		if err == legacypool.ErrAlreadyKnown { //nolint: errorlint
+			errs[i] = gethtxpool.ErrAlreadyKnown
+		}
 	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	t.admittedLock.Lock()
+	t.admitted[transaction.Hash()] = time.Now()
+	t.admittedLock.Unlock()
+	t.lastAdmitted.Mark()
 	return nil
 }
 
+// Get returns the transaction of the pool with the given hash, or nil if the pool doesn't have it
+func (t *TxPool) Get(hash gethcommon.Hash) *common.L2Tx {
+	if !t.running.Load() {
+		return nil
+	}
+	t.poolLock.RLock()
+	defer t.poolLock.RUnlock()
+	return t.pool.Get(hash)
+}
+
+func (t *TxPool) has(hash gethcommon.Hash) bool {
+	t.poolLock.RLock()
+	defer t.poolLock.RUnlock()
+	return t.pool.Has(hash)
+}
+
 //go:linkname validateTxBasics github.com/ethereum/go-ethereum/core/txpool/legacypool.(*LegacyPool).validateTxBasics
 func validateTxBasics(_ *legacypool.LegacyPool, _ *types.Transaction, _ bool) error
 
@@ -90,6 +220,9 @@ func validateTx(_ *legacypool.LegacyPool, _ *types.Transaction, _ bool) error
 
 // Validate - run the underlying tx pool validation logic
 func (t *TxPool) Validate(tx *common.L2Tx) error {
+	t.poolLock.RLock()
+	defer t.poolLock.RUnlock()
+
 	// validate against the consensus rules
 	err := validateTxBasics(t.legacyPool, tx, false)
 	if err != nil {
@@ -100,15 +233,45 @@ func (t *TxPool) Validate(tx *common.L2Tx) error {
 	return validateTx(t.legacyPool, tx, false)
 }
 
+// SetMinGasPrice - changes the minimum gas price of the transactions accepted by the pool. The pending transactions
+// paying less are dropped.
+func (t *TxPool) SetMinGasPrice(price *big.Int) {
+	t.poolLock.Lock()
+	defer t.poolLock.Unlock()
+	if price == nil || t.gasTip.Cmp(price) == 0 {
+		return
+	}
+	t.logger.Info("Changing the min gas price of the mempool", "from", t.gasTip, "to", price)
+	t.gasTip = new(big.Int).Set(price)
+	if t.pool != nil {
+		t.pool.SetGasTip(t.gasTip)
+	}
+}
+
 func (t *TxPool) Running() bool {
-	return t.running
+	return t.running.Load()
 }
 
 func (t *TxPool) Close() error {
+	started := t.running.CompareAndSwap(true, false)
+	if started {
+		close(t.stopEviction)
+	}
+	t.poolLock.Lock()
+	defer t.poolLock.Unlock()
 	defer func() {
 		if err := recover(); err != nil {
 			t.logger.Error("Could not close legacy pool", log.ErrKey, err)
 		}
 	}()
-	return t.legacyPool.Close()
+	switch {
+	case started:
+		// stops the loop of the geth pool, which closes the legacy pool
+		return t.pool.Close()
+	case t.pool != nil:
+		// already closed
+		return nil
+	default:
+		return t.legacyPool.Close()
+	}
 }
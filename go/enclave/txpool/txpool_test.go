@@ -0,0 +1,200 @@
+package txpool
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtxpool "github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/evm/ethchainadapter"
+	"github.com/ten-protocol/go-ten/go/enclave/genesis"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+)
+
+const testGasPrice = 1_000_000_000
+
+func TestExpiredTxsAreEvictedAndTheOthersSurvive(t *testing.T) {
+	pool, keys := newTestPool(t, Limits{TTL: time.Hour}, 0, 2)
+	expired := newPoolTx(t, pool, keys[0], 0, testGasPrice)
+	kept := []*common.L2Tx{newPoolTx(t, pool, keys[1], 0, testGasPrice), newPoolTx(t, pool, keys[1], 1, testGasPrice)}
+	for _, tx := range append([]*common.L2Tx{expired}, kept...) {
+		require.NoError(t, pool.Add(tx))
+	}
+	requirePending(t, pool, expired, kept[0], kept[1])
+
+	// only the first transaction was admitted more than a TTL ago
+	pool.admittedLock.Lock()
+	pool.admitted[expired.Hash()] = time.Now().Add(-2 * time.Hour)
+	pool.admittedLock.Unlock()
+	require.NoError(t, pool.evictExpired(time.Now()))
+
+	require.Nil(t, pool.Get(expired.Hash()))
+	requirePending(t, pool, kept[0], kept[1])
+	require.Equal(t, uint64(2), pool.Status().Pending)
+
+	// the rebuilt pool keeps admitting the transactions, and still knows when the survivors were admitted
+	next := newPoolTx(t, pool, keys[1], 2, testGasPrice)
+	require.NoError(t, pool.Add(next))
+	requirePending(t, pool, kept[0], kept[1], next)
+	require.NoError(t, pool.evictExpired(time.Now().Add(59*time.Minute)))
+	requirePending(t, pool, kept[0], kept[1], next)
+	require.NoError(t, pool.evictExpired(time.Now().Add(61*time.Minute)))
+	require.Zero(t, pool.Status().Pending)
+}
+
+func TestSenderIsCappedAtItsAllowance(t *testing.T) {
+	pool, keys := newTestPool(t, Limits{AccountCap: 2}, 0, 2)
+	require.NoError(t, pool.Add(newPoolTx(t, pool, keys[0], 0, testGasPrice)))
+	require.NoError(t, pool.Add(newPoolTx(t, pool, keys[0], 1, testGasPrice)))
+
+	err := pool.Add(newPoolTx(t, pool, keys[0], 2, testGasPrice))
+	require.ErrorIs(t, err, ErrTxPoolFull)
+
+	// a replacement does not increase the footprint of the sender, and the other senders have their own allowance
+	require.NoError(t, pool.Add(newPoolTx(t, pool, keys[0], 1, 2*testGasPrice)))
+	require.NoError(t, pool.Add(newPoolTx(t, pool, keys[1], 0, testGasPrice)))
+}
+
+func TestLowestPayerIsEvictedWhenThePoolIsFull(t *testing.T) {
+	const capacity = 6
+	pool, keys := newTestPool(t, Limits{GlobalCap: capacity}, 0, capacity+2)
+	txs := make([]*common.L2Tx, capacity)
+	for i := range txs {
+		txs[i] = newPoolTx(t, pool, keys[i], 0, int64(i+2)*testGasPrice)
+		require.NoError(t, pool.Add(txs[i]))
+	}
+	requirePending(t, pool, txs...)
+
+	// a transaction paying less than all the others finds no room
+	err := pool.Add(newPoolTx(t, pool, keys[capacity], 0, testGasPrice))
+	require.ErrorIs(t, err, ErrTxPoolFull)
+
+	// a better paying one takes the place of the cheapest
+	better := newPoolTx(t, pool, keys[capacity+1], 0, 10*testGasPrice)
+	require.NoError(t, pool.Add(better))
+	require.Nil(t, pool.Get(txs[0].Hash()))
+	requirePending(t, pool, append(txs[1:], better)...)
+}
+
+func TestPendingTxIsReplacedByOnePayingTheBump(t *testing.T) {
+	pool, keys := newTestPool(t, Limits{}, 20, 1)
+	pending := newPoolTx(t, pool, keys[0], 0, 10*testGasPrice)
+	require.NoError(t, pool.Add(pending))
+	requirePending(t, pool, pending)
+
+	// the replacement must pay at least 20% more than the pending transaction
+	err := pool.Add(newPoolTx(t, pool, keys[0], 0, 12*testGasPrice-1))
+	require.ErrorIs(t, err, gethtxpool.ErrReplaceUnderpriced)
+	requirePending(t, pool, pending)
+
+	replacement := newPoolTx(t, pool, keys[0], 0, 12*testGasPrice)
+	require.NoError(t, pool.Add(replacement))
+	requirePending(t, pool, replacement)
+	// the replaced transaction is dropped, so it can't be included in a batch
+	require.Nil(t, pool.Get(pending.Hash()))
+}
+
+func TestDefaultPriceBumpIsTenPercent(t *testing.T) {
+	pool, keys := newTestPool(t, Limits{}, 0, 1)
+	pending := newPoolTx(t, pool, keys[0], 0, 100*testGasPrice)
+	require.NoError(t, pool.Add(pending))
+
+	err := pool.Add(newPoolTx(t, pool, keys[0], 0, 105*testGasPrice))
+	require.ErrorIs(t, err, gethtxpool.ErrReplaceUnderpriced)
+	replacement := newPoolTx(t, pool, keys[0], 0, 110*testGasPrice)
+	require.NoError(t, pool.Add(replacement))
+	requirePending(t, pool, replacement)
+}
+
+// newTestPool - a started pool, on a chain whose genesis funds the returned keys
+func newTestPool(t *testing.T, limits Limits, priceBump uint64, funded int) (*TxPool, []*ecdsa.PrivateKey) {
+	logger := gethlog.New()
+	chainID := big.NewInt(443)
+	backingDB, err := sqlite.CreateTemporarySQLiteDB("", "", logger)
+	require.NoError(t, err)
+	storageDB := storage.NewStorage(backingDB, ethchainadapter.ChainParams(chainID), logger)
+	require.NoError(t, storageDB.StoreSecret(crypto.SharedEnclaveSecret{}))
+
+	keys := make([]*ecdsa.PrivateKey, funded)
+	g := genesis.Genesis{}
+	for i := range keys {
+		keys[i], err = gethcrypto.GenerateKey()
+		require.NoError(t, err)
+		g.Accounts = append(g.Accounts, genesis.Account{Address: gethcrypto.PubkeyToAddress(keys[i].PublicKey), Amount: big.NewInt(1e18)})
+	}
+	root, err := g.GetGenesisRoot(storageDB)
+	require.NoError(t, err)
+	require.NoError(t, g.CommitGenesisState(storageDB))
+
+	gethEncoding := gethencoding.NewGethEncodingService(storageDB, logger)
+	registry := components.NewBatchRegistry(storageDB, logger)
+	batch := &core.Batch{
+		Header: &common.BatchHeader{
+			Root:             *root,
+			TxHash:           types.EmptyRootHash,
+			ReceiptHash:      types.EmptyRootHash,
+			Number:           big.NewInt(0),
+			SequencerOrderNo: new(big.Int).SetUint64(common.L2GenesisSeqNo),
+			Time:             uint64(time.Now().Unix()),
+			BaseFee:          big.NewInt(1),
+			GasLimit:         30_000_000,
+		},
+	}
+	convertedHeader, err := gethEncoding.CreateEthHeaderForBatch(batch.Header)
+	require.NoError(t, err)
+	require.NoError(t, storageDB.StoreBatch(batch, convertedHeader.Hash()))
+	require.NoError(t, storageDB.StoreExecutedBatch(batch, nil))
+	registry.OnBatchExecuted(batch, nil)
+
+	blockchain := ethchainadapter.NewEthChainAdapter(chainID, registry, storageDB, gethEncoding, logger)
+	require.NoError(t, blockchain.IngestNewBlock(batch))
+	pool, err := NewTxPool(blockchain, big.NewInt(1), priceBump, limits, storageDB, nil, logger)
+	require.NoError(t, err)
+	require.NoError(t, pool.Start())
+	t.Cleanup(func() { _ = pool.Close() })
+	return pool, keys
+}
+
+func newPoolTx(t *testing.T, pool *TxPool, key *ecdsa.PrivateKey, nonce uint64, gasPrice int64) *common.L2Tx {
+	to := gethcommon.HexToAddress("0x1")
+	tx, err := types.SignNewTx(key, types.LatestSigner(pool.Chain.Config()), &types.LegacyTx{Nonce: nonce, To: &to, Gas: 21_000, GasPrice: big.NewInt(gasPrice), Value: big.NewInt(1)})
+	require.NoError(t, err)
+	return tx
+}
+
+// requirePending - the pool promotes the transactions asynchronously, so it eventually has exactly these pending
+func requirePending(t *testing.T, pool *TxPool, txs ...*common.L2Tx) {
+	expected := map[gethcommon.Hash]bool{}
+	for _, tx := range txs {
+		expected[tx.Hash()] = true
+	}
+	require.Eventually(t, func() bool {
+		pending := map[gethcommon.Hash]bool{}
+		for _, senderTxs := range pool.PendingTransactions() {
+			for _, tx := range senderTxs {
+				pending[tx.Hash] = true
+			}
+		}
+		if len(pending) != len(expected) {
+			return false
+		}
+		for hash := range expected {
+			if !pending[hash] {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+}
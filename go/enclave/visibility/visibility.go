@@ -0,0 +1,96 @@
+// Package visibility holds the rules with which the contracts configure who can see their events.
+//
+// A contract configures one of its events by emitting ConfigEvent, with the signature of the event as the indexed
+// topic and the Rule as the data:
+//
+//	event EventVisibility(bytes32 indexed eventSignature, uint8 rule);
+//	emit EventVisibility(Transfer.selector, 3);
+//
+// The batch executor records the rules in the storage of ConfigAddress, so they are part of the state all the nodes
+// agree on. A rule applies to the logs of the batches which follow the batch that recorded it, so the relevancy of
+// the logs of a batch is decided with the rules of the state of its parent.
+package visibility
+
+import (
+	"bytes"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common/log"
+)
+
+// Rule - who can see the logs of an event
+type Rule uint8
+
+const (
+	// Default - the relevancy heuristics: the log is visible to the user accounts among its topics, or to every
+	// account when there are none
+	Default Rule = iota
+	// Public - the log is visible to every account
+	Public
+	// Topic1Owner - the log is only visible to the account whose address is its first indexed topic
+	Topic1Owner
+	// SenderOnly - the log is only visible to the sender of the transaction which emitted it
+	SenderOnly
+)
+
+var (
+	// ConfigAddress - the account whose storage holds the rules. Nobody holds its key.
+	ConfigAddress = gethcommon.HexToAddress("0x0B0B0B0B0B0B0B0B0B0B0B0B0B0B0B0B0B0B0B0B")
+	// ConfigEvent - the signature of the event with which a contract configures the rule of one of its events
+	ConfigEvent = crypto.Keccak256Hash([]byte("EventVisibility(bytes32,uint8)"))
+
+	// The leading zero bytes of a topic which holds an address.
+	addressPadding = make([]byte, gethcommon.HashLength-gethcommon.AddressLength)
+)
+
+// Record - records in the state the rules configured by the logs of the receipts, in order, so the last one wins. The
+// logs which do not match the ABI of ConfigEvent, or configure an unknown rule, are ignored.
+func Record(receipts types.Receipts, db *state.StateDB, logger gethlog.Logger) {
+	for _, receipt := range receipts {
+		for _, l := range receipt.Logs {
+			if len(l.Topics) == 0 || l.Topics[0] != ConfigEvent {
+				continue
+			}
+			if len(l.Topics) != 2 || len(l.Data) != gethcommon.HashLength {
+				logger.Debug("Ignoring malformed event visibility config", "contract", l.Address, log.TxKey, l.TxHash)
+				continue
+			}
+			rule := new(big.Int).SetBytes(l.Data)
+			if rule.Cmp(big.NewInt(int64(SenderOnly))) > 0 {
+				logger.Debug("Ignoring unknown event visibility rule", "contract", l.Address, "rule", rule, log.TxKey, l.TxHash)
+				continue
+			}
+			// the account would be deleted as empty when the state is committed
+			if db.GetNonce(ConfigAddress) == 0 {
+				db.SetNonce(ConfigAddress, 1)
+			}
+			db.SetState(ConfigAddress, ruleSlot(l.Address, l.Topics[1]), gethcommon.BigToHash(rule))
+		}
+	}
+}
+
+// RuleOf - the rule which applies to the log, in the state db
+func RuleOf(l *types.Log, db *state.StateDB) Rule {
+	if len(l.Topics) == 0 {
+		return Default
+	}
+	return Rule(db.GetState(ConfigAddress, ruleSlot(l.Address, l.Topics[0])).Big().Uint64())
+}
+
+// Topic1Account - the account to which a Topic1Owner log is visible, nil when its first indexed topic is not an address
+func Topic1Account(l *types.Log) *gethcommon.Address {
+	if len(l.Topics) < 2 || !bytes.Equal(l.Topics[1][:len(addressPadding)], addressPadding) {
+		return nil
+	}
+	addr := gethcommon.BytesToAddress(l.Topics[1].Bytes())
+	return &addr
+}
+
+func ruleSlot(contract gethcommon.Address, event gethcommon.Hash) gethcommon.Hash {
+	return crypto.Keccak256Hash(contract.Bytes(), event.Bytes())
+}
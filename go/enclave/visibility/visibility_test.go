@@ -0,0 +1,51 @@
+package visibility
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+func TestRulesAreCommittedToTheState(t *testing.T) {
+	stateDatabase := state.NewDatabase(rawdb.NewMemoryDatabase())
+	db, err := state.New(types.EmptyRootHash, stateDatabase, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract, other := gethcommon.HexToAddress("0xc0"), gethcommon.HexToAddress("0xc1")
+	event := gethcommon.HexToHash("0xaa")
+	configure := func(emitter gethcommon.Address, topics []gethcommon.Hash, rule int64) *types.Log {
+		return &types.Log{Address: emitter, Topics: append([]gethcommon.Hash{ConfigEvent}, topics...), Data: gethcommon.BigToHash(big.NewInt(rule)).Bytes()}
+	}
+	Record(types.Receipts{
+		{Logs: []*types.Log{configure(contract, []gethcommon.Hash{event}, int64(Public))}},
+		{Logs: []*types.Log{
+			// the last rule wins
+			configure(contract, []gethcommon.Hash{event}, int64(SenderOnly)),
+			// the malformed and unknown rules are ignored
+			configure(other, []gethcommon.Hash{event}, int64(SenderOnly)+1),
+			configure(other, []gethcommon.Hash{event, event}, int64(Public)),
+		}},
+	}, db, gethlog.New())
+
+	// the rules survive the deletion of the empty accounts
+	root, err := db.Commit(1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committed, err := state.New(root, stateDatabase, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule := RuleOf(&types.Log{Address: contract, Topics: []gethcommon.Hash{event}}, committed); rule != SenderOnly {
+		t.Fatalf("expected the sender only rule, got %d", rule)
+	}
+	if rule := RuleOf(&types.Log{Address: other, Topics: []gethcommon.Hash{event}}, committed); rule != Default {
+		t.Fatalf("expected the default rule for the ignored configs, got %d", rule)
+	}
+}
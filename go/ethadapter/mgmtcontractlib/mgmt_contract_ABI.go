@@ -14,3 +14,16 @@ const (
 )
 
 var MgmtContractABI = ManagementContract.ManagementContractMetaData.ABI
+
+const NetworkParametersUpdatedEvent = "NetworkParametersUpdated"
+
+// The keys of the network parameters in the NetworkParametersUpdated event
+const (
+	MinGasPriceParam       = "MinGasPrice"
+	BaseFeeParam           = "BaseFee"
+	GasPaymentAddressParam = "GasPaymentAddress"
+)
+
+// NetworkParametersABI - the NetworkParametersUpdated event of the management contract, which the generated bindings
+// predate
+const NetworkParametersABI = `[{"anonymous":false,"inputs":[{"indexed":false,"internalType":"string[]","name":"keys","type":"string[]"},{"indexed":false,"internalType":"uint256[]","name":"values","type":"uint256[]"}],"name":"NetworkParametersUpdated","type":"event"}]`
@@ -45,12 +45,17 @@ type MgmtContractLib interface {
 
 	GetImportantAddressCallMsg(key string) (ethereum.CallMsg, error)
 	DecodeImportantAddressResponse(callResponse []byte) (gethcommon.Address, error)
+
+	// DecodeNetworkParametersUpdate - the network parameters set by the management contract in the receipts of a block,
+	// merged in the order of the events, or nil if they set none
+	DecodeNetworkParametersUpdate(receipts types.Receipts) (*common.NetworkParametersUpdate, error)
 }
 
 type contractLibImpl struct {
-	addr        *gethcommon.Address
-	contractABI abi.ABI
-	logger      gethlog.Logger
+	addr          *gethcommon.Address
+	contractABI   abi.ABI
+	parametersABI abi.ABI
+	logger        gethlog.Logger
 }
 
 func NewMgmtContractLib(addr *gethcommon.Address, logger gethlog.Logger) MgmtContractLib {
@@ -58,11 +63,16 @@ func NewMgmtContractLib(addr *gethcommon.Address, logger gethlog.Logger) MgmtCon
 	if err != nil {
 		panic(err)
 	}
+	parametersABI, err := abi.JSON(strings.NewReader(NetworkParametersABI))
+	if err != nil {
+		panic(err)
+	}
 
 	return &contractLibImpl{
-		addr:        addr,
-		contractABI: contractABI,
-		logger:      logger,
+		addr:          addr,
+		contractABI:   contractABI,
+		parametersABI: parametersABI,
+		logger:        logger,
 	}
 }
 
@@ -310,6 +320,49 @@ func (c *contractLibImpl) DecodeImportantAddressResponse(callResponse []byte) (g
 	return address, nil
 }
 
+func (c *contractLibImpl) DecodeNetworkParametersUpdate(receipts types.Receipts) (*common.NetworkParametersUpdate, error) {
+	event := c.parametersABI.Events[NetworkParametersUpdatedEvent]
+	var update *common.NetworkParametersUpdate
+	for _, receipt := range receipts {
+		if receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
+			continue
+		}
+		for _, l := range receipt.Logs {
+			if l.Address != *c.addr || len(l.Topics) == 0 || l.Topics[0] != event.ID {
+				continue
+			}
+			var values struct {
+				Keys   []string
+				Values []*big.Int
+			}
+			if err := c.parametersABI.UnpackIntoInterface(&values, NetworkParametersUpdatedEvent, l.Data); err != nil {
+				return nil, fmt.Errorf("could not unpack the network parameters. Cause: %w", err)
+			}
+			if len(values.Keys) != len(values.Values) {
+				return nil, fmt.Errorf("the network parameters update has %d keys but %d values", len(values.Keys), len(values.Values))
+			}
+			if update == nil {
+				update = &common.NetworkParametersUpdate{}
+			}
+			for i, key := range values.Keys {
+				switch key {
+				case MinGasPriceParam:
+					update.MinGasPrice = values.Values[i]
+				case BaseFeeParam:
+					update.BaseFee = values.Values[i]
+				case GasPaymentAddressParam:
+					address := gethcommon.BigToAddress(values.Values[i])
+					update.GasPaymentAddress = &address
+				default:
+					// the parameters introduced by later versions are ignored
+					c.logger.Warn("Unknown network parameter", "key", key)
+				}
+			}
+		}
+	}
+	return update, nil
+}
+
 func (c *contractLibImpl) unpackInitSecretTx(tx *types.Transaction, method *abi.Method, contractCallData map[string]interface{}) *ethadapter.L1InitializeSecretTx {
 	err := method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[methodBytesLen:])
 	if err != nil {
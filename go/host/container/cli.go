@@ -30,6 +30,10 @@ type HostConfigToml struct {
 	L1WebsocketURL            string
 	EnclaveRPCTimeout         int
 	L1RPCTimeout              int
+	L1FullReceipts            bool
+	L1ReceiptProofs           bool
+	CompressL2Updates         bool
+	StateSnapshotPath         string
 	P2PConnectionTimeout      int
 	ManagementContractAddress string
 	MessageBusAddress         string
@@ -72,6 +76,10 @@ func ParseConfig() (*config.HostInputConfig, error) {
 	l1WSURL := flag.String(l1WebsocketURLName, cfg.L1WebsocketURL, flagUsageMap[l1WebsocketURLName])
 	enclaveRPCTimeoutSecs := flag.Uint64(enclaveRPCTimeoutSecsName, uint64(cfg.EnclaveRPCTimeout.Seconds()), flagUsageMap[enclaveRPCTimeoutSecsName])
 	l1RPCTimeoutSecs := flag.Uint64(l1RPCTimeoutSecsName, uint64(cfg.L1RPCTimeout.Seconds()), flagUsageMap[l1RPCTimeoutSecsName])
+	l1FullReceipts := flag.Bool(l1FullReceiptsName, cfg.L1FullReceipts, flagUsageMap[l1FullReceiptsName])
+	l1ReceiptProofs := flag.Bool(l1ReceiptProofsName, cfg.L1ReceiptProofs, flagUsageMap[l1ReceiptProofsName])
+	compressL2Updates := flag.Bool(compressL2UpdatesName, cfg.CompressL2Updates, flagUsageMap[compressL2UpdatesName])
+	stateSnapshotPath := flag.String(stateSnapshotPathName, cfg.StateSnapshotPath, flagUsageMap[stateSnapshotPathName])
 	p2pConnectionTimeoutSecs := flag.Uint64(p2pConnectionTimeoutSecsName, uint64(cfg.P2PConnectionTimeout.Seconds()), flagUsageMap[p2pConnectionTimeoutSecsName])
 	managementContractAddress := flag.String(managementContractAddrName, cfg.ManagementContractAddress.Hex(), flagUsageMap[managementContractAddrName])
 	messageBusContractAddress := flag.String(messageBusContractAddrName, cfg.MessageBusAddress.Hex(), flagUsageMap[messageBusContractAddrName])
@@ -118,6 +126,10 @@ func ParseConfig() (*config.HostInputConfig, error) {
 	cfg.L1WebsocketURL = *l1WSURL
 	cfg.EnclaveRPCTimeout = time.Duration(*enclaveRPCTimeoutSecs) * time.Second
 	cfg.L1RPCTimeout = time.Duration(*l1RPCTimeoutSecs) * time.Second
+	cfg.L1FullReceipts = *l1FullReceipts
+	cfg.L1ReceiptProofs = *l1ReceiptProofs
+	cfg.CompressL2Updates = *compressL2Updates
+	cfg.StateSnapshotPath = *stateSnapshotPath
 	cfg.P2PConnectionTimeout = time.Duration(*p2pConnectionTimeoutSecs) * time.Second
 	cfg.ManagementContractAddress = gethcommon.HexToAddress(*managementContractAddress)
 	cfg.MessageBusAddress = gethcommon.HexToAddress(*messageBusContractAddress)
@@ -195,6 +207,10 @@ func fileBasedConfig(configPath string) (*config.HostInputConfig, error) {
 		L1WebsocketURL:            tomlConfig.L1WebsocketURL,
 		EnclaveRPCTimeout:         time.Duration(tomlConfig.EnclaveRPCTimeout) * time.Second,
 		L1RPCTimeout:              time.Duration(tomlConfig.L1RPCTimeout) * time.Second,
+		L1FullReceipts:            tomlConfig.L1FullReceipts,
+		L1ReceiptProofs:           tomlConfig.L1ReceiptProofs,
+		CompressL2Updates:         tomlConfig.CompressL2Updates,
+		StateSnapshotPath:         tomlConfig.StateSnapshotPath,
 		P2PConnectionTimeout:      time.Duration(tomlConfig.P2PConnectionTimeout) * time.Second,
 		ManagementContractAddress: gethcommon.HexToAddress(tomlConfig.ManagementContractAddress),
 		MessageBusAddress:         gethcommon.HexToAddress(tomlConfig.MessageBusAddress),
@@ -15,6 +15,10 @@ const (
 	l1WebsocketURLName           = "l1WSURL"
 	enclaveRPCTimeoutSecsName    = "enclaveRPCTimeoutSecs"
 	l1RPCTimeoutSecsName         = "l1RPCTimeoutSecs"
+	l1FullReceiptsName           = "l1FullReceipts"
+	l1ReceiptProofsName          = "l1ReceiptProofs"
+	compressL2UpdatesName        = "compressL2Updates"
+	stateSnapshotPathName        = "stateSnapshotPath"
 	p2pConnectionTimeoutSecsName = "p2pConnectionTimeoutSecs"
 	managementContractAddrName   = "managementContractAddress"
 	messageBusContractAddrName   = "messageBusContractAddress"
@@ -55,6 +59,10 @@ func getFlagUsageMap() map[string]string {
 		l1WebsocketURLName:           "The websocket RPC address the host can use for L1 requests",
 		enclaveRPCTimeoutSecsName:    "The timeout for host <-> enclave RPC communication",
 		l1RPCTimeoutSecsName:         "The timeout for connecting to, and communicating with, the Ethereum client",
+		l1FullReceiptsName:           "Whether to submit the receipts of all the transactions of the L1 blocks. Required when the enclave validates the L1 blocks, unless the receipts are proven",
+		l1ReceiptProofsName:          "Whether to submit only the relevant receipts of the L1 blocks, with merkle proofs against the receipts roots. Takes precedence over l1FullReceipts",
+		compressL2UpdatesName:        "Whether to ask the enclave to compress the batches it streams to the host, when the enclave supports it",
+		stateSnapshotPathName:        "The file of a state snapshot exported for the enclave, imported while its database is empty",
 		p2pConnectionTimeoutSecsName: "The timeout for host <-> host P2P messaging",
 		managementContractAddrName:   "The management contract address on the L1",
 		messageBusContractAddrName:   "The message bus contract address on the L1",
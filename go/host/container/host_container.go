@@ -34,6 +34,7 @@ const (
 	APINamespaceNetwork = "net"
 	APINamespaceTest    = "test"
 	APINamespaceDebug   = "debug"
+	APINamespaceWeb3    = "web3"
 )
 
 type HostContainer struct {
@@ -92,9 +93,13 @@ func (h *HostContainer) Host() hostcommon.Host {
 	return h.host
 }
 
+// P2PWrapper - wraps the P2P layer built from the config, e.g. to simulate network faults in tests
+type P2PWrapper func(hostcommon.P2PHostService) hostcommon.P2PHostService
+
 // NewHostContainerFromConfig uses config to create all HostContainer dependencies and inject them into a new HostContainer
 // (Note: it does not start the HostContainer process, `Start()` must be called on the container)
-func NewHostContainerFromConfig(parsedConfig *config.HostInputConfig, logger gethlog.Logger) *HostContainer {
+// The P2P layer is wrapped by wrapP2P, if it is not nil.
+func NewHostContainerFromConfig(parsedConfig *config.HostInputConfig, logger gethlog.Logger, wrapP2P P2PWrapper) *HostContainer {
 	cfg := parsedConfig.ToHostConfig()
 
 	addr, err := wallet.RetrieveAddress(parsedConfig.PrivateKeyString)
@@ -135,13 +140,16 @@ func NewHostContainerFromConfig(parsedConfig *config.HostInputConfig, logger get
 	p2pLogger := logger.New(log.CmpKey, log.P2PCmp)
 	metricsService := metrics.New(cfg.MetricsEnabled, cfg.MetricsHTTPPort, logger)
 
-	aggP2P := p2p.NewSocketP2PLayer(cfg, services, p2pLogger, metricsService.Registry())
+	var aggP2P hostcommon.P2PHostService = p2p.NewSocketP2PLayer(cfg, services, p2pLogger, metricsService.Registry())
+	if wrapP2P != nil {
+		aggP2P = wrapP2P(aggP2P)
+	}
 
 	rpcServer := clientrpc.NewServer(cfg, logger)
 
 	mgmtContractLib := mgmtcontractlib.NewMgmtContractLib(&cfg.ManagementContractAddress, logger)
 	obscuroRelevantContracts := []gethcommon.Address{cfg.ManagementContractAddress, cfg.MessageBusAddress}
-	l1Repo := l1.NewL1Repository(l1Client, obscuroRelevantContracts, logger)
+	l1Repo := l1.NewL1Repository(l1Client, obscuroRelevantContracts, cfg.L1FullReceipts, logger)
 
 	return NewHostContainer(cfg, services, aggP2P, l1Client, l1Repo, enclaveClient, mgmtContractLib, ethWallet, rpcServer, logger, metricsService)
 }
@@ -184,6 +192,12 @@ func NewHostContainer(cfg *config.HostConfig, services *host.ServicesRegistry, p
 				Service:   clientapi.NewNetworkAPI(h),
 				Public:    true,
 			},
+			{
+				Namespace: APINamespaceWeb3,
+				Version:   APIVersion1,
+				Service:   clientapi.NewWeb3API(h),
+				Public:    true,
+			},
 			{
 				Namespace: APINamespaceTest,
 				Version:   APIVersion1,
@@ -1,8 +1,11 @@
 package enclave
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"math/big"
+	"os"
 	"strings"
 	"sync"
 	"time"
@@ -35,6 +38,12 @@ const (
 
 	// when we have submitted request to L1 for the secret, how long do we wait for an answer before we retry
 	_maxWaitForSecretResponse = 2 * time.Minute
+
+	// the number of L1 headers submitted at once when looking for the latest block the enclave has
+	_l1HeadersBatchSize = 64
+
+	// how often the progress of the L2 catch-up is logged
+	_l2CatchupLogInterval = 10 * time.Second
 )
 
 // This private interface enforces the services that the guardian depends on
@@ -65,6 +74,10 @@ type Guardian struct {
 	blockTime      time.Duration
 	l1StartHash    gethcommon.Hash
 	maxRollupSize  uint64
+	// submit proofs of the relevant receipts instead of the receipts
+	l1ReceiptProofs bool
+	// the state snapshot the enclave imports while its database is empty, instead of replaying the batches
+	stateSnapshotPath string
 
 	hostInterrupter *stopcontrol.StopControl // host hostInterrupter so we can stop quickly
 
@@ -76,19 +89,21 @@ type Guardian struct {
 
 func NewGuardian(cfg *config.HostConfig, hostData host.Identity, serviceLocator guardianServiceLocator, enclaveClient common.Enclave, db *db.DB, interrupter *stopcontrol.StopControl, logger gethlog.Logger) *Guardian {
 	return &Guardian{
-		hostData:         hostData,
-		state:            NewStateTracker(logger),
-		enclaveClient:    enclaveClient,
-		sl:               serviceLocator,
-		batchInterval:    cfg.BatchInterval,
-		maxBatchInterval: cfg.MaxBatchInterval,
-		rollupInterval:   cfg.RollupInterval,
-		l1StartHash:      cfg.L1StartHash,
-		maxRollupSize:    cfg.MaxRollupSize,
-		blockTime:        cfg.L1BlockTime,
-		db:               db,
-		hostInterrupter:  interrupter,
-		logger:           logger,
+		hostData:          hostData,
+		state:             NewStateTracker(logger),
+		enclaveClient:     enclaveClient,
+		sl:                serviceLocator,
+		batchInterval:     cfg.BatchInterval,
+		maxBatchInterval:  cfg.MaxBatchInterval,
+		rollupInterval:    cfg.RollupInterval,
+		l1StartHash:       cfg.L1StartHash,
+		maxRollupSize:     cfg.MaxRollupSize,
+		l1ReceiptProofs:   cfg.L1ReceiptProofs,
+		stateSnapshotPath: cfg.StateSnapshotPath,
+		blockTime:         cfg.L1BlockTime,
+		db:                db,
+		hostInterrupter:   interrupter,
+		logger:            logger,
 	}
 }
 
@@ -196,26 +211,22 @@ func (g *Guardian) HandleBatch(batch *common.ExtBatch) {
 	if !g.state.IsUpToDate() {
 		return // ignore batches until we're up-to-date
 	}
-	err := g.submitL2Batch(batch)
+	_, err := g.submitL2Batch(batch)
 	if err != nil {
 		g.logger.Error("Error submitting batch to enclave", log.ErrKey, err)
 	}
 }
 
-func (g *Guardian) HandleTransaction(tx common.EncryptedTx) {
+func (g *Guardian) HandleTransaction(tx common.EncryptedForwardedTx) {
 	if g.GetEnclaveState().status == Disconnected ||
 		g.GetEnclaveState().status == Unavailable ||
-		g.GetEnclaveState().status == AwaitingSecret {
+		g.GetEnclaveState().status == AwaitingSecret ||
+		g.GetEnclaveState().status == Recovering {
 		g.logger.Info("Enclave is not ready yet, dropping transaction.")
 		return // ignore transactions when enclave unavailable
 	}
-	resp, sysError := g.enclaveClient.SubmitTx(tx)
-	if sysError != nil {
-		g.logger.Warn("could not submit transaction due to sysError", log.ErrKey, sysError)
-		return
-	}
-	if resp.Error() != nil {
-		g.logger.Trace("could not submit transaction", log.ErrKey, resp.Error())
+	if sysError := g.enclaveClient.SubmitForwardedTx(tx); sysError != nil {
+		g.logger.Warn("could not submit forwarded transaction due to sysError", log.ErrKey, sysError)
 	}
 }
 
@@ -229,7 +240,7 @@ func (g *Guardian) mainLoop() {
 		g.checkEnclaveStatus()
 		g.logger.Trace("mainLoop - enclave status", "status", g.state.GetStatus())
 		switch g.state.GetStatus() {
-		case Disconnected, Unavailable:
+		case Disconnected, Unavailable, Recovering:
 			// nothing to do, we are waiting for the enclave to be available
 			time.Sleep(_retryInterval)
 		case AwaitingSecret:
@@ -356,6 +367,14 @@ func (g *Guardian) generateAndBroadcastSecret() error {
 }
 
 func (g *Guardian) catchupWithL1() error {
+	if g.stateSnapshotPath != "" && g.state.GetEnclaveL1Head() == gethutil.EmptyHash {
+		if err := g.importStateSnapshot(); err != nil {
+			return fmt.Errorf("could not import the state snapshot. Cause: %w", err)
+		}
+		// the enclave resumes from the L1 block and the batch of the snapshot
+		g.checkEnclaveStatus()
+		return nil
+	}
 	// while we are behind the L1 head and still running, fetch and submit L1 blocks
 	for !g.hostInterrupter.IsStopping() && g.state.GetStatus() == L1Catchup {
 		// generally we will be feeding the block after the enclave's current head
@@ -383,7 +402,32 @@ func (g *Guardian) catchupWithL1() error {
 	return nil
 }
 
+// importStateSnapshot - the enclave has not processed the L1 blocks, so it is given the attestations published on them,
+// to verify the key which signed the snapshot and to learn the key of the sequencer
+func (g *Guardian) importStateSnapshot() error {
+	encoded, err := os.ReadFile(g.stateSnapshotPath)
+	if err != nil {
+		return fmt.Errorf("could not read the state snapshot. Cause: %w", err)
+	}
+	snapshot, err := common.DecodeStateSnapshot(encoded)
+	if err != nil {
+		return fmt.Errorf("could not decode the state snapshot. Cause: %w", err)
+	}
+	attestations, err := g.sl.L1Publisher().FindAttestations(g.l1StartHash)
+	if err != nil {
+		return fmt.Errorf("could not find the attestations published on L1. Cause: %w", err)
+	}
+	info, err := g.enclaveClient.ImportStateSnapshot(snapshot, attestations)
+	if err != nil {
+		return err
+	}
+	g.logger.Info("Imported the state snapshot", log.RollupHashKey, info.RollupHash, log.BatchSeqNoKey, info.SeqNo)
+	return nil
+}
+
 func (g *Guardian) catchupWithL2() error {
+	lastProgressLog := time.Now()
+	var executed uint64
 	// while we are behind the L2 head and still running:
 	for !g.hostInterrupter.IsStopping() && g.state.GetStatus() == L2Catchup {
 		if g.hostData.IsSequencer {
@@ -399,10 +443,25 @@ func (g *Guardian) catchupWithL2() error {
 			return errors.Wrap(err, "could not fetch next L2 batch")
 		}
 
-		err = g.submitL2Batch(batch)
+		summary, err := g.submitL2Batch(batch)
 		if err != nil {
 			return err
 		}
+		// the older enclaves do not report what they executed
+		if summary == nil {
+			continue
+		}
+		executed += summary.Executed
+		// logged for every batch while the enclave waits for its L1 block, the progress is logged periodically below
+		if summary.StopReason != common.BatchExecutionUpToDate {
+			g.logger.Debug("Batch stored by the enclave, waiting to be executed", log.BatchSeqNoKey, nextHead,
+				"stoppedAt", summary.StoppedAtSeqNo, "reason", summary.StopReason)
+		}
+		if time.Since(lastProgressLog) >= _l2CatchupLogInterval {
+			lastProgressLog = time.Now()
+			g.logger.Info(fmt.Sprintf("Catching up with the L2, the enclave executed %d batches", executed),
+				"enclaveHead", summary.HeadSeqNo, "hostHead", g.state.GetHostL2Head(), "reason", summary.StopReason)
+		}
 	}
 	return nil
 }
@@ -416,12 +475,12 @@ func (g *Guardian) submitL1Block(block *common.L1Block, isLatest bool) (bool, er
 		// we are waiting for the enclave to process other data, and we don't want to leak goroutines, we wil catch up with the block later
 		return false, nil
 	}
-	receipts, err := g.sl.L1Repo().FetchObscuroReceipts(block)
+	receipts, receiptProofs, err := g.fetchL1Receipts(block)
 	if err != nil {
 		g.submitDataLock.Unlock() // lock must be released before returning
 		return false, fmt.Errorf("could not fetch obscuro receipts for block=%s - %w", block.Hash(), err)
 	}
-	resp, err := g.enclaveClient.SubmitL1Block(*block, receipts, isLatest)
+	resp, err := g.enclaveClient.SubmitL1Block(context.Background(), *block, receipts, receiptProofs, isLatest)
 	g.submitDataLock.Unlock() // lock is only guarding the enclave call, so we can release it now
 	if err != nil {
 		if strings.Contains(err.Error(), errutil.ErrBlockAlreadyProcessed.Error()) {
@@ -436,6 +495,11 @@ func (g *Guardian) submitL1Block(block *common.L1Block, isLatest bool) (bool, er
 			}
 			return g.submitL1Block(nextCanonicalBlock, isLatest)
 		}
+		var rejErr *errutil.BlockRejectError
+		if errors.As(err, &rejErr) && rejErr.Is(errutil.ErrBlockAncestorNotFound) {
+			// the enclave is missing blocks before this one, the catch-up will submit them
+			return false, g.rewindToLatestAncestor(block, rejErr)
+		}
 		// something went wrong, return error and let the main loop check status and try again when appropriate
 		return false, errors.Wrap(err, "could not submit L1 block to enclave")
 	}
@@ -457,6 +521,67 @@ func (g *Guardian) submitL1Block(block *common.L1Block, isLatest bool) (bool, er
 	return true, nil
 }
 
+// fetchL1Receipts returns either the receipts of the block, or the proofs of the relevant ones
+func (g *Guardian) fetchL1Receipts(block *common.L1Block) (types.Receipts, common.L1ReceiptProofs, error) {
+	if g.l1ReceiptProofs {
+		receiptProofs, err := g.sl.L1Repo().FetchObscuroReceiptProofs(block)
+		return nil, receiptProofs, err
+	}
+	receipts, err := g.sl.L1Repo().FetchObscuroReceipts(block)
+	return receipts, nil, err
+}
+
+// rewindToLatestAncestor sets the enclave L1 head to the latest ancestor of the rejected block which the enclave has, so the
+// L1 catch-up submits exactly the missing range
+func (g *Guardian) rewindToLatestAncestor(block *common.L1Block, rejErr *errutil.BlockRejectError) error {
+	ancestor := rejErr.LatestAncestor
+	if ancestor == gethutil.EmptyHash {
+		// the enclave could not infer it from the heights (e.g. after a fork), so we look for it with the headers
+		forkPoint, err := g.findL1ForkPoint(block)
+		if err != nil {
+			return errors.Wrap(err, "could not find the latest L1 ancestor known by the enclave")
+		}
+		ancestor = forkPoint.Ancestor
+	}
+	g.logger.Info("Enclave is missing L1 blocks, rewinding to its latest ancestor", log.BlockHashKey, block.Hash(),
+		"ancestor", ancestor, "missingBlocks", rejErr.MissingBlocks)
+	g.state.OnProcessedBlock(ancestor)
+	return nil
+}
+
+// findL1ForkPoint submits the headers of the ancestors of the block to the enclave, going back one batch at a time until
+// it finds a block the enclave has
+func (g *Guardian) findL1ForkPoint(block *common.L1Block) (*common.L1ForkPoint, error) {
+	parentHash := block.ParentHash()
+	for !g.hostInterrupter.IsStopping() {
+		headers := make([]*types.Header, 0, _l1HeadersBatchSize)
+		for len(headers) < _l1HeadersBatchSize {
+			parent, err := g.sl.L1Repo().FetchBlock(parentHash)
+			if err != nil {
+				return nil, fmt.Errorf("could not fetch L1 block=%s - %w", parentHash, err)
+			}
+			headers = append(headers, parent.Header())
+			if parent.NumberU64() == 0 {
+				break
+			}
+			parentHash = parent.ParentHash()
+		}
+		// the enclave expects the headers ordered by height
+		for i, j := 0, len(headers)-1; i < j; i, j = i+1, j-1 {
+			headers[i], headers[j] = headers[j], headers[i]
+		}
+
+		forkPoint, err := g.enclaveClient.SubmitL1Headers(headers)
+		if err == nil {
+			return forkPoint, nil
+		}
+		if !errors.Is(err, errutil.ErrBlockAncestorNotFound) || headers[0].Number.Sign() == 0 {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("host is stopping")
+}
+
 func (g *Guardian) processL1BlockTransactions(block *common.L1Block) {
 	// if there are any secret responses in the block we should refresh our P2P list to re-sync with the network
 	secretRespTxs, rollupTxs, contractAddressTxs := g.sl.L1Publisher().ExtractObscuroRelevantTransactions(block)
@@ -508,17 +633,22 @@ func (g *Guardian) publishSharedSecretResponses(scrtResponses []*common.Produced
 	return nil
 }
 
-func (g *Guardian) submitL2Batch(batch *common.ExtBatch) error {
+// submitL2Batch - returns what the enclave executed of its stored batches, nil for the older enclaves
+func (g *Guardian) submitL2Batch(batch *common.ExtBatch) (*common.BatchExecutionSummary, error) {
 	g.submitDataLock.Lock()
-	err := g.enclaveClient.SubmitBatch(batch)
+	summary, err := g.enclaveClient.SubmitBatch(context.Background(), batch)
 	g.submitDataLock.Unlock()
 	if err != nil {
+		if summary != nil {
+			g.logger.Warn("The enclave stopped executing the batches", log.BatchSeqNoKey, summary.StoppedAtSeqNo,
+				"reason", summary.StopReason, "executed", summary.Executed)
+		}
 		// something went wrong, return error and let the main loop check status and try again when appropriate
-		return errors.Wrap(err, "could not submit L2 batch to enclave")
+		return nil, errors.Wrap(err, "could not submit L2 batch to enclave")
 	}
 	// successfully processed batch, update the state
 	g.state.OnProcessedBatch(batch.Header.SequencerOrderNo)
-	return nil
+	return summary, nil
 }
 
 func (g *Guardian) periodicBatchProduction() {
@@ -546,9 +676,26 @@ func (g *Guardian) periodicBatchProduction() {
 			// if maxBatchInterval is set higher than batchInterval then we are happy to skip creating batches when there is no data
 			// (up to a maximum time of maxBatchInterval)
 			skipBatchIfEmpty := g.maxBatchInterval > g.batchInterval && time.Since(g.lastBatchCreated) < g.maxBatchInterval
-			err := g.enclaveClient.CreateBatch(skipBatchIfEmpty)
+			result, err := g.enclaveClient.CreateBatch(skipBatchIfEmpty)
 			if err != nil {
+				if errors.Is(err, errutil.ErrNotReady) {
+					g.logger.Debug("Skipping batch production, the enclave has no L1 head to create the genesis batch on yet")
+					continue
+				}
 				g.logger.Error("Unable to produce batch", log.ErrKey, err)
+				continue
+			}
+			switch result.SkipReason {
+			case common.BatchNotSkipped:
+				g.lastBatchCreated = time.Now()
+				g.state.OnEnclaveReady()
+				g.logger.Debug("Batch created", log.BatchSeqNoKey, result.SeqNo, log.BatchHashKey, result.BatchHash, "numTxs", result.TxCount)
+			case common.BatchSkippedEmpty:
+				g.logger.Debug("Skipping batch production, no transactions to execute")
+			case common.BatchSkippedPaused:
+				g.logger.Debug("Skipping batch production, the batch production of the enclave is paused")
+			case common.BatchSkippedInEnclave:
+				g.logger.Debug("Skipping batch production, the enclave produces the batches on its own timer")
 			}
 		case <-g.hostInterrupter.Done():
 			// interrupted - end periodic process
@@ -563,6 +710,13 @@ const batchCompressionFactor = 0.85
 func (g *Guardian) periodicRollupProduction() {
 	defer g.logger.Info("Stopping rollup production")
 
+	// there is nothing to roll up until the enclave has a head batch
+	select {
+	case <-g.state.EnclaveReady():
+	case <-g.hostInterrupter.Done():
+		return
+	}
+
 	// check rollup every l1 block time
 	rollupCheckTicker := time.NewTicker(g.blockTime)
 	lastSuccessfulRollup := time.Now()
@@ -600,14 +754,27 @@ func (g *Guardian) periodicRollupProduction() {
 			sizeExceeded := estimatedRunningRollupSize >= g.maxRollupSize
 			if timeExpired || sizeExceeded {
 				g.logger.Info("Trigger rollup production.", "timeExpired", timeExpired, "sizeExceeded", sizeExceeded)
-				producedRollup, err := g.enclaveClient.CreateRollup(fromBatch)
-				if err != nil {
-					g.logger.Error("Unable to create rollup", log.BatchSeqNoKey, fromBatch, log.ErrKey, err)
-					continue
+				// a rollup cut short by the size limit is followed straight away by the next one
+				for {
+					producedRollup, metadata, err := g.enclaveClient.CreateRollup(fromBatch)
+					if err != nil {
+						g.logger.Error("Unable to create rollup", log.BatchSeqNoKey, fromBatch, log.ErrKey, err)
+						break
+					}
+					// this method waits until the receipt is received
+					g.sl.L1Publisher().PublishRollup(producedRollup)
+					lastSuccessfulRollup = time.Now()
+
+					if !metadata.SizeLimitReached || metadata.RemainingBatches == 0 {
+						break
+					}
+					g.logger.Info("Rollup reached the size limit. Producing the next one.", log.BatchSeqNoKey, metadata.LastBatchSeqNo, "remaining_batches", metadata.RemainingBatches)
+					// read from the L1 again, in case the rollup was not published
+					if fromBatch, err = g.getLatestBatchNo(); err != nil {
+						g.logger.Error("encountered error while trying to retrieve latest sequence number", log.ErrKey, err)
+						break
+					}
 				}
-				// this method waits until the receipt is received
-				g.sl.L1Publisher().PublishRollup(producedRollup)
-				lastSuccessfulRollup = time.Now()
 			}
 
 		case <-g.hostInterrupter.Done():
@@ -623,7 +790,12 @@ func (g *Guardian) streamEnclaveData() {
 	g.logger.Info("Starting L2 update stream from enclave")
 
 	streamChan, stop := g.enclaveClient.StreamL2Updates()
-	var lastBatch *common.ExtBatch
+	// the stream only carries the batches produced once it is open. The sequencer resumes from the last batch its host
+	// stored, so a restarted host receives the batches in the same order as a fresh one, which starts with the genesis
+	var lastSeqNo uint64
+	if g.hostData.IsSequencer {
+		lastSeqNo = g.fetchMissedBatches(g.lastStoredSeqNo())
+	}
 	for {
 		select {
 		case resp, ok := <-streamChan:
@@ -632,37 +804,38 @@ func (g *Guardian) streamEnclaveData() {
 				g.logger.Warn("Batch streaming failed. Reconnecting after 3 seconds")
 				time.Sleep(3 * time.Second)
 				streamChan, stop = g.enclaveClient.StreamL2Updates()
-
+				// the sequencer enclave may have produced batches while the stream was down. They are fetched once the
+				// new stream is open, so none is missed
+				if g.hostData.IsSequencer {
+					lastSeqNo = g.fetchMissedBatches(lastSeqNo)
+				}
 				continue
 			}
 
-			if resp.Batch != nil { //nolint:nestif
-				lastBatch = resp.Batch
-				g.logger.Trace("Received batch from stream", log.BatchHashKey, lastBatch.Hash())
-				err := g.sl.L2Repo().AddBatch(resp.Batch)
-				if err != nil && !errors.Is(err, errutil.ErrAlreadyExists) {
-					// todo (@matt) this is a catastrophic scenario, the host may never get that batch - handle this
-					g.logger.Crit("failed to add batch to L2 repo", log.BatchHashKey, resp.Batch.Hash(), log.ErrKey, err)
-				}
-
-				if g.hostData.IsSequencer { // if we are the sequencer we need to broadcast this new batch to the network
-					g.lastBatchCreated = time.Now()
-					g.logger.Info("Batch produced. Sending to peers..", log.BatchHeightKey, resp.Batch.Header.Number, log.BatchHashKey, resp.Batch.Hash())
+			if resp.Fork != nil {
+				// the batches of the new canonical chain follow, the batches already stored are skipped
+				g.logger.Info("The enclave reported an L2 fork", "commonAncestor", resp.Fork.CommonAncestorSeqNo,
+					log.BatchSeqNoKey, resp.Fork.NewHeadSeqNo)
+			}
 
-					err = g.sl.P2P().BroadcastBatches([]*common.ExtBatch{resp.Batch})
-					if err != nil {
-						g.logger.Error("Failed to broadcast batch", log.BatchHashKey, resp.Batch.Hash(), log.ErrKey, err)
-					}
+			if resp.Batch != nil {
+				// the sequencer batches fetched after a reconnection can be streamed too
+				if g.hostData.IsSequencer && resp.Batch.Header.SequencerOrderNo.Uint64() <= lastSeqNo {
+					g.logger.Debug("Skipping batch already received", log.BatchSeqNoKey, resp.Batch.Header.SequencerOrderNo)
 				} else {
-					g.logger.Debug("Received batch from enclave", log.BatchSeqNoKey, resp.Batch.Header.SequencerOrderNo, log.BatchHashKey, resp.Batch.Hash())
+					lastSeqNo = resp.Batch.Header.SequencerOrderNo.Uint64()
+					g.onStreamedBatch(resp.Batch)
 				}
-				g.state.OnProcessedBatch(resp.Batch.Header.SequencerOrderNo)
 			}
 
 			if resp.Logs != nil {
 				g.sl.LogSubs().SendLogsToSubscribers(&resp.Logs)
 			}
 
+			if resp.NewHeads != nil {
+				g.sl.LogSubs().SendLogsToSubscribers(&resp.NewHeads)
+			}
+
 		case <-g.hostInterrupter.Done():
 			// interrupted - end periodic process
 			return
@@ -670,6 +843,59 @@ func (g *Guardian) streamEnclaveData() {
 	}
 }
 
+func (g *Guardian) onStreamedBatch(batch *common.ExtBatch) {
+	g.logger.Trace("Received batch from stream", log.BatchHashKey, batch.Hash())
+	err := g.sl.L2Repo().AddBatch(batch)
+	if err != nil && !errors.Is(err, errutil.ErrAlreadyExists) {
+		// todo (@matt) this is a catastrophic scenario, the host may never get that batch - handle this
+		g.logger.Crit("failed to add batch to L2 repo", log.BatchHashKey, batch.Hash(), log.ErrKey, err)
+	}
+
+	if g.hostData.IsSequencer { // if we are the sequencer we need to broadcast this new batch to the network
+		g.logger.Info("Batch produced. Sending to peers..", log.BatchHeightKey, batch.Header.Number, log.BatchHashKey, batch.Hash())
+
+		err = g.sl.P2P().BroadcastBatches([]*common.ExtBatch{batch})
+		if err != nil {
+			g.logger.Error("Failed to broadcast batch", log.BatchHashKey, batch.Hash(), log.ErrKey, err)
+		}
+	} else {
+		g.logger.Debug("Received batch from enclave", log.BatchSeqNoKey, batch.Header.SequencerOrderNo, log.BatchHashKey, batch.Hash())
+	}
+	g.state.OnProcessedBatch(batch.Header.SequencerOrderNo)
+}
+
+// fetchMissedBatches - handles the batches produced by the enclave after the seq number, and returns the seq number of
+// the last one handled. From 0, the batches are handled from the genesis.
+func (g *Guardian) fetchMissedBatches(lastSeqNo uint64) uint64 {
+	for {
+		from := lastSeqNo + 1
+		batches, err := g.enclaveClient.GetBatchesBySeqRange(from, math.MaxInt64, 0)
+		if err != nil {
+			g.logger.Error("Could not fetch the batches missed by the stream", log.BatchSeqNoKey, from, log.ErrKey, err)
+			return lastSeqNo
+		}
+		if len(batches) == 0 {
+			return lastSeqNo
+		}
+		for _, batch := range batches {
+			g.onStreamedBatch(batch)
+			lastSeqNo = batch.Header.SequencerOrderNo.Uint64()
+		}
+	}
+}
+
+// lastStoredSeqNo - the seq number of the head batch stored by the host, 0 when it has none
+func (g *Guardian) lastStoredSeqNo() uint64 {
+	head, err := g.db.GetHeadBatchHeader()
+	if err != nil {
+		if !errors.Is(err, errutil.ErrNotFound) {
+			g.logger.Warn("Could not read the head batch stored by the host", log.ErrKey, err)
+		}
+		return 0
+	}
+	return head.SequencerOrderNo.Uint64()
+}
+
 func (g *Guardian) calculateNonRolledupBatchesSize(seqNo uint64) (uint64, error) {
 	var size uint64
 
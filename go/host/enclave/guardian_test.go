@@ -0,0 +1,181 @@
+package enclave
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/host/db"
+)
+
+// streamedEnclave - a sequencer enclave whose stream is opened once per stream given, and which produces the batches of
+// each stream when it is opened
+type streamedEnclave struct {
+	common.Enclave
+	lock    sync.Mutex
+	batches []*common.ExtBatch
+	streams []enclaveStream
+}
+
+type enclaveStream struct {
+	produced []*common.ExtBatch // while the stream was down
+	updates  chan common.StreamL2UpdatesResponse
+}
+
+func (e *streamedEnclave) StreamL2Updates() (chan common.StreamL2UpdatesResponse, func()) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	stream := e.streams[0]
+	e.streams = e.streams[1:]
+	e.batches = append(e.batches, stream.produced...)
+	return stream.updates, func() {}
+}
+
+func (e *streamedEnclave) GetBatchesBySeqRange(fromSeqNo uint64, toSeqNo uint64, _ uint64) ([]*common.ExtBatch, common.SystemError) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	var batches []*common.ExtBatch
+	for _, batch := range e.batches {
+		if seqNo := batch.Header.SequencerOrderNo.Uint64(); seqNo >= fromSeqNo && seqNo <= toSeqNo {
+			batches = append(batches, batch)
+		}
+	}
+	return batches, nil
+}
+
+// batchRecorder - records the seq nos of the batches stored and broadcast by the guardian
+type batchRecorder struct {
+	host.L2BatchRepository
+	host.P2P
+	lock        sync.Mutex
+	stored      []uint64
+	broadcasted []uint64
+}
+
+func (r *batchRecorder) AddBatch(batch *common.ExtBatch) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.stored = append(r.stored, batch.Header.SequencerOrderNo.Uint64())
+	return nil
+}
+
+func (r *batchRecorder) BroadcastBatches(batches []*common.ExtBatch) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, batch := range batches {
+		r.broadcasted = append(r.broadcasted, batch.Header.SequencerOrderNo.Uint64())
+	}
+	return nil
+}
+
+func (r *batchRecorder) recorded() ([]uint64, []uint64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]uint64(nil), r.stored...), append([]uint64(nil), r.broadcasted...)
+}
+
+type recorderLocator struct {
+	guardianServiceLocator
+	recorder *batchRecorder
+}
+
+func (l *recorderLocator) P2P() host.P2P {
+	return l.recorder
+}
+
+func (l *recorderLocator) L2Repo() host.L2BatchRepository {
+	return l.recorder
+}
+
+func newSequencerGuardian(enclave common.Enclave, hostDB *db.DB) (*Guardian, *batchRecorder) {
+	recorder := &batchRecorder{}
+	logger := gethlog.New()
+	return &Guardian{
+		hostData:        host.Identity{IsSequencer: true},
+		state:           NewStateTracker(logger),
+		enclaveClient:   enclave,
+		sl:              &recorderLocator{recorder: recorder},
+		db:              hostDB,
+		hostInterrupter: stopcontrol.New(),
+		logger:          logger,
+	}, recorder
+}
+
+func testBatches(from uint64, to uint64) []*common.ExtBatch {
+	var batches []*common.ExtBatch
+	for seqNo := from; seqNo <= to; seqNo++ {
+		batches = append(batches, &common.ExtBatch{Header: &common.BatchHeader{
+			Number:           new(big.Int).SetUint64(seqNo),
+			SequencerOrderNo: new(big.Int).SetUint64(seqNo),
+		}})
+	}
+	return batches
+}
+
+func TestMissedBatchesAreFetchedAfterTheLastOne(t *testing.T) {
+	enclave := &streamedEnclave{batches: testBatches(1, 5)}
+	g, recorder := newSequencerGuardian(enclave, nil)
+
+	require.Equal(t, uint64(5), g.fetchMissedBatches(2))
+	stored, broadcasted := recorder.recorded()
+	require.Equal(t, []uint64{3, 4, 5}, stored)
+	require.Equal(t, []uint64{3, 4, 5}, broadcasted)
+
+	// from 0, the batches are fetched from the genesis
+	g, recorder = newSequencerGuardian(enclave, nil)
+	require.Equal(t, uint64(5), g.fetchMissedBatches(0))
+	stored, _ = recorder.recorded()
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, stored)
+
+	// nothing was missed
+	require.Equal(t, uint64(5), g.fetchMissedBatches(5))
+}
+
+func TestARestartedHostResumesTheStreamWithoutDuplicatedBatches(t *testing.T) {
+	// the host crashed after storing batch 2, while the enclave produced batches up to 4
+	hostDB := db.NewInMemoryDB(nil, gethlog.New())
+	for _, batch := range testBatches(1, 2) {
+		require.NoError(t, hostDB.AddBatch(batch))
+	}
+
+	// the first stream carries batches the host already fetched, and breaks. Batch 6 is produced while it is down, and
+	// the next stream carries it too
+	first := make(chan common.StreamL2UpdatesResponse, 3)
+	second := make(chan common.StreamL2UpdatesResponse, 2)
+	for _, batch := range testBatches(3, 5) {
+		first <- common.StreamL2UpdatesResponse{Batch: batch}
+	}
+	close(first)
+	for _, batch := range testBatches(6, 7) {
+		second <- common.StreamL2UpdatesResponse{Batch: batch}
+	}
+	enclave := &streamedEnclave{
+		batches: testBatches(1, 4),
+		streams: []enclaveStream{{updates: first}, {produced: testBatches(6, 6), updates: second}},
+	}
+
+	g, recorder := newSequencerGuardian(enclave, hostDB)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		g.streamEnclaveData()
+	}()
+
+	// the stream is opened again after 3 seconds
+	require.Eventually(t, func() bool {
+		stored, _ := recorder.recorded()
+		return len(stored) == 5
+	}, 10*time.Second, 10*time.Millisecond)
+	g.hostInterrupter.Stop()
+	<-done
+
+	stored, broadcasted := recorder.recorded()
+	require.Equal(t, []uint64{3, 4, 5, 6, 7}, stored)
+	require.Equal(t, []uint64{3, 4, 5, 6, 7}, broadcasted)
+}
@@ -3,6 +3,7 @@ package enclave
 import (
 	"fmt"
 	"math/big"
+	"strings"
 	"sync/atomic"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
@@ -56,19 +57,37 @@ func (e *Service) HealthStatus() host.HealthStatus {
 	}
 
 	// check the enclave health, which in turn checks the DB health
-	enclaveHealthy, err := e.enclaveGuardian.enclaveClient.HealthCheck()
+	enclaveHealth, err := e.enclaveGuardian.enclaveClient.HealthCheck()
 	if err != nil {
 		return &host.BasicErrHealthStatus{ErrMsg: fmt.Sprintf("unable to HealthCheck enclave - %s", err.Error())}
-	} else if !enclaveHealthy {
-		return &host.BasicErrHealthStatus{ErrMsg: "enclave reported itself as not healthy"}
 	}
-
-	if !e.enclaveGuardian.GetEnclaveState().InSyncWithL1() {
-		return &host.BasicErrHealthStatus{ErrMsg: "enclave not in sync with L1"}
+	status := &host.EnclaveHealthStatus{Enclave: enclaveHealth}
+	// the occupancy of the mempool is only informative, so the health does not depend on it
+	enclaveStatus, err := e.enclaveGuardian.enclaveClient.Status()
+	if err == nil {
+		status.TxPool = &enclaveStatus.TxPool
+		status.Replay = enclaveStatus.Replay
+	}
+	if !enclaveHealth.OverallHealth {
+		unhealthy := make([]string, 0)
+		for _, component := range enclaveHealth.Components {
+			if !component.Healthy {
+				unhealthy = append(unhealthy, fmt.Sprintf("%s: %s", component.Name, component.Error))
+			}
+		}
+		status.ErrMsg = fmt.Sprintf("enclave reported itself as not healthy [%s]", strings.Join(unhealthy, ", "))
+	} else if replay := status.Replay; replay != nil && replay.Error != "" {
+		status.ErrMsg = fmt.Sprintf("enclave failed to replay the batches whose state was lost - %s", replay.Error)
+	} else if replay != nil && replay.InProgress {
+		// still making progress, unlike an enclave which is stuck
+		status.ErrMsg = fmt.Sprintf("enclave recovering - replayed %d of %d batches, at seq no %d of %d",
+			replay.Replayed, replay.Total, replay.CurrentSeqNo, replay.TargetSeqNo)
+	} else if !e.enclaveGuardian.GetEnclaveState().InSyncWithL1() {
+		status.ErrMsg = "enclave not in sync with L1"
 	}
 
 	// empty error msg means healthy
-	return &host.BasicErrHealthStatus{ErrMsg: ""}
+	return status
 }
 
 // LookupBatchBySeqNo is used to fetch batch data from the enclave - it is only used as a fallback for the sequencer
@@ -89,18 +108,20 @@ func (e *Service) GetEnclaveClient() common.Enclave {
 func (e *Service) SubmitAndBroadcastTx(encryptedParams common.EncryptedParamsSendRawTx) (*responses.RawTx, error) {
 	encryptedTx := common.EncryptedTx(encryptedParams)
 
-	enclaveResponse, sysError := e.enclaveGuardian.GetEnclaveClient().SubmitTx(encryptedTx)
+	submitResponse, sysError := e.enclaveGuardian.GetEnclaveClient().SubmitTx(encryptedTx)
 	if sysError != nil {
 		e.logger.Warn("Could not submit transaction due to sysError.", log.ErrKey, sysError)
 		return nil, sysError
 	}
+	enclaveResponse := submitResponse.Response
 	if enclaveResponse.Error() != nil {
 		e.logger.Trace("Could not submit transaction.", log.ErrKey, enclaveResponse.Error())
 		return enclaveResponse, nil //nolint: nilerr
 	}
 
-	if !e.hostData.IsSequencer {
-		err := e.sl.P2P().SendTxToSequencer(encryptedTx)
+	// the validator enclaves don't include transactions in batches, so they hand them over to be relayed to the sequencer
+	if !e.hostData.IsSequencer && submitResponse.NeedsForwarding() {
+		err := e.sl.P2P().SendTxToSequencer(submitResponse.ForwardTx)
 		if err != nil {
 			return nil, fmt.Errorf("could not broadcast transaction to sequencer. Cause: %w", err)
 		}
@@ -113,6 +134,10 @@ func (e *Service) Subscribe(id rpc.ID, encryptedParams common.EncryptedParamsLog
 	return e.enclaveGuardian.GetEnclaveClient().Subscribe(id, encryptedParams)
 }
 
+func (e *Service) SubscribeNewHeads(id rpc.ID) error {
+	return e.enclaveGuardian.GetEnclaveClient().SubscribeNewHeads(id)
+}
+
 func (e *Service) Unsubscribe(id rpc.ID) error {
 	return e.enclaveGuardian.GetEnclaveClient().Unsubscribe(id)
 }
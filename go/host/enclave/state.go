@@ -38,13 +38,15 @@ const (
 	L1Catchup
 	// L2Catchup - enclave is behind on L2 data, host should request and submit L2 batches to catch up
 	L2Catchup
+	// Recovering - enclave is replaying the batches whose state was lost, the host waits for it to complete
+	Recovering
 )
 
 // when the L2 head is 0 then it means no batch has been seen or processed (first seq number is always 1)
 var _noBatch = big.NewInt(0)
 
 func (es Status) String() string {
-	return [...]string{"Live", "Disconnected", "Unavailable", "AwaitingSecret", "L1Catchup", "L2Catchup"}[es]
+	return [...]string{"Live", "Disconnected", "Unavailable", "AwaitingSecret", "L1Catchup", "L2Catchup", "Recovering"}[es]
 }
 
 // StateTracker is the state machine for the enclave
@@ -54,25 +56,31 @@ type StateTracker struct {
 	status Status
 
 	// enclave states (updated when enclave returns Status and optimistically after successful actions)
-	enclaveStatusCode common.StatusCode // this is the status code reported by the enclave (Running/AwaitingSecret/Unavailable)
+	enclaveStatusCode common.StatusCode // this is the status code reported by the enclave (Running/AwaitingSecret/Unavailable/Recovering)
 	enclaveL1Head     gethcommon.Hash
 	enclaveL2Head     *big.Int
+	// the last batch executed by the enclave, which trails enclaveL2Head while the enclave catches up
+	enclaveL2ExecutedHead *big.Int
 
 	// latest seen heads of L1 and L2 chains from external sources
 	hostL1Head gethcommon.Hash
 	hostL2Head *big.Int
 
+	// closed when the enclave reports a head batch, the rollups cannot be created before
+	enclaveReady     chan struct{}
+	enclaveReadyOnce sync.Once
+
 	m      *sync.RWMutex
 	logger gethlog.Logger
 }
 
 func NewStateTracker(logger gethlog.Logger) *StateTracker {
-	return &StateTracker{status: Disconnected, m: &sync.RWMutex{}, logger: logger}
+	return &StateTracker{status: Disconnected, enclaveReady: make(chan struct{}), m: &sync.RWMutex{}, logger: logger}
 }
 
 func (s *StateTracker) String() string {
-	return fmt.Sprintf("StateTracker: [%s] enclave(StatusCode=%d, L1Head=%s, L2Head=%s), Host(L1Head=%s, L2Head=%s)",
-		s.status, s.enclaveStatusCode, s.enclaveL1Head, s.enclaveL2Head, s.hostL1Head, s.hostL2Head)
+	return fmt.Sprintf("StateTracker: [%s] enclave(StatusCode=%d, L1Head=%s, L2Head=%s, L2ExecutedHead=%s), Host(L1Head=%s, L2Head=%s)",
+		s.status, s.enclaveStatusCode, s.enclaveL1Head, s.enclaveL2Head, s.enclaveL2ExecutedHead, s.hostL1Head, s.hostL2Head)
 }
 
 func (s *StateTracker) GetStatus() Status {
@@ -128,10 +136,27 @@ func (s *StateTracker) OnEnclaveStatus(es common.Status) {
 	s.enclaveStatusCode = es.StatusCode
 	s.enclaveL1Head = es.L1Head
 	s.enclaveL2Head = es.L2Head
+	s.enclaveL2ExecutedHead = es.L2ExecutedHead
+	if es.Ready {
+		s.OnEnclaveReady()
+	}
 
 	s.setStatus(s.calculateStatus())
 }
 
+// OnEnclaveReady is called when the enclave is known to have a head batch
+func (s *StateTracker) OnEnclaveReady() {
+	s.enclaveReadyOnce.Do(func() {
+		s.logger.Info("Enclave is ready")
+		close(s.enclaveReady)
+	})
+}
+
+// EnclaveReady - the returned channel is closed when the enclave has a head batch
+func (s *StateTracker) EnclaveReady() <-chan struct{} {
+	return s.enclaveReady
+}
+
 // OnDisconnected is called if the enclave is unreachable/not returning a valid Status
 func (s *StateTracker) OnDisconnected() {
 	s.m.Lock()
@@ -146,6 +171,8 @@ func (s *StateTracker) calculateStatus() Status {
 		return AwaitingSecret
 	case common.Unavailable:
 		return Unavailable
+	case common.Recovering:
+		return Recovering
 	case common.Running:
 		if s.hostL1Head != s.enclaveL1Head || s.enclaveL1Head == gethutil.EmptyHash {
 			return L1Catchup
@@ -178,6 +205,16 @@ func (s *StateTracker) GetEnclaveL1Head() gethcommon.Hash {
 	return s.enclaveL1Head
 }
 
+// GetHostL2Head - the seq no of the latest batch the host received
+func (s *StateTracker) GetHostL2Head() *big.Int {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.hostL2Head == nil {
+		return nil
+	}
+	return big.NewInt(0).SetBytes(s.hostL2Head.Bytes())
+}
+
 func (s *StateTracker) GetEnclaveL2Head() *big.Int {
 	s.m.RLock()
 	defer s.m.RUnlock()
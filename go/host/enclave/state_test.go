@@ -7,6 +7,7 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/assert"
+	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/log"
 )
 
@@ -73,3 +74,16 @@ func TestStateTracker_Disconnected(t *testing.T) {
 	s.OnDisconnected()
 	assert.Equal(t, Disconnected, s.GetStatus())
 }
+
+func TestStateTracker_Recovering(t *testing.T) {
+	s := NewStateTracker(stateTrackerLogger)
+	s.OnReceivedBlock(_l1Block123)
+	s.OnReceivedBatch(_l2Batch456)
+	// the enclave replays the batches whose state was lost, so it cannot catch up yet
+	s.OnEnclaveStatus(common.Status{StatusCode: common.Recovering, L1Head: _l1Block123, L2Head: _l2Batch456})
+	assert.Equal(t, Recovering, s.GetStatus())
+	assert.False(t, s.InSyncWithL1())
+
+	s.OnEnclaveStatus(common.Status{StatusCode: common.Running, L1Head: _l1Block123, L2Head: _l2Batch456})
+	assert.Equal(t, Live, s.GetStatus())
+}
@@ -59,6 +59,19 @@ func (l *LogEventManager) Subscribe(id rpc.ID, encryptedLogSubscription common.E
 	return nil
 }
 
+func (l *LogEventManager) SubscribeNewHeads(id rpc.ID, headsCh chan []byte) error {
+	err := l.sl.Enclaves().SubscribeNewHeads(id)
+	if err != nil {
+		return errors.Wrap(err, "could not create newHeads subscription with enclave")
+	}
+	l.subscriptionMutex.Lock()
+	defer l.subscriptionMutex.Unlock()
+
+	// the heads are routed like the logs, by subscription ID
+	l.subscriptions[id] = &subscription{ch: headsCh}
+	return nil
+}
+
 func (l *LogEventManager) Unsubscribe(id rpc.ID) {
 	enclaveUnsubErr := l.sl.Enclaves().Unsubscribe(id)
 	if enclaveUnsubErr != nil {
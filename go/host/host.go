@@ -3,6 +3,9 @@ package host
 import (
 	"encoding/json"
 	"fmt"
+	"os"
+	"strconv"
+	"sync/atomic"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 
@@ -47,6 +50,11 @@ type host struct {
 
 	// l2MessageBusAddress is fetched from the enclave but cache it here because it never changes
 	l2MessageBusAddress *gethcommon.Address
+	// networkInfo is the last one served by the enclave. It is refreshed until the enclave knows the genesis batch,
+	// because it then never changes
+	networkInfo atomic.Pointer[common.NetworkInfo]
+	// fetchingNetworkInfo is set while a refresh of networkInfo is in flight
+	fetchingNetworkInfo atomic.Bool
 }
 
 func NewHost(config *config.HostConfig, hostServices *ServicesRegistry, p2p hostcommon.P2PHostService, ethClient ethadapter.EthClient, l1Repo hostcommon.L1RepoService, enclaveClient common.Enclave, ethWallet wallet.Wallet, mgmtContractLib mgmtcontractlib.MgmtContractLib, logger gethlog.Logger, regMetrics gethmetrics.Registry) hostcommon.Host {
@@ -153,6 +161,13 @@ func (h *host) Subscribe(id rpc.ID, encryptedLogSubscription common.EncryptedPar
 	return h.services.LogSubs().Subscribe(id, encryptedLogSubscription, matchedLogsCh)
 }
 
+func (h *host) SubscribeNewHeads(id rpc.ID, headsCh chan []byte) error {
+	if h.stopControl.IsStopping() {
+		return responses.ToInternalError(fmt.Errorf("requested SubscribeNewHeads with the host stopping"))
+	}
+	return h.services.LogSubs().SubscribeNewHeads(id, headsCh)
+}
+
 func (h *host) Unsubscribe(id rpc.ID) {
 	if h.stopControl.IsStopping() {
 		h.logger.Debug("requested Subscribe with the host stopping")
@@ -188,6 +203,9 @@ func (h *host) HealthCheck() (*hostcommon.HealthCheck, error) {
 	}
 
 	healthErrors := make([]string, 0)
+	var enclaveHealth *common.HealthStatus
+	var txPool *common.TxPoolStatus
+	var replay *common.ReplayStatus
 
 	// loop through all registered services and collect their health statuses
 	for name, service := range h.services.All() {
@@ -195,11 +213,20 @@ func (h *host) HealthCheck() (*hostcommon.HealthCheck, error) {
 		if !status.OK() {
 			healthErrors = append(healthErrors, fmt.Sprintf("[%s] not healthy - %s", name, status.Message()))
 		}
+		// the enclave service passes through the health of each enclave component
+		if enclaveStatus, ok := status.(*hostcommon.EnclaveHealthStatus); ok {
+			enclaveHealth = enclaveStatus.Enclave
+			txPool = enclaveStatus.TxPool
+			replay = enclaveStatus.Replay
+		}
 	}
 
 	return &hostcommon.HealthCheck{
 		OverallHealth: len(healthErrors) == 0,
 		Errors:        healthErrors,
+		Enclave:       enclaveHealth,
+		TxPool:        txPool,
+		Replay:        replay,
 	}, nil
 }
 
@@ -223,6 +250,72 @@ func (h *host) ObscuroConfig() (*common.ObscuroNetworkInfo, error) {
 	}, nil
 }
 
+// NetworkInfo - answers immediately, so the wallets can always detect the chain. Until the enclave has served it, or
+// when the enclave is unreachable or too old, the host falls back to its own config, and the enclave is asked in the
+// background
+func (h *host) NetworkInfo() *common.NetworkInfo {
+	info := h.networkInfo.Load()
+	if (info == nil || info.GenesisBatchHash == common.L2BatchHash{}) && h.fetchingNetworkInfo.CompareAndSwap(false, true) {
+		go h.fetchNetworkInfo()
+	}
+	if info != nil {
+		return info
+	}
+	return &common.NetworkInfo{
+		ChainID:        h.config.ObscuroChainID,
+		NetworkVersion: strconv.FormatInt(h.config.ObscuroChainID, 10),
+	}
+}
+
+func (h *host) fetchNetworkInfo() {
+	defer h.fetchingNetworkInfo.Store(false)
+	if h.stopControl.IsStopping() {
+		return
+	}
+	info, err := h.EnclaveClient().GetNetworkInfo()
+	if err != nil {
+		h.logger.Debug("Could not get the network info from the enclave, using the host config", log.ErrKey, err)
+		return
+	}
+	if info.ChainID != h.config.ObscuroChainID {
+		h.logger.Warn("The chain ID of the enclave differs from the one of the host", "enclaveChainID", info.ChainID,
+			"hostChainID", h.config.ObscuroChainID)
+	}
+	h.networkInfo.Store(info)
+}
+
+// ExportStateSnapshot - the snapshot is encrypted for the latest attestation published on L1 by the requester, which is
+// the one its enclave holds the key of
+func (h *host) ExportStateSnapshot(rollupHash common.L2RollupHash, requesterID gethcommon.Address, path string) (*common.StateSnapshotInfo, error) {
+	attestations, err := h.services.L1Publisher().FindAttestations(h.config.L1StartHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not find the attestations published on L1. Cause: %w", err)
+	}
+	var requester *common.AttestationReport
+	for _, attestation := range attestations {
+		if attestation.Owner == requesterID {
+			requester = attestation
+		}
+	}
+	if requester == nil {
+		return nil, fmt.Errorf("no attestation of %s was published on L1", requesterID)
+	}
+	snapshot, sysErr := h.EnclaveClient().ExportStateSnapshot(rollupHash, requester)
+	if sysErr != nil {
+		return nil, fmt.Errorf("could not export the state snapshot. Cause: %w", sysErr)
+	}
+	encoded, err := common.EncodeStateSnapshot(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode the state snapshot. Cause: %w", err)
+	}
+	if err = os.WriteFile(path, encoded, 0o600); err != nil {
+		return nil, fmt.Errorf("could not write the state snapshot. Cause: %w", err)
+	}
+	h.logger.Info("Exported the state snapshot", log.RollupHashKey, rollupHash, log.BatchSeqNoKey, snapshot.Info.SeqNo,
+		"requester", requesterID)
+	return &snapshot.Info, nil
+}
+
 // Checks the host config is valid.
 func (h *host) validateConfig() {
 	if h.config.IsGenesis && h.config.NodeType != common.Sequencer {
@@ -0,0 +1,97 @@
+package host
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	hostcommon "github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
+	"github.com/ten-protocol/go-ten/go/config"
+)
+
+// networkInfoEnclave - serves the network info once released
+type networkInfoEnclave struct {
+	common.Enclave
+	release chan struct{}
+	info    atomic.Pointer[common.NetworkInfo]
+	calls   atomic.Int32
+}
+
+func (e *networkInfoEnclave) GetNetworkInfo() (*common.NetworkInfo, common.SystemError) {
+	e.calls.Add(1)
+	<-e.release
+	info := e.info.Load()
+	if info == nil {
+		return nil, errors.New("the enclave is unreachable")
+	}
+	return info, nil
+}
+
+type enclaveService struct {
+	hostcommon.Service
+	hostcommon.EnclaveService
+	client common.Enclave
+}
+
+func (s *enclaveService) GetEnclaveClient() common.Enclave {
+	return s.client
+}
+
+func newNetworkInfoHost(enclave common.Enclave) *host {
+	services := NewServicesRegistry(gethlog.New())
+	services.RegisterService(hostcommon.EnclaveServiceName, &enclaveService{client: enclave})
+	return &host{
+		config:      &config.HostConfig{ObscuroChainID: 443},
+		services:    services,
+		stopControl: stopcontrol.New(),
+		logger:      gethlog.New(),
+	}
+}
+
+func TestNetworkInfoFallsBackToTheHostConfig(t *testing.T) {
+	enclave := &networkInfoEnclave{release: make(chan struct{})}
+	h := newNetworkInfoHost(enclave)
+	hostInfo := &common.NetworkInfo{ChainID: 443, NetworkVersion: "443"}
+
+	// the enclave does not answer, but the host does
+	require.Equal(t, hostInfo, h.NetworkInfo())
+	require.Eventually(t, func() bool { return enclave.calls.Load() == 1 }, time.Second, time.Millisecond)
+	// only one request to the enclave is in flight
+	require.Equal(t, hostInfo, h.NetworkInfo())
+	require.Equal(t, int32(1), enclave.calls.Load())
+
+	// the enclave is unreachable, so the host keeps answering from its config and asks again
+	close(enclave.release)
+	require.Eventually(t, func() bool { return !h.fetchingNetworkInfo.Load() }, time.Second, time.Millisecond)
+	require.Equal(t, hostInfo, h.NetworkInfo())
+	require.Eventually(t, func() bool { return enclave.calls.Load() == 2 }, time.Second, time.Millisecond)
+}
+
+func TestNetworkInfoOfTheEnclaveIsCachedOnceItKnowsTheGenesis(t *testing.T) {
+	enclave := &networkInfoEnclave{release: make(chan struct{})}
+	enclave.info.Store(&common.NetworkInfo{ChainID: 443, NetworkVersion: "443", ClientVersion: "v1"})
+	close(enclave.release)
+	h := newNetworkInfoHost(enclave)
+
+	require.Eventually(t, func() bool { return h.NetworkInfo().ClientVersion == "v1" }, time.Second, time.Millisecond)
+
+	// without the genesis, the enclave is asked again
+	calls := enclave.calls.Load()
+	require.Eventually(t, func() bool { h.NetworkInfo(); return enclave.calls.Load() > calls }, time.Second, time.Millisecond)
+
+	genesis := common.L2BatchHash{1}
+	enclave.info.Store(&common.NetworkInfo{ChainID: 443, NetworkVersion: "443", ClientVersion: "v1", GenesisBatchHash: genesis})
+	require.Eventually(t, func() bool { return h.NetworkInfo().GenesisBatchHash == genesis }, time.Second, time.Millisecond)
+	require.Eventually(t, func() bool { return !h.fetchingNetworkInfo.Load() }, time.Second, time.Millisecond)
+
+	// from then on, the cached info is served
+	calls = enclave.calls.Load()
+	require.Equal(t, genesis, h.NetworkInfo().GenesisBatchHash)
+	require.False(t, h.fetchingNetworkInfo.Load())
+	require.Equal(t, calls, enclave.calls.Load())
+}
@@ -38,13 +38,16 @@ type Repository struct {
 	running                  atomic.Bool
 	head                     gethcommon.Hash
 	obscuroRelevantContracts []gethcommon.Address
+	// when set, the receipts of all transactions are fetched, so the enclave can verify them against the receipts root
+	fullReceipts bool
 }
 
-func NewL1Repository(ethClient ethadapter.EthClient, obscuroRelevantContracts []gethcommon.Address, logger gethlog.Logger) *Repository {
+func NewL1Repository(ethClient ethadapter.EthClient, obscuroRelevantContracts []gethcommon.Address, fullReceipts bool, logger gethlog.Logger) *Repository {
 	return &Repository{
 		blockSubscribers:         subscription.NewManager[host.L1BlockHandler](),
 		ethClient:                ethClient,
 		obscuroRelevantContracts: obscuroRelevantContracts,
+		fullReceipts:             fullReceipts,
 		running:                  atomic.Bool{},
 		logger:                   logger,
 	}
@@ -127,7 +130,8 @@ func (r *Repository) latestCanonAncestor(blkHash gethcommon.Hash) (*types.Block,
 	return blk, nil
 }
 
-// FetchObscuroReceipts returns all obscuro-relevant receipts for an L1 block
+// FetchObscuroReceipts returns all obscuro-relevant receipts for an L1 block, or all the receipts of the block when the
+// enclave validates the blocks
 func (r *Repository) FetchObscuroReceipts(block *common.L1Block) (types.Receipts, error) {
 	receipts := make([]*types.Receipt, len(block.Transactions()))
 
@@ -144,7 +148,7 @@ func (r *Repository) FetchObscuroReceipts(block *common.L1Block) (types.Receipts
 	}
 
 	for idx, transaction := range block.Transactions() {
-		if !relevantTx[transaction.Hash()] && !r.isObscuroTransaction(transaction) {
+		if !r.fullReceipts && !relevantTx[transaction.Hash()] && !r.isObscuroTransaction(transaction) {
 			// put in a dummy receipt so that the index matches the transaction index
 			// (the receipts list maintains the indexes of the transactions, it is a sparse list)
 			receipts[idx] = &types.Receipt{Status: types.ReceiptStatusFailed}
@@ -216,10 +220,28 @@ func (r *Repository) resetLiveStream() (chan *types.Header, ethereum.Subscriptio
 	return r.ethClient.BlockListener()
 }
 
+// FetchObscuroReceiptProofs returns the proofs of the obscuro-relevant receipts of an L1 block, against its receipts root
+func (r *Repository) FetchObscuroReceiptProofs(block *common.L1Block) (common.L1ReceiptProofs, error) {
+	// the proofs are built from the trie of all the receipts
+	receipts := make(types.Receipts, len(block.Transactions()))
+	for idx, transaction := range block.Transactions() {
+		receipt, err := r.ethClient.TransactionReceipt(transaction.Hash())
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve the receipt of tx=%s - %w", transaction.Hash(), err)
+		}
+		receipts[idx] = receipt
+	}
+	return common.ProveL1Receipts(receipts, common.L1ReceiptsToProve(block, r.obscuroRelevantContracts))
+}
+
 func (r *Repository) FetchBlockByHeight(height *big.Int) (*types.Block, error) {
 	return r.ethClient.BlockByNumber(height)
 }
 
+func (r *Repository) FetchBlock(hash gethcommon.Hash) (*types.Block, error) {
+	return r.ethClient.BlockByHash(hash)
+}
+
 // isObscuroTransaction will look at the 'to' address of the transaction, we are only interested in management contract and bridge transactions
 func (r *Repository) isObscuroTransaction(transaction *types.Transaction) bool {
 	for _, address := range r.obscuroRelevantContracts {
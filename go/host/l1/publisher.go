@@ -188,6 +188,50 @@ func (p *Publisher) ExtractObscuroRelevantTransactions(block *types.Block) ([]*e
 	return secretRespTxs, rollupTxs, contractAddressTxs
 }
 
+// FindAttestations - the attestations of the initialisation of the secret and of the secret requests, oldest first. The
+// blocks are fetched one by one up to the head, which is only done for the rare import of a state snapshot.
+func (p *Publisher) FindAttestations(fromBlock gethcommon.Hash) ([]*common.AttestationReport, error) {
+	var block *types.Block
+	var err error
+	if fromBlock == (gethcommon.Hash{}) {
+		block, _, err = p.repository.FetchNextBlock(fromBlock)
+	} else {
+		block, err = p.repository.FetchBlock(fromBlock)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the first L1 block. Cause: %w", err)
+	}
+
+	var attestations []*common.AttestationReport
+	for {
+		for _, tx := range block.Transactions() {
+			var encoded common.EncodedAttestationReport
+			switch t := p.mgmtContractLib.DecodeTx(tx).(type) {
+			case *ethadapter.L1InitializeSecretTx:
+				encoded = t.Attestation
+			case *ethadapter.L1RequestSecretTx:
+				encoded = t.Attestation
+			default:
+				continue
+			}
+			attestation, err := common.DecodeAttestation(encoded)
+			if err != nil {
+				p.logger.Warn("Could not decode the attestation of an L1 transaction", log.TxKey, tx.Hash(), log.ErrKey, err)
+				continue
+			}
+			attestations = append(attestations, attestation)
+		}
+
+		block, _, err = p.repository.FetchNextBlock(block.Hash())
+		if errors.Is(err, ErrNoNextBlock) {
+			return attestations, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch the next L1 block. Cause: %w", err)
+		}
+	}
+}
+
 func (p *Publisher) FetchLatestSeqNo() (*big.Int, error) {
 	return p.ethClient.FetchLastBatchSeqNo(*p.mgmtContractLib.GetContractAddr())
 }
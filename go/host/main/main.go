@@ -14,6 +14,6 @@ func main() {
 		panic(fmt.Errorf("could not parse config. Cause: %w", err))
 	}
 
-	hostContainer := hostcontainer.NewHostContainerFromConfig(parsedConfig, nil)
+	hostContainer := hostcontainer.NewHostContainerFromConfig(parsedConfig, nil, nil)
 	container.Serve(hostContainer)
 }
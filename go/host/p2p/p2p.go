@@ -196,7 +196,7 @@ func (p *Service) RefreshPeerList() {
 	p.peerAddresses = newPeers
 }
 
-func (p *Service) SendTxToSequencer(tx common.EncryptedTx) error {
+func (p *Service) SendTxToSequencer(tx common.EncryptedForwardedTx) error {
 	if p.isSequencer {
 		return errors.New("sequencer cannot send tx to itself")
 	}
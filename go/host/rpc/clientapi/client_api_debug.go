@@ -3,10 +3,11 @@ package clientapi
 import (
 	"context"
 
-	"github.com/ten-protocol/go-ten/go/common/host"
-	"github.com/ten-protocol/go-ten/go/common/tracers"
-
 	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/responses"
 )
 
 // NetworkDebug implements a subset of the Ethereum network JSON RPC operations.
@@ -20,21 +21,66 @@ func NewNetworkDebug(host host.Host) *NetworkDebug {
 	}
 }
 
-// TraceTransaction returns the structured logs created during the execution of EVM
-// and returns them as a JSON object.
-func (api *NetworkDebug) TraceTransaction(_ context.Context, hash gethcommon.Hash, config *tracers.TraceConfig) (interface{}, error) {
-	response, err := api.host.EnclaveClient().DebugTraceTransaction(hash, config)
-	if err != nil {
-		return "", err
+// TraceTransaction returns the trace of the execution of the transaction in the EVM, encrypted with the viewing key of
+// its sender or of the owner of the contract it calls
+func (api *NetworkDebug) TraceTransaction(_ context.Context, encryptedParams common.EncryptedParamsDebugTraceTx) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().DebugTraceTransaction(encryptedParams)
+	if sysError != nil {
+		return responses.EnclaveResponse{Err: &responses.InternalErrMsg}, nil
+	}
+	return *enclaveResponse, nil
+}
+
+// StorageRangeAt returns a page of the storage of a contract at a transaction of a batch, encrypted with the viewing
+// key of the owner of the contract
+func (api *NetworkDebug) StorageRangeAt(_ context.Context, encryptedParams common.EncryptedParamsStorageRangeAt) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().DebugStorageRangeAt(encryptedParams)
+	if sysError != nil {
+		// the enclave logs the system error, the user only learns that it was internal
+		return responses.EnclaveResponse{Err: &responses.InternalErrMsg}, nil
+	}
+	return *enclaveResponse, nil
+}
+
+// BatchLogRelevancy returns a page of the relevancy decisions for the logs of a batch, encrypted with the viewing key of
+// the owner of the contracts which emitted them
+func (api *NetworkDebug) BatchLogRelevancy(_ context.Context, encryptedParams common.EncryptedParamsBatchLogs) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().DebugBatchLogRelevancy(encryptedParams)
+	if sysError != nil {
+		return responses.EnclaveResponse{Err: &responses.InternalErrMsg}, nil
 	}
-	return response, nil
+	return *enclaveResponse, nil
+}
+
+// ExportStateSnapshot writes the state after the last batch of the rollup to a file of the host, encrypted for the
+// enclave of the validator which requested the secret, so its operator can start it from the snapshot
+func (api *NetworkDebug) ExportStateSnapshot(_ context.Context, rollupHash gethcommon.Hash, requesterID gethcommon.Address, path string) (*common.StateSnapshotInfo, error) {
+	return api.host.ExportStateSnapshot(rollupHash, requesterID, path)
+}
+
+// SetEnclaveProfilerEnabled starts or stops the profiler of the enclave, and returns where it listens
+func (api *NetworkDebug) SetEnclaveProfilerEnabled(_ context.Context, enabled bool) (*common.ProfilerStatus, error) {
+	return api.host.EnclaveClient().SetProfilerEnabled(enabled)
+}
+
+// SetEnclaveLogLevel changes the log level of a component of the enclave, and returns the levels of the components
+// which are not logged at the default level
+func (api *NetworkDebug) SetEnclaveLogLevel(_ context.Context, component string, level int) (map[string]int, error) {
+	return api.host.EnclaveClient().SetLogLevel(component, level)
+}
+
+// EnclaveConfiguration returns the sanitized configuration of the enclave of the node, so the configurations of two nodes
+// can be diffed. The enclave only serves it when its debug namespace or its config introspection is enabled
+func (api *NetworkDebug) EnclaveConfiguration(_ context.Context) (*common.EnclaveConfiguration, error) {
+	return api.host.EnclaveClient().GetEnclaveConfiguration()
 }
 
-// EventLogRelevancy returns the events for a given transactions and the revelancy params
-func (api *NetworkDebug) EventLogRelevancy(_ context.Context, hash gethcommon.Hash) (interface{}, error) {
-	response, err := api.host.EnclaveClient().DebugEventLogRelevancy(hash)
-	if err != nil {
-		return "", err
+// EventLogRelevancy returns the events for a given transactions and the revelancy params, encrypted with the viewing
+// key of its sender or of the owner of the contract it calls
+func (api *NetworkDebug) EventLogRelevancy(_ context.Context, encryptedParams common.EncryptedParamsDebugLogs) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().DebugEventLogRelevancy(encryptedParams)
+	if sysError != nil {
+		return responses.EnclaveResponse{Err: &responses.InternalErrMsg}, nil
 	}
-	return response, nil
+	return *enclaveResponse, nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/host"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/responses"
@@ -32,9 +33,9 @@ func NewEthereumAPI(host host.Host, logger gethlog.Logger) *EthereumAPI {
 	}
 }
 
-// ChainId returns the Obscuro chain ID.
+// ChainId returns the Obscuro chain ID. It is served before the enclave is provisioned.
 func (api *EthereumAPI) ChainId() (*hexutil.Big, error) { //nolint:stylecheck,revive
-	return (*hexutil.Big)(big.NewInt(api.host.Config().ObscuroChainID)), nil
+	return (*hexutil.Big)(big.NewInt(api.host.NetworkInfo().ChainID)), nil
 }
 
 // BlockNumber returns the height of the current head batch.
@@ -57,13 +58,24 @@ func (api *EthereumAPI) GetBlockByNumber(ctx context.Context, number rpc.BlockNu
 	return api.GetBlockByHash(ctx, *batchHash, true)
 }
 
-// GetBlockByHash returns the header of the batch with the given hash.
+// GetBlockByHash returns the header of the batch with the given hash. The hash is either the hash of the batch, or the
+// block hash seen by the standard tooling, i.e. the hash of the Ethereum header the batch is converted to.
 func (api *EthereumAPI) GetBlockByHash(_ context.Context, hash gethcommon.Hash, _ bool) (*common.BatchHeader, error) {
 	batchHeader, err := api.host.DB().GetBatchHeader(hash)
-	if err != nil {
+	if err == nil || !errors.Is(err, errutil.ErrNotFound) {
+		return batchHeader, err
+	}
+
+	// only the enclave knows the converted hashes
+	hashes, sysError := api.host.EnclaveClient().ConvertBatchHash(hash)
+	if sysError != nil {
+		api.logger.Error(fmt.Sprintf("Enclave System Error. Function %s", "GetBlockByHash"), log.ErrKey, sysError)
+		return nil, fmt.Errorf(responses.InternalErrMsg)
+	}
+	if hashes == nil {
 		return nil, err
 	}
-	return batchHeader, nil
+	return api.host.DB().GetBatchHeader(hashes.BatchHash)
 }
 
 // GasPrice is a placeholder for an RPC method required by MetaMask/Remix.
@@ -92,8 +104,8 @@ func (api *EthereumAPI) GetBalance(_ context.Context, encryptedParams common.Enc
 
 // Call returns the result of executing the smart contract as a user, encrypted with the viewing key corresponding to
 // the `from` field and encoded as hex.
-func (api *EthereumAPI) Call(_ context.Context, encryptedParams common.EncryptedParamsCall) (responses.EnclaveResponse, error) {
-	enclaveResponse, sysError := api.host.EnclaveClient().ObsCall(encryptedParams)
+func (api *EthereumAPI) Call(ctx context.Context, encryptedParams common.EncryptedParamsCall) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().ObsCall(ctx, encryptedParams)
 	if sysError != nil {
 		return api.handleSysError("Call", sysError)
 	}
@@ -102,8 +114,8 @@ func (api *EthereumAPI) Call(_ context.Context, encryptedParams common.Encrypted
 
 // GetTransactionReceipt returns the transaction receipt for the given transaction hash, encrypted with the viewing key
 // corresponding to the original transaction submitter and encoded as hex, or nil if no matching transaction exists.
-func (api *EthereumAPI) GetTransactionReceipt(_ context.Context, encryptedParams common.EncryptedParamsGetTxReceipt) (responses.EnclaveResponse, error) {
-	enclaveResponse, sysError := api.host.EnclaveClient().GetTransactionReceipt(encryptedParams)
+func (api *EthereumAPI) GetTransactionReceipt(ctx context.Context, encryptedParams common.EncryptedParamsGetTxReceipt) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().GetTransactionReceipt(ctx, encryptedParams)
 	if sysError != nil {
 		return api.handleSysError("GetTransactionReceipt", sysError)
 	}
@@ -244,7 +256,5 @@ func (api *EthereumAPI) batchNumberToBatchHash(batchNumber rpc.BlockNumber) (*ge
 
 func (api *EthereumAPI) handleSysError(function string, sysError common.SystemError) (responses.EnclaveResponse, error) {
 	api.logger.Error(fmt.Sprintf("Enclave System Error. Function %s", function), log.ErrKey, sysError)
-	return responses.EnclaveResponse{
-		Err: &responses.InternalErrMsg,
-	}, nil
+	return *responses.AsSystemErr(), nil
 }
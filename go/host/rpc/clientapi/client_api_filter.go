@@ -2,6 +2,7 @@ package clientapi
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync/atomic"
 	"time"
@@ -97,6 +98,39 @@ func (api *FilterAPI) Logs(ctx context.Context, encryptedParams common.Encrypted
 	return subscription, nil
 }
 
+// NewHeads returns a subscription to the new head batches, delivered as the Ethereum headers they are converted to,
+// like the newHeads subscriptions of geth. The headers are public, so they are not encrypted.
+func (api *FilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, fmt.Errorf("creation of subscriptions is not supported")
+	}
+	subscription := notifier.CreateSubscription()
+
+	headsFromSubscription := make(chan []byte)
+	err := api.host.SubscribeNewHeads(subscription.ID, headsFromSubscription)
+	if err != nil {
+		return nil, fmt.Errorf("could not subscribe for new heads. Cause: %w", err)
+	}
+
+	go func() {
+		for jsonHeader := range headsFromSubscription {
+			err := notifier.Notify(subscription.ID, json.RawMessage(jsonHeader))
+			if err != nil {
+				api.logger.Error("could not send new head to client on subscription ", log.SubIDKey, subscription.ID)
+			}
+		}
+		api.logger.Info("subscription channel closed", log.SubIDKey, subscription.ID)
+	}()
+
+	go func() {
+		<-subscription.Err()
+		api.host.Unsubscribe(subscription.ID)
+	}()
+
+	return subscription, nil
+}
+
 // GetLogs returns the logs matching the filter.
 func (api *FilterAPI) GetLogs(_ context.Context, encryptedParams common.EncryptedParamsGetLogs) (responses.EnclaveResponse, error) {
 	enclaveResponse, sysError := api.host.EnclaveClient().GetLogs(encryptedParams)
@@ -108,7 +142,5 @@ func (api *FilterAPI) GetLogs(_ context.Context, encryptedParams common.Encrypte
 
 func (api *FilterAPI) handleSysError(function string, sysError common.SystemError) (responses.EnclaveResponse, error) {
 	api.logger.Error(fmt.Sprintf("Enclave System Error. Function %s", function), log.ErrKey, sysError)
-	return responses.EnclaveResponse{
-		Err: &responses.InternalErrMsg,
-	}, nil
+	return *responses.AsSystemErr(), nil
 }
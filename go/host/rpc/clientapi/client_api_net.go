@@ -1,8 +1,6 @@
 package clientapi
 
 import (
-	"fmt"
-
 	"github.com/ten-protocol/go-ten/go/common/host"
 )
 
@@ -19,5 +17,5 @@ func NewNetworkAPI(host host.Host) *NetworkAPI {
 
 // Version returns the protocol version of the Obscuro network.
 func (api *NetworkAPI) Version() string {
-	return fmt.Sprintf("%d", api.host.Config().ObscuroChainID)
+	return api.host.NetworkInfo().NetworkVersion
 }
@@ -2,8 +2,10 @@ package clientapi
 
 import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/responses"
 )
 
 // ObscuroAPI implements Obscuro-specific JSON RPC operations.
@@ -31,6 +33,45 @@ func (api *ObscuroAPI) Config() (*ChecksumFormattedObscuroNetworkConfig, error)
 	return checksumFormatted(config), nil
 }
 
+// EnclaveIDProof returns the ID of the enclave of the node with the signature by the enclave key of the nonce, so the
+// caller can check the enclave controls the key of its ID without an attestation
+func (api *ObscuroAPI) EnclaveIDProof(nonce hexutil.Bytes) (*common.EnclaveIDProof, error) {
+	return api.host.EnclaveClient().EnclaveIDProof(nonce)
+}
+
+// GetCrossChainMessageProof returns the merkle proof required to withdraw an outbound cross chain message on the L1,
+// encrypted with the viewing key of the message sender
+func (api *ObscuroAPI) GetCrossChainMessageProof(encryptedParams common.EncryptedParamsGetMessageProof) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().GetCrossChainMessageProof(encryptedParams)
+	if sysError != nil {
+		// the enclave logs the system error, the user only learns that it was internal
+		return *responses.AsSystemErr(), nil
+	}
+	return *enclaveResponse, nil
+}
+
+// GetCrossChainMessageStatus returns the lifecycle state of a cross chain message, encrypted with the viewing key of
+// the requester
+func (api *ObscuroAPI) GetCrossChainMessageStatus(encryptedParams common.EncryptedParamsGetMessageStatus) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().GetCrossChainMessageStatus(encryptedParams)
+	if sysError != nil {
+		// the enclave logs the system error, the user only learns that it was internal
+		return *responses.AsSystemErr(), nil
+	}
+	return *enclaveResponse, nil
+}
+
+// EstimateL1Fee returns the cost of publishing the transaction to the L1, which is part of its gas estimate, so
+// wallets can show it separately
+func (api *ObscuroAPI) EstimateL1Fee(encryptedParams common.EncryptedParamsEstimateL1Fee) (responses.EnclaveResponse, error) {
+	enclaveResponse, sysError := api.host.EnclaveClient().EstimateL1Fee(encryptedParams)
+	if sysError != nil {
+		// the enclave logs the system error, the user only learns that it was internal
+		return *responses.AsSystemErr(), nil
+	}
+	return *enclaveResponse, nil
+}
+
 // ChecksumFormattedObscuroNetworkConfig serialises the addresses as EIP55 checksum addresses.
 type ChecksumFormattedObscuroNetworkConfig struct {
 	ManagementContractAddress gethcommon.AddressEIP55
@@ -0,0 +1,27 @@
+package clientapi
+
+import (
+	"errors"
+
+	"github.com/ten-protocol/go-ten/go/common/host"
+)
+
+// Web3API implements a subset of the Ethereum web3 JSON RPC operations.
+type Web3API struct {
+	host host.Host
+}
+
+func NewWeb3API(host host.Host) *Web3API {
+	return &Web3API{
+		host: host,
+	}
+}
+
+// ClientVersion returns the version of the enclave software.
+func (api *Web3API) ClientVersion() (string, error) {
+	clientVersion := api.host.NetworkInfo().ClientVersion
+	if clientVersion == "" {
+		return "", errors.New("the client version is not available")
+	}
+	return clientVersion, nil
+}
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ten-protocol/go-ten/go/enclave/core"
@@ -13,31 +15,45 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/compression"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/measure"
 	"github.com/ten-protocol/go-ten/go/common/retry"
 	"github.com/ten-protocol/go-ten/go/common/rpc"
 	"github.com/ten-protocol/go-ten/go/common/rpc/generated"
 	"github.com/ten-protocol/go-ten/go/common/syserr"
-	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/common/tracing"
 	"github.com/ten-protocol/go-ten/go/config"
 	"github.com/ten-protocol/go-ten/go/responses"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 )
 
+// stateSnapshotTimeout - the time the export or the import of a state snapshot can take. The enclave walks or writes the
+// whole state, so it is far longer than the timeout of the other calls.
+const stateSnapshotTimeout = 30 * time.Minute
+
 // Client implements enclave.Enclave and should be used by the host when communicating with the enclave via RPC.
 type Client struct {
 	protoClient generated.EnclaveProtoClient
 	connection  *grpc.ClientConn
 	config      *config.HostConfig
 	logger      gethlog.Logger
+	// decompresses the streamed updates the enclave compressed
+	dataCompression compression.DataCompressionService
+
+	// apiVersion - the version negotiated with the enclave, nil until the enclave answered
+	apiVersion      *common.EnclaveAPIVersion
+	apiVersionMutex sync.Mutex
 }
 
 func NewClient(config *config.HostConfig, logger gethlog.Logger) common.Enclave {
@@ -67,12 +83,19 @@ func NewClient(config *config.HostConfig, logger gethlog.Logger) common.Enclave
 		logger.Crit("failed to connect to enclave", log.ErrKey, err)
 	}
 
-	return &Client{
+	client := &Client{
 		protoClient: generated.NewEnclaveProtoClient(connection),
 		connection:  connection,
 		config:      config,
 		logger:      logger,
+
+		dataCompression: compression.NewBrotliDataCompressionService(),
+	}
+	if _, err = client.negotiatedAPIVersion(); err != nil {
+		// the negotiation is attempted again before the next call which depends on it
+		logger.Warn("Could not negotiate the enclave API version", log.ErrKey, err)
 	}
+	return client
 }
 
 func (c *Client) StopClient() common.SystemError {
@@ -80,6 +103,63 @@ func (c *Client) StopClient() common.SystemError {
 	return c.connection.Close()
 }
 
+func (c *Client) GetEnclaveAPIVersion() (*common.EnclaveAPIVersion, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetEnclaveAPIVersion(timeoutCtx, &generated.EmptyArgs{})
+	if err != nil {
+		// the enclaves which predate the negotiation do not implement it
+		if status.Code(err) == codes.Unimplemented {
+			version := common.LegacyEnclaveAPIVersion
+			return &version, nil
+		}
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return &common.EnclaveAPIVersion{
+		Major:    response.Major,
+		Minor:    response.Minor,
+		Patch:    response.Patch,
+		Features: common.EnclaveFeatures(response.Features),
+	}, nil
+}
+
+// negotiatedAPIVersion - the version of the enclave, which is queried only until the enclave answers
+func (c *Client) negotiatedAPIVersion() (*common.EnclaveAPIVersion, error) {
+	c.apiVersionMutex.Lock()
+	defer c.apiVersionMutex.Unlock()
+	if c.apiVersion != nil {
+		return c.apiVersion, nil
+	}
+
+	version, err := c.GetEnclaveAPIVersion()
+	if err != nil {
+		return nil, err
+	}
+	if !common.CurrentEnclaveAPIVersion.CompatibleWith(*version) {
+		c.logger.Error("The enclave API version is not compatible with the host", "enclaveVersion", version, "hostVersion", common.CurrentEnclaveAPIVersion)
+	} else {
+		c.logger.Info("Negotiated the enclave API version", "enclaveVersion", version)
+	}
+	c.apiVersion = version
+	return version, nil
+}
+
+// requireFeature - rejects the calls the enclave does not support with a clear error, rather than a decoding failure
+func (c *Client) requireFeature(feature common.EnclaveFeatures) common.SystemError {
+	version, err := c.negotiatedAPIVersion()
+	if err != nil {
+		return syserr.NewInternalError(fmt.Errorf("could not negotiate the enclave API version. Cause: %w", err))
+	}
+	if !common.CurrentEnclaveAPIVersion.CompatibleWith(*version) || !version.Supports(feature) {
+		return syserr.NewInternalError(fmt.Errorf("%w: %s is not available with the enclave API version %s", common.ErrUnsupportedEnclaveFeature, feature, version))
+	}
+	return nil
+}
+
 func (c *Client) Status() (common.Status, common.SystemError) {
 	if c.connection.GetState() != connectivity.Ready {
 		return common.Status{StatusCode: common.Unavailable}, syserr.NewInternalError(fmt.Errorf("RPC connection is not ready"))
@@ -96,11 +176,35 @@ func (c *Client) Status() (common.Status, common.SystemError) {
 		return common.Status{StatusCode: common.Unavailable}, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
 	}
 
-	return common.Status{
-		StatusCode: common.StatusCode(response.StatusCode),
-		L1Head:     gethcommon.BytesToHash(response.L1Head),
-		L2Head:     big.NewInt(0).SetBytes(response.L2Head),
-	}, nil
+	status := common.Status{
+		StatusCode:            common.StatusCode(response.StatusCode),
+		L1Head:                gethcommon.BytesToHash(response.L1Head),
+		L2Head:                big.NewInt(0).SetBytes(response.L2Head),
+		Version:               response.Version,
+		EnclaveID:             gethcommon.BytesToAddress(response.EnclaveID),
+		HasSecret:             response.HasSecret,
+		WillAttest:            response.WillAttest,
+		L2HeadHash:            gethcommon.BytesToHash(response.L2HeadHash),
+		LatestRollup:          rpc.FromRollupMetadataMsg(response.LatestRollup),
+		L2ExecutedHead:        big.NewInt(0).SetBytes(response.L2ExecutedHead),
+		BatchGasLimit:         response.BatchGasLimit,
+		BatchProductionPaused: response.BatchProductionPaused,
+		// the enclaves which predate the readiness flag are ready once they executed a batch
+		Ready:            response.Ready || len(response.L2ExecutedHead) > 0,
+		Replay:           rpc.FromReplayStatusMsg(response.Replay),
+		StateRetention:   common.StateRetentionMode(response.StateRetention),
+		OldestStateSeqNo: response.OldestStateSeqNo,
+	}
+	// the enclaves which predate the node type don't send it
+	status.NodeType, _ = common.ToNodeType(response.NodeType)
+	if response.TxPool != nil {
+		status.TxPool = common.TxPoolStatus{
+			Pending:  response.TxPool.Pending,
+			Queued:   response.TxPool.Queued,
+			Capacity: response.TxPool.Capacity,
+		}
+	}
+	return status, nil
 }
 
 func (c *Client) Attestation() (*common.AttestationReport, common.SystemError) {
@@ -160,10 +264,35 @@ func (c *Client) EnclaveID() (common.EnclaveID, common.SystemError) {
 	return common.EnclaveID(response.EnclaveID), nil
 }
 
-func (c *Client) SubmitL1Block(block types.Block, receipts types.Receipts, isLatest bool) (*common.BlockSubmissionResponse, common.SystemError) {
+func (c *Client) EnclaveIDProof(nonce []byte) (*common.EnclaveIDProof, common.SystemError) {
+	if err := c.requireFeature(common.FeatureEnclaveIDProof); err != nil {
+		return nil, err
+	}
+
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
 
+	response, err := c.protoClient.EnclaveIDProof(timeoutCtx, &generated.EnclaveIDProofRequest{Nonce: nonce})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	if response.UserError != "" {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, errors.New(response.UserError))
+	}
+	return &common.EnclaveIDProof{
+		EnclaveID: common.EnclaveID(response.EnclaveID),
+		PublicKey: response.PublicKey,
+		Signature: response.Signature,
+	}, nil
+}
+
+func (c *Client) SubmitL1Block(ctx context.Context, block types.Block, receipts types.Receipts, receiptProofs common.L1ReceiptProofs, isLatest bool) (*common.BlockSubmissionResponse, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(tracing.ToOutgoingGRPC(ctx), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
 	var buffer bytes.Buffer
 	if err := block.EncodeRLP(&buffer); err != nil {
 		return nil, fmt.Errorf("could not encode block. Cause: %w", err)
@@ -174,7 +303,20 @@ func (c *Client) SubmitL1Block(block types.Block, receipts types.Receipts, isLat
 		return nil, fmt.Errorf("could not encode receipts. Cause: %w", err)
 	}
 
-	response, err := c.protoClient.SubmitL1Block(timeoutCtx, &generated.SubmitBlockRequest{EncodedBlock: buffer.Bytes(), EncodedReceipts: serialized, IsLatest: isLatest})
+	var serializedProofs []byte
+	if receiptProofs != nil {
+		serializedProofs, err = rlp.EncodeToBytes(receiptProofs)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode receipt proofs. Cause: %w", err)
+		}
+	}
+
+	response, err := c.protoClient.SubmitL1Block(timeoutCtx, &generated.SubmitBlockRequest{
+		EncodedBlock:         buffer.Bytes(),
+		EncodedReceipts:      serialized,
+		EncodedReceiptProofs: serializedProofs,
+		IsLatest:             isLatest,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("could not submit block. Cause: %w", err)
 	}
@@ -186,7 +328,33 @@ func (c *Client) SubmitL1Block(block types.Block, receipts types.Receipts, isLat
 	return blockSubmissionResponse, nil
 }
 
-func (c *Client) SubmitTx(tx common.EncryptedTx) (*responses.RawTx, common.SystemError) {
+func (c *Client) SubmitL1Headers(headers []*types.Header) (*common.L1ForkPoint, common.SystemError) {
+	if err := c.requireFeature(common.FeatureL1HeaderPreSubmission); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	encoded, err := rlp.EncodeToBytes(headers)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode headers. Cause: %w", err)
+	}
+
+	response, err := c.protoClient.SubmitL1Headers(timeoutCtx, &generated.SubmitL1HeadersRequest{EncodedHeaders: encoded})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	if response.Error != nil {
+		return nil, rpc.FromBlockSubmissionErrorMsg(response.Error)
+	}
+	return rpc.FromL1ForkPointMsg(response.ForkPoint), nil
+}
+
+func (c *Client) SubmitTx(tx common.EncryptedTx) (*common.SubmitTxResponse, common.SystemError) {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
 
@@ -198,18 +366,17 @@ func (c *Client) SubmitTx(tx common.EncryptedTx) (*responses.RawTx, common.Syste
 		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
 	}
 
-	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
+	return &common.SubmitTxResponse{
+		Response:  responses.ToEnclaveResponse(response.EncodedEnclaveResponse),
+		ForwardTx: response.ForwardTx,
+	}, nil
 }
 
-func (c *Client) SubmitBatch(batch *common.ExtBatch) common.SystemError {
-	defer core.LogMethodDuration(c.logger, measure.NewStopwatch(), "SubmitBatch rpc call")
-
+func (c *Client) SubmitForwardedTx(tx common.EncryptedForwardedTx) common.SystemError {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
 
-	batchMsg := rpc.ToExtBatchMsg(batch)
-
-	response, err := c.protoClient.SubmitBatch(timeoutCtx, &generated.SubmitBatchRequest{Batch: &batchMsg})
+	response, err := c.protoClient.SubmitForwardedTx(timeoutCtx, &generated.SubmitForwardedTxRequest{ForwardedTx: tx})
 	if err != nil {
 		return syserr.NewRPCError(err)
 	}
@@ -219,8 +386,27 @@ func (c *Client) SubmitBatch(batch *common.ExtBatch) common.SystemError {
 	return nil
 }
 
-func (c *Client) ObsCall(encryptedParams common.EncryptedParamsCall) (*responses.Call, common.SystemError) {
-	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+func (c *Client) SubmitBatch(ctx context.Context, batch *common.ExtBatch) (*common.BatchExecutionSummary, common.SystemError) {
+	defer core.LogMethodDuration(c.logger, measure.NewStopwatch(), "SubmitBatch rpc call")
+
+	timeoutCtx, cancel := context.WithTimeout(tracing.ToOutgoingGRPC(ctx), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	batchMsg := rpc.ToExtBatchMsg(batch)
+
+	response, err := c.protoClient.SubmitBatch(timeoutCtx, &generated.SubmitBatchRequest{Batch: &batchMsg})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	summary := rpc.FromBatchExecutionSummaryMsg(response.Summary)
+	if response.SystemError != nil {
+		return summary, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return summary, nil
+}
+
+func (c *Client) ObsCall(ctx context.Context, encryptedParams common.EncryptedParamsCall) (*responses.Call, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(tracing.ToOutgoingGRPC(ctx), c.config.EnclaveRPCTimeout)
 	defer cancel()
 
 	response, err := c.protoClient.ObsCall(timeoutCtx, &generated.ObsCallRequest{
@@ -282,8 +468,8 @@ func (c *Client) GetTransaction(encryptedParams common.EncryptedParamsGetTxByHas
 	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
 }
 
-func (c *Client) GetTransactionReceipt(encryptedParams common.EncryptedParamsGetTxReceipt) (*responses.TxReceipt, common.SystemError) {
-	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+func (c *Client) GetTransactionReceipt(ctx context.Context, encryptedParams common.EncryptedParamsGetTxReceipt) (*responses.TxReceipt, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(tracing.ToOutgoingGRPC(ctx), c.config.EnclaveRPCTimeout)
 	defer cancel()
 
 	response, err := c.protoClient.GetTransactionReceipt(timeoutCtx, &generated.GetTransactionReceiptRequest{EncryptedParams: encryptedParams})
@@ -297,6 +483,36 @@ func (c *Client) GetTransactionReceipt(encryptedParams common.EncryptedParamsGet
 	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
 }
 
+func (c *Client) GetCrossChainMessageProof(encryptedParams common.EncryptedParamsGetMessageProof) (*responses.MessageProof, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetCrossChainMessageProof(timeoutCtx, &generated.GetCrossChainMessageProofRequest{EncryptedParams: encryptedParams})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
+}
+
+func (c *Client) GetCrossChainMessageStatus(encryptedParams common.EncryptedParamsGetMessageStatus) (*responses.MessageStatus, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetCrossChainMessageStatus(timeoutCtx, &generated.GetCrossChainMessageStatusRequest{EncryptedParams: encryptedParams})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
+}
+
 func (c *Client) GetBalance(encryptedParams common.EncryptedParamsGetBalance) (*responses.Balance, common.SystemError) {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
@@ -332,6 +548,31 @@ func (c *Client) GetCode(address gethcommon.Address, batchHash *gethcommon.Hash)
 	return response.Code, nil
 }
 
+func (c *Client) ConvertBatchHash(hash gethcommon.Hash) (*common.BatchHashes, common.SystemError) {
+	if err := c.requireFeature(common.FeatureBatchHashConversion); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.ConvertBatchHash(timeoutCtx, &generated.ConvertBatchHashRequest{Hash: hash.Bytes()})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	// neither hash is known
+	if len(response.BatchHash) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	return &common.BatchHashes{
+		BatchHash:     gethcommon.BytesToHash(response.BatchHash),
+		ConvertedHash: gethcommon.BytesToHash(response.ConvertedHash),
+	}, nil
+}
+
 func (c *Client) Subscribe(id gethrpc.ID, encryptedParams common.EncryptedParamsLogSubscription) common.SystemError {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
@@ -344,11 +585,41 @@ func (c *Client) Subscribe(id gethrpc.ID, encryptedParams common.EncryptedParams
 		return syserr.NewRPCError(err)
 	}
 	if response != nil && response.SystemError != nil {
-		return syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+		return fromRPCError(response.SystemError)
+	}
+	return nil
+}
+
+func (c *Client) SubscribeNewHeads(id gethrpc.ID) common.SystemError {
+	if err := c.requireFeature(common.FeatureNewHeads); err != nil {
+		return err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.SubscribeNewHeads(timeoutCtx, &generated.SubscribeNewHeadsRequest{
+		Id: []byte(id),
+	})
+	if err != nil {
+		return syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return fromRPCError(response.SystemError)
 	}
 	return nil
 }
 
+// fromRPCError - the errors returned with a user error code are returned to the user as such, e.g. when the cap of
+// subscriptions is reached. The others are failures of the enclave.
+func fromRPCError(rpcErr *generated.SystemError) common.SystemError {
+	code := responses.ErrorCode(rpcErr.ErrorCode)
+	if code >= responses.ErrCodeUserError && !code.IsSystemError() {
+		return responses.WithCode(code, errors.New(rpcErr.ErrorString))
+	}
+	return syserr.NewInternalError(fmt.Errorf("%s", rpcErr.ErrorString))
+}
+
 func (c *Client) Unsubscribe(id gethrpc.ID) common.SystemError {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
@@ -382,6 +653,23 @@ func (c *Client) EstimateGas(encryptedParams common.EncryptedParamsEstimateGas)
 	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
 }
 
+func (c *Client) EstimateL1Fee(encryptedParams common.EncryptedParamsEstimateL1Fee) (*responses.L1Fee, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.EstimateL1Fee(timeoutCtx, &generated.EstimateL1FeeRequest{
+		EncryptedParams: encryptedParams,
+	})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
+}
+
 func (c *Client) GetLogs(encryptedParams common.EncryptedParamsGetLogs) (*responses.Logs, common.SystemError) {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
@@ -399,21 +687,90 @@ func (c *Client) GetLogs(encryptedParams common.EncryptedParamsGetLogs) (*respon
 	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
 }
 
-func (c *Client) HealthCheck() (bool, common.SystemError) {
+func (c *Client) HealthCheck() (*common.HealthStatus, common.SystemError) {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
 
 	response, err := c.protoClient.HealthCheck(timeoutCtx, &generated.EmptyArgs{})
 	if err != nil {
-		return false, syserr.NewRPCError(err)
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	// the enclaves which predate the per component detail only send the overall status
+	return &common.HealthStatus{OverallHealth: response.Status, Components: rpc.FromComponentHealthMsgs(response.Components)}, nil
+}
+
+func (c *Client) GetStateDivergenceReports() ([]*common.StateDivergenceReport, common.SystemError) {
+	if err := c.requireFeature(common.FeatureStateDivergenceReports); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetStateDivergenceReports(timeoutCtx, &generated.EmptyArgs{})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return rpc.FromStateDivergenceReportMsgs(response.Reports), nil
+}
+
+func (c *Client) GetSecretDisclosures(fromID uint64, maxCount uint64) ([]*common.SecretDisclosureRecord, common.SystemError) {
+	if err := c.requireFeature(common.FeatureSecretDisclosureAudit); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetSecretDisclosures(timeoutCtx, &generated.GetSecretDisclosuresRequest{FromID: fromID, MaxCount: maxCount})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return rpc.FromSecretDisclosureRecordMsgs(response.Records), nil
+}
+
+func (c *Client) GetRollupCompressionStats() ([]*common.RollupCompressionStats, common.SystemError) {
+	if err := c.requireFeature(common.FeatureRollupCompressionStats); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetRollupCompressionStats(timeoutCtx, &generated.EmptyArgs{})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
 	}
 	if response != nil && response.SystemError != nil {
-		return false, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
 	}
-	return response.Status, nil
+	return rpc.FromRollupCompressionStatsMsgs(response.Stats), nil
 }
 
-func (c *Client) CreateBatch(skipIfEmpty bool) common.SystemError {
+func (c *Client) GetMetrics() ([]byte, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetMetrics(timeoutCtx, &generated.EmptyArgs{})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return response.Metrics, nil
+}
+
+func (c *Client) CreateBatch(skipIfEmpty bool) (*common.CreateBatchResult, common.SystemError) {
 	defer core.LogMethodDuration(c.logger, measure.NewStopwatch(), "CreateBatch rpc call")
 
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
@@ -421,15 +778,79 @@ func (c *Client) CreateBatch(skipIfEmpty bool) common.SystemError {
 
 	response, err := c.protoClient.CreateBatch(timeoutCtx, &generated.CreateBatchRequest{SkipIfEmpty: skipIfEmpty})
 	if err != nil {
-		return syserr.NewInternalError(err)
+		// the enclave is not ready to create the genesis batch yet, which is not a failure
+		if status.Convert(err).Message() == errutil.ErrNotReady.Error() {
+			return nil, errutil.ErrNotReady
+		}
+		return nil, syserr.NewInternalError(err)
 	}
 	if response != nil && response.Error != "" {
-		return syserr.NewInternalError(fmt.Errorf("%s", response.Error))
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.Error))
+	}
+	return &common.CreateBatchResult{
+		Produced:   response.Produced,
+		BatchHash:  gethcommon.BytesToHash(response.BatchHash),
+		SeqNo:      response.SeqNo,
+		TxCount:    int(response.TxCount),
+		SkipReason: common.BatchSkipReason(response.SkipReason),
+	}, nil
+}
+
+func (c *Client) PauseBatchProduction() common.SystemError {
+	return c.setBatchProductionPaused(true)
+}
+
+func (c *Client) ResumeBatchProduction() common.SystemError {
+	return c.setBatchProductionPaused(false)
+}
+
+func (c *Client) setBatchProductionPaused(paused bool) common.SystemError {
+	if err := c.requireFeature(common.FeatureBatchProductionPause); err != nil {
+		return err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.SetBatchProductionPaused(timeoutCtx, &generated.SetBatchProductionPausedRequest{Paused: paused})
+	if err != nil {
+		return syserr.NewRPCError(err)
 	}
-	return err
+	if response != nil && response.SystemError != nil {
+		// a validator was asked to change the batch production, which is a user error, not an enclave failure
+		if response.SystemError.ErrorString == errutil.ErrNotSequencer.Error() {
+			return errutil.ErrNotSequencer
+		}
+		return syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return nil
 }
 
-func (c *Client) CreateRollup(fromSeqNo uint64) (*common.ExtRollup, common.SystemError) {
+func (c *Client) ReprocessRollup(rollupHash common.L2RollupHash) (*common.RollupProcessing, common.SystemError) {
+	if err := c.requireFeature(common.FeatureRollupReprocessing); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.ReprocessRollup(timeoutCtx, &generated.ReprocessRollupRequest{RollupHash: rollupHash.Bytes()})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	if response.UserError != "" {
+		return nil, responses.WithCode(responses.ErrCodeInvalidParams, errors.New(response.UserError))
+	}
+	if response.Processing == nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("the enclave returned no rollup processing"))
+	}
+	return rpc.FromRollupProcessingMsg(response.Processing), nil
+}
+
+func (c *Client) CreateRollup(fromSeqNo uint64) (*common.ExtRollup, *common.ExtRollupMetadata, common.SystemError) {
 	defer core.LogMethodDuration(c.logger, measure.NewStopwatch(), "CreateRollup rpc call")
 
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
@@ -439,27 +860,42 @@ func (c *Client) CreateRollup(fromSeqNo uint64) (*common.ExtRollup, common.Syste
 		FromSequenceNumber: &fromSeqNo,
 	})
 	if err != nil {
-		return nil, syserr.NewRPCError(err)
+		return nil, nil, syserr.NewRPCError(err)
 	}
 	if response != nil && response.SystemError != nil {
-		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+		// the enclave has no head batch yet, which is not a failure
+		if response.SystemError.ErrorString == errutil.ErrNotReady.Error() {
+			return nil, nil, errutil.ErrNotReady
+		}
+		return nil, nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
 	}
 
-	return rpc.FromExtRollupMsg(response.Msg), nil
+	return rpc.FromExtRollupMsg(response.Msg), rpc.FromExtRollupMetadataMsg(response.Metadata), nil
 }
 
-func (c *Client) DebugTraceTransaction(hash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, common.SystemError) {
+func (c *Client) DebugTraceTransaction(encryptedParams common.EncryptedParamsDebugTraceTx) (*responses.DebugTrace, common.SystemError) {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
 
-	confBytes, err := json.Marshal(config)
+	response, err := c.protoClient.DebugTraceTransaction(timeoutCtx, &generated.DebugTraceTransactionRequest{
+		EncryptedParams: encryptedParams,
+	})
 	if err != nil {
-		return nil, err
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
 	}
 
-	response, err := c.protoClient.DebugTraceTransaction(timeoutCtx, &generated.DebugTraceTransactionRequest{
-		TxHash: hash.Bytes(),
-		Config: confBytes,
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
+}
+
+func (c *Client) DebugStorageRangeAt(encryptedParams common.EncryptedParamsStorageRangeAt) (*responses.StorageRange, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.DebugStorageRangeAt(timeoutCtx, &generated.DebugStorageRangeAtRequest{
+		EncryptedParams: encryptedParams,
 	})
 	if err != nil {
 		return nil, syserr.NewRPCError(err)
@@ -468,7 +904,7 @@ func (c *Client) DebugTraceTransaction(hash gethcommon.Hash, config *tracers.Tra
 		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
 	}
 
-	return json.RawMessage(response.Msg), nil
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
 }
 
 func (c *Client) GetBatch(hash common.L2BatchHash) (*common.ExtBatch, common.SystemError) {
@@ -495,12 +931,196 @@ func (c *Client) GetBatchBySeqNo(seqNo uint64) (*common.ExtBatch, common.SystemE
 	return common.DecodeExtBatch(batchMsg.Batch)
 }
 
+func (c *Client) GetBatchByHeight(height uint64) (*common.ExtBatch, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	batchMsg, err := c.protoClient.GetBatchByHeight(timeoutCtx, &generated.GetBatchByHeightRequest{Height: height})
+	if err != nil {
+		return nil, fmt.Errorf("rpc GetBatchByHeight failed. Cause: %w", err)
+	}
+	if batchMsg.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", batchMsg.SystemError.ErrorString))
+	}
+
+	return common.DecodeExtBatch(batchMsg.Batch)
+}
+
+func (c *Client) GetBatchesBySeqRange(fromSeqNo uint64, toSeqNo uint64, maxCount uint64) ([]*common.ExtBatch, common.SystemError) {
+	if err := c.requireFeature(common.FeatureBatchRanges); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetBatchesBySeqRange(timeoutCtx, &generated.GetBatchesBySeqRangeRequest{
+		FromSeqNo: fromSeqNo,
+		ToSeqNo:   toSeqNo,
+		MaxCount:  maxCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rpc GetBatchesBySeqRange failed. Cause: %w", err)
+	}
+	if response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+
+	batches := make([]*common.ExtBatch, len(response.Batches))
+	for i, encodedBatch := range response.Batches {
+		batches[i], err = common.DecodeExtBatch(encodedBatch)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode batch. Cause: %w", err)
+		}
+	}
+	return batches, nil
+}
+
+// ExportStateSnapshot - the snapshot is streamed in chunks, and can take far longer than the other calls, as the enclave
+// walks the whole state
+func (c *Client) ExportStateSnapshot(rollupHash common.L2RollupHash, requester *common.AttestationReport) (*common.EncryptedStateSnapshot, common.SystemError) {
+	if err := c.requireFeature(common.FeatureStateSnapshots); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), stateSnapshotTimeout)
+	defer cancel()
+
+	request := &generated.ExportStateSnapshotRequest{RollupHash: rollupHash.Bytes()}
+	if requester != nil {
+		msg := rpc.ToAttestationReportMsg(requester)
+		request.Requester = &msg
+	}
+	stream, err := c.protoClient.ExportStateSnapshot(timeoutCtx, request)
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	var exportErr error
+	snapshot, _, err := rpc.FromStateSnapshotChunkMsgs(func() (*generated.StateSnapshotChunkMsg, error) {
+		chunk, err := stream.Recv()
+		if err == nil && chunk.SystemError != nil {
+			exportErr = fmt.Errorf("%s", chunk.SystemError.ErrorString)
+			return nil, exportErr
+		}
+		return chunk, err
+	})
+	if exportErr != nil {
+		return nil, syserr.NewInternalError(exportErr)
+	}
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	return snapshot, nil
+}
+
+func (c *Client) ImportStateSnapshot(snapshot *common.EncryptedStateSnapshot, l1Attestations []*common.AttestationReport) (*common.StateSnapshotInfo, common.SystemError) {
+	if err := c.requireFeature(common.FeatureStateSnapshots); err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, syserr.NewInternalError(errors.New("the state snapshot is missing"))
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), stateSnapshotTimeout)
+	defer cancel()
+
+	stream, err := c.protoClient.ImportStateSnapshot(timeoutCtx)
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	for _, chunk := range rpc.ToStateSnapshotChunkMsgs(snapshot, l1Attestations) {
+		if err = stream.Send(chunk); err != nil {
+			break
+		}
+	}
+	// a failed send is reported by the enclave when it closed the stream, otherwise by the receive
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return rpc.FromStateSnapshotInfoMsg(response.Info), nil
+}
+
+func (c *Client) GetBatchExecutionSummary(seqNo uint64) (*common.ExecutedBatchSummary, common.SystemError) {
+	if err := c.requireFeature(common.FeatureBatchExecutionSummaries); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetBatchExecutionSummary(timeoutCtx, &generated.GetBatchExecutionSummaryRequest{SeqNo: seqNo})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, fromRPCError(response.SystemError)
+	}
+	return rpc.FromExecutedBatchSummaryMsg(response.Summary), nil
+}
+
+func (c *Client) GetBatchExecutionSummaries(fromSeqNo uint64, toSeqNo uint64, maxCount uint64) ([]*common.ExecutedBatchSummary, common.SystemError) {
+	if err := c.requireFeature(common.FeatureBatchExecutionSummaries); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetBatchExecutionSummaries(timeoutCtx, &generated.GetBatchesBySeqRangeRequest{
+		FromSeqNo: fromSeqNo,
+		ToSeqNo:   toSeqNo,
+		MaxCount:  maxCount,
+	})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return rpc.FromExecutedBatchSummaryMsgs(response.Summaries), nil
+}
+
+func (c *Client) GetRollupData(hash common.L2RollupHash) (*common.PublicRollupMetadata, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetRollupData(timeoutCtx, &generated.GetRollupDataRequest{Hash: hash.Bytes()})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return rpc.FromRollupMetadataMsg(response.Msg), nil
+}
+
+func (c *Client) GetRollupByBatchSeqNo(seqNo uint64) (*common.PublicRollupMetadata, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetRollupByBatchSeqNo(timeoutCtx, &generated.GetRollupByBatchSeqNoRequest{SeqNo: seqNo})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return rpc.FromRollupMetadataMsg(response.Msg), nil
+}
+
+// StreamL2Updates - the updates are requested compressed when the host is configured to, from the enclaves which support
+// it, and are decompressed before being queued, so the caller gets the same updates either way
 func (c *Client) StreamL2Updates() (chan common.StreamL2UpdatesResponse, func()) {
 	// channel size is 10 to allow for some buffering but caller is expected to read immediately to avoid blocking
 	batchChan := make(chan common.StreamL2UpdatesResponse, 10)
 	cancelCtx, cancel := context.WithCancel(context.Background())
 
-	stream, err := c.protoClient.StreamL2Updates(cancelCtx, &generated.StreamL2UpdatesRequest{})
+	compressed := c.config.CompressL2Updates && c.requireFeature(common.FeatureCompressedL2Updates) == nil
+	stream, err := c.protoClient.StreamL2Updates(cancelCtx, &generated.StreamL2UpdatesRequest{Compressed: compressed})
 	if err != nil {
 		c.logger.Error("Error opening batch stream.", log.ErrKey, err)
 		cancel()
@@ -528,8 +1148,15 @@ func (c *Client) StreamL2Updates() (chan common.StreamL2UpdatesResponse, func())
 				break
 			}
 
+			encoded := batchMsg.Batch
+			if batchMsg.Compressed {
+				if encoded, err = c.dataCompression.Decompress(encoded); err != nil {
+					c.logger.Error("Error decompressing batch from stream.", log.ErrKey, err)
+					break
+				}
+			}
 			var decoded common.StreamL2UpdatesResponse
-			if err := json.Unmarshal(batchMsg.Batch, &decoded); err != nil {
+			if err := json.Unmarshal(encoded, &decoded); err != nil {
 				c.logger.Error("Error unmarshalling batch from stream.", log.ErrKey, err)
 				break
 			}
@@ -541,12 +1168,29 @@ func (c *Client) StreamL2Updates() (chan common.StreamL2UpdatesResponse, func())
 	return batchChan, cancel
 }
 
-func (c *Client) DebugEventLogRelevancy(hash gethcommon.Hash) (json.RawMessage, common.SystemError) {
+func (c *Client) DebugEventLogRelevancy(encryptedParams common.EncryptedParamsDebugLogs) (*responses.DebugLogs, common.SystemError) {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
 
 	response, err := c.protoClient.DebugEventLogRelevancy(timeoutCtx, &generated.DebugEventLogRelevancyRequest{
-		TxHash: hash.Bytes(),
+		EncryptedParams: encryptedParams,
+	})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
+}
+
+func (c *Client) DebugBatchLogRelevancy(encryptedParams common.EncryptedParamsBatchLogs) (*responses.BatchLogRelevancy, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.DebugBatchLogRelevancy(timeoutCtx, &generated.DebugBatchLogRelevancyRequest{
+		EncryptedParams: encryptedParams,
 	})
 	if err != nil {
 		return nil, syserr.NewRPCError(err)
@@ -554,7 +1198,87 @@ func (c *Client) DebugEventLogRelevancy(hash gethcommon.Hash) (json.RawMessage,
 	if response != nil && response.SystemError != nil {
 		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
 	}
-	return json.RawMessage(response.Msg), nil
+
+	return responses.ToEnclaveResponse(response.EncodedEnclaveResponse), nil
+}
+
+func (c *Client) SetProfilerEnabled(enabled bool) (*common.ProfilerStatus, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.SetProfilerEnabled(timeoutCtx, &generated.SetProfilerEnabledRequest{Enabled: enabled})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return &common.ProfilerStatus{Running: response.Running, Address: response.Address, Message: response.Message}, nil
+}
+
+func (c *Client) SetLogLevel(component string, level int) (map[string]int, common.SystemError) {
+	if err := c.requireFeature(common.FeatureComponentLogLevels); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.SetLogLevel(timeoutCtx, &generated.SetLogLevelRequest{Component: component, Level: int32(level)})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, fromRPCError(response.SystemError)
+	}
+	levels := make(map[string]int, len(response.Levels))
+	for component, level := range response.Levels {
+		levels[component] = int(level)
+	}
+	return levels, nil
+}
+
+func (c *Client) GetEnclaveConfiguration() (*common.EnclaveConfiguration, common.SystemError) {
+	if err := c.requireFeature(common.FeatureConfigIntrospection); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetEnclaveConfiguration(timeoutCtx, &generated.EmptyArgs{})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, fromRPCError(response.SystemError)
+	}
+	var configuration common.EnclaveConfiguration
+	if err = json.Unmarshal(response.EncodedConfiguration, &configuration); err != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("could not decode the enclave configuration. Cause: %w", err))
+	}
+	return &configuration, nil
+}
+
+func (c *Client) GetNetworkInfo() (*common.NetworkInfo, common.SystemError) {
+	if err := c.requireFeature(common.FeatureNetworkInfo); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetNetworkInfo(timeoutCtx, &generated.EmptyArgs{})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	if response.NetworkInfo == nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("the enclave returned no network info"))
+	}
+	return rpc.FromNetworkInfoMsg(response.NetworkInfo), nil
 }
 
 func (c *Client) GetTotalContractCount() (*big.Int, common.SystemError) {
@@ -625,7 +1349,19 @@ func (c *Client) EnclavePublicConfig() (*common.EnclavePublicConfig, common.Syst
 	if response != nil && response.SystemError != nil {
 		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
 	}
+	systemContracts := make(map[string]gethcommon.Address, len(response.SystemContracts))
+	for name, address := range response.SystemContracts {
+		systemContracts[name] = gethcommon.BytesToAddress(address)
+	}
 	return &common.EnclavePublicConfig{
 		L2MessageBusAddress: gethcommon.BytesToAddress(response.L2MessageBusAddress),
+		L1MessageBusAddress: gethcommon.BytesToAddress(response.L1MessageBusAddress),
+		BridgeAddress:       gethcommon.BytesToAddress(response.BridgeAddress),
+		SystemContracts:     systemContracts,
+		L2ChainID:           response.L2ChainID,
+		MinGasPrice:         new(big.Int).SetBytes(response.MinGasPrice),
+		Version:             response.Version,
+		BaseFee:             new(big.Int).SetBytes(response.BaseFee),
+		GasPaymentAddress:   gethcommon.BytesToAddress(response.GasPaymentAddress),
 	}, nil
 }
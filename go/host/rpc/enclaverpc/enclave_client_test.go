@@ -0,0 +1,112 @@
+package enclaverpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/rpc/generated"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/config"
+	"github.com/ten-protocol/go-ten/go/responses"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testProtoClient - an enclave which answers the version negotiation with the given version, or not at all if nil
+type testProtoClient struct {
+	generated.EnclaveProtoClient
+	version        *common.EnclaveAPIVersion
+	versionQueries int
+}
+
+func (c *testProtoClient) GetEnclaveAPIVersion(context.Context, *generated.EmptyArgs, ...grpc.CallOption) (*generated.GetEnclaveAPIVersionResponse, error) {
+	c.versionQueries++
+	if c.version == nil {
+		return nil, status.Error(codes.Unimplemented, "method GetEnclaveAPIVersion not implemented")
+	}
+	return &generated.GetEnclaveAPIVersionResponse{
+		Major:    c.version.Major,
+		Minor:    c.version.Minor,
+		Patch:    c.version.Patch,
+		Features: uint64(c.version.Features),
+	}, nil
+}
+
+func (c *testProtoClient) SubmitL1Headers(context.Context, *generated.SubmitL1HeadersRequest, ...grpc.CallOption) (*generated.SubmitL1HeadersResponse, error) {
+	return &generated.SubmitL1HeadersResponse{ForkPoint: &generated.L1ForkPointMsg{}}, nil
+}
+
+// subscribingProtoClient - an enclave which refuses the subscriptions with the given error
+type subscribingProtoClient struct {
+	generated.EnclaveProtoClient
+	rpcErr *generated.SystemError
+}
+
+func (c *subscribingProtoClient) Subscribe(context.Context, *generated.SubscribeRequest, ...grpc.CallOption) (*generated.SubscribeResponse, error) {
+	return &generated.SubscribeResponse{SystemError: c.rpcErr}, nil
+}
+
+// introspectionProtoClient - an enclave whose configuration introspection is disabled
+type introspectionProtoClient struct {
+	testProtoClient
+}
+
+func (c *introspectionProtoClient) GetEnclaveConfiguration(context.Context, *generated.EmptyArgs, ...grpc.CallOption) (*generated.GetEnclaveConfigurationResponse, error) {
+	return &generated.GetEnclaveConfigurationResponse{SystemError: &generated.SystemError{ErrorCode: int32(responses.ErrCodeUserError), ErrorString: "config introspection not enabled"}}, nil
+}
+
+func newTestClient(protoClient generated.EnclaveProtoClient) *Client {
+	return &Client{protoClient: protoClient, config: &config.HostConfig{EnclaveRPCTimeout: time.Second}, logger: gethlog.New()}
+}
+
+func TestCallsAreRejectedIfTheEnclaveDoesNotSupportTheFeature(t *testing.T) {
+	// the enclaves which predate the negotiation support no optional features
+	legacy := &testProtoClient{}
+	client := newTestClient(legacy)
+	_, err := client.SubmitL1Headers([]*types.Header{})
+	require.True(t, errors.Is(err, common.ErrUnsupportedEnclaveFeature), "unexpected error %v", err)
+	require.ErrorContains(t, err, "L1 header pre-submission")
+
+	// a different major version is incompatible, whatever the features
+	incompatible := common.CurrentEnclaveAPIVersion
+	incompatible.Major++
+	client = newTestClient(&testProtoClient{version: &incompatible})
+	_, err = client.SubmitL1Headers([]*types.Header{})
+	require.True(t, errors.Is(err, common.ErrUnsupportedEnclaveFeature), "unexpected error %v", err)
+
+	current := &testProtoClient{version: &common.CurrentEnclaveAPIVersion}
+	client = newTestClient(current)
+	_, err = client.SubmitL1Headers([]*types.Header{})
+	require.NoError(t, err)
+	_, err = client.SubmitL1Headers([]*types.Header{})
+	require.NoError(t, err)
+	require.Equal(t, 1, current.versionQueries, "expected the version to be negotiated once")
+}
+
+func TestSubscriptionCapIsReturnedAsAUserError(t *testing.T) {
+	client := newTestClient(&subscribingProtoClient{rpcErr: &generated.SystemError{ErrorCode: int32(responses.ErrCodeTooManySubscriptions), ErrorString: "too many log subscriptions"}})
+	err := client.Subscribe("1", nil)
+	require.Equal(t, responses.ErrCodeTooManySubscriptions, responses.ErrorCodeOf(err))
+	require.False(t, errors.Is(err, &syserr.InternalError{}))
+	require.EqualError(t, err, "too many log subscriptions")
+
+	// the other failures of the enclave are internal errors
+	client = newTestClient(&subscribingProtoClient{rpcErr: &generated.SystemError{ErrorCode: 1, ErrorString: "could not decrypt params"}})
+	err = client.Subscribe("1", nil)
+	require.ErrorIs(t, err, &syserr.InternalError{})
+}
+
+func TestDisabledConfigIntrospectionIsReturnedAsAUserError(t *testing.T) {
+	client := newTestClient(&introspectionProtoClient{testProtoClient{version: &common.CurrentEnclaveAPIVersion}})
+	_, err := client.GetEnclaveConfiguration()
+	require.Equal(t, responses.ErrCodeUserError, responses.ErrorCodeOf(err))
+	require.False(t, errors.Is(err, &syserr.InternalError{}))
+	require.EqualError(t, err, "config introspection not enabled")
+}
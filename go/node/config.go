@@ -55,6 +55,8 @@ type Config struct {
 	rollupInterval            string
 	l1ChainID                 int
 	obscuroGenesis            string
+	sqliteDBPath              string
+	levelDBPath               string
 }
 
 func NewNodeConfig(opts ...Option) *Config {
@@ -91,6 +93,11 @@ func (c *Config) ToEnclaveConfig() *config.EnclaveConfig {
 	cfg.DebugNamespaceEnabled = c.debugNamespaceEnabled
 	cfg.ObscuroGenesis = c.obscuroGenesis
 
+	if c.sqliteDBPath != "" {
+		cfg.UseInMemoryDB = false
+		cfg.SqliteDBPath = c.sqliteDBPath
+	}
+
 	if c.nodeType == "sequencer" && c.coinbaseAddress != "" {
 		cfg.GasPaymentAddress = gethcommon.HexToAddress(c.coinbaseAddress)
 	}
@@ -127,6 +134,11 @@ func (c *Config) ToHostConfig() *config.HostInputConfig {
 	cfg.L1BlockTime = c.l1BlockTime
 	cfg.L1ChainID = int64(c.l1ChainID)
 
+	if c.levelDBPath != "" {
+		cfg.UseInMemoryDB = false
+		cfg.LevelDBPath = c.levelDBPath
+	}
+
 	return cfg
 }
 
@@ -341,3 +353,15 @@ func WithObscuroGenesis(g string) Option {
 		c.obscuroGenesis = g
 	}
 }
+
+func WithSqliteDBPath(s string) Option {
+	return func(c *Config) {
+		c.sqliteDBPath = s
+	}
+}
+
+func WithLevelDBPath(s string) Option {
+	return func(c *Config) {
+		c.levelDBPath = s
+	}
+}
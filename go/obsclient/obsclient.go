@@ -99,6 +99,44 @@ func (oc *ObsClient) Health() (bool, error) {
 	return healthy.OverallHealth, nil
 }
 
+// EnclaveIDProof returns the proof that the enclave of the node controls the key of its ID, once it is verified against
+// the nonce. The nonce should be random, so the proof cannot be replayed by another node.
+func (oc *ObsClient) EnclaveIDProof(nonce []byte) (*common.EnclaveIDProof, error) {
+	var proof *common.EnclaveIDProof
+	err := oc.rpcClient.Call(&proof, rpc.EnclaveIDProof, hexutil.Bytes(nonce))
+	if err != nil {
+		return nil, err
+	}
+	if err = proof.Verify(nonce); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// EnclaveConfiguration returns the sanitized configuration of the enclave of the node. It is served by the debug
+// namespace of the host
+func (oc *ObsClient) EnclaveConfiguration() (*common.EnclaveConfiguration, error) {
+	var configuration *common.EnclaveConfiguration
+	err := oc.rpcClient.Call(&configuration, rpc.EnclaveConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	return configuration, nil
+}
+
+// TxPoolStatus returns the occupancy of the mempool of the node's enclave
+func (oc *ObsClient) TxPoolStatus() (*common.TxPoolStatus, error) {
+	var healthCheck *hostcommon.HealthCheck
+	err := oc.rpcClient.Call(&healthCheck, rpc.Health)
+	if err != nil {
+		return nil, err
+	}
+	if healthCheck.TxPool == nil {
+		return nil, errors.New("the node did not report the occupancy of its mempool")
+	}
+	return healthCheck.TxPool, nil
+}
+
 // GetTotalContractCount returns the total count of created contracts
 func (oc *ObsClient) GetTotalContractCount() (int, error) {
 	var count int
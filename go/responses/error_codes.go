@@ -0,0 +1,121 @@
+package responses
+
+import (
+	"context"
+	"errors"
+
+	gethcore "github.com/ethereum/go-ethereum/core"
+	gethtxpool "github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+)
+
+// ErrorCode - identifies the kind of error returned by the enclave, so the clients don't have to match the messages
+type ErrorCode int
+
+const (
+	ErrCodeUnknown ErrorCode = 0 // the error was returned without a code, e.g. by an older enclave
+
+	// The user errors - the request cannot succeed as it is.
+
+	ErrCodeUserError            ErrorCode = 1000 // any other user error
+	ErrCodeInvalidParams        ErrorCode = 1001
+	ErrCodeUnauthorised         ErrorCode = 1002 // the viewing key is invalid, or does not allow access to the resource
+	ErrCodeNonceTooLow          ErrorCode = 1003
+	ErrCodeUnderpriced          ErrorCode = 1004
+	ErrCodeNotFound             ErrorCode = 1005
+	ErrCodeRateLimited          ErrorCode = 1006 // e.g. the sender has reached its allowance in the mempool
+	ErrCodeExecutionReverted    ErrorCode = 1007 // the message is the serialised EVM error
+	ErrCodeServerBusy           ErrorCode = 1008 // the enclave is executing too many requests, the request can be sent again later
+	ErrCodeInsufficientFunds    ErrorCode = 1009 // the sender cannot pay for the gas and the value of the tx
+	ErrCodeOversizedData        ErrorCode = 1010
+	ErrCodeAlreadyKnown         ErrorCode = 1011 // the tx was already submitted, its hash is returned with the error
+	ErrCodeTooManySubscriptions ErrorCode = 1012 // the enclave, or the viewing key, has reached its cap of subscriptions
+
+	// The system errors - the request failed because of the enclave, and can be retried.
+
+	ErrCodeInternal    ErrorCode = 2000
+	ErrCodeUnavailable ErrorCode = 2001 // e.g. the enclave is shutting down
+	ErrCodeTimeout     ErrorCode = 2002
+)
+
+// IsSystemError - whether the code belongs to the system errors
+func (c ErrorCode) IsSystemError() bool {
+	return c >= ErrCodeInternal
+}
+
+// CodedError - an error returned by the enclave with its code. The message is left unchanged, so the wallets which
+// depend on the exact geth messages keep working.
+type CodedError struct {
+	Code ErrorCode
+	Err  error
+}
+
+func (e *CodedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *CodedError) Unwrap() error {
+	return e.Err
+}
+
+// WithCode - attaches the code to the error
+func WithCode(code ErrorCode, err error) error {
+	return &CodedError{Code: code, Err: err}
+}
+
+// WithDefaultCode - attaches the code to the error, unless a more specific one is known for it
+func WithDefaultCode(code ErrorCode, err error) error {
+	if _, found := knownErrorCode(err); found {
+		return err
+	}
+	return WithCode(code, err)
+}
+
+// ErrorCodeOf - the code of the error. The errors without a known code are user errors.
+func ErrorCodeOf(err error) ErrorCode {
+	if code, found := knownErrorCode(err); found {
+		return code
+	}
+	return ErrCodeUserError
+}
+
+func knownErrorCode(err error) (ErrorCode, bool) {
+	var codedErr *CodedError
+	var internalErr *syserr.InternalError
+	switch {
+	case errors.As(err, &codedErr):
+		return codedErr.Code, true
+	case errors.Is(err, gethcore.ErrNonceTooLow):
+		return ErrCodeNonceTooLow, true
+	case errors.Is(err, gethtxpool.ErrUnderpriced), errors.Is(err, gethtxpool.ErrReplaceUnderpriced):
+		return ErrCodeUnderpriced, true
+	case errors.Is(err, gethcore.ErrInsufficientFunds):
+		return ErrCodeInsufficientFunds, true
+	case errors.Is(err, gethtxpool.ErrOversizedData):
+		return ErrCodeOversizedData, true
+	case errors.Is(err, gethtxpool.ErrAlreadyKnown):
+		return ErrCodeAlreadyKnown, true
+	case errors.Is(err, legacypool.ErrTxPoolOverflow):
+		return ErrCodeRateLimited, true
+	case errors.Is(err, errutil.ErrNotFound):
+		return ErrCodeNotFound, true
+	case errors.Is(err, errutil.ErrShuttingDown), errors.Is(err, context.Canceled):
+		return ErrCodeUnavailable, true
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrCodeTimeout, true
+	case errors.As(err, &internalErr):
+		return ErrCodeInternal, true
+	}
+	return ErrCodeUnknown, false
+}
+
+// toError - the error received with its code, if any
+func toError(msg string, code ErrorCode) error {
+	err := errors.New(msg)
+	if code == ErrCodeUnknown {
+		return err
+	}
+	return WithCode(code, err)
+}
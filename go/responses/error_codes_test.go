@@ -0,0 +1,29 @@
+package responses
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+)
+
+func TestErrorCodeOf(t *testing.T) {
+	internal := syserr.NewInternalError(errors.New("could not read the db"))
+	tests := map[string]struct {
+		err  error
+		code ErrorCode
+	}{
+		"internal":         {internal, ErrCodeInternal},
+		"wrapped internal": {fmt.Errorf("request failed. Cause: %w", internal), ErrCodeInternal},
+		"coded":            {WithCode(ErrCodeUnauthorised, errors.New("invalid viewing key")), ErrCodeUnauthorised},
+		"not found":        {fmt.Errorf("no batch. Cause: %w", errutil.ErrNotFound), ErrCodeNotFound},
+		"unknown":          {errors.New("invalid params"), ErrCodeUserError},
+	}
+	for name, test := range tests {
+		if code := ErrorCodeOf(test.err); code != test.code {
+			t.Errorf("%s: expected code %d, got %d", name, test.code, code)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package responses
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// paddingMarker - the first byte of a padded response. The unpadded responses are json objects, so they never start with it
+const paddingMarker = byte(0)
+
+// paddingHeaderLen - the marker followed by the length of the response, as a big-endian uint32
+const paddingHeaderLen = 5
+
+// Padding - the sizes (buckets) to which the plaintext of the encrypted user responses is padded, so the size of the
+// ciphertext only reveals the bucket and not the size of the response (e.g. a zero vs a non-zero balance).
+// The buckets are MinBucket doubled until MaxBucket, then the multiples of MaxBucket. A zero MinBucket disables the padding.
+//
+// The padding costs bandwidth: a response grows by less than its size (at most doubling it) below MaxBucket, and by less
+// than MaxBucket above it. The small responses (balances, nonces, receipts) all fit in MinBucket, so MinBucket is their size.
+type Padding struct {
+	MinBucket uint64
+	MaxBucket uint64
+}
+
+// Bucket - the size to which a padded response of `size` bytes (including the header) is padded
+func (p Padding) Bucket(size uint64) uint64 {
+	if size > p.MaxBucket {
+		return (size + p.MaxBucket - 1) / p.MaxBucket * p.MaxBucket
+	}
+	bucket := p.MinBucket
+	for bucket < size {
+		bucket *= 2
+	}
+	if bucket > p.MaxBucket {
+		return p.MaxBucket
+	}
+	return bucket
+}
+
+// Pad - prefixes the response with its length, and pads it to its bucket
+func (p Padding) Pad(response []byte) []byte {
+	if p.MinBucket == 0 {
+		return response
+	}
+	padded := make([]byte, p.Bucket(uint64(len(response)+paddingHeaderLen)))
+	padded[0] = paddingMarker
+	binary.BigEndian.PutUint32(padded[1:paddingHeaderLen], uint32(len(response)))
+	copy(padded[paddingHeaderLen:], response)
+	return padded
+}
+
+// Unpad - the response without its padding. The responses which were not padded are returned as they are.
+func Unpad(data []byte) ([]byte, error) {
+	if len(data) == 0 || data[0] != paddingMarker {
+		return data, nil
+	}
+	if len(data) < paddingHeaderLen {
+		return nil, fmt.Errorf("padded response is too short")
+	}
+	length := uint64(binary.BigEndian.Uint32(data[1:paddingHeaderLen]))
+	if length > uint64(len(data)-paddingHeaderLen) {
+		return nil, fmt.Errorf("padded response is truncated. length=%d, received=%d", length, len(data)-paddingHeaderLen)
+	}
+	return data[paddingHeaderLen : paddingHeaderLen+length], nil
+}
+
+// WithPadding - an Encryptor which pads the responses before they are encrypted by `encryptor`
+func WithPadding(encryptor Encryptor, padding Padding) Encryptor {
+	return paddingEncryptor{encryptor: encryptor, padding: padding}
+}
+
+type paddingEncryptor struct {
+	encryptor Encryptor
+	padding   Padding
+}
+
+func (e paddingEncryptor) Encrypt(bytes []byte) ([]byte, error) {
+	return e.encryptor.Encrypt(e.padding.Pad(bytes))
+}
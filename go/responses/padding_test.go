@@ -0,0 +1,93 @@
+package responses
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var testPadding = Padding{MinBucket: 256, MaxBucket: 64 * 1024}
+
+func TestPaddingRoundTrip(t *testing.T) {
+	for _, size := range []int{0, 1, 250, 251, 252, 1000, 64*1024 - 5, 64 * 1024, 200_000} {
+		response := bytes.Repeat([]byte{'a'}, size)
+		padded := testPadding.Pad(response)
+		if uint64(len(padded)) != testPadding.Bucket(uint64(size+paddingHeaderLen)) {
+			t.Fatalf("size %d: expected the response to be padded to its bucket, got %d bytes", size, len(padded))
+		}
+		unpadded, err := Unpad(padded)
+		if err != nil {
+			t.Fatalf("size %d: %s", size, err)
+		}
+		if !bytes.Equal(response, unpadded) {
+			t.Fatalf("size %d: the unpadded response differs from the original", size)
+		}
+	}
+}
+
+func TestPaddingHidesBalance(t *testing.T) {
+	zero, err := encodeUserResponse(&hexutil.Big{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	large, err := encodeUserResponse((*hexutil.Big)(hexutil.MustDecodeBig("0xffffffffffffffffffffffffffffffff")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zero) == len(large) {
+		t.Fatal("expected the unpadded balances to have different sizes")
+	}
+	if len(testPadding.Pad(zero)) != len(testPadding.Pad(large)) {
+		t.Fatal("expected the padded balances to have the same size")
+	}
+}
+
+func TestUnpaddedResponsesAreDecoded(t *testing.T) {
+	encoded, err := encodeUserResponse(&hexutil.Big{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, response := range [][]byte{encoded, testPadding.Pad(encoded), (Padding{}).Pad(encoded)} {
+		if _, err := DecodeResponse[hexutil.Big](response); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestTruncatedPaddedResponse(t *testing.T) {
+	padded := testPadding.Pad([]byte(strings.Repeat("a", 300)))
+	if _, err := Unpad(padded[:100]); err == nil {
+		t.Fatal("expected an error for a truncated response")
+	}
+}
+
+// TestPaddingOverhead measures the bandwidth overhead of the default padding for typical responses.
+// With the default buckets (256 bytes to 64KiB) the small responses cost a few hundred bytes more, the larger ones at most
+// double up to 64KiB, and above it they grow by less than 64KiB (e.g. 6% for 1MiB of logs).
+func TestPaddingOverhead(t *testing.T) {
+	for name, size := range map[string]int{
+		"balance":        40,
+		"receipt":        700,
+		"call result 4K": 4 * 1024,
+		"logs 40K":       40 * 1024,
+		"logs 1M":        1024 * 1024,
+	} {
+		padded := len(testPadding.Pad(make([]byte, size)))
+		overhead := float64(padded-size) / float64(size) * 100
+		t.Logf("%-15s %8d bytes -> %8d bytes (+%.1f%%)", name, size, padded, overhead)
+
+		if size >= int(testPadding.MinBucket) && padded >= 2*size+paddingHeaderLen {
+			t.Errorf("%s: expected less than 100%% overhead, got %.1f%%", name, overhead)
+		}
+		if size > int(testPadding.MaxBucket) && padded-size > int(testPadding.MaxBucket) {
+			t.Errorf("%s: expected the overhead to stay below the max bucket, got %d bytes", name, padded-size)
+		}
+	}
+}
+
+func encodeUserResponse(balance *hexutil.Big) ([]byte, error) {
+	return json.Marshal(UserResponse[hexutil.Big]{Result: balance})
+}
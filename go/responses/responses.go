@@ -2,14 +2,23 @@ package responses
 
 import (
 	"encoding/json"
-	"fmt"
+	"errors"
 
+	gethtxpool "github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/ten-protocol/go-ten/go/common/syserr"
 )
 
 // InternalErrMsg is the common response returned to the user when an InternalError occurs
 var InternalErrMsg = "internal system error"
 
+// userErrors - the errors which are returned to the user with the standard geth text, so that wallets recognise them
+var userErrors = []error{
+	gethtxpool.ErrReplaceUnderpriced,
+	gethtxpool.ErrAlreadyKnown,   // the tx is in the mempool, or was included in a recent batch
+	legacypool.ErrTxPoolOverflow, // the mempool has no room for the tx
+}
+
 // EncryptedUserResponse - This is the encoded & encrypted form of a UserResponse[Type]
 type EncryptedUserResponse []byte
 
@@ -18,6 +27,7 @@ type EncryptedUserResponse []byte
 type EnclaveResponse struct {
 	EncUserResponse EncryptedUserResponse
 	Err             *string
+	ErrCode         ErrorCode `json:",omitempty"`
 }
 
 // Encode - serializes the enclave response into a json
@@ -30,9 +40,10 @@ func (er *EnclaveResponse) Encode() []byte {
 	return encoded
 }
 
+// Error - the plaintext error, as a *CodedError if the response has a code
 func (er *EnclaveResponse) Error() error {
 	if er.Err != nil {
-		return fmt.Errorf(*er.Err)
+		return toError(*er.Err, er.ErrCode)
 	}
 	return nil
 }
@@ -59,7 +70,8 @@ func AsEmptyResponse() *EnclaveResponse {
 // AsSystemErr - generates a plaintext response containing a visible error.
 func AsSystemErr() *EnclaveResponse {
 	return &EnclaveResponse{
-		Err: &InternalErrMsg,
+		Err:     &InternalErrMsg,
+		ErrCode: ErrCodeInternal,
 	}
 }
 
@@ -67,7 +79,8 @@ func AsSystemErr() *EnclaveResponse {
 func AsPlaintextError(err error) *EnclaveResponse {
 	errStr := err.Error()
 	return &EnclaveResponse{
-		Err: &errStr,
+		Err:     &errStr,
+		ErrCode: ErrorCodeOf(err),
 	}
 }
 
@@ -75,11 +88,30 @@ type Encryptor interface {
 	Encrypt(bytes []byte) ([]byte, error)
 }
 
+// WithRequestID - an Encryptor for the responses to the request with the ID, which is echoed in them
+func WithRequestID(encryptor Encryptor, requestID string) Encryptor {
+	return requestEncryptor{Encryptor: encryptor, requestID: requestID}
+}
+
+type requestEncryptor struct {
+	Encryptor
+	requestID string
+}
+
+// requestIDOf - the ID of the request the responses encrypted by the encryptor are for, if any
+func requestIDOf(encryptor Encryptor) string {
+	if e, ok := encryptor.(requestEncryptor); ok {
+		return e.requestID
+	}
+	return ""
+}
+
 // AsEncryptedResponse - wraps the data passed into the proper format, serializes it and encrypts it.
 // It is then encoded in a plaintext response.
 func AsEncryptedResponse[T any](data *T, encryptHandler Encryptor) *EnclaveResponse {
 	userResp := UserResponse[T]{
-		Result: data,
+		Result:    data,
+		RequestID: requestIDOf(encryptHandler),
 	}
 
 	encoded, err := json.Marshal(userResp)
@@ -98,7 +130,8 @@ func AsEncryptedResponse[T any](data *T, encryptHandler Encryptor) *EnclaveRespo
 // AsEncryptedEmptyResponse - encrypts an empty message
 func AsEncryptedEmptyResponse(encryptHandler Encryptor) *EnclaveResponse {
 	userResp := UserResponse[any]{
-		Result: nil,
+		Result:    nil,
+		RequestID: requestIDOf(encryptHandler),
 	}
 
 	encoded, err := json.Marshal(userResp)
@@ -116,9 +149,18 @@ func AsEncryptedEmptyResponse(encryptHandler Encryptor) *EnclaveResponse {
 
 // AsEncryptedError - Encodes and encrypts an error to be returned for a concrete user.
 func AsEncryptedError(err error, encrypt Encryptor) *EnclaveResponse {
+	return AsEncryptedErrorWithResult[string](nil, err, encrypt)
+}
+
+// AsEncryptedErrorWithResult - as AsEncryptedError, for the errors which come with a result the user can still use, e.g.
+// the hash of a transaction which was already known
+func AsEncryptedErrorWithResult[T any](data *T, err error, encrypt Encryptor) *EnclaveResponse {
 	errStr := err.Error()
-	userResp := UserResponse[string]{
-		ErrStr: &errStr,
+	userResp := UserResponse[T]{
+		Result:    data,
+		ErrStr:    &errStr,
+		ErrCode:   ErrorCodeOf(err),
+		RequestID: requestIDOf(encrypt),
 	}
 
 	encoded, err := json.Marshal(userResp)
@@ -153,17 +195,38 @@ func ToInternalError(err error) error {
 	return syserr.NewInternalError(err)
 }
 
+// ToUserError - Converts the known user errors to their standard form, dropping any wrapping context
+func ToUserError(err error) error {
+	for _, userErr := range userErrors {
+		if errors.Is(err, userErr) {
+			return userErr
+		}
+	}
+	return err
+}
+
 // DecodeResponse - Extracts the user response from a decrypted bytes field and returns the
-// result or nil and optional error.
+// result or nil and optional error. The padding added by the enclave, if any, is removed.
 func DecodeResponse[T any](encoded []byte) (*T, error) {
+	result, _, err := DecodeResponseWithRequestID[T](encoded)
+	return result, err
+}
+
+// DecodeResponseWithRequestID - as DecodeResponse, also returning the request ID echoed by the enclave
+func DecodeResponseWithRequestID[T any](encoded []byte) (*T, string, error) {
+	encoded, err := Unpad(encoded)
+	if err != nil {
+		return nil, "", err
+	}
 	resp := UserResponse[T]{}
-	err := json.Unmarshal(encoded, &resp)
+	err = json.Unmarshal(encoded, &resp)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	if resp.ErrStr != nil {
-		return nil, fmt.Errorf(*resp.ErrStr)
+	// the result is nil, unless the error comes with one
+	if err := resp.Error(); err != nil {
+		return resp.Result, resp.RequestID, err
 	}
 
-	return resp.Result, nil
+	return resp.Result, resp.RequestID, nil
 }
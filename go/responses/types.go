@@ -1,8 +1,6 @@
 package responses
 
 import (
-	"fmt"
-
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -13,14 +11,17 @@ type ViewingKeyEncryptor func([]byte) ([]byte, error)
 // UserResponse - The response struct that contains either data or result
 // which will be decoded only on the client side.
 type UserResponse[T any] struct {
-	Result *T
-	ErrStr *string
+	Result    *T
+	ErrStr    *string
+	ErrCode   ErrorCode `json:",omitempty"`
+	RequestID string    `json:",omitempty"` // the ID the caller gave to the request, if any
 }
 
 // Error - converts the encoded string in the response into a normal error and returns it.
+// The error is a *CodedError if the response has a code.
 func (ur *UserResponse[T]) Error() error {
 	if ur.ErrStr != nil {
-		return fmt.Errorf(*ur.ErrStr)
+		return toError(*ur.ErrStr, ur.ErrCode)
 	}
 	return nil
 }
@@ -38,6 +39,13 @@ type (
 	Logs                 = EnclaveResponse
 	Receipts             = EnclaveResponse
 	PrivateQueryResponse = EnclaveResponse
+	MessageProof         = EnclaveResponse // As above, but for an RPC getCrossChainMessageProof request.
+	MessageStatus        = EnclaveResponse // As above, but for an RPC getCrossChainMessageStatus request.
+	L1Fee                = EnclaveResponse // As above, but for an RPC estimateL1Fee request.
+	StorageRange         = EnclaveResponse // As above, but for an RPC debug_storageRangeAt request.
+	DebugTrace           = EnclaveResponse // As above, but for an RPC debug_traceTransaction request.
+	DebugLogs            = EnclaveResponse // As above, but for an RPC debug_eventLogRelevancy request.
+	BatchLogRelevancy    = EnclaveResponse // As above, but for an RPC debug_batchLogRelevancy request.
 )
 
 // Data Types
@@ -23,9 +23,15 @@ const (
 	GetLogs               = "eth_getLogs"
 	GetStorageAt          = "eth_getStorageAt"
 	GasPrice              = "eth_gasPrice"
+	StorageRangeAt        = "debug_storageRangeAt"
+	DebugTraceTransaction = "debug_traceTransaction"
+	DebugLogRelevancy     = "debug_eventLogRelevancy"
+	BatchLogRelevancy     = "debug_batchLogRelevancy"
+	EnclaveConfiguration  = "debug_enclaveConfiguration"
 
-	Health = "obscuro_health"
-	Config = "obscuro_config"
+	Health         = "obscuro_health"
+	Config         = "obscuro_config"
+	EnclaveIDProof = "obscuro_enclaveIDProof"
 
 	GetBlockHeaderByHash = "tenscan_getBlockHeaderByHash"
 	GetBatch             = "tenscan_getBatch"
@@ -42,6 +42,10 @@ var SensitiveMethods = []string{
 	EstimateGas,
 	GetLogs,
 	GetStorageAt,
+	StorageRangeAt,
+	DebugTraceTransaction,
+	DebugLogRelevancy,
+	BatchLogRelevancy,
 }
 
 // EncRPCClient is a Client wrapper that implements Client but also has extra functionality for managing viewing key registration and decryption
@@ -52,6 +56,22 @@ type EncRPCClient struct {
 	logger           gethlog.Logger
 }
 
+type requestIDKey struct{}
+
+// WithRequestID - the sensitive calls made with the context carry the request ID, which the enclave logs when they fail
+// and echoes in its responses, so the logs of the caller can be matched with the ones of the enclave
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+func requestIDFrom(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
 // NewEncRPCClient sets up a client with a viewing key for encrypted communication
 func NewEncRPCClient(client Client, viewingKey *viewingkey.ViewingKey, logger gethlog.Logger) (*EncRPCClient, error) {
 	// todo: this is a convenience for testnet but needs to replaced by a parameter and/or retrieved from the target host
@@ -209,8 +229,12 @@ func (c *EncRPCClient) createAuthenticatedLogSubscription(args []interface{}) (*
 }
 
 func (c *EncRPCClient) executeSensitiveCall(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	requestID := requestIDFrom(ctx)
+	if err := rpc.ValidateRequestID(requestID); err != nil {
+		return err
+	}
 	// encode the params into a json blob and encrypt them
-	encryptedParams, err := c.encryptArgs(args...)
+	encryptedParams, err := c.encryptArgs(requestID, args...)
 	if err != nil {
 		return fmt.Errorf("failed to encrypt args for %s call - %w", method, err)
 	}
@@ -246,7 +270,11 @@ func (c *EncRPCClient) executeSensitiveCall(ctx context.Context, result interfac
 
 	// We decode the UserResponse but keep the result as a json object
 	// this method returns the user error if any and the result encoded as json.
-	decodedResult, decodedError := responses.DecodeResponse[json.RawMessage](decrypted)
+	decodedResult, echoedID, decodedError := responses.DecodeResponseWithRequestID[json.RawMessage](decrypted)
+	// the enclaves which predate the request IDs don't echo them
+	if echoedID != "" && echoedID != requestID {
+		return fmt.Errorf("the response to the %s call is for request %q, not for request %q", method, echoedID, requestID)
+	}
 
 	// If there is a user error that was decrypted we return it
 	if decodedError != nil {
@@ -262,6 +290,13 @@ func (c *EncRPCClient) executeSensitiveCall(ctx context.Context, result interfac
 			return result
 		}
 
+		// the errors which come with a result, e.g. the hash of a transaction already known, populate it
+		if decodedResult != nil {
+			resultBytes, _ := decodedResult.MarshalJSON()
+			if err = json.Unmarshal(resultBytes, result); err != nil {
+				return fmt.Errorf("could not populate the response object with the json_rpc result. Cause: %w", err)
+			}
+		}
 		// Return the user error.
 		return decodedError
 	}
@@ -300,7 +335,7 @@ func (c *EncRPCClient) Account() *gethcommon.Address {
 	return c.viewingKey.Account
 }
 
-func (c *EncRPCClient) encryptArgs(args ...interface{}) ([]byte, error) {
+func (c *EncRPCClient) encryptArgs(requestID string, args ...interface{}) ([]byte, error) {
 	if len(args) == 0 {
 		return nil, nil
 	}
@@ -309,7 +344,7 @@ func (c *EncRPCClient) encryptArgs(args ...interface{}) ([]byte, error) {
 		PublicKey:               c.viewingKey.PublicKey,
 		SignatureWithAccountKey: c.viewingKey.SignatureWithAccountKey,
 	}
-	argsWithVK := &rpc.RequestWithVk{VK: &vk, Params: args}
+	argsWithVK := &rpc.RequestWithVk{VK: &vk, Params: args, RequestID: requestID}
 
 	paramsJSON, err := json.Marshal(argsWithVK)
 	if err != nil {
@@ -0,0 +1,96 @@
+package common
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// LatencyDistribution - the distribution of the delays of the messages on the mock networks
+type LatencyDistribution interface {
+	sample(rng *rand.Rand) time.Duration
+}
+
+// UniformLatency - delays picked evenly between Min and Max
+type UniformLatency struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+func (d UniformLatency) sample(rng *rand.Rand) time.Duration {
+	if d.Max <= d.Min {
+		return d.Min
+	}
+	return d.Min + time.Duration(rng.Int63n(int64(d.Max-d.Min)))
+}
+
+// NormalLatency - delays around the Mean, which are never negative
+type NormalLatency struct {
+	Mean   time.Duration
+	StdDev time.Duration
+}
+
+func (d NormalLatency) sample(rng *rand.Rand) time.Duration {
+	delay := time.Duration(rng.NormFloat64()*float64(d.StdDev)) + d.Mean
+	if delay < 0 {
+		return 0
+	}
+	return delay
+}
+
+// ParetoLatency - heavy-tailed delays of at least Scale. The lower the Shape, the heavier the tail. The delays are capped
+// at Max, unless it is zero.
+type ParetoLatency struct {
+	Scale time.Duration
+	Shape float64
+	Max   time.Duration
+}
+
+func (d ParetoLatency) sample(rng *rand.Rand) time.Duration {
+	// 1 - Float64() is in (0, 1], so the power is never infinite
+	delay := float64(d.Scale) / math.Pow(1-rng.Float64(), 1/d.Shape)
+	if d.Max > 0 && delay > float64(d.Max) {
+		return d.Max
+	}
+	return time.Duration(delay)
+}
+
+// Latency - the delays of the messages between the nodes of a mock network. Each direction of each link is slowed down or
+// sped up by its own factor, picked once between 1-asymmetry and 1+asymmetry. The delays only depend on the seed and on
+// the order of the calls.
+type Latency struct {
+	distribution LatencyDistribution
+	asymmetry    float64
+
+	lock        sync.Mutex
+	rng         *rand.Rand
+	linkFactors map[[2]int]float64
+}
+
+func NewLatency(distribution LatencyDistribution, asymmetry float64, seed int64) *Latency {
+	return &Latency{
+		distribution: distribution,
+		asymmetry:    asymmetry,
+		rng:          rand.New(rand.NewSource(seed)), //nolint:gosec
+		linkFactors:  make(map[[2]int]float64),
+	}
+}
+
+// Delay - the delay of a message from one node to another
+func (l *Latency) Delay(from int, to int) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	delay := l.distribution.sample(l.rng)
+	if l.asymmetry == 0 {
+		return delay
+	}
+	link := [2]int{from, to}
+	factor, found := l.linkFactors[link]
+	if !found {
+		factor = 1 + l.asymmetry*(2*l.rng.Float64()-1)
+		l.linkFactors[link] = factor
+	}
+	return time.Duration(float64(delay) * factor)
+}
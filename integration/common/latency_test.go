@@ -0,0 +1,32 @@
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLatenciesAreReproducible(t *testing.T) {
+	distribution := ParetoLatency{Scale: time.Millisecond, Shape: 1.5, Max: time.Second}
+	latency := NewLatency(distribution, 0.5, 42)
+	sameSeed := NewLatency(distribution, 0.5, 42)
+	for i := 0; i < 100; i++ {
+		delay := latency.Delay(i%3, (i+1)%3)
+		if delay != sameSeed.Delay(i%3, (i+1)%3) {
+			t.Fatalf("the latencies with the same seed differ")
+		}
+		// the asymmetry scales the delays by at most 50%
+		if delay < distribution.Scale/2 || delay > distribution.Max*3/2 {
+			t.Errorf("delay %s is out of bounds", delay)
+		}
+	}
+}
+
+func TestLinksAreAsymmetric(t *testing.T) {
+	latency := NewLatency(UniformLatency{Min: time.Second, Max: time.Second}, 0.5, 42)
+	if latency.Delay(0, 1) == latency.Delay(1, 0) {
+		t.Errorf("the two directions of the link have the same delay")
+	}
+	if latency.Delay(0, 1) != latency.Delay(0, 1) {
+		t.Errorf("the factor of the link changed")
+	}
+}
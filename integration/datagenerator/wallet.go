@@ -2,7 +2,10 @@ package datagenerator
 
 import (
 	"encoding/hex"
+	"math/big"
+	"math/rand"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ten-protocol/go-ten/integration/common/testlog"
 
 	"github.com/ten-protocol/go-ten/go/wallet"
@@ -17,6 +20,19 @@ func RandomWallet(chainID int64) wallet.Wallet {
 	)
 }
 
+// RandomWalletFrom returns a wallet with a private key drawn from the source, so the sources with the same seed return
+// the same wallets
+func RandomWalletFrom(rng *rand.Rand, chainID int64) wallet.Wallet {
+	keyBytes := make([]byte, 32)
+	for {
+		_, _ = rng.Read(keyBytes)
+		// the bytes which are not a valid key, e.g. above the order of the curve, are drawn again
+		if key, err := crypto.ToECDSA(keyBytes); err == nil {
+			return wallet.NewInMemoryWalletFromPK(big.NewInt(chainID), key, testlog.Logger())
+		}
+	}
+}
+
 func randomHex(n int) string {
 	return hex.EncodeToString(RandomBytes(n))
 }
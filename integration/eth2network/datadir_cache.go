@@ -0,0 +1,183 @@
+package eth2network
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultDataDirCachePath - where the snapshots of the initialised geth datadirs are kept between runs
+var DefaultDataDirCachePath = path.Join(basepath, "../.build/eth2_datadir_cache")
+
+// maxCachedSnapshots - the number of snapshots kept in the cache, the least recently used ones are evicted
+const maxCachedSnapshots = 3
+
+// Option - an optional setting of the network
+type Option func(n *Impl)
+
+// WithDataDirCache - snapshots the geth datadirs into the cache dir once the genesis is initialised, and restores them
+// on the next runs with the same genesis, number of nodes and geth binary instead of running `geth init` again. A new
+// genesis or geth binary gives a new cache key, so the stale snapshots are never restored. The ports are not part of
+// the datadirs, so the networks restored from the same snapshot can still run in parallel on different ports.
+// The miner keys are derived from the chain ID, and the prefunded addresses, which change between runs, are funded once
+// the network is up instead of in the genesis, so the genesis is stable across runs. Only the most recently used
+// snapshots are kept.
+func WithDataDirCache(cacheDir string) Option {
+	return func(n *Impl) {
+		n.dataDirCacheDir = cacheDir
+	}
+}
+
+// dataDirCacheKey - the key of the snapshot of the datadirs initialised with the given genesis and geth binary
+func dataDirCacheKey(genesis []byte, numNodes int, gethBinaryPath string) (string, error) {
+	gethBinary, err := os.Open(gethBinaryPath)
+	if err != nil {
+		return "", fmt.Errorf("could not open the geth binary - %w", err)
+	}
+	defer gethBinary.Close()
+
+	hash := sha256.New()
+	if _, err = io.Copy(hash, gethBinary); err != nil {
+		return "", fmt.Errorf("could not hash the geth binary - %w", err)
+	}
+	hash.Write(genesis)
+	hash.Write([]byte(strconv.Itoa(numNodes)))
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// restoreDataDirs - copies the snapshot of the datadirs into the node datadirs, returns false if there is no snapshot
+func restoreDataDirs(cacheDir string, key string, dataDirs []string) (bool, error) {
+	snapshotDir := path.Join(cacheDir, key)
+	if _, err := os.Stat(snapshotDir); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for i, dataDir := range dataDirs {
+		if err := copyDir(path.Join(snapshotDir, "n"+strconv.Itoa(i)), dataDir); err != nil {
+			return false, fmt.Errorf("could not restore the datadir of node %d - %w", i, err)
+		}
+	}
+	// the snapshot was used, so it is the last one to be evicted
+	now := time.Now()
+	if err := os.Chtimes(snapshotDir, now, now); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// evictSnapshots - removes the least recently used snapshots beyond the most recent ones. The snapshots still being
+// built by other runs are left alone.
+func evictSnapshots(cacheDir string, keep int) error {
+	entries, err := os.ReadDir(cacheDir)
+	if err != nil {
+		return err
+	}
+	type snapshot struct {
+		name   string
+		usedAt time.Time
+	}
+	var snapshots []snapshot
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.Contains(entry.Name(), "-") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot{name: entry.Name(), usedAt: info.ModTime()})
+	}
+	if len(snapshots) <= keep {
+		return nil
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].usedAt.After(snapshots[j].usedAt)
+	})
+	for _, evicted := range snapshots[keep:] {
+		if err = os.RemoveAll(path.Join(cacheDir, evicted.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshotDataDirs - copies the node datadirs into the cache. The snapshot is built in a temporary folder and renamed
+// once complete, so a concurrent run never restores a partial snapshot.
+func snapshotDataDirs(cacheDir string, key string, dataDirs []string) error {
+	if err := os.MkdirAll(cacheDir, os.ModePerm); err != nil {
+		return err
+	}
+	tmpDir, err := os.MkdirTemp(cacheDir, key+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	for i, dataDir := range dataDirs {
+		if err = copyDir(dataDir, path.Join(tmpDir, "n"+strconv.Itoa(i))); err != nil {
+			return fmt.Errorf("could not snapshot the datadir of node %d - %w", i, err)
+		}
+	}
+
+	err = os.Rename(tmpDir, path.Join(cacheDir, key))
+	if err != nil {
+		if _, statErr := os.Stat(path.Join(cacheDir, key)); statErr == nil {
+			// a concurrent run stored the same snapshot first
+			return nil
+		}
+	}
+	return err
+}
+
+func copyDir(srcDir string, dstDir string) error {
+	return filepath.WalkDir(srcDir, func(srcPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(srcDir, srcPath)
+		if err != nil {
+			return err
+		}
+		dstPath := filepath.Join(dstDir, relPath)
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return os.MkdirAll(dstPath, info.Mode().Perm())
+		}
+		return copyFile(srcPath, dstPath, info.Mode().Perm())
+	})
+}
+
+func copyFile(srcPath string, dstPath string, perm fs.FileMode) error {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(dstPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	return dst.Close()
+}
@@ -0,0 +1,96 @@
+package eth2network
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataDirCacheKeyChangesWithTheInputs(t *testing.T) {
+	temp := t.TempDir()
+	gethBinaryPath := path.Join(temp, "geth")
+	assert.Nil(t, os.WriteFile(gethBinaryPath, []byte("geth v1"), 0o600))
+
+	key, err := dataDirCacheKey([]byte("genesis"), 2, gethBinaryPath)
+	assert.Nil(t, err)
+	sameKey, err := dataDirCacheKey([]byte("genesis"), 2, gethBinaryPath)
+	assert.Nil(t, err)
+	assert.Equal(t, key, sameKey)
+
+	otherGenesisKey, err := dataDirCacheKey([]byte("other genesis"), 2, gethBinaryPath)
+	assert.Nil(t, err)
+	assert.NotEqual(t, key, otherGenesisKey)
+
+	otherNumNodesKey, err := dataDirCacheKey([]byte("genesis"), 3, gethBinaryPath)
+	assert.Nil(t, err)
+	assert.NotEqual(t, key, otherNumNodesKey)
+
+	assert.Nil(t, os.WriteFile(gethBinaryPath, []byte("geth v2"), 0o600))
+	otherGethKey, err := dataDirCacheKey([]byte("genesis"), 2, gethBinaryPath)
+	assert.Nil(t, err)
+	assert.NotEqual(t, key, otherGethKey)
+}
+
+func TestDataDirsAreRestoredFromTheSnapshot(t *testing.T) {
+	temp := t.TempDir()
+	cacheDir := path.Join(temp, "cache")
+	dataDirs := []string{path.Join(temp, "run1", "n0"), path.Join(temp, "run1", "n1")}
+	for i, dataDir := range dataDirs {
+		assert.Nil(t, os.MkdirAll(path.Join(dataDir, "geth", "chaindata"), os.ModePerm))
+		assert.Nil(t, os.WriteFile(path.Join(dataDir, "geth", "chaindata", "000001.log"), []byte{byte(i)}, 0o600))
+	}
+
+	restored, err := restoreDataDirs(cacheDir, "key", dataDirs)
+	assert.Nil(t, err)
+	assert.False(t, restored)
+
+	assert.Nil(t, snapshotDataDirs(cacheDir, "key", dataDirs))
+	// a second snapshot with the same key, e.g. from a concurrent run, is not an error
+	assert.Nil(t, snapshotDataDirs(cacheDir, "key", dataDirs))
+
+	restoredDataDirs := []string{path.Join(temp, "run2", "n0"), path.Join(temp, "run2", "n1")}
+	restored, err = restoreDataDirs(cacheDir, "key", restoredDataDirs)
+	assert.Nil(t, err)
+	assert.True(t, restored)
+	for i, dataDir := range restoredDataDirs {
+		content, err := os.ReadFile(path.Join(dataDir, "geth", "chaindata", "000001.log"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{byte(i)}, content)
+	}
+
+	restored, err = restoreDataDirs(cacheDir, "other key", restoredDataDirs)
+	assert.Nil(t, err)
+	assert.False(t, restored)
+}
+
+func TestLeastRecentlyUsedSnapshotsAreEvicted(t *testing.T) {
+	temp := t.TempDir()
+	cacheDir := path.Join(temp, "cache")
+	dataDirs := []string{path.Join(temp, "run", "n0")}
+	assert.Nil(t, os.MkdirAll(dataDirs[0], os.ModePerm))
+
+	usedAt := time.Now().Add(-time.Hour)
+	for _, key := range []string{"oldest", "older", "recent"} {
+		assert.Nil(t, snapshotDataDirs(cacheDir, key, dataDirs))
+		assert.Nil(t, os.Chtimes(path.Join(cacheDir, key), usedAt, usedAt))
+		usedAt = usedAt.Add(time.Minute)
+	}
+	// restoring the oldest snapshot makes it the most recently used one
+	restored, err := restoreDataDirs(cacheDir, "oldest", []string{path.Join(temp, "restored", "n0")})
+	assert.Nil(t, err)
+	assert.True(t, restored)
+	// the snapshot being built by a concurrent run is not evicted
+	assert.Nil(t, os.MkdirAll(path.Join(cacheDir, "building-123"), os.ModePerm))
+
+	assert.Nil(t, evictSnapshots(cacheDir, 2))
+	entries, err := os.ReadDir(cacheDir)
+	assert.Nil(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.ElementsMatch(t, []string{"oldest", "recent", "building-123"}, names)
+}
@@ -95,8 +95,14 @@ const _baseGenesis = `{
   }
 }`
 
-// generateGenesis returns a genesis with specified params
-func generateGenesis(blockTimeSecs int, chainID int, signerAddrs, prefundedAddrs []string) (string, error) {
+// _preFundedBalance - the balance of each of the prefunded addresses
+const _preFundedBalance = "7500000000000000000000000000000"
+
+// _funderBalance - the balance of the funder, which pays the balance of the prefunded addresses left out of the genesis
+const _funderBalance = "7500000000000000000000000000000000000"
+
+// generateGenesis returns a genesis with specified params. The funder is not prefunded when empty.
+func generateGenesis(blockTimeSecs int, chainID int, signerAddrs, prefundedAddrs []string, funderAddr string) (string, error) {
 	var genesisJSON map[string]interface{}
 
 	err := json.Unmarshal([]byte(_baseGenesis), &genesisJSON)
@@ -106,7 +112,10 @@ func generateGenesis(blockTimeSecs int, chainID int, signerAddrs, prefundedAddrs
 
 	// add the prefunded prefundedAddrs
 	for _, account := range prefundedAddrs {
-		genesisJSON["alloc"].(map[string]interface{})[account] = map[string]string{"balance": "7500000000000000000000000000000"}
+		genesisJSON["alloc"].(map[string]interface{})[account] = map[string]string{"balance": _preFundedBalance}
+	}
+	if funderAddr != "" {
+		genesisJSON["alloc"].(map[string]interface{})[funderAddr] = map[string]string{"balance": _funderBalance}
 	}
 
 	// set the block prod speed
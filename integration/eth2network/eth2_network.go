@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/big"
+	"math/rand"
 	"net/http"
 	"os"
 	"os/exec"
@@ -14,8 +16,11 @@ import (
 	"time"
 
 	"github.com/ten-protocol/go-ten/go/common/retry"
+	"github.com/ten-protocol/go-ten/go/wallet"
 
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ten-protocol/go-ten/integration/datagenerator"
 	"golang.org/x/sync/errgroup"
 
@@ -57,12 +62,17 @@ type Impl struct {
 	prysmValidtorLogFile     io.Writer
 	preFundedMinerAddrs      []string
 	preFundedMinerPKs        []string
+	funder                   wallet.Wallet // pays the balance of the preFundedAddrs, when they are left out of the genesis
+	preFundedAddrs           []string
 	gethGenesisBytes         []byte
 	timeout                  time.Duration
+	dataDirCacheDir          string // the datadirs are initialised from scratch when empty
 }
 
 type Eth2Network interface {
 	GethGenesis() []byte
+	GethHTTPPorts() []int // the HTTP RPC port of each geth node
+	GethWSPorts() []int   // the websocket RPC port of each geth node
 	Start() error
 	Stop() error
 }
@@ -83,7 +93,13 @@ func NewEth2Network(
 	secondsPerSlot int,
 	preFundedAddrs []string,
 	timeout time.Duration,
+	opts ...Option,
 ) Eth2Network {
+	network := &Impl{}
+	for _, opt := range opts {
+		opt(network)
+	}
+
 	// Build dirs are suffixed with a timestamp so multiple executions don't collide
 	timestamp := strconv.FormatInt(time.Now().UnixMicro(), 10)
 
@@ -112,13 +128,30 @@ func NewEth2Network(
 	// Generate pk pairs for miners
 	preFundedMinerAddrs := make([]string, numNodes)
 	preFundedMinerPKs := make([]string, numNodes)
+	// the cached datadirs can only be restored if the genesis, and so the miner addresses, are the same on every run
+	minerRng := rand.New(rand.NewSource(int64(chainID))) //nolint:gosec
 	for i := 0; i < numNodes; i++ {
-		w := datagenerator.RandomWallet(int64(chainID))
+		var w wallet.Wallet
+		if network.dataDirCacheDir != "" {
+			w = datagenerator.RandomWalletFrom(minerRng, int64(chainID))
+		} else {
+			w = datagenerator.RandomWallet(int64(chainID))
+		}
 		preFundedMinerAddrs[i] = w.Address().Hex()
 		preFundedMinerPKs[i] = fmt.Sprintf("%x", w.PrivateKey().D.Bytes())
 	}
+	// the cached datadirs are only restored on the runs with the same genesis, so the addresses which change between
+	// runs are left out of it, and funded by a funder derived from the chain ID once the network is up
+	genesisPreFundedAddrs := append(append([]string{}, preFundedAddrs...), preFundedMinerAddrs...)
+	var funder wallet.Wallet
+	var funderAddr string
+	if network.dataDirCacheDir != "" {
+		funder = datagenerator.RandomWalletFrom(minerRng, int64(chainID))
+		funderAddr = funder.Address().Hex()
+		genesisPreFundedAddrs = append([]string{}, preFundedMinerAddrs...)
+	}
 	// Generate and write genesis file
-	genesisStr, err := generateGenesis(blockTimeSecs, chainID, preFundedMinerAddrs, append(preFundedAddrs, preFundedMinerAddrs...))
+	genesisStr, err := generateGenesis(blockTimeSecs, chainID, preFundedMinerAddrs, genesisPreFundedAddrs, funderAddr)
 	if err != nil {
 		panic(err)
 	}
@@ -156,6 +189,8 @@ func NewEth2Network(
 		prysmBeaconHTTPPorts[i] = prysmBeaconHTTPPortStart + i
 		prysmBeaconP2PPorts[i] = prysmBeaconP2PPortStart + i
 	}
+	// the beacon p2p ports are UDP, so they can share the numbers of the TCP ports
+	ensureNoPortClash(gethHTTPPorts, gethWSPorts, gethAuthRPCPorts, gethNetworkPorts, prysmBeaconHTTPPorts)
 
 	// create the log files
 	gethLogFile := io.Writer(os.Stdout)
@@ -177,7 +212,7 @@ func NewEth2Network(
 		}
 	}
 
-	return &Impl{
+	*network = Impl{
 		buildDir:                 buildDir,
 		binDir:                   binDir,
 		dataDirs:                 dataDirs,
@@ -203,9 +238,13 @@ func NewEth2Network(
 		prysmValidtorLogFile:     prysmValidatorLogFile,
 		preFundedMinerAddrs:      preFundedMinerAddrs,
 		preFundedMinerPKs:        preFundedMinerPKs,
+		funder:                   funder,
+		preFundedAddrs:           preFundedAddrs,
 		gethGenesisBytes:         []byte(genesisStr),
 		timeout:                  timeout,
+		dataDirCacheDir:          network.dataDirCacheDir,
 	}
+	return network
 }
 
 // Start starts the network
@@ -218,13 +257,7 @@ func (n *Impl) Start() error {
 	}
 
 	// initialize the genesis data on the nodes
-	for _, nodeDataDir := range n.dataDirs {
-		dataDir := nodeDataDir
-		eg.Go(func() error {
-			return n.gethInitGenesisData(dataDir)
-		})
-	}
-	err := eg.Wait()
+	err := n.initGenesisData()
 	if err != nil {
 		return err
 	}
@@ -353,6 +386,72 @@ func (n *Impl) GethGenesis() []byte {
 	return n.gethGenesisBytes
 }
 
+// initGenesisData - initialises the genesis on the datadirs of the nodes, or restores them from the cache
+func (n *Impl) initGenesisData() error {
+	var cacheKey string
+	if n.dataDirCacheDir != "" {
+		var err error
+		cacheKey, err = dataDirCacheKey(n.gethGenesisBytes, len(n.dataDirs), n.gethBinaryPath)
+		if err != nil {
+			return err
+		}
+		restored, err := restoreDataDirs(n.dataDirCacheDir, cacheKey, n.dataDirs)
+		if err != nil {
+			return err
+		}
+		if restored {
+			fmt.Printf("Geth datadirs restored from the snapshot %s\n", cacheKey)
+			return nil
+		}
+	}
+
+	var eg errgroup.Group
+	for _, nodeDataDir := range n.dataDirs {
+		dataDir := nodeDataDir
+		eg.Go(func() error {
+			return n.gethInitGenesisData(dataDir)
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	if n.dataDirCacheDir != "" {
+		// the network still starts without the snapshot, it is only slower on the next run
+		if err := snapshotDataDirs(n.dataDirCacheDir, cacheKey, n.dataDirs); err != nil {
+			fmt.Printf("Could not snapshot the geth datadirs - %s\n", err)
+		}
+		if err := evictSnapshots(n.dataDirCacheDir, maxCachedSnapshots); err != nil {
+			fmt.Printf("Could not evict the old geth datadir snapshots - %s\n", err)
+		}
+	}
+	return nil
+}
+
+// GethHTTPPorts returns the HTTP RPC port of each geth node
+func (n *Impl) GethHTTPPorts() []int {
+	return n.gethHTTPPorts
+}
+
+// GethWSPorts returns the websocket RPC port of each geth node
+func (n *Impl) GethWSPorts() []int {
+	return n.gethWSPorts
+}
+
+// ensureNoPortClash panics if the port ranges overlap, e.g. when there are more nodes than the gap between two start
+// ports
+func ensureNoPortClash(portRanges ...[]int) {
+	usedPorts := map[int]bool{}
+	for _, portRange := range portRanges {
+		for _, port := range portRange {
+			if usedPorts[port] {
+				panic(fmt.Sprintf("port %d is allocated twice", port))
+			}
+			usedPorts[port] = true
+		}
+	}
+}
+
 func (n *Impl) gethInitGenesisData(dataDirPath string) error {
 	// full command list at https://geth.ethereum.org/docs/fundamentals/command-line-options
 	args := []string{_dataDirFlag, dataDirPath, "init", n.gethGenesisPath}
@@ -525,6 +624,66 @@ func (n *Impl) waitForMergeEvent(startTime time.Time) error {
 		fmt.Printf("Error prefunding accounts %s\n", err.Error())
 		return err
 	}
+	if n.funder != nil {
+		if err = n.fundPreFundedAddrs(dial); err != nil {
+			fmt.Printf("Error prefunding accounts %s\n", err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
+// fundPreFundedAddrs - transfers to the prefunded addresses left out of the genesis the balance they would have had
+func (n *Impl) fundPreFundedAddrs(client *ethclient.Client) error {
+	ctx := context.Background()
+	balance, _ := new(big.Int).SetString(_preFundedBalance, 10)
+	nonce, err := client.PendingNonceAt(ctx, n.funder.Address())
+	if err != nil {
+		return fmt.Errorf("unable to fetch the nonce of the funder - %w", err)
+	}
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch the gas price - %w", err)
+	}
+
+	txHashes := make([]gethcommon.Hash, 0, len(n.preFundedAddrs))
+	for i, addr := range n.preFundedAddrs {
+		to := gethcommon.HexToAddress(addr)
+		tx, err := n.funder.SignTransaction(&types.LegacyTx{
+			Nonce:    nonce + uint64(i),
+			GasPrice: gasPrice,
+			Gas:      params.TxGas,
+			To:       &to,
+			Value:    balance,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to sign the funding of account %s - %w", addr, err)
+		}
+		if err = client.SendTransaction(ctx, tx); err != nil {
+			return fmt.Errorf("unable to fund account %s - %w", addr, err)
+		}
+		txHashes = append(txHashes, tx.Hash())
+	}
+
+	for _, txHash := range txHashes {
+		err = retry.Do(
+			func() error {
+				receipt, err := client.TransactionReceipt(ctx, txHash)
+				if err != nil {
+					return err
+				}
+				if receipt.Status != types.ReceiptStatusSuccessful {
+					return retry.FailFast(fmt.Errorf("funding transaction %s failed", txHash))
+				}
+				return nil
+			},
+			retry.NewTimeoutStrategy(n.timeout, time.Second),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	fmt.Printf("Funded %d accounts\n", len(txHashes))
 	return nil
 }
 
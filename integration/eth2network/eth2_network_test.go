@@ -86,6 +86,11 @@ func TestStartEth2Network(t *testing.T) {
 	t.Run("txsAreMinted", func(t *testing.T) {
 		txsAreMinted(t, randomWallets)
 	})
+
+	// every node serves its RPC over HTTP
+	t.Run("chainIDOverHTTP", func(t *testing.T) {
+		chainIDOverHTTP(t, network, chainID)
+	})
 }
 
 func areConfigsUphold(t *testing.T, addr gethcommon.Address, chainID int) {
@@ -102,6 +107,18 @@ func areConfigsUphold(t *testing.T, addr gethcommon.Address, chainID int) {
 	assert.Equal(t, int64(chainID), id.Int64())
 }
 
+func chainIDOverHTTP(t *testing.T, network Eth2Network, chainID int) {
+	assert.Equal(t, _numTestNodes, len(network.GethHTTPPorts()))
+	for _, port := range network.GethHTTPPorts() {
+		conn, err := ethclient.Dial(fmt.Sprintf("http://127.0.0.1:%d", port))
+		assert.Nil(t, err)
+
+		id, err := conn.ChainID(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, int64(chainID), id.Int64())
+	}
+}
+
 func numberOfNodes(t *testing.T) {
 	for i := 0; i < _numTestNodes; i++ {
 		url := fmt.Sprintf("http://127.0.0.1:%d", _startPort+i)
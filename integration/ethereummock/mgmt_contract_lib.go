@@ -6,6 +6,7 @@ import (
 
 	"github.com/ten-protocol/go-ten/integration/datagenerator"
 
+	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -99,6 +100,10 @@ func (m *mockContractLib) DecodeImportantAddressResponse([]byte) (gethcommon.Add
 	return gethcommon.Address{}, nil
 }
 
+func (m *mockContractLib) DecodeNetworkParametersUpdate(types.Receipts) (*common.NetworkParametersUpdate, error) {
+	return nil, nil //nolint:nilnil
+}
+
 func decodeTx(tx *types.Transaction) ethadapter.L1Transaction {
 	if len(tx.Data()) == 0 {
 		panic("Data cannot be 0 in the mock implementation")
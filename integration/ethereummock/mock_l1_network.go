@@ -29,17 +29,19 @@ type MockEthNetwork struct {
 	AllNodes []*Node
 
 	// config
-	avgLatency       time.Duration
+	latency          *testcommon.Latency
+	nodeIdx          int // the index of the current node in AllNodes
 	avgBlockDuration time.Duration
 
 	Stats *stats.Stats
 }
 
 // NewMockEthNetwork returns an instance of a configured L1 Network (no nodes)
-func NewMockEthNetwork(avgBlockDuration time.Duration, avgLatency time.Duration, stats *stats.Stats) *MockEthNetwork {
+func NewMockEthNetwork(avgBlockDuration time.Duration, latency *testcommon.Latency, nodeIdx int, stats *stats.Stats) *MockEthNetwork {
 	return &MockEthNetwork{
 		Stats:            stats,
-		avgLatency:       avgLatency,
+		latency:          latency,
+		nodeIdx:          nodeIdx,
 		avgBlockDuration: avgBlockDuration,
 	}
 }
@@ -47,10 +49,10 @@ func NewMockEthNetwork(avgBlockDuration time.Duration, avgLatency time.Duration,
 // BroadcastBlock broadcast a block to the l1 nodes
 func (n *MockEthNetwork) BroadcastBlock(b common.EncodedL1Block, p common.EncodedL1Block) {
 	bl, _ := b.DecodeBlock()
-	for _, m := range n.AllNodes {
+	for i, m := range n.AllNodes {
 		if m.Info().L2ID != n.CurrentNode.Info().L2ID {
 			t := m
-			async.Schedule(n.delay(), func() { t.P2PReceiveBlock(b, p) })
+			async.Schedule(n.delay(i), func() { t.P2PReceiveBlock(b, p) })
 		} else {
 			m.logger.Info(printBlock(bl, m))
 		}
@@ -61,20 +63,20 @@ func (n *MockEthNetwork) BroadcastBlock(b common.EncodedL1Block, p common.Encode
 
 // BroadcastTx Broadcasts the L1 tx containing the rollup to the L1 network
 func (n *MockEthNetwork) BroadcastTx(tx *types.Transaction) {
-	for _, m := range n.AllNodes {
+	for i, m := range n.AllNodes {
 		if m.Info().L2ID != n.CurrentNode.Info().L2ID {
 			t := m
 			// the time to broadcast a tx is half that of a L1 block, because it is smaller.
 			// todo - find a better way to express this
-			d := n.delay() / 2
+			d := n.delay(i) / 2
 			async.Schedule(d, func() { t.P2PGossipTx(tx) })
 		}
 	}
 }
 
-// delay returns an expected delay on the l1 network
-func (n *MockEthNetwork) delay() time.Duration {
-	return testcommon.RndBtwTime(n.avgLatency/10, 2*n.avgLatency)
+// delay returns an expected delay on the l1 network, for a message to the node with the given index
+func (n *MockEthNetwork) delay(toIdx int) time.Duration {
+	return n.latency.Delay(n.nodeIdx, toIdx)
 }
 
 func printBlock(b *types.Block, m *Node) string {
@@ -532,7 +532,7 @@ func TestL2IssueContractSubscribeChange(t *testing.T) {
 	}
 
 	result := json.RawMessage{}
-	err = client.Call(&result, "debug_eventLogRelevancy", signedTx.Hash())
+	err = client.Call(&result, rpc.DebugLogRelevancy, signedTx.Hash())
 	assert.NoError(t, err)
 	fmt.Println(err)
 }
@@ -18,13 +18,16 @@ import (
 
 type InMemNode struct {
 	cfg     *node.Config
+	wrapP2P hostcontainer.P2PWrapper
 	enclave *enclavecontainer.EnclaveContainer
 	host    *hostcontainer.HostContainer
 }
 
-func NewInMemNode(cfg *node.Config) *InMemNode {
+// NewInMemNode - the P2P layer of the host is wrapped by wrapP2P, if it is not nil
+func NewInMemNode(cfg *node.Config, wrapP2P hostcontainer.P2PWrapper) *InMemNode {
 	return &InMemNode{
-		cfg: cfg,
+		cfg:     cfg,
+		wrapP2P: wrapP2P,
 	}
 }
 
@@ -92,7 +95,7 @@ func (d *InMemNode) startHost() error {
 		panic("unable to calculate the Node ID")
 	}
 	logger := testlog.Logger().New(log.CmpKey, log.HostCmp, log.NodeIDKey, *addr)
-	d.host = hostcontainer.NewHostContainerFromConfig(hostConfig, logger)
+	d.host = hostcontainer.NewHostContainerFromConfig(hostConfig, logger, d.wrapP2P)
 	return d.host.Start()
 }
 
@@ -101,6 +104,10 @@ func (d *InMemNode) startEnclave() error {
 	logger := testlog.Logger().New(log.CmpKey, log.EnclaveCmp, log.NodeIDKey, enclaveCfg.HostID)
 
 	// if not nil, the node will use the testlog.Logger - NewEnclaveContainerWithLogger will create one otherwise
-	d.enclave = enclavecontainer.NewEnclaveContainerWithLogger(enclaveCfg, logger)
+	enclaveContainer, err := enclavecontainer.NewEnclaveContainerWithLogger(enclaveCfg, logger)
+	if err != nil {
+		return err
+	}
+	d.enclave = enclaveContainer
 	return d.enclave.Start()
 }
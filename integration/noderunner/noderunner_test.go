@@ -134,5 +134,5 @@ func createInMemoryNode(t *testing.T) (node.Node, gethcommon.Address) {
 		node.WithL1BlockTime(1*time.Second),
 	)
 
-	return NewInMemNode(nodeCfg), hostAddress
+	return NewInMemNode(nodeCfg, nil), hostAddress
 }
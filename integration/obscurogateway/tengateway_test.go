@@ -647,7 +647,6 @@ func createTenNetwork(t *testing.T, startPort int) {
 		ERC20ContractLib: ethereummock.NewERC20ContractLibMock(),
 		Wallets:          wallets,
 		StartPort:        startPort,
-		WithPrefunding:   true,
 	}
 
 	tenNetwork := network.NewNetworkOfSocketNodes(wallets)
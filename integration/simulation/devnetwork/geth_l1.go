@@ -3,8 +3,6 @@ package devnetwork
 import (
 	"fmt"
 
-	"github.com/ten-protocol/go-ten/integration"
-
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 	"github.com/ten-protocol/go-ten/integration/eth2network"
 	"github.com/ten-protocol/go-ten/integration/simulation/network"
@@ -32,7 +30,7 @@ func (g *gethDockerNetwork) Prepare() {
 	}
 	g.l1Clients = make([]ethadapter.EthClient, g.l1Config.NumNodes)
 	for i := 0; i < g.l1Config.NumNodes; i++ {
-		g.l1Clients[i] = network.CreateEthClientConnection(int64(i), uint(g.l1Config.PortStart+integration.DefaultGethWSPortOffset))
+		g.l1Clients[i] = network.CreateEthClientConnection(int64(i), uint(gethNetwork.GethWSPorts()[0]))
 	}
 	g.ethNetwork = gethNetwork
 }
@@ -96,7 +96,11 @@ func (n *InMemNodeOperator) StartHost() error {
 func (n *InMemNodeOperator) StartEnclave() error {
 	// even if enclave was running previously we recreate the container to ensure state is like a new process
 	// todo (@matt) - check if enclave is still running?
-	n.enclave = n.createEnclaveContainer()
+	enclaveContainer, err := n.createEnclaveContainer()
+	if err != nil {
+		return fmt.Errorf("failed to create enclave - %w", err)
+	}
+	n.enclave = enclaveContainer
 	return n.enclave.Start()
 }
 
@@ -134,6 +138,7 @@ func (n *InMemNodeOperator) createHostContainer() *hostcontainer.HostContainer {
 		RollupInterval:            n.config.RollupInterval,
 		L1BlockTime:               n.config.L1BlockTime,
 		MaxRollupSize:             1024 * 64,
+		CompressL2Updates:         true,
 	}
 
 	hostLogger := testlog.Logger().New(log.NodeIDKey, n.l1Wallet.Address(), log.CmpKey, log.HostCmp)
@@ -147,11 +152,11 @@ func (n *InMemNodeOperator) createHostContainer() *hostcontainer.HostContainer {
 	enclaveClient := enclaverpc.NewClient(hostConfig, testlog.Logger().New(log.NodeIDKey, n.l1Wallet.Address()))
 	rpcServer := clientrpc.NewServer(hostConfig, n.logger)
 	mgmtContractLib := mgmtcontractlib.NewMgmtContractLib(&hostConfig.ManagementContractAddress, n.logger)
-	l1Repo := l1.NewL1Repository(n.l1Client, []gethcommon.Address{hostConfig.ManagementContractAddress, hostConfig.MessageBusAddress}, n.logger)
+	l1Repo := l1.NewL1Repository(n.l1Client, []gethcommon.Address{hostConfig.ManagementContractAddress, hostConfig.MessageBusAddress}, hostConfig.L1FullReceipts, n.logger)
 	return hostcontainer.NewHostContainer(hostConfig, svcLocator, nodeP2p, n.l1Client, l1Repo, enclaveClient, mgmtContractLib, n.l1Wallet, rpcServer, hostLogger, metrics.New(false, 0, n.logger))
 }
 
-func (n *InMemNodeOperator) createEnclaveContainer() *enclavecontainer.EnclaveContainer {
+func (n *InMemNodeOperator) createEnclaveContainer() (*enclavecontainer.EnclaveContainer, error) {
 	enclaveLogger := testlog.Logger().New(log.NodeIDKey, n.l1Wallet.Address(), log.CmpKey, log.EnclaveCmp)
 	enclavePort := n.config.PortStart + integration.DefaultEnclaveOffset + n.operatorIdx
 	enclaveAddr := fmt.Sprintf("%s:%d", network.Localhost, enclavePort)
@@ -162,6 +167,7 @@ func (n *InMemNodeOperator) createEnclaveContainer() *enclavecontainer.EnclaveCo
 	defaultCfg := integrationCommon.DefaultEnclaveConfig()
 
 	enclaveConfig := &config.EnclaveConfig{
+		LogLevel:                  int(gethlog.LvlTrace), // the test log has a level of its own
 		HostID:                    n.l1Wallet.Address(),
 		SequencerID:               n.config.SequencerID,
 		HostAddress:               hostAddr,
@@ -184,6 +190,11 @@ func (n *InMemNodeOperator) createEnclaveContainer() *enclavecontainer.EnclaveCo
 		GasBatchExecutionLimit:    defaultCfg.GasBatchExecutionLimit,
 		GasLocalExecutionCapFlag:  defaultCfg.GasLocalExecutionCapFlag,
 		GasPaymentAddress:         defaultCfg.GasPaymentAddress,
+		StateRetention:            defaultCfg.StateRetention,
+		CallExecutionTimeout:      defaultCfg.CallExecutionTimeout,
+		CallMemoryCap:             defaultCfg.CallMemoryCap,
+		// the networks of the simulations start with the domain separated signatures
+		SignatureDomainActivationSeqNo: 0,
 	}
 	return enclavecontainer.NewEnclaveContainerWithLogger(enclaveConfig, enclaveLogger)
 }
@@ -0,0 +1,95 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common/retry"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/wallet"
+	"github.com/ten-protocol/go-ten/integration/common/testlog"
+)
+
+const (
+	// todo (@matt) these timings should be driven by the L1 block times
+	_deployTimeout         = 50 * time.Second
+	_deployInitialInterval = 100 * time.Millisecond
+	_deployMaxInterval     = 5 * time.Second
+)
+
+var errNoDeployReceipt = errors.New("no receipt for the deployment tx")
+
+// DeployContract returns receipt of deployment. The nonce is the pending nonce of the wallet on the L1, so the wallets
+// which already sent transactions, e.g. on a warm network, can deploy. The submission is retried on the transient
+// errors and the receipt is polled with a backoff, until the deadline.
+func DeployContract(workerClient ethadapter.EthClient, w wallet.Wallet, contractBytes []byte) (*types.Receipt, error) {
+	return deployContract(workerClient, w, contractBytes, _deployTimeout)
+}
+
+func deployContract(workerClient ethadapter.EthClient, w wallet.Wallet, contractBytes []byte, timeout time.Duration) (*types.Receipt, error) {
+	deadline := time.Now().Add(timeout)
+
+	var signedTx *types.Transaction
+	err := retry.Do(func() error {
+		nonce, err := workerClient.Nonce(w.Address())
+		if err != nil {
+			return fmt.Errorf("could not fetch the nonce - %w", err)
+		}
+		// the gas is estimated by the client
+		deployContractTx, err := workerClient.PrepareTransactionToSend(&types.LegacyTx{Data: contractBytes}, w.Address(), nonce)
+		if err != nil {
+			return failFastIfPermanent(fmt.Errorf("could not prepare the deployment tx - %w", err))
+		}
+		signedTx, err = w.SignTransaction(deployContractTx)
+		if err != nil {
+			return retry.FailFast(err)
+		}
+
+		err = workerClient.SendTransaction(signedTx)
+		// a resubmission of a tx which reached the mempool is a success
+		if err != nil && !strings.Contains(err.Error(), txpool.ErrAlreadyKnown.Error()) {
+			// a nonce which is already used is fetched again on the next attempt
+			return failFastIfPermanent(fmt.Errorf("could not submit the deployment tx %s - %w", signedTx.Hash(), err))
+		}
+		w.SetNonce(nonce + 1)
+		return nil
+	}, retry.NewTimeoutWithBackoffStrategy(timeout, _deployInitialInterval, _deployMaxInterval))
+	if err != nil {
+		return nil, fmt.Errorf("could not deploy the contract from %s - %w", w.Address(), err)
+	}
+
+	var receipt *types.Receipt
+	err = retry.Do(func() error {
+		receipt, err = workerClient.TransactionReceipt(signedTx.Hash())
+		if err != nil {
+			return err
+		}
+		if receipt == nil {
+			return errNoDeployReceipt
+		}
+		return nil
+	}, retry.NewTimeoutWithBackoffStrategy(time.Until(deadline), _deployInitialInterval, _deployMaxInterval))
+	if err != nil {
+		return nil, fmt.Errorf("failed to mine contract deploy tx (%s) into a block - %w", signedTx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("contract deploy tx (%s) was mined but failed", signedTx.Hash())
+	}
+
+	testlog.Logger().Info(fmt.Sprintf("Contract successfully deployed to %s", receipt.ContractAddress))
+	return receipt, nil
+}
+
+// failFastIfPermanent - stops the retries of the deployments which would fail the same way on every attempt
+func failFastIfPermanent(err error) error {
+	msg := err.Error()
+	if strings.Contains(msg, "execution reverted") || strings.Contains(msg, core.ErrInsufficientFunds.Error()) {
+		return retry.FailFast(err)
+	}
+	return err
+}
@@ -0,0 +1,128 @@
+package network
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/ten-protocol/go-ten/integration/common/testlog"
+	"github.com/ten-protocol/go-ten/integration/datagenerator"
+	"github.com/ten-protocol/go-ten/integration/ethereummock"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const testLogs = "../../.build/tests/"
+
+func setupTestLog() {
+	testlog.Setup(&testlog.Cfg{
+		LogDir:      testLogs,
+		TestType:    "unit",
+		TestSubtype: "contract-deployer",
+		LogLevel:    gethlog.LvlInfo,
+	})
+}
+
+// slowL1 - a mock L1 node which mines the transactions after a few receipt polls, and rejects the used nonces
+type slowL1 struct {
+	*ethereummock.Node
+	pollsToMine int
+
+	lock     sync.Mutex
+	nonce    uint64
+	polls    map[gethcommon.Hash]int
+	sentTxs  []*types.Transaction
+	rejected int
+}
+
+func newSlowL1(pollsToMine int, usedNonces uint64) *slowL1 {
+	return &slowL1{
+		Node:        &ethereummock.Node{},
+		pollsToMine: pollsToMine,
+		nonce:       usedNonces,
+		polls:       map[gethcommon.Hash]int{},
+	}
+}
+
+func (l *slowL1) Nonce(gethcommon.Address) (uint64, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.nonce, nil
+}
+
+func (l *slowL1) SendTransaction(tx *types.Transaction) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if tx.Nonce() < l.nonce {
+		l.rejected++
+		return fmt.Errorf("%w: address has nonce %d, tx has %d", core.ErrNonceTooLow, l.nonce, tx.Nonce())
+	}
+	l.nonce = tx.Nonce() + 1
+	l.sentTxs = append(l.sentTxs, tx)
+	return nil
+}
+
+func (l *slowL1) TransactionReceipt(hash gethcommon.Hash) (*types.Receipt, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.polls[hash]++
+	if l.polls[hash] <= l.pollsToMine {
+		return nil, ethereum.NotFound
+	}
+	return &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: hash}, nil
+}
+
+// staleNonceL1 - a mock L1 node which reports a stale pending nonce once, as when the pending state lags behind
+type staleNonceL1 struct {
+	*slowL1
+	staleNonceReported bool
+}
+
+func (l *staleNonceL1) Nonce(address gethcommon.Address) (uint64, error) {
+	if !l.staleNonceReported {
+		l.staleNonceReported = true
+		return 0, nil
+	}
+	return l.slowL1.Nonce(address)
+}
+
+func TestDeployContractWaitsForSlowMining(t *testing.T) {
+	setupTestLog()
+	l1 := newSlowL1(3, 0)
+	w := datagenerator.RandomWallet(1337)
+
+	receipt, err := deployContract(l1, w, []byte{0x1}, 10*time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(l1.sentTxs))
+	assert.Equal(t, l1.sentTxs[0].Hash(), receipt.TxHash)
+	assert.Equal(t, uint64(1), w.GetNonce())
+}
+
+func TestDeployContractUsesThePendingNonce(t *testing.T) {
+	setupTestLog()
+	// the wallet sent transactions on a previous run against the same network
+	l1 := &staleNonceL1{slowL1: newSlowL1(0, 5)}
+	w := datagenerator.RandomWallet(1337)
+
+	_, err := deployContract(l1, w, []byte{0x1}, 10*time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, l1.rejected)
+	assert.Equal(t, 1, len(l1.sentTxs))
+	assert.Equal(t, uint64(5), l1.sentTxs[0].Nonce())
+	assert.Equal(t, uint64(6), w.GetNonce())
+}
+
+func TestDeployContractFailsWhenNeverMined(t *testing.T) {
+	setupTestLog()
+	l1 := newSlowL1(1_000_000, 0)
+	w := datagenerator.RandomWallet(1337)
+
+	_, err := deployContract(l1, w, []byte{0x1}, time.Second)
+	assert.ErrorIs(t, err, ethereum.NotFound)
+}
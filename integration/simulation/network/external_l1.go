@@ -0,0 +1,228 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/ethadapter/erc20contractlib"
+	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
+	"github.com/ten-protocol/go-ten/go/obsclient"
+	"github.com/ten-protocol/go-ten/go/rpc"
+	"github.com/ten-protocol/go-ten/go/wallet"
+	"github.com/ten-protocol/go-ten/integration/common/testlog"
+	"github.com/ten-protocol/go-ten/integration/simulation/p2p"
+	"github.com/ten-protocol/go-ten/integration/simulation/params"
+	"github.com/ten-protocol/go-ten/integration/simulation/stats"
+
+	integrationCommon "github.com/ten-protocol/go-ten/integration/common"
+)
+
+const (
+	// the number of the latest L1 blocks the block interval of the external L1 is averaged over
+	observedL1Blocks = 20
+	// the number of L1 blocks the transactions of the set-up of the network get to be mined
+	l1SetUpBlocks = 10
+)
+
+// networkInMemExternalL1 - in-memory obscuro nodes connected to a pre-existing L1 network, see params.ExternalL1. The
+// network only owns the obscuro nodes and its connections to the L1 nodes, so the L1 nodes are left running on tear down.
+type networkInMemExternalL1 struct {
+	l2Clients  []rpc.Client
+	ethClients []ethadapter.EthClient
+	wallets    *params.SimWallets
+}
+
+func NewNetworkInMemoryExternalL1(wallets *params.SimWallets) Network {
+	return &networkInMemExternalL1{
+		wallets: wallets,
+	}
+}
+
+// Create connects to the L1 nodes, funds the L1 wallets and deploys the obscuro contracts unless they are reused, then
+// starts the obscuro nodes and populates the network objects
+func (n *networkInMemExternalL1) Create(params *params.SimParams, stats *stats.Stats) (*RPCHandles, error) {
+	externalL1 := params.ExternalL1
+	if externalL1 == nil || len(externalL1.RPCURLs) == 0 {
+		return nil, errors.New("no RPC URLs of the external L1 network")
+	}
+	if externalL1.FundingWallet == nil {
+		return nil, errors.New("no funding wallet for the external L1 network")
+	}
+
+	n.ethClients = make([]ethadapter.EthClient, params.NumberOfNodes)
+	for i := 0; i < params.NumberOfNodes; i++ {
+		url := externalL1.RPCURLs[i%len(externalL1.RPCURLs)]
+		ethClient, err := ethadapter.NewEthClientFromURL(url, DefaultL1RPCTimeout, common.BigToAddress(big.NewInt(int64(i))), testlog.Logger())
+		if err != nil {
+			return nil, fmt.Errorf("could not connect to the L1 node %s. Cause: %w", url, err)
+		}
+		n.ethClients[i] = ethClient
+	}
+	setUpClient := n.ethClients[0]
+
+	chainID, err := setUpClient.EthClient().ChainID(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the chain ID of the L1 network. Cause: %w", err)
+	}
+	if chainID.Cmp(n.wallets.MCOwnerWallet.ChainID()) != 0 || chainID.Cmp(externalL1.FundingWallet.ChainID()) != 0 {
+		return nil, fmt.Errorf("the L1 network has the chain ID %d, the L1 wallets of the simulation have the chain ID %d and the funding wallet %d",
+			chainID, n.wallets.MCOwnerWallet.ChainID(), externalL1.FundingWallet.ChainID())
+	}
+
+	params.L1BlockInterval, err = ObserveL1BlockInterval(setUpClient, observedL1Blocks)
+	if err != nil {
+		return nil, err
+	}
+	testlog.Logger().Info(fmt.Sprintf("Observed an L1 block interval of %s", params.L1BlockInterval))
+	setUpTimeout := l1SetUpBlocks * params.L1BlockInterval
+
+	err = fundL1Wallets(setUpClient, externalL1.FundingWallet, externalL1.FundingAmount, n.wallets.AllEthWallets(), setUpTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if externalL1.Contracts != nil {
+		l1SetupData := *externalL1.Contracts
+		params.L1SetupData = &l1SetupData
+		n.wallets.Tokens[integrationCommon.HOC].L1ContractAddress = &params.L1SetupData.ObxErc20Address
+		n.wallets.Tokens[integrationCommon.POC].L1ContractAddress = &params.L1SetupData.EthErc20Address
+		testlog.Logger().Info(fmt.Sprintf("Reusing the management contract %s", params.L1SetupData.MgmtContractAddress))
+	} else {
+		params.L1SetupData, err = DeployObscuroNetworkContracts(setUpClient, n.wallets, true)
+		if err != nil {
+			return nil, fmt.Errorf("error deploying obscuro contract %w", err)
+		}
+	}
+
+	// the wallets may have sent transactions in previous runs against the same L1
+	if err = syncL1Nonces(setUpClient, n.wallets.AllEthWallets()); err != nil {
+		return nil, err
+	}
+
+	params.MgmtContractLib = mgmtcontractlib.NewMgmtContractLib(&params.L1SetupData.MgmtContractAddress, testlog.Logger())
+	params.ERC20ContractLib = erc20contractlib.NewERC20ContractLib(&params.L1SetupData.MgmtContractAddress,
+		&params.L1SetupData.ObxErc20Address, &params.L1SetupData.EthErc20Address)
+
+	// Start the obscuro nodes and return the handles. The genesis of the L1 is not known, so the L1 blocks are not validated
+	n.l2Clients = startInMemoryObscuroNodes(params, stats, nil, n.ethClients)
+
+	obscuroClients := make([]*obsclient.ObsClient, params.NumberOfNodes)
+	for idx, l2Client := range n.l2Clients {
+		obscuroClients[idx] = obsclient.NewObsClient(l2Client)
+	}
+	walletClients := createAuthClientsPerWallet(n.l2Clients, params.Wallets)
+
+	return &RPCHandles{
+		EthClients:     n.ethClients,
+		ObscuroClients: obscuroClients,
+		RPCClients:     n.l2Clients,
+		AuthObsClients: walletClients,
+	}, nil
+}
+
+func (n *networkInMemExternalL1) TearDown() {
+	// Stop the obscuro nodes first
+	StopObscuroNodes(n.l2Clients)
+
+	// Only close the connections to the L1 nodes, which are not owned by the network
+	StopEth2Network(n.ethClients, nil)
+}
+
+func (n *networkInMemExternalL1) StopNode(int) error {
+	return ErrRestartNotSupported
+}
+
+func (n *networkInMemExternalL1) RestartNode(int) error {
+	return ErrRestartNotSupported
+}
+
+func (n *networkInMemExternalL1) AddNode() (*JoinedNode, error) {
+	return nil, ErrAddNodeNotSupported
+}
+
+func (n *networkInMemExternalL1) BatchFaults() *p2p.BatchFaultInjector {
+	return nil
+}
+
+func (n *networkInMemExternalL1) Partitions() *p2p.PartitionController {
+	return nil
+}
+
+// ObserveL1BlockInterval - the average interval between the given number of latest L1 blocks. The genesis block is left
+// out, as its timestamp is usually unrelated to the block time.
+func ObserveL1BlockInterval(client ethadapter.EthClient, nrBlocks uint64) (time.Duration, error) {
+	head, err := client.BlockByNumber(nil)
+	if err != nil {
+		return 0, fmt.Errorf("could not fetch the head L1 block. Cause: %w", err)
+	}
+	if head.NumberU64() < 2 {
+		return 0, fmt.Errorf("the L1 network only produced %d blocks, its block interval cannot be observed", head.NumberU64())
+	}
+
+	from := uint64(1)
+	if head.NumberU64() > nrBlocks {
+		from = head.NumberU64() - nrBlocks
+	}
+	oldest, err := client.BlockByNumber(big.NewInt(int64(from)))
+	if err != nil {
+		return 0, fmt.Errorf("could not fetch the L1 block %d. Cause: %w", from, err)
+	}
+
+	interval := time.Duration(head.Time()-oldest.Time()) * time.Second / time.Duration(head.NumberU64()-from)
+	if interval <= 0 {
+		return 0, fmt.Errorf("the L1 blocks %d to %d have the same timestamp, the L1 network does not produce blocks at a regular interval", from, head.NumberU64())
+	}
+	return interval, nil
+}
+
+// fundL1Wallets - sends the amount from the funding wallet to each of the wallets, and waits for the transfers to be mined
+func fundL1Wallets(client ethadapter.EthClient, funder wallet.Wallet, amount *big.Int, wallets []wallet.Wallet, timeout time.Duration) error {
+	nonce, err := client.Nonce(funder.Address())
+	if err != nil {
+		return fmt.Errorf("could not fetch the nonce of the funding wallet %s. Cause: %w", funder.Address(), err)
+	}
+
+	txHashes := make([]common.Hash, len(wallets))
+	for idx, w := range wallets {
+		destAddr := w.Address()
+		tx, err := client.PrepareTransactionToSend(&types.LegacyTx{To: &destAddr, Value: amount}, funder.Address(), nonce)
+		if err != nil {
+			return fmt.Errorf("could not prepare the funding of %s. Cause: %w", destAddr, err)
+		}
+		signedTx, err := funder.SignTransaction(tx)
+		if err != nil {
+			return err
+		}
+		if err = client.SendTransaction(signedTx); err != nil {
+			return fmt.Errorf("could not fund %s from %s. Cause: %w", destAddr, funder.Address(), err)
+		}
+		nonce++
+		txHashes[idx] = signedTx.Hash()
+	}
+	funder.SetNonce(nonce)
+
+	for _, txHash := range txHashes {
+		if _, err = integrationCommon.AwaitReceiptEth(context.Background(), client.EthClient(), txHash, timeout); err != nil {
+			return fmt.Errorf("funding transaction unsuccessful. Cause: %w", err)
+		}
+	}
+	return nil
+}
+
+// syncL1Nonces - sets the nonce of each wallet to its pending nonce on the L1
+func syncL1Nonces(client ethadapter.EthClient, wallets []wallet.Wallet) error {
+	for _, w := range wallets {
+		nonce, err := client.Nonce(w.Address())
+		if err != nil {
+			return fmt.Errorf("could not fetch the nonce of %s. Cause: %w", w.Address(), err)
+		}
+		w.SetNonce(nonce)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/rpc"
 	"github.com/ten-protocol/go-ten/integration/common/testlog"
 	"github.com/ten-protocol/go-ten/integration/eth2network"
+	"github.com/ten-protocol/go-ten/integration/simulation/p2p"
 	"github.com/ten-protocol/go-ten/integration/simulation/params"
 	"github.com/ten-protocol/go-ten/integration/simulation/stats"
 )
@@ -28,21 +29,25 @@ func NewNetworkInMemoryGeth(wallets *params.SimWallets) Network {
 }
 
 // Create inits and starts the nodes, wires them up, and populates the network objects
-func (n *networkInMemGeth) Create(params *params.SimParams, _ *stats.Stats) (*RPCHandles, error) {
+func (n *networkInMemGeth) Create(params *params.SimParams, stats *stats.Stats) (*RPCHandles, error) {
 	// kickoff the network with the prefunded wallet addresses
-	params.L1SetupData, n.gethClients, n.eth2Network = SetUpGethNetwork(
+	var err error
+	params.L1SetupData, n.gethClients, n.eth2Network, err = SetUpGethNetwork(
 		n.wallets,
 		params.StartPort,
 		params.NumberOfNodes,
 		int(params.AvgBlockDuration.Seconds()),
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	params.MgmtContractLib = mgmtcontractlib.NewMgmtContractLib(&params.L1SetupData.MgmtContractAddress, testlog.Logger())
 	params.ERC20ContractLib = erc20contractlib.NewERC20ContractLib(&params.L1SetupData.MgmtContractAddress,
 		&params.L1SetupData.ObxErc20Address, &params.L1SetupData.EthErc20Address)
 
 	// Start the obscuro nodes and return the handles
-	n.l2Clients = startInMemoryObscuroNodes(params, n.eth2Network.GethGenesis(), n.gethClients)
+	n.l2Clients = startInMemoryObscuroNodes(params, stats, n.eth2Network.GethGenesis(), n.gethClients)
 
 	obscuroClients := make([]*obsclient.ObsClient, params.NumberOfNodes)
 	for idx, l2Client := range n.l2Clients {
@@ -65,3 +70,23 @@ func (n *networkInMemGeth) TearDown() {
 	// Stop geth last
 	StopEth2Network(n.gethClients, n.eth2Network)
 }
+
+func (n *networkInMemGeth) StopNode(int) error {
+	return ErrRestartNotSupported
+}
+
+func (n *networkInMemGeth) RestartNode(int) error {
+	return ErrRestartNotSupported
+}
+
+func (n *networkInMemGeth) AddNode() (*JoinedNode, error) {
+	return nil, ErrAddNodeNotSupported
+}
+
+func (n *networkInMemGeth) BatchFaults() *p2p.BatchFaultInjector {
+	return nil
+}
+
+func (n *networkInMemGeth) Partitions() *p2p.PartitionController {
+	return nil
+}
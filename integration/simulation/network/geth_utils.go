@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ten-protocol/go-ten/contracts/generated/ManagementContract"
 	"github.com/ten-protocol/go-ten/go/common/constants"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
@@ -28,29 +29,32 @@ const (
 	e2eTestPrefundedL1Addr = "0x13E23Ca74DE0206C56ebaE8D51b5622EFF1E9944"
 )
 
-func SetUpGethNetwork(wallets *params.SimWallets, startPort int, nrNodes int, blockDurationSeconds int) (*params.L1SetupData, []ethadapter.EthClient, eth2network.Eth2Network) {
+// SetUpGethNetwork starts the geth network and deploys the obscuro contracts to it. The network is returned alongside
+// the errors once started, so the caller can tear it down.
+func SetUpGethNetwork(wallets *params.SimWallets, startPort int, nrNodes int, blockDurationSeconds int) (*params.L1SetupData, []ethadapter.EthClient, eth2network.Eth2Network, error) {
 	eth2Network, err := StartGethNetwork(wallets, startPort, blockDurationSeconds)
 	if err != nil {
-		panic(fmt.Errorf("error starting geth network %w", err))
+		return nil, nil, eth2Network, fmt.Errorf("error starting geth network %w", err)
 	}
 
 	// connect to the first host to deploy
-	tmpEthClient, err := ethadapter.NewEthClient(Localhost, uint(startPort+100), DefaultL1RPCTimeout, common.HexToAddress("0x0"), testlog.Logger())
+	wsPort := uint(eth2Network.GethWSPorts()[0])
+	tmpEthClient, err := ethadapter.NewEthClient(Localhost, wsPort, DefaultL1RPCTimeout, common.HexToAddress("0x0"), testlog.Logger())
 	if err != nil {
-		panic(fmt.Errorf("error connecting to te first host %w", err))
+		return nil, nil, eth2Network, fmt.Errorf("error connecting to te first host %w", err)
 	}
 
 	l1Data, err := DeployObscuroNetworkContracts(tmpEthClient, wallets, true)
 	if err != nil {
-		panic(fmt.Errorf("error deploying obscuro contract %w", err))
+		return nil, nil, eth2Network, fmt.Errorf("error deploying obscuro contract %w", err)
 	}
 
 	ethClients := make([]ethadapter.EthClient, nrNodes)
 	for i := 0; i < nrNodes; i++ {
-		ethClients[i] = CreateEthClientConnection(int64(i), uint(startPort+100))
+		ethClients[i] = CreateEthClientConnection(int64(i), wsPort)
 	}
 
-	return l1Data, ethClients, eth2Network
+	return l1Data, ethClients, eth2Network, nil
 }
 
 func StartGethNetwork(wallets *params.SimWallets, startPort int, blockDurationSeconds int) (eth2network.Eth2Network, error) {
@@ -85,6 +89,7 @@ func StartGethNetwork(wallets *params.SimWallets, startPort int, blockDurationSe
 		2,
 		walletAddresses,
 		2*time.Minute,
+		eth2network.WithDataDirCache(eth2network.DefaultDataDirCachePath),
 	)
 
 	err = eth2Network.Start()
@@ -211,44 +216,14 @@ func InitializeContract(workerClient ethadapter.EthClient, w wallet.Wallet, cont
 	return receipt, nil
 }
 
-// DeployContract returns receipt of deployment
-// todo (@matt) - this should live somewhere else
-func DeployContract(workerClient ethadapter.EthClient, w wallet.Wallet, contractBytes []byte) (*types.Receipt, error) {
-	deployContractTx, err := workerClient.PrepareTransactionToSend(&types.LegacyTx{
-		Data: contractBytes,
-	}, w.Address(), w.GetNonceAndIncrement())
-	if err != nil {
-		w.SetNonce(w.GetNonce() - 1)
-		return nil, err
-	}
-
-	signedTx, err := w.SignTransaction(deployContractTx)
-	if err != nil {
-		return nil, err
-	}
-
-	err = workerClient.SendTransaction(signedTx)
-	if err != nil {
-		return nil, err
-	}
-
-	var start time.Time
-	var receipt *types.Receipt
-	// todo (@matt) these timings should be driven by the L2 batch times and L1 block times
-	for start = time.Now(); time.Since(start) < 50*time.Second; time.Sleep(1 * time.Second) {
-		receipt, err = workerClient.TransactionReceipt(signedTx.Hash())
-		if err == nil && receipt != nil {
-			if receipt.Status != types.ReceiptStatusSuccessful {
-				return nil, errors.New("unable to deploy contract")
-			}
-			testlog.Logger().Info(fmt.Sprintf("Contract successfully deployed to %s", receipt.ContractAddress))
-			return receipt, nil
-		}
-
-		testlog.Logger().Info(fmt.Sprintf("Contract deploy tx (%s) has not been mined into a block after %s...", signedTx.Hash(), time.Since(start)))
+// CreateEthHTTPClient - a client of the HTTP RPC endpoint of the given geth node, for the tools which do not use
+// websockets
+func CreateEthHTTPClient(eth2Network eth2network.Eth2Network, nodeIdx int) (*ethclient.Client, error) {
+	httpPorts := eth2Network.GethHTTPPorts()
+	if nodeIdx < 0 || nodeIdx >= len(httpPorts) {
+		return nil, fmt.Errorf("no geth node with index %d, the network has %d nodes", nodeIdx, len(httpPorts))
 	}
-
-	return nil, fmt.Errorf("failed to mine contract deploy tx (%s) into a block after %s. Aborting", signedTx.Hash(), time.Since(start))
+	return ethclient.Dial(fmt.Sprintf("http://%s:%d", Localhost, httpPorts[nodeIdx]))
 }
 
 func CreateEthClientConnection(id int64, port uint) ethadapter.EthClient {
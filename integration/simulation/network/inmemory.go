@@ -1,10 +1,13 @@
 package network
 
 import (
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/enclave/genesis"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 	"github.com/ten-protocol/go-ten/go/host/container"
 	"github.com/ten-protocol/go-ten/go/obsclient"
@@ -19,7 +22,20 @@ import (
 
 type basicNetworkOfInMemoryNodes struct {
 	ethNodes  []*ethereummock.Node
-	l2Clients []rpc.Client
+	l2Clients []*restartableClient
+
+	// what is needed to recreate the obscuro nodes when they are restarted
+	params      *params.SimParams
+	p2pNetw     p2p.MockP2PNetworkIntf
+	partitions  *p2p.PartitionController
+	batchFaults *p2p.BatchFaultInjector
+	stats       *stats.Stats
+	l2Genesis   *genesis.Genesis
+	busAddress  common.Address
+	// the nodes are added while the network is running
+	nodesLock    sync.Mutex
+	dbPaths      []*nodeDBPaths
+	obscuroNodes []*container.HostContainer
 }
 
 func NewBasicNetworkOfInMemoryNodes() Network {
@@ -30,52 +46,52 @@ func NewBasicNetworkOfInMemoryNodes() Network {
 func (n *basicNetworkOfInMemoryNodes) Create(params *params.SimParams, stats *stats.Stats) (*RPCHandles, error) {
 	l1Clients := make([]ethadapter.EthClient, params.NumberOfNodes)
 	n.ethNodes = make([]*ethereummock.Node, params.NumberOfNodes)
-	obscuroNodes := make([]*container.HostContainer, params.NumberOfNodes)
-	n.l2Clients = make([]rpc.Client, params.NumberOfNodes)
+	n.obscuroNodes = make([]*container.HostContainer, params.NumberOfNodes)
+	n.l2Clients = make([]*restartableClient, params.NumberOfNodes)
+	n.dbPaths = make([]*nodeDBPaths, params.NumberOfNodes)
+	l2Clients := make([]rpc.Client, params.NumberOfNodes)
 	obscuroHosts := make([]host.Host, params.NumberOfNodes)
 
-	p2pNetw := p2p.NewMockP2PNetwork(params.AvgBlockDuration, params.AvgNetworkLatency, params.NodeWithInboundP2PDisabled)
+	n.params = params
+	n.stats = stats
+	n.partitions = p2p.NewPartitionController()
+	if params.BatchCorruptionRate > 0 {
+		n.batchFaults = p2p.NewBatchFaultInjector(params.BatchCorruptionRate, 2*params.AvgNetworkLatency)
+	}
+	n.p2pNetw = p2p.NewMockP2PNetwork(params.AvgBlockDuration, params.NewLatency("p2p latency"), params.NodeWithInboundP2PDisabled, n.partitions)
+	l1Latency := params.NewLatency("l1 latency")
 
 	// Invent some addresses to assign as the L1 erc20 contracts
 	dummyOBXAddress := datagenerator.RandomAddress()
 	params.Wallets.Tokens[testcommon.HOC].L1ContractAddress = &dummyOBXAddress
 	dummyETHAddress := datagenerator.RandomAddress()
 	params.Wallets.Tokens[testcommon.POC].L1ContractAddress = &dummyETHAddress
-	dummyBus := datagenerator.RandomAddress()
+	n.busAddress = datagenerator.RandomAddress()
 	// dummyMgmtContractAddress := datagenerator.RandomAddress()
 	// params.MgmtContractLib
 
+	n.l2Genesis = params.Wallets.L2Genesis()
 	for i := 0; i < params.NumberOfNodes; i++ {
-		isGenesis := i == 0
-
-		incomingP2PDisabled := !isGenesis && i == params.NodeWithInboundP2PDisabled
+		// only the node which is restarted needs its databases to outlive it
+		if params.IsRestarted(i) {
+			dbPaths, err := newNodeDBPaths()
+			if err != nil {
+				return nil, err
+			}
+			n.dbPaths[i] = dbPaths
+		}
 
 		// create the in memory l1 and l2 node
-		miner := createMockEthNode(int64(i), params.NumberOfNodes, params.AvgBlockDuration, params.AvgNetworkLatency, stats)
-
-		agg := createInMemObscuroNode(
-			int64(i),
-			isGenesis,
-			GetNodeType(i),
-			params.MgmtContractLib,
-			false,
-			nil,
-			params.Wallets.NodeWallets[i],
-			miner,
-			p2pNetw.NewNode(i),
-			dummyBus,
-			common.Hash{},
-			params.AvgBlockDuration/2,
-			incomingP2PDisabled,
-			params.AvgBlockDuration,
-		)
-		obscuroClient := p2p.NewInMemObscuroClient(agg)
-
+		miner := createMockEthNode(int64(i), params.NumberOfNodes, params.AvgBlockDuration, l1Latency, params.Rand(fmt.Sprintf("mining %d", i)), stats)
 		n.ethNodes[i] = miner
-		obscuroNodes[i] = agg
-		n.l2Clients[i] = obscuroClient
+
+		agg := n.createObscuroNode(i)
+		n.l2Clients[i] = newRestartableClient(p2p.NewInMemObscuroClient(agg))
+
+		n.obscuroNodes[i] = agg
+		l2Clients[i] = n.l2Clients[i]
 		l1Clients[i] = miner
-		obscuroHosts[i] = obscuroNodes[i].Host()
+		obscuroHosts[i] = n.obscuroNodes[i].Host()
 	}
 
 	// populate the nodes field of each network
@@ -95,7 +111,7 @@ func (n *basicNetworkOfInMemoryNodes) Create(params *params.SimParams, stats *st
 		time.Sleep(params.AvgBlockDuration)
 	}
 
-	for _, m := range obscuroNodes {
+	for _, m := range n.obscuroNodes {
 		t := m
 		go func() {
 			err := t.Start()
@@ -107,24 +123,117 @@ func (n *basicNetworkOfInMemoryNodes) Create(params *params.SimParams, stats *st
 	}
 
 	obscuroClients := make([]*obsclient.ObsClient, params.NumberOfNodes)
-	for idx, l2Client := range n.l2Clients {
+	for idx, l2Client := range l2Clients {
 		obscuroClients[idx] = obsclient.NewObsClient(l2Client)
 	}
-	walletClients := createAuthClientsPerWallet(n.l2Clients, params.Wallets)
+	walletClients := createAuthClientsPerWallet(l2Clients, params.Wallets)
 
 	return &RPCHandles{
 		EthClients:     l1Clients,
 		ObscuroClients: obscuroClients,
-		RPCClients:     n.l2Clients,
+		RPCClients:     l2Clients,
 		AuthObsClients: walletClients,
 	}, nil
 }
 
 func (n *basicNetworkOfInMemoryNodes) TearDown() {
-	StopObscuroNodes(n.l2Clients)
+	n.nodesLock.Lock()
+	defer n.nodesLock.Unlock()
+	l2Clients := make([]rpc.Client, len(n.l2Clients))
+	for i, client := range n.l2Clients {
+		l2Clients[i] = client
+	}
+	StopObscuroNodes(l2Clients)
 
 	for _, node := range n.ethNodes {
 		temp := node
 		go temp.Stop()
 	}
+	for _, dbPaths := range n.dbPaths {
+		dbPaths.remove()
+	}
+}
+
+func (n *basicNetworkOfInMemoryNodes) StopNode(nodeIdx int) error {
+	n.nodesLock.Lock()
+	defer n.nodesLock.Unlock()
+	if n.dbPaths[nodeIdx] == nil {
+		return ErrRestartNotSupported
+	}
+	n.l2Clients[nodeIdx].swap(nil)
+	return n.obscuroNodes[nodeIdx].Stop()
+}
+
+func (n *basicNetworkOfInMemoryNodes) RestartNode(nodeIdx int) error {
+	n.nodesLock.Lock()
+	defer n.nodesLock.Unlock()
+	if n.dbPaths[nodeIdx] == nil {
+		return ErrRestartNotSupported
+	}
+	// the host and the enclave are recreated, as they would be by a new process
+	agg := n.createObscuroNode(nodeIdx)
+	if err := agg.Start(); err != nil {
+		return fmt.Errorf("could not restart obscuro node %d - %w", nodeIdx, err)
+	}
+	n.obscuroNodes[nodeIdx] = agg
+	n.l2Clients[nodeIdx].swap(p2p.NewInMemObscuroClient(agg))
+	return nil
+}
+
+func (n *basicNetworkOfInMemoryNodes) AddNode() (*JoinedNode, error) {
+	n.nodesLock.Lock()
+	defer n.nodesLock.Unlock()
+
+	nodeIdx := len(n.obscuroNodes)
+	if nodeIdx >= len(n.params.Wallets.NodeWallets) {
+		return nil, fmt.Errorf("there is no wallet for node %d", nodeIdx)
+	}
+	n.dbPaths = append(n.dbPaths, nil)
+	agg := n.createObscuroNode(nodeIdx)
+	if err := agg.Start(); err != nil {
+		return nil, fmt.Errorf("could not start obscuro node %d - %w", nodeIdx, err)
+	}
+	client := newRestartableClient(p2p.NewInMemObscuroClient(agg))
+	n.obscuroNodes = append(n.obscuroNodes, agg)
+	n.l2Clients = append(n.l2Clients, client)
+	return &JoinedNode{Idx: nodeIdx, HostID: agg.Host().Config().ID, Client: client}, nil
+}
+
+func (n *basicNetworkOfInMemoryNodes) Partitions() *p2p.PartitionController {
+	return n.partitions
+}
+
+func (n *basicNetworkOfInMemoryNodes) BatchFaults() *p2p.BatchFaultInjector {
+	return n.batchFaults
+}
+
+// createObscuroNode - creates the obscuro node, which is connected to the mock L1 node with the same index. The nodes
+// added to the running network share the existing L1 nodes.
+func (n *basicNetworkOfInMemoryNodes) createObscuroNode(nodeIdx int) *container.HostContainer {
+	isGenesis := nodeIdx == 0
+	incomingP2PDisabled := !isGenesis && nodeIdx == n.params.NodeWithInboundP2PDisabled
+	p2pService := n.p2pNetw.NewNode(nodeIdx)
+	if isGenesis {
+		// the stats only see the genuine batches
+		p2pService = p2p.NewBatchStatsP2P(n.batchFaults.Wrap(p2pService), n.stats)
+	}
+
+	return createInMemObscuroNode(
+		int64(nodeIdx),
+		isGenesis,
+		GetNodeType(nodeIdx),
+		n.params.MgmtContractLib,
+		false,
+		nil,
+		n.l2Genesis,
+		n.params.Wallets.NodeWallets[nodeIdx],
+		n.ethNodes[nodeIdx%len(n.ethNodes)],
+		p2pService,
+		n.busAddress,
+		common.Hash{},
+		n.params.AvgBlockDuration/2,
+		incomingP2PDisabled,
+		n.params.AvgBlockDuration,
+		n.dbPaths[nodeIdx],
+	)
 }
@@ -1,7 +1,9 @@
 package network
 
 import (
+	"errors"
 	"math/rand"
+	"sync"
 
 	"github.com/ten-protocol/go-ten/go/rpc"
 
@@ -11,6 +13,7 @@ import (
 
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 	"github.com/ten-protocol/go-ten/go/wallet"
+	"github.com/ten-protocol/go-ten/integration/simulation/p2p"
 	"github.com/ten-protocol/go-ten/integration/simulation/params"
 	"github.com/ten-protocol/go-ten/integration/simulation/stats"
 )
@@ -26,6 +29,34 @@ type Network interface {
 	// Return an error in case it cannot start for an expected reason. Otherwise it panics.
 	Create(params *params.SimParams, stats *stats.Stats) (*RPCHandles, error)
 	TearDown()
+
+	// StopNode - stops the host and the enclave of the Obscuro node, keeping its databases. The calls to the node fail
+	// until it is restarted.
+	StopNode(nodeIdx int) error
+	// RestartNode - starts the stopped Obscuro node again with the same databases, and reconnects its clients to it
+	RestartNode(nodeIdx int) error
+	// AddNode - starts a new Obscuro validator in the running network, with the next node index. The node requests the
+	// network secret through the L1 and catches up with the chain, like a validator joining a live network.
+	AddNode() (*JoinedNode, error)
+
+	// Partitions - the controller of the partitions between the Obscuro nodes, or nil if the network cannot be partitioned
+	Partitions() *p2p.PartitionController
+	// BatchFaults - the injector of the corrupted batches gossiped by the sequencer, or nil if none are injected
+	BatchFaults() *p2p.BatchFaultInjector
+}
+
+var (
+	// ErrRestartNotSupported - the network cannot restart its nodes, or was not configured to restart the node
+	ErrRestartNotSupported = errors.New("the node cannot be restarted")
+	// ErrAddNodeNotSupported - the network cannot add nodes once it is created
+	ErrAddNodeNotSupported = errors.New("the network cannot add nodes")
+)
+
+// JoinedNode - an Obscuro node added to the running network
+type JoinedNode struct {
+	Idx    int
+	HostID common.Address // the ID the node requests the network secret with
+	Client rpc.Client
 }
 
 type RPCHandles struct {
@@ -42,6 +73,41 @@ type RPCHandles struct {
 	// map of owner addresses to RPC clients for that owner (one per L2 node)
 	// todo (@matt) - simplify this with a client per node when we have clients that can support multiple wallets
 	AuthObsClients map[string][]*obsclient.AuthObsClient
+
+	// the nodes which are not given out as random clients, e.g. because they are stopped or partitioned from the
+	// sequencer, with the number of reasons for each
+	excludedNodesLock sync.RWMutex
+	excludedNodes     map[int]int
+}
+
+// ExcludeNode - leaves the node out of the random clients, until it is included again as many times
+func (n *RPCHandles) ExcludeNode(nodeIdx int) {
+	n.excludedNodesLock.Lock()
+	defer n.excludedNodesLock.Unlock()
+	if n.excludedNodes == nil {
+		n.excludedNodes = make(map[int]int)
+	}
+	n.excludedNodes[nodeIdx]++
+}
+
+// IncludeNode - reverses an ExcludeNode
+func (n *RPCHandles) IncludeNode(nodeIdx int) {
+	n.excludedNodesLock.Lock()
+	defer n.excludedNodesLock.Unlock()
+	n.excludedNodes[nodeIdx]--
+}
+
+// rndIncludedNode - the index of a random node which is not excluded
+func (n *RPCHandles) rndIncludedNode(nrNodes int) int {
+	n.excludedNodesLock.RLock()
+	defer n.excludedNodesLock.RUnlock()
+	included := make([]int, 0, nrNodes)
+	for i := 0; i < nrNodes; i++ {
+		if n.excludedNodes[i] == 0 {
+			included = append(included, i)
+		}
+	}
+	return included[rand.Intn(len(included))] //nolint:gosec
 }
 
 func (n *RPCHandles) RndEthClient() ethadapter.EthClient {
@@ -50,9 +116,16 @@ func (n *RPCHandles) RndEthClient() ethadapter.EthClient {
 
 // ObscuroWalletRndClient fetches an RPC client connected to a random L2 node for a given wallet
 func (n *RPCHandles) ObscuroWalletRndClient(wallet wallet.Wallet) *obsclient.AuthObsClient {
+	client, _ := n.ObscuroWalletRndNodeClient(wallet)
+	return client
+}
+
+// ObscuroWalletRndNodeClient fetches an RPC client connected to a random L2 node for a given wallet, and the index of the node
+func (n *RPCHandles) ObscuroWalletRndNodeClient(wallet wallet.Wallet) (*obsclient.AuthObsClient, int) {
 	addr := wallet.Address().String()
 	clients := n.AuthObsClients[addr]
-	return clients[rand.Intn(len(clients))] //nolint:gosec
+	nodeIdx := n.rndIncludedNode(len(clients))
+	return clients[nodeIdx], nodeIdx
 }
 
 // ObscuroWalletClient fetches a client for a given wallet address, for a specific node
@@ -4,9 +4,14 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
 	"time"
 
+	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
+
 	"github.com/ten-protocol/go-ten/go/host"
 	"github.com/ten-protocol/go-ten/go/host/l1"
 
@@ -16,6 +21,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/config"
 	"github.com/ten-protocol/go-ten/go/enclave"
 	"github.com/ten-protocol/go-ten/go/enclave/genesis"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
 	"github.com/ten-protocol/go-ten/go/host/container"
@@ -36,9 +42,9 @@ const (
 	DefaultL1RPCTimeout     = 15 * time.Second
 )
 
-func createMockEthNode(id int64, nrNodes int, avgBlockDuration time.Duration, avgNetworkLatency time.Duration, stats *stats.Stats) *ethereummock.Node {
-	mockEthNetwork := ethereummock.NewMockEthNetwork(avgBlockDuration, avgNetworkLatency, stats)
-	ethereumMockCfg := defaultMockEthNodeCfg(nrNodes, avgBlockDuration)
+func createMockEthNode(id int64, nrNodes int, avgBlockDuration time.Duration, latency *testcommon.Latency, rng *rand.Rand, stats *stats.Stats) *ethereummock.Node {
+	mockEthNetwork := ethereummock.NewMockEthNetwork(avgBlockDuration, latency, int(id), stats)
+	ethereumMockCfg := defaultMockEthNodeCfg(nrNodes, avgBlockDuration, rng)
 	// create an in memory mock ethereum node responsible with notifying the layer 2 node about blocks
 	miner := ethereummock.NewMiner(gethcommon.BigToAddress(big.NewInt(id)), ethereumMockCfg, mockEthNetwork, stats)
 	mockEthNetwork.CurrentNode = miner
@@ -52,6 +58,7 @@ func createInMemObscuroNode(
 	mgmtContractLib mgmtcontractlib.MgmtContractLib,
 	validateBlocks bool,
 	genesisJSON []byte,
+	l2Genesis *genesis.Genesis,
 	ethWallet wallet.Wallet,
 	ethClient ethadapter.EthClient,
 	mockP2P hostcommon.P2PHostService,
@@ -60,17 +67,19 @@ func createInMemObscuroNode(
 	batchInterval time.Duration,
 	incomingP2PDisabled bool,
 	l1BlockTime time.Duration,
+	dbPaths *nodeDBPaths,
 ) *container.HostContainer {
 	mgtContractAddress := mgmtContractLib.GetContractAddr()
 
+	// the IDs start at 1 because the enclaves reject an empty sequencer ID, and the genesis node is the sequencer
 	hostConfig := &config.HostConfig{
-		ID:                        gethcommon.BigToAddress(big.NewInt(id)),
+		ID:                        gethcommon.BigToAddress(big.NewInt(id + 1)),
 		IsGenesis:                 isGenesis,
 		NodeType:                  nodeType,
 		HasClientRPCHTTP:          false,
 		P2PPublicAddress:          fmt.Sprintf("%d", id),
 		L1StartHash:               l1StartBlk,
-		SequencerID:               gethcommon.BigToAddress(big.NewInt(0)),
+		SequencerID:               gethcommon.BigToAddress(big.NewInt(1)),
 		ManagementContractAddress: *mgtContractAddress,
 		MessageBusAddress:         l1BusAddress,
 		BatchInterval:             batchInterval,
@@ -79,7 +88,8 @@ func createInMemObscuroNode(
 	}
 
 	enclaveConfig := &config.EnclaveConfig{
-		SequencerID:               gethcommon.BigToAddress(big.NewInt(0)),
+		LogLevel:                  int(gethlog.LvlTrace), // the test log has a level of its own
+		SequencerID:               hostConfig.SequencerID,
 		HostID:                    hostConfig.ID,
 		NodeType:                  nodeType,
 		L1ChainID:                 integration.EthereumChainID,
@@ -96,21 +106,78 @@ func createInMemObscuroNode(
 		BaseFee:                   big.NewInt(1), // todo @siliev:: fix test transaction builders so this can be different
 		GasLocalExecutionCapFlag:  params.MaxGasLimit / 2,
 		GasBatchExecutionLimit:    params.MaxGasLimit / 2,
+		StateRetention:            common.ArchiveStateRetention,
+		CallExecutionTimeout:      5 * time.Second,
+		CallMemoryCap:             64 << 20,
+		// the networks of the simulations start with the domain separated signatures
+		SignatureDomainActivationSeqNo: 0,
+	}
+
+	// the mock L1 blocks have no timestamps, so the times of the batches can only be checked against a real L1
+	if !validateBlocks {
+		enclaveConfig.BatchTimeRulesActivationSeqNo = math.MaxUint64
+	}
+
+	// the node keeps its databases across restarts
+	if dbPaths != nil {
+		hostConfig.LevelDBPath = dbPaths.hostDB
+		enclaveConfig.UseInMemoryDB = false
+		enclaveConfig.SqliteDBPath = dbPaths.enclaveDB
 	}
 
 	enclaveLogger := testlog.Logger().New(log.NodeIDKey, id, log.CmpKey, log.EnclaveCmp)
-	enclaveClient := enclave.NewEnclave(enclaveConfig, &genesis.TestnetGenesis, mgmtContractLib, enclaveLogger)
+	enclaveClient, err := enclave.NewEnclave(enclaveConfig, l2Genesis, mgmtContractLib, enclaveLogger)
+	if err != nil {
+		panic(fmt.Errorf("unable to create the in-memory enclave - %w", err))
+	}
 
 	// create an in memory obscuro node
 	hostLogger := testlog.Logger().New(log.NodeIDKey, id, log.CmpKey, log.HostCmp)
 	metricsService := metrics.New(hostConfig.MetricsEnabled, hostConfig.MetricsHTTPPort, hostLogger)
-	l1Repo := l1.NewL1Repository(ethClient, ethereummock.MgmtContractAddresses, hostLogger)
+	l1Repo := l1.NewL1Repository(ethClient, ethereummock.MgmtContractAddresses, validateBlocks, hostLogger)
 	currentContainer := container.NewHostContainer(hostConfig, host.NewServicesRegistry(hostLogger), mockP2P, ethClient, l1Repo, enclaveClient, mgmtContractLib, ethWallet, nil, hostLogger, metricsService)
 
 	return currentContainer
 }
 
-func defaultMockEthNodeCfg(nrNodes int, avgBlockDuration time.Duration) ethereummock.MiningConfig {
+// nodeDBPaths - the databases of a node which persists its state across restarts
+type nodeDBPaths struct {
+	enclaveDB string // the sqlite file of the enclave
+	hostDB    string // the levelDB directory of the host
+}
+
+func newNodeDBPaths() (*nodeDBPaths, error) {
+	enclaveDB, err := sqlite.CreateTempDBFile()
+	if err != nil {
+		return nil, fmt.Errorf("could not create the enclave DB file - %w", err)
+	}
+	hostDB, err := os.MkdirTemp("", "levelDB_*")
+	if err != nil {
+		return nil, fmt.Errorf("could not create the host DB directory - %w", err)
+	}
+	return &nodeDBPaths{enclaveDB: enclaveDB, hostDB: hostDB}, nil
+}
+
+// remove - deletes the databases once the node is stopped for good
+func (p *nodeDBPaths) remove() {
+	if p == nil {
+		return
+	}
+	if p.enclaveDB != "" {
+		if err := os.RemoveAll(filepath.Dir(p.enclaveDB)); err != nil {
+			testlog.Logger().Warn("could not remove the enclave DB", log.ErrKey, err)
+		}
+	}
+	if p.hostDB != "" {
+		if err := os.RemoveAll(p.hostDB); err != nil {
+			testlog.Logger().Warn("could not remove the host DB", log.ErrKey, err)
+		}
+	}
+}
+
+// defaultMockEthNodeCfg - the config of a mock L1 node, which draws the time to mine its blocks from the given source. The
+// source is only used by the mining loop of the node.
+func defaultMockEthNodeCfg(nrNodes int, avgBlockDuration time.Duration, rng *rand.Rand) ethereummock.MiningConfig {
 	return ethereummock.MiningConfig{
 		PowTime: func() time.Duration {
 			// This formula might feel counter-intuitive, but it is a good approximation for Proof of Work.
@@ -119,7 +186,8 @@ func defaultMockEthNodeCfg(nrNodes int, avgBlockDuration time.Duration) ethereum
 			// while everyone else will have higher values.
 			// Over a large number of rounds, the actual average block duration will be around the desired value, while the number of miners who get very close numbers will be limited.
 			span := math.Max(2, float64(nrNodes)) // We handle the special cases of zero or one nodes.
-			return testcommon.RndBtwTime(avgBlockDuration/time.Duration(span), avgBlockDuration*time.Duration(span))
+			min, max := avgBlockDuration/time.Duration(span), avgBlockDuration*time.Duration(span)
+			return min + time.Duration(rng.Int63n(int64(max-min)))
 		},
 		LogFile: testlog.LogFile(),
 	}
@@ -21,6 +21,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/wallet"
 	"github.com/ten-protocol/go-ten/integration/common/testlog"
 	"github.com/ten-protocol/go-ten/integration/simulation/params"
+	"github.com/ten-protocol/go-ten/integration/simulation/stats"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -29,30 +30,39 @@ const (
 	networkTCP        = "tcp"
 )
 
-func startInMemoryObscuroNodes(params *params.SimParams, genesisJSON []byte, l1Clients []ethadapter.EthClient) []rpc.Client {
+// startInMemoryObscuroNodes - creates and starts the in-memory obscuro nodes, each connected to its L1 client. The nodes
+// only validate the L1 blocks if the genesis of the L1 is given.
+func startInMemoryObscuroNodes(params *params.SimParams, stats *stats.Stats, genesisJSON []byte, l1Clients []ethadapter.EthClient) []rpc.Client {
 	// Create the in memory obscuro nodes, each connect each to a geth node
 	obscuroNodes := make([]*hostcontainer.HostContainer, params.NumberOfNodes)
 	obscuroHosts := make([]host.Host, params.NumberOfNodes)
-	mockP2PNetw := p2p.NewMockP2PNetwork(params.AvgBlockDuration, params.AvgNetworkLatency, params.NodeWithInboundP2PDisabled)
+	mockP2PNetw := p2p.NewMockP2PNetwork(params.AvgBlockDuration, params.NewLatency("p2p latency"), params.NodeWithInboundP2PDisabled, nil)
 
+	l2Genesis := params.Wallets.L2Genesis()
 	for i := 0; i < params.NumberOfNodes; i++ {
 		isGenesis := i == 0
+		p2pService := mockP2PNetw.NewNode(i)
+		if isGenesis {
+			p2pService = p2p.NewBatchStatsP2P(p2pService, stats)
+		}
 
 		obscuroNodes[i] = createInMemObscuroNode(
 			int64(i),
 			isGenesis,
 			GetNodeType(i),
 			params.MgmtContractLib,
-			true,
+			genesisJSON != nil,
 			genesisJSON,
+			l2Genesis,
 			params.Wallets.NodeWallets[i],
 			l1Clients[i],
-			mockP2PNetw.NewNode(i),
+			p2pService,
 			params.L1SetupData.MessageBusAddr,
 			params.L1SetupData.ObscuroStartBlock,
 			params.AvgBlockDuration/3,
 			true,
-			params.AvgBlockDuration,
+			params.L1BlockDuration(),
+			nil,
 		)
 		obscuroHosts[i] = obscuroNodes[i].Host()
 	}
@@ -81,7 +91,7 @@ func startInMemoryObscuroNodes(params *params.SimParams, genesisJSON []byte, l1C
 func createAuthClientsPerWallet(clients []rpc.Client, wallets *params.SimWallets) map[string][]*obsclient.AuthObsClient {
 	walletClients := make(map[string][]*obsclient.AuthObsClient)
 	// loop through all the L2 wallets we're using and round-robin allocate them the rpc clients we have for each host
-	for _, w := range append(wallets.SimObsWallets, wallets.L2FaucetWallet) {
+	for _, w := range append(append(wallets.SimObsWallets, wallets.BurstObsWallets...), wallets.L2FaucetWallet) {
 		walletClients[w.Address().String()] = CreateAuthClients(clients, w)
 	}
 	for _, t := range wallets.Tokens {
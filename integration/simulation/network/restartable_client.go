@@ -0,0 +1,72 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ten-protocol/go-ten/go/rpc"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+var errNodeStopped = errors.New("the node is stopped")
+
+// restartableClient - an `rpc.Client` which outlives the restarts of its node, so the handles built on top of it keep
+// working. The calls fail while the node is stopped.
+type restartableClient struct {
+	lock   sync.RWMutex
+	client rpc.Client // nil while the node is stopped
+}
+
+func newRestartableClient(client rpc.Client) *restartableClient {
+	return &restartableClient{client: client}
+}
+
+// swap - replaces the client of the node, and returns the previous one
+func (c *restartableClient) swap(client rpc.Client) rpc.Client {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	previous := c.client
+	c.client = client
+	return previous
+}
+
+func (c *restartableClient) current() (rpc.Client, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if c.client == nil {
+		return nil, errNodeStopped
+	}
+	return c.client, nil
+}
+
+func (c *restartableClient) Call(result interface{}, method string, args ...interface{}) error {
+	client, err := c.current()
+	if err != nil {
+		return err
+	}
+	return client.Call(result, method, args...)
+}
+
+func (c *restartableClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	client, err := c.current()
+	if err != nil {
+		return err
+	}
+	return client.CallContext(ctx, result, method, args...)
+}
+
+func (c *restartableClient) Subscribe(ctx context.Context, result interface{}, namespace string, channel interface{}, args ...interface{}) (*gethrpc.ClientSubscription, error) {
+	client, err := c.current()
+	if err != nil {
+		return nil, err
+	}
+	return client.Subscribe(ctx, result, namespace, channel, args...)
+}
+
+func (c *restartableClient) Stop() {
+	if client, err := c.current(); err == nil {
+		client.Stop()
+	}
+}
@@ -2,33 +2,49 @@ package network
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"os/exec"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ten-protocol/go-ten/integration/noderunner"
 
 	"github.com/ethereum/go-ethereum/crypto"
+	hostcommon "github.com/ten-protocol/go-ten/go/common/host"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 	"github.com/ten-protocol/go-ten/go/ethadapter/erc20contractlib"
 	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
+	hostcontainer "github.com/ten-protocol/go-ten/go/host/container"
 	"github.com/ten-protocol/go-ten/go/node"
 	"github.com/ten-protocol/go-ten/go/obsclient"
 	"github.com/ten-protocol/go-ten/go/rpc"
 	"github.com/ten-protocol/go-ten/integration"
+	testcommon "github.com/ten-protocol/go-ten/integration/common"
 	"github.com/ten-protocol/go-ten/integration/common/testlog"
 	"github.com/ten-protocol/go-ten/integration/eth2network"
+	"github.com/ten-protocol/go-ten/integration/simulation/p2p"
 	"github.com/ten-protocol/go-ten/integration/simulation/params"
 	"github.com/ten-protocol/go-ten/integration/simulation/stats"
 )
 
 // creates Obscuro nodes with their own enclave servers that communicate with peers via sockets, wires them up, and populates the network objects
 type networkOfSocketNodes struct {
-	l2Clients         []rpc.Client
+	// the nodes are added while the network is running
+	nodesLock         sync.Mutex
+	l2Clients         []*restartableClient
 	hostWebsocketURLs []string
+	nodes             []node.Node
+	dbPaths           []*nodeDBPaths // the databases of the nodes which are restarted
+	simParams         *params.SimParams
+	genesis           string
+	partitions        *p2p.PartitionController
+	latency           *testcommon.Latency // added to the latency of the real network, if a distribution is configured
+	batchFaults       *p2p.BatchFaultInjector
+	stats             *stats.Stats
 
 	// geth
 	eth2Network    eth2network.Eth2Network
@@ -43,14 +59,18 @@ func NewNetworkOfSocketNodes(wallets *params.SimWallets) Network {
 	}
 }
 
-func (n *networkOfSocketNodes) Create(simParams *params.SimParams, _ *stats.Stats) (*RPCHandles, error) {
+func (n *networkOfSocketNodes) Create(simParams *params.SimParams, stats *stats.Stats) (*RPCHandles, error) {
 	// kickoff the network with the prefunded wallet addresses
-	simParams.L1SetupData, n.gethClients, n.eth2Network = SetUpGethNetwork(
+	var err error
+	simParams.L1SetupData, n.gethClients, n.eth2Network, err = SetUpGethNetwork(
 		n.wallets,
 		simParams.StartPort,
 		simParams.NumberOfNodes,
 		int(simParams.AvgBlockDuration.Seconds()),
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	simParams.MgmtContractLib = mgmtcontractlib.NewMgmtContractLib(&simParams.L1SetupData.MgmtContractAddress, testlog.Logger())
 	simParams.ERC20ContractLib = erc20contractlib.NewERC20ContractLib(
@@ -59,62 +79,32 @@ func (n *networkOfSocketNodes) Create(simParams *params.SimParams, _ *stats.Stat
 		&simParams.L1SetupData.EthErc20Address,
 	)
 
-	// get the sequencer Address
-	seqPrivateKey := n.wallets.NodeWallets[0].PrivateKey()
-	seqPrivKey := fmt.Sprintf("%x", crypto.FromECDSA(seqPrivateKey))
-	seqHostAddress := crypto.PubkeyToAddress(seqPrivateKey.PublicKey)
+	// all the nodes must be configured with the same genesis
+	genesis, err := json.Marshal(simParams.Wallets.L2Genesis())
+	if err != nil {
+		return nil, fmt.Errorf("could not encode the L2 genesis. Cause: %w", err)
+	}
+	n.genesis = string(genesis)
 
 	// create the nodes
-	nodes := make([]node.Node, simParams.NumberOfNodes)
-	var err error
+	n.simParams = simParams
+	n.stats = stats
+	n.partitions = p2p.NewPartitionController()
+	if simParams.BatchCorruptionRate > 0 {
+		n.batchFaults = p2p.NewBatchFaultInjector(simParams.BatchCorruptionRate, simParams.AvgBlockDuration/2)
+	}
+	if simParams.LatencyDistribution != nil {
+		n.latency = simParams.NewLatency("p2p latency")
+	}
+	n.nodes = make([]node.Node, simParams.NumberOfNodes)
 	for i := 0; i < simParams.NumberOfNodes; i++ {
-		privateKey := seqPrivKey
-		hostAddress := seqHostAddress
-		nodeTypeStr := "sequencer"
-		isInboundP2PDisabled := false
-
-		// if it's not the sequencer
-		if i != 0 {
-			nodeTypeStr = "validator"
-			privateKey = fmt.Sprintf("%x", crypto.FromECDSA(n.wallets.NodeWallets[i].PrivateKey()))
-			hostAddress = crypto.PubkeyToAddress(n.wallets.NodeWallets[i].PrivateKey().PublicKey)
-
-			// only the validators can have the incoming p2p disabled
-			isInboundP2PDisabled = i == simParams.NodeWithInboundP2PDisabled
-		}
-
-		genesis := "{}"
-		if simParams.WithPrefunding {
-			genesis = ""
+		n.nodes[i], err = n.createNode(i)
+		if err != nil {
+			return nil, err
 		}
 
-		// create the nodes
-		nodes[i] = noderunner.NewInMemNode(
-			node.NewNodeConfig(
-				node.WithGenesis(i == 0),
-				node.WithHostID(hostAddress.String()),
-				node.WithPrivateKey(privateKey),
-				node.WithSequencerID(seqHostAddress.String()),
-				node.WithEnclaveWSPort(simParams.StartPort+integration.DefaultEnclaveOffset+i),
-				node.WithHostWSPort(simParams.StartPort+integration.DefaultHostRPCWSOffset+i),
-				node.WithHostHTTPPort(simParams.StartPort+integration.DefaultHostRPCHTTPOffset+i),
-				node.WithHostP2PPort(simParams.StartPort+integration.DefaultHostP2pOffset+i),
-				node.WithHostPublicP2PAddr(fmt.Sprintf("127.0.0.1:%d", simParams.StartPort+integration.DefaultHostP2pOffset+i)),
-				node.WithManagementContractAddress(simParams.L1SetupData.MgmtContractAddress.String()),
-				node.WithMessageBusContractAddress(simParams.L1SetupData.MessageBusAddr.String()),
-				node.WithNodeType(nodeTypeStr),
-				node.WithCoinbase(simParams.Wallets.L2FeesWallet.Address().Hex()),
-				node.WithL1WebsocketURL(fmt.Sprintf("ws://%s:%d", "127.0.0.1", simParams.StartPort+100)),
-				node.WithInboundP2PDisabled(isInboundP2PDisabled),
-				node.WithLogLevel(4),
-				node.WithDebugNamespaceEnabled(true),
-				node.WithL1BlockTime(simParams.AvgBlockDuration),
-				node.WithObscuroGenesis(genesis),
-			),
-		)
-
 		// start the nodes
-		err = nodes[i].Start()
+		err = n.nodes[i].Start()
 		if err != nil {
 			errCheck := checkProcessPort(err.Error())
 			if errCheck != nil {
@@ -130,46 +120,193 @@ func (n *networkOfSocketNodes) Create(simParams *params.SimParams, _ *stats.Stat
 	if err != nil {
 		testlog.Logger().Crit("unable to create node connections", log.ErrKey, err)
 	}
-	walletClients := createAuthClientsPerWallet(n.l2Clients, simParams.Wallets)
+	l2Clients := n.rpcClients()
+	walletClients := createAuthClientsPerWallet(l2Clients, simParams.Wallets)
 
 	return &RPCHandles{
 		EthClients:     n.gethClients,
 		ObscuroClients: n.obscuroClients,
-		RPCClients:     n.l2Clients,
+		RPCClients:     l2Clients,
 		AuthObsClients: walletClients,
 	}, nil
 }
 
 func (n *networkOfSocketNodes) TearDown() {
+	n.nodesLock.Lock()
+	defer n.nodesLock.Unlock()
 	// Stop the Obscuro nodes first (each host will attempt to shut down its enclave as part of shutdown).
-	StopObscuroNodes(n.l2Clients)
+	StopObscuroNodes(n.rpcClients())
 	StopEth2Network(n.gethClients, n.eth2Network)
 	CheckHostRPCServersStopped(n.hostWebsocketURLs)
+	for _, dbPaths := range n.dbPaths {
+		dbPaths.remove()
+	}
+}
+
+func (n *networkOfSocketNodes) StopNode(nodeIdx int) error {
+	n.nodesLock.Lock()
+	defer n.nodesLock.Unlock()
+	if !n.simParams.IsRestarted(nodeIdx) {
+		return ErrRestartNotSupported
+	}
+	if client := n.l2Clients[nodeIdx].swap(nil); client != nil {
+		client.Stop()
+	}
+	if err := n.nodes[nodeIdx].Stop(); err != nil {
+		return fmt.Errorf("could not stop obscuro node %d - %w", nodeIdx, err)
+	}
+	// the restarted node binds to the same ports
+	CheckHostRPCServersStopped([]string{n.hostWebsocketURLs[nodeIdx]})
+	return nil
+}
+
+func (n *networkOfSocketNodes) RestartNode(nodeIdx int) error {
+	n.nodesLock.Lock()
+	defer n.nodesLock.Unlock()
+	if !n.simParams.IsRestarted(nodeIdx) {
+		return ErrRestartNotSupported
+	}
+	// the host and the enclave are recreated, as they would be by a new process
+	if err := n.nodes[nodeIdx].Start(); err != nil {
+		return fmt.Errorf("could not restart obscuro node %d - %w", nodeIdx, err)
+	}
+	client, err := n.connectToNode(nodeIdx)
+	if err != nil {
+		return err
+	}
+	if err = waitForHealthyNode(obsclient.NewObsClient(client)); err != nil {
+		return fmt.Errorf("obscuro node %d - %w", nodeIdx, err)
+	}
+	n.l2Clients[nodeIdx].swap(client)
+	return nil
+}
+
+func (n *networkOfSocketNodes) AddNode() (*JoinedNode, error) {
+	n.nodesLock.Lock()
+	defer n.nodesLock.Unlock()
+
+	nodeIdx := len(n.nodes)
+	if nodeIdx >= len(n.wallets.NodeWallets) {
+		return nil, fmt.Errorf("there is no wallet for node %d", nodeIdx)
+	}
+	newNode, err := n.createNode(nodeIdx)
+	if err != nil {
+		return nil, err
+	}
+	if err = newNode.Start(); err != nil {
+		return nil, fmt.Errorf("could not start obscuro node %d - %w", nodeIdx, err)
+	}
+	n.nodes = append(n.nodes, newNode)
+	n.hostWebsocketURLs = append(n.hostWebsocketURLs, fmt.Sprintf("ws://%s:%d", Localhost, n.simParams.StartPort+integration.DefaultHostRPCWSOffset+nodeIdx))
+
+	client, err := n.connectToNode(nodeIdx)
+	if err != nil {
+		return nil, err
+	}
+	l2Client := newRestartableClient(client)
+	n.l2Clients = append(n.l2Clients, l2Client)
+	return &JoinedNode{Idx: nodeIdx, HostID: n.wallets.NodeWallets[nodeIdx].Address(), Client: l2Client}, nil
+}
+
+func (n *networkOfSocketNodes) Partitions() *p2p.PartitionController {
+	return n.partitions
+}
+
+func (n *networkOfSocketNodes) BatchFaults() *p2p.BatchFaultInjector {
+	return n.batchFaults
+}
+
+// createNode - creates the obscuro node with the given index, connected to the first geth node
+func (n *networkOfSocketNodes) createNode(nodeIdx int) (node.Node, error) {
+	seqPrivateKey := n.wallets.NodeWallets[0].PrivateKey()
+	seqHostAddress := crypto.PubkeyToAddress(seqPrivateKey.PublicKey)
+	nodeTypeStr := "sequencer"
+	isInboundP2PDisabled := false
+
+	// if it's not the sequencer
+	if nodeIdx != 0 {
+		nodeTypeStr = "validator"
+		// only the validators can have the incoming p2p disabled
+		isInboundP2PDisabled = nodeIdx == n.simParams.NodeWithInboundP2PDisabled
+	}
+	privateKey := n.wallets.NodeWallets[nodeIdx].PrivateKey()
+	hostAddress := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	// only the node which is restarted needs its databases to outlive it, the others use in-memory databases
+	dbPaths := &nodeDBPaths{}
+	if n.simParams.IsRestarted(nodeIdx) {
+		var err error
+		dbPaths, err = newNodeDBPaths()
+		if err != nil {
+			return nil, err
+		}
+		n.dbPaths = append(n.dbPaths, dbPaths)
+	}
+
+	simParams := n.simParams
+	return noderunner.NewInMemNode(
+		node.NewNodeConfig(
+			node.WithGenesis(nodeIdx == 0),
+			node.WithHostID(hostAddress.String()),
+			node.WithPrivateKey(fmt.Sprintf("%x", crypto.FromECDSA(privateKey))),
+			node.WithSequencerID(seqHostAddress.String()),
+			node.WithEnclaveWSPort(simParams.StartPort+integration.DefaultEnclaveOffset+nodeIdx),
+			node.WithHostWSPort(simParams.StartPort+integration.DefaultHostRPCWSOffset+nodeIdx),
+			node.WithHostHTTPPort(simParams.StartPort+integration.DefaultHostRPCHTTPOffset+nodeIdx),
+			node.WithHostP2PPort(simParams.StartPort+integration.DefaultHostP2pOffset+nodeIdx),
+			node.WithHostPublicP2PAddr(fmt.Sprintf("127.0.0.1:%d", simParams.StartPort+integration.DefaultHostP2pOffset+nodeIdx)),
+			node.WithManagementContractAddress(simParams.L1SetupData.MgmtContractAddress.String()),
+			node.WithMessageBusContractAddress(simParams.L1SetupData.MessageBusAddr.String()),
+			node.WithNodeType(nodeTypeStr),
+			node.WithCoinbase(simParams.Wallets.L2FeesWallet.Address().Hex()),
+			node.WithL1WebsocketURL(fmt.Sprintf("ws://%s:%d", "127.0.0.1", simParams.StartPort+100)),
+			node.WithInboundP2PDisabled(isInboundP2PDisabled),
+			node.WithLogLevel(4),
+			node.WithDebugNamespaceEnabled(true),
+			node.WithL1BlockTime(simParams.AvgBlockDuration),
+			node.WithObscuroGenesis(n.genesis),
+			node.WithSqliteDBPath(dbPaths.enclaveDB),
+			node.WithLevelDBPath(dbPaths.hostDB),
+		),
+		n.wrapP2P(nodeIdx),
+	), nil
+}
+
+// wrapP2P - makes the p2p layer of the node follow the latencies and the partitions of the network, and the sequencer
+// corrupt batches and record the genuine ones in the stats
+func (n *networkOfSocketNodes) wrapP2P(nodeIdx int) hostcontainer.P2PWrapper {
+	return func(service hostcommon.P2PHostService) hostcommon.P2PHostService {
+		service = p2p.NewDelayedP2P(service, nodeIdx, n.latency)
+		service = p2p.NewPartitionedP2P(service, nodeIdx, n.partitions)
+		if nodeIdx == 0 {
+			// the stats only see the genuine batches
+			service = p2p.NewBatchStatsP2P(n.batchFaults.Wrap(service), n.stats)
+		}
+		return service
+	}
+}
+
+func (n *networkOfSocketNodes) rpcClients() []rpc.Client {
+	clients := make([]rpc.Client, len(n.l2Clients))
+	for i, client := range n.l2Clients {
+		clients[i] = client
+	}
+	return clients
 }
 
 func (n *networkOfSocketNodes) createConnections(simParams *params.SimParams) error {
 	// create the clients in the structs
-	n.l2Clients = make([]rpc.Client, simParams.NumberOfNodes)
+	n.l2Clients = make([]*restartableClient, simParams.NumberOfNodes)
 	n.hostWebsocketURLs = make([]string, simParams.NumberOfNodes)
 	n.obscuroClients = make([]*obsclient.ObsClient, simParams.NumberOfNodes)
 
 	for i := 0; i < simParams.NumberOfNodes; i++ {
-		var client rpc.Client
-		var err error
-
-		// create a connection to the newly created nodes - panic if no connection is made after some time
-		startTime := time.Now()
-		for connected := false; !connected; time.Sleep(500 * time.Millisecond) {
-			client, err = rpc.NewNetworkClient(fmt.Sprintf("ws://127.0.0.1:%d", simParams.StartPort+integration.DefaultHostRPCWSOffset+i))
-			connected = err == nil // The client cannot be created until the node has started.
-			if time.Now().After(startTime.Add(2 * time.Minute)) {
-				return fmt.Errorf("failed to create a connect to node after 2 minute - %w", err)
-			}
-
-			testlog.Logger().Info(fmt.Sprintf("Could not create client %d. Retrying...", i), log.ErrKey, err)
+		client, err := n.connectToNode(i)
+		if err != nil {
+			return err
 		}
 
-		n.l2Clients[i] = client
+		n.l2Clients[i] = newRestartableClient(client)
 		n.hostWebsocketURLs[i] = fmt.Sprintf("ws://%s:%d", Localhost, simParams.StartPort+integration.DefaultHostRPCWSOffset+i)
 	}
 
@@ -179,13 +316,39 @@ func (n *networkOfSocketNodes) createConnections(simParams *params.SimParams) er
 
 	// make sure the nodes are healthy
 	for _, client := range n.obscuroClients {
-		startTime := time.Now()
-		healthy := false
-		for ; !healthy; time.Sleep(500 * time.Millisecond) {
-			healthy, _ = client.Health()
-			if time.Now().After(startTime.Add(3 * time.Minute)) {
-				return fmt.Errorf("nodes not healthy after 3 minutes")
-			}
+		if err := waitForHealthyNode(client); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// connectToNode - creates a connection to the started node, retrying until the node accepts it
+func (n *networkOfSocketNodes) connectToNode(nodeIdx int) (rpc.Client, error) {
+	var client rpc.Client
+	var err error
+
+	// create a connection to the newly created nodes - panic if no connection is made after some time
+	startTime := time.Now()
+	for connected := false; !connected; time.Sleep(500 * time.Millisecond) {
+		client, err = rpc.NewNetworkClient(fmt.Sprintf("ws://127.0.0.1:%d", n.simParams.StartPort+integration.DefaultHostRPCWSOffset+nodeIdx))
+		connected = err == nil // The client cannot be created until the node has started.
+		if time.Now().After(startTime.Add(2 * time.Minute)) {
+			return nil, fmt.Errorf("failed to create a connect to node after 2 minute - %w", err)
+		}
+
+		testlog.Logger().Info(fmt.Sprintf("Could not create client %d. Retrying...", nodeIdx), log.ErrKey, err)
+	}
+	return client, nil
+}
+
+func waitForHealthyNode(client *obsclient.ObsClient) error {
+	startTime := time.Now()
+	healthy := false
+	for ; !healthy; time.Sleep(500 * time.Millisecond) {
+		healthy, _ = client.Health()
+		if time.Now().After(startTime.Add(3 * time.Minute)) {
+			return fmt.Errorf("nodes not healthy after 3 minutes")
 		}
 	}
 	return nil
@@ -135,7 +135,10 @@ func (o *OutputStats) String() string {
 		"nrTransferTransactions: %d\n"+
 		"nrNativeTransferTransactions: %d\n"+
 		"nrBlockParsedERC20Deposits: %d\n"+
-		"gasBridgeCount: %d\n",
+		"gasBridgeCount: %d\n"+
+		"txLatencies: %+v\n"+
+		"peakMempoolDepths: %v\n"+
+		"burstTxs: %d accepted, %d refused\n",
 		o.simulation.Stats.NrMiners,
 		o.l1Height,
 		o.l2Height,
@@ -155,5 +158,9 @@ func (o *OutputStats) String() string {
 		o.simulation.Stats.NrNativeTransferTransactions,
 		o.canonicalERC20DepositCount,
 		len(o.simulation.TxInjector.TxTracker.GasBridgeTransactions),
+		txLatencyReports(o.simulation.Stats),
+		o.simulation.Stats.PeakMempoolDepths(),
+		len(o.simulation.TxInjector.TxTracker.BurstL2Transactions),
+		len(o.simulation.TxInjector.TxTracker.RejectedBurstTransactions),
 	)
 }
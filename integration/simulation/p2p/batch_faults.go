@@ -0,0 +1,137 @@
+package p2p
+
+import (
+	"math/big"
+	"math/rand"
+	"sync"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+)
+
+// BatchFault - a way the batches gossiped by an adversarial sequencer are corrupted
+type BatchFault int
+
+const (
+	FaultBadSignature BatchFault = iota
+	FaultWrongParent
+	FaultTamperedStateRoot
+	FaultConflictingSeqNo // the same seq no as a genuine batch, with different content
+	nrBatchFaults
+)
+
+func (f BatchFault) String() string {
+	switch f {
+	case FaultBadSignature:
+		return "bad signature"
+	case FaultWrongParent:
+		return "wrong parent hash"
+	case FaultTamperedStateRoot:
+		return "tampered state root"
+	case FaultConflictingSeqNo:
+		return "conflicting seq no"
+	default:
+		return "unknown fault"
+	}
+}
+
+// CorruptedBatch - a batch gossiped by the adversarial sequencer in place of a genuine one
+type CorruptedBatch struct {
+	Header *common.BatchHeader
+	Fault  BatchFault
+}
+
+// BatchFaultInjector - corrupts the batches gossiped by the sequencer at the given rate, and records the corrupted ones
+// so the simulation can check that the validators rejected them. A nil injector does not corrupt any batch.
+type BatchFaultInjector struct {
+	rate      float64
+	gap       time.Duration // between the gossip of a batch and of its corrupted copy, longer than the gossip latency
+	lock      sync.Mutex
+	corrupted []CorruptedBatch
+}
+
+func NewBatchFaultInjector(rate float64, gap time.Duration) *BatchFaultInjector {
+	return &BatchFaultInjector{rate: rate, gap: gap}
+}
+
+// Wrap - makes the p2p service of the sequencer gossip corrupted batches
+func (i *BatchFaultInjector) Wrap(p2p host.P2PHostService) host.P2PHostService {
+	if i == nil {
+		return p2p
+	}
+	return &faultyP2P{P2PHostService: p2p, injector: i}
+}
+
+// CorruptedBatches - the batches corrupted so far
+func (i *BatchFaultInjector) CorruptedBatches() []CorruptedBatch {
+	if i == nil {
+		return nil
+	}
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	return append([]CorruptedBatch(nil), i.corrupted...)
+}
+
+// corrupt - a corrupted copy of the batch, or nil if the batch is left alone
+func (i *BatchFaultInjector) corrupt(batch *common.ExtBatch) *common.ExtBatch {
+	if rand.Float64() >= i.rate { //nolint:gosec
+		return nil
+	}
+
+	fault := BatchFault(rand.Intn(int(nrBatchFaults))) //nolint:gosec
+	header := *batch.Header
+	switch fault {
+	case FaultBadSignature:
+		header.S = new(big.Int).Add(header.S, big.NewInt(1))
+	case FaultWrongParent:
+		header.ParentHash = gethcommon.BigToHash(big.NewInt(rand.Int63())) //nolint:gosec
+	case FaultTamperedStateRoot:
+		header.Root = gethcommon.BigToHash(big.NewInt(rand.Int63())) //nolint:gosec
+	case FaultConflictingSeqNo:
+		header.GasUsed++
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.corrupted = append(i.corrupted, CorruptedBatch{Header: &header, Fault: fault})
+	return &common.ExtBatch{
+		Header:          &header,
+		TxHashes:        batch.TxHashes,
+		EncryptedTxBlob: batch.EncryptedTxBlob,
+	}
+}
+
+// faultyP2P - gossips a corrupted copy along with some of the batches, before or after the genuine batch at random. The
+// validators must reject the corrupted copies and stay live either way: the hosts store the gossiped batches by hash,
+// so a copy with a bad signature shares its hash with the genuine batch, and the other copies share its seq no.
+type faultyP2P struct {
+	host.P2PHostService
+	injector *BatchFaultInjector
+}
+
+func (p *faultyP2P) BroadcastBatches(batches []*common.ExtBatch) error {
+	for _, batch := range batches {
+		corrupted := p.injector.corrupt(batch)
+		if corrupted == nil {
+			if err := p.P2PHostService.BroadcastBatches([]*common.ExtBatch{batch}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		first, second := corrupted, batch
+		if rand.Intn(2) == 0 { //nolint:gosec
+			first, second = batch, corrupted
+		}
+		if err := p.P2PHostService.BroadcastBatches([]*common.ExtBatch{first}); err != nil {
+			return err
+		}
+		time.Sleep(p.injector.gap)
+		if err := p.P2PHostService.BroadcastBatches([]*common.ExtBatch{second}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package p2p
+
+import (
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/integration/simulation/stats"
+)
+
+// batchStatsP2P - wraps the p2p service of the sequencer, and records the batches it gossips in the stats
+type batchStatsP2P struct {
+	host.P2PHostService
+	stats *stats.Stats
+}
+
+// NewBatchStatsP2P - wraps the p2p service of the sequencer, so the batches it gossips are recorded in the stats
+func NewBatchStatsP2P(p2p host.P2PHostService, stats *stats.Stats) host.P2PHostService {
+	return &batchStatsP2P{P2PHostService: p2p, stats: stats}
+}
+
+func (p *batchStatsP2P) BroadcastBatches(batches []*common.ExtBatch) error {
+	for _, batch := range batches {
+		p.stats.NewBatch(batch)
+	}
+	return p.P2PHostService.BroadcastBatches(batches)
+}
@@ -272,7 +272,12 @@ func (c *inMemObscuroClient) RegisterViewingKey(_ gethcommon.Address, _ []byte)
 }
 
 func (c *inMemObscuroClient) health(result interface{}) error {
-	*result.(**hostcommon.HealthCheck) = &hostcommon.HealthCheck{OverallHealth: true}
+	// the in-memory nodes are always reported healthy, with the occupancy of their mempool
+	healthCheck := &hostcommon.HealthCheck{OverallHealth: true}
+	if nodeHealth, err := c.obscuroAPI.Health(); err == nil {
+		healthCheck.TxPool = nodeHealth.TxPool
+	}
+	*result.(**hostcommon.HealthCheck) = healthCheck
 	return nil
 }
 
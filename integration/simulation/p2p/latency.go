@@ -0,0 +1,65 @@
+package p2p
+
+import (
+	"math/big"
+
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/async"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/integration/common/testlog"
+
+	testcommon "github.com/ten-protocol/go-ten/integration/common"
+)
+
+// delayedP2P - wraps the p2p service of a validator, and delays its messages to and from the sequencer on top of the
+// latency of the real network. Like the partitions, it sees the other end of every message.
+type delayedP2P struct {
+	host.P2PHostService
+	nodeIdx int
+	latency *testcommon.Latency
+}
+
+// NewDelayedP2P - wraps the p2p service of the node with the given index, so its messages follow the latencies. The
+// sequencer's service is left alone, as the validators delay both directions of their links to it.
+func NewDelayedP2P(p2p host.P2PHostService, nodeIdx int, latency *testcommon.Latency) host.P2PHostService {
+	if nodeIdx == sequencerIdx || latency == nil {
+		return p2p
+	}
+	return &delayedP2P{P2PHostService: p2p, nodeIdx: nodeIdx, latency: latency}
+}
+
+func (p *delayedP2P) SendTxToSequencer(tx common.EncryptedForwardedTx) error {
+	async.Schedule(p.latency.Delay(p.nodeIdx, sequencerIdx), func() {
+		if err := p.P2PHostService.SendTxToSequencer(tx); err != nil {
+			testlog.Logger().Warn("Could not send the delayed transaction to the sequencer", log.ErrKey, err)
+		}
+	})
+	return nil
+}
+
+func (p *delayedP2P) RequestBatchesFromSequencer(fromSeqNo *big.Int) error {
+	// the caller may reuse the seq no once the call returns
+	seqNo := new(big.Int).Set(fromSeqNo)
+	async.Schedule(p.latency.Delay(p.nodeIdx, sequencerIdx), func() {
+		if err := p.P2PHostService.RequestBatchesFromSequencer(seqNo); err != nil {
+			testlog.Logger().Warn("Could not send the delayed batch request to the sequencer", log.ErrKey, err)
+		}
+	})
+	return nil
+}
+
+func (p *delayedP2P) SubscribeForBatches(handler host.P2PBatchHandler) func() {
+	return p.P2PHostService.SubscribeForBatches(&delayedBatchHandler{P2PBatchHandler: handler, p2p: p})
+}
+
+type delayedBatchHandler struct {
+	host.P2PBatchHandler
+	p2p *delayedP2P
+}
+
+func (h *delayedBatchHandler) HandleBatches(batches []*common.ExtBatch, isLive bool) {
+	async.Schedule(h.p2p.latency.Delay(sequencerIdx, h.p2p.nodeIdx), func() {
+		h.P2PBatchHandler.HandleBatches(batches, isLive)
+	})
+}
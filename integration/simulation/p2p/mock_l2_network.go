@@ -3,6 +3,7 @@ package p2p
 import (
 	"math/big"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -19,23 +20,26 @@ import (
 const _sequencerID = "0"
 
 type MockP2PNetwork struct {
-	nodes map[string]*MockP2P
+	nodes     map[string]*MockP2P
+	nodesLock sync.RWMutex // a restarted node replaces its previous instance while the messages are flowing
 
-	avgLatency                  time.Duration
+	latency                     *testcommon.Latency
 	avgBlockDuration            time.Duration
 	nodeWithIncomingP2PDisabled int
+	partitions                  *PartitionController // the messages are dropped on delivery while their ends are partitioned
 }
 
 type MockP2PNetworkIntf interface {
 	NewNode(id int) host.P2PHostService
 }
 
-func NewMockP2PNetwork(avgBlockDuration time.Duration, avgLatency time.Duration, nodeWithIncomingP2PDisabled int) MockP2PNetworkIntf {
+func NewMockP2PNetwork(avgBlockDuration time.Duration, latency *testcommon.Latency, nodeWithIncomingP2PDisabled int, partitions *PartitionController) MockP2PNetworkIntf {
 	return &MockP2PNetwork{
 		nodes:                       make(map[string]*MockP2P),
 		avgBlockDuration:            avgBlockDuration,
-		avgLatency:                  avgLatency,
+		latency:                     latency,
 		nodeWithIncomingP2PDisabled: nodeWithIncomingP2PDisabled,
+		partitions:                  partitions,
 	}
 }
 
@@ -43,42 +47,76 @@ func (m *MockP2PNetwork) NewNode(id int) host.P2PHostService {
 	idStr := strconv.Itoa(id)
 	isIncomingP2PDisabled := m.nodeWithIncomingP2PDisabled != 0 && m.nodeWithIncomingP2PDisabled == id
 	node := NewMockP2P(m, idStr, isIncomingP2PDisabled)
+	m.nodesLock.Lock()
 	m.nodes[idStr] = node
+	m.nodesLock.Unlock()
 	return node
 }
 
+func (m *MockP2PNetwork) node(id string) (*MockP2P, bool) {
+	m.nodesLock.RLock()
+	defer m.nodesLock.RUnlock()
+	node, ok := m.nodes[id]
+	return node, ok
+}
+
 func (m *MockP2PNetwork) RequestBatchesFromSequencer(id string, fromSeqNo *big.Int) {
-	seqNode := m.nodes[_sequencerID]
-	async.Schedule(m.delay()/2, func() { seqNode.ReceiveBatchRequest(id, fromSeqNo) })
+	seqNode, _ := m.node(_sequencerID)
+	async.Schedule(m.delay(id, _sequencerID)/2, func() {
+		if m.connected(id, _sequencerID) {
+			seqNode.ReceiveBatchRequest(id, fromSeqNo)
+		}
+	})
 }
 
-func (m *MockP2PNetwork) SendTransactionToSequencer(tx common.EncryptedTx) {
-	seqNode := m.nodes[_sequencerID]
-	async.Schedule(m.delay()/2, func() { seqNode.ReceiveTransaction(tx) })
+func (m *MockP2PNetwork) SendTransactionToSequencer(fromNodeID string, tx common.EncryptedForwardedTx) {
+	seqNode, _ := m.node(_sequencerID)
+	async.Schedule(m.delay(fromNodeID, _sequencerID)/2, func() {
+		if m.connected(fromNodeID, _sequencerID) {
+			seqNode.ReceiveTransaction(tx)
+		}
+	})
 }
 
 func (m *MockP2PNetwork) BroadcastBatch(fromNodeID string, batches []*common.ExtBatch) {
+	m.nodesLock.RLock()
+	defer m.nodesLock.RUnlock()
 	for _, node := range m.nodes {
 		if node.id != fromNodeID {
 			tempNode := node
-			async.Schedule(m.delay()/2, func() { tempNode.ReceiveBatches(batches, true) })
+			async.Schedule(m.delay(fromNodeID, tempNode.id)/2, func() {
+				if m.connected(fromNodeID, tempNode.id) {
+					tempNode.ReceiveBatches(batches, true)
+				}
+			})
 		}
 	}
 }
 
-func (m *MockP2PNetwork) RespondToBatchRequest(requesterID string, batches []*common.ExtBatch) {
-	async.Schedule(m.delay()/2, func() {
-		requester, ok := m.nodes[requesterID]
+func (m *MockP2PNetwork) RespondToBatchRequest(fromNodeID string, requesterID string, batches []*common.ExtBatch) {
+	async.Schedule(m.delay(fromNodeID, requesterID)/2, func() {
+		requester, ok := m.node(requesterID)
 		if !ok {
 			panic("requester not found in mock p2p service")
 		}
-		requester.ReceiveBatches(batches, false)
+		if m.connected(fromNodeID, requesterID) {
+			requester.ReceiveBatches(batches, false)
+		}
 	})
 }
 
-// delay returns an expected delay on the l2
-func (m *MockP2PNetwork) delay() time.Duration {
-	return testcommon.RndBtwTime(m.avgLatency/10, 2*m.avgLatency)
+// connected - whether the messages flow between the two nodes
+func (m *MockP2PNetwork) connected(nodeID string, otherNodeID string) bool {
+	nodeIdx, _ := strconv.Atoi(nodeID)
+	otherNodeIdx, _ := strconv.Atoi(otherNodeID)
+	return m.partitions.Connected(nodeIdx, otherNodeIdx)
+}
+
+// delay returns an expected delay on the l2, for a message between the two nodes
+func (m *MockP2PNetwork) delay(fromNodeID string, toNodeID string) time.Duration {
+	fromIdx, _ := strconv.Atoi(fromNodeID)
+	toIdx, _ := strconv.Atoi(toNodeID)
+	return m.latency.Delay(fromIdx, toIdx)
 }
 
 // MockP2P - models the p2p service of a host, but instead of sending messages over tcp it uses the `MockP2PNetwork` to distribute messages
@@ -127,11 +165,11 @@ func (n *MockP2P) UpdatePeerList([]string) {
 	// Do nothing.
 }
 
-func (n *MockP2P) SendTxToSequencer(tx common.EncryptedTx) error {
+func (n *MockP2P) SendTxToSequencer(tx common.EncryptedForwardedTx) error {
 	if atomic.LoadInt32(n.listenerInterrupt) == 1 {
 		return nil
 	}
-	n.network.SendTransactionToSequencer(tx)
+	n.network.SendTransactionToSequencer(n.id, tx)
 	return nil
 }
 
@@ -191,12 +229,16 @@ func (n *MockP2P) RespondToBatchRequest(requesterID string, batches []*common.Ex
 	if atomic.LoadInt32(n.listenerInterrupt) == 1 {
 		return nil
 	}
-	n.network.RespondToBatchRequest(requesterID, batches)
+	n.network.RespondToBatchRequest(n.id, requesterID, batches)
 	return nil
 }
 
 // ReceiveTransaction is a mock method that simulates receiving a batch from a peer and then forwarding to all subscribers
-func (n *MockP2P) ReceiveTransaction(tx common.EncryptedTx) {
+func (n *MockP2P) ReceiveTransaction(tx common.EncryptedForwardedTx) {
+	if atomic.LoadInt32(n.listenerInterrupt) == 1 {
+		return
+	}
+
 	for _, sub := range n.txSubscribers.Subscribers() {
 		sub.HandleTransaction(tx)
 	}
@@ -204,7 +246,7 @@ func (n *MockP2P) ReceiveTransaction(tx common.EncryptedTx) {
 
 // ReceiveBatches is a mock method that simulates receiving a batch from a peer and then forwarding to all subscribers
 func (n *MockP2P) ReceiveBatches(batches []*common.ExtBatch, isLive bool) {
-	if n.isIncomingP2PDisabled {
+	if n.isIncomingP2PDisabled || atomic.LoadInt32(n.listenerInterrupt) == 1 {
 		return
 	}
 
@@ -215,7 +257,7 @@ func (n *MockP2P) ReceiveBatches(batches []*common.ExtBatch, isLive bool) {
 
 // ReceiveBatchRequest is a mock method that simulates receiving a batch request from a peer and then forwarding to all subscribers
 func (n *MockP2P) ReceiveBatchRequest(requestID string, fromSeqNo *big.Int) {
-	if n.isIncomingP2PDisabled {
+	if n.isIncomingP2PDisabled || atomic.LoadInt32(n.listenerInterrupt) == 1 {
 		return
 	}
 
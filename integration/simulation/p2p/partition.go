@@ -0,0 +1,111 @@
+package p2p
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+)
+
+// the index of the sequencer node, which is at one end of every p2p message
+const sequencerIdx = 0
+
+// PartitionController - the partitions of the network between the obscuro nodes. While a partition is in place, the p2p
+// messages between the nodes on its two sides are dropped. A nil controller never partitions the network.
+type PartitionController struct {
+	lock       sync.RWMutex
+	partitions map[uint64]*partition
+	nextID     uint64
+}
+
+type partition struct {
+	sideA map[int]bool
+	sideB map[int]bool
+}
+
+func NewPartitionController() *PartitionController {
+	return &PartitionController{partitions: make(map[uint64]*partition)}
+}
+
+// Partition - drops the messages between the two groups of nodes until the returned func heals the partition
+func (c *PartitionController) Partition(groupA []int, groupB []int) func() {
+	p := &partition{sideA: make(map[int]bool), sideB: make(map[int]bool)}
+	for _, nodeIdx := range groupA {
+		p.sideA[nodeIdx] = true
+	}
+	for _, nodeIdx := range groupB {
+		p.sideB[nodeIdx] = true
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	id := c.nextID
+	c.nextID++
+	c.partitions[id] = p
+
+	return func() {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		delete(c.partitions, id)
+	}
+}
+
+// Connected - whether the messages flow between the two nodes
+func (c *PartitionController) Connected(nodeA int, nodeB int) bool {
+	if c == nil {
+		return true
+	}
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	for _, p := range c.partitions {
+		if (p.sideA[nodeA] && p.sideB[nodeB]) || (p.sideB[nodeA] && p.sideA[nodeB]) {
+			return false
+		}
+	}
+	return true
+}
+
+// partitionedP2P - wraps the p2p service of a node, and drops its messages to and from the sequencer while they are
+// partitioned. The batches and the batch responses only come from the sequencer, and the transactions and the batch
+// requests only go to it, so the wrapper sees the other end of every message.
+type partitionedP2P struct {
+	host.P2PHostService
+	nodeIdx    int
+	partitions *PartitionController
+}
+
+// NewPartitionedP2P - wraps the p2p service of the node with the given index, so it follows the partitions
+func NewPartitionedP2P(p2p host.P2PHostService, nodeIdx int, partitions *PartitionController) host.P2PHostService {
+	return &partitionedP2P{P2PHostService: p2p, nodeIdx: nodeIdx, partitions: partitions}
+}
+
+func (p *partitionedP2P) SendTxToSequencer(tx common.EncryptedForwardedTx) error {
+	if !p.partitions.Connected(p.nodeIdx, sequencerIdx) {
+		return nil
+	}
+	return p.P2PHostService.SendTxToSequencer(tx)
+}
+
+func (p *partitionedP2P) RequestBatchesFromSequencer(fromSeqNo *big.Int) error {
+	if !p.partitions.Connected(p.nodeIdx, sequencerIdx) {
+		return nil
+	}
+	return p.P2PHostService.RequestBatchesFromSequencer(fromSeqNo)
+}
+
+func (p *partitionedP2P) SubscribeForBatches(handler host.P2PBatchHandler) func() {
+	return p.P2PHostService.SubscribeForBatches(&partitionedBatchHandler{P2PBatchHandler: handler, p2p: p})
+}
+
+type partitionedBatchHandler struct {
+	host.P2PBatchHandler
+	p2p *partitionedP2P
+}
+
+func (h *partitionedBatchHandler) HandleBatches(batches []*common.ExtBatch, isLive bool) {
+	if !h.p2p.partitions.Connected(h.p2p.nodeIdx, sequencerIdx) {
+		return
+	}
+	h.P2PBatchHandler.HandleBatches(batches, isLive)
+}
@@ -0,0 +1,31 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionsDropTheMessagesBetweenTheirSidesOnly(t *testing.T) {
+	var noPartitions *PartitionController
+	require.True(t, noPartitions.Connected(0, 1))
+
+	controller := NewPartitionController()
+	healFirst := controller.Partition([]int{0, 1}, []int{3, 4})
+	healSecond := controller.Partition([]int{0}, []int{1})
+
+	// the messages flow within a side, and to the nodes outside the partitions
+	require.True(t, controller.Connected(3, 4))
+	require.True(t, controller.Connected(0, 2))
+	require.True(t, controller.Connected(2, 4))
+	require.False(t, controller.Connected(0, 3))
+	require.False(t, controller.Connected(4, 1))
+	require.False(t, controller.Connected(1, 0))
+
+	// healing a partition leaves the overlapping ones in place
+	healFirst()
+	require.True(t, controller.Connected(0, 3))
+	require.False(t, controller.Connected(0, 1))
+	healSecond()
+	require.True(t, controller.Connected(0, 1))
+}
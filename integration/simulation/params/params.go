@@ -1,12 +1,18 @@
 package params
 
 import (
+	"hash/fnv"
+	"math/big"
+	"math/rand"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/ten-protocol/go-ten/go/ethadapter/erc20contractlib"
 	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
+	"github.com/ten-protocol/go-ten/go/wallet"
+
+	testcommon "github.com/ten-protocol/go-ten/integration/common"
 )
 
 // SimParams are the parameters for setting up the simulation.
@@ -17,19 +23,27 @@ type SimParams struct {
 	AvgBlockDuration  time.Duration
 	AvgNetworkLatency time.Duration // artificial latency injected between sending and receiving messages on the mock network
 
+	// The distribution of the artificial latencies, which replaces the default uniform distribution around the
+	// AvgNetworkLatency. LatencyAsymmetry skews each direction of each link by a fixed factor, e.g. 0.5 makes some links up
+	// to 50% slower and others up to 50% faster.
+	LatencyDistribution testcommon.LatencyDistribution
+	LatencyAsymmetry    float64
+
+	Seed int64 // seeds the random choices of the simulation, e.g. the wallets, the transactions and the latencies. A seed is picked if zero
+
 	SimulationTime time.Duration // how long the simulations should run for
 
 	L1EfficiencyThreshold float64
 
 	// MgmtContractLib allows parsing MgmtContract txs to and from the eth txs
-	MgmtContractLib mgmtcontractlib.MgmtContractLib
+	MgmtContractLib mgmtcontractlib.MgmtContractLib `json:"-"`
 	// ERC20ContractLib allows parsing ERC20Contract txs to and from the eth txs
-	ERC20ContractLib erc20contractlib.ERC20ContractLib
+	ERC20ContractLib erc20contractlib.ERC20ContractLib `json:"-"`
 
 	L1SetupData *L1SetupData
 
-	// Contains all the wallets required by the simulation
-	Wallets *SimWallets
+	// Contains all the wallets required by the simulation. They are left out of the reports, as they hold the keys
+	Wallets *SimWallets `json:"-"`
 
 	StartPort int  // The port from which to start allocating ports. Must be unique across all simulations.
 	IsInMem   bool // Denotes that the sim does not have a full RPC layer.
@@ -38,7 +52,133 @@ type SimParams struct {
 
 	StoppingDelay              time.Duration // How long to wait between injection and verification
 	NodeWithInboundP2PDisabled int
-	WithPrefunding             bool
+
+	// The non-genesis node which is stopped and restarted with the same databases at each of the restart points, which
+	// are relative to the start of the injection. No node is restarted if there are no restart points.
+	NodeToRestart       int
+	NodeRestartPoints   []time.Duration
+	NodeRestartDowntime time.Duration // How long the node stays down at each restart point
+
+	NetworkPartitions []NetworkPartition // The windows during which the p2p messages between groups of nodes are dropped
+
+	// The points, relative to the start of the injection, at which a new validator joins the running network. The
+	// joining nodes take the indices after NumberOfNodes, so the wallets must include a node wallet for each of them.
+	NodeJoinPoints []time.Duration
+
+	// The share of the batches gossiped by the sequencer along with a corrupted copy, that the validators must reject. No
+	// batch is corrupted if zero.
+	BatchCorruptionRate float64
+
+	// The number of sim wallets which subscribe to the ERC20 Transfer events, to check the delivered events against the
+	// receipts. The in-memory networks do not support subscriptions, so they ignore it.
+	NrSubscribedWallets int
+
+	// The limits on the percentiles of the time from the submission of a transaction to the availability of its receipt,
+	// on each node. A limit is not checked if zero. The transactions which never got a receipt are reported as lost, and
+	// do not count in the percentiles.
+	MaxTxLatencyP50 time.Duration
+	MaxTxLatencyP95 time.Duration
+	MaxTxLatencyP99 time.Duration
+
+	// The bursts of transactions, which concentrate the load on a single node to stress its mempool and the batch gas
+	// limit. The wallets must include a burst wallet for each multiple of the rate, see SimWallets.AddBurstWallets.
+	TxBursts []TxBurst
+
+	// The pre-existing L1 network the simulation runs against, instead of starting an L1 of its own. Nil if the network
+	// starts its L1.
+	ExternalL1 *ExternalL1
+	// The block interval observed on the L1 network, set by the networks which do not control the L1 block time. See
+	// L1BlockDuration.
+	L1BlockInterval time.Duration
+}
+
+// ExternalL1 - a pre-existing L1 network, which the obscuro nodes of the simulation connect to. The simulation does not
+// own its nodes, so it never stops them.
+type ExternalL1 struct {
+	// The websocket URLs of the L1 nodes. The obscuro nodes are spread across them.
+	RPCURLs []string
+	// A wallet funded on the L1 network, which sends FundingAmount to each L1 wallet of the simulation before the
+	// contracts are deployed. Its chain ID must be the one of the L1 network, like the L1 wallets of the simulation.
+	FundingWallet wallet.Wallet `json:"-"`
+	FundingAmount *big.Int
+	// The contracts deployed on the L1 by a previous run, which are reused instead of deploying new ones if set. The L1
+	// owners of the tokens in the sim wallets must be the wallets which deployed the ERC20s.
+	Contracts *L1SetupData
+}
+
+// TxBurst - a window during which the injector submits native transfers at RateMultiplier times the baseline rate of the
+// transfers, all to the RPC of the same node. Each multiple of the rate is sent by a burst wallet of its own, which does
+// not send any other transaction.
+type TxBurst struct {
+	Start          time.Duration // relative to the start of the injection
+	Duration       time.Duration
+	RateMultiplier int
+	NodeIdx        int
+}
+
+// NetworkPartition - a window during which the p2p messages between the two groups of nodes are dropped. The nodes which
+// are in neither group keep talking to both.
+type NetworkPartition struct {
+	Start    time.Duration // relative to the start of the injection
+	Duration time.Duration
+	GroupA   []int
+	GroupB   []int
+}
+
+// L1BlockDuration - the L1 block time the block-time-dependent thresholds are derived from. It is the observed block
+// interval of a pre-existing L1, and the configured AvgBlockDuration otherwise.
+func (p *SimParams) L1BlockDuration() time.Duration {
+	if p.L1BlockInterval > 0 {
+		return p.L1BlockInterval
+	}
+	return p.AvgBlockDuration
+}
+
+// PartitionedFraction - the share of the simulation time during which the network is partitioned
+func (p *SimParams) PartitionedFraction() float64 {
+	var partitioned time.Duration
+	for _, partition := range p.NetworkPartitions {
+		partitioned += partition.Duration
+	}
+	return float64(partitioned) / float64(p.SimulationTime)
+}
+
+// LongestPartition - the duration of the longest partition of the network
+func (p *SimParams) LongestPartition() time.Duration {
+	var longest time.Duration
+	for _, partition := range p.NetworkPartitions {
+		if partition.Duration > longest {
+			longest = partition.Duration
+		}
+	}
+	return longest
+}
+
+// SeededRand - a source of randomness for the given part of a simulation, derived from the seed of the simulation. Each
+// part draws from its own stream, so its choices do not depend on when the other parts draw from theirs.
+func SeededRand(seed int64, stream string) *rand.Rand {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(stream))
+	return rand.New(rand.NewSource(seed ^ int64(h.Sum64()))) //nolint:gosec
+}
+
+// Rand - a source of randomness for the given part of the simulation, derived from its seed
+func (p *SimParams) Rand(stream string) *rand.Rand {
+	return SeededRand(p.Seed, stream)
+}
+
+// NewLatency - the latencies of a mock network, seeded from the given stream of the simulation
+func (p *SimParams) NewLatency(stream string) *testcommon.Latency {
+	distribution := p.LatencyDistribution
+	if distribution == nil {
+		distribution = testcommon.UniformLatency{Min: p.AvgNetworkLatency / 10, Max: 2 * p.AvgNetworkLatency}
+	}
+	return testcommon.NewLatency(distribution, p.LatencyAsymmetry, p.Rand(stream).Int63())
+}
+
+// IsRestarted - whether the node is stopped and restarted during the simulation
+func (p *SimParams) IsRestarted(nodeIdx int) bool {
+	return len(p.NodeRestartPoints) > 0 && p.NodeToRestart == nodeIdx
 }
 
 type L1SetupData struct {
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/ethereum/go-ethereum/common"
+	gethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/ten-protocol/go-ten/go/wallet"
 	testcommon "github.com/ten-protocol/go-ten/integration/common"
@@ -42,6 +43,8 @@ type SimWallets struct {
 	SimEthWallets []wallet.Wallet // the wallets of the simulated users on the Ethereum side
 	SimObsWallets []wallet.Wallet // and their equivalents on the obscuro side (with a different chainId)
 
+	BurstObsWallets []wallet.Wallet // the obscuro wallets which only send the bursts of transactions
+
 	GasBridgeWallet wallet.Wallet
 
 	L2FaucetWallet wallet.Wallet // the wallet of the L2 faucet
@@ -52,10 +55,24 @@ type SimWallets struct {
 }
 
 func NewSimWallets(nrSimWallets int, nNodes int, ethereumChainID int64, obscuroChainID int64) *SimWallets {
+	return newSimWallets(datagenerator.RandomWallet, nrSimWallets, nNodes, ethereumChainID, obscuroChainID)
+}
+
+// NewSeededSimWallets - the wallets of a simulation, with the keys drawn from the seed of the simulation, so the runs with
+// the same seed use the same wallets
+func NewSeededSimWallets(seed int64, nrSimWallets int, nNodes int, ethereumChainID int64, obscuroChainID int64) *SimWallets {
+	rng := SeededRand(seed, "wallets")
+	randomWallet := func(chainID int64) wallet.Wallet {
+		return datagenerator.RandomWalletFrom(rng, chainID)
+	}
+	return newSimWallets(randomWallet, nrSimWallets, nNodes, ethereumChainID, obscuroChainID)
+}
+
+func newSimWallets(randomWallet func(chainID int64) wallet.Wallet, nrSimWallets int, nNodes int, ethereumChainID int64, obscuroChainID int64) *SimWallets {
 	// create the ethereum wallets to be used by the nodes
 	nodeWallets := make([]wallet.Wallet, nNodes)
 	for i := 0; i < nNodes; i++ {
-		nodeWallets[i] = datagenerator.RandomWallet(ethereumChainID)
+		nodeWallets[i] = randomWallet(ethereumChainID)
 	}
 
 	// create the wallets to be used by the simulated users
@@ -63,12 +80,12 @@ func NewSimWallets(nrSimWallets int, nNodes int, ethereumChainID int64, obscuroC
 	simEthWallets := make([]wallet.Wallet, nrSimWallets)
 	simObsWallets := make([]wallet.Wallet, nrSimWallets)
 	for i := 0; i < nrSimWallets; i++ {
-		simEthWallets[i] = datagenerator.RandomWallet(ethereumChainID)
+		simEthWallets[i] = randomWallet(ethereumChainID)
 		simObsWallets[i] = wallet.NewInMemoryWalletFromPK(big.NewInt(obscuroChainID), simEthWallets[i].PrivateKey(), testlog.Logger())
 	}
 
 	// create the wallet to deploy the Management contract
-	mcOwnerWallet := datagenerator.RandomWallet(ethereumChainID)
+	mcOwnerWallet := randomWallet(ethereumChainID)
 
 	// create the L2 faucet wallet
 	l2FaucetPrivKey, err := crypto.HexToECDSA(genesis.TestnetPrefundedPK)
@@ -79,19 +96,18 @@ func NewSimWallets(nrSimWallets int, nNodes int, ethereumChainID int64, obscuroC
 
 	gasWallet := wallet.NewInMemoryWalletFromPK(big.NewInt(ethereumChainID), genesis.GasBridgingKeys, testlog.Logger())
 
-	sequencerGasKeys, _ := crypto.GenerateKey()
-	sequencerFeeWallet := wallet.NewInMemoryWalletFromPK(big.NewInt(obscuroChainID), sequencerGasKeys, testlog.Logger())
+	sequencerFeeWallet := randomWallet(obscuroChainID)
 
 	// create the L1 addresses of the two tokens, and connect them to the hardcoded addresses from the enclave
 	hoc := SimToken{
 		Name:              testcommon.HOC,
-		L1Owner:           datagenerator.RandomWallet(ethereumChainID),
+		L1Owner:           randomWallet(ethereumChainID),
 		L2Owner:           wallet.NewInMemoryWalletFromPK(big.NewInt(obscuroChainID), testcommon.HOCOwner, testlog.Logger()),
 		L2ContractAddress: &testcommon.HOCContract,
 	}
 	poc := SimToken{
 		Name:              testcommon.POC,
-		L1Owner:           datagenerator.RandomWallet(ethereumChainID),
+		L1Owner:           randomWallet(ethereumChainID),
 		L2Owner:           wallet.NewInMemoryWalletFromPK(big.NewInt(obscuroChainID), testcommon.POCOwner, testlog.Logger()),
 		L2ContractAddress: &testcommon.POCContract,
 	}
@@ -109,9 +125,9 @@ func NewSimWallets(nrSimWallets int, nNodes int, ethereumChainID int64, obscuroC
 			testcommon.POC: &poc,
 		},
 		PrefundedEthWallets: L1PrefundWallets{
-			HOC:    datagenerator.RandomWallet(ethereumChainID),
-			POC:    datagenerator.RandomWallet(ethereumChainID),
-			Faucet: datagenerator.RandomWallet(ethereumChainID),
+			HOC:    randomWallet(ethereumChainID),
+			POC:    randomWallet(ethereumChainID),
+			Faucet: randomWallet(ethereumChainID),
 		},
 	}
 }
@@ -128,10 +144,40 @@ func (w *SimWallets) AllEthWallets() []wallet.Wallet {
 	return append(append(append(w.NodeWallets, w.SimEthWallets...), w.MCOwnerWallet), ethWallets...)
 }
 
+// L2Allocation - the balance of each L2 wallet of the simulation at genesis. 100 ether, as lower amounts were choking
+// the gas payments
+var L2Allocation = big.NewInt(0).Mul(big.NewInt(100), big.NewInt(gethparams.Ether))
+
+// L2Genesis - the testnet genesis, with every L2 wallet of the simulation prefunded with L2Allocation, so the simulated
+// users can transact from the first batch
+func (w *SimWallets) L2Genesis() *genesis.Genesis {
+	accounts := append([]genesis.Account{}, genesis.TestnetGenesis.Accounts...)
+	prefunded := map[common.Address]bool{}
+	for _, acc := range accounts {
+		prefunded[acc.Address] = true
+	}
+	for _, w := range w.AllObsWallets() {
+		if !prefunded[w.Address()] {
+			accounts = append(accounts, genesis.Account{Address: w.Address(), Amount: L2Allocation})
+			prefunded[w.Address()] = true
+		}
+	}
+	return &genesis.Genesis{Accounts: accounts}
+}
+
 func (w *SimWallets) AllObsWallets() []wallet.Wallet {
 	obsWallets := make([]wallet.Wallet, 0)
 	for _, token := range w.Tokens {
 		obsWallets = append(obsWallets, token.L2Owner)
 	}
-	return append(w.SimObsWallets, obsWallets...)
+	return append(append(obsWallets, w.SimObsWallets...), w.BurstObsWallets...)
+}
+
+// AddBurstWallets - adds the given number of obscuro wallets to send the bursts of transactions, with the keys drawn from
+// the seed of the simulation. They are prefunded at genesis like the other sim wallets.
+func (w *SimWallets) AddBurstWallets(seed int64, nrWallets int, obscuroChainID int64) {
+	rng := SeededRand(seed, "burst wallets")
+	for i := 0; i < nrWallets; i++ {
+		w.BurstObsWallets = append(w.BurstObsWallets, datagenerator.RandomWalletFrom(rng, obscuroChainID))
+	}
 }
@@ -0,0 +1,96 @@
+package simulation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ten-protocol/go-ten/integration/simulation/network"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	simstats "github.com/ten-protocol/go-ten/integration/simulation/stats"
+)
+
+// receiptCollector - polls the receipts of the L2 transactions submitted by the injector, on the node each was submitted
+// to, and records in the stats when each receipt became available. The transactions still without a receipt once the
+// collector stops are the lost ones.
+type receiptCollector struct {
+	stats        *simstats.Stats
+	rpcHandles   *network.RPCHandles
+	pollInterval time.Duration
+
+	lock    sync.Mutex
+	pending []pendingReceipt
+
+	stopCh chan struct{}
+	done   chan struct{}
+}
+
+// pendingReceipt - a submitted transaction whose receipt is not available yet
+type pendingReceipt struct {
+	txHash  gethcommon.Hash
+	sender  gethcommon.Address
+	nodeIdx int
+}
+
+func newReceiptCollector(stats *simstats.Stats, rpcHandles *network.RPCHandles, pollInterval time.Duration) *receiptCollector {
+	return &receiptCollector{
+		stats:        stats,
+		rpcHandles:   rpcHandles,
+		pollInterval: pollInterval,
+		stopCh:       make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+}
+
+// submitted - records the submission of the transaction to the node, and starts polling its receipt
+func (c *receiptCollector) submitted(txHash gethcommon.Hash, sender gethcommon.Address, nodeIdx int) {
+	c.stats.TxSubmitted(txHash, nodeIdx)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.pending = append(c.pending, pendingReceipt{txHash: txHash, sender: sender, nodeIdx: nodeIdx})
+}
+
+func (c *receiptCollector) start(ctx context.Context) {
+	go func() {
+		defer close(c.done)
+		ticker := time.NewTicker(c.pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.stopCh:
+				return
+			case <-ticker.C:
+				c.poll(ctx)
+			}
+		}
+	}()
+}
+
+// stop - stops polling the receipts, once the network had the time to process the last transactions
+func (c *receiptCollector) stop() {
+	close(c.stopCh)
+	<-c.done
+}
+
+func (c *receiptCollector) poll(ctx context.Context) {
+	c.lock.Lock()
+	pending := c.pending
+	c.pending = nil
+	c.lock.Unlock()
+
+	var stillPending []pendingReceipt
+	for _, p := range pending {
+		// the receipts are only visible to the sender
+		client := c.rpcHandles.ObscuroWalletClient(p.sender, p.nodeIdx)
+		if _, err := client.TransactionReceipt(ctx, p.txHash); err != nil {
+			stillPending = append(stillPending, p)
+			continue
+		}
+		c.stats.TxReceiptAvailable(p.txHash)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.pending = append(stillPending, c.pending...)
+}
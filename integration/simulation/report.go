@@ -0,0 +1,196 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/integration/common/testlog"
+	"github.com/ten-protocol/go-ten/integration/simulation/params"
+
+	simstats "github.com/ten-protocol/go-ten/integration/simulation/stats"
+)
+
+// Report - the numbers of a simulation run. It is written as JSON next to the logs of the run, so the runs with
+// different parameters can be compared, e.g. to tune the batch and rollup sizes.
+type Report struct {
+	Params     *params.SimParams
+	Nodes      []NodeReport
+	Batches    BatchReport
+	L1Blocks   uint64 // the height of the L1 chain
+	Rollups    RollupReport
+	Efficiency EfficiencyReport
+}
+
+// NodeReport - the numbers of an obscuro node
+type NodeReport struct {
+	Index    int
+	Batches  uint64 // in the canonical chain of the node, from the genesis batch to its head
+	L1Blocks uint64 // the height of the L1 node the obscuro node is connected to
+	L1Reorgs int    // only counted by the mock L1 nodes
+
+	TxLatency        TxLatencyReport // of the transactions the injector submitted to the node
+	PeakMempoolDepth uint64          // the most transactions seen in the mempool of the node
+}
+
+// TxLatencyReport - the percentiles of the time from the submission of a transaction to the availability of its receipt
+// on the node. The transactions which never got a receipt, and the ones which waited for the node to be restarted, are
+// counted separately, and do not count in the percentiles.
+type TxLatencyReport struct {
+	Txs    int // with a receipt
+	Lost   int // without a receipt
+	Waited int // with a receipt, pending while the node was down
+	P50    time.Duration
+	P95    time.Duration
+	P99    time.Duration
+}
+
+// BatchReport - the numbers of the batches gossiped by the sequencer
+type BatchReport struct {
+	Count          int
+	AvgInterval    time.Duration // between two batches
+	Txs            int
+	AvgTxsPerBatch float64
+	MaxTxsPerBatch int
+	TxThroughput   float64 // the transactions per second, while the sequencer gossiped batches
+}
+
+// RollupReport - the numbers of the rollups published on the canonical L1 chain
+type RollupReport struct {
+	Count      int
+	TotalSize  int // the bytes of the compressed batches
+	AvgSize    float64
+	MaxSize    int
+	AvgBatches float64 // per rollup
+}
+
+// EfficiencyReport - the ratios the simulation checks are based on
+type EfficiencyReport struct {
+	L1ReorgRatio      float64 // the highest share of the L1 blocks reorged out by a node, checked against L1EfficiencyThreshold
+	EmptyL1BlockRatio float64 // only counted by the mock L1 nodes
+	RollupBytesPerTx  float64
+}
+
+// NewReport - the report of the simulation, once it stopped injecting transactions
+func NewReport(s *Simulation) (*Report, error) {
+	report := &Report{Params: s.Params}
+	l1Head, err := s.RPCHandles.EthClients[0].FetchHeadBlock()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the L1 head. Cause: %w", err)
+	}
+	report.L1Blocks = l1Head.NumberU64()
+
+	txLatencies := txLatencyReports(s.Stats)
+	peakMempoolDepths := s.Stats.PeakMempoolDepths()
+	for nodeIdx, client := range s.RPCHandles.ObscuroClients {
+		nodeReport := NodeReport{Index: nodeIdx, TxLatency: txLatencies[nodeIdx], PeakMempoolDepth: peakMempoolDepths[nodeIdx]}
+		if head, err := getHeadBatchHeader(client); err == nil {
+			nodeReport.Batches = head.Number.Uint64() - common.L2GenesisHeight + 1
+		}
+		ethClient := s.RPCHandles.EthClients[nodeIdx]
+		if height, err := ethClient.BlockNumber(); err == nil {
+			nodeReport.L1Blocks = height
+			nodeReport.L1Reorgs = s.Stats.NoL1Reorgs[ethClient.Info().L2ID]
+			if height > 0 {
+				report.Efficiency.L1ReorgRatio = maxFloat(report.Efficiency.L1ReorgRatio, float64(nodeReport.L1Reorgs)/float64(height))
+			}
+		}
+		report.Nodes = append(report.Nodes, nodeReport)
+	}
+
+	txCounts, gossipTimes := s.Stats.Batches()
+	report.Batches.Count = len(txCounts)
+	for _, txCount := range txCounts {
+		report.Batches.Txs += txCount
+		if txCount > report.Batches.MaxTxsPerBatch {
+			report.Batches.MaxTxsPerBatch = txCount
+		}
+	}
+	if len(txCounts) > 0 {
+		report.Batches.AvgTxsPerBatch = float64(report.Batches.Txs) / float64(len(txCounts))
+	}
+	if len(gossipTimes) > 1 {
+		gossiping := gossipTimes[len(gossipTimes)-1].Sub(gossipTimes[0])
+		report.Batches.AvgInterval = gossiping / time.Duration(len(gossipTimes)-1)
+		report.Batches.TxThroughput = float64(report.Batches.Txs) / gossiping.Seconds()
+	}
+
+	var rolledUpBatches uint64
+	for _, rollup := range canonicalRollups(s.RPCHandles.EthClients[0], s, l1Head) {
+		size := len(rollup.BatchPayloads)
+		report.Rollups.Count++
+		report.Rollups.TotalSize += size
+		if size > report.Rollups.MaxSize {
+			report.Rollups.MaxSize = size
+		}
+		if seqNos, err := rollupSeqNoRange(rollup); err == nil {
+			rolledUpBatches += seqNos.Last - seqNos.First + 1
+		}
+	}
+	if report.Rollups.Count > 0 {
+		report.Rollups.AvgSize = float64(report.Rollups.TotalSize) / float64(report.Rollups.Count)
+		report.Rollups.AvgBatches = float64(rolledUpBatches) / float64(report.Rollups.Count)
+	}
+	if report.L1Blocks > 0 {
+		report.Efficiency.EmptyL1BlockRatio = float64(s.Stats.NrEmptyBlocks) / float64(report.L1Blocks)
+	}
+	if report.Batches.Txs > 0 {
+		report.Efficiency.RollupBytesPerTx = float64(report.Rollups.TotalSize) / float64(report.Batches.Txs)
+	}
+	return report, nil
+}
+
+// txLatencyReports - the latencies of the transactions submitted to each node
+func txLatencyReports(stats *simstats.Stats) map[int]TxLatencyReport {
+	latencies, lost, waited := stats.TxLatencies()
+	reports := map[int]TxLatencyReport{}
+	for nodeIdx, nodeLatencies := range latencies {
+		reports[nodeIdx] = TxLatencyReport{
+			Txs: len(nodeLatencies),
+			P50: simstats.Percentile(nodeLatencies, 50),
+			P95: simstats.Percentile(nodeLatencies, 95),
+			P99: simstats.Percentile(nodeLatencies, 99),
+		}
+	}
+	for nodeIdx, nodeLost := range lost {
+		nodeReport := reports[nodeIdx]
+		nodeReport.Lost = nodeLost
+		reports[nodeIdx] = nodeReport
+	}
+	for nodeIdx, nodeWaited := range waited {
+		nodeReport := reports[nodeIdx]
+		nodeReport.Waited = nodeWaited
+		reports[nodeIdx] = nodeReport
+	}
+	return reports
+}
+
+// writeReport - writes the report as JSON next to the log file of the simulation, and returns its path
+func writeReport(report *Report) (string, error) {
+	encoded, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("could not encode the report. Cause: %w", err)
+	}
+	// a test may run several simulations with the same log file
+	logFile := testlog.LogFile()
+	f, err := os.CreateTemp(filepath.Dir(logFile), strings.TrimSuffix(filepath.Base(logFile), ".txt")+"-report-*.json")
+	if err != nil {
+		return "", fmt.Errorf("could not create the report file. Cause: %w", err)
+	}
+	defer f.Close()
+	if _, err = f.Write(encoded); err != nil {
+		return "", fmt.Errorf("could not write the report. Cause: %w", err)
+	}
+	return f.Name(), nil
+}
+
+func maxFloat(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -12,7 +12,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
-	gethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/ten-protocol/go-ten/contracts/generated/MessageBus"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/errutil"
@@ -36,6 +35,7 @@ var initialBalance = common.ValueInWei(big.NewInt(5000))
 
 // Simulation represents all the data required to inject transactions on a network
 type Simulation struct {
+	Network          network.Network
 	RPCHandles       *network.RPCHandles
 	AvgBlockDuration uint64
 	TxInjector       *TransactionInjector
@@ -44,7 +44,12 @@ type Simulation struct {
 	Params           *params.SimParams
 	LogChannels      map[string][]chan common.IDAndLog // Maps an owner to the channels on which they receive logs for each client.
 	Subscriptions    []ethereum.Subscription           // A slice of all created event subscriptions.
+	JoinedNodes      []*network.JoinedNode             // The nodes which joined the running network, set once the simulation stops.
+	auditor          *subscriptionAuditor              // The subscriptions to the Transfer events of a subset of the wallets.
 	ctx              context.Context
+
+	faultsLock sync.Mutex
+	faults     []error // the failures of the faults injected into the network, reported once the simulation stops
 }
 
 // Start executes the simulation given all the Params. Injects transactions.
@@ -59,8 +64,9 @@ func (s *Simulation) Start() {
 	time.Sleep(5 * time.Second)
 
 	s.bridgeFundingToObscuro()
-	s.trackLogs()              // Create log subscriptions, to validate that they're working correctly later.
-	s.prefundObscuroAccounts() // Prefund every L2 wallet
+	s.trackLogs() // Create log subscriptions, to validate that they're working correctly later.
+	s.auditSubscriptions()
+	// the L2 wallets are prefunded at genesis, see SimWallets.L2Genesis
 
 	// wait for the validator to become up to date
 	time.Sleep(1 * time.Second)
@@ -72,6 +78,9 @@ func (s *Simulation) Start() {
 	fmt.Printf("Starting injection\n")
 	testlog.Logger().Info("Starting injection")
 	go s.TxInjector.Start()
+	restartsDone := s.restartNode(timer)
+	partitionsDone := s.partitionNetwork(timer)
+	joinsDone := s.joinNodes(timer)
 
 	// Allow for some time after tx injection was stopped so that the network can process all transactions, catch up
 	// on missed batches, etc.
@@ -84,11 +93,169 @@ func (s *Simulation) Start() {
 	s.TxInjector.Stop()
 
 	time.Sleep(s.Params.StoppingDelay)
+	s.TxInjector.StopCollectingReceipts()
+	<-restartsDone
+	<-partitionsDone
+	<-joinsDone
 
 	fmt.Printf("Ran simulation for %f secs, configured to run for: %s ... \n", time.Since(timer).Seconds(), s.SimulationTime)
 	testlog.Logger().Info(fmt.Sprintf("Ran simulation for %f secs, configured to run for: %s ... \n", time.Since(timer).Seconds(), s.SimulationTime))
 }
 
+// restartNode - stops the node configured to be restarted at each of the restart points after the start of the injection,
+// and starts it again after the downtime. The returned channel is closed once the node is back up for the last time.
+func (s *Simulation) restartNode(injectionStart time.Time) <-chan struct{} {
+	done := make(chan struct{})
+	nodeIdx := s.Params.NodeToRestart
+	if len(s.Params.NodeRestartPoints) > 0 && (nodeIdx <= 0 || nodeIdx >= s.Params.NumberOfNodes) {
+		panic(fmt.Sprintf("only a non-genesis node can be restarted, not node %d", nodeIdx))
+	}
+
+	go func() {
+		defer close(done)
+		for _, restartPoint := range s.Params.NodeRestartPoints {
+			time.Sleep(time.Until(injectionStart.Add(restartPoint)))
+
+			// the node is no longer given out to the injector, and the requests in flight get the time to complete
+			downFrom := time.Now()
+			s.RPCHandles.ExcludeNode(nodeIdx)
+			time.Sleep(s.Params.AvgBlockDuration)
+
+			testlog.Logger().Info(fmt.Sprintf("Stopping node %d", nodeIdx))
+			if err := s.Network.StopNode(nodeIdx); err != nil {
+				s.recordFault(fmt.Errorf("could not stop node %d. Cause: %w", nodeIdx, err))
+				return
+			}
+			time.Sleep(s.Params.NodeRestartDowntime)
+
+			testlog.Logger().Info(fmt.Sprintf("Restarting node %d", nodeIdx))
+			if err := s.Network.RestartNode(nodeIdx); err != nil {
+				s.recordFault(fmt.Errorf("could not restart node %d. Cause: %w", nodeIdx, err))
+				return
+			}
+			s.RPCHandles.IncludeNode(nodeIdx)
+			// the transactions submitted to the node wait for it until it follows the sequencer again
+			s.awaitCatchUp(nodeIdx)
+			s.Stats.NodeDowntime(nodeIdx, downFrom, time.Now())
+		}
+	}()
+	return done
+}
+
+// awaitCatchUp - waits until the node has a batch the sequencer produced after the node was back up, i.e. it caught up
+// and receives the new batches again. The convergence of the node is checked with the rest of the network, so a node
+// which does not catch up is only logged here.
+func (s *Simulation) awaitCatchUp(nodeIdx int) {
+	sequencerHead, err := getHeadBatchHeader(s.RPCHandles.ObscuroClients[0])
+	if err != nil {
+		testlog.Logger().Warn("Could not retrieve the head of the sequencer", log.ErrKey, err)
+		return
+	}
+	err = retry.Do(func() error {
+		headHeight, err := s.RPCHandles.ObscuroClients[nodeIdx].BatchNumber()
+		if err != nil {
+			return err
+		}
+		if headHeight <= sequencerHead.Number.Uint64() {
+			return fmt.Errorf("node %d is at batch %d, the sequencer was at %d", nodeIdx, headHeight, sequencerHead.Number)
+		}
+		return nil
+	}, retry.NewTimeoutStrategy(s.Params.LongestPartition()+maxBlockDelay*s.Params.L1BlockDuration(), s.Params.L1BlockDuration()/10))
+	if err != nil {
+		testlog.Logger().Warn("The restarted node did not catch up", log.ErrKey, err)
+	}
+}
+
+// recordFault - the faults are injected by their own goroutines, so their failures are reported with the checks of the
+// network instead of crashing the test binary
+func (s *Simulation) recordFault(err error) {
+	testlog.Logger().Error("Fault injection failed", log.ErrKey, err)
+	s.faultsLock.Lock()
+	defer s.faultsLock.Unlock()
+	s.faults = append(s.faults, err)
+}
+
+// partitionNetwork - splits the network according to each of the partitions, and heals it at the end of their windows.
+// The returned channel is closed once all the partitions are healed.
+func (s *Simulation) partitionNetwork(injectionStart time.Time) <-chan struct{} {
+	done := make(chan struct{})
+	controller := s.Network.Partitions()
+	if len(s.Params.NetworkPartitions) > 0 && controller == nil {
+		panic("the network cannot be partitioned")
+	}
+
+	var wg sync.WaitGroup
+	for _, partition := range s.Params.NetworkPartitions {
+		wg.Add(1)
+		go func(partition params.NetworkPartition) {
+			defer wg.Done()
+			time.Sleep(time.Until(injectionStart.Add(partition.Start)))
+
+			// the transactions sent to the nodes cut off from the sequencer would be lost, and leave gaps in the nonces
+			// of the wallets, so the injector stops using them, and the requests in flight get the time to complete
+			cutOff := sequencerlessSide(partition)
+			for _, nodeIdx := range cutOff {
+				s.RPCHandles.ExcludeNode(nodeIdx)
+			}
+			time.Sleep(s.Params.AvgBlockDuration)
+
+			testlog.Logger().Info(fmt.Sprintf("Partitioning nodes %v from nodes %v", partition.GroupA, partition.GroupB))
+			heal := controller.Partition(partition.GroupA, partition.GroupB)
+			time.Sleep(partition.Duration)
+
+			testlog.Logger().Info(fmt.Sprintf("Healing the partition of nodes %v from nodes %v", partition.GroupA, partition.GroupB))
+			heal()
+			for _, nodeIdx := range cutOff {
+				s.RPCHandles.IncludeNode(nodeIdx)
+			}
+		}(partition)
+	}
+
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	return done
+}
+
+// joinNodes - adds a new validator to the running network at each of the join points after the start of the injection.
+// The joined nodes are left out of the random clients of the injector, and are checked once the simulation stops. The
+// returned channel is closed once the last node has joined.
+func (s *Simulation) joinNodes(injectionStart time.Time) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, joinPoint := range s.Params.NodeJoinPoints {
+			time.Sleep(time.Until(injectionStart.Add(joinPoint)))
+
+			testlog.Logger().Info("Adding a node to the network")
+			joined, err := s.Network.AddNode()
+			if err != nil {
+				s.recordFault(fmt.Errorf("could not add a node to the network. Cause: %w", err))
+				return
+			}
+			testlog.Logger().Info(fmt.Sprintf("Node %d joined the network", joined.Idx))
+			s.JoinedNodes = append(s.JoinedNodes, joined)
+		}
+	}()
+	return done
+}
+
+// sequencerlessSide - the group of the partition which is cut off from the sequencer, if any
+func sequencerlessSide(partition params.NetworkPartition) []int {
+	for _, nodeIdx := range partition.GroupA {
+		if nodeIdx == 0 {
+			return partition.GroupB
+		}
+	}
+	for _, nodeIdx := range partition.GroupB {
+		if nodeIdx == 0 {
+			return partition.GroupA
+		}
+	}
+	return nil
+}
+
 func (s *Simulation) Stop() {
 	// nothing to do for now
 }
@@ -104,7 +271,7 @@ func (s *Simulation) waitForObscuroGenesisOnL1() {
 			panic(fmt.Errorf("could not fetch head block. Cause: %w", err))
 		}
 		if err == nil {
-			for _, b := range client.BlocksBetween(ethereummock.MockGenesisBlock, head) {
+			for _, b := range client.BlocksBetween(s.l1StartBlock(client), head) {
 				for _, tx := range b.Transactions() {
 					t := s.Params.MgmtContractLib.DecodeTx(tx)
 					if t == nil {
@@ -206,17 +373,18 @@ func (s *Simulation) trackLogs() {
 	}
 }
 
-// Prefunds the L2 wallets with `allocObsWallets` each.
-func (s *Simulation) prefundObscuroAccounts() {
-	faucetWallet := s.Params.Wallets.L2FaucetWallet
-	faucetClient := s.RPCHandles.ObscuroWalletClient(faucetWallet.Address(), 0) // get sequencer, else errors on submission get swallowed
-	nonce := NextNonce(s.ctx, s.RPCHandles, faucetWallet)
-
-	// Give 100 ether per account - ether is 1e18 so best convert it by code
-	// as a lot of the hardcodes were giving way too little and choking the gas payments
-	allocObsWallets := big.NewInt(0).Mul(big.NewInt(100), big.NewInt(gethparams.Ether))
-
-	testcommon.PrefundWallets(s.ctx, faucetWallet, faucetClient, nonce, s.Params.Wallets.AllObsWallets(), allocObsWallets, s.Params.ReceiptTimeout)
+// auditSubscriptions - subscribes a subset of the wallets to the Transfer events of the ERC20s, before they are deployed,
+// so every event of the run can be checked against the receipts
+func (s *Simulation) auditSubscriptions() {
+	// In-memory clients cannot handle subscriptions for now.
+	if s.Params.IsInMem || s.Params.NrSubscribedWallets == 0 {
+		return
+	}
+	auditor, err := newSubscriptionAuditor(s.ctx, s, s.Params.NrSubscribedWallets)
+	if err != nil {
+		panic(err)
+	}
+	s.auditor = auditor
 }
 
 // This deploys an ERC20 contract on Obscuro, which is used for token arithmetic.
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ten-protocol/go-ten/integration"
+	testcommon "github.com/ten-protocol/go-ten/integration/common"
 
 	"github.com/ten-protocol/go-ten/integration/simulation/params"
 
@@ -20,10 +21,12 @@ func TestFullNetworkMonteCarloSimulation(t *testing.T) {
 	numberOfNodes := 5
 	numberOfSimWallets := 5
 
-	wallets := params.NewSimWallets(numberOfSimWallets, numberOfNodes, integration.EthereumChainID, integration.TenChainID)
+	seed := simulationSeed(t)
+	wallets := params.NewSeededSimWallets(seed, numberOfSimWallets, numberOfNodes, integration.EthereumChainID, integration.TenChainID)
 
 	simParams := &params.SimParams{
 		NumberOfNodes:              numberOfNodes,
+		Seed:                       seed,
 		AvgBlockDuration:           1 * time.Second,
 		SimulationTime:             75 * time.Second,
 		L1EfficiencyThreshold:      0.2,
@@ -32,6 +35,70 @@ func TestFullNetworkMonteCarloSimulation(t *testing.T) {
 		ReceiptTimeout:             20 * time.Second,
 		StoppingDelay:              15 * time.Second,
 		NodeWithInboundP2PDisabled: 2,
+		NrSubscribedWallets:        3,
+	}
+	simParams.AvgNetworkLatency = simParams.AvgBlockDuration / 15
+
+	testSimulation(t, network.NewNetworkOfSocketNodes(wallets), simParams)
+}
+
+// This test runs the socket network with heavy-tailed latencies on the links between the validators and the sequencer,
+// which deliver some of the messages out of order, and checks that the nodes still converge.
+func TestFullNetworkHeavyTailedLatencySimulation(t *testing.T) {
+	setupSimTestLog("full-network-latency")
+
+	numberOfNodes := 5
+	numberOfSimWallets := 5
+
+	seed := simulationSeed(t)
+	wallets := params.NewSeededSimWallets(seed, numberOfSimWallets, numberOfNodes, integration.EthereumChainID, integration.TenChainID)
+
+	simParams := &params.SimParams{
+		NumberOfNodes:              numberOfNodes,
+		Seed:                       seed,
+		AvgBlockDuration:           1 * time.Second,
+		SimulationTime:             75 * time.Second,
+		L1EfficiencyThreshold:      0.2,
+		Wallets:                    wallets,
+		StartPort:                  integration.StartPortSimulationFullNetwork,
+		ReceiptTimeout:             20 * time.Second,
+		StoppingDelay:              15 * time.Second,
+		NodeWithInboundP2PDisabled: 2,
+		LatencyAsymmetry:           0.5,
+	}
+	// most messages take a few tens of milliseconds, and a few of them more than a block
+	simParams.LatencyDistribution = testcommon.ParetoLatency{
+		Scale: simParams.AvgBlockDuration / 50,
+		Shape: 1.5,
+		Max:   2 * simParams.AvgBlockDuration,
+	}
+
+	testSimulation(t, network.NewNetworkOfSocketNodes(wallets), simParams)
+}
+
+// This test adds validators to the running socket network, which must catch up with the sequencer from the L1 and their
+// peers.
+func TestFullNetworkNodeJoinSimulation(t *testing.T) {
+	setupSimTestLog("full-network-node-join")
+
+	numberOfNodes := 3
+	joinPoints := []time.Duration{15 * time.Second, 35 * time.Second}
+	numberOfSimWallets := 5
+
+	seed := simulationSeed(t)
+	wallets := params.NewSeededSimWallets(seed, numberOfSimWallets, numberOfNodes+len(joinPoints), integration.EthereumChainID, integration.TenChainID)
+
+	simParams := &params.SimParams{
+		NumberOfNodes:         numberOfNodes,
+		Seed:                  seed,
+		AvgBlockDuration:      1 * time.Second,
+		SimulationTime:        75 * time.Second,
+		L1EfficiencyThreshold: 0.2,
+		Wallets:               wallets,
+		StartPort:             integration.StartPortSimulationFullNetwork,
+		ReceiptTimeout:        20 * time.Second,
+		StoppingDelay:         15 * time.Second,
+		NodeJoinPoints:        joinPoints,
 	}
 	simParams.AvgNetworkLatency = simParams.AvgBlockDuration / 15
 
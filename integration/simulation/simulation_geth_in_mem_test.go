@@ -1,14 +1,23 @@
 package simulation
 
 import (
+	"context"
+	"math/big"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ten-protocol/go-ten/go/wallet"
 	"github.com/ten-protocol/go-ten/integration"
+	"github.com/ten-protocol/go-ten/integration/common/testlog"
 
 	"github.com/ten-protocol/go-ten/integration/simulation/network"
 	"github.com/ten-protocol/go-ten/integration/simulation/params"
+
+	gethparams "github.com/ethereum/go-ethereum/params"
 )
 
 const gethTestEnv = "GETH_TEST_ENABLED"
@@ -23,10 +32,12 @@ func TestGethSimulation(t *testing.T) {
 	numberOfNodes := 5
 	numberOfSimWallets := 5
 
-	wallets := params.NewSimWallets(numberOfSimWallets, numberOfNodes, integration.EthereumChainID, integration.TenChainID)
+	seed := simulationSeed(t)
+	wallets := params.NewSeededSimWallets(seed, numberOfSimWallets, numberOfNodes, integration.EthereumChainID, integration.TenChainID)
 
 	simParams := &params.SimParams{
 		NumberOfNodes:         numberOfNodes,
+		Seed:                  seed,
 		AvgBlockDuration:      1 * time.Second,
 		SimulationTime:        35 * time.Second,
 		L1EfficiencyThreshold: 0.2,
@@ -41,3 +52,60 @@ func TestGethSimulation(t *testing.T) {
 
 	testSimulation(t, network.NewNetworkInMemoryGeth(wallets), simParams)
 }
+
+const (
+	externalL1URLsEnv       = "EXTERNAL_L1_URLS"        // the comma-separated websocket URLs of the L1 nodes
+	externalL1FundingKeyEnv = "EXTERNAL_L1_FUNDING_KEY" // the hex private key of a wallet funded on the L1
+)
+
+// TestExternalL1Simulation runs the simulation against a pre-existing L1 network, e.g. a testnet
+func TestExternalL1Simulation(t *testing.T) {
+	urls := os.Getenv(externalL1URLsEnv)
+	fundingKey := os.Getenv(externalL1FundingKeyEnv)
+	if urls == "" || fundingKey == "" {
+		t.Skipf("set the variables to run this test: `%s=ws://...` and `%s=<private key>`", externalL1URLsEnv, externalL1FundingKeyEnv)
+	}
+	setupSimTestLog("external-l1")
+
+	rpcURLs := strings.Split(urls, ",")
+	l1Client, err := ethclient.Dial(rpcURLs[0])
+	if err != nil {
+		t.Fatalf("could not connect to the L1 node %s. Cause: %s", rpcURLs[0], err)
+	}
+	l1ChainID, err := l1Client.ChainID(context.Background())
+	l1Client.Close()
+	if err != nil {
+		t.Fatalf("could not fetch the chain ID of the L1 network. Cause: %s", err)
+	}
+	fundingPrivateKey, err := crypto.HexToECDSA(fundingKey)
+	if err != nil {
+		t.Fatalf("invalid %s. Cause: %s", externalL1FundingKeyEnv, err)
+	}
+
+	numberOfNodes := 5
+	numberOfSimWallets := 5
+
+	seed := simulationSeed(t)
+	wallets := params.NewSeededSimWallets(seed, numberOfSimWallets, numberOfNodes, l1ChainID.Int64(), integration.TenChainID)
+
+	simParams := &params.SimParams{
+		NumberOfNodes:         numberOfNodes,
+		Seed:                  seed,
+		AvgBlockDuration:      1 * time.Second,
+		SimulationTime:        35 * time.Second,
+		L1EfficiencyThreshold: 0.2,
+		Wallets:               wallets,
+		IsInMem:               true,
+		ReceiptTimeout:        30 * time.Second,
+		StoppingDelay:         10 * time.Second,
+		ExternalL1: &params.ExternalL1{
+			RPCURLs:       rpcURLs,
+			FundingWallet: wallet.NewInMemoryWalletFromPK(l1ChainID, fundingPrivateKey, testlog.Logger()),
+			FundingAmount: big.NewInt(gethparams.Ether),
+		},
+	}
+
+	simParams.AvgNetworkLatency = simParams.AvgBlockDuration / 15
+
+	testSimulation(t, network.NewNetworkInMemoryExternalL1(wallets), simParams)
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ten-protocol/go-ten/integration"
 	"github.com/ten-protocol/go-ten/integration/ethereummock"
 	"github.com/ten-protocol/go-ten/integration/simulation/network"
@@ -21,10 +22,12 @@ func TestInMemoryMonteCarloSimulation(t *testing.T) {
 	// todo (#718) - try increasing this back to 7 once faster-finality model is optimised
 	numberOfNodes := 5
 	numberOfSimWallets := 10
-	wallets := params.NewSimWallets(numberOfSimWallets, numberOfNodes, integration.EthereumChainID, integration.TenChainID)
+	seed := simulationSeed(t)
+	wallets := params.NewSeededSimWallets(seed, numberOfSimWallets, numberOfNodes, integration.EthereumChainID, integration.TenChainID)
 
 	simParams := params.SimParams{
 		NumberOfNodes: numberOfNodes,
+		Seed:          seed,
 		//  todo (#718) - try reducing this back to 50 milliseconds once faster-finality model is optimised
 		AvgBlockDuration:           250 * time.Millisecond,
 		SimulationTime:             30 * time.Second,
@@ -38,9 +41,179 @@ func TestInMemoryMonteCarloSimulation(t *testing.T) {
 		ReceiptTimeout:             5 * time.Second,
 		StoppingDelay:              4 * time.Second,
 		NodeWithInboundP2PDisabled: 2,
+		NodeToRestart:              1,
+		NodeRestartPoints:          []time.Duration{8 * time.Second},
+		NodeRestartDowntime:        3 * time.Second,
+		NetworkPartitions: []params.NetworkPartition{
+			{Start: 14 * time.Second, Duration: 4 * time.Second, GroupA: []int{0, 1}, GroupB: []int{3, 4}},
+		},
+		// the users wait for the receipts no longer than the receipt timeout, despite the partition. The transactions
+		// pending on the restarted node while it is down do not count
+		MaxTxLatencyP99: 5 * time.Second,
 	}
 
 	simParams.AvgNetworkLatency = simParams.AvgBlockDuration / 15
 
 	testSimulation(t, network.NewBasicNetworkOfInMemoryNodes(), &simParams)
 }
+
+// This test runs the in memory network with a sequencer which gossips a corrupted copy ahead of some of its batches, and
+// checks that the validators reject the corrupted batches while they keep following the genuine ones.
+func TestInMemoryAdversarialSequencerSimulation(t *testing.T) {
+	setupSimTestLog("in-mem-adversarial")
+
+	numberOfNodes := 5
+	numberOfSimWallets := 10
+	seed := simulationSeed(t)
+	wallets := params.NewSeededSimWallets(seed, numberOfSimWallets, numberOfNodes, integration.EthereumChainID, integration.TenChainID)
+
+	simParams := params.SimParams{
+		NumberOfNodes:              numberOfNodes,
+		Seed:                       seed,
+		AvgBlockDuration:           250 * time.Millisecond,
+		SimulationTime:             30 * time.Second,
+		L1EfficiencyThreshold:      0.2,
+		MgmtContractLib:            ethereummock.NewMgmtContractLibMock(),
+		ERC20ContractLib:           ethereummock.NewERC20ContractLibMock(),
+		Wallets:                    wallets,
+		StartPort:                  integration.StartPortSimulationInMem,
+		IsInMem:                    true,
+		L1SetupData:                &params.L1SetupData{},
+		ReceiptTimeout:             5 * time.Second,
+		StoppingDelay:              4 * time.Second,
+		NodeWithInboundP2PDisabled: 2,
+		BatchCorruptionRate:        0.2,
+	}
+
+	simParams.AvgNetworkLatency = simParams.AvgBlockDuration / 15
+
+	testSimulation(t, network.NewBasicNetworkOfInMemoryNodes(), &simParams)
+}
+
+// This test runs the in memory network with validators joining while the transactions are injected. The new nodes must be
+// sent the network secret through the L1 and catch up with the chain like the others.
+func TestInMemoryNodeJoinSimulation(t *testing.T) {
+	setupSimTestLog("in-mem-node-join")
+
+	numberOfNodes := 3
+	joinPoints := []time.Duration{5 * time.Second, 12 * time.Second}
+	numberOfSimWallets := 10
+	seed := simulationSeed(t)
+	wallets := params.NewSeededSimWallets(seed, numberOfSimWallets, numberOfNodes+len(joinPoints), integration.EthereumChainID, integration.TenChainID)
+
+	simParams := params.SimParams{
+		NumberOfNodes:         numberOfNodes,
+		Seed:                  seed,
+		AvgBlockDuration:      250 * time.Millisecond,
+		SimulationTime:        30 * time.Second,
+		L1EfficiencyThreshold: 0.2,
+		MgmtContractLib:       ethereummock.NewMgmtContractLibMock(),
+		ERC20ContractLib:      ethereummock.NewERC20ContractLibMock(),
+		Wallets:               wallets,
+		StartPort:             integration.StartPortSimulationInMem,
+		IsInMem:               true,
+		L1SetupData:           &params.L1SetupData{},
+		ReceiptTimeout:        5 * time.Second,
+		StoppingDelay:         4 * time.Second,
+		NodeJoinPoints:        joinPoints,
+	}
+
+	simParams.AvgNetworkLatency = simParams.AvgBlockDuration / 15
+
+	testSimulation(t, network.NewBasicNetworkOfInMemoryNodes(), &simParams)
+}
+
+// This test runs the in memory network with bursts of transactions sent to a single node, at many times the rate of the
+// other transfers. The transactions the nodes refuse must be refused for lack of room in the mempool, and the ones they
+// accept must be executed exactly once.
+func TestInMemoryTxBurstSimulation(t *testing.T) {
+	setupSimTestLog("in-mem-tx-burst")
+
+	numberOfNodes := 5
+	numberOfSimWallets := 10
+	rateMultiplier := 5
+	seed := simulationSeed(t)
+	wallets := params.NewSeededSimWallets(seed, numberOfSimWallets, numberOfNodes, integration.EthereumChainID, integration.TenChainID)
+	wallets.AddBurstWallets(seed, rateMultiplier, integration.TenChainID)
+
+	simParams := params.SimParams{
+		NumberOfNodes:         numberOfNodes,
+		Seed:                  seed,
+		AvgBlockDuration:      250 * time.Millisecond,
+		SimulationTime:        30 * time.Second,
+		L1EfficiencyThreshold: 0.2,
+		MgmtContractLib:       ethereummock.NewMgmtContractLibMock(),
+		ERC20ContractLib:      ethereummock.NewERC20ContractLibMock(),
+		Wallets:               wallets,
+		StartPort:             integration.StartPortSimulationInMem,
+		IsInMem:               true,
+		L1SetupData:           &params.L1SetupData{},
+		ReceiptTimeout:        5 * time.Second,
+		StoppingDelay:         4 * time.Second,
+		TxBursts: []params.TxBurst{
+			{Start: 5 * time.Second, Duration: 5 * time.Second, RateMultiplier: rateMultiplier, NodeIdx: 1},
+			{Start: 15 * time.Second, Duration: 5 * time.Second, RateMultiplier: rateMultiplier, NodeIdx: 0},
+		},
+	}
+
+	simParams.AvgNetworkLatency = simParams.AvgBlockDuration / 15
+
+	testSimulation(t, network.NewBasicNetworkOfInMemoryNodes(), &simParams)
+}
+
+// This test runs the in memory network twice with the same seed, and checks that both runs injected the same
+// transactions. The runs may inject a different number of them, as it depends on the timings.
+func TestInMemorySimulationIsReproducible(t *testing.T) {
+	setupSimTestLog("in-mem-reproducible")
+
+	const seed = 42
+	numberOfNodes := 3
+	numberOfSimWallets := 5
+	runSimulation := func() map[string][]gethcommon.Hash {
+		wallets := params.NewSeededSimWallets(seed, numberOfSimWallets, numberOfNodes, integration.EthereumChainID, integration.TenChainID)
+		simParams := params.SimParams{
+			NumberOfNodes:         numberOfNodes,
+			Seed:                  seed,
+			AvgBlockDuration:      250 * time.Millisecond,
+			SimulationTime:        15 * time.Second,
+			L1EfficiencyThreshold: 0.2,
+			MgmtContractLib:       ethereummock.NewMgmtContractLibMock(),
+			ERC20ContractLib:      ethereummock.NewERC20ContractLibMock(),
+			Wallets:               wallets,
+			StartPort:             integration.StartPortSimulationInMem,
+			IsInMem:               true,
+			L1SetupData:           &params.L1SetupData{},
+			ReceiptTimeout:        5 * time.Second,
+			StoppingDelay:         4 * time.Second,
+		}
+		simParams.AvgNetworkLatency = simParams.AvgBlockDuration / 15
+
+		simulation := testSimulation(t, network.NewBasicNetworkOfInMemoryNodes(), &simParams)
+		if simulation == nil {
+			t.Fatalf("the simulation did not run")
+		}
+		return simulation.TxInjector.TxTracker.InjectedTxs()
+	}
+
+	first := runSimulation()
+	second := runSimulation()
+	if len(first) == 0 || len(first) != len(second) {
+		t.Fatalf("the runs injected %d and %d streams of transactions", len(first), len(second))
+	}
+	for stream, firstTxs := range first {
+		secondTxs := second[stream]
+		common := len(firstTxs)
+		if len(secondTxs) < common {
+			common = len(secondTxs)
+		}
+		if common == 0 {
+			t.Errorf("one of the runs did not inject any %s", stream)
+		}
+		for i := 0; i < common; i++ {
+			if firstTxs[i] != secondTxs[i] {
+				t.Errorf("the runs injected different %s, starting with transaction %d", stream, i)
+				break
+			}
+		}
+	}
+}
@@ -20,14 +20,20 @@ import (
 	"github.com/google/uuid"
 )
 
-// testSimulation encapsulates the shared logic for simulating and testing various types of nodes.
-func testSimulation(t *testing.T, netw network.Network, params *params.SimParams) {
+// testSimulation encapsulates the shared logic for simulating and testing various types of nodes. It returns the
+// simulation once it stopped, or nil if the network could not be created.
+func testSimulation(t *testing.T, netw network.Network, params *params.SimParams) *Simulation {
 	defer func() {
 		// wait until clean up is complete before we log the lingering goroutine count
 		testlog.Logger().Info(fmt.Sprintf("goroutine leak monitor - simulation end - %d goroutines currently running", runtime.NumGoroutine()))
 	}()
 	testlog.Logger().Info(fmt.Sprintf("goroutine leak monitor - simulation start - %d goroutines currently running", runtime.NumGoroutine()))
-	rand.Seed(time.Now().UnixNano()) //nolint: staticcheck
+	if params.Seed == 0 {
+		params.Seed = time.Now().UnixNano()
+	}
+	rand.Seed(params.Seed) //nolint: staticcheck
+	fmt.Printf("Simulation seed: %d (set %s to reproduce the run)\n", params.Seed, simulationSeedEnvVar)
+	testlog.Logger().Info(fmt.Sprintf("Simulation seed: %d", params.Seed))
 	uuid.EnableRandPool()
 
 	stats := simstats.NewStats(params.NumberOfNodes)
@@ -39,7 +45,7 @@ func testSimulation(t *testing.T, netw network.Network, params *params.SimParams
 	// Return early if the network was not created
 	if err != nil {
 		fmt.Printf("Could not run test: %s\n", err)
-		return
+		return nil
 	}
 
 	txInjector := NewTransactionInjector(
@@ -55,6 +61,7 @@ func testSimulation(t *testing.T, netw network.Network, params *params.SimParams
 	)
 
 	simulation := Simulation{
+		Network:          netw,
 		RPCHandles:       networkClients,
 		AvgBlockDuration: uint64(params.AvgBlockDuration),
 		TxInjector:       txInjector,
@@ -75,6 +82,15 @@ func testSimulation(t *testing.T, netw network.Network, params *params.SimParams
 	testlog.Logger().Info("Validating simulation results")
 	checkNetworkValidity(t, &simulation)
 
+	// the report is written while the nodes still answer, and does not affect the outcome of the test
+	if report, err := NewReport(&simulation); err != nil {
+		t.Logf("Could not create the simulation report. Cause: %s", err)
+	} else if reportFile, err := writeReport(report); err != nil {
+		t.Logf("Could not write the simulation report. Cause: %s", err)
+	} else {
+		fmt.Printf("Simulation report: %s\n", reportFile)
+	}
+
 	fmt.Printf("Stopping simulation\n")
 	testlog.Logger().Info("Stopping simulation")
 	simulation.Stop()
@@ -82,4 +98,5 @@ func testSimulation(t *testing.T, netw network.Network, params *params.SimParams
 	// generate and print the final stats
 	t.Logf("Simulation results:%+v", NewOutputStats(&simulation))
 	testlog.Logger().Info(fmt.Sprintf("Simulation results:%+v", NewOutputStats(&simulation)))
+	return &simulation
 }
@@ -1,8 +1,11 @@
 package stats
 
 import (
+	"math"
 	"math/big"
+	"sort"
 	"sync"
+	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -28,7 +31,20 @@ type Stats struct {
 	RollupWithMoreRecentProofCount uint64
 	NrTransferTransactions         int
 	NrNativeTransferTransactions   int
-	statsMu                        *sync.RWMutex
+
+	// the batches gossiped by the sequencer
+	batchTxCounts    []int       // the number of transactions of each batch
+	batchGossipTimes []time.Time // when each batch was gossiped
+
+	// the L2 transactions submitted by the injector, by hash
+	submittedTxs map[gethcommon.Hash]*submittedTx
+	// the windows during which each node was taken out of the network, e.g. to restart it
+	downtimes map[int][]downtime
+
+	// the highest number of transactions in the mempool of each node, as reported by the node
+	peakMempoolDepths map[int]uint64
+
+	statsMu *sync.RWMutex
 }
 
 func NewStats(nrMiners int) *Stats {
@@ -39,6 +55,9 @@ func NewStats(nrMiners int) *Stats {
 		NoL2Blocks:                     map[int]uint64{},
 		TotalDepositedAmount:           big.NewInt(0),
 		TotalWithdrawalRequestedAmount: big.NewInt(0),
+		submittedTxs:                   map[gethcommon.Hash]*submittedTx{},
+		downtimes:                      map[int][]downtime{},
+		peakMempoolDepths:              map[int]uint64{},
 		statsMu:                        &sync.RWMutex{},
 	}
 }
@@ -83,8 +102,130 @@ func (s *Stats) NativeTransfer() {
 	s.statsMu.Unlock()
 }
 
+// NewBatch - records a batch gossiped by the sequencer
+func (s *Stats) NewBatch(batch *common.ExtBatch) {
+	s.statsMu.Lock()
+	s.batchTxCounts = append(s.batchTxCounts, len(batch.TxHashes))
+	s.batchGossipTimes = append(s.batchGossipTimes, time.Now())
+	s.statsMu.Unlock()
+}
+
+// Batches - the number of transactions of each batch gossiped by the sequencer, and when each was gossiped
+func (s *Stats) Batches() ([]int, []time.Time) {
+	s.statsMu.RLock()
+	defer s.statsMu.RUnlock()
+	return append([]int(nil), s.batchTxCounts...), append([]time.Time(nil), s.batchGossipTimes...)
+}
+
 func (s *Stats) Withdrawal(v *big.Int) {
 	s.statsMu.Lock()
 	s.TotalWithdrawalRequestedAmount = s.TotalWithdrawalRequestedAmount.Add(s.TotalWithdrawalRequestedAmount, v)
 	s.statsMu.Unlock()
 }
+
+// submittedTx - when a transaction was submitted to a node, and when its receipt was first available on the node
+type submittedTx struct {
+	nodeIdx     int
+	submittedAt time.Time
+	receiptAt   time.Time
+}
+
+// TxSubmitted - records that the transaction was submitted to the node
+func (s *Stats) TxSubmitted(txHash gethcommon.Hash, nodeIdx int) {
+	s.statsMu.Lock()
+	s.submittedTxs[txHash] = &submittedTx{nodeIdx: nodeIdx, submittedAt: time.Now()}
+	s.statsMu.Unlock()
+}
+
+// TxReceiptAvailable - records that the receipt of the submitted transaction is available on the node it was submitted
+// to. Only the first time counts.
+func (s *Stats) TxReceiptAvailable(txHash gethcommon.Hash) {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+	tx, found := s.submittedTxs[txHash]
+	if found && tx.receiptAt.IsZero() {
+		tx.receiptAt = time.Now()
+	}
+}
+
+// downtime - from when a node stopped being given out to the injector, to when it was given out again
+type downtime struct {
+	from time.Time
+	to   time.Time
+}
+
+// NodeDowntime - records that the node was taken out of the network between the two times
+func (s *Stats) NodeDowntime(nodeIdx int, from time.Time, to time.Time) {
+	s.statsMu.Lock()
+	s.downtimes[nodeIdx] = append(s.downtimes[nodeIdx], downtime{from: from, to: to})
+	s.statsMu.Unlock()
+}
+
+// TxLatencies - the time from the submission of each transaction to the availability of its receipt, sorted, the number
+// of transactions which never got a receipt, and the number of transactions which waited for the node to come back, by
+// the node they were submitted to. The transactions which were pending on the node while it was down wait for the
+// downtime, so they do not count in the latencies.
+func (s *Stats) TxLatencies() (map[int][]time.Duration, map[int]int, map[int]int) {
+	s.statsMu.RLock()
+	defer s.statsMu.RUnlock()
+	latencies := map[int][]time.Duration{}
+	lost := map[int]int{}
+	waited := map[int]int{}
+	for _, tx := range s.submittedTxs {
+		if tx.receiptAt.IsZero() {
+			lost[tx.nodeIdx]++
+			continue
+		}
+		if s.pendingDuringDowntime(tx) {
+			waited[tx.nodeIdx]++
+			continue
+		}
+		latencies[tx.nodeIdx] = append(latencies[tx.nodeIdx], tx.receiptAt.Sub(tx.submittedAt))
+	}
+	for _, nodeLatencies := range latencies {
+		sort.Slice(nodeLatencies, func(i, j int) bool { return nodeLatencies[i] < nodeLatencies[j] })
+	}
+	return latencies, lost, waited
+}
+
+// pendingDuringDowntime - whether the node the transaction was submitted to was down before its receipt was available
+func (s *Stats) pendingDuringDowntime(tx *submittedTx) bool {
+	for _, d := range s.downtimes[tx.nodeIdx] {
+		if tx.submittedAt.Before(d.to) && tx.receiptAt.After(d.from) {
+			return true
+		}
+	}
+	return false
+}
+
+// Percentile - the nearest-rank percentile of the sorted durations, or zero if there are none
+func Percentile(sorted []time.Duration, percentile float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(percentile / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// MempoolDepth - records the number of transactions in the mempool of the node
+func (s *Stats) MempoolDepth(nodeIdx int, depth uint64) {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+	if peak, found := s.peakMempoolDepths[nodeIdx]; !found || depth > peak {
+		s.peakMempoolDepths[nodeIdx] = depth
+	}
+}
+
+// PeakMempoolDepths - the highest number of transactions seen in the mempool of each node
+func (s *Stats) PeakMempoolDepths() map[int]uint64 {
+	s.statsMu.RLock()
+	defer s.statsMu.RUnlock()
+	peaks := make(map[int]uint64, len(s.peakMempoolDepths))
+	for nodeIdx, depth := range s.peakMempoolDepths {
+		peaks[nodeIdx] = depth
+	}
+	return peaks
+}
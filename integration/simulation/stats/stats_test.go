@@ -0,0 +1,77 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i)*time.Millisecond)
+	}
+
+	for percentile, expected := range map[float64]time.Duration{
+		50: 50 * time.Millisecond,
+		95: 95 * time.Millisecond,
+		99: 99 * time.Millisecond,
+		0:  time.Millisecond,
+	} {
+		if actual := Percentile(sorted, percentile); actual != expected {
+			t.Errorf("p%v: expected %s, got %s", percentile, expected, actual)
+		}
+	}
+	if actual := Percentile(sorted[:1], 99); actual != time.Millisecond {
+		t.Errorf("p99 of a single latency: expected %s, got %s", time.Millisecond, actual)
+	}
+	if actual := Percentile(nil, 50); actual != 0 {
+		t.Errorf("p50 of no latencies: expected 0, got %s", actual)
+	}
+}
+
+func TestTxLatenciesReportLostTxsSeparately(t *testing.T) {
+	stats := NewStats(2)
+	stats.TxSubmitted(gethcommon.Hash{1}, 0)
+	stats.TxSubmitted(gethcommon.Hash{2}, 0)
+	stats.TxSubmitted(gethcommon.Hash{3}, 1)
+	stats.TxReceiptAvailable(gethcommon.Hash{1})
+	// a later receipt of the same transaction does not count
+	stats.TxReceiptAvailable(gethcommon.Hash{1})
+	// nor does the receipt of a transaction which was not submitted
+	stats.TxReceiptAvailable(gethcommon.Hash{4})
+
+	latencies, lost, _ := stats.TxLatencies()
+	if len(latencies[0]) != 1 || len(latencies[1]) != 0 {
+		t.Errorf("expected a single latency on node 0, got %v", latencies)
+	}
+	if lost[0] != 1 || lost[1] != 1 {
+		t.Errorf("expected a lost transaction on each node, got %v", lost)
+	}
+}
+
+func TestTxsPendingWhileTheirNodeWasDownAreCountedSeparately(t *testing.T) {
+	stats := NewStats(2)
+	// a downtime of node 1 which ended before the transactions were submitted
+	stats.NodeDowntime(1, time.Now().Add(-2*time.Minute), time.Now().Add(-time.Minute))
+	stats.TxSubmitted(gethcommon.Hash{1}, 0)
+	stats.TxSubmitted(gethcommon.Hash{2}, 1)
+	stats.TxSubmitted(gethcommon.Hash{3}, 1)
+	stats.TxReceiptAvailable(gethcommon.Hash{1})
+	stats.TxReceiptAvailable(gethcommon.Hash{2})
+	// node 1 goes down while the transaction 3 is pending
+	stats.NodeDowntime(1, time.Now(), time.Now())
+	stats.TxReceiptAvailable(gethcommon.Hash{3})
+
+	latencies, lost, waited := stats.TxLatencies()
+	if len(latencies[0]) != 1 || len(latencies[1]) != 1 {
+		t.Errorf("expected a latency on each node, got %v", latencies)
+	}
+	if len(lost) != 0 {
+		t.Errorf("expected no lost transactions, got %v", lost)
+	}
+	if waited[0] != 0 || waited[1] != 1 {
+		t.Errorf("expected a transaction waiting for node 1, got %v", waited)
+	}
+}
@@ -0,0 +1,285 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/obsclient"
+	"github.com/ten-protocol/go-ten/go/rpc"
+	"github.com/ten-protocol/go-ten/go/wallet"
+	"github.com/ten-protocol/go-ten/integration/simulation/network"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	testcommon "github.com/ten-protocol/go-ten/integration/common"
+)
+
+const subscriptionsStream = "subscriptions"
+
+// the topic of the ERC20 Transfer events
+var transferEventTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// subscriptionAuditor - subscribes a subset of the wallets to the Transfer events of the simulation ERC20s, each with a
+// viewing key of its own registered on one of the nodes, and records every log delivered to them during the run, so
+// they can be checked against the canonical receipts once the simulation stops.
+type subscriptionAuditor struct {
+	subscribers []*auditedSubscriber
+}
+
+// auditedSubscriber - the subscription of a wallet, and the logs delivered on it
+type auditedSubscriber struct {
+	address      gethcommon.Address
+	nodeIdx      int
+	subscription ethereum.Subscription
+	done         chan struct{}
+
+	lock      sync.Mutex
+	delivered []types.Log
+}
+
+// logKey - identifies a log across the deliveries, whether it was added or removed
+type logKey struct {
+	blockHash gethcommon.Hash
+	txHash    gethcommon.Hash
+	index     uint
+}
+
+func keyOf(l *types.Log) logKey {
+	return logKey{blockHash: l.BlockHash, txHash: l.TxHash, index: l.Index}
+}
+
+// newSubscriptionAuditor - subscribes the given number of sim wallets, picked with the seed of the simulation, each on a
+// node which is never stopped during the run
+func newSubscriptionAuditor(ctx context.Context, s *Simulation, nrSubscribers int) (*subscriptionAuditor, error) {
+	rng := s.Params.Rand(subscriptionsStream)
+	wallets := s.Params.Wallets.SimObsWallets
+	if nrSubscribers > len(wallets) {
+		nrSubscribers = len(wallets)
+	}
+
+	var nodes []int
+	for nodeIdx := range s.RPCHandles.RPCClients {
+		if len(s.Params.NodeRestartPoints) > 0 && nodeIdx == s.Params.NodeToRestart {
+			continue
+		}
+		nodes = append(nodes, nodeIdx)
+	}
+
+	auditor := &subscriptionAuditor{}
+	for _, walletIdx := range rng.Perm(len(wallets))[:nrSubscribers] {
+		nodeIdx := nodes[rng.Intn(len(nodes))]
+		subscriber, err := subscribe(ctx, wallets[walletIdx], nodeIdx, s.RPCHandles.RPCClients[nodeIdx])
+		if err != nil {
+			auditor.stop()
+			return nil, fmt.Errorf("could not subscribe wallet %s on node %d. Cause: %w", wallets[walletIdx].Address(), nodeIdx, err)
+		}
+		auditor.subscribers = append(auditor.subscribers, subscriber)
+	}
+	return auditor, nil
+}
+
+func subscribe(ctx context.Context, w wallet.Wallet, nodeIdx int, client rpc.Client) (*auditedSubscriber, error) {
+	// a client of its own, so the subscription is not tied to the viewing keys used by the injector
+	authClient := network.CreateAuthClients([]rpc.Client{client}, w)[0]
+
+	filter := filters.FilterCriteria{
+		Addresses: []gethcommon.Address{testcommon.HOCContract, testcommon.POCContract},
+		Topics:    [][]gethcommon.Hash{{transferEventTopic}},
+	}
+	channel := make(chan common.IDAndLog, 1000)
+	sub, err := authClient.SubscribeFilterLogs(ctx, filter, channel)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriber := &auditedSubscriber{address: w.Address(), nodeIdx: nodeIdx, subscription: sub, done: make(chan struct{})}
+	go subscriber.record(channel)
+	return subscriber, nil
+}
+
+// record - drains the channel of the subscription until it is unsubscribed
+func (a *auditedSubscriber) record(channel chan common.IDAndLog) {
+	defer close(a.done)
+	for {
+		select {
+		case idAndLog, ok := <-channel:
+			if !ok {
+				return
+			}
+			a.add(idAndLog)
+		case <-a.subscription.Err():
+			// the logs already buffered in the channel were delivered before the subscription ended
+			for {
+				select {
+				case idAndLog, ok := <-channel:
+					if !ok {
+						return
+					}
+					a.add(idAndLog)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (a *auditedSubscriber) add(idAndLog common.IDAndLog) {
+	// the first message of a subscription only carries its ID
+	if idAndLog.Log == nil {
+		return
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.delivered = append(a.delivered, *idAndLog.Log)
+}
+
+// stop - unsubscribes every wallet, and waits for the logs in flight to be recorded
+func (a *subscriptionAuditor) stop() {
+	for _, subscriber := range a.subscribers {
+		subscriber.subscription.Unsubscribe()
+		<-subscriber.done
+	}
+}
+
+// check - compares the logs delivered to each subscriber against the Transfer events of the canonical receipts of the
+// tracked ERC20 transfers. Every relevant event must be delivered exactly once, no event of another wallet may be
+// delivered, and every log delivered from a batch that is no longer canonical must have been removed again.
+func (a *subscriptionAuditor) check(t *testing.T, s *Simulation) {
+	// the logs of the batches after the last one seen by the subscriptions are not expected
+	lastBatch, err := s.RPCHandles.ObscuroClients[0].BatchNumber()
+	if err != nil {
+		t.Errorf("Subscriptions: could not fetch the head batch. Cause: %s", err)
+		return
+	}
+	a.stop()
+
+	canonical := newCanonicalBatches(s.RPCHandles.ObscuroClients[0])
+	expected := expectedTransferLogs(t, s, canonical, lastBatch)
+
+	for _, subscriber := range a.subscribers {
+		subscriber.lock.Lock()
+		delivered := subscriber.delivered
+		subscriber.lock.Unlock()
+
+		// the number of times each log was delivered, less the number of times it was removed
+		netDeliveries := make(map[logKey]int)
+		batchNumbers := make(map[logKey]uint64)
+		for i := range delivered {
+			l := &delivered[i]
+			if !involves(l, subscriber.address) {
+				t.Errorf("Subscriptions: wallet %s received the log %d of tx %s, which does not involve it",
+					subscriber.address, l.Index, l.TxHash)
+				continue
+			}
+			key := keyOf(l)
+			batchNumbers[key] = l.BlockNumber
+			if l.Removed {
+				if netDeliveries[key] <= 0 {
+					t.Errorf("Subscriptions: wallet %s received the removal of the log %d of tx %s, which it had not been delivered",
+						subscriber.address, l.Index, l.TxHash)
+				}
+				netDeliveries[key]--
+				continue
+			}
+			netDeliveries[key]++
+		}
+
+		for key, count := range netDeliveries {
+			if count > 1 {
+				t.Errorf("Subscriptions: wallet %s received the log %d of tx %s %d times",
+					subscriber.address, key.index, key.txHash, count)
+			}
+			if count != 1 {
+				continue
+			}
+			isCanonical, err := canonical.isCanonical(new(big.Int).SetUint64(batchNumbers[key]), key.blockHash)
+			if err != nil {
+				t.Errorf("Subscriptions: could not fetch batch %d. Cause: %s", batchNumbers[key], err)
+				continue
+			}
+			if !isCanonical {
+				t.Errorf("Subscriptions: wallet %s was not sent the removal of the log %d of tx %s, from the reorged batch %s",
+					subscriber.address, key.index, key.txHash, key.blockHash)
+			}
+		}
+
+		for _, l := range expected {
+			if involves(l, subscriber.address) && netDeliveries[keyOf(l)] < 1 {
+				t.Errorf("Subscriptions: wallet %s on node %d did not receive the log %d of tx %s",
+					subscriber.address, subscriber.nodeIdx, l.Index, l.TxHash)
+			}
+		}
+	}
+}
+
+// expectedTransferLogs - the Transfer events of the tracked ERC20 transfers which succeeded in a canonical batch, up to
+// the given batch. The receipts are only visible to the senders, so they are fetched with the senders' clients.
+func expectedTransferLogs(t *testing.T, s *Simulation, canonical *canonicalBatches, lastBatch uint64) []*types.Log {
+	var expected []*types.Log
+	for _, tx := range s.TxInjector.TxTracker.TransferL2Transactions {
+		sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			t.Errorf("Subscriptions: could not recover the sender of tx %s. Cause: %s", tx.Hash(), err)
+			continue
+		}
+		receipt, err := s.RPCHandles.ObscuroWalletClient(sender, 0).TransactionReceipt(context.Background(), tx.Hash())
+		if err != nil || receipt.Status != types.ReceiptStatusSuccessful || receipt.BlockNumber.Uint64() > lastBatch {
+			// the transfer failed, or was not included in time
+			continue
+		}
+		isCanonical, err := canonical.isCanonical(receipt.BlockNumber, receipt.BlockHash)
+		if err != nil {
+			t.Errorf("Subscriptions: could not fetch batch %d. Cause: %s", receipt.BlockNumber, err)
+			continue
+		}
+		if !isCanonical {
+			continue
+		}
+		for _, l := range receipt.Logs {
+			if len(l.Topics) > 0 && l.Topics[0] == transferEventTopic {
+				expected = append(expected, l)
+			}
+		}
+	}
+	return expected
+}
+
+// involves - whether the wallet is the sender or the recipient of the Transfer event
+func involves(l *types.Log, address gethcommon.Address) bool {
+	if len(l.Topics) != 3 || l.Topics[0] != transferEventTopic {
+		return false
+	}
+	addressTopic := gethcommon.BytesToHash(address.Bytes())
+	return l.Topics[1] == addressTopic || l.Topics[2] == addressTopic
+}
+
+// canonicalBatches - the hashes of the canonical batches by number, fetched once from the sequencer
+type canonicalBatches struct {
+	client *obsclient.ObsClient
+	hashes map[uint64]gethcommon.Hash
+}
+
+func newCanonicalBatches(client *obsclient.ObsClient) *canonicalBatches {
+	return &canonicalBatches{client: client, hashes: make(map[uint64]gethcommon.Hash)}
+}
+
+func (c *canonicalBatches) isCanonical(number *big.Int, hash gethcommon.Hash) (bool, error) {
+	canonicalHash, found := c.hashes[number.Uint64()]
+	if !found {
+		header, err := c.client.BatchHeaderByNumber(number)
+		if err != nil {
+			return false, err
+		}
+		canonicalHash = header.Hash()
+		c.hashes[number.Uint64()] = canonicalHash
+	}
+	return canonicalHash == hash, nil
+}
@@ -0,0 +1,35 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+// endedSubscription - a subscription which has already ended
+type endedSubscription struct {
+	err chan error
+}
+
+func (s *endedSubscription) Unsubscribe() {}
+
+func (s *endedSubscription) Err() <-chan error {
+	return s.err
+}
+
+func TestTheLogsBufferedBeforeTheSubscriptionEndedAreRecorded(t *testing.T) {
+	subscription := &endedSubscription{err: make(chan error)}
+	close(subscription.err)
+	subscriber := &auditedSubscriber{subscription: subscription, done: make(chan struct{})}
+
+	channel := make(chan common.IDAndLog, 3)
+	channel <- common.IDAndLog{}
+	channel <- common.IDAndLog{Log: &types.Log{Index: 1}}
+	channel <- common.IDAndLog{Log: &types.Log{Index: 2}}
+
+	subscriber.record(channel)
+	<-subscriber.done
+	require.Len(t, subscriber.delivered, 2)
+}
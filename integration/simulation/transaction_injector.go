@@ -40,11 +40,22 @@ const (
 	EnclavePublicKeyHex = "034d3b7e63a8bcd532ee3d1d6ecad9d67fca7821981a044551f0f0cbec74d0bc5e"
 )
 
+// The streams of transactions issued concurrently by the injector. Each draws its choices from its own source, seeded
+// from the seed of the simulation.
+const (
+	depositsStream       = "deposits"
+	transfersStream      = "transfers"
+	valueTransfersStream = "value transfers"
+	gasBridgingStream    = "gas bridging"
+	invalidTxsStream     = "invalid txs"
+)
+
 // TransactionInjector is a structure that generates, issues and tracks transactions
 type TransactionInjector struct {
 	// counters
 	TxTracker *txInjectorTracker
 	stats     *simstats.Stats
+	receipts  *receiptCollector
 
 	// settings
 	avgBlockDuration time.Duration
@@ -109,6 +120,7 @@ func NewTransactionInjector(
 		erc20ContractLib: erc20ContractLib,
 		wallets:          wallets,
 		TxTracker:        newCounter(),
+		receipts:         newReceiptCollector(stats, rpcHandles, avgBlockDuration/10),
 		enclavePublicKey: enclavePublicKeyEcies,
 		txsToIssue:       txsToIssue,
 		params:           params,
@@ -121,7 +133,20 @@ func NewTransactionInjector(
 // Deposits an initial balance in to each wallet
 // Generates and issues L1 and L2 transactions to the network
 func (ti *TransactionInjector) Start() {
+	injectionStart := time.Now()
+	ti.receipts.start(ti.ctx)
+
 	var wg errgroup.Group
+	wg.Go(func() error {
+		ti.sampleMempoolDepths()
+		return nil
+	})
+
+	wg.Go(func() error {
+		ti.issueBursts(injectionStart)
+		return nil
+	})
+
 	wg.Go(func() error {
 		ti.issueRandomDeposits()
 		return nil
@@ -168,24 +193,32 @@ func (ti *TransactionInjector) Stop() {
 	}
 }
 
+// StopCollectingReceipts stops polling the receipts of the submitted transactions. The transactions without a receipt
+// by then are counted as lost, so it is called once the network had the time to process them.
+func (ti *TransactionInjector) StopCollectingReceipts() {
+	ti.receipts.stop()
+}
+
 // issueRandomValueTransfers creates and issues a number of L2 value transfer transactions proportional to the simulation time, such that they can be processed
 func (ti *TransactionInjector) issueRandomValueTransfers() {
+	rng := ti.params.Rand(valueTransfersStream)
 	for txCounter := 0; ti.shouldKeepIssuing(txCounter); txCounter++ {
-		fromWallet := ti.rndObsWallet()
-		toWallet := ti.rndObsWallet()
-		obscuroClient := ti.rpcHandles.ObscuroWalletRndClient(fromWallet)
+		fromWallet := ti.rndObsWallet(rng)
+		toWallet := ti.rndObsWallet(rng)
+		obscuroClient, nodeIdx := ti.rpcHandles.ObscuroWalletRndNodeClient(fromWallet)
 		// We avoid transfers to self, unless there is only a single L2 wallet.
 		for len(ti.wallets.SimObsWallets) > 1 && fromWallet.Address().Hex() == toWallet.Address().Hex() {
-			toWallet = ti.rndObsWallet()
+			toWallet = ti.rndObsWallet(rng)
 		}
 		toWalletAddr := toWallet.Address()
 		txData := &types.LegacyTx{
 			Nonce:    fromWallet.GetNonceAndIncrement(),
-			Value:    big.NewInt(int64(testcommon.RndBtw(1, 100))),
+			Value:    big.NewInt(int64(rndBtw(rng, 1, 100))),
 			Gas:      uint64(50_000),
 			GasPrice: gethcommon.Big1,
 			To:       &toWalletAddr,
 		}
+		ti.TxTracker.trackInjectedTx(valueTransfersStream, fromWallet.Address(), txData)
 
 		tx := obscuroClient.EstimateGasAndGasPrice(txData)
 		signedTx, err := fromWallet.SignTransaction(tx)
@@ -201,25 +234,28 @@ func (ti *TransactionInjector) issueRandomValueTransfers() {
 			ti.logger.Info("Failed to issue transfer via RPC.", log.ErrKey, err)
 			continue
 		}
+		ti.receipts.submitted(signedTx.Hash(), fromWallet.Address(), nodeIdx)
 
 		// todo (@pedro) - retrieve receipt
 
 		go ti.TxTracker.trackNativeValueTransferL2Tx(signedTx)
-		sleepRndBtw(ti.avgBlockDuration/10, ti.avgBlockDuration/4)
+		sleepRndBtw(rng, ti.avgBlockDuration/10, ti.avgBlockDuration/4)
 	}
 }
 
 // issueRandomTransfers creates and issues a number of L2 transfer transactions proportional to the simulation time, such that they can be processed
 func (ti *TransactionInjector) issueRandomTransfers() {
+	rng := ti.params.Rand(transfersStream)
 	for txCounter := 0; ti.shouldKeepIssuing(txCounter); txCounter++ {
-		fromWallet := ti.rndObsWallet()
-		toWallet := ti.rndObsWallet()
-		obscuroClient := ti.rpcHandles.ObscuroWalletRndClient(fromWallet)
+		fromWallet := ti.rndObsWallet(rng)
+		toWallet := ti.rndObsWallet(rng)
+		obscuroClient, nodeIdx := ti.rpcHandles.ObscuroWalletRndNodeClient(fromWallet)
 		// We avoid transfers to self, unless there is only a single L2 wallet.
 		for len(ti.wallets.SimObsWallets) > 1 && fromWallet.Address().Hex() == toWallet.Address().Hex() {
-			toWallet = ti.rndObsWallet()
+			toWallet = ti.rndObsWallet(rng)
 		}
-		tx := ti.newObscuroTransferTx(fromWallet, toWallet.Address(), testcommon.RndBtw(1, 500), testcommon.HOC)
+		tx := ti.newObscuroTransferTx(fromWallet, toWallet.Address(), rndBtw(rng, 1, 500), testcommon.HOC)
+		ti.TxTracker.trackInjectedTx(transfersStream, fromWallet.Address(), tx)
 		tx = obscuroClient.EstimateGasAndGasPrice(tx)
 		signedTx, err := fromWallet.SignTransaction(tx)
 		if err != nil {
@@ -232,12 +268,14 @@ func (ti *TransactionInjector) issueRandomTransfers() {
 		err = obscuroClient.SendTransaction(ti.ctx, signedTx)
 		if err != nil {
 			ti.logger.Info("Failed to issue transfer via RPC.", log.ErrKey, err)
+		} else {
+			ti.receipts.submitted(signedTx.Hash(), fromWallet.Address(), nodeIdx)
 		}
 
 		// todo (@pedro) - retrieve receipt
 
 		go ti.TxTracker.trackTransferL2Tx(signedTx)
-		sleepRndBtw(ti.avgBlockDuration/100, ti.avgBlockDuration/20)
+		sleepRndBtw(rng, ti.avgBlockDuration/100, ti.avgBlockDuration/20)
 	}
 }
 
@@ -255,6 +293,7 @@ func (ti *TransactionInjector) bridgeRandomGasTransfers() {
 		panic(err)
 	}
 
+	rng := ti.params.Rand(gasBridgingStream)
 	for txCounter := 0; ti.shouldKeepIssuing(txCounter); txCounter++ {
 		ethClient = ti.rpcHandles.RndEthClient()
 
@@ -268,9 +307,11 @@ func (ti *TransactionInjector) bridgeRandomGasTransfers() {
 			panic(err)
 		}
 
-		receiverWallet := datagenerator.RandomWallet(ti.rndObsWallet().ChainID().Int64())
-		amount := big.NewInt(0).SetUint64(testcommon.RndBtw(500, 100_000))
+		receiverWallet := datagenerator.RandomWalletFrom(rng, ti.rndObsWallet(rng).ChainID().Int64())
+		amount := big.NewInt(0).SetUint64(rndBtw(rng, 500, 100_000))
 		opts.Value = big.NewInt(0).Set(amount)
+		receiverAddr := receiverWallet.Address()
+		ti.TxTracker.trackInjectedTx(gasBridgingStream, gasWallet.Address(), &types.LegacyTx{To: &receiverAddr, Value: amount})
 
 		tx, err := busCtr.SendValueToL2(opts, receiverWallet.Address(), amount)
 		if err != nil {
@@ -279,7 +320,7 @@ func (ti *TransactionInjector) bridgeRandomGasTransfers() {
 
 		go ti.TxTracker.trackGasBridgingTx(tx, receiverWallet)
 
-		sleepRndBtw(ti.avgBlockDuration/3, ti.avgBlockDuration)
+		sleepRndBtw(rng, ti.avgBlockDuration/3, ti.avgBlockDuration)
 	}
 }
 
@@ -287,16 +328,18 @@ func (ti *TransactionInjector) bridgeRandomGasTransfers() {
 func (ti *TransactionInjector) issueRandomDeposits() {
 	// todo (@stefan) - this implementation transfers from the hoc and poc owner contracts
 	// a better implementation should use the bridge
+	rng := ti.params.Rand(depositsStream)
 	for txCounter := 0; ti.shouldKeepIssuing(txCounter); txCounter++ {
 		fromWalletToken := testcommon.HOC
 		if txCounter%2 == 0 {
 			fromWalletToken = testcommon.POC
 		}
 		fromWallet := ti.wallets.Tokens[fromWalletToken].L2Owner
-		toWallet := ti.rndObsWallet()
-		obscuroClient := ti.rpcHandles.ObscuroWalletRndClient(fromWallet)
-		v := testcommon.RndBtw(500, 2000)
+		toWallet := ti.rndObsWallet(rng)
+		obscuroClient, nodeIdx := ti.rpcHandles.ObscuroWalletRndNodeClient(fromWallet)
+		v := rndBtw(rng, 500, 2000)
 		txData := ti.newObscuroTransferTx(fromWallet, toWallet.Address(), v, fromWalletToken)
+		ti.TxTracker.trackInjectedTx(depositsStream, fromWallet.Address(), txData)
 		tx := obscuroClient.EstimateGasAndGasPrice(txData)
 		signedTx, err := fromWallet.SignTransaction(tx)
 		if err != nil {
@@ -310,11 +353,12 @@ func (ti *TransactionInjector) issueRandomDeposits() {
 		if err != nil {
 			ti.logger.Info("Failed to issue deposit via RPC.", log.ErrKey, err)
 		} else {
+			ti.receipts.submitted(signedTx.Hash(), fromWallet.Address(), nodeIdx)
 			go ti.TxTracker.trackTransferL2Tx(signedTx)
 		}
 		// todo (@pedro) - retrieve receipt
 
-		sleepRndBtw(ti.avgBlockDuration/3, ti.avgBlockDuration)
+		sleepRndBtw(rng, ti.avgBlockDuration/3, ti.avgBlockDuration)
 	}
 	// todo (@stefan) - rework this when old contract deployer is phased out?
 }
@@ -328,29 +372,31 @@ func (ti *TransactionInjector) issueRandomWithdrawals() {
 // These transactions should be rejected by the nodes, and thus we expect them to not affect the simulation
 func (ti *TransactionInjector) issueInvalidL2Txs() {
 	// todo (@tudor) - also issue transactions with insufficient gas
+	rng := ti.params.Rand(invalidTxsStream)
 	for txCounter := 0; ti.shouldKeepIssuing(txCounter); txCounter++ {
-		fromWallet := ti.rndObsWallet()
-		toWallet := ti.rndObsWallet()
+		fromWallet := ti.rndObsWallet(rng)
+		toWallet := ti.rndObsWallet(rng)
 		// We avoid transfers to self, unless there is only a single L2 wallet.
 		for len(ti.wallets.SimObsWallets) > 1 && fromWallet.Address().Hex() == toWallet.Address().Hex() {
-			toWallet = ti.rndObsWallet()
+			toWallet = ti.rndObsWallet(rng)
 		}
-		txData := ti.newCustomObscuroWithdrawalTx(testcommon.RndBtw(1, 100))
+		txData := ti.newCustomObscuroWithdrawalTx(rndBtw(rng, 1, 100))
+		ti.TxTracker.trackInjectedTx(invalidTxsStream, fromWallet.Address(), txData)
 
 		tx := ti.rpcHandles.ObscuroWalletRndClient(fromWallet).EstimateGasAndGasPrice(txData)
-		signedTx := ti.createInvalidSignage(tx, fromWallet)
+		signedTx := ti.createInvalidSignage(rng, tx, fromWallet)
 
 		err := ti.rpcHandles.ObscuroWalletRndClient(fromWallet).SendTransaction(ti.ctx, signedTx)
 		if err != nil {
 			ti.logger.Info("Failed to issue withdrawal via RPC. ", log.ErrKey, err)
 		}
-		time.Sleep(testcommon.RndBtwTime(ti.avgBlockDuration/4, ti.avgBlockDuration))
+		sleepRndBtw(rng, ti.avgBlockDuration/4, ti.avgBlockDuration)
 	}
 }
 
 // Uses one of the approaches to create an invalidly-signed transaction.
-func (ti *TransactionInjector) createInvalidSignage(tx types.TxData, w wallet.Wallet) *types.Transaction {
-	switch rand.Intn(2) { //nolint:gosec
+func (ti *TransactionInjector) createInvalidSignage(rng *rand.Rand, tx types.TxData, w wallet.Wallet) *types.Transaction {
+	switch rng.Intn(2) {
 	case 0: // We sign the transaction with a bad signer.
 		incorrectChainID := int64(integration.EthereumChainID + 1)
 		signer := types.NewLondonSigner(big.NewInt(incorrectChainID))
@@ -363,8 +409,8 @@ func (ti *TransactionInjector) createInvalidSignage(tx types.TxData, w wallet.Wa
 	return nil
 }
 
-func (ti *TransactionInjector) rndObsWallet() wallet.Wallet {
-	return ti.wallets.SimObsWallets[rand.Intn(len(ti.wallets.SimObsWallets))] //nolint:gosec
+func (ti *TransactionInjector) rndObsWallet(rng *rand.Rand) wallet.Wallet {
+	return ti.wallets.SimObsWallets[rng.Intn(len(ti.wallets.SimObsWallets))]
 }
 
 func (ti *TransactionInjector) newObscuroTransferTx(from wallet.Wallet, dest gethcommon.Address, amount uint64, ercType testcommon.ERC20) types.TxData {
@@ -0,0 +1,117 @@
+package simulation
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/responses"
+	"github.com/ten-protocol/go-ten/go/wallet"
+	"github.com/ten-protocol/go-ten/integration/simulation/params"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// issueBursts - submits the bursts of transactions of the params one after the other, each at its point after the start
+// of the injection
+func (ti *TransactionInjector) issueBursts(injectionStart time.Time) {
+	for burstIdx, burst := range ti.params.TxBursts {
+		if burst.RateMultiplier > len(ti.wallets.BurstObsWallets) {
+			panic(fmt.Sprintf("burst %d needs %d burst wallets, there are only %d", burstIdx, burst.RateMultiplier, len(ti.wallets.BurstObsWallets)))
+		}
+		if !ti.sleepUntil(injectionStart.Add(burst.Start)) {
+			return
+		}
+
+		ti.logger.Info(fmt.Sprintf("Starting burst %d of %dx the transfer rate on node %d", burstIdx, burst.RateMultiplier, burst.NodeIdx))
+		end := time.Now().Add(burst.Duration)
+		var wg sync.WaitGroup
+		for i := 0; i < burst.RateMultiplier; i++ {
+			wg.Add(1)
+			go func(fromWallet wallet.Wallet, rng *rand.Rand) {
+				defer wg.Done()
+				ti.issueBurst(burst, fromWallet, rng, end)
+			}(ti.wallets.BurstObsWallets[i], ti.params.Rand(fmt.Sprintf("burst %d-%d", burstIdx, i)))
+		}
+		wg.Wait()
+		ti.logger.Info(fmt.Sprintf("Burst %d stopped", burstIdx))
+	}
+}
+
+// sleepUntil - sleeps until the given time, and returns false if the injector was stopped in the meantime
+func (ti *TransactionInjector) sleepUntil(deadline time.Time) bool {
+	for atomic.LoadInt32(ti.interruptRun) == 0 {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return true
+		}
+		if remaining > ti.avgBlockDuration {
+			remaining = ti.avgBlockDuration
+		}
+		time.Sleep(remaining)
+	}
+	return false
+}
+
+// issueBurst - submits native transfers from the burst wallet to the node of the burst, at the baseline rate of the
+// transfers, until the end of the burst. The wallet is only used by this burst, so the nonce of a refused transaction is
+// used again by the next one.
+func (ti *TransactionInjector) issueBurst(burst params.TxBurst, fromWallet wallet.Wallet, rng *rand.Rand, end time.Time) {
+	obscuroClient := ti.rpcHandles.ObscuroWalletClient(fromWallet.Address(), burst.NodeIdx)
+	for time.Now().Before(end) && atomic.LoadInt32(ti.interruptRun) == 0 {
+		toWalletAddr := ti.rndObsWallet(rng).Address()
+		nonce := fromWallet.GetNonce()
+		txData := &types.LegacyTx{
+			Nonce:    nonce,
+			Value:    big.NewInt(int64(rndBtw(rng, 1, 100))),
+			Gas:      uint64(50_000),
+			GasPrice: gethcommon.Big1,
+			To:       &toWalletAddr,
+		}
+
+		tx := obscuroClient.EstimateGasAndGasPrice(txData)
+		signedTx, err := fromWallet.SignTransaction(tx)
+		if err != nil {
+			panic(err)
+		}
+
+		err = obscuroClient.SendTransaction(ti.ctx, signedTx)
+		if err != nil {
+			ti.logger.Info("Burst transaction refused.", log.TxKey, signedTx.Hash(), log.ErrKey, err)
+			ti.TxTracker.trackRejectedBurstTx(signedTx, err)
+		} else {
+			fromWallet.SetNonce(nonce + 1)
+			ti.receipts.submitted(signedTx.Hash(), fromWallet.Address(), burst.NodeIdx)
+			ti.TxTracker.trackBurstTx(signedTx)
+		}
+		sleepRndBtw(rng, ti.avgBlockDuration/100, ti.avgBlockDuration/20)
+	}
+}
+
+// sampleMempoolDepths - records the number of transactions in the mempool of each node in the stats, until the injector
+// is stopped
+func (ti *TransactionInjector) sampleMempoolDepths() {
+	for atomic.LoadInt32(ti.interruptRun) == 0 {
+		for nodeIdx, client := range ti.rpcHandles.ObscuroClients {
+			// the nodes which are stopped do not report their mempool
+			if status, err := client.TxPoolStatus(); err == nil {
+				ti.stats.MempoolDepth(nodeIdx, status.Pending+status.Queued)
+			}
+		}
+		time.Sleep(ti.avgBlockDuration / 4)
+	}
+}
+
+// isCapacityError - whether the node refused the transaction because its mempool had no room for it. The code of the
+// error does not survive every RPC transport, so its text is checked as well.
+func isCapacityError(err error) bool {
+	return responses.ErrorCodeOf(err) == responses.ErrCodeRateLimited ||
+		strings.Contains(err.Error(), legacypool.ErrTxPoolOverflow.Error())
+}
@@ -3,7 +3,9 @@ package simulation
 import (
 	"sync"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 	"github.com/ten-protocol/go-ten/go/wallet"
 
@@ -19,6 +21,19 @@ type txInjectorTracker struct {
 	NativeValueTransferL2Transactions []*common.L2Tx
 	WithdrawalL2Transactions          []*common.L2Tx
 	GasBridgeTransactions             []GasBridgingRecord
+
+	burstTransactionsLock     sync.Mutex
+	BurstL2Transactions       []*common.L2Tx    // the transactions of the bursts which the node accepted
+	RejectedBurstTransactions []RejectedBurstTx // the transactions of the bursts which the node refused
+
+	injectedTxsLock sync.Mutex
+	injectedTxs     map[string][]gethcommon.Hash // the fingerprints of the injected transactions, by stream
+}
+
+// RejectedBurstTx - a transaction of a burst which the node refused, with the error it returned
+type RejectedBurstTx struct {
+	Tx  *common.L2Tx
+	Err error
 }
 
 type GasBridgingRecord struct {
@@ -35,7 +50,38 @@ func newCounter() *txInjectorTracker {
 		WithdrawalL2Transactions:          []*common.L2Tx{},
 		NativeValueTransferL2Transactions: []*common.L2Tx{},
 		GasBridgeTransactions:             []GasBridgingRecord{},
+		injectedTxs:                       map[string][]gethcommon.Hash{},
+	}
+}
+
+// trackInjectedTx records the fingerprint of a transaction as the injector picked it, before it is sent
+func (m *txInjectorTracker) trackInjectedTx(stream string, from gethcommon.Address, txData types.TxData) {
+	m.injectedTxsLock.Lock()
+	defer m.injectedTxsLock.Unlock()
+	m.injectedTxs[stream] = append(m.injectedTxs[stream], injectionFingerprint(from, txData))
+}
+
+// InjectedTxs returns the fingerprints of the injected transactions, in the order each stream injected them. The runs
+// with the same seed inject the same transactions, but the number of them depends on the timings of the run.
+func (m *txInjectorTracker) InjectedTxs() map[string][]gethcommon.Hash {
+	m.injectedTxsLock.Lock()
+	defer m.injectedTxsLock.Unlock()
+	injected := make(map[string][]gethcommon.Hash, len(m.injectedTxs))
+	for stream, fingerprints := range m.injectedTxs {
+		injected[stream] = append([]gethcommon.Hash(nil), fingerprints...)
+	}
+	return injected
+}
+
+// injectionFingerprint - the hash of what the injector picks for a transaction. It leaves out the nonce, the gas and the
+// signature, which depend on the network and on the order in which the concurrent streams use the wallets.
+func injectionFingerprint(from gethcommon.Address, txData types.TxData) gethcommon.Hash {
+	tx := types.NewTx(txData)
+	var to gethcommon.Address
+	if tx.To() != nil {
+		to = *tx.To()
 	}
+	return crypto.Keccak256Hash(from.Bytes(), to.Bytes(), tx.Value().Bytes(), tx.Data())
 }
 
 func (m *txInjectorTracker) trackGasBridgingTx(tx *types.Transaction, receiverWallet wallet.Wallet) {
@@ -66,6 +112,18 @@ func (m *txInjectorTracker) trackNativeValueTransferL2Tx(tx *common.L2Tx) {
 	m.NativeValueTransferL2Transactions = append(m.NativeValueTransferL2Transactions, tx)
 }
 
+func (m *txInjectorTracker) trackBurstTx(tx *common.L2Tx) {
+	m.burstTransactionsLock.Lock()
+	defer m.burstTransactionsLock.Unlock()
+	m.BurstL2Transactions = append(m.BurstL2Transactions, tx)
+}
+
+func (m *txInjectorTracker) trackRejectedBurstTx(tx *common.L2Tx, err error) {
+	m.burstTransactionsLock.Lock()
+	defer m.burstTransactionsLock.Unlock()
+	m.RejectedBurstTransactions = append(m.RejectedBurstTransactions, RejectedBurstTx{Tx: tx, Err: err})
+}
+
 // GetL1Transactions returns all generated L1 L2Txs
 func (m *txInjectorTracker) GetL1Transactions() []ethadapter.L1Transaction {
 	return m.L1Transactions
@@ -4,15 +4,20 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
 	"github.com/ten-protocol/go-ten/go/obsclient"
+	"github.com/ten-protocol/go-ten/integration/ethereummock"
 
 	"github.com/ten-protocol/go-ten/integration/common/testlog"
 
-	testcommon "github.com/ten-protocol/go-ten/integration/common"
-
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
@@ -22,10 +27,26 @@ import (
 
 const (
 	testLogs = "../.build/simulations/"
+
+	// the environment variable which sets the seed of the simulations, to reproduce a run
+	simulationSeedEnvVar = "SIMULATION_SEED"
 )
 
 var SequencerGasKeys, _ = crypto.GenerateKey()
 
+// simulationSeed - the seed set in the environment, or one picked from the time
+func simulationSeed(t *testing.T) int64 {
+	value, found := os.LookupEnv(simulationSeedEnvVar)
+	if !found {
+		return time.Now().UnixNano()
+	}
+	seed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		t.Fatalf("invalid %s %q. Cause: %s", simulationSeedEnvVar, value, err)
+	}
+	return seed
+}
+
 func setupSimTestLog(simType string) {
 	testlog.Setup(&testlog.Cfg{
 		LogDir:      testLogs,
@@ -35,6 +56,20 @@ func setupSimTestLog(simType string) {
 	})
 }
 
+// l1StartBlock - the L1 block the chain is walked back to when looking for the obscuro transactions. On a geth L1, the
+// blocks before the deployment of the management contract are irrelevant, so the walk stops there instead of at the
+// genesis. The mock L1 has no such block.
+func (s *Simulation) l1StartBlock(client ethadapter.EthClient) *types.Block {
+	if s.Params.L1SetupData == nil || s.Params.L1SetupData.ObscuroStartBlock == (gethcommon.Hash{}) {
+		return ethereummock.MockGenesisBlock
+	}
+	block, err := client.BlockByHash(s.Params.L1SetupData.ObscuroStartBlock)
+	if err != nil {
+		panic(fmt.Errorf("could not fetch the L1 start block %s. Cause: %w", s.Params.L1SetupData.ObscuroStartBlock, err))
+	}
+	return block
+}
+
 func minMax(arr []uint64) (min uint64, max uint64) {
 	min = ^uint64(0)
 	for _, no := range arr {
@@ -128,6 +163,14 @@ func findRollupDups(list []*common.ExtRollup) map[common.L2BatchHash]int {
 	return dups
 }
 
-func sleepRndBtw(min time.Duration, max time.Duration) {
-	time.Sleep(testcommon.RndBtwTime(min, max))
+func sleepRndBtw(rng *rand.Rand, min time.Duration, max time.Duration) {
+	time.Sleep(time.Duration(rndBtw(rng, uint64(min), uint64(max))))
+}
+
+// rndBtw - a number between min and max, drawn from the source
+func rndBtw(rng *rand.Rand, min uint64, max uint64) uint64 {
+	if min >= max {
+		panic(fmt.Sprintf("rndBtw requires min (%d) to be lower than max (%d)", min, max))
+	}
+	return uint64(rng.Int63n(int64(max-min))) + min
 }
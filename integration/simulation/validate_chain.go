@@ -3,6 +3,7 @@ package simulation
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/big"
 	"sort"
 	"strings"
@@ -13,15 +14,16 @@ import (
 	"github.com/ten-protocol/go-ten/contracts/generated/MessageBus"
 
 	testcommon "github.com/ten-protocol/go-ten/integration/common"
-	"github.com/ten-protocol/go-ten/integration/ethereummock"
 
 	"github.com/ten-protocol/go-ten/integration/common/testlog"
 
 	"github.com/ten-protocol/go-ten/go/obsclient"
 
 	"github.com/ten-protocol/go-ten/integration/simulation/network"
+	"github.com/ten-protocol/go-ten/integration/simulation/p2p"
 
 	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/retry"
 
 	"github.com/ten-protocol/go-ten/go/rpc"
 
@@ -29,6 +31,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common/compression"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ten-protocol/go-ten/go/common"
@@ -45,6 +51,9 @@ const (
 	maxBlockDelay = 5
 	// The leading zero bytes in a hash indicating that it is possibly an address, since it only has 20 bytes of data.
 	zeroBytesHex = "000000000000000000000000"
+	// The batches on the most recent L1 blocks which may not be rolled up yet. The sequencer leaves out the batches of
+	// the last nodetype.RollupDelay blocks, and the rollup takes a few blocks to be published.
+	rollupGraceL1Blocks = 10
 )
 
 // After a simulation has run, check as much as possible that the outputs of the simulation are expected.
@@ -52,11 +61,24 @@ const (
 // time of the simulation and the average block duration, that all Obscuro nodes are roughly in sync, etc
 func checkNetworkValidity(t *testing.T, s *Simulation) {
 	time.Sleep(2 * time.Second)
+	checkFaultsInjected(t, s)
 	checkTransactionsInjected(t, s)
 	l1MaxHeight := checkEthereumBlockchainValidity(t, s)
 	checkObscuroBlockchainValidity(t, s, l1MaxHeight)
 	checkReceivedLogs(t, s)
+	checkSubscribedTransfers(t, s)
 	checkTenscan(t, s)
+	checkTxLatencies(t, s)
+	checkTxBursts(t, s)
+}
+
+// Ensures that the faults were injected into the network as configured.
+func checkFaultsInjected(t *testing.T, s *Simulation) {
+	s.faultsLock.Lock()
+	defer s.faultsLock.Unlock()
+	for _, err := range s.faults {
+		t.Errorf("Simulation could not inject a fault. Cause: %s", err)
+	}
 }
 
 // Ensures that L1 and L2 txs were actually issued.
@@ -84,7 +106,7 @@ func checkTransactionsInjected(t *testing.T, s *Simulation) {
 // - no reorgs
 func checkEthereumBlockchainValidity(t *testing.T, s *Simulation) uint64 {
 	// Sanity check number for a minimum height
-	minHeight := uint64(float64(s.Params.SimulationTime.Microseconds()) / (2 * float64(s.Params.AvgBlockDuration)))
+	minHeight := uint64(float64(s.Params.SimulationTime.Microseconds()) / (2 * float64(s.Params.L1BlockDuration())))
 
 	heights := make([]uint64, len(s.RPCHandles.EthClients))
 	for i, node := range s.RPCHandles.EthClients {
@@ -109,7 +131,18 @@ func checkEthereumBlockchainValidity(t *testing.T, s *Simulation) uint64 {
 // - check withdrawals/deposits
 func checkObscuroBlockchainValidity(t *testing.T, s *Simulation, maxL1Height uint64) {
 	// Sanity check number for a minimum height
-	minHeight := uint64(float64(s.Params.SimulationTime.Microseconds()) / (2 * float64(s.Params.AvgBlockDuration)))
+	minHeight := uint64(float64(s.Params.SimulationTime.Microseconds()) / (2 * float64(s.Params.L1BlockDuration())))
+
+	// the restarted and partitioned nodes get the time to catch up before they are checked like the others
+	if len(s.Params.NodeRestartPoints) > 0 || len(s.Params.NetworkPartitions) > 0 {
+		checkNodesConverged(t, s)
+	}
+	if s.Params.BatchCorruptionRate > 0 {
+		checkCorruptedBatchesRejected(t, s)
+	}
+	if len(s.Params.NodeJoinPoints) > 0 {
+		checkJoinedNodesConverged(t, s)
+	}
 
 	// process the blockchain of each node in parallel to minimize the difference between them since they are still running
 	heights := make([]uint64, len(s.RPCHandles.ObscuroClients))
@@ -121,12 +154,169 @@ func checkObscuroBlockchainValidity(t *testing.T, s *Simulation, maxL1Height uin
 	wg.Wait()
 	min, max := minMax(heights)
 	// This checks that all the nodes are in sync. When a node falls behind with processing blocks it might highlight a problem.
-	// since there is one node that only listens to rollups it will be naturally behind.
-	if max-min > max/3 {
+	// since there is one node that only listens to rollups it will be naturally behind. The partitioned nodes may be
+	// further behind.
+	if max-min > max/3+uint64(float64(max)*s.Params.PartitionedFraction()) {
 		t.Errorf("There is a problem with the Obscuro chain. Nodes fell out of sync. Max height: %d. Min height: %d -> %+v", max, min, heights)
 	}
 }
 
+// checkNodesConverged - checks that every node reaches the heads the other nodes have at the start of the checks, with the
+// same hashes, within an allowance for the restarts and the partitions of the simulation
+func checkNodesConverged(t *testing.T, s *Simulation) {
+	allowance := s.Params.NodeRestartDowntime + s.Params.LongestPartition() + maxBlockDelay*s.Params.L1BlockDuration()
+	clients := s.RPCHandles.ObscuroClients
+
+	heads := make([]*common.BatchHeader, len(clients))
+	for nodeIdx, client := range clients {
+		head, err := getHeadBatchHeader(client)
+		if err != nil {
+			t.Errorf("Node %d: %s", nodeIdx, err)
+			continue
+		}
+		heads[nodeIdx] = head
+	}
+
+	for nodeIdx, client := range clients {
+		for otherIdx, head := range heads {
+			if otherIdx == nodeIdx || head == nil {
+				continue
+			}
+			err := retry.Do(func() error {
+				header, err := client.BatchHeaderByNumber(head.Number)
+				if err != nil {
+					return fmt.Errorf("could not retrieve batch %d. Cause: %w", head.Number, err)
+				}
+				if header.Hash() != head.Hash() {
+					return fmt.Errorf("batch %d is %s, not %s", head.Number, header.Hash(), head.Hash())
+				}
+				return nil
+			}, retry.NewTimeoutStrategy(allowance, s.Params.L1BlockDuration()/2))
+			if err != nil {
+				t.Errorf("Node %d: did not converge to the head of node %d within %s. Cause: %s", nodeIdx, otherIdx, allowance, err)
+			}
+		}
+	}
+}
+
+// checkJoinedNodesConverged - checks that each node which joined the running network was sent the network secret
+// through the L1, and reached the head the sequencer has at the start of the checks
+func checkJoinedNodesConverged(t *testing.T, s *Simulation) {
+	if len(s.JoinedNodes) != len(s.Params.NodeJoinPoints) {
+		t.Errorf("Only %d of the %d nodes joined the network", len(s.JoinedNodes), len(s.Params.NodeJoinPoints))
+	}
+	sequencerHead, err := getHeadBatchHeader(s.RPCHandles.ObscuroClients[0])
+	if err != nil {
+		t.Errorf("Node 0: %s", err)
+		return
+	}
+	recipients, err := secretRecipients(s)
+	if err != nil {
+		t.Errorf("Could not find the network secrets sent through the L1. Cause: %s", err)
+	}
+
+	for _, joined := range s.JoinedNodes {
+		if !recipients[joined.HostID] {
+			t.Errorf("Node %d: no network secret was sent through the L1 to its host %s", joined.Idx, joined.HostID)
+		}
+
+		client := obsclient.NewObsClient(joined.Client)
+		err = retry.Do(func() error {
+			header, err := client.BatchHeaderByNumber(sequencerHead.Number)
+			if err != nil {
+				return fmt.Errorf("could not retrieve batch %d. Cause: %w", sequencerHead.Number, err)
+			}
+			if header.Hash() != sequencerHead.Hash() {
+				return fmt.Errorf("batch %d is %s, not %s", sequencerHead.Number, header.Hash(), sequencerHead.Hash())
+			}
+			return nil
+		}, retry.NewTimeoutStrategy(maxBlockDelay*s.Params.L1BlockDuration(), s.Params.L1BlockDuration()/2))
+		if err != nil {
+			t.Errorf("Node %d: did not converge to the head of the sequencer after joining. Cause: %s", joined.Idx, err)
+		}
+	}
+}
+
+// secretRecipients - the hosts which were sent the network secret through the L1
+func secretRecipients(s *Simulation) (map[gethcommon.Address]bool, error) {
+	ethClient := s.RPCHandles.EthClients[0]
+	head, err := ethClient.FetchHeadBlock()
+	if err != nil {
+		return nil, err
+	}
+	recipients := make(map[gethcommon.Address]bool)
+	for _, block := range ethClient.BlocksBetween(s.l1StartBlock(ethClient), head) {
+		for _, tx := range block.Transactions() {
+			if respondTx, ok := s.Params.MgmtContractLib.DecodeTx(tx).(*ethadapter.L1RespondSecretTx); ok {
+				recipients[respondTx.RequesterID] = true
+			}
+		}
+	}
+	return recipients, nil
+}
+
+// checkCorruptedBatchesRejected - checks that the validators kept none of the batches corrupted by the sequencer in their
+// chains, and reached the height of the last of them with the genuine batches
+func checkCorruptedBatchesRejected(t *testing.T, s *Simulation) {
+	corrupted := s.Network.BatchFaults().CorruptedBatches()
+	if len(corrupted) == 0 {
+		t.Errorf("The sequencer did not corrupt any batch, with a corruption rate of %f", s.Params.BatchCorruptionRate)
+		return
+	}
+	lastHeight, firstHeight := uint64(0), uint64(math.MaxUint64)
+	for _, batch := range corrupted {
+		if batch.Header.Number.Uint64() > lastHeight {
+			lastHeight = batch.Header.Number.Uint64()
+		}
+		if batch.Header.Number.Uint64() < firstHeight {
+			firstHeight = batch.Header.Number.Uint64()
+		}
+	}
+	t.Logf("The sequencer corrupted %d batches, up to height %d", len(corrupted), lastHeight)
+
+	// the sequencer does not receive the batches it gossips
+	for nodeIdx := 1; nodeIdx < len(s.RPCHandles.ObscuroClients); nodeIdx++ {
+		// no gossip reaches the node, it only learns the batches from the rollups
+		if nodeIdx == s.Params.NodeWithInboundP2PDisabled {
+			continue
+		}
+		client := s.RPCHandles.ObscuroClients[nodeIdx]
+		err := retry.Do(func() error {
+			head, err := getHeadBatchHeader(client)
+			if err != nil {
+				return err
+			}
+			if head.Number.Uint64() < lastHeight {
+				return fmt.Errorf("the head batch %d is behind the last corrupted batch %d", head.Number, lastHeight)
+			}
+			// the chain is followed by the parent hashes, as the host indexes the heights by the last batch it received.
+			// The signature is not part of the hash, so for a copy with a bad signature it is only checked that the node
+			// went past it
+			chain := map[gethcommon.Hash]bool{}
+			for header := head; ; {
+				chain[header.Hash()] = true
+				if header.Number.Uint64() <= firstHeight {
+					break
+				}
+				parent, err := client.BatchHeaderByHash(header.ParentHash)
+				if err != nil {
+					return fmt.Errorf("could not retrieve the parent of batch %d. Cause: %w", header.Number, err)
+				}
+				header = parent
+			}
+			for _, batch := range corrupted {
+				if batch.Fault != p2p.FaultBadSignature && chain[batch.Header.Hash()] {
+					return fmt.Errorf("batch %d is the one corrupted with a %s", batch.Header.Number, batch.Fault)
+				}
+			}
+			return nil
+		}, retry.NewTimeoutStrategy(maxBlockDelay*s.Params.L1BlockDuration(), s.Params.L1BlockDuration()/2))
+		if err != nil {
+			t.Errorf("Node %d: did not reject the corrupted batches. Cause: %s", nodeIdx, err)
+		}
+	}
+}
+
 // the cost of an empty rollup - adjust if the management contract changes. This is the rollup overhead.
 const emptyRollupGas = 110_000
 
@@ -174,12 +364,15 @@ func checkBlockchainOfEthereumNode(t *testing.T, node ethadapter.EthClient, minH
 		t.Errorf("Node %d: Could not find head block. Cause: %s", nodeIdx, err)
 	}
 	height := head.NumberU64()
+	start := s.l1StartBlock(node)
+	// the blocks of a pre-existing L1 which predate the simulation do not count
+	mined := height - start.NumberU64()
 
-	if height < minHeight {
-		t.Errorf("Node %d: There were only %d blocks mined. Expected at least: %d.", nodeIdx, height, minHeight)
+	if mined < minHeight {
+		t.Errorf("Node %d: There were only %d blocks mined. Expected at least: %d.", nodeIdx, mined, minHeight)
 	}
 
-	deposits, rollups, _, blockCount, _, rollupReceipts := ExtractDataFromEthereumChain(ethereummock.MockGenesisBlock, head, node, s, nodeIdx)
+	deposits, rollups, _, blockCount, _, rollupReceipts := ExtractDataFromEthereumChain(start, head, node, s, nodeIdx)
 	s.Stats.TotalL1Blocks = uint64(blockCount)
 
 	checkCollectedL1Fees(t, node, s, nodeIdx, rollupReceipts)
@@ -207,8 +400,8 @@ func checkBlockchainOfEthereumNode(t *testing.T, node ethadapter.EthClient, minH
 
 	// compare the number of reorgs for this node against the height
 	reorgs := s.Stats.NoL1Reorgs[node.Info().L2ID]
-	reorgEfficiency := float64(reorgs) / float64(height)
-	if reorgEfficiency > s.Params.L1EfficiencyThreshold {
+	reorgEfficiency := float64(reorgs) / float64(mined)
+	if reorgEfficiency > s.Params.L1EfficiencyThreshold+s.Params.PartitionedFraction() {
 		t.Errorf("Node %d: The number of reorgs is too high: %d. ", nodeIdx, reorgs)
 	}
 	if !s.Params.IsInMem {
@@ -245,6 +438,176 @@ func checkRollups(t *testing.T, s *Simulation, nodeIdx int, rollups []*common.Ex
 	}
 }
 
+// checkBatchesRolledUp - every batch of the node, except for the ones in the grace window, is published by exactly one
+// canonical rollup. The rollups are read from the L1 node of the node.
+func checkBatchesRolledUp(t *testing.T, s *Simulation, nodeIdx int, headBatchHeader *common.BatchHeader) {
+	ethClient := s.RPCHandles.EthClients[nodeIdx]
+	l1Head, err := ethClient.FetchHeadBlock()
+	if err != nil {
+		t.Errorf("Node %d: Could not find the head block. Cause: %s", nodeIdx, err)
+		return
+	}
+	upToSeqNo, err := lastBatchOutsideGraceWindow(s.RPCHandles.ObscuroClients[nodeIdx], ethClient, headBatchHeader, l1Head)
+	if err != nil {
+		t.Errorf("Node %d: Could not walk the batch chain. Cause: %s", nodeIdx, err)
+		return
+	}
+	if upToSeqNo < common.L2GenesisSeqNo {
+		t.Errorf("Node %d: All the batches are in the grace window of %d L1 blocks", nodeIdx, rollupGraceL1Blocks)
+		return
+	}
+
+	var ranges []seqNoRange
+	for _, rollup := range canonicalRollups(ethClient, s, l1Head) {
+		r, err := rollupSeqNoRange(rollup)
+		if err != nil {
+			t.Errorf("Node %d: Could not decode rollup %s. Cause: %s", nodeIdx, rollup.Hash(), err)
+			return
+		}
+		ranges = append(ranges, r)
+	}
+
+	missing, duplicated := rollupCoverage(ranges, upToSeqNo)
+	if len(missing) > 0 {
+		t.Errorf("Node %d: The batches %v up to %d are not in any rollup", nodeIdx, missing, upToSeqNo)
+	}
+	if len(duplicated) == 0 {
+		return
+	}
+	// the mock L1 returns the receipt of a rollup before it is mined, so the in memory hosts can build the next rollup
+	// from the last one mined, and publish its batches again. Such a rollup still starts right after another one
+	misaligned := misalignedRollups(ranges)
+	if !s.Params.IsInMem || len(misaligned) > 0 {
+		t.Errorf("Node %d: The batches %v are in more than one rollup. Rollups not starting after another one: %v", nodeIdx, duplicated, misaligned)
+		return
+	}
+	t.Logf("Node %d: The batches %v are in more than one rollup", nodeIdx, duplicated)
+}
+
+// lastBatchOutsideGraceWindow - walks the batch chain back from the head to the first batch whose L1 proof is older
+// than the grace window
+func lastBatchOutsideGraceWindow(client *obsclient.ObsClient, ethClient ethadapter.EthClient, header *common.BatchHeader, l1Head *types.Block) (uint64, error) {
+	for {
+		l1Proof, err := ethClient.BlockByHash(header.L1Proof)
+		if err != nil {
+			return 0, fmt.Errorf("could not fetch the L1 proof of batch %d. Cause: %w", header.SequencerOrderNo, err)
+		}
+		if l1Proof.NumberU64()+rollupGraceL1Blocks <= l1Head.NumberU64() {
+			return header.SequencerOrderNo.Uint64(), nil
+		}
+		if header.SequencerOrderNo.Uint64() == common.L2GenesisSeqNo {
+			return 0, nil
+		}
+		parent, err := client.BatchHeaderByHash(header.ParentHash)
+		if err != nil {
+			return 0, fmt.Errorf("could not fetch the parent of batch %d. Cause: %w", header.SequencerOrderNo, err)
+		}
+		header = parent
+	}
+}
+
+// canonicalRollups - the rollups published by the successful transactions of the canonical L1 chain
+func canonicalRollups(ethClient ethadapter.EthClient, s *Simulation, l1Head *types.Block) []*common.ExtRollup {
+	var rollups []*common.ExtRollup
+	for _, block := range ethClient.BlocksBetween(s.l1StartBlock(ethClient), l1Head) {
+		for _, tx := range block.Transactions() {
+			rollupTx, ok := s.Params.MgmtContractLib.DecodeTx(tx).(*ethadapter.L1RollupTx)
+			if !ok {
+				continue
+			}
+			receipt, err := ethClient.TransactionReceipt(tx.Hash())
+			if err != nil || receipt.Status != types.ReceiptStatusSuccessful {
+				continue
+			}
+			rollup, err := common.DecodeRollup(rollupTx.Rollup)
+			if err != nil {
+				testlog.Logger().Crit("could not decode rollup. ", log.ErrKey, err)
+			}
+			rollups = append(rollups, rollup)
+		}
+	}
+	return rollups
+}
+
+// seqNoRange - the batches from First to Last, inclusive
+type seqNoRange struct {
+	First, Last uint64
+}
+
+func (r seqNoRange) String() string {
+	if r.First == r.Last {
+		return fmt.Sprintf("%d", r.First)
+	}
+	return fmt.Sprintf("%d-%d", r.First, r.Last)
+}
+
+// rollupSeqNoRange - the batches of the rollup. The first one is only in the calldata header, which the simulation can
+// read because the rollups are encrypted with a fixed key.
+func rollupSeqNoRange(rollup *common.ExtRollup) (seqNoRange, error) {
+	plaintext, err := crypto.NewDataEncryptionService(testlog.Logger()).Decrypt(rollup.CalldataRollupHeader)
+	if err != nil {
+		return seqNoRange{}, err
+	}
+	payload, err := core.DecodePayload(plaintext)
+	if err != nil {
+		return seqNoRange{}, err
+	}
+	serialised, err := compression.NewBrotliDataCompressionService().Decompress(payload.Compressed)
+	if err != nil {
+		return seqNoRange{}, err
+	}
+	header := new(common.CalldataRollupHeader)
+	if err := rlp.DecodeBytes(serialised, header); err != nil {
+		return seqNoRange{}, err
+	}
+	return seqNoRange{First: header.FirstBatchSequence.Uint64(), Last: rollup.Header.LastBatchSeqNo}, nil
+}
+
+// rollupCoverage - the batches up to upToSeqNo which are in no rollup, and the batches which are in more than one
+func rollupCoverage(ranges []seqNoRange, upToSeqNo uint64) (missing []seqNoRange, duplicated []seqNoRange) {
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].First < ranges[j].First
+	})
+	next := uint64(common.L2GenesisSeqNo) // the first batch not covered by the ranges so far
+	for _, r := range ranges {
+		if r.First > next && next <= upToSeqNo {
+			missing = append(missing, seqNoRange{First: next, Last: minUint64(r.First-1, upToSeqNo)})
+		}
+		if r.First < next {
+			duplicated = append(duplicated, seqNoRange{First: r.First, Last: minUint64(r.Last, next-1)})
+		}
+		if r.Last >= next {
+			next = r.Last + 1
+		}
+	}
+	if next <= upToSeqNo {
+		missing = append(missing, seqNoRange{First: next, Last: upToSeqNo})
+	}
+	return missing, duplicated
+}
+
+// misalignedRollups - the rollups which start neither with the first batch, nor right after the last batch of a rollup
+func misalignedRollups(ranges []seqNoRange) []seqNoRange {
+	starts := map[uint64]bool{common.L2GenesisSeqNo: true}
+	for _, r := range ranges {
+		starts[r.Last+1] = true
+	}
+	var misaligned []seqNoRange
+	for _, r := range ranges {
+		if !starts[r.First] {
+			misaligned = append(misaligned, r)
+		}
+	}
+	return misaligned
+}
+
+func minUint64(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
 // ExtractDataFromEthereumChain returns the deposits, rollups, total amount deposited and length of the blockchain
 // between the start block and the end block.
 func ExtractDataFromEthereumChain(
@@ -430,6 +793,8 @@ func checkBlockchainOfObscuroNode(t *testing.T, rpcHandles *network.RPCHandles,
 
 	heights[nodeIdx] = l2Height.Uint64()
 
+	checkBatchesRolledUp(t, s, nodeIdx, headBatchHeader)
+
 	if headBatchHeader.SequencerOrderNo.Uint64() == common.L2GenesisSeqNo {
 		return
 	}
@@ -613,6 +978,78 @@ func checkReceivedLogs(t *testing.T, s *Simulation) {
 	}
 }
 
+// Checks the percentiles of the time the transactions submitted to each node took to get a receipt against the limits.
+func checkTxLatencies(t *testing.T, s *Simulation) {
+	for nodeIdx, latency := range txLatencyReports(s.Stats) {
+		limits := []struct {
+			name  string
+			value time.Duration
+			limit time.Duration
+		}{
+			{"p50", latency.P50, s.Params.MaxTxLatencyP50},
+			{"p95", latency.P95, s.Params.MaxTxLatencyP95},
+			{"p99", latency.P99, s.Params.MaxTxLatencyP99},
+		}
+		for _, l := range limits {
+			if l.limit > 0 && l.value > l.limit {
+				t.Errorf("Node %d: the %s latency of the transactions is %s, more than the limit of %s (%d txs, %d lost, %d waited for a restart)",
+					nodeIdx, l.name, l.value, l.limit, latency.Txs, latency.Lost, latency.Waited)
+			}
+		}
+	}
+}
+
+// Checks that the transactions of the bursts were either refused for lack of room in the mempool, or executed exactly
+// once. Each burst wallet only sends burst transactions and reuses the nonces of the refused ones, so its nonce is the
+// number of its transactions which were executed.
+func checkTxBursts(t *testing.T, s *Simulation) {
+	if len(s.Params.TxBursts) == 0 {
+		return
+	}
+	tracker := s.TxInjector.TxTracker
+
+	for _, rejected := range tracker.RejectedBurstTransactions {
+		if !isCapacityError(rejected.Err) {
+			t.Errorf("Burst transaction %s was refused for another reason than the capacity of the mempool. Cause: %s",
+				rejected.Tx.Hash(), rejected.Err)
+		}
+	}
+
+	accepted := map[gethcommon.Address]uint64{}
+	for _, tx := range tracker.BurstL2Transactions {
+		sender := getSender(tx)
+		accepted[sender]++
+		receipt, err := s.RPCHandles.ObscuroWalletClient(sender, 0).TransactionReceipt(s.ctx, tx.Hash())
+		if err != nil {
+			t.Errorf("Burst transaction %s was accepted, but has no receipt. Cause: %s", tx.Hash(), err)
+			continue
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			t.Errorf("Burst transaction %s was accepted, but failed", tx.Hash())
+		}
+	}
+	for _, w := range s.Params.Wallets.BurstObsWallets {
+		nonce, err := s.RPCHandles.ObscuroWalletClient(w.Address(), 0).NonceAt(s.ctx, nil)
+		if err != nil {
+			t.Errorf("Could not fetch the nonce of the burst wallet %s. Cause: %s", w.Address(), err)
+			continue
+		}
+		if nonce != accepted[w.Address()] {
+			t.Errorf("Burst wallet %s executed %d transactions, but %d were accepted", w.Address(), nonce, accepted[w.Address()])
+		}
+	}
+	testlog.Logger().Info(fmt.Sprintf("Bursts: %d transactions accepted, %d refused for lack of room in the mempool",
+		len(tracker.BurstL2Transactions), len(tracker.RejectedBurstTransactions)))
+}
+
+// Checks the Transfer events delivered to the audited subscriptions against the receipts.
+func checkSubscribedTransfers(t *testing.T, s *Simulation) {
+	if s.auditor == nil {
+		return
+	}
+	s.auditor.check(t, s)
+}
+
 // Checks that a subscription has received the expected logs.
 func checkSubscribedLogs(t *testing.T, owner string, channel chan common.IDAndLog) int {
 	var logs []*types.Log
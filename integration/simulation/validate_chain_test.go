@@ -0,0 +1,29 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollupCoverage(t *testing.T) {
+	missing, duplicated := rollupCoverage([]seqNoRange{{6, 8}, {1, 3}, {3, 5}, {11, 12}}, 15)
+	require.Equal(t, []seqNoRange{{9, 10}, {13, 15}}, missing)
+	require.Equal(t, []seqNoRange{{3, 3}}, duplicated)
+
+	// the batches after the last one checked can be missing
+	missing, duplicated = rollupCoverage([]seqNoRange{{1, 4}, {1, 6}, {7, 7}}, 5)
+	require.Empty(t, missing)
+	require.Equal(t, []seqNoRange{{1, 4}}, duplicated)
+
+	// the same rollup published twice
+	_, duplicated = rollupCoverage([]seqNoRange{{1, 4}, {1, 4}}, 4)
+	require.Equal(t, []seqNoRange{{1, 4}}, duplicated)
+}
+
+func TestOnlyTheRollupsNotStartingAfterAnotherOneAreMisaligned(t *testing.T) {
+	// rollups built from the last rollup mined, before the next one was, publish some batches again
+	require.Empty(t, misalignedRollups([]seqNoRange{{1, 2}, {1, 4}, {3, 6}, {5, 8}, {5, 8}, {9, 9}}))
+
+	require.Equal(t, []seqNoRange{{4, 7}}, misalignedRollups([]seqNoRange{{1, 5}, {4, 7}, {6, 8}}))
+}
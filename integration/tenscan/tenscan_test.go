@@ -216,7 +216,6 @@ func createObscuroNetwork(t *testing.T, startPort int) {
 		ERC20ContractLib: ethereummock.NewERC20ContractLibMock(),
 		Wallets:          wallets,
 		StartPort:        startPort,
-		WithPrefunding:   true,
 	}
 
 	obscuroNetwork := network.NewNetworkOfSocketNodes(wallets)
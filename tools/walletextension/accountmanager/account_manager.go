@@ -2,6 +2,7 @@ package accountmanager
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"sync"
 
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/google/uuid"
 	"github.com/ten-protocol/go-ten/go/common"
 
 	"github.com/ten-protocol/go-ten/tools/walletextension/storage"
@@ -16,6 +18,7 @@ import (
 	"github.com/ten-protocol/go-ten/tools/walletextension/subscriptions"
 
 	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/common/log"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 
@@ -205,9 +208,23 @@ var platformAuthorisedCalls = map[string]bool{
 	rpc.GetTransactionCount:   true,
 	rpc.GetTransactionReceipt: true,
 	rpc.GetLogs:               true,
+	rpc.StorageRangeAt:        true,
+	rpc.DebugTraceTransaction: true,
+	rpc.DebugLogRelevancy:     true,
+	rpc.BatchLogRelevancy:     true,
 }
 
 func (m *AccountManager) executeCall(rpcReq *wecommon.RPCRequest, rpcResp *interface{}) error {
+	// the enclave logs the failed requests under this ID
+	requestID := uuid.NewString()
+	err := m.executeCallWithID(rpc.WithRequestID(context.Background(), requestID), rpcReq, rpcResp)
+	if err != nil {
+		m.logger.Info("Request failed", "method", rpcReq.Method, log.RequestIDKey, requestID, log.ErrKey, err)
+	}
+	return err
+}
+
+func (m *AccountManager) executeCallWithID(ctx context.Context, rpcReq *wecommon.RPCRequest, rpcResp *interface{}) error {
 	m.accountsMutex.RLock()
 	defer m.accountsMutex.RUnlock()
 	// for Ten RPC requests, it is important we know the sender account for the viewing key encryption/decryption
@@ -217,13 +234,13 @@ func (m *AccountManager) executeCall(rpcReq *wecommon.RPCRequest, rpcResp *inter
 	case suggestedClient != nil: // use the suggested client if there is one
 		// todo (@ziga) - if we have a suggested client, should we still loop through the other clients if it fails?
 		// 		The call data guessing won't often be wrong but there could be edge-cases there
-		return submitCall(suggestedClient, rpcReq, rpcResp)
+		return submitCall(ctx, suggestedClient, rpcReq, rpcResp)
 
 	case len(m.accountClientsHTTP) > 0: // try registered clients until there's a successful execution
 		m.logger.Info(fmt.Sprintf("appropriate client not found, attempting request with up to %d clients", len(m.accountClientsHTTP)))
 		var err error
 		for _, client := range m.accountClientsHTTP {
-			err = submitCall(client, rpcReq, rpcResp)
+			err = submitCall(ctx, client, rpcReq, rpcResp)
 			if err == nil || errors.Is(err, rpc.ErrNilResponse) {
 				// request didn't fail, we don't need to continue trying the other clients
 				return nil
@@ -382,7 +399,7 @@ func searchDataFieldForAccount(callParams map[string]interface{}, accClients map
 	return nil, fmt.Errorf("no known account found in data bytes")
 }
 
-func submitCall(client *rpc.EncRPCClient, req *wecommon.RPCRequest, resp *interface{}) error {
+func submitCall(ctx context.Context, client *rpc.EncRPCClient, req *wecommon.RPCRequest, resp *interface{}) error {
 	if req.Method == rpc.Call || req.Method == rpc.EstimateGas {
 		// Never modify the original request, as it might be reused.
 		req = req.Clone()
@@ -406,7 +423,7 @@ func submitCall(client *rpc.EncRPCClient, req *wecommon.RPCRequest, resp *interf
 		req.Params = append(req.Params, client.Account().Hex())
 	}
 
-	return client.Call(resp, req.Method, req.Params...)
+	return client.CallContext(ctx, resp, req.Method, req.Params...)
 }
 
 // The enclave requires the `from` field to be set so that it can encrypt the response, but sources like MetaMask often